@@ -16,6 +16,7 @@ import (
 	"github.com/fagongzi/gateway/pkg/util"
 	"github.com/fagongzi/grpcx"
 	"github.com/fagongzi/log"
+	"github.com/fagongzi/util/task"
 	"github.com/labstack/echo"
 	"google.golang.org/grpc"
 )
@@ -23,7 +24,7 @@ import (
 var (
 	addr           = flag.String("addr", "127.0.0.1:9092", "Addr: client grpc entrypoint")
 	addrHTTP       = flag.String("addr-http", "127.0.0.1:9093", "Addr: client http restful entrypoint")
-	addrStore      = flag.String("addr-store", "etcd://127.0.0.1:2379", "Addr: store address")
+	addrStore      = flag.String("addr-store", "etcd://127.0.0.1:2379", "Addr: store address, support etcd and consul, e.g. consul://127.0.0.1:8500, the diff and the changeset rollback are not supported by consul")
 	namespace      = flag.String("namespace", "dev", "The namespace to isolation the environment.")
 	discovery      = flag.Bool("discovery", false, "Publish apiserver service via discovery.")
 	servicePrefix  = flag.String("service-prefix", "/services", "The prefix for service name.")
@@ -31,7 +32,46 @@ var (
 	publishTimeout = flag.Int("publish-timeout", 30, "Publish service timeout seconds")
 	ui             = flag.String("ui", "/app/gateway/ui", "The gateway ui dist dir.")
 	uiPrefix       = flag.String("ui-prefix", "/ui", "The gateway ui prefix path.")
+	limitStoreSlow = flag.Int("limit-store-slow", 1000, "Limit(ms): The store operation slower than this will be logged")
+	legacyErrors   = flag.Bool("legacy-errors", false, "Return the errors of the restful api with the status code only, compatible with the old clients")
+	apiApproval    = flag.Bool("api-approval", false, "The api submitted by an operator must be approved by another operator before it is published")
 	version        = flag.Bool("version", false, "Show version info")
+
+	// portal
+	portalSecret = flag.String("portal-secret", "", "The HS256 secret of the developer portal token, the portal is disabled if empty")
+	portalQuota  = flag.Int64("portal-quota", 10000, "The default daily quota of the developer portal consumers, counted by each proxy separately, so N proxies allow N times the quota, 0 means no limit")
+	portalKeyTTL = flag.Int64("portal-key-ttl", 0, "Limit(sec): The max ttl of the api keys requested in the developer portal, 0 means never expire")
+
+	// key expiry
+	keyExpiryWebhook = flag.String("key-expiry-webhook", "", "The webhook that receives the expiry notifications of the api keys")
+	keyExpiryNotice  = flag.Int64("key-expiry-notice", 604800, "Limit(sec): Notify the api key expiry before the seconds, 0 means disable")
+
+	// changeset
+	requireChangeDescription = flag.Bool("require-change-description", false, "The admin mutations must carry the X-Change-Description or the X-Changeset header")
+	changesetWebhook         = flag.String("changeset-webhook", "", "The webhook that receives the notifications of the committed and rolled back changesets")
+
+	// idempotency
+	ttlIdempotencyKey = flag.Int("ttl-idempotency-key", 600, "TTL(secs): replay the results of the admin mutations that have the same Idempotency-Key header, 0 means disable")
+
+	// usage report
+	intervalUsageReport = flag.Int("interval-usage-report", 300, "Interval(sec): collect the consumer usages from the proxies for the reports, 0 means disable")
+	usageRetention      = flag.Int("usage-retention", 400, "The days of the consumer usages are kept, 0 means forever")
+
+	// consistency
+	intervalConsistencyCheck = flag.Int("interval-consistency-check", 600, "Interval(sec): check the consistency of the config in the store, 0 means disable")
+
+	// stale proxy
+	intervalStaleProxyCheck = flag.Int("interval-stale-proxy-check", 30, "Interval(sec): check the proxies that not applied the last meta change in time, 0 means disable")
+	limitStaleProxy         = flag.Int("limit-stale-proxy", 10, "Limit(sec): the proxy is stale if the last meta change is not applied in the seconds")
+
+	// fleet cache
+	ttlFleetCache = flag.Int("ttl-fleet-cache", 2, "TTL(secs): cache the analysis and the health that aggregated from all proxies, 0 means disable")
+
+	// metric
+	metricJob          = flag.String("metric-job", "", "prometheus job name")
+	metricInstance     = flag.String("metric-instance", "", "prometheus instance name")
+	metricAddress      = flag.String("metric-address", "", "prometheus proxy address")
+	metricIntervalSync = flag.Uint64("interval-metric-sync", 0, "Interval(sec): metric sync")
 )
 
 func main() {
@@ -51,6 +91,24 @@ func main() {
 	log.Infof("service-prefix: %s", *servicePrefix)
 	log.Infof("publish-lease: %d", *publishLease)
 	log.Infof("publish-timeout: %d", *publishTimeout)
+	log.Infof("limit-store-slow: %d", *limitStoreSlow)
+	log.Infof("legacy-errors: %v", *legacyErrors)
+	log.Infof("api-approval: %v", *apiApproval)
+	log.Infof("portal-quota: %d", *portalQuota)
+	log.Infof("portal-key-ttl: %d", *portalKeyTTL)
+	log.Infof("key-expiry-webhook: %s", *keyExpiryWebhook)
+	log.Infof("key-expiry-notice: %d", *keyExpiryNotice)
+	log.Infof("require-change-description: %v", *requireChangeDescription)
+	log.Infof("changeset-webhook: %s", *changesetWebhook)
+	log.Infof("ttl-idempotency-key: %d", *ttlIdempotencyKey)
+	log.Infof("interval-usage-report: %d", *intervalUsageReport)
+	log.Infof("usage-retention: %d", *usageRetention)
+	log.Infof("interval-consistency-check: %d", *intervalConsistencyCheck)
+	log.Infof("interval-stale-proxy-check: %d", *intervalStaleProxyCheck)
+	log.Infof("limit-stale-proxy: %d", *limitStaleProxy)
+	log.Infof("ttl-fleet-cache: %d", *ttlFleetCache)
+
+	store.SlowRequestTime = time.Millisecond * time.Duration(*limitStoreSlow)
 
 	db, err := store.GetStoreFrom(*addrStore, fmt.Sprintf("/%s", *namespace))
 	if err != nil {
@@ -60,15 +118,37 @@ func main() {
 	}
 
 	service.Init(db)
+	service.SetLegacyErrors(*legacyErrors)
+	service.SetAPIApproval(*apiApproval)
+	service.SetRequireChangeDescription(*requireChangeDescription)
+	service.SetChangesetWebhook(*changesetWebhook)
+	service.SetIdempotencyKeysTTL(time.Second * time.Duration(*ttlIdempotencyKey))
+	service.StaleProxyTimeout = time.Second * time.Duration(*limitStaleProxy)
+
+	runner := task.NewRunner()
+	util.StartMetricsPush(runner, util.NewMetricCfg(*metricJob, *metricInstance, *metricAddress, time.Second*time.Duration(*metricIntervalSync)))
+	service.StartKeyExpiryNotifier(runner, *keyExpiryWebhook, *keyExpiryNotice)
+	service.StartUsageReporter(runner, time.Second*time.Duration(*intervalUsageReport), *usageRetention)
+	service.StartConsistencyChecker(runner, time.Second*time.Duration(*intervalConsistencyCheck))
+	service.StartStaleProxyChecker(runner, time.Second*time.Duration(*intervalStaleProxyCheck))
+	service.StartFleetCache(runner, time.Second*time.Duration(*ttlFleetCache))
 
 	var opts []grpcx.ServerOption
 	if *discovery {
-		opts = append(opts, grpcx.WithEtcdPublisher(db.Raw().(*clientv3.Client), *servicePrefix, *publishLease, time.Second*time.Duration(*publishTimeout)))
+		etcdClient, ok := db.Raw().(*clientv3.Client)
+		if !ok {
+			log.Fatalf("the discovery requires the etcd store, but the store is %s", *addrStore)
+		}
+
+		opts = append(opts, grpcx.WithEtcdPublisher(etcdClient, *servicePrefix, *publishLease, time.Second*time.Duration(*publishTimeout)))
 	}
 
 	if *addrHTTP != "" {
 		opts = append(opts, grpcx.WithHTTPServer(*addrHTTP, func(server *echo.Echo) {
 			service.InitHTTPRouter(server, *ui, *uiPrefix)
+			if *portalSecret != "" {
+				service.InitPortalRouter(server, *portalSecret, *portalQuota, *portalKeyTTL)
+			}
 		}))
 	}
 
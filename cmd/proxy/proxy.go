@@ -8,10 +8,12 @@ import (
 	"os"
 	"os/signal"
 	"runtime"
+	"strings"
 	"syscall"
 	"time"
 
 	"github.com/fagongzi/gateway/pkg/proxy"
+	"github.com/fagongzi/gateway/pkg/store"
 	"github.com/fagongzi/gateway/pkg/util"
 	"github.com/fagongzi/log"
 )
@@ -32,8 +34,11 @@ var (
 	filters        = &filterFlag{}
 
 	addr                          = flag.String("addr", "127.0.0.1:80", "Addr: http request entrypoint")
+	addrV6                        = flag.String("addr-v6", "", "Addr: http request entrypoint of ipv6, e.g. [::]:80, the socket only accepts ipv6 connections")
+	addrInternal                  = flag.String("addr-internal", "", "Addr: http request entrypoint of the internal apis, e.g. 10.0.0.1:8080, the internal apis are only reachable from it, empty means disabled")
+	v6Only                        = flag.Bool("v6-only", false, "Only listen on the ipv6 addr")
 	addrRPC                       = flag.String("addr-rpc", "127.0.0.1:9091", "Addr: manager request entrypoint")
-	addrStore                     = flag.String("addr-store", "etcd://127.0.0.1:2379", "Addr: store of meta data, support etcd")
+	addrStore                     = flag.String("addr-store", "etcd://127.0.0.1:2379", "Addr: store of meta data, support etcd and consul, e.g. consul://127.0.0.1:8500")
 	addrPPROF                     = flag.String("addr-pprof", "", "Addr: pprof addr")
 	namespace                     = flag.String("namespace", "dev", "The namespace to isolation the environment.")
 	limitCpus                     = flag.Int("limit-cpus", 0, "Limit: schedule threads count")
@@ -50,11 +55,43 @@ var (
 	limitBufferWrite              = flag.Int("limit-buf-write", 1024, "Limit(bytes): Bytes for write buffer size")
 	limitBytesBodyMB              = flag.Int("limit-body", 10, "Limit(MB): MB for body size")
 	limitBytesCachingMB           = flag.Uint64("limit-caching", 64, "Limit(MB): MB for caching size")
+	limitCountAnalysisBuffer      = flag.Int("limit-analysis-buffer", 4096, "Limit(count): Count of the buffered analysis updates, the updates are dropped if the buffer is full, 0 means updating on the request goroutines")
+	limitCountHeavyWorker         = flag.Int("limit-heavy", 0, "Limit(count): Count of the cpu-heavy operations(template rendering, aggregation, body transcoding and schema validation) that running at the same time, 0 means no limit")
+	limitCountWebSocketConn       = flag.Int("limit-websocket-conn", 0, "Limit(count): Count of concurrent websocket connections, 0 means no limit")
+	limitDurationWebSocketIdleSec = flag.Int("limit-websocket-idle", 0, "Limit(sec): Idle for websocket connections, 0 means no limit")
+	limitTimeoutWebSocketDrainSec = flag.Int("limit-websocket-drain", 10, "Limit(sec): Timeout for the websocket connections to close when proxy stopping")
+	limitCountConnPerIP           = flag.Int("limit-ip-conn", 0, "Limit(count): Count of concurrent connections per client ip, 0 means no limit")
+	limitRateConnPerIP            = flag.Int("limit-ip-conn-rate", 0, "Limit(count): Count of new connections per second per client ip, 0 means no limit")
+	limitRateRequestPerIP         = flag.Int("limit-ip-request-rate", 0, "Limit(count): Count of requests per second per client ip, 0 means no limit")
+	limitDurationIPBanSec         = flag.Int("limit-ip-ban", 60, "Limit(sec): Ban the client ip that exceeds the rates for the duration, 0 means no ban")
+	limitStoreSlowMS              = flag.Int("limit-store-slow", 1000, "Limit(ms): The store operation slower than this will be logged")
+	limitDialFallbackMS           = flag.Int("limit-dial-fallback", 250, "Limit(ms): Delay to connect to the next address of the backend host while the previous attempts are in progress, 0 means after the previous attempts failed")
 	ttlProxy                      = flag.Int64("ttl-proxy", 10, "TTL(secs): proxy")
+	labels                        = flag.String("labels", "", "The labels of the proxy, used by proxy overrides and api proxy groups, format is name=value[,name=value]")
 	version                       = flag.Bool("version", false, "Show version info")
 
 	// internal plugin configuration file
-	jwtCfg = flag.String("jwt", "", "PLugin(JWT): jwt plugin configuration file, json format")
+	jwtCfg           = flag.String("jwt", "", "PLugin(JWT): jwt plugin configuration file, json format")
+	tokenExchangeCfg = flag.String("token-exchange", "", "Plugin(TOKEN-EXCHANGE): token exchange plugin configuration file, json format")
+	oidcCfg          = flag.String("oidc", "", "Plugin(OIDC): openid connect plugin configuration file, json format")
+
+	errorPages       = flag.String("error-pages", "", "The default error pages configuration file, json format")
+	securityHeaders  = flag.String("security-headers", "", "The security headers policy configuration file of all apis, json format")
+	resolverCfg      = flag.String("resolver", "", "The dns resolver configuration file for the backend servers, json format, default is the host resolver")
+	streamListeners  = flag.String("stream-listeners", "", "The layer-4 stream listeners configuration file, json format")
+	spillDir         = flag.String("spill-dir", "", "The directory to spill the large request bodies of the apis that enable spill to disk, default is the temp directory")
+	deadlineHeader   = flag.String("deadline-header", "", "The header to propagate the remaining deadline(ms) of the request to the backends, e.g. X-Request-Timeout-Ms, empty means disabled")
+	traceHeader      = flag.String("trace-header", "", "The header of the trace id of the requests, e.g. traceparent, the trace ids are the exemplars of the latency histograms in the OpenMetrics exposition, empty means disabled")
+	tracingEndpoint  = flag.String("tracing-endpoint", "", "The OTLP/HTTP endpoint that the spans of the requests are exported to, e.g. http://127.0.0.1:4318/v1/traces of jaeger, empty means disabled")
+	tracingService   = flag.String("tracing-service", "gateway-proxy", "The service name of the spans of the requests")
+	tracingSampling  = flag.Int("tracing-sampling", 0, "The percent of the new traces that sampled, 0 means all")
+	debugSecret      = flag.String("debug-secret", "", "The secret of the X-Gateway-Debug header to add the routing debug headers to the responses, empty means disabled")
+	trustedProxies   = flag.String("trusted-proxies", "", "The ips or cidrs of the proxies in front of the gateway, e.g. 10.0.0.0/8,192.168.1.1, the X-Forwarded-For is only trusted from them, empty means the client ip is the connection ip")
+	federationSecret = flag.String("federation-secret", "", "The secret that shared with the gateways that forward the requests to this gateway, the consumers signed by the secret are trusted, empty means disabled")
+	cachingRedis     = flag.String("caching-redis", "", "The redis addr that shared by the proxies to cache the responses, empty means caching in the proxy")
+	oauth2Secret     = flag.String("oauth2-secret", "", "The HS256 secret that shared by the proxies to sign the access tokens of the oauth2 token endpoint, empty means disabled")
+	oauth2TokenPath  = flag.String("oauth2-token-path", "/oauth2/token", "The path of the oauth2 token endpoint that issues the access tokens by the client credentials grant")
+	oauth2TokenTTL   = flag.Int64("oauth2-token-ttl", 300, "Limit(sec): The ttl of the access tokens that issued by the oauth2 token endpoint")
 
 	// metric
 	metricJob          = flag.String("metric-job", "", "prometheus job name")
@@ -63,20 +100,27 @@ var (
 	metricIntervalSync = flag.Uint64("interval-metric-sync", 0, "Interval(sec): metric sync")
 
 	// enable features
-	enableWebSocket = flag.Bool("websocket", false, "enable websocket")
+	enableWebSocket      = flag.Bool("websocket", false, "enable websocket")
+	enableExecHeathCheck = flag.Bool("exec-heath-check", false, "enable the heath checks that run the commands on the proxy")
 )
 
 func init() {
 	defaultFilters.Set(proxy.FilterWhiteList)
 	defaultFilters.Set(proxy.FilterBlackList)
+	defaultFilters.Set(proxy.FilterAccessPolicy)
+	defaultFilters.Set(proxy.FilterKeyAuth)
+	defaultFilters.Set(proxy.FilterContentType)
 	defaultFilters.Set(proxy.FilterCaching)
 	defaultFilters.Set(proxy.FilterAnalysis)
 	defaultFilters.Set(proxy.FilterRateLimiting)
+	defaultFilters.Set(proxy.FilterRateLimits)
 	defaultFilters.Set(proxy.FilterCircuitBreake)
 	defaultFilters.Set(proxy.FilterHTTPAccess)
 	defaultFilters.Set(proxy.FilterHeader)
 	defaultFilters.Set(proxy.FilterXForward)
 	defaultFilters.Set(proxy.FilterValidation)
+	defaultFilters.Set(proxy.FilterFederation)
+	defaultFilters.Set(proxy.FilterOutboundAuth)
 }
 
 func main() {
@@ -102,6 +146,9 @@ func main() {
 		}()
 	}
 
+	store.SlowRequestTime = time.Millisecond * time.Duration(*limitStoreSlowMS)
+	util.DialFallbackDelay = time.Millisecond * time.Duration(*limitDialFallbackMS)
+
 	p := proxy.NewProxy(getCfg())
 	go p.Start()
 
@@ -130,19 +177,28 @@ func waitStop(p *proxy.Proxy) {
 }
 
 func getCfg() *proxy.Cfg {
+	var err error
 	cfg := &proxy.Cfg{
 		Option: &proxy.Option{},
 		Metric: util.NewMetricCfg(*metricJob, *metricInstance, *metricAddress, time.Second*time.Duration(*metricIntervalSync)),
 	}
 
 	cfg.Addr = *addr
+	cfg.AddrV6 = *addrV6
+	cfg.AddrInternal = *addrInternal
+	cfg.V6Only = *v6Only
 	cfg.AddrRPC = *addrRPC
 	cfg.AddrPPROF = *addrPPROF
 	cfg.AddrStore = *addrStore
 	cfg.TTLProxy = *ttlProxy
+	cfg.Labels, err = proxy.ParseLabels(*labels)
+	if err != nil {
+		log.Fatalf("boostrap: parse labels failed: errors:\n%+v", err)
+	}
 	cfg.Namespace = fmt.Sprintf("/%s", *namespace)
 	cfg.Option.LimitBytesBody = *limitBytesBodyMB * 1024 * 1024
 	cfg.Option.LimitBytesCaching = *limitBytesCachingMB * 1024 * 1024
+	cfg.Option.LimitCountAnalysisBuffer = *limitCountAnalysisBuffer
 	cfg.Option.LimitBufferRead = *limitBufferRead
 	cfg.Option.LimitBufferWrite = *limitBufferWrite
 	cfg.Option.LimitCountConn = *limitCountConn
@@ -154,8 +210,40 @@ func getCfg() *proxy.Cfg {
 	cfg.Option.LimitTimeoutRead = time.Second * time.Duration(*limitTimeoutReadSec)
 	cfg.Option.LimitTimeoutWrite = time.Second * time.Duration(*limitTimeoutWriteSec)
 	cfg.Option.LimitIntervalHeathCheck = time.Second * time.Duration(*limitIntervalHeathCheckSec)
+	cfg.Option.LimitCountHeavyWorker = *limitCountHeavyWorker
+	cfg.Option.LimitCountWebSocketConn = *limitCountWebSocketConn
+	cfg.Option.LimitDurationWebSocketIdle = time.Second * time.Duration(*limitDurationWebSocketIdleSec)
+	cfg.Option.LimitTimeoutWebSocketDrain = time.Second * time.Duration(*limitTimeoutWebSocketDrainSec)
+	cfg.Option.LimitCountConnPerIP = *limitCountConnPerIP
+	cfg.Option.LimitRateConnPerIP = *limitRateConnPerIP
+	cfg.Option.LimitRateRequestPerIP = *limitRateRequestPerIP
+	cfg.Option.LimitDurationIPBan = time.Second * time.Duration(*limitDurationIPBanSec)
 	cfg.Option.JWTCfgFile = *jwtCfg
+	cfg.Option.TokenExchangeCfgFile = *tokenExchangeCfg
+	cfg.Option.OIDCCfgFile = *oidcCfg
+	cfg.Option.ErrorPagesFile = *errorPages
+	cfg.Option.SecurityHeadersFile = *securityHeaders
+	cfg.Option.StreamListenersFile = *streamListeners
+	cfg.Option.ResolverCfgFile = *resolverCfg
+	cfg.Option.DeadlineHeader = *deadlineHeader
+	cfg.Option.TraceHeader = *traceHeader
+	cfg.Option.TracingEndpoint = *tracingEndpoint
+	cfg.Option.TracingService = *tracingService
+	cfg.Option.TracingSampling = *tracingSampling
+	cfg.Option.DebugSecret = *debugSecret
+	cfg.Option.FederationSecret = *federationSecret
+	if *trustedProxies != "" {
+		for _, value := range strings.Split(*trustedProxies, ",") {
+			cfg.Option.TrustedProxies = append(cfg.Option.TrustedProxies, strings.TrimSpace(value))
+		}
+	}
+	cfg.Option.CachingRedisAddr = *cachingRedis
+	cfg.Option.OAuth2Secret = *oauth2Secret
+	cfg.Option.OAuth2TokenPath = *oauth2TokenPath
+	cfg.Option.OAuth2TokenTTL = time.Second * time.Duration(*oauth2TokenTTL)
+	cfg.Option.SpillDir = *spillDir
 	cfg.Option.EnableWebSocket = *enableWebSocket
+	cfg.Option.EnableExecHeathCheck = *enableExecHeathCheck
 
 	specs := defaultFilters
 	if len(*filters) > 0 {
@@ -27,7 +27,6 @@ require (
 	github.com/jonboulle/clockwork v0.1.0 // indirect
 	github.com/klauspost/compress v0.0.0-20180708153741-b939724e787a // indirect
 	github.com/klauspost/cpuid v0.0.0-20170728055534-ae7887de9fa5 // indirect
-	github.com/koding/websocketproxy v0.0.0-20180716164433-0fa3f994f6e7
 	github.com/labstack/echo v0.0.0-20180412143600-6d227dfea4d2
 	github.com/labstack/gommon v0.0.0-20180613044413-d6898124de91 // indirect
 	github.com/mattn/go-colorable v0.0.0-20170801030607-167de6bfdfba // indirect
@@ -91,6 +91,310 @@ func (ab *APIBuilder) MatchDomain(domain string) *APIBuilder {
 	return ab
 }
 
+// AddAlias add an alias match rule, the request matches the alias is dispatched as this api
+func (ab *APIBuilder) AddAlias(urlPattern, method string) *APIBuilder {
+	ab.value.Aliases = append(ab.value.Aliases, &metapb.APIAlias{
+		URLPattern: urlPattern,
+		Method:     method,
+	})
+	return ab
+}
+
+// AddDomainAlias add an alias match domain
+func (ab *APIBuilder) AddDomainAlias(domain string) *APIBuilder {
+	ab.value.Aliases = append(ab.value.Aliases, &metapb.APIAlias{
+		Domain: domain,
+	})
+	return ab
+}
+
+// NoAliases remove all aliases
+func (ab *APIBuilder) NoAliases() *APIBuilder {
+	ab.value.Aliases = nil
+	return ab
+}
+
+// GraphQL serve the graphql requests by the resolvers, 0 means no limit
+func (ab *APIBuilder) GraphQL(maxDepth, maxComplexity int32) *APIBuilder {
+	if ab.value.GraphQL == nil {
+		ab.value.GraphQL = &metapb.GraphQLOptions{}
+	}
+
+	ab.value.GraphQL.MaxDepth = maxDepth
+	ab.value.GraphQL.MaxComplexity = maxComplexity
+	return ab
+}
+
+// AddGraphQLResolver add a graphql resolver for the root field
+func (ab *APIBuilder) AddGraphQLResolver(resolver metapb.GraphQLResolver) *APIBuilder {
+	if ab.value.GraphQL == nil {
+		ab.value.GraphQL = &metapb.GraphQLOptions{}
+	}
+
+	ab.value.GraphQL.Resolvers = append(ab.value.GraphQL.Resolvers, &resolver)
+	return ab
+}
+
+// NoGraphQL disable graphql
+func (ab *APIBuilder) NoGraphQL() *APIBuilder {
+	ab.value.GraphQL = nil
+	return ab
+}
+
+// AccessPolicy set the access policy
+func (ab *APIBuilder) AccessPolicy(policy metapb.AccessPolicy) *APIBuilder {
+	ab.value.AccessPolicy = &policy
+	return ab
+}
+
+// NoAccessPolicy remove the access policy
+func (ab *APIBuilder) NoAccessPolicy() *APIBuilder {
+	ab.value.AccessPolicy = nil
+	return ab
+}
+
+// UpstreamHost set the host header that sent to the servers, the value is used by FixedHost
+func (ab *APIBuilder) UpstreamHost(hostType metapb.HostType, value string) *APIBuilder {
+	ab.value.UpstreamHost = &metapb.UpstreamHost{
+		Type:  hostType,
+		Value: value,
+	}
+	return ab
+}
+
+// NoUpstreamHost remove the upstream host, use the cluster option
+func (ab *APIBuilder) NoUpstreamHost() *APIBuilder {
+	ab.value.UpstreamHost = nil
+	return ab
+}
+
+// Portal publish the api in the developer portal, the api requires the api key of the consumers if keyRequired
+func (ab *APIBuilder) Portal(description, doc string, keyRequired bool) *APIBuilder {
+	ab.value.Portal = &metapb.PortalOptions{
+		Published:   true,
+		Description: description,
+		Doc:         doc,
+		KeyRequired: keyRequired,
+	}
+	return ab
+}
+
+// NoPortal remove the api from the developer portal
+func (ab *APIBuilder) NoPortal() *APIBuilder {
+	ab.value.Portal = nil
+	return ab
+}
+
+// PathRewrite set the path rewrite of the requests to the nodes that have no url rewrite, the pattern
+// is a regexp and the replacement can reference the capture groups
+func (ab *APIBuilder) PathRewrite(stripPrefix, pattern, replacement, addPrefix string) *APIBuilder {
+	ab.value.PathRewrite = &metapb.PathRewrite{
+		StripPrefix: stripPrefix,
+		Pattern:     pattern,
+		Replacement: replacement,
+		AddPrefix:   addPrefix,
+	}
+	return ab
+}
+
+// Visibility set the visibility of the api, the internal api is only reachable from the internal listener
+// of the proxies
+func (ab *APIBuilder) Visibility(value metapb.Visibility) *APIBuilder {
+	ab.value.Visibility = value
+	return ab
+}
+
+// Draft mark the api as draft, the api is only reachable by the requests that have the secret in the
+// header or from the ips, the header is X-Gateway-Preview if empty
+func (ab *APIBuilder) Draft(header, secret string, ips ...string) *APIBuilder {
+	ab.value.PublishState = metapb.Draft
+	ab.value.Preview = &metapb.PreviewOptions{
+		Header: header,
+		Secret: secret,
+		IPs:    ips,
+	}
+	return ab
+}
+
+// Publish publish the draft api to all requests
+func (ab *APIBuilder) Publish() *APIBuilder {
+	ab.value.PublishState = metapb.Published
+	ab.value.Preview = nil
+	return ab
+}
+
+// Deprecate deprecate the published api, the deprecated api is still reachable with the deprecation headers
+func (ab *APIBuilder) Deprecate() *APIBuilder {
+	ab.value.PublishState = metapb.Deprecated
+	return ab
+}
+
+// RequestHeaderLimits set the max count and the max bytes of the request headers, 0 means no limit
+func (ab *APIBuilder) RequestHeaderLimits(maxHeaders, maxBytes int32) *APIBuilder {
+	if ab.value.HeaderLimits == nil {
+		ab.value.HeaderLimits = &metapb.HeaderLimits{}
+	}
+
+	ab.value.HeaderLimits.MaxRequestHeaders = maxHeaders
+	ab.value.HeaderLimits.MaxRequestHeaderBytes = maxBytes
+	return ab
+}
+
+// ResponseHeaderLimits set the max count and the max bytes of the upstream response headers, 0 means no limit
+func (ab *APIBuilder) ResponseHeaderLimits(maxHeaders, maxBytes int32) *APIBuilder {
+	if ab.value.HeaderLimits == nil {
+		ab.value.HeaderLimits = &metapb.HeaderLimits{}
+	}
+
+	ab.value.HeaderLimits.MaxResponseHeaders = maxHeaders
+	ab.value.HeaderLimits.MaxResponseHeaderBytes = maxBytes
+	return ab
+}
+
+// RequestBody set the max bytes of the request body, and the max bytes that buffered in memory,
+// the larger body is spilled to disk if spill is true, otherwise rejected. 0 means no limit
+func (ab *APIBuilder) RequestBody(maxBytes, maxBufferBytes int64, spill bool) *APIBuilder {
+	if ab.value.RequestBody == nil {
+		ab.value.RequestBody = &metapb.RequestBodyPolicy{}
+	}
+
+	ab.value.RequestBody.MaxBytes = maxBytes
+	ab.value.RequestBody.MaxBufferBytes = maxBufferBytes
+	ab.value.RequestBody.SpillToDisk = spill
+	return ab
+}
+
+// MaxPartBytes set the max bytes of a part of the multipart/form-data request body, 0 means no limit
+func (ab *APIBuilder) MaxPartBytes(max int64) *APIBuilder {
+	if ab.value.RequestBody == nil {
+		ab.value.RequestBody = &metapb.RequestBodyPolicy{}
+	}
+
+	ab.value.RequestBody.MaxPartBytes = max
+	return ab
+}
+
+// OIDC set the audience and the scopes of the access tokens that required by the OIDC auth filter,
+// empty audience means the audience of the filter
+func (ab *APIBuilder) OIDC(audience string, scopes ...string) *APIBuilder {
+	ab.value.AuthFilter = "OIDC"
+	ab.value.OIDC = &metapb.OIDCPolicy{
+		Audience: audience,
+		Scopes:   scopes,
+	}
+	return ab
+}
+
+// ResponseBody set the max bytes of the upstream response body, the larger response is truncated to the
+// max bytes and has the header if truncate is true, otherwise rejected with 502. 0 means no limit
+func (ab *APIBuilder) ResponseBody(maxBytes int64, truncate bool, header string) *APIBuilder {
+	ab.value.ResponseBody = &metapb.ResponseBodyPolicy{
+		MaxBytes: maxBytes,
+		Truncate: truncate,
+		Header:   header,
+	}
+	return ab
+}
+
+// ContentTypes set the media types allowlist of the request content-type and the accept header,
+// empty means no limit
+func (ab *APIBuilder) ContentTypes(consumes, produces []string) *APIBuilder {
+	ab.value.ContentTypes = &metapb.ContentTypes{
+		Consumes: consumes,
+		Produces: produces,
+	}
+	return ab
+}
+
+// DegradedAttr set the attr of the merged result that lists the attrs of the degraded nodes
+func (ab *APIBuilder) DegradedAttr(attr string) *APIBuilder {
+	ab.value.DegradedAttr = attr
+	return ab
+}
+
+// Constant set a custom constant of the api that used by the templates
+func (ab *APIBuilder) Constant(name, value string) *APIBuilder {
+	for idx := range ab.value.Constants {
+		if ab.value.Constants[idx].Name == name {
+			ab.value.Constants[idx].Value = value
+			return ab
+		}
+	}
+
+	ab.value.Constants = append(ab.value.Constants, metapb.PairValue{Name: name, Value: value})
+	return ab
+}
+
+// AccessLog set the access log format, fields and sampling(1-100) of the api, 0 sampling means using the
+// proxy sampling
+func (ab *APIBuilder) AccessLog(format metapb.AccessLogFormat, sampling int32, fields ...string) *APIBuilder {
+	ab.value.AccessLog = &metapb.AccessLog{
+		Format:   format,
+		Sampling: sampling,
+		Fields:   fields,
+	}
+	return ab
+}
+
+// DisableAccessLog disable the access log of the api
+func (ab *APIBuilder) DisableAccessLog() *APIBuilder {
+	ab.value.AccessLog = &metapb.AccessLog{
+		Disabled: true,
+	}
+	return ab
+}
+
+// AddProxyGroup add a proxy group that serves the api, the group is the proxies that have all the labels
+func (ab *APIBuilder) AddProxyGroup(labels ...metapb.PairValue) *APIBuilder {
+	ab.value.ProxyGroups = append(ab.value.ProxyGroups, metapb.ProxyGroup{Labels: labels})
+	return ab
+}
+
+// SecurityHeaders set the security headers policy of the api, the set fields override the proxy policy
+func (ab *APIBuilder) SecurityHeaders(value *metapb.SecurityHeaders) *APIBuilder {
+	ab.value.SecurityHeaders = value
+	return ab
+}
+
+// HeaderTransform set the header rules of the requests that sent to the backends and the responses that
+// returned to the clients
+func (ab *APIBuilder) HeaderTransform(value *metapb.HeaderTransform) *APIBuilder {
+	ab.value.HeaderTransform = value
+	return ab
+}
+
+// DisableSecurityHeaders disable the security headers policy of the proxy for the api
+func (ab *APIBuilder) DisableSecurityHeaders() *APIBuilder {
+	ab.value.SecurityHeaders = &metapb.SecurityHeaders{
+		Disabled: true,
+	}
+	return ab
+}
+
+// Debug add the routing debug headers to the responses of the api
+func (ab *APIBuilder) Debug(enable bool) *APIBuilder {
+	ab.value.Debug = enable
+	return ab
+}
+
+// Mirror copy the percent of the requests to the shadow cluster, 0 means all requests
+func (ab *APIBuilder) Mirror(cluster uint64, rate int32) *APIBuilder {
+	ab.value.Mirror = &metapb.Mirror{
+		ClusterID: cluster,
+		Rate:      rate,
+	}
+	return ab
+}
+
+// Timeouts set the read and the write timeouts of all nodes, and the overall timeout of the requests,
+// the timeouts of the nodes override the api
+func (ab *APIBuilder) Timeouts(readTimeout, writeTimeout, timeout int64) *APIBuilder {
+	ab.value.ReadTimeout = readTimeout
+	ab.value.WriteTimeout = writeTimeout
+	ab.value.Timeout = timeout
+	return ab
+}
+
 // UP up this api
 func (ab *APIBuilder) UP() *APIBuilder {
 	ab.value.Status = metapb.Up
@@ -295,6 +599,51 @@ func (ab *APIBuilder) DispatchNodeRetryStrategyWithIndex(cluster uint64, idx int
 	return ab
 }
 
+// DispatchNodeAffinity pin the requests that have the same value of the parameter to the same server
+func (ab *APIBuilder) DispatchNodeAffinity(cluster uint64, param metapb.Parameter) *APIBuilder {
+	return ab.DispatchNodeAffinityWithIndex(cluster, 0, param)
+}
+
+// DispatchNodeAffinityWithIndex pin the requests that have the same value of the parameter to the same server
+func (ab *APIBuilder) DispatchNodeAffinityWithIndex(cluster uint64, idx int, param metapb.Parameter) *APIBuilder {
+	node := ab.getNode(cluster, idx)
+	if nil == node {
+		ab.value.Nodes = append(ab.value.Nodes, &metapb.DispatchNode{
+			ClusterID: cluster,
+			Affinity:  &param,
+		})
+	} else {
+		node.Affinity = &param
+	}
+
+	return ab
+}
+
+// DispatchNodeGRPCTranscoding transcode the http/json requests to the grpc method of the proto descriptor
+func (ab *APIBuilder) DispatchNodeGRPCTranscoding(cluster uint64, descriptor uint64, method string) *APIBuilder {
+	return ab.DispatchNodeGRPCTranscodingWithIndex(cluster, 0, descriptor, method)
+}
+
+// DispatchNodeGRPCTranscodingWithIndex transcode the http/json requests to the grpc method of the proto descriptor
+func (ab *APIBuilder) DispatchNodeGRPCTranscodingWithIndex(cluster uint64, idx int, descriptor uint64, method string) *APIBuilder {
+	value := &metapb.GRPCTranscoding{
+		DescriptorID: descriptor,
+		Method:       method,
+	}
+
+	node := ab.getNode(cluster, idx)
+	if nil == node {
+		ab.value.Nodes = append(ab.value.Nodes, &metapb.DispatchNode{
+			ClusterID:       cluster,
+			GRPCTranscoding: value,
+		})
+	} else {
+		node.GRPCTranscoding = value
+	}
+
+	return ab
+}
+
 // DispatchNodeBatchIndex add a dispatch node batch index
 func (ab *APIBuilder) DispatchNodeBatchIndex(cluster uint64, batchIndex int) *APIBuilder {
 	return ab.DispatchNodeBatchIndexWithIndex(cluster, 0, batchIndex)
@@ -602,6 +951,18 @@ func (ab *APIBuilder) NoRenderTemplate() *APIBuilder {
 	return ab
 }
 
+// RenderBody render the result of the nodes by the body template instead of the render objects,
+// empty content type means json
+func (ab *APIBuilder) RenderBody(body, contentType string) *APIBuilder {
+	if ab.value.RenderTemplate == nil {
+		ab.value.RenderTemplate = &metapb.RenderTemplate{}
+	}
+
+	ab.value.RenderTemplate.Body = body
+	ab.value.RenderTemplate.ContentType = contentType
+	return ab
+}
+
 // AddFlatRenderObject add the render object to the top level object
 func (ab *APIBuilder) AddFlatRenderObject(namesAndExtractExps ...string) *APIBuilder {
 	return ab.addRenderObject("", true, namesAndExtractExps...)
@@ -1,6 +1,8 @@
 package client
 
 import (
+	"time"
+
 	"github.com/fagongzi/gateway/pkg/pb"
 	"github.com/fagongzi/gateway/pkg/pb/metapb"
 	"github.com/fagongzi/gateway/pkg/pb/rpcpb"
@@ -38,6 +40,94 @@ func (cb *ClusterBuilder) Loadbalance(lb metapb.LoadBalance) *ClusterBuilder {
 	return cb
 }
 
+// UpstreamHost set the host header that sent to the servers, the value is used by FixedHost
+func (cb *ClusterBuilder) UpstreamHost(hostType metapb.HostType, value string) *ClusterBuilder {
+	cb.value.UpstreamHost = &metapb.UpstreamHost{
+		Type:  hostType,
+		Value: value,
+	}
+	return cb
+}
+
+// DNS use the A/AAAA records of the host as the servers of the cluster
+func (cb *ClusterBuilder) DNS(host string, port int, protocol metapb.Protocol, maxQPS int64) *ClusterBuilder {
+	cb.value.DNS = &metapb.DNSTarget{
+		Host:     host,
+		Port:     int32(port),
+		Protocol: protocol,
+		MaxQPS:   maxQPS,
+	}
+	return cb
+}
+
+// DNSHeathCheck set the heath check of the servers that resolved from the dns host
+func (cb *ClusterBuilder) DNSHeathCheck(check *metapb.HeathCheck) *ClusterBuilder {
+	if cb.value.DNS != nil {
+		cb.value.DNS.HeathCheck = check
+	}
+	return cb
+}
+
+// MinActive set the min active servers, the standby servers are promoted if the up active servers
+// less than it
+func (cb *ClusterBuilder) MinActive(value int32) *ClusterBuilder {
+	cb.value.MinActive = value
+	return cb
+}
+
+// RemoteGateway use the servers of the cluster as the gateways of another gateway cluster, the consumer
+// of the requests are signed by the secret
+func (cb *ClusterBuilder) RemoteGateway(secret string, maxHops int32) *ClusterBuilder {
+	cb.value.RemoteGateway = &metapb.RemoteGateway{
+		Secret:  secret,
+		MaxHops: maxHops,
+	}
+	return cb
+}
+
+// Policy set the default policies of the apis that forward to the cluster
+func (cb *ClusterBuilder) Policy(value *metapb.Policy) *ClusterBuilder {
+	cb.value.Policy = value
+	return cb
+}
+
+// CircuitBreaker set the circuit breaker of the servers in the cluster that have no circuit breaker
+func (cb *ClusterBuilder) CircuitBreaker(value *metapb.CircuitBreaker) *ClusterBuilder {
+	cb.value.CircuitBreaker = value
+	return cb
+}
+
+// ConnRecycle close the keep-alive connections to the servers after the max lifetime or the max requests,
+// 0 means no limit
+func (cb *ClusterBuilder) ConnRecycle(maxLifetime time.Duration, maxRequests int64) *ClusterBuilder {
+	cb.value.ConnRecycle = &metapb.ConnRecycle{
+		MaxLifetime: int64(maxLifetime),
+		MaxRequests: maxRequests,
+	}
+	return cb
+}
+
+// AddTag add tag for cluster
+func (cb *ClusterBuilder) AddTag(key, value string) *ClusterBuilder {
+	cb.value.Tags = append(cb.value.Tags, &metapb.PairValue{
+		Name:  key,
+		Value: value,
+	})
+	return cb
+}
+
+// RemoveTag remove tag for cluster
+func (cb *ClusterBuilder) RemoveTag(key string) *ClusterBuilder {
+	var newTags []*metapb.PairValue
+	for _, tag := range cb.value.Tags {
+		if tag.Name != key {
+			newTags = append(newTags, tag)
+		}
+	}
+	cb.value.Tags = newTags
+	return cb
+}
+
 // Commit commit
 func (cb *ClusterBuilder) Commit() (uint64, error) {
 	err := pb.ValidateCluster(&cb.value)
@@ -78,6 +78,27 @@ func (rb *RoutingBuilder) API(api uint64) *RoutingBuilder {
 	return rb
 }
 
+// AddTag add tag for routing
+func (rb *RoutingBuilder) AddTag(key, value string) *RoutingBuilder {
+	rb.value.Tags = append(rb.value.Tags, &metapb.PairValue{
+		Name:  key,
+		Value: value,
+	})
+	return rb
+}
+
+// RemoveTag remove tag for routing
+func (rb *RoutingBuilder) RemoveTag(key string) *RoutingBuilder {
+	var newTags []*metapb.PairValue
+	for _, tag := range rb.value.Tags {
+		if tag.Name != key {
+			newTags = append(newTags, tag)
+		}
+	}
+	rb.value.Tags = newTags
+	return rb
+}
+
 // Commit commit
 func (rb *RoutingBuilder) Commit() (uint64, error) {
 	err := pb.ValidateRouting(&rb.value)
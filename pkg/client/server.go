@@ -41,8 +41,11 @@ func (sb *ServerBuilder) CheckHTTPCode(path string, interval time.Duration, time
 
 	}
 
+	sb.value.HeathCheck.Type = metapb.HTTPCheck
 	sb.value.HeathCheck.Path = path
 	sb.value.HeathCheck.Body = ""
+	sb.value.HeathCheck.Command = nil
+	sb.value.HeathCheck.Checker = ""
 	sb.value.HeathCheck.CheckInterval = int64(interval)
 	sb.value.HeathCheck.Timeout = int64(timeout)
 	return sb
@@ -55,13 +58,76 @@ func (sb *ServerBuilder) CheckHTTPBody(path, body string, interval time.Duration
 
 	}
 
+	sb.value.HeathCheck.Type = metapb.HTTPCheck
 	sb.value.HeathCheck.Path = path
 	sb.value.HeathCheck.Body = body
+	sb.value.HeathCheck.Command = nil
+	sb.value.HeathCheck.Checker = ""
 	sb.value.HeathCheck.CheckInterval = int64(interval)
 	sb.value.HeathCheck.Timeout = int64(timeout)
 	return sb
 }
 
+// CheckTCP use a tcp heath check, the server is up if it can be connected
+func (sb *ServerBuilder) CheckTCP(interval time.Duration, timeout time.Duration) *ServerBuilder {
+	if sb.value.HeathCheck == nil {
+		sb.value.HeathCheck = &metapb.HeathCheck{}
+	}
+
+	sb.value.HeathCheck.Type = metapb.TCPCheck
+	sb.value.HeathCheck.Path = ""
+	sb.value.HeathCheck.Body = ""
+	sb.value.HeathCheck.Command = nil
+	sb.value.HeathCheck.Checker = ""
+	sb.value.HeathCheck.CheckInterval = int64(interval)
+	sb.value.HeathCheck.Timeout = int64(timeout)
+	return sb
+}
+
+// CheckExec use a heath check that runs the command on the proxies, the server is up if the command
+// exits with 0, the proxies must enable the exec heath checks
+func (sb *ServerBuilder) CheckExec(command []string, interval time.Duration, timeout time.Duration) *ServerBuilder {
+	if sb.value.HeathCheck == nil {
+		sb.value.HeathCheck = &metapb.HeathCheck{}
+	}
+
+	sb.value.HeathCheck.Type = metapb.ExecCheck
+	sb.value.HeathCheck.Path = ""
+	sb.value.HeathCheck.Body = ""
+	sb.value.HeathCheck.Command = command
+	sb.value.HeathCheck.Checker = ""
+	sb.value.HeathCheck.CheckInterval = int64(interval)
+	sb.value.HeathCheck.Timeout = int64(timeout)
+	return sb
+}
+
+// CheckByChecker use a heath check that asks the checker service, the checker is the url of the service
+func (sb *ServerBuilder) CheckByChecker(checker string, interval time.Duration, timeout time.Duration) *ServerBuilder {
+	if sb.value.HeathCheck == nil {
+		sb.value.HeathCheck = &metapb.HeathCheck{}
+	}
+
+	sb.value.HeathCheck.Type = metapb.CheckerCheck
+	sb.value.HeathCheck.Path = ""
+	sb.value.HeathCheck.Body = ""
+	sb.value.HeathCheck.Command = nil
+	sb.value.HeathCheck.Checker = checker
+	sb.value.HeathCheck.CheckInterval = int64(interval)
+	sb.value.HeathCheck.Timeout = int64(timeout)
+	return sb
+}
+
+// CheckThreshold set the continuous succeed checks to up and the continuous failed checks to down,
+// the heath check must be set first
+func (sb *ServerBuilder) CheckThreshold(healthy, unhealthy int32) *ServerBuilder {
+	if sb.value.HeathCheck != nil {
+		sb.value.HeathCheck.HealthyThreshold = healthy
+		sb.value.HeathCheck.UnhealthyThreshold = unhealthy
+	}
+
+	return sb
+}
+
 // Addr set addr
 func (sb *ServerBuilder) Addr(addr string) *ServerBuilder {
 	sb.value.Addr = addr
@@ -136,6 +202,63 @@ func (sb *ServerBuilder) CircuitBreakerHalfToOpenCondition(succeedRate int) *Ser
 	return sb
 }
 
+// CircuitBreakerContinuousFailuresToClose set circuit breaker condition of open or half convert to close
+// by the continuous failures
+func (sb *ServerBuilder) CircuitBreakerContinuousFailuresToClose(failures int) *ServerBuilder {
+	if sb.value.CircuitBreaker == nil {
+		sb.value.CircuitBreaker = &metapb.CircuitBreaker{}
+	}
+
+	sb.value.CircuitBreaker.ContinuousFailuresToClose = int32(failures)
+	return sb
+}
+
+// AddTag add tag for server
+func (sb *ServerBuilder) AddTag(key, value string) *ServerBuilder {
+	sb.value.Tags = append(sb.value.Tags, &metapb.PairValue{
+		Name:  key,
+		Value: value,
+	})
+	return sb
+}
+
+// RemoveTag remove tag for server
+func (sb *ServerBuilder) RemoveTag(key string) *ServerBuilder {
+	var newTags []*metapb.PairValue
+	for _, tag := range sb.value.Tags {
+		if tag.Name != key {
+			newTags = append(newTags, tag)
+		}
+	}
+	sb.value.Tags = newTags
+	return sb
+}
+
+// Drain drain the server, the server is removed from the load balance of the clusters
+func (sb *ServerBuilder) Drain() *ServerBuilder {
+	sb.value.Drained = true
+	return sb
+}
+
+// Undrain undrain the server
+func (sb *ServerBuilder) Undrain() *ServerBuilder {
+	sb.value.Drained = false
+	return sb
+}
+
+// Standby set the server as a standby server, or an active server if value is false
+func (sb *ServerBuilder) Standby(value bool) *ServerBuilder {
+	sb.value.Standby = value
+	return sb
+}
+
+// Weight change the load balance weight of the server to the target, the weight ramps from the
+// current weight in rampSeconds
+func (sb *ServerBuilder) Weight(target int32, rampSeconds int64) *ServerBuilder {
+	sb.value.Weight = pb.RampServerWeight(sb.value.Weight, target, rampSeconds, time.Now().Unix())
+	return sb
+}
+
 // Commit commit
 func (sb *ServerBuilder) Commit() (uint64, error) {
 	err := pb.ValidateServer(&sb.value)
@@ -1,6 +1,8 @@
 package filter
 
 import (
+	"io"
+	"net/textproto"
 	"time"
 
 	"github.com/fagongzi/gateway/pkg/pb/metapb"
@@ -60,6 +62,22 @@ type Filter interface {
 	PostErr(c Context)
 }
 
+// Part is a part of the multipart/form-data request body
+type Part struct {
+	FormName string
+	FileName string
+	Header   textproto.MIMEHeader
+}
+
+// PartFilter is the filter that inspects the parts of the multipart/form-data request bodies,
+// e.g. virus scan. Part is called for each part in order after all the pre filters, r streams
+// the content of the part, so the large uploads are inspected without buffering
+type PartFilter interface {
+	Filter
+
+	Part(c Context, part *Part, r io.Reader) (statusCode int, err error)
+}
+
 // BaseFilter base filter support default implemention
 type BaseFilter struct{}
 
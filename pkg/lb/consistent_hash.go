@@ -0,0 +1,39 @@
+package lb
+
+import (
+	"container/list"
+	"hash/fnv"
+)
+
+// SelectByKey select a server from servers using the rendezvous(highest random weight) hash of the
+// key, the same key selects the same server as long as the server is in the servers, and only the
+// keys of the removed server move to the other servers
+func SelectByKey(key string, servers *list.List) int {
+	h := fnv.New64a()
+	h.Write([]byte(key))
+	sum := h.Sum64()
+
+	index := -1
+	var max uint64
+	i := 0
+	for iter := servers.Front(); iter != nil; iter = iter.Next() {
+		id, _ := iter.Value.(uint64)
+		if score := mix(sum ^ id); index < 0 || score > max {
+			index = i
+			max = score
+		}
+		i++
+	}
+
+	return index
+}
+
+// mix is the finalizer of the splitmix64
+func mix(x uint64) uint64 {
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+	return x
+}
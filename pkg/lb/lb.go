@@ -8,16 +8,22 @@ import (
 )
 
 var (
-	supportLbs = []metapb.LoadBalance{metapb.RoundRobin}
+	supportLbs = []metapb.LoadBalance{metapb.RoundRobin, metapb.WeightRobin}
 )
 
 var (
 	// LBS map loadBalance name and process function
-	LBS = map[metapb.LoadBalance]func() LoadBalance{
-		metapb.RoundRobin: NewRoundRobin,
+	LBS = map[metapb.LoadBalance]func(WeightFunc) LoadBalance{
+		metapb.RoundRobin: func(weight WeightFunc) LoadBalance {
+			return NewRoundRobin()
+		},
+		metapb.WeightRobin: NewWeightRobin,
 	}
 )
 
+// WeightFunc returns the weight of the server, the server with 0 weight will not be selected
+type WeightFunc func(id uint64) int
+
 // LoadBalance loadBalance interface
 type LoadBalance interface {
 	Select(req *fasthttp.Request, servers *list.List) int
@@ -29,6 +35,6 @@ func GetSupportLBS() []metapb.LoadBalance {
 }
 
 // NewLoadBalance create a LoadBalance
-func NewLoadBalance(name metapb.LoadBalance) LoadBalance {
-	return LBS[name]()
+func NewLoadBalance(name metapb.LoadBalance, weight WeightFunc) LoadBalance {
+	return LBS[name](weight)
 }
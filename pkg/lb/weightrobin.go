@@ -0,0 +1,50 @@
+package lb
+
+import (
+	"container/list"
+	"math/rand"
+
+	"github.com/valyala/fasthttp"
+)
+
+// WeightRobin weight random loadBalance impl, the server is selected by the weight
+type WeightRobin struct {
+	weight WeightFunc
+}
+
+// NewWeightRobin create a WeightRobin
+func NewWeightRobin(weight WeightFunc) LoadBalance {
+	return WeightRobin{
+		weight: weight,
+	}
+}
+
+// Select select a server from servers using the weight of the servers
+func (w WeightRobin) Select(req *fasthttp.Request, servers *list.List) int {
+	l := servers.Len()
+	if 0 >= l {
+		return -1
+	}
+
+	total := 0
+	for iter := servers.Front(); iter != nil; iter = iter.Next() {
+		total += w.weight(iter.Value.(uint64))
+	}
+
+	// all servers has 0 weight, fallback to random
+	if total <= 0 {
+		return rand.Intn(l)
+	}
+
+	value := rand.Intn(total)
+	index := 0
+	for iter := servers.Front(); iter != nil; iter = iter.Next() {
+		value -= w.weight(iter.Value.(uint64))
+		if value < 0 {
+			return index
+		}
+		index++
+	}
+
+	return l - 1
+}
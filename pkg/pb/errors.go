@@ -0,0 +1,47 @@
+package pb
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ValidationError is the error of the invalid meta, field is the json path of the invalid field,
+// e.g. nodes[0].cache.deadline
+type ValidationError struct {
+	Field   string
+	Message string
+}
+
+func (e *ValidationError) Error() string {
+	return e.Message
+}
+
+func fieldError(field, format string, args ...interface{}) error {
+	return &ValidationError{
+		Field:   field,
+		Message: fmt.Sprintf(format, args...),
+	}
+}
+
+// withField returns the validation error of the field, the field of the nested validation error is
+// relative to the field
+func withField(field string, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	value, ok := err.(*ValidationError)
+	if !ok {
+		return &ValidationError{Field: field, Message: err.Error()}
+	}
+
+	if value.Field != "" {
+		if strings.HasPrefix(value.Field, "[") {
+			field = field + value.Field
+		} else {
+			field = field + "." + value.Field
+		}
+	}
+
+	return &ValidationError{Field: field, Message: value.Message}
+}
@@ -11,28 +11,91 @@
 	It has these top-level messages:
 		Proxy
 		Cluster
+		ConnRecycle
+		Policy
+		RemoteGateway
+		DNSTarget
+		HalfOpenProbe
+		OutboundAuth
+		UpstreamHost
 		HeathCheck
 		CircuitBreaker
 		Server
+		ServerWeight
 		Bind
 		PairValue
 		IPAccessControl
 		HTTPResult
+		ErrorPage
 		Parameter
 		ValidationRule
 		Validation
 		RetryStrategy
 		DispatchNode
+		GRPCTranscoding
 		Cache
 		RenderTemplate
 		RenderObject
 		RenderAttr
 		API
+		PathRewrite
+		HeaderTransform
+		HeaderRules
+		Mirror
+		Approval
+		ProxyGroup
+		AccessLog
+		SecurityHeaders
+		ContentTypes
+		RequestBodyPolicy
+		ResponseBodyPolicy
+		OIDCPolicy
+		HeaderLimits
+		PreviewOptions
+		PortalOptions
+		AccessPolicy
+		TimeWindow
+		AnomalyRule
+		GraphQLOptions
+		GraphQLResolver
+		ResponseSchema
+		RequestSchema
+		APIAlias
+		Deprecation
 		Condition
 		Routing
 		WebSocketOptions
 		System
 		CountMetric
+		ServerHealth
+		PhaseTimeout
+		PhaseLatency
+		FleetServerHealth
+		HealthTransition
+		StandbyEvent
+		ConfigPropagation
+		RouteTest
+		RouteTestResult
+		RouteTestNode
+		MetaDiff
+		MetaChange
+		FieldChange
+		Changeset
+		ChangeRecord
+		ConsistencyReport
+		ConfigFinding
+		ProxyOverride
+		KillSwitch
+		Consumer
+		APIKey
+		ConsumerUsage
+		LatencyHeatmap
+		HeatmapRow
+		RateLimit
+		RateLimitProfile
+		ProtoDescriptor
+		APIRateLimit
+		APITemplate
 */
 package metapb
 
@@ -43,6 +106,8 @@ import (
 
 	math "math"
 
+	encoding_binary "encoding/binary"
+
 	io "io"
 )
 
@@ -137,17 +202,20 @@ func (CircuitStatus) EnumDescriptor() ([]byte, []int) { return fileDescriptorMet
 type LoadBalance int32
 
 const (
-	RoundRobin LoadBalance = 0
-	IPHash     LoadBalance = 1
+	RoundRobin  LoadBalance = 0
+	IPHash      LoadBalance = 1
+	WeightRobin LoadBalance = 2
 )
 
 var LoadBalance_name = map[int32]string{
 	0: "RoundRobin",
 	1: "IPHash",
+	2: "WeightRobin",
 }
 var LoadBalance_value = map[string]int32{
-	"RoundRobin": 0,
-	"IPHash":     1,
+	"RoundRobin":  0,
+	"IPHash":      1,
+	"WeightRobin": 2,
 }
 
 func (x LoadBalance) Enum() *LoadBalance {
@@ -168,6 +236,242 @@ func (x *LoadBalance) UnmarshalJSON(data []byte) error {
 }
 func (LoadBalance) EnumDescriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{2} }
 
+// OutboundAuthType is the way to sign the requests to upstreams
+type OutboundAuthType int32
+
+const (
+	NoOutboundAuth OutboundAuthType = 0
+	BearerToken    OutboundAuthType = 1
+	HMACSignature  OutboundAuthType = 2
+	AWSSigV4       OutboundAuthType = 3
+)
+
+var OutboundAuthType_name = map[int32]string{
+	0: "NoOutboundAuth",
+	1: "BearerToken",
+	2: "HMACSignature",
+	3: "AWSSigV4",
+}
+var OutboundAuthType_value = map[string]int32{
+	"NoOutboundAuth": 0,
+	"BearerToken":    1,
+	"HMACSignature":  2,
+	"AWSSigV4":       3,
+}
+
+func (x OutboundAuthType) Enum() *OutboundAuthType {
+	p := new(OutboundAuthType)
+	*p = x
+	return p
+}
+func (x OutboundAuthType) String() string {
+	return proto.EnumName(OutboundAuthType_name, int32(x))
+}
+func (x *OutboundAuthType) UnmarshalJSON(data []byte) error {
+	value, err := proto.UnmarshalJSONEnum(OutboundAuthType_value, data, "OutboundAuthType")
+	if err != nil {
+		return err
+	}
+	*x = OutboundAuthType(value)
+	return nil
+}
+func (OutboundAuthType) EnumDescriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{3} }
+
+// HostType is the host header that sent to the upstreams
+type HostType int32
+
+const (
+	ServerAddrHost HostType = 0
+	ClientHost     HostType = 1
+	FixedHost      HostType = 2
+)
+
+var HostType_name = map[int32]string{
+	0: "ServerAddrHost",
+	1: "ClientHost",
+	2: "FixedHost",
+}
+var HostType_value = map[string]int32{
+	"ServerAddrHost": 0,
+	"ClientHost":     1,
+	"FixedHost":      2,
+}
+
+func (x HostType) Enum() *HostType {
+	p := new(HostType)
+	*p = x
+	return p
+}
+func (x HostType) String() string {
+	return proto.EnumName(HostType_name, int32(x))
+}
+func (x *HostType) UnmarshalJSON(data []byte) error {
+	value, err := proto.UnmarshalJSONEnum(HostType_value, data, "HostType")
+	if err != nil {
+		return err
+	}
+	*x = HostType(value)
+	return nil
+}
+func (HostType) EnumDescriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{4} }
+
+// PublishState is the publish state of the api, the draft and the in review apis are only reachable by the
+// preview requests, the deprecated api is still reachable with the deprecation headers
+type PublishState int32
+
+const (
+	Published  PublishState = 0
+	Draft      PublishState = 1
+	Review     PublishState = 2
+	Deprecated PublishState = 3
+)
+
+var PublishState_name = map[int32]string{
+	0: "Published",
+	1: "Draft",
+	2: "Review",
+	3: "Deprecated",
+}
+var PublishState_value = map[string]int32{
+	"Published":  0,
+	"Draft":      1,
+	"Review":     2,
+	"Deprecated": 3,
+}
+
+func (x PublishState) Enum() *PublishState {
+	p := new(PublishState)
+	*p = x
+	return p
+}
+func (x PublishState) String() string {
+	return proto.EnumName(PublishState_name, int32(x))
+}
+func (x *PublishState) UnmarshalJSON(data []byte) error {
+	value, err := proto.UnmarshalJSONEnum(PublishState_value, data, "PublishState")
+	if err != nil {
+		return err
+	}
+	*x = PublishState(value)
+	return nil
+}
+func (PublishState) EnumDescriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{5} }
+
+// Visibility is which listeners of the proxy the api is reachable from, the internal api is only
+// reachable from the internal listener
+type Visibility int32
+
+const (
+	Public   Visibility = 0
+	Internal Visibility = 1
+)
+
+var Visibility_name = map[int32]string{
+	0: "Public",
+	1: "Internal",
+}
+var Visibility_value = map[string]int32{
+	"Public":   0,
+	"Internal": 1,
+}
+
+func (x Visibility) Enum() *Visibility {
+	p := new(Visibility)
+	*p = x
+	return p
+}
+func (x Visibility) String() string {
+	return proto.EnumName(Visibility_name, int32(x))
+}
+func (x *Visibility) UnmarshalJSON(data []byte) error {
+	value, err := proto.UnmarshalJSONEnum(Visibility_value, data, "Visibility")
+	if err != nil {
+		return err
+	}
+	*x = Visibility(value)
+	return nil
+}
+func (Visibility) EnumDescriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{6} }
+
+// RateLimitKey is the client identity that the buckets of the rate limit are keyed by
+type RateLimitKey int32
+
+const (
+	LimitByAPI      RateLimitKey = 0
+	LimitByAPIKey   RateLimitKey = 1
+	LimitByIP       RateLimitKey = 2
+	LimitByHeader   RateLimitKey = 3
+	LimitByConsumer RateLimitKey = 4
+)
+
+var RateLimitKey_name = map[int32]string{
+	0: "LimitByAPI",
+	1: "LimitByAPIKey",
+	2: "LimitByIP",
+	3: "LimitByHeader",
+	4: "LimitByConsumer",
+}
+var RateLimitKey_value = map[string]int32{
+	"LimitByAPI":      0,
+	"LimitByAPIKey":   1,
+	"LimitByIP":       2,
+	"LimitByHeader":   3,
+	"LimitByConsumer": 4,
+}
+
+func (x RateLimitKey) Enum() *RateLimitKey {
+	p := new(RateLimitKey)
+	*p = x
+	return p
+}
+func (x RateLimitKey) String() string {
+	return proto.EnumName(RateLimitKey_name, int32(x))
+}
+func (x *RateLimitKey) UnmarshalJSON(data []byte) error {
+	value, err := proto.UnmarshalJSONEnum(RateLimitKey_value, data, "RateLimitKey")
+	if err != nil {
+		return err
+	}
+	*x = RateLimitKey(value)
+	return nil
+}
+func (RateLimitKey) EnumDescriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{7} }
+
+// ProbeStrategy is the way to select the probe requests in half-open circuit
+type ProbeStrategy int32
+
+const (
+	RealTraffic    ProbeStrategy = 0
+	SyntheticProbe ProbeStrategy = 1
+)
+
+var ProbeStrategy_name = map[int32]string{
+	0: "RealTraffic",
+	1: "SyntheticProbe",
+}
+var ProbeStrategy_value = map[string]int32{
+	"RealTraffic":    0,
+	"SyntheticProbe": 1,
+}
+
+func (x ProbeStrategy) Enum() *ProbeStrategy {
+	p := new(ProbeStrategy)
+	*p = x
+	return p
+}
+func (x ProbeStrategy) String() string {
+	return proto.EnumName(ProbeStrategy_name, int32(x))
+}
+func (x *ProbeStrategy) UnmarshalJSON(data []byte) error {
+	value, err := proto.UnmarshalJSONEnum(ProbeStrategy_value, data, "ProbeStrategy")
+	if err != nil {
+		return err
+	}
+	*x = ProbeStrategy(value)
+	return nil
+}
+func (ProbeStrategy) EnumDescriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{8} }
+
 // Protocol is the protocol of the backend api
 type Protocol int32
 
@@ -207,7 +511,7 @@ func (x *Protocol) UnmarshalJSON(data []byte) error {
 	*x = Protocol(value)
 	return nil
 }
-func (Protocol) EnumDescriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{3} }
+func (Protocol) EnumDescriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{9} }
 
 type Source int32
 
@@ -253,7 +557,7 @@ func (x *Source) UnmarshalJSON(data []byte) error {
 	*x = Source(value)
 	return nil
 }
-func (Source) EnumDescriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{4} }
+func (Source) EnumDescriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{10} }
 
 type RuleType int32
 
@@ -284,7 +588,7 @@ func (x *RuleType) UnmarshalJSON(data []byte) error {
 	*x = RuleType(value)
 	return nil
 }
-func (RuleType) EnumDescriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{5} }
+func (RuleType) EnumDescriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{11} }
 
 type CMP int32
 
@@ -333,7 +637,7 @@ func (x *CMP) UnmarshalJSON(data []byte) error {
 	*x = CMP(value)
 	return nil
 }
-func (CMP) EnumDescriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{6} }
+func (CMP) EnumDescriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{12} }
 
 type RoutingStrategy int32
 
@@ -367,7 +671,7 @@ func (x *RoutingStrategy) UnmarshalJSON(data []byte) error {
 	*x = RoutingStrategy(value)
 	return nil
 }
-func (RoutingStrategy) EnumDescriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{7} }
+func (RoutingStrategy) EnumDescriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{13} }
 
 type MatchRule int32
 
@@ -404,13 +708,279 @@ func (x *MatchRule) UnmarshalJSON(data []byte) error {
 	*x = MatchRule(value)
 	return nil
 }
-func (MatchRule) EnumDescriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{8} }
+func (MatchRule) EnumDescriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{14} }
+
+// ChangeType is the type of the meta change
+type ChangeType int32
+
+const (
+	MetaAdded    ChangeType = 0
+	MetaRemoved  ChangeType = 1
+	MetaModified ChangeType = 2
+)
+
+var ChangeType_name = map[int32]string{
+	0: "MetaAdded",
+	1: "MetaRemoved",
+	2: "MetaModified",
+}
+var ChangeType_value = map[string]int32{
+	"MetaAdded":    0,
+	"MetaRemoved":  1,
+	"MetaModified": 2,
+}
+
+func (x ChangeType) Enum() *ChangeType {
+	p := new(ChangeType)
+	*p = x
+	return p
+}
+func (x ChangeType) String() string {
+	return proto.EnumName(ChangeType_name, int32(x))
+}
+func (x *ChangeType) UnmarshalJSON(data []byte) error {
+	value, err := proto.UnmarshalJSONEnum(ChangeType_value, data, "ChangeType")
+	if err != nil {
+		return err
+	}
+	*x = ChangeType(value)
+	return nil
+}
+func (ChangeType) EnumDescriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{15} }
+
+// ChangesetState is the state of the changeset, the mutations are only recorded to the open changeset
+type ChangesetState int32
+
+const (
+	ChangesetOpen       ChangesetState = 0
+	ChangesetCommitted  ChangesetState = 1
+	ChangesetRolledBack ChangesetState = 2
+)
+
+var ChangesetState_name = map[int32]string{
+	0: "ChangesetOpen",
+	1: "ChangesetCommitted",
+	2: "ChangesetRolledBack",
+}
+var ChangesetState_value = map[string]int32{
+	"ChangesetOpen":       0,
+	"ChangesetCommitted":  1,
+	"ChangesetRolledBack": 2,
+}
+
+func (x ChangesetState) Enum() *ChangesetState {
+	p := new(ChangesetState)
+	*p = x
+	return p
+}
+func (x ChangesetState) String() string {
+	return proto.EnumName(ChangesetState_name, int32(x))
+}
+func (x *ChangesetState) UnmarshalJSON(data []byte) error {
+	value, err := proto.UnmarshalJSONEnum(ChangesetState_value, data, "ChangesetState")
+	if err != nil {
+		return err
+	}
+	*x = ChangesetState(value)
+	return nil
+}
+func (ChangesetState) EnumDescriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{16} }
+
+// HeathCheckType is the probe of the heath check, the tcp probe only checks the connection, the exec
+// probe runs a command on the proxy, the checker probe asks a checker service
+type HeathCheckType int32
+
+const (
+	HTTPCheck    HeathCheckType = 0
+	TCPCheck     HeathCheckType = 1
+	ExecCheck    HeathCheckType = 2
+	CheckerCheck HeathCheckType = 3
+)
+
+var HeathCheckType_name = map[int32]string{
+	0: "HTTPCheck",
+	1: "TCPCheck",
+	2: "ExecCheck",
+	3: "CheckerCheck",
+}
+var HeathCheckType_value = map[string]int32{
+	"HTTPCheck":    0,
+	"TCPCheck":     1,
+	"ExecCheck":    2,
+	"CheckerCheck": 3,
+}
+
+func (x HeathCheckType) Enum() *HeathCheckType {
+	p := new(HeathCheckType)
+	*p = x
+	return p
+}
+func (x HeathCheckType) String() string {
+	return proto.EnumName(HeathCheckType_name, int32(x))
+}
+func (x *HeathCheckType) UnmarshalJSON(data []byte) error {
+	value, err := proto.UnmarshalJSONEnum(HeathCheckType_value, data, "HeathCheckType")
+	if err != nil {
+		return err
+	}
+	*x = HeathCheckType(value)
+	return nil
+}
+func (HeathCheckType) EnumDescriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{17} }
+
+// HealthStatus is the backend server health status that a proxy seen,
+// the bigger value is the worse status
+type HealthStatus int32
+
+const (
+	Healthy   HealthStatus = 0
+	Draining  HealthStatus = 1
+	Unhealthy HealthStatus = 2
+)
+
+var HealthStatus_name = map[int32]string{
+	0: "Healthy",
+	1: "Draining",
+	2: "Unhealthy",
+}
+var HealthStatus_value = map[string]int32{
+	"Healthy":   0,
+	"Draining":  1,
+	"Unhealthy": 2,
+}
+
+func (x HealthStatus) Enum() *HealthStatus {
+	p := new(HealthStatus)
+	*p = x
+	return p
+}
+func (x HealthStatus) String() string {
+	return proto.EnumName(HealthStatus_name, int32(x))
+}
+func (x *HealthStatus) UnmarshalJSON(data []byte) error {
+	value, err := proto.UnmarshalJSONEnum(HealthStatus_value, data, "HealthStatus")
+	if err != nil {
+		return err
+	}
+	*x = HealthStatus(value)
+	return nil
+}
+func (HealthStatus) EnumDescriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{18} }
+
+// FindingType is the type of the config consistency finding
+type FindingType int32
+
+const (
+	DanglingReference FindingType = 0
+	EmptyCluster      FindingType = 1
+	RoutingOverflow   FindingType = 2
+)
+
+var FindingType_name = map[int32]string{
+	0: "DanglingReference",
+	1: "EmptyCluster",
+	2: "RoutingOverflow",
+}
+var FindingType_value = map[string]int32{
+	"DanglingReference": 0,
+	"EmptyCluster":      1,
+	"RoutingOverflow":   2,
+}
+
+func (x FindingType) Enum() *FindingType {
+	p := new(FindingType)
+	*p = x
+	return p
+}
+func (x FindingType) String() string {
+	return proto.EnumName(FindingType_name, int32(x))
+}
+func (x *FindingType) UnmarshalJSON(data []byte) error {
+	value, err := proto.UnmarshalJSONEnum(FindingType_value, data, "FindingType")
+	if err != nil {
+		return err
+	}
+	*x = FindingType(value)
+	return nil
+}
+func (FindingType) EnumDescriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{19} }
+
+// AccessLogFormat is the format of the access log
+type AccessLogFormat int32
+
+const (
+	TextLog AccessLogFormat = 0
+	JSONLog AccessLogFormat = 1
+)
+
+var AccessLogFormat_name = map[int32]string{
+	0: "TextLog",
+	1: "JSONLog",
+}
+var AccessLogFormat_value = map[string]int32{
+	"TextLog": 0,
+	"JSONLog": 1,
+}
+
+func (x AccessLogFormat) Enum() *AccessLogFormat {
+	p := new(AccessLogFormat)
+	*p = x
+	return p
+}
+func (x AccessLogFormat) String() string {
+	return proto.EnumName(AccessLogFormat_name, int32(x))
+}
+func (x *AccessLogFormat) UnmarshalJSON(data []byte) error {
+	value, err := proto.UnmarshalJSONEnum(AccessLogFormat_value, data, "AccessLogFormat")
+	if err != nil {
+		return err
+	}
+	*x = AccessLogFormat(value)
+	return nil
+}
+func (AccessLogFormat) EnumDescriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{20} }
+
+// GraphQLOperation graphql operation type
+type GraphQLOperation int32
+
+const (
+	GraphQLQuery    GraphQLOperation = 0
+	GraphQLMutation GraphQLOperation = 1
+)
+
+var GraphQLOperation_name = map[int32]string{
+	0: "GraphQLQuery",
+	1: "GraphQLMutation",
+}
+var GraphQLOperation_value = map[string]int32{
+	"GraphQLQuery":    0,
+	"GraphQLMutation": 1,
+}
+
+func (x GraphQLOperation) Enum() *GraphQLOperation {
+	p := new(GraphQLOperation)
+	*p = x
+	return p
+}
+func (x GraphQLOperation) String() string {
+	return proto.EnumName(GraphQLOperation_name, int32(x))
+}
+func (x *GraphQLOperation) UnmarshalJSON(data []byte) error {
+	value, err := proto.UnmarshalJSONEnum(GraphQLOperation_value, data, "GraphQLOperation")
+	if err != nil {
+		return err
+	}
+	*x = GraphQLOperation(value)
+	return nil
+}
+func (GraphQLOperation) EnumDescriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{21} }
 
 // Proxy is a meta data of the gateway proxy
 type Proxy struct {
-	Addr             string `protobuf:"bytes,1,opt,name=addr" json:"addr"`
-	AddrRPC          string `protobuf:"bytes,2,opt,name=addrRPC" json:"addrRPC"`
-	XXX_unrecognized []byte `json:"-"`
+	Addr             string      `protobuf:"bytes,1,opt,name=addr" json:"addr"`
+	AddrRPC          string      `protobuf:"bytes,2,opt,name=addrRPC" json:"addrRPC"`
+	Labels           []PairValue `protobuf:"bytes,3,rep,name=labels" json:"labels"`
+	XXX_unrecognized []byte      `json:"-"`
 }
 
 func (m *Proxy) Reset()                    { *m = Proxy{} }
@@ -432,12 +1002,32 @@ func (m *Proxy) GetAddrRPC() string {
 	return ""
 }
 
-// Cluster is a set of server has same interface
+func (m *Proxy) GetLabels() []PairValue {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+// Cluster is a set of server has same interface, the standby servers of the cluster are promoted
+// when the up active servers less than minActive, and demoted when the active servers recovered.
+// circuitBreaker is used by the servers of the cluster that have no circuit breaker, the servers
+// bound to multiple clusters use the circuit breaker of the cluster with the min id
 type Cluster struct {
-	ID               uint64      `protobuf:"varint,1,opt,name=id" json:"id"`
-	Name             string      `protobuf:"bytes,2,opt,name=name" json:"name"`
-	LoadBalance      LoadBalance `protobuf:"varint,3,opt,name=loadBalance,enum=metapb.LoadBalance" json:"loadBalance"`
-	XXX_unrecognized []byte      `json:"-"`
+	ID               uint64          `protobuf:"varint,1,opt,name=id" json:"id"`
+	Name             string          `protobuf:"bytes,2,opt,name=name" json:"name"`
+	LoadBalance      LoadBalance     `protobuf:"varint,3,opt,name=loadBalance,enum=metapb.LoadBalance" json:"loadBalance"`
+	OutboundAuth     *OutboundAuth   `protobuf:"bytes,4,opt,name=outboundAuth" json:"outboundAuth,omitempty"`
+	HalfOpenProbe    *HalfOpenProbe  `protobuf:"bytes,5,opt,name=halfOpenProbe" json:"halfOpenProbe,omitempty"`
+	UpstreamHost     *UpstreamHost   `protobuf:"bytes,6,opt,name=upstreamHost" json:"upstreamHost,omitempty"`
+	Tags             []*PairValue    `protobuf:"bytes,7,rep,name=tags" json:"tags,omitempty"`
+	DNS              *DNSTarget      `protobuf:"bytes,8,opt,name=dns" json:"dns,omitempty"`
+	MinActive        int32           `protobuf:"varint,9,opt,name=minActive" json:"minActive"`
+	RemoteGateway    *RemoteGateway  `protobuf:"bytes,10,opt,name=remoteGateway" json:"remoteGateway,omitempty"`
+	Policy           *Policy         `protobuf:"bytes,11,opt,name=policy" json:"policy,omitempty"`
+	CircuitBreaker   *CircuitBreaker `protobuf:"bytes,12,opt,name=circuitBreaker" json:"circuitBreaker,omitempty"`
+	ConnRecycle      *ConnRecycle    `protobuf:"bytes,13,opt,name=connRecycle" json:"connRecycle,omitempty"`
+	XXX_unrecognized []byte          `json:"-"`
 }
 
 func (m *Cluster) Reset()                    { *m = Cluster{} }
@@ -466,2557 +1056,23999 @@ func (m *Cluster) GetLoadBalance() LoadBalance {
 	return RoundRobin
 }
 
-// HeathCheck is the heath check
-type HeathCheck struct {
-	Path             string `protobuf:"bytes,1,opt,name=path" json:"path"`
-	Body             string `protobuf:"bytes,2,opt,name=body" json:"body"`
-	CheckInterval    int64  `protobuf:"varint,3,opt,name=checkInterval" json:"checkInterval"`
-	Timeout          int64  `protobuf:"varint,4,opt,name=timeout" json:"timeout"`
-	XXX_unrecognized []byte `json:"-"`
+func (m *Cluster) GetOutboundAuth() *OutboundAuth {
+	if m != nil {
+		return m.OutboundAuth
+	}
+	return nil
 }
 
-func (m *HeathCheck) Reset()                    { *m = HeathCheck{} }
-func (m *HeathCheck) String() string            { return proto.CompactTextString(m) }
-func (*HeathCheck) ProtoMessage()               {}
-func (*HeathCheck) Descriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{2} }
-
-func (m *HeathCheck) GetPath() string {
+func (m *Cluster) GetHalfOpenProbe() *HalfOpenProbe {
 	if m != nil {
-		return m.Path
+		return m.HalfOpenProbe
 	}
-	return ""
+	return nil
 }
 
-func (m *HeathCheck) GetBody() string {
+func (m *Cluster) GetUpstreamHost() *UpstreamHost {
 	if m != nil {
-		return m.Body
+		return m.UpstreamHost
 	}
-	return ""
+	return nil
 }
 
-func (m *HeathCheck) GetCheckInterval() int64 {
+func (m *Cluster) GetTags() []*PairValue {
 	if m != nil {
-		return m.CheckInterval
+		return m.Tags
 	}
-	return 0
+	return nil
 }
 
-func (m *HeathCheck) GetTimeout() int64 {
+func (m *Cluster) GetDNS() *DNSTarget {
 	if m != nil {
-		return m.Timeout
+		return m.DNS
 	}
-	return 0
-}
-
-// CircuitBreaker circuit breaker
-type CircuitBreaker struct {
-	CloseTimeout       int64  `protobuf:"varint,1,opt,name=closeTimeout" json:"closeTimeout"`
-	HalfTrafficRate    int32  `protobuf:"varint,2,opt,name=halfTrafficRate" json:"halfTrafficRate"`
-	RateCheckPeriod    int64  `protobuf:"varint,3,opt,name=rateCheckPeriod" json:"rateCheckPeriod"`
-	FailureRateToClose int32  `protobuf:"varint,4,opt,name=failureRateToClose" json:"failureRateToClose"`
-	SucceedRateToOpen  int32  `protobuf:"varint,5,opt,name=succeedRateToOpen" json:"succeedRateToOpen"`
-	XXX_unrecognized   []byte `json:"-"`
+	return nil
 }
 
-func (m *CircuitBreaker) Reset()                    { *m = CircuitBreaker{} }
-func (m *CircuitBreaker) String() string            { return proto.CompactTextString(m) }
-func (*CircuitBreaker) ProtoMessage()               {}
-func (*CircuitBreaker) Descriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{3} }
-
-func (m *CircuitBreaker) GetCloseTimeout() int64 {
+func (m *Cluster) GetMinActive() int32 {
 	if m != nil {
-		return m.CloseTimeout
+		return m.MinActive
 	}
 	return 0
 }
 
-func (m *CircuitBreaker) GetHalfTrafficRate() int32 {
+func (m *Cluster) GetRemoteGateway() *RemoteGateway {
 	if m != nil {
-		return m.HalfTrafficRate
+		return m.RemoteGateway
 	}
-	return 0
+	return nil
 }
 
-func (m *CircuitBreaker) GetRateCheckPeriod() int64 {
+func (m *Cluster) GetPolicy() *Policy {
 	if m != nil {
-		return m.RateCheckPeriod
+		return m.Policy
 	}
-	return 0
+	return nil
 }
 
-func (m *CircuitBreaker) GetFailureRateToClose() int32 {
+func (m *Cluster) GetCircuitBreaker() *CircuitBreaker {
 	if m != nil {
-		return m.FailureRateToClose
+		return m.CircuitBreaker
 	}
-	return 0
+	return nil
 }
 
-func (m *CircuitBreaker) GetSucceedRateToOpen() int32 {
+func (m *Cluster) GetConnRecycle() *ConnRecycle {
 	if m != nil {
-		return m.SucceedRateToOpen
+		return m.ConnRecycle
 	}
-	return 0
+	return nil
 }
 
-// Server is a backend server that provide api
-type Server struct {
-	ID               uint64          `protobuf:"varint,1,opt,name=id" json:"id"`
-	Addr             string          `protobuf:"bytes,2,opt,name=addr" json:"addr"`
-	Protocol         Protocol        `protobuf:"varint,3,opt,name=protocol,enum=metapb.Protocol" json:"protocol"`
-	MaxQPS           int64           `protobuf:"varint,4,opt,name=maxQPS" json:"maxQPS"`
-	HeathCheck       *HeathCheck     `protobuf:"bytes,5,opt,name=heathCheck" json:"heathCheck,omitempty"`
-	CircuitBreaker   *CircuitBreaker `protobuf:"bytes,6,opt,name=circuitBreaker" json:"circuitBreaker,omitempty"`
-	XXX_unrecognized []byte          `json:"-"`
+// ConnRecycle recycle the keep-alive connections to the servers of the cluster, the connection is
+// closed after maxLifetime(ns) since it's connected, or after maxRequests requests, so the traffic is
+// not pinned to the backends behind the nats or the load balancers that changed. 0 maxLifetime means
+// the keepalive limit of the proxy, 0 maxRequests means no limit
+type ConnRecycle struct {
+	MaxLifetime      int64  `protobuf:"varint,1,opt,name=maxLifetime" json:"maxLifetime"`
+	MaxRequests      int64  `protobuf:"varint,2,opt,name=maxRequests" json:"maxRequests"`
+	XXX_unrecognized []byte `json:"-"`
 }
 
-func (m *Server) Reset()                    { *m = Server{} }
-func (m *Server) String() string            { return proto.CompactTextString(m) }
-func (*Server) ProtoMessage()               {}
-func (*Server) Descriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{4} }
+func (m *ConnRecycle) Reset()                    { *m = ConnRecycle{} }
+func (m *ConnRecycle) String() string            { return proto.CompactTextString(m) }
+func (*ConnRecycle) ProtoMessage()               {}
+func (*ConnRecycle) Descriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{2} }
 
-func (m *Server) GetID() uint64 {
+func (m *ConnRecycle) GetMaxLifetime() int64 {
 	if m != nil {
-		return m.ID
+		return m.MaxLifetime
 	}
 	return 0
 }
 
-func (m *Server) GetAddr() string {
+func (m *ConnRecycle) GetMaxRequests() int64 {
 	if m != nil {
-		return m.Addr
+		return m.MaxRequests
 	}
-	return ""
+	return 0
 }
 
-func (m *Server) GetProtocol() Protocol {
+// Policy is the default policies of the apis, it is defined globally and on the clusters. The api
+// policies override the template policies, the template policies override the cluster policies, and
+// the cluster policies override the global policies. The timeouts and the retries of the nodes use
+// the policy of the node cluster, the others use the policy of the first node cluster. timeout(ns) is
+// the overall timeout of the requests, includes all nodes and retries. ipAccessControl is only defined
+// globally, it's not inherited but checked for all requests before the dispatch
+type Policy struct {
+	AuthFilter       string           `protobuf:"bytes,1,opt,name=authFilter" json:"authFilter"`
+	MaxQPS           int64            `protobuf:"varint,2,opt,name=maxQPS" json:"maxQPS"`
+	RetryStrategy    *RetryStrategy   `protobuf:"bytes,3,opt,name=retryStrategy" json:"retryStrategy,omitempty"`
+	WriteTimeout     int64            `protobuf:"varint,4,opt,name=writeTimeout" json:"writeTimeout"`
+	ReadTimeout      int64            `protobuf:"varint,5,opt,name=readTimeout" json:"readTimeout"`
+	Timeout          int64            `protobuf:"varint,6,opt,name=timeout" json:"timeout"`
+	IPAccessControl  *IPAccessControl `protobuf:"bytes,7,opt,name=ipAccessControl" json:"ipAccessControl,omitempty"`
+	XXX_unrecognized []byte           `json:"-"`
+}
+
+func (m *Policy) Reset()                    { *m = Policy{} }
+func (m *Policy) String() string            { return proto.CompactTextString(m) }
+func (*Policy) ProtoMessage()               {}
+func (*Policy) Descriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{3} }
+
+func (m *Policy) GetAuthFilter() string {
 	if m != nil {
-		return m.Protocol
+		return m.AuthFilter
 	}
-	return HTTP
+	return ""
 }
 
-func (m *Server) GetMaxQPS() int64 {
+func (m *Policy) GetMaxQPS() int64 {
 	if m != nil {
 		return m.MaxQPS
 	}
 	return 0
 }
 
-func (m *Server) GetHeathCheck() *HeathCheck {
+func (m *Policy) GetRetryStrategy() *RetryStrategy {
 	if m != nil {
-		return m.HeathCheck
+		return m.RetryStrategy
 	}
 	return nil
 }
 
-func (m *Server) GetCircuitBreaker() *CircuitBreaker {
+func (m *Policy) GetWriteTimeout() int64 {
 	if m != nil {
-		return m.CircuitBreaker
+		return m.WriteTimeout
 	}
-	return nil
+	return 0
 }
 
-// Bind is a bind pair with cluster and server
-type Bind struct {
-	ClusterID        uint64 `protobuf:"varint,1,opt,name=clusterID" json:"clusterID"`
-	ServerID         uint64 `protobuf:"varint,2,opt,name=serverID" json:"serverID"`
-	XXX_unrecognized []byte `json:"-"`
+func (m *Policy) GetReadTimeout() int64 {
+	if m != nil {
+		return m.ReadTimeout
+	}
+	return 0
 }
 
-func (m *Bind) Reset()                    { *m = Bind{} }
-func (m *Bind) String() string            { return proto.CompactTextString(m) }
-func (*Bind) ProtoMessage()               {}
-func (*Bind) Descriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{5} }
-
-func (m *Bind) GetClusterID() uint64 {
+func (m *Policy) GetTimeout() int64 {
 	if m != nil {
-		return m.ClusterID
+		return m.Timeout
 	}
 	return 0
 }
 
-func (m *Bind) GetServerID() uint64 {
+func (m *Policy) GetIPAccessControl() *IPAccessControl {
 	if m != nil {
-		return m.ServerID
+		return m.IPAccessControl
 	}
-	return 0
+	return nil
 }
 
-// Pair is pair value
-type PairValue struct {
-	Name             string `protobuf:"bytes,1,opt,name=name" json:"name"`
-	Value            string `protobuf:"bytes,2,opt,name=value" json:"value"`
+// RemoteGateway means the servers of the cluster are the gateways of another gateway cluster, e.g.
+// the gateway of another region. The request id and the consumer of the requests are forwarded to
+// the remote gateways, the consumer is signed by the secret that shared with the remote gateways.
+// maxHops is the max gateways that a request go through, default is 3
+type RemoteGateway struct {
+	Secret           string `protobuf:"bytes,1,opt,name=secret" json:"secret"`
+	MaxHops          int32  `protobuf:"varint,2,opt,name=maxHops" json:"maxHops"`
 	XXX_unrecognized []byte `json:"-"`
 }
 
-func (m *PairValue) Reset()                    { *m = PairValue{} }
-func (m *PairValue) String() string            { return proto.CompactTextString(m) }
-func (*PairValue) ProtoMessage()               {}
-func (*PairValue) Descriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{6} }
+func (m *RemoteGateway) Reset()                    { *m = RemoteGateway{} }
+func (m *RemoteGateway) String() string            { return proto.CompactTextString(m) }
+func (*RemoteGateway) ProtoMessage()               {}
+func (*RemoteGateway) Descriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{4} }
 
-func (m *PairValue) GetName() string {
+func (m *RemoteGateway) GetSecret() string {
 	if m != nil {
-		return m.Name
+		return m.Secret
 	}
 	return ""
 }
 
-func (m *PairValue) GetValue() string {
+func (m *RemoteGateway) GetMaxHops() int32 {
 	if m != nil {
-		return m.Value
+		return m.MaxHops
 	}
-	return ""
+	return 0
 }
 
-// IPAccessControl is for ip access control
-type IPAccessControl struct {
-	Whitelist        []string `protobuf:"bytes,1,rep,name=whitelist" json:"whitelist,omitempty"`
-	Blacklist        []string `protobuf:"bytes,2,rep,name=blacklist" json:"blacklist,omitempty"`
-	XXX_unrecognized []byte   `json:"-"`
+// DNSTarget is the hostname and port of the cluster that already behind the service discovery,
+// the A/AAAA records of the host are resolved every refreshInterval seconds(default 30), and used
+// as the servers of the cluster with the protocol, maxQPS, heathCheck and circuitBreaker
+type DNSTarget struct {
+	Host             string          `protobuf:"bytes,1,opt,name=host" json:"host"`
+	Port             int32           `protobuf:"varint,2,opt,name=port" json:"port"`
+	Protocol         Protocol        `protobuf:"varint,3,opt,name=protocol,enum=metapb.Protocol" json:"protocol"`
+	MaxQPS           int64           `protobuf:"varint,4,opt,name=maxQPS" json:"maxQPS"`
+	HeathCheck       *HeathCheck     `protobuf:"bytes,5,opt,name=heathCheck" json:"heathCheck,omitempty"`
+	CircuitBreaker   *CircuitBreaker `protobuf:"bytes,6,opt,name=circuitBreaker" json:"circuitBreaker,omitempty"`
+	RefreshInterval  int64           `protobuf:"varint,7,opt,name=refreshInterval" json:"refreshInterval"`
+	XXX_unrecognized []byte          `json:"-"`
 }
 
-func (m *IPAccessControl) Reset()                    { *m = IPAccessControl{} }
-func (m *IPAccessControl) String() string            { return proto.CompactTextString(m) }
-func (*IPAccessControl) ProtoMessage()               {}
-func (*IPAccessControl) Descriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{7} }
+func (m *DNSTarget) Reset()                    { *m = DNSTarget{} }
+func (m *DNSTarget) String() string            { return proto.CompactTextString(m) }
+func (*DNSTarget) ProtoMessage()               {}
+func (*DNSTarget) Descriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{5} }
 
-func (m *IPAccessControl) GetWhitelist() []string {
+func (m *DNSTarget) GetHost() string {
 	if m != nil {
-		return m.Whitelist
+		return m.Host
 	}
-	return nil
+	return ""
 }
 
-func (m *IPAccessControl) GetBlacklist() []string {
+func (m *DNSTarget) GetPort() int32 {
 	if m != nil {
-		return m.Blacklist
+		return m.Port
 	}
-	return nil
+	return 0
 }
 
-// HTTPResult is a http result
-type HTTPResult struct {
-	Body             []byte       `protobuf:"bytes,1,opt,name=body" json:"body,omitempty"`
-	Headers          []*PairValue `protobuf:"bytes,2,rep,name=headers" json:"headers,omitempty"`
-	Cookies          []*PairValue `protobuf:"bytes,3,rep,name=cookies" json:"cookies,omitempty"`
-	Code             int32        `protobuf:"varint,4,opt,name=code" json:"code"`
-	XXX_unrecognized []byte       `json:"-"`
+func (m *DNSTarget) GetProtocol() Protocol {
+	if m != nil {
+		return m.Protocol
+	}
+	return HTTP
 }
 
-func (m *HTTPResult) Reset()                    { *m = HTTPResult{} }
-func (m *HTTPResult) String() string            { return proto.CompactTextString(m) }
-func (*HTTPResult) ProtoMessage()               {}
-func (*HTTPResult) Descriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{8} }
-
-func (m *HTTPResult) GetBody() []byte {
+func (m *DNSTarget) GetMaxQPS() int64 {
 	if m != nil {
-		return m.Body
+		return m.MaxQPS
 	}
-	return nil
+	return 0
 }
 
-func (m *HTTPResult) GetHeaders() []*PairValue {
+func (m *DNSTarget) GetHeathCheck() *HeathCheck {
 	if m != nil {
-		return m.Headers
+		return m.HeathCheck
 	}
 	return nil
 }
 
-func (m *HTTPResult) GetCookies() []*PairValue {
+func (m *DNSTarget) GetCircuitBreaker() *CircuitBreaker {
 	if m != nil {
-		return m.Cookies
+		return m.CircuitBreaker
 	}
 	return nil
 }
 
-func (m *HTTPResult) GetCode() int32 {
+func (m *DNSTarget) GetRefreshInterval() int64 {
 	if m != nil {
-		return m.Code
+		return m.RefreshInterval
 	}
 	return 0
 }
 
-// Parameter is a parameter from a http request
-type Parameter struct {
-	Name             string `protobuf:"bytes,1,opt,name=name" json:"name"`
-	Source           Source `protobuf:"varint,2,opt,name=source,enum=metapb.Source" json:"source"`
-	Index            int32  `protobuf:"varint,3,opt,name=index" json:"index"`
-	XXX_unrecognized []byte `json:"-"`
+// HalfOpenProbe is how the requests go through the half-open circuit of the servers in the cluster,
+// maxRequests is the max probe requests in each half-open period, 0 means no limit,
+// trafficRate is the percent of real traffic, 0 means using the halfTrafficRate of the circuit breaker
+type HalfOpenProbe struct {
+	Strategy         ProbeStrategy `protobuf:"varint,1,opt,name=strategy,enum=metapb.ProbeStrategy" json:"strategy"`
+	MaxRequests      int32         `protobuf:"varint,2,opt,name=maxRequests" json:"maxRequests"`
+	TrafficRate      int32         `protobuf:"varint,3,opt,name=trafficRate" json:"trafficRate"`
+	XXX_unrecognized []byte        `json:"-"`
 }
 
-func (m *Parameter) Reset()                    { *m = Parameter{} }
-func (m *Parameter) String() string            { return proto.CompactTextString(m) }
-func (*Parameter) ProtoMessage()               {}
-func (*Parameter) Descriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{9} }
+func (m *HalfOpenProbe) Reset()                    { *m = HalfOpenProbe{} }
+func (m *HalfOpenProbe) String() string            { return proto.CompactTextString(m) }
+func (*HalfOpenProbe) ProtoMessage()               {}
+func (*HalfOpenProbe) Descriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{6} }
 
-func (m *Parameter) GetName() string {
+func (m *HalfOpenProbe) GetStrategy() ProbeStrategy {
 	if m != nil {
-		return m.Name
+		return m.Strategy
 	}
-	return ""
+	return RealTraffic
 }
 
-func (m *Parameter) GetSource() Source {
+func (m *HalfOpenProbe) GetMaxRequests() int32 {
 	if m != nil {
-		return m.Source
+		return m.MaxRequests
 	}
-	return QueryString
+	return 0
 }
 
-func (m *Parameter) GetIndex() int32 {
+func (m *HalfOpenProbe) GetTrafficRate() int32 {
 	if m != nil {
-		return m.Index
+		return m.TrafficRate
 	}
 	return 0
 }
 
-// ValidationRule is a validation rule
-type ValidationRule struct {
-	RuleType         RuleType `protobuf:"varint,1,opt,name=ruleType,enum=metapb.RuleType" json:"ruleType"`
-	Expression       string   `protobuf:"bytes,2,opt,name=expression" json:"expression"`
-	XXX_unrecognized []byte   `json:"-"`
+// OutboundAuth is used to sign the requests that sent to the servers of the cluster
+type OutboundAuth struct {
+	Type             OutboundAuthType `protobuf:"varint,1,opt,name=type,enum=metapb.OutboundAuthType" json:"type"`
+	Token            string           `protobuf:"bytes,2,opt,name=token" json:"token"`
+	AccessKey        string           `protobuf:"bytes,3,opt,name=accessKey" json:"accessKey"`
+	SecretKey        string           `protobuf:"bytes,4,opt,name=secretKey" json:"secretKey"`
+	Region           string           `protobuf:"bytes,5,opt,name=region" json:"region"`
+	Service          string           `protobuf:"bytes,6,opt,name=service" json:"service"`
+	Header           string           `protobuf:"bytes,7,opt,name=header" json:"header"`
+	XXX_unrecognized []byte           `json:"-"`
 }
 
-func (m *ValidationRule) Reset()                    { *m = ValidationRule{} }
-func (m *ValidationRule) String() string            { return proto.CompactTextString(m) }
-func (*ValidationRule) ProtoMessage()               {}
-func (*ValidationRule) Descriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{10} }
+func (m *OutboundAuth) Reset()                    { *m = OutboundAuth{} }
+func (m *OutboundAuth) String() string            { return proto.CompactTextString(m) }
+func (*OutboundAuth) ProtoMessage()               {}
+func (*OutboundAuth) Descriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{7} }
 
-func (m *ValidationRule) GetRuleType() RuleType {
+func (m *OutboundAuth) GetType() OutboundAuthType {
 	if m != nil {
-		return m.RuleType
+		return m.Type
 	}
-	return RuleRegexp
+	return NoOutboundAuth
 }
 
-func (m *ValidationRule) GetExpression() string {
+func (m *OutboundAuth) GetToken() string {
 	if m != nil {
-		return m.Expression
+		return m.Token
 	}
 	return ""
 }
 
-// Validation is a validation
-type Validation struct {
-	Parameter        Parameter        `protobuf:"bytes,1,opt,name=parameter" json:"parameter"`
-	Required         bool             `protobuf:"varint,2,opt,name=required" json:"required"`
-	Rules            []ValidationRule `protobuf:"bytes,3,rep,name=rules" json:"rules"`
-	XXX_unrecognized []byte           `json:"-"`
-}
-
-func (m *Validation) Reset()                    { *m = Validation{} }
-func (m *Validation) String() string            { return proto.CompactTextString(m) }
-func (*Validation) ProtoMessage()               {}
-func (*Validation) Descriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{11} }
-
-func (m *Validation) GetParameter() Parameter {
+func (m *OutboundAuth) GetAccessKey() string {
 	if m != nil {
-		return m.Parameter
+		return m.AccessKey
 	}
-	return Parameter{}
+	return ""
 }
 
-func (m *Validation) GetRequired() bool {
+func (m *OutboundAuth) GetSecretKey() string {
 	if m != nil {
-		return m.Required
+		return m.SecretKey
 	}
-	return false
+	return ""
 }
 
-func (m *Validation) GetRules() []ValidationRule {
+func (m *OutboundAuth) GetRegion() string {
 	if m != nil {
-		return m.Rules
+		return m.Region
 	}
-	return nil
+	return ""
 }
 
-// RetryStrategy retry strategy
-type RetryStrategy struct {
-	Interval         int32   `protobuf:"varint,1,opt,name=interval" json:"interval"`
-	MaxTimes         int32   `protobuf:"varint,2,opt,name=maxTimes" json:"maxTimes"`
-	Codes            []int32 `protobuf:"varint,3,rep,name=codes" json:"codes,omitempty"`
-	XXX_unrecognized []byte  `json:"-"`
+func (m *OutboundAuth) GetService() string {
+	if m != nil {
+		return m.Service
+	}
+	return ""
 }
 
-func (m *RetryStrategy) Reset()                    { *m = RetryStrategy{} }
-func (m *RetryStrategy) String() string            { return proto.CompactTextString(m) }
-func (*RetryStrategy) ProtoMessage()               {}
-func (*RetryStrategy) Descriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{12} }
-
-func (m *RetryStrategy) GetInterval() int32 {
+func (m *OutboundAuth) GetHeader() string {
 	if m != nil {
-		return m.Interval
+		return m.Header
 	}
-	return 0
+	return ""
 }
 
-func (m *RetryStrategy) GetMaxTimes() int32 {
+// UpstreamHost is the host header of the requests that sent to the servers, the address of the server
+// by default, the host of the client request, or the fixed value
+type UpstreamHost struct {
+	Type             HostType `protobuf:"varint,1,opt,name=type,enum=metapb.HostType" json:"type"`
+	Value            string   `protobuf:"bytes,2,opt,name=value" json:"value"`
+	XXX_unrecognized []byte   `json:"-"`
+}
+
+func (m *UpstreamHost) Reset()                    { *m = UpstreamHost{} }
+func (m *UpstreamHost) String() string            { return proto.CompactTextString(m) }
+func (*UpstreamHost) ProtoMessage()               {}
+func (*UpstreamHost) Descriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{8} }
+
+func (m *UpstreamHost) GetType() HostType {
 	if m != nil {
-		return m.MaxTimes
+		return m.Type
 	}
-	return 0
+	return ServerAddrHost
 }
 
-func (m *RetryStrategy) GetCodes() []int32 {
+func (m *UpstreamHost) GetValue() string {
 	if m != nil {
-		return m.Codes
+		return m.Value
 	}
-	return nil
+	return ""
 }
 
-// DispatchNode is the request forward to
-type DispatchNode struct {
-	ClusterID        uint64         `protobuf:"varint,1,opt,name=clusterID" json:"clusterID"`
-	URLRewrite       string         `protobuf:"bytes,2,opt,name=urlRewrite" json:"urlRewrite"`
-	AttrName         string         `protobuf:"bytes,3,opt,name=attrName" json:"attrName"`
-	Validations      []*Validation  `protobuf:"bytes,4,rep,name=validations" json:"validations,omitempty"`
-	Cache            *Cache         `protobuf:"bytes,5,opt,name=cache" json:"cache,omitempty"`
-	DefaultValue     *HTTPResult    `protobuf:"bytes,6,opt,name=defaultValue" json:"defaultValue,omitempty"`
-	UseDefault       bool           `protobuf:"varint,7,opt,name=useDefault" json:"useDefault"`
-	BatchIndex       int32          `protobuf:"varint,8,opt,name=batchIndex" json:"batchIndex"`
-	RetryStrategy    *RetryStrategy `protobuf:"bytes,9,opt,name=retryStrategy" json:"retryStrategy,omitempty"`
-	WriteTimeout     int64          `protobuf:"varint,10,opt,name=writeTimeout" json:"writeTimeout"`
-	ReadTimeout      int64          `protobuf:"varint,11,opt,name=readTimeout" json:"readTimeout"`
-	XXX_unrecognized []byte         `json:"-"`
+// HeathCheck is the heath check, the server changes to up after healthyThreshold continuous succeed
+// checks and changes to down after unhealthyThreshold continuous failed checks, 0 means 1. The path
+// and the body are only used by the http probe. The command is the program and the arguments of the exec
+// probe, the server is healthy if the command exits with 0. The checker is the url of the checker probe,
+// the checker service returns the health of the server as a json {"healthy":true,"reason":""}
+type HeathCheck struct {
+	Path               string         `protobuf:"bytes,1,opt,name=path" json:"path"`
+	Body               string         `protobuf:"bytes,2,opt,name=body" json:"body"`
+	CheckInterval      int64          `protobuf:"varint,3,opt,name=checkInterval" json:"checkInterval"`
+	Timeout            int64          `protobuf:"varint,4,opt,name=timeout" json:"timeout"`
+	Type               HeathCheckType `protobuf:"varint,5,opt,name=type,enum=metapb.HeathCheckType" json:"type"`
+	HealthyThreshold   int32          `protobuf:"varint,6,opt,name=healthyThreshold" json:"healthyThreshold"`
+	UnhealthyThreshold int32          `protobuf:"varint,7,opt,name=unhealthyThreshold" json:"unhealthyThreshold"`
+	Command            []string       `protobuf:"bytes,8,rep,name=command" json:"command,omitempty"`
+	Checker            string         `protobuf:"bytes,9,opt,name=checker" json:"checker"`
+	XXX_unrecognized   []byte         `json:"-"`
 }
 
-func (m *DispatchNode) Reset()                    { *m = DispatchNode{} }
-func (m *DispatchNode) String() string            { return proto.CompactTextString(m) }
-func (*DispatchNode) ProtoMessage()               {}
-func (*DispatchNode) Descriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{13} }
+func (m *HeathCheck) Reset()                    { *m = HeathCheck{} }
+func (m *HeathCheck) String() string            { return proto.CompactTextString(m) }
+func (*HeathCheck) ProtoMessage()               {}
+func (*HeathCheck) Descriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{9} }
 
-func (m *DispatchNode) GetClusterID() uint64 {
+func (m *HeathCheck) GetPath() string {
 	if m != nil {
-		return m.ClusterID
+		return m.Path
 	}
-	return 0
+	return ""
 }
 
-func (m *DispatchNode) GetURLRewrite() string {
+func (m *HeathCheck) GetBody() string {
 	if m != nil {
-		return m.URLRewrite
+		return m.Body
 	}
 	return ""
 }
 
-func (m *DispatchNode) GetAttrName() string {
-	if m != nil {
-		return m.AttrName
-	}
-	return ""
-}
-
-func (m *DispatchNode) GetValidations() []*Validation {
-	if m != nil {
-		return m.Validations
-	}
-	return nil
-}
-
-func (m *DispatchNode) GetCache() *Cache {
-	if m != nil {
-		return m.Cache
-	}
-	return nil
-}
-
-func (m *DispatchNode) GetDefaultValue() *HTTPResult {
-	if m != nil {
-		return m.DefaultValue
-	}
-	return nil
-}
-
-func (m *DispatchNode) GetUseDefault() bool {
+func (m *HeathCheck) GetCheckInterval() int64 {
 	if m != nil {
-		return m.UseDefault
+		return m.CheckInterval
 	}
-	return false
+	return 0
 }
 
-func (m *DispatchNode) GetBatchIndex() int32 {
+func (m *HeathCheck) GetTimeout() int64 {
 	if m != nil {
-		return m.BatchIndex
+		return m.Timeout
 	}
 	return 0
 }
 
-func (m *DispatchNode) GetRetryStrategy() *RetryStrategy {
+func (m *HeathCheck) GetType() HeathCheckType {
 	if m != nil {
-		return m.RetryStrategy
+		return m.Type
 	}
-	return nil
+	return HTTPCheck
 }
 
-func (m *DispatchNode) GetWriteTimeout() int64 {
+func (m *HeathCheck) GetHealthyThreshold() int32 {
 	if m != nil {
-		return m.WriteTimeout
+		return m.HealthyThreshold
 	}
 	return 0
 }
 
-func (m *DispatchNode) GetReadTimeout() int64 {
+func (m *HeathCheck) GetUnhealthyThreshold() int32 {
 	if m != nil {
-		return m.ReadTimeout
+		return m.UnhealthyThreshold
 	}
 	return 0
 }
 
-// Cache is used for cache api result
-type Cache struct {
-	Keys             []Parameter `protobuf:"bytes,1,rep,name=keys" json:"keys"`
-	Deadline         uint64      `protobuf:"varint,2,opt,name=deadline" json:"deadline"`
-	Conditions       []Condition `protobuf:"bytes,3,rep,name=conditions" json:"conditions"`
-	XXX_unrecognized []byte      `json:"-"`
-}
-
-func (m *Cache) Reset()                    { *m = Cache{} }
-func (m *Cache) String() string            { return proto.CompactTextString(m) }
-func (*Cache) ProtoMessage()               {}
-func (*Cache) Descriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{14} }
-
-func (m *Cache) GetKeys() []Parameter {
+func (m *HeathCheck) GetCommand() []string {
 	if m != nil {
-		return m.Keys
+		return m.Command
 	}
 	return nil
 }
 
-func (m *Cache) GetDeadline() uint64 {
-	if m != nil {
-		return m.Deadline
-	}
-	return 0
-}
-
-func (m *Cache) GetConditions() []Condition {
+func (m *HeathCheck) GetChecker() string {
 	if m != nil {
-		return m.Conditions
+		return m.Checker
 	}
-	return nil
+	return ""
 }
 
-// RenderTemplate the template that render to client
-type RenderTemplate struct {
-	Objects          []*RenderObject `protobuf:"bytes,1,rep,name=objects" json:"objects,omitempty"`
-	XXX_unrecognized []byte          `json:"-"`
+// CircuitBreaker circuit breaker, the circuit is closed if the failure rate in rateCheckPeriod reaches
+// failureRateToClose, or the continuous failures reach continuousFailuresToClose, 0 means disabled.
+// The closed circuit changes to half after closeTimeout, and the half circuit changes to open if the
+// succeed rate reaches succeedRateToOpen
+type CircuitBreaker struct {
+	CloseTimeout              int64  `protobuf:"varint,1,opt,name=closeTimeout" json:"closeTimeout"`
+	HalfTrafficRate           int32  `protobuf:"varint,2,opt,name=halfTrafficRate" json:"halfTrafficRate"`
+	RateCheckPeriod           int64  `protobuf:"varint,3,opt,name=rateCheckPeriod" json:"rateCheckPeriod"`
+	FailureRateToClose        int32  `protobuf:"varint,4,opt,name=failureRateToClose" json:"failureRateToClose"`
+	SucceedRateToOpen         int32  `protobuf:"varint,5,opt,name=succeedRateToOpen" json:"succeedRateToOpen"`
+	ContinuousFailuresToClose int32  `protobuf:"varint,6,opt,name=continuousFailuresToClose" json:"continuousFailuresToClose"`
+	XXX_unrecognized          []byte `json:"-"`
 }
 
-func (m *RenderTemplate) Reset()                    { *m = RenderTemplate{} }
-func (m *RenderTemplate) String() string            { return proto.CompactTextString(m) }
-func (*RenderTemplate) ProtoMessage()               {}
-func (*RenderTemplate) Descriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{15} }
+func (m *CircuitBreaker) Reset()                    { *m = CircuitBreaker{} }
+func (m *CircuitBreaker) String() string            { return proto.CompactTextString(m) }
+func (*CircuitBreaker) ProtoMessage()               {}
+func (*CircuitBreaker) Descriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{10} }
 
-func (m *RenderTemplate) GetObjects() []*RenderObject {
+func (m *CircuitBreaker) GetCloseTimeout() int64 {
 	if m != nil {
-		return m.Objects
+		return m.CloseTimeout
 	}
-	return nil
-}
-
-// RenderObject the object in the render template
-type RenderObject struct {
-	Name             string        `protobuf:"bytes,1,opt,name=name" json:"name"`
-	Attrs            []*RenderAttr `protobuf:"bytes,2,rep,name=attrs" json:"attrs,omitempty"`
-	FlatAttrs        bool          `protobuf:"varint,3,opt,name=flatAttrs" json:"flatAttrs"`
-	XXX_unrecognized []byte        `json:"-"`
+	return 0
 }
 
-func (m *RenderObject) Reset()                    { *m = RenderObject{} }
-func (m *RenderObject) String() string            { return proto.CompactTextString(m) }
-func (*RenderObject) ProtoMessage()               {}
-func (*RenderObject) Descriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{16} }
-
-func (m *RenderObject) GetName() string {
+func (m *CircuitBreaker) GetHalfTrafficRate() int32 {
 	if m != nil {
-		return m.Name
+		return m.HalfTrafficRate
 	}
-	return ""
+	return 0
 }
 
-func (m *RenderObject) GetAttrs() []*RenderAttr {
+func (m *CircuitBreaker) GetRateCheckPeriod() int64 {
 	if m != nil {
-		return m.Attrs
+		return m.RateCheckPeriod
 	}
-	return nil
+	return 0
 }
 
-func (m *RenderObject) GetFlatAttrs() bool {
+func (m *CircuitBreaker) GetFailureRateToClose() int32 {
 	if m != nil {
-		return m.FlatAttrs
+		return m.FailureRateToClose
 	}
-	return false
-}
-
-// RenderAttr the attr in the render object
-type RenderAttr struct {
-	Name             string `protobuf:"bytes,1,opt,name=name" json:"name"`
-	ExtractExp       string `protobuf:"bytes,2,opt,name=extractExp" json:"extractExp"`
-	XXX_unrecognized []byte `json:"-"`
+	return 0
 }
 
-func (m *RenderAttr) Reset()                    { *m = RenderAttr{} }
-func (m *RenderAttr) String() string            { return proto.CompactTextString(m) }
-func (*RenderAttr) ProtoMessage()               {}
-func (*RenderAttr) Descriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{17} }
-
-func (m *RenderAttr) GetName() string {
+func (m *CircuitBreaker) GetSucceedRateToOpen() int32 {
 	if m != nil {
-		return m.Name
+		return m.SucceedRateToOpen
 	}
-	return ""
+	return 0
 }
 
-func (m *RenderAttr) GetExtractExp() string {
+func (m *CircuitBreaker) GetContinuousFailuresToClose() int32 {
 	if m != nil {
-		return m.ExtractExp
+		return m.ContinuousFailuresToClose
 	}
-	return ""
+	return 0
 }
 
-// API is the api for dispatcher
-type API struct {
-	ID               uint64            `protobuf:"varint,1,opt,name=id" json:"id"`
-	Name             string            `protobuf:"bytes,2,opt,name=name" json:"name"`
-	URLPattern       string            `protobuf:"bytes,3,opt,name=urlPattern" json:"urlPattern"`
-	Method           string            `protobuf:"bytes,4,opt,name=method" json:"method"`
-	Domain           string            `protobuf:"bytes,5,opt,name=domain" json:"domain"`
-	Status           Status            `protobuf:"varint,6,opt,name=status,enum=metapb.Status" json:"status"`
-	IPAccessControl  *IPAccessControl  `protobuf:"bytes,7,opt,name=ipAccessControl" json:"ipAccessControl,omitempty"`
-	DefaultValue     *HTTPResult       `protobuf:"bytes,8,opt,name=defaultValue" json:"defaultValue,omitempty"`
-	Nodes            []*DispatchNode   `protobuf:"bytes,9,rep,name=nodes" json:"nodes,omitempty"`
-	Perms            []string          `protobuf:"bytes,10,rep,name=perms" json:"perms,omitempty"`
-	AuthFilter       string            `protobuf:"bytes,11,opt,name=authFilter" json:"authFilter"`
-	RenderTemplate   *RenderTemplate   `protobuf:"bytes,12,opt,name=renderTemplate" json:"renderTemplate,omitempty"`
-	UseDefault       bool              `protobuf:"varint,13,opt,name=useDefault" json:"useDefault"`
-	MatchRule        MatchRule         `protobuf:"varint,14,opt,name=matchRule,enum=metapb.MatchRule" json:"matchRule"`
-	Position         uint32            `protobuf:"varint,15,opt,name=position" json:"position"`
-	Tags             []*PairValue      `protobuf:"bytes,16,rep,name=tags" json:"tags,omitempty"`
-	WebSocketOptions *WebSocketOptions `protobuf:"bytes,17,opt,name=webSocketOptions" json:"webSocketOptions,omitempty"`
-	MaxQPS           int64             `protobuf:"varint,18,opt,name=maxQPS" json:"maxQPS"`
-	CircuitBreaker   *CircuitBreaker   `protobuf:"bytes,19,opt,name=circuitBreaker" json:"circuitBreaker,omitempty"`
-	XXX_unrecognized []byte            `json:"-"`
+// Server is a backend server that provide api, the drained server is removed from the
+// load balance of the clusters, and no new requests are sent to it. The standby server is
+// heath checked but receives no traffic until it's promoted by the clusters
+type Server struct {
+	ID               uint64          `protobuf:"varint,1,opt,name=id" json:"id"`
+	Addr             string          `protobuf:"bytes,2,opt,name=addr" json:"addr"`
+	Protocol         Protocol        `protobuf:"varint,3,opt,name=protocol,enum=metapb.Protocol" json:"protocol"`
+	MaxQPS           int64           `protobuf:"varint,4,opt,name=maxQPS" json:"maxQPS"`
+	HeathCheck       *HeathCheck     `protobuf:"bytes,5,opt,name=heathCheck" json:"heathCheck,omitempty"`
+	CircuitBreaker   *CircuitBreaker `protobuf:"bytes,6,opt,name=circuitBreaker" json:"circuitBreaker,omitempty"`
+	Tags             []*PairValue    `protobuf:"bytes,7,rep,name=tags" json:"tags,omitempty"`
+	Drained          bool            `protobuf:"varint,8,opt,name=drained" json:"drained"`
+	Weight           *ServerWeight   `protobuf:"bytes,9,opt,name=weight" json:"weight,omitempty"`
+	Standby          bool            `protobuf:"varint,10,opt,name=standby" json:"standby"`
+	XXX_unrecognized []byte          `json:"-"`
 }
 
-func (m *API) Reset()                    { *m = API{} }
-func (m *API) String() string            { return proto.CompactTextString(m) }
-func (*API) ProtoMessage()               {}
-func (*API) Descriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{18} }
+func (m *Server) Reset()                    { *m = Server{} }
+func (m *Server) String() string            { return proto.CompactTextString(m) }
+func (*Server) ProtoMessage()               {}
+func (*Server) Descriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{11} }
 
-func (m *API) GetID() uint64 {
+func (m *Server) GetID() uint64 {
 	if m != nil {
 		return m.ID
 	}
 	return 0
 }
 
-func (m *API) GetName() string {
-	if m != nil {
-		return m.Name
-	}
-	return ""
-}
-
-func (m *API) GetURLPattern() string {
+func (m *Server) GetAddr() string {
 	if m != nil {
-		return m.URLPattern
+		return m.Addr
 	}
 	return ""
 }
 
-func (m *API) GetMethod() string {
+func (m *Server) GetProtocol() Protocol {
 	if m != nil {
-		return m.Method
+		return m.Protocol
 	}
-	return ""
+	return HTTP
 }
 
-func (m *API) GetDomain() string {
+func (m *Server) GetMaxQPS() int64 {
 	if m != nil {
-		return m.Domain
+		return m.MaxQPS
 	}
-	return ""
+	return 0
 }
 
-func (m *API) GetStatus() Status {
+func (m *Server) GetHeathCheck() *HeathCheck {
 	if m != nil {
-		return m.Status
+		return m.HeathCheck
 	}
-	return Down
+	return nil
 }
 
-func (m *API) GetIPAccessControl() *IPAccessControl {
+func (m *Server) GetCircuitBreaker() *CircuitBreaker {
 	if m != nil {
-		return m.IPAccessControl
+		return m.CircuitBreaker
 	}
 	return nil
 }
 
-func (m *API) GetDefaultValue() *HTTPResult {
+func (m *Server) GetTags() []*PairValue {
 	if m != nil {
-		return m.DefaultValue
+		return m.Tags
 	}
 	return nil
 }
 
-func (m *API) GetNodes() []*DispatchNode {
+func (m *Server) GetDrained() bool {
 	if m != nil {
-		return m.Nodes
+		return m.Drained
 	}
-	return nil
+	return false
 }
 
-func (m *API) GetPerms() []string {
+func (m *Server) GetWeight() *ServerWeight {
 	if m != nil {
-		return m.Perms
+		return m.Weight
 	}
 	return nil
 }
 
-func (m *API) GetAuthFilter() string {
+func (m *Server) GetStandby() bool {
 	if m != nil {
-		return m.AuthFilter
+		return m.Standby
 	}
-	return ""
+	return false
 }
 
-func (m *API) GetRenderTemplate() *RenderTemplate {
-	if m != nil {
-		return m.RenderTemplate
-	}
-	return nil
+// ServerWeight is the load balance weight of the server, the weight ramps from the from weight
+// to the target weight linearly in rampSeconds since rampStartAt(unix seconds), the weight of
+// the server without ServerWeight is 100
+type ServerWeight struct {
+	From             int32  `protobuf:"varint,1,opt,name=from" json:"from"`
+	Target           int32  `protobuf:"varint,2,opt,name=target" json:"target"`
+	RampStartAt      int64  `protobuf:"varint,3,opt,name=rampStartAt" json:"rampStartAt"`
+	RampSeconds      int64  `protobuf:"varint,4,opt,name=rampSeconds" json:"rampSeconds"`
+	XXX_unrecognized []byte `json:"-"`
 }
 
-func (m *API) GetUseDefault() bool {
+func (m *ServerWeight) Reset()                    { *m = ServerWeight{} }
+func (m *ServerWeight) String() string            { return proto.CompactTextString(m) }
+func (*ServerWeight) ProtoMessage()               {}
+func (*ServerWeight) Descriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{12} }
+
+func (m *ServerWeight) GetFrom() int32 {
 	if m != nil {
-		return m.UseDefault
+		return m.From
 	}
-	return false
+	return 0
 }
 
-func (m *API) GetMatchRule() MatchRule {
+func (m *ServerWeight) GetTarget() int32 {
 	if m != nil {
-		return m.MatchRule
+		return m.Target
 	}
-	return MatchDefault
+	return 0
 }
 
-func (m *API) GetPosition() uint32 {
+func (m *ServerWeight) GetRampStartAt() int64 {
 	if m != nil {
-		return m.Position
+		return m.RampStartAt
 	}
 	return 0
 }
 
-func (m *API) GetTags() []*PairValue {
+func (m *ServerWeight) GetRampSeconds() int64 {
 	if m != nil {
-		return m.Tags
+		return m.RampSeconds
 	}
-	return nil
+	return 0
 }
 
-func (m *API) GetWebSocketOptions() *WebSocketOptions {
-	if m != nil {
-		return m.WebSocketOptions
-	}
-	return nil
+// Bind is a bind pair with cluster and server
+type Bind struct {
+	ClusterID        uint64 `protobuf:"varint,1,opt,name=clusterID" json:"clusterID"`
+	ServerID         uint64 `protobuf:"varint,2,opt,name=serverID" json:"serverID"`
+	XXX_unrecognized []byte `json:"-"`
 }
 
-func (m *API) GetMaxQPS() int64 {
+func (m *Bind) Reset()                    { *m = Bind{} }
+func (m *Bind) String() string            { return proto.CompactTextString(m) }
+func (*Bind) ProtoMessage()               {}
+func (*Bind) Descriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{13} }
+
+func (m *Bind) GetClusterID() uint64 {
 	if m != nil {
-		return m.MaxQPS
+		return m.ClusterID
 	}
 	return 0
 }
 
-func (m *API) GetCircuitBreaker() *CircuitBreaker {
+func (m *Bind) GetServerID() uint64 {
 	if m != nil {
-		return m.CircuitBreaker
+		return m.ServerID
 	}
-	return nil
+	return 0
 }
 
-// Condition is a condition for routing
-type Condition struct {
-	Parameter        Parameter `protobuf:"bytes,1,opt,name=parameter" json:"parameter"`
-	Cmp              CMP       `protobuf:"varint,2,opt,name=cmp,enum=metapb.CMP" json:"cmp"`
-	Expect           string    `protobuf:"bytes,3,opt,name=expect" json:"expect"`
-	XXX_unrecognized []byte    `json:"-"`
+// Pair is pair value
+type PairValue struct {
+	Name             string `protobuf:"bytes,1,opt,name=name" json:"name"`
+	Value            string `protobuf:"bytes,2,opt,name=value" json:"value"`
+	XXX_unrecognized []byte `json:"-"`
 }
 
-func (m *Condition) Reset()                    { *m = Condition{} }
-func (m *Condition) String() string            { return proto.CompactTextString(m) }
-func (*Condition) ProtoMessage()               {}
-func (*Condition) Descriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{19} }
+func (m *PairValue) Reset()                    { *m = PairValue{} }
+func (m *PairValue) String() string            { return proto.CompactTextString(m) }
+func (*PairValue) ProtoMessage()               {}
+func (*PairValue) Descriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{14} }
 
-func (m *Condition) GetParameter() Parameter {
+func (m *PairValue) GetName() string {
 	if m != nil {
-		return m.Parameter
+		return m.Name
 	}
-	return Parameter{}
+	return ""
 }
 
-func (m *Condition) GetCmp() CMP {
+func (m *PairValue) GetValue() string {
 	if m != nil {
-		return m.Cmp
+		return m.Value
 	}
-	return CMPEQ
+	return ""
 }
 
-func (m *Condition) GetExpect() string {
+// IPAccessControl is for ip access control
+type IPAccessControl struct {
+	Whitelist        []string `protobuf:"bytes,1,rep,name=whitelist" json:"whitelist,omitempty"`
+	Blacklist        []string `protobuf:"bytes,2,rep,name=blacklist" json:"blacklist,omitempty"`
+	XXX_unrecognized []byte   `json:"-"`
+}
+
+func (m *IPAccessControl) Reset()                    { *m = IPAccessControl{} }
+func (m *IPAccessControl) String() string            { return proto.CompactTextString(m) }
+func (*IPAccessControl) ProtoMessage()               {}
+func (*IPAccessControl) Descriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{15} }
+
+func (m *IPAccessControl) GetWhitelist() []string {
 	if m != nil {
-		return m.Expect
+		return m.Whitelist
 	}
-	return ""
+	return nil
 }
 
-// Routing is a routing
-type Routing struct {
-	ID               uint64          `protobuf:"varint,1,opt,name=id" json:"id"`
-	ClusterID        uint64          `protobuf:"varint,2,opt,name=clusterID" json:"clusterID"`
-	Conditions       []Condition     `protobuf:"bytes,3,rep,name=conditions" json:"conditions"`
-	Strategy         RoutingStrategy `protobuf:"varint,4,opt,name=strategy,enum=metapb.RoutingStrategy" json:"strategy"`
-	TrafficRate      int32           `protobuf:"varint,5,opt,name=trafficRate" json:"trafficRate"`
-	Status           Status          `protobuf:"varint,6,opt,name=status,enum=metapb.Status" json:"status"`
-	API              uint64          `protobuf:"varint,7,opt,name=api" json:"api"`
-	Name             string          `protobuf:"bytes,8,opt,name=name" json:"name"`
-	XXX_unrecognized []byte          `json:"-"`
+func (m *IPAccessControl) GetBlacklist() []string {
+	if m != nil {
+		return m.Blacklist
+	}
+	return nil
 }
 
-func (m *Routing) Reset()                    { *m = Routing{} }
-func (m *Routing) String() string            { return proto.CompactTextString(m) }
-func (*Routing) ProtoMessage()               {}
-func (*Routing) Descriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{20} }
+// HTTPResult is a http result
+type HTTPResult struct {
+	Body             []byte       `protobuf:"bytes,1,opt,name=body" json:"body,omitempty"`
+	Headers          []*PairValue `protobuf:"bytes,2,rep,name=headers" json:"headers,omitempty"`
+	Cookies          []*PairValue `protobuf:"bytes,3,rep,name=cookies" json:"cookies,omitempty"`
+	Code             int32        `protobuf:"varint,4,opt,name=code" json:"code"`
+	XXX_unrecognized []byte       `json:"-"`
+}
 
-func (m *Routing) GetID() uint64 {
+func (m *HTTPResult) Reset()                    { *m = HTTPResult{} }
+func (m *HTTPResult) String() string            { return proto.CompactTextString(m) }
+func (*HTTPResult) ProtoMessage()               {}
+func (*HTTPResult) Descriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{16} }
+
+func (m *HTTPResult) GetBody() []byte {
 	if m != nil {
-		return m.ID
+		return m.Body
 	}
-	return 0
+	return nil
 }
 
-func (m *Routing) GetClusterID() uint64 {
+func (m *HTTPResult) GetHeaders() []*PairValue {
 	if m != nil {
-		return m.ClusterID
+		return m.Headers
 	}
-	return 0
+	return nil
 }
 
-func (m *Routing) GetConditions() []Condition {
+func (m *HTTPResult) GetCookies() []*PairValue {
 	if m != nil {
-		return m.Conditions
+		return m.Cookies
 	}
 	return nil
 }
 
-func (m *Routing) GetStrategy() RoutingStrategy {
+func (m *HTTPResult) GetCode() int32 {
 	if m != nil {
-		return m.Strategy
+		return m.Code
 	}
-	return Copy
+	return 0
 }
 
-func (m *Routing) GetTrafficRate() int32 {
+// ErrorPage is a custom response for the errors generated by gateway,
+// status is the error status code to match, 0 matches all errors.
+// body is a template, $requestID, $code and $message will be replaced
+type ErrorPage struct {
+	Status           int32        `protobuf:"varint,1,opt,name=status" json:"status"`
+	Code             int32        `protobuf:"varint,2,opt,name=code" json:"code"`
+	ContentType      string       `protobuf:"bytes,3,opt,name=contentType" json:"contentType"`
+	Body             string       `protobuf:"bytes,4,opt,name=body" json:"body"`
+	Headers          []*PairValue `protobuf:"bytes,5,rep,name=headers" json:"headers,omitempty"`
+	XXX_unrecognized []byte       `json:"-"`
+}
+
+func (m *ErrorPage) Reset()                    { *m = ErrorPage{} }
+func (m *ErrorPage) String() string            { return proto.CompactTextString(m) }
+func (*ErrorPage) ProtoMessage()               {}
+func (*ErrorPage) Descriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{17} }
+
+func (m *ErrorPage) GetStatus() int32 {
 	if m != nil {
-		return m.TrafficRate
+		return m.Status
 	}
 	return 0
 }
 
-func (m *Routing) GetStatus() Status {
+func (m *ErrorPage) GetCode() int32 {
 	if m != nil {
-		return m.Status
+		return m.Code
 	}
-	return Down
+	return 0
 }
 
-func (m *Routing) GetAPI() uint64 {
+func (m *ErrorPage) GetContentType() string {
 	if m != nil {
-		return m.API
+		return m.ContentType
 	}
-	return 0
+	return ""
 }
 
-func (m *Routing) GetName() string {
+func (m *ErrorPage) GetBody() string {
 	if m != nil {
-		return m.Name
+		return m.Body
 	}
 	return ""
 }
 
-// WebSocketOptions websocket options
-type WebSocketOptions struct {
-	Origin           string `protobuf:"bytes,1,opt,name=origin" json:"origin"`
+func (m *ErrorPage) GetHeaders() []*PairValue {
+	if m != nil {
+		return m.Headers
+	}
+	return nil
+}
+
+// Parameter is a parameter from a http request
+type Parameter struct {
+	Name             string `protobuf:"bytes,1,opt,name=name" json:"name"`
+	Source           Source `protobuf:"varint,2,opt,name=source,enum=metapb.Source" json:"source"`
+	Index            int32  `protobuf:"varint,3,opt,name=index" json:"index"`
 	XXX_unrecognized []byte `json:"-"`
 }
 
-func (m *WebSocketOptions) Reset()                    { *m = WebSocketOptions{} }
-func (m *WebSocketOptions) String() string            { return proto.CompactTextString(m) }
-func (*WebSocketOptions) ProtoMessage()               {}
-func (*WebSocketOptions) Descriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{21} }
+func (m *Parameter) Reset()                    { *m = Parameter{} }
+func (m *Parameter) String() string            { return proto.CompactTextString(m) }
+func (*Parameter) ProtoMessage()               {}
+func (*Parameter) Descriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{18} }
 
-func (m *WebSocketOptions) GetOrigin() string {
+func (m *Parameter) GetName() string {
 	if m != nil {
-		return m.Origin
+		return m.Name
 	}
 	return ""
 }
 
-// System system
-type System struct {
-	Count            CountMetric `protobuf:"bytes,1,opt,name=count" json:"count"`
-	XXX_unrecognized []byte      `json:"-"`
+func (m *Parameter) GetSource() Source {
+	if m != nil {
+		return m.Source
+	}
+	return QueryString
 }
 
-func (m *System) Reset()                    { *m = System{} }
-func (m *System) String() string            { return proto.CompactTextString(m) }
-func (*System) ProtoMessage()               {}
-func (*System) Descriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{22} }
+func (m *Parameter) GetIndex() int32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
 
-func (m *System) GetCount() CountMetric {
+// ValidationRule is a validation rule
+type ValidationRule struct {
+	RuleType         RuleType `protobuf:"varint,1,opt,name=ruleType,enum=metapb.RuleType" json:"ruleType"`
+	Expression       string   `protobuf:"bytes,2,opt,name=expression" json:"expression"`
+	XXX_unrecognized []byte   `json:"-"`
+}
+
+func (m *ValidationRule) Reset()                    { *m = ValidationRule{} }
+func (m *ValidationRule) String() string            { return proto.CompactTextString(m) }
+func (*ValidationRule) ProtoMessage()               {}
+func (*ValidationRule) Descriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{19} }
+
+func (m *ValidationRule) GetRuleType() RuleType {
 	if m != nil {
-		return m.Count
+		return m.RuleType
 	}
-	return CountMetric{}
+	return RuleRegexp
 }
 
-// CountMetric count metric
-type CountMetric struct {
-	Cluster          int64  `protobuf:"varint,1,opt,name=cluster" json:"cluster"`
-	Server           int64  `protobuf:"varint,2,opt,name=server" json:"server"`
-	API              int64  `protobuf:"varint,3,opt,name=api" json:"api"`
-	Routing          int64  `protobuf:"varint,4,opt,name=Routing" json:"Routing"`
-	XXX_unrecognized []byte `json:"-"`
+func (m *ValidationRule) GetExpression() string {
+	if m != nil {
+		return m.Expression
+	}
+	return ""
 }
 
-func (m *CountMetric) Reset()                    { *m = CountMetric{} }
-func (m *CountMetric) String() string            { return proto.CompactTextString(m) }
-func (*CountMetric) ProtoMessage()               {}
-func (*CountMetric) Descriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{23} }
+// Validation is a validation
+type Validation struct {
+	Parameter        Parameter        `protobuf:"bytes,1,opt,name=parameter" json:"parameter"`
+	Required         bool             `protobuf:"varint,2,opt,name=required" json:"required"`
+	Rules            []ValidationRule `protobuf:"bytes,3,rep,name=rules" json:"rules"`
+	XXX_unrecognized []byte           `json:"-"`
+}
 
-func (m *CountMetric) GetCluster() int64 {
+func (m *Validation) Reset()                    { *m = Validation{} }
+func (m *Validation) String() string            { return proto.CompactTextString(m) }
+func (*Validation) ProtoMessage()               {}
+func (*Validation) Descriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{20} }
+
+func (m *Validation) GetParameter() Parameter {
 	if m != nil {
-		return m.Cluster
+		return m.Parameter
+	}
+	return Parameter{}
+}
+
+func (m *Validation) GetRequired() bool {
+	if m != nil {
+		return m.Required
+	}
+	return false
+}
+
+func (m *Validation) GetRules() []ValidationRule {
+	if m != nil {
+		return m.Rules
+	}
+	return nil
+}
+
+// RetryStrategy retry the failed requests on the other servers of the cluster, maxTimes is the max
+// attempts include the first one. The requests are retried on the status codes and the connection errors
+// (e.g. refused, reset, timeout), all failures are retried if both not set. interval(ms) is the wait before
+// the retries, it's doubled for each retry up to maxInterval(ms) if set, and the jitter randomizes the wait
+// in [wait/2, wait]. perTryTimeout(ns) is the read timeout of each attempt
+type RetryStrategy struct {
+	Interval         int32   `protobuf:"varint,1,opt,name=interval" json:"interval"`
+	MaxTimes         int32   `protobuf:"varint,2,opt,name=maxTimes" json:"maxTimes"`
+	Codes            []int32 `protobuf:"varint,3,rep,name=codes" json:"codes,omitempty"`
+	ConnectionErrors bool    `protobuf:"varint,4,opt,name=connectionErrors" json:"connectionErrors"`
+	MaxInterval      int32   `protobuf:"varint,5,opt,name=maxInterval" json:"maxInterval"`
+	Jitter           bool    `protobuf:"varint,6,opt,name=jitter" json:"jitter"`
+	PerTryTimeout    int64   `protobuf:"varint,7,opt,name=perTryTimeout" json:"perTryTimeout"`
+	XXX_unrecognized []byte  `json:"-"`
+}
+
+func (m *RetryStrategy) Reset()                    { *m = RetryStrategy{} }
+func (m *RetryStrategy) String() string            { return proto.CompactTextString(m) }
+func (*RetryStrategy) ProtoMessage()               {}
+func (*RetryStrategy) Descriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{21} }
+
+func (m *RetryStrategy) GetInterval() int32 {
+	if m != nil {
+		return m.Interval
 	}
 	return 0
 }
 
-func (m *CountMetric) GetServer() int64 {
+func (m *RetryStrategy) GetMaxTimes() int32 {
 	if m != nil {
-		return m.Server
+		return m.MaxTimes
 	}
 	return 0
 }
 
-func (m *CountMetric) GetAPI() int64 {
+func (m *RetryStrategy) GetCodes() []int32 {
 	if m != nil {
-		return m.API
+		return m.Codes
+	}
+	return nil
+}
+
+func (m *RetryStrategy) GetConnectionErrors() bool {
+	if m != nil {
+		return m.ConnectionErrors
+	}
+	return false
+}
+
+func (m *RetryStrategy) GetMaxInterval() int32 {
+	if m != nil {
+		return m.MaxInterval
 	}
 	return 0
 }
 
-func (m *CountMetric) GetRouting() int64 {
+func (m *RetryStrategy) GetJitter() bool {
 	if m != nil {
-		return m.Routing
+		return m.Jitter
+	}
+	return false
+}
+
+func (m *RetryStrategy) GetPerTryTimeout() int64 {
+	if m != nil {
+		return m.PerTryTimeout
 	}
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*Proxy)(nil), "metapb.Proxy")
-	proto.RegisterType((*Cluster)(nil), "metapb.Cluster")
-	proto.RegisterType((*HeathCheck)(nil), "metapb.HeathCheck")
-	proto.RegisterType((*CircuitBreaker)(nil), "metapb.CircuitBreaker")
-	proto.RegisterType((*Server)(nil), "metapb.Server")
-	proto.RegisterType((*Bind)(nil), "metapb.Bind")
-	proto.RegisterType((*PairValue)(nil), "metapb.PairValue")
-	proto.RegisterType((*IPAccessControl)(nil), "metapb.IPAccessControl")
-	proto.RegisterType((*HTTPResult)(nil), "metapb.HTTPResult")
-	proto.RegisterType((*Parameter)(nil), "metapb.Parameter")
-	proto.RegisterType((*ValidationRule)(nil), "metapb.ValidationRule")
-	proto.RegisterType((*Validation)(nil), "metapb.Validation")
-	proto.RegisterType((*RetryStrategy)(nil), "metapb.RetryStrategy")
-	proto.RegisterType((*DispatchNode)(nil), "metapb.DispatchNode")
-	proto.RegisterType((*Cache)(nil), "metapb.Cache")
-	proto.RegisterType((*RenderTemplate)(nil), "metapb.RenderTemplate")
-	proto.RegisterType((*RenderObject)(nil), "metapb.RenderObject")
-	proto.RegisterType((*RenderAttr)(nil), "metapb.RenderAttr")
-	proto.RegisterType((*API)(nil), "metapb.API")
-	proto.RegisterType((*Condition)(nil), "metapb.Condition")
-	proto.RegisterType((*Routing)(nil), "metapb.Routing")
-	proto.RegisterType((*WebSocketOptions)(nil), "metapb.WebSocketOptions")
-	proto.RegisterType((*System)(nil), "metapb.System")
-	proto.RegisterType((*CountMetric)(nil), "metapb.CountMetric")
-	proto.RegisterEnum("metapb.Status", Status_name, Status_value)
-	proto.RegisterEnum("metapb.CircuitStatus", CircuitStatus_name, CircuitStatus_value)
-	proto.RegisterEnum("metapb.LoadBalance", LoadBalance_name, LoadBalance_value)
-	proto.RegisterEnum("metapb.Protocol", Protocol_name, Protocol_value)
-	proto.RegisterEnum("metapb.Source", Source_name, Source_value)
-	proto.RegisterEnum("metapb.RuleType", RuleType_name, RuleType_value)
-	proto.RegisterEnum("metapb.CMP", CMP_name, CMP_value)
-	proto.RegisterEnum("metapb.RoutingStrategy", RoutingStrategy_name, RoutingStrategy_value)
-	proto.RegisterEnum("metapb.MatchRule", MatchRule_name, MatchRule_value)
+// DispatchNode is the request forward to
+type DispatchNode struct {
+	ClusterID        uint64           `protobuf:"varint,1,opt,name=clusterID" json:"clusterID"`
+	URLRewrite       string           `protobuf:"bytes,2,opt,name=urlRewrite" json:"urlRewrite"`
+	AttrName         string           `protobuf:"bytes,3,opt,name=attrName" json:"attrName"`
+	Validations      []*Validation    `protobuf:"bytes,4,rep,name=validations" json:"validations,omitempty"`
+	Cache            *Cache           `protobuf:"bytes,5,opt,name=cache" json:"cache,omitempty"`
+	DefaultValue     *HTTPResult      `protobuf:"bytes,6,opt,name=defaultValue" json:"defaultValue,omitempty"`
+	UseDefault       bool             `protobuf:"varint,7,opt,name=useDefault" json:"useDefault"`
+	BatchIndex       int32            `protobuf:"varint,8,opt,name=batchIndex" json:"batchIndex"`
+	RetryStrategy    *RetryStrategy   `protobuf:"bytes,9,opt,name=retryStrategy" json:"retryStrategy,omitempty"`
+	WriteTimeout     int64            `protobuf:"varint,10,opt,name=writeTimeout" json:"writeTimeout"`
+	ReadTimeout      int64            `protobuf:"varint,11,opt,name=readTimeout" json:"readTimeout"`
+	Affinity         *Parameter       `protobuf:"bytes,12,opt,name=affinity" json:"affinity,omitempty"`
+	GRPCTranscoding  *GRPCTranscoding `protobuf:"bytes,13,opt,name=grpcTranscoding" json:"grpcTranscoding,omitempty"`
+	XXX_unrecognized []byte           `json:"-"`
 }
-func (m *Proxy) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+
+func (m *DispatchNode) Reset()                    { *m = DispatchNode{} }
+func (m *DispatchNode) String() string            { return proto.CompactTextString(m) }
+func (*DispatchNode) ProtoMessage()               {}
+func (*DispatchNode) Descriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{22} }
+
+func (m *DispatchNode) GetClusterID() uint64 {
+	if m != nil {
+		return m.ClusterID
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Proxy) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	dAtA[i] = 0xa
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Addr)))
-	i += copy(dAtA[i:], m.Addr)
-	dAtA[i] = 0x12
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(len(m.AddrRPC)))
-	i += copy(dAtA[i:], m.AddrRPC)
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (m *DispatchNode) GetURLRewrite() string {
+	if m != nil {
+		return m.URLRewrite
 	}
-	return i, nil
+	return ""
 }
 
-func (m *Cluster) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *DispatchNode) GetAttrName() string {
+	if m != nil {
+		return m.AttrName
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Cluster) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(m.ID))
-	dAtA[i] = 0x12
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Name)))
-	i += copy(dAtA[i:], m.Name)
-	dAtA[i] = 0x18
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(m.LoadBalance))
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (m *DispatchNode) GetValidations() []*Validation {
+	if m != nil {
+		return m.Validations
 	}
-	return i, nil
+	return nil
 }
 
-func (m *HeathCheck) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *DispatchNode) GetCache() *Cache {
+	if m != nil {
+		return m.Cache
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *HeathCheck) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	dAtA[i] = 0xa
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Path)))
-	i += copy(dAtA[i:], m.Path)
-	dAtA[i] = 0x12
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Body)))
-	i += copy(dAtA[i:], m.Body)
-	dAtA[i] = 0x18
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(m.CheckInterval))
-	dAtA[i] = 0x20
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(m.Timeout))
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (m *DispatchNode) GetDefaultValue() *HTTPResult {
+	if m != nil {
+		return m.DefaultValue
 	}
-	return i, nil
+	return nil
 }
 
-func (m *CircuitBreaker) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *DispatchNode) GetUseDefault() bool {
+	if m != nil {
+		return m.UseDefault
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *CircuitBreaker) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(m.CloseTimeout))
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(m.HalfTrafficRate))
-	dAtA[i] = 0x18
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(m.RateCheckPeriod))
-	dAtA[i] = 0x20
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(m.FailureRateToClose))
-	dAtA[i] = 0x28
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(m.SucceedRateToOpen))
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (m *DispatchNode) GetBatchIndex() int32 {
+	if m != nil {
+		return m.BatchIndex
 	}
-	return i, nil
+	return 0
 }
 
-func (m *Server) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *DispatchNode) GetRetryStrategy() *RetryStrategy {
+	if m != nil {
+		return m.RetryStrategy
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Server) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(m.ID))
-	dAtA[i] = 0x12
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Addr)))
-	i += copy(dAtA[i:], m.Addr)
-	dAtA[i] = 0x18
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(m.Protocol))
-	dAtA[i] = 0x20
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(m.MaxQPS))
-	if m.HeathCheck != nil {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintMetapb(dAtA, i, uint64(m.HeathCheck.Size()))
-		n1, err := m.HeathCheck.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n1
+func (m *DispatchNode) GetWriteTimeout() int64 {
+	if m != nil {
+		return m.WriteTimeout
 	}
-	if m.CircuitBreaker != nil {
-		dAtA[i] = 0x32
-		i++
-		i = encodeVarintMetapb(dAtA, i, uint64(m.CircuitBreaker.Size()))
-		n2, err := m.CircuitBreaker.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n2
+	return 0
+}
+
+func (m *DispatchNode) GetReadTimeout() int64 {
+	if m != nil {
+		return m.ReadTimeout
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	return 0
+}
+
+func (m *DispatchNode) GetAffinity() *Parameter {
+	if m != nil {
+		return m.Affinity
 	}
-	return i, nil
+	return nil
 }
 
-func (m *Bind) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *DispatchNode) GetGRPCTranscoding() *GRPCTranscoding {
+	if m != nil {
+		return m.GRPCTranscoding
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Bind) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(m.ClusterID))
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(m.ServerID))
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+// GRPCTranscoding transcode the http/json requests of the dispatch node to the unary calls of the grpc
+// method, descriptor is the id of the proto descriptor, method is the full name of the method, e.g.
+// helloworld.Greeter/SayHello
+type GRPCTranscoding struct {
+	DescriptorID     uint64 `protobuf:"varint,1,opt,name=descriptor" json:"descriptor"`
+	Method           string `protobuf:"bytes,2,opt,name=method" json:"method"`
+	XXX_unrecognized []byte `json:"-"`
 }
 
-func (m *PairValue) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *GRPCTranscoding) Reset()                    { *m = GRPCTranscoding{} }
+func (m *GRPCTranscoding) String() string            { return proto.CompactTextString(m) }
+func (*GRPCTranscoding) ProtoMessage()               {}
+func (*GRPCTranscoding) Descriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{23} }
+
+func (m *GRPCTranscoding) GetDescriptorID() uint64 {
+	if m != nil {
+		return m.DescriptorID
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *PairValue) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	dAtA[i] = 0xa
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Name)))
-	i += copy(dAtA[i:], m.Name)
-	dAtA[i] = 0x12
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Value)))
-	i += copy(dAtA[i:], m.Value)
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (m *GRPCTranscoding) GetMethod() string {
+	if m != nil {
+		return m.Method
 	}
-	return i, nil
+	return ""
 }
 
-func (m *IPAccessControl) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// Cache is used for cache api result, only the successful GET responses are cached. deadline is the ttl(secs)
+// of the cached responses, the cached responses are keyed by the path, the query string and the keys,
+// ignoreQuery removes the query string from the key, so only the query values in the keys are keyed
+type Cache struct {
+	Keys             []Parameter `protobuf:"bytes,1,rep,name=keys" json:"keys"`
+	Deadline         uint64      `protobuf:"varint,2,opt,name=deadline" json:"deadline"`
+	Conditions       []Condition `protobuf:"bytes,3,rep,name=conditions" json:"conditions"`
+	IgnoreQuery      bool        `protobuf:"varint,4,opt,name=ignoreQuery" json:"ignoreQuery"`
+	XXX_unrecognized []byte      `json:"-"`
 }
 
-func (m *IPAccessControl) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Whitelist) > 0 {
-		for _, s := range m.Whitelist {
-			dAtA[i] = 0xa
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
-		}
-	}
-	if len(m.Blacklist) > 0 {
-		for _, s := range m.Blacklist {
-			dAtA[i] = 0x12
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
-		}
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (m *Cache) Reset()                    { *m = Cache{} }
+func (m *Cache) String() string            { return proto.CompactTextString(m) }
+func (*Cache) ProtoMessage()               {}
+func (*Cache) Descriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{24} }
+
+func (m *Cache) GetKeys() []Parameter {
+	if m != nil {
+		return m.Keys
 	}
-	return i, nil
+	return nil
 }
 
-func (m *HTTPResult) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *Cache) GetDeadline() uint64 {
+	if m != nil {
+		return m.Deadline
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *HTTPResult) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Body != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintMetapb(dAtA, i, uint64(len(m.Body)))
-		i += copy(dAtA[i:], m.Body)
-	}
-	if len(m.Headers) > 0 {
-		for _, msg := range m.Headers {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintMetapb(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+func (m *Cache) GetConditions() []Condition {
+	if m != nil {
+		return m.Conditions
 	}
-	if len(m.Cookies) > 0 {
-		for _, msg := range m.Cookies {
-			dAtA[i] = 0x1a
-			i++
-			i = encodeVarintMetapb(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+	return nil
+}
+
+func (m *Cache) GetIgnoreQuery() bool {
+	if m != nil {
+		return m.IgnoreQuery
 	}
-	dAtA[i] = 0x20
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(m.Code))
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	return false
+}
+
+// RenderTemplate the template that render to client, the body is a template that executed with the
+// result of the nodes instead of the objects if set, contentType is the content type of the body,
+// default is json
+type RenderTemplate struct {
+	Objects          []*RenderObject `protobuf:"bytes,1,rep,name=objects" json:"objects,omitempty"`
+	Body             string          `protobuf:"bytes,2,opt,name=body" json:"body"`
+	ContentType      string          `protobuf:"bytes,3,opt,name=contentType" json:"contentType"`
+	XXX_unrecognized []byte          `json:"-"`
+}
+
+func (m *RenderTemplate) Reset()                    { *m = RenderTemplate{} }
+func (m *RenderTemplate) String() string            { return proto.CompactTextString(m) }
+func (*RenderTemplate) ProtoMessage()               {}
+func (*RenderTemplate) Descriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{25} }
+
+func (m *RenderTemplate) GetObjects() []*RenderObject {
+	if m != nil {
+		return m.Objects
 	}
-	return i, nil
+	return nil
 }
 
-func (m *Parameter) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *RenderTemplate) GetBody() string {
+	if m != nil {
+		return m.Body
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Parameter) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	dAtA[i] = 0xa
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Name)))
-	i += copy(dAtA[i:], m.Name)
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(m.Source))
-	dAtA[i] = 0x18
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(m.Index))
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (m *RenderTemplate) GetContentType() string {
+	if m != nil {
+		return m.ContentType
 	}
-	return i, nil
+	return ""
 }
 
-func (m *ValidationRule) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+// RenderObject the object in the render template
+type RenderObject struct {
+	Name             string        `protobuf:"bytes,1,opt,name=name" json:"name"`
+	Attrs            []*RenderAttr `protobuf:"bytes,2,rep,name=attrs" json:"attrs,omitempty"`
+	FlatAttrs        bool          `protobuf:"varint,3,opt,name=flatAttrs" json:"flatAttrs"`
+	XXX_unrecognized []byte        `json:"-"`
+}
+
+func (m *RenderObject) Reset()                    { *m = RenderObject{} }
+func (m *RenderObject) String() string            { return proto.CompactTextString(m) }
+func (*RenderObject) ProtoMessage()               {}
+func (*RenderObject) Descriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{26} }
+
+func (m *RenderObject) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *ValidationRule) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(m.RuleType))
-	dAtA[i] = 0x12
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Expression)))
-	i += copy(dAtA[i:], m.Expression)
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (m *RenderObject) GetAttrs() []*RenderAttr {
+	if m != nil {
+		return m.Attrs
 	}
-	return i, nil
+	return nil
 }
 
-func (m *Validation) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *RenderObject) GetFlatAttrs() bool {
+	if m != nil {
+		return m.FlatAttrs
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *Validation) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	dAtA[i] = 0xa
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(m.Parameter.Size()))
-	n3, err := m.Parameter.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
+// RenderAttr the attr in the render object
+type RenderAttr struct {
+	Name             string `protobuf:"bytes,1,opt,name=name" json:"name"`
+	ExtractExp       string `protobuf:"bytes,2,opt,name=extractExp" json:"extractExp"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *RenderAttr) Reset()                    { *m = RenderAttr{} }
+func (m *RenderAttr) String() string            { return proto.CompactTextString(m) }
+func (*RenderAttr) ProtoMessage()               {}
+func (*RenderAttr) Descriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{27} }
+
+func (m *RenderAttr) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	i += n3
-	dAtA[i] = 0x10
-	i++
-	if m.Required {
-		dAtA[i] = 1
-	} else {
-		dAtA[i] = 0
+	return ""
+}
+
+func (m *RenderAttr) GetExtractExp() string {
+	if m != nil {
+		return m.ExtractExp
 	}
-	i++
-	if len(m.Rules) > 0 {
-		for _, msg := range m.Rules {
-			dAtA[i] = 0x1a
-			i++
-			i = encodeVarintMetapb(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+	return ""
+}
+
+// API is the api for dispatcher
+type API struct {
+	ID               uint64              `protobuf:"varint,1,opt,name=id" json:"id"`
+	Name             string              `protobuf:"bytes,2,opt,name=name" json:"name"`
+	URLPattern       string              `protobuf:"bytes,3,opt,name=urlPattern" json:"urlPattern"`
+	Method           string              `protobuf:"bytes,4,opt,name=method" json:"method"`
+	Domain           string              `protobuf:"bytes,5,opt,name=domain" json:"domain"`
+	Status           Status              `protobuf:"varint,6,opt,name=status,enum=metapb.Status" json:"status"`
+	IPAccessControl  *IPAccessControl    `protobuf:"bytes,7,opt,name=ipAccessControl" json:"ipAccessControl,omitempty"`
+	DefaultValue     *HTTPResult         `protobuf:"bytes,8,opt,name=defaultValue" json:"defaultValue,omitempty"`
+	Nodes            []*DispatchNode     `protobuf:"bytes,9,rep,name=nodes" json:"nodes,omitempty"`
+	Perms            []string            `protobuf:"bytes,10,rep,name=perms" json:"perms,omitempty"`
+	AuthFilter       string              `protobuf:"bytes,11,opt,name=authFilter" json:"authFilter"`
+	RenderTemplate   *RenderTemplate     `protobuf:"bytes,12,opt,name=renderTemplate" json:"renderTemplate,omitempty"`
+	UseDefault       bool                `protobuf:"varint,13,opt,name=useDefault" json:"useDefault"`
+	MatchRule        MatchRule           `protobuf:"varint,14,opt,name=matchRule,enum=metapb.MatchRule" json:"matchRule"`
+	Position         uint32              `protobuf:"varint,15,opt,name=position" json:"position"`
+	Tags             []*PairValue        `protobuf:"bytes,16,rep,name=tags" json:"tags,omitempty"`
+	WebSocketOptions *WebSocketOptions   `protobuf:"bytes,17,opt,name=webSocketOptions" json:"webSocketOptions,omitempty"`
+	MaxQPS           int64               `protobuf:"varint,18,opt,name=maxQPS" json:"maxQPS"`
+	CircuitBreaker   *CircuitBreaker     `protobuf:"bytes,19,opt,name=circuitBreaker" json:"circuitBreaker,omitempty"`
+	Template         uint64              `protobuf:"varint,20,opt,name=template" json:"template"`
+	ErrorPages       []*ErrorPage        `protobuf:"bytes,21,rep,name=errorPages" json:"errorPages,omitempty"`
+	Deprecation      *Deprecation        `protobuf:"bytes,22,opt,name=deprecation" json:"deprecation,omitempty"`
+	Aliases          []*APIAlias         `protobuf:"bytes,23,rep,name=aliases" json:"aliases,omitempty"`
+	RequestSchema    *RequestSchema      `protobuf:"bytes,24,opt,name=requestSchema" json:"requestSchema,omitempty"`
+	ResponseSchema   *ResponseSchema     `protobuf:"bytes,25,opt,name=responseSchema" json:"responseSchema,omitempty"`
+	GraphQL          *GraphQLOptions     `protobuf:"bytes,26,opt,name=graphQL" json:"graphQL,omitempty"`
+	AccessPolicy     *AccessPolicy       `protobuf:"bytes,27,opt,name=accessPolicy" json:"accessPolicy,omitempty"`
+	UpstreamHost     *UpstreamHost       `protobuf:"bytes,28,opt,name=upstreamHost" json:"upstreamHost,omitempty"`
+	Portal           *PortalOptions      `protobuf:"bytes,29,opt,name=portal" json:"portal,omitempty"`
+	PublishState     PublishState        `protobuf:"varint,30,opt,name=publishState,enum=metapb.PublishState" json:"publishState"`
+	Preview          *PreviewOptions     `protobuf:"bytes,31,opt,name=preview" json:"preview,omitempty"`
+	HeaderLimits     *HeaderLimits       `protobuf:"bytes,32,opt,name=headerLimits" json:"headerLimits,omitempty"`
+	RequestBody      *RequestBodyPolicy  `protobuf:"bytes,33,opt,name=requestBody" json:"requestBody,omitempty"`
+	ContentTypes     *ContentTypes       `protobuf:"bytes,34,opt,name=contentTypes" json:"contentTypes,omitempty"`
+	DegradedAttr     string              `protobuf:"bytes,35,opt,name=degradedAttr" json:"degradedAttr"`
+	Constants        []PairValue         `protobuf:"bytes,36,rep,name=constants" json:"constants"`
+	AccessLog        *AccessLog          `protobuf:"bytes,37,opt,name=accessLog" json:"accessLog,omitempty"`
+	ProxyGroups      []ProxyGroup        `protobuf:"bytes,38,rep,name=proxyGroups" json:"proxyGroups"`
+	SecurityHeaders  *SecurityHeaders    `protobuf:"bytes,39,opt,name=securityHeaders" json:"securityHeaders,omitempty"`
+	Approval         *Approval           `protobuf:"bytes,40,opt,name=approval" json:"approval,omitempty"`
+	Cache            *Cache              `protobuf:"bytes,41,opt,name=cache" json:"cache,omitempty"`
+	Debug            bool                `protobuf:"varint,42,opt,name=debug" json:"debug"`
+	Mirror           *Mirror             `protobuf:"bytes,43,opt,name=mirror" json:"mirror,omitempty"`
+	ReadTimeout      int64               `protobuf:"varint,44,opt,name=readTimeout" json:"readTimeout"`
+	WriteTimeout     int64               `protobuf:"varint,45,opt,name=writeTimeout" json:"writeTimeout"`
+	Timeout          int64               `protobuf:"varint,46,opt,name=timeout" json:"timeout"`
+	HeaderTransform  *HeaderTransform    `protobuf:"bytes,47,opt,name=headerTransform" json:"headerTransform,omitempty"`
+	Visibility       Visibility          `protobuf:"varint,48,opt,name=visibility,enum=metapb.Visibility" json:"visibility"`
+	PathRewrite      *PathRewrite        `protobuf:"bytes,49,opt,name=pathRewrite" json:"pathRewrite,omitempty"`
+	ResponseBody     *ResponseBodyPolicy `protobuf:"bytes,50,opt,name=responseBody" json:"responseBody,omitempty"`
+	OIDC             *OIDCPolicy         `protobuf:"bytes,51,opt,name=oidc" json:"oidc,omitempty"`
+	XXX_unrecognized []byte              `json:"-"`
+}
+
+func (m *API) Reset()                    { *m = API{} }
+func (m *API) String() string            { return proto.CompactTextString(m) }
+func (*API) ProtoMessage()               {}
+func (*API) Descriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{28} }
+
+func (m *API) GetID() uint64 {
+	if m != nil {
+		return m.ID
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	return 0
+}
+
+func (m *API) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return i, nil
+	return ""
 }
 
-func (m *RetryStrategy) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *API) GetURLPattern() string {
+	if m != nil {
+		return m.URLPattern
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *RetryStrategy) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(m.Interval))
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(m.MaxTimes))
-	if len(m.Codes) > 0 {
-		for _, num := range m.Codes {
-			dAtA[i] = 0x18
-			i++
-			i = encodeVarintMetapb(dAtA, i, uint64(num))
-		}
+func (m *API) GetMethod() string {
+	if m != nil {
+		return m.Method
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	return ""
+}
+
+func (m *API) GetDomain() string {
+	if m != nil {
+		return m.Domain
 	}
-	return i, nil
+	return ""
 }
 
-func (m *DispatchNode) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *API) GetStatus() Status {
+	if m != nil {
+		return m.Status
 	}
-	return dAtA[:n], nil
+	return Down
 }
 
-func (m *DispatchNode) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(m.ClusterID))
-	dAtA[i] = 0x12
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(len(m.URLRewrite)))
-	i += copy(dAtA[i:], m.URLRewrite)
-	dAtA[i] = 0x1a
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(len(m.AttrName)))
-	i += copy(dAtA[i:], m.AttrName)
-	if len(m.Validations) > 0 {
-		for _, msg := range m.Validations {
-			dAtA[i] = 0x22
-			i++
-			i = encodeVarintMetapb(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+func (m *API) GetIPAccessControl() *IPAccessControl {
+	if m != nil {
+		return m.IPAccessControl
 	}
-	if m.Cache != nil {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintMetapb(dAtA, i, uint64(m.Cache.Size()))
-		n4, err := m.Cache.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n4
+	return nil
+}
+
+func (m *API) GetDefaultValue() *HTTPResult {
+	if m != nil {
+		return m.DefaultValue
 	}
-	if m.DefaultValue != nil {
-		dAtA[i] = 0x32
-		i++
-		i = encodeVarintMetapb(dAtA, i, uint64(m.DefaultValue.Size()))
-		n5, err := m.DefaultValue.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n5
+	return nil
+}
+
+func (m *API) GetNodes() []*DispatchNode {
+	if m != nil {
+		return m.Nodes
 	}
-	dAtA[i] = 0x38
-	i++
-	if m.UseDefault {
-		dAtA[i] = 1
-	} else {
-		dAtA[i] = 0
+	return nil
+}
+
+func (m *API) GetPerms() []string {
+	if m != nil {
+		return m.Perms
 	}
-	i++
-	dAtA[i] = 0x40
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(m.BatchIndex))
-	if m.RetryStrategy != nil {
-		dAtA[i] = 0x4a
-		i++
-		i = encodeVarintMetapb(dAtA, i, uint64(m.RetryStrategy.Size()))
-		n6, err := m.RetryStrategy.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n6
+	return nil
+}
+
+func (m *API) GetAuthFilter() string {
+	if m != nil {
+		return m.AuthFilter
 	}
-	dAtA[i] = 0x50
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(m.WriteTimeout))
-	dAtA[i] = 0x58
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(m.ReadTimeout))
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	return ""
+}
+
+func (m *API) GetRenderTemplate() *RenderTemplate {
+	if m != nil {
+		return m.RenderTemplate
 	}
-	return i, nil
+	return nil
 }
 
-func (m *Cache) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *API) GetUseDefault() bool {
+	if m != nil {
+		return m.UseDefault
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *Cache) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Keys) > 0 {
-		for _, msg := range m.Keys {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintMetapb(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+func (m *API) GetMatchRule() MatchRule {
+	if m != nil {
+		return m.MatchRule
 	}
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(m.Deadline))
-	if len(m.Conditions) > 0 {
-		for _, msg := range m.Conditions {
-			dAtA[i] = 0x1a
-			i++
-			i = encodeVarintMetapb(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	return MatchDefault
+}
+
+func (m *API) GetPosition() uint32 {
+	if m != nil {
+		return m.Position
 	}
-	return i, nil
+	return 0
 }
 
-func (m *RenderTemplate) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *API) GetTags() []*PairValue {
+	if m != nil {
+		return m.Tags
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *RenderTemplate) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Objects) > 0 {
-		for _, msg := range m.Objects {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintMetapb(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+func (m *API) GetWebSocketOptions() *WebSocketOptions {
+	if m != nil {
+		return m.WebSocketOptions
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	return nil
+}
+
+func (m *API) GetMaxQPS() int64 {
+	if m != nil {
+		return m.MaxQPS
 	}
-	return i, nil
+	return 0
 }
 
-func (m *RenderObject) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *API) GetCircuitBreaker() *CircuitBreaker {
+	if m != nil {
+		return m.CircuitBreaker
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *RenderObject) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	dAtA[i] = 0xa
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Name)))
-	i += copy(dAtA[i:], m.Name)
-	if len(m.Attrs) > 0 {
-		for _, msg := range m.Attrs {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintMetapb(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+func (m *API) GetTemplate() uint64 {
+	if m != nil {
+		return m.Template
 	}
-	dAtA[i] = 0x18
-	i++
-	if m.FlatAttrs {
-		dAtA[i] = 1
-	} else {
-		dAtA[i] = 0
+	return 0
+}
+
+func (m *API) GetErrorPages() []*ErrorPage {
+	if m != nil {
+		return m.ErrorPages
 	}
-	i++
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	return nil
+}
+
+func (m *API) GetDeprecation() *Deprecation {
+	if m != nil {
+		return m.Deprecation
 	}
-	return i, nil
+	return nil
 }
 
-func (m *RenderAttr) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *API) GetAliases() []*APIAlias {
+	if m != nil {
+		return m.Aliases
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *RenderAttr) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	dAtA[i] = 0xa
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Name)))
-	i += copy(dAtA[i:], m.Name)
-	dAtA[i] = 0x12
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(len(m.ExtractExp)))
-	i += copy(dAtA[i:], m.ExtractExp)
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (m *API) GetRequestSchema() *RequestSchema {
+	if m != nil {
+		return m.RequestSchema
 	}
-	return i, nil
+	return nil
 }
 
-func (m *API) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *API) GetResponseSchema() *ResponseSchema {
+	if m != nil {
+		return m.ResponseSchema
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *API) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(m.ID))
-	dAtA[i] = 0x12
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Name)))
-	i += copy(dAtA[i:], m.Name)
-	dAtA[i] = 0x1a
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(len(m.URLPattern)))
-	i += copy(dAtA[i:], m.URLPattern)
-	dAtA[i] = 0x22
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Method)))
-	i += copy(dAtA[i:], m.Method)
-	dAtA[i] = 0x2a
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Domain)))
-	i += copy(dAtA[i:], m.Domain)
-	dAtA[i] = 0x30
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(m.Status))
-	if m.IPAccessControl != nil {
-		dAtA[i] = 0x3a
-		i++
-		i = encodeVarintMetapb(dAtA, i, uint64(m.IPAccessControl.Size()))
-		n7, err := m.IPAccessControl.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n7
+func (m *API) GetGraphQL() *GraphQLOptions {
+	if m != nil {
+		return m.GraphQL
 	}
-	if m.DefaultValue != nil {
-		dAtA[i] = 0x42
-		i++
-		i = encodeVarintMetapb(dAtA, i, uint64(m.DefaultValue.Size()))
-		n8, err := m.DefaultValue.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n8
+	return nil
+}
+
+func (m *API) GetAccessPolicy() *AccessPolicy {
+	if m != nil {
+		return m.AccessPolicy
 	}
-	if len(m.Nodes) > 0 {
-		for _, msg := range m.Nodes {
-			dAtA[i] = 0x4a
-			i++
-			i = encodeVarintMetapb(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+	return nil
+}
+
+func (m *API) GetUpstreamHost() *UpstreamHost {
+	if m != nil {
+		return m.UpstreamHost
 	}
-	if len(m.Perms) > 0 {
-		for _, s := range m.Perms {
-			dAtA[i] = 0x52
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
-		}
+	return nil
+}
+
+func (m *API) GetPortal() *PortalOptions {
+	if m != nil {
+		return m.Portal
 	}
-	dAtA[i] = 0x5a
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(len(m.AuthFilter)))
-	i += copy(dAtA[i:], m.AuthFilter)
-	if m.RenderTemplate != nil {
-		dAtA[i] = 0x62
-		i++
-		i = encodeVarintMetapb(dAtA, i, uint64(m.RenderTemplate.Size()))
-		n9, err := m.RenderTemplate.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n9
+	return nil
+}
+
+func (m *API) GetPublishState() PublishState {
+	if m != nil {
+		return m.PublishState
 	}
-	dAtA[i] = 0x68
-	i++
-	if m.UseDefault {
-		dAtA[i] = 1
-	} else {
-		dAtA[i] = 0
+	return Published
+}
+
+func (m *API) GetPreview() *PreviewOptions {
+	if m != nil {
+		return m.Preview
 	}
-	i++
-	dAtA[i] = 0x70
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(m.MatchRule))
-	dAtA[i] = 0x78
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(m.Position))
-	if len(m.Tags) > 0 {
-		for _, msg := range m.Tags {
-			dAtA[i] = 0x82
-			i++
-			dAtA[i] = 0x1
-			i++
-			i = encodeVarintMetapb(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+	return nil
+}
+
+func (m *API) GetHeaderLimits() *HeaderLimits {
+	if m != nil {
+		return m.HeaderLimits
 	}
-	if m.WebSocketOptions != nil {
-		dAtA[i] = 0x8a
-		i++
-		dAtA[i] = 0x1
-		i++
-		i = encodeVarintMetapb(dAtA, i, uint64(m.WebSocketOptions.Size()))
-		n10, err := m.WebSocketOptions.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n10
+	return nil
+}
+
+func (m *API) GetRequestBody() *RequestBodyPolicy {
+	if m != nil {
+		return m.RequestBody
 	}
-	dAtA[i] = 0x90
-	i++
-	dAtA[i] = 0x1
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(m.MaxQPS))
-	if m.CircuitBreaker != nil {
-		dAtA[i] = 0x9a
-		i++
-		dAtA[i] = 0x1
-		i++
-		i = encodeVarintMetapb(dAtA, i, uint64(m.CircuitBreaker.Size()))
-		n11, err := m.CircuitBreaker.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n11
+	return nil
+}
+
+func (m *API) GetContentTypes() *ContentTypes {
+	if m != nil {
+		return m.ContentTypes
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	return nil
+}
+
+func (m *API) GetDegradedAttr() string {
+	if m != nil {
+		return m.DegradedAttr
 	}
-	return i, nil
+	return ""
 }
 
-func (m *Condition) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *API) GetConstants() []PairValue {
+	if m != nil {
+		return m.Constants
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Condition) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	dAtA[i] = 0xa
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(m.Parameter.Size()))
-	n12, err := m.Parameter.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
+func (m *API) GetAccessLog() *AccessLog {
+	if m != nil {
+		return m.AccessLog
 	}
-	i += n12
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(m.Cmp))
-	dAtA[i] = 0x1a
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Expect)))
-	i += copy(dAtA[i:], m.Expect)
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	return nil
+}
+
+func (m *API) GetProxyGroups() []ProxyGroup {
+	if m != nil {
+		return m.ProxyGroups
 	}
-	return i, nil
+	return nil
 }
 
-func (m *Routing) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *API) GetSecurityHeaders() *SecurityHeaders {
+	if m != nil {
+		return m.SecurityHeaders
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Routing) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(m.ID))
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(m.ClusterID))
-	if len(m.Conditions) > 0 {
-		for _, msg := range m.Conditions {
-			dAtA[i] = 0x1a
-			i++
-			i = encodeVarintMetapb(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+func (m *API) GetApproval() *Approval {
+	if m != nil {
+		return m.Approval
 	}
-	dAtA[i] = 0x20
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(m.Strategy))
-	dAtA[i] = 0x28
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(m.TrafficRate))
-	dAtA[i] = 0x30
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(m.Status))
-	dAtA[i] = 0x38
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(m.API))
-	dAtA[i] = 0x42
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Name)))
-	i += copy(dAtA[i:], m.Name)
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	return nil
+}
+
+func (m *API) GetCache() *Cache {
+	if m != nil {
+		return m.Cache
 	}
-	return i, nil
+	return nil
 }
 
-func (m *WebSocketOptions) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *API) GetDebug() bool {
+	if m != nil {
+		return m.Debug
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *WebSocketOptions) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	dAtA[i] = 0xa
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Origin)))
-	i += copy(dAtA[i:], m.Origin)
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (m *API) GetMirror() *Mirror {
+	if m != nil {
+		return m.Mirror
 	}
-	return i, nil
+	return nil
 }
 
-func (m *System) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *API) GetReadTimeout() int64 {
+	if m != nil {
+		return m.ReadTimeout
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *System) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	dAtA[i] = 0xa
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(m.Count.Size()))
-	n13, err := m.Count.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
+func (m *API) GetWriteTimeout() int64 {
+	if m != nil {
+		return m.WriteTimeout
 	}
-	i += n13
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	return 0
+}
+
+func (m *API) GetTimeout() int64 {
+	if m != nil {
+		return m.Timeout
 	}
-	return i, nil
+	return 0
 }
 
-func (m *CountMetric) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *API) GetHeaderTransform() *HeaderTransform {
+	if m != nil {
+		return m.HeaderTransform
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *CountMetric) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(m.Cluster))
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(m.Server))
-	dAtA[i] = 0x18
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(m.API))
-	dAtA[i] = 0x20
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(m.Routing))
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (m *API) GetVisibility() Visibility {
+	if m != nil {
+		return m.Visibility
 	}
-	return i, nil
+	return Public
 }
 
-func encodeFixed64Metapb(dAtA []byte, offset int, v uint64) int {
-	dAtA[offset] = uint8(v)
-	dAtA[offset+1] = uint8(v >> 8)
-	dAtA[offset+2] = uint8(v >> 16)
-	dAtA[offset+3] = uint8(v >> 24)
-	dAtA[offset+4] = uint8(v >> 32)
-	dAtA[offset+5] = uint8(v >> 40)
-	dAtA[offset+6] = uint8(v >> 48)
-	dAtA[offset+7] = uint8(v >> 56)
-	return offset + 8
+func (m *API) GetPathRewrite() *PathRewrite {
+	if m != nil {
+		return m.PathRewrite
+	}
+	return nil
 }
-func encodeFixed32Metapb(dAtA []byte, offset int, v uint32) int {
-	dAtA[offset] = uint8(v)
-	dAtA[offset+1] = uint8(v >> 8)
-	dAtA[offset+2] = uint8(v >> 16)
-	dAtA[offset+3] = uint8(v >> 24)
-	return offset + 4
-}
-func encodeVarintMetapb(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+
+func (m *API) GetResponseBody() *ResponseBodyPolicy {
+	if m != nil {
+		return m.ResponseBody
 	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
+	return nil
 }
-func (m *Proxy) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Addr)
-	n += 1 + l + sovMetapb(uint64(l))
-	l = len(m.AddrRPC)
-	n += 1 + l + sovMetapb(uint64(l))
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+
+func (m *API) GetOIDC() *OIDCPolicy {
+	if m != nil {
+		return m.OIDC
 	}
-	return n
+	return nil
 }
 
-func (m *Cluster) Size() (n int) {
-	var l int
-	_ = l
-	n += 1 + sovMetapb(uint64(m.ID))
-	l = len(m.Name)
-	n += 1 + l + sovMetapb(uint64(l))
-	n += 1 + sovMetapb(uint64(m.LoadBalance))
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+// PathRewrite rewrite the path of the requests to the nodes that have no urlRewrite, in order: stripPrefix
+// is removed from the path, the path that matches the regexp pattern is replaced by the replacement that
+// can reference the capture groups, e.g. $1, and addPrefix is added. The query string is kept
+type PathRewrite struct {
+	StripPrefix      string `protobuf:"bytes,1,opt,name=stripPrefix" json:"stripPrefix"`
+	Pattern          string `protobuf:"bytes,2,opt,name=pattern" json:"pattern"`
+	Replacement      string `protobuf:"bytes,3,opt,name=replacement" json:"replacement"`
+	AddPrefix        string `protobuf:"bytes,4,opt,name=addPrefix" json:"addPrefix"`
+	XXX_unrecognized []byte `json:"-"`
 }
 
-func (m *HeathCheck) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Path)
-	n += 1 + l + sovMetapb(uint64(l))
-	l = len(m.Body)
-	n += 1 + l + sovMetapb(uint64(l))
-	n += 1 + sovMetapb(uint64(m.CheckInterval))
-	n += 1 + sovMetapb(uint64(m.Timeout))
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *PathRewrite) Reset()                    { *m = PathRewrite{} }
+func (m *PathRewrite) String() string            { return proto.CompactTextString(m) }
+func (*PathRewrite) ProtoMessage()               {}
+func (*PathRewrite) Descriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{29} }
+
+func (m *PathRewrite) GetStripPrefix() string {
+	if m != nil {
+		return m.StripPrefix
 	}
-	return n
+	return ""
 }
 
-func (m *CircuitBreaker) Size() (n int) {
-	var l int
-	_ = l
-	n += 1 + sovMetapb(uint64(m.CloseTimeout))
-	n += 1 + sovMetapb(uint64(m.HalfTrafficRate))
-	n += 1 + sovMetapb(uint64(m.RateCheckPeriod))
-	n += 1 + sovMetapb(uint64(m.FailureRateToClose))
-	n += 1 + sovMetapb(uint64(m.SucceedRateToOpen))
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *PathRewrite) GetPattern() string {
+	if m != nil {
+		return m.Pattern
 	}
-	return n
+	return ""
 }
 
-func (m *Server) Size() (n int) {
-	var l int
-	_ = l
-	n += 1 + sovMetapb(uint64(m.ID))
-	l = len(m.Addr)
-	n += 1 + l + sovMetapb(uint64(l))
-	n += 1 + sovMetapb(uint64(m.Protocol))
-	n += 1 + sovMetapb(uint64(m.MaxQPS))
-	if m.HeathCheck != nil {
-		l = m.HeathCheck.Size()
-		n += 1 + l + sovMetapb(uint64(l))
-	}
-	if m.CircuitBreaker != nil {
-		l = m.CircuitBreaker.Size()
-		n += 1 + l + sovMetapb(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *PathRewrite) GetReplacement() string {
+	if m != nil {
+		return m.Replacement
 	}
-	return n
+	return ""
 }
 
-func (m *Bind) Size() (n int) {
-	var l int
-	_ = l
-	n += 1 + sovMetapb(uint64(m.ClusterID))
-	n += 1 + sovMetapb(uint64(m.ServerID))
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *PathRewrite) GetAddPrefix() string {
+	if m != nil {
+		return m.AddPrefix
 	}
-	return n
+	return ""
 }
 
-func (m *PairValue) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Name)
-	n += 1 + l + sovMetapb(uint64(l))
-	l = len(m.Value)
-	n += 1 + l + sovMetapb(uint64(l))
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+// HeaderTransform is the header rules of the requests that sent to the backends and the responses
+// that returned to the clients
+type HeaderTransform struct {
+	Request          *HeaderRules `protobuf:"bytes,1,opt,name=request" json:"request,omitempty"`
+	Response         *HeaderRules `protobuf:"bytes,2,opt,name=response" json:"response,omitempty"`
+	XXX_unrecognized []byte       `json:"-"`
+}
+
+func (m *HeaderTransform) Reset()                    { *m = HeaderTransform{} }
+func (m *HeaderTransform) String() string            { return proto.CompactTextString(m) }
+func (*HeaderTransform) ProtoMessage()               {}
+func (*HeaderTransform) Descriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{30} }
+
+func (m *HeaderTransform) GetRequest() *HeaderRules {
+	if m != nil {
+		return m.Request
 	}
-	return n
+	return nil
 }
 
-func (m *IPAccessControl) Size() (n int) {
-	var l int
-	_ = l
-	if len(m.Whitelist) > 0 {
-		for _, s := range m.Whitelist {
-			l = len(s)
-			n += 1 + l + sovMetapb(uint64(l))
-		}
+func (m *HeaderTransform) GetResponse() *HeaderRules {
+	if m != nil {
+		return m.Response
 	}
-	if len(m.Blacklist) > 0 {
-		for _, s := range m.Blacklist {
-			l = len(s)
-			n += 1 + l + sovMetapb(uint64(l))
-		}
+	return nil
+}
+
+// HeaderRules is applied in order: remove the headers, rename the headers(name to value), set the
+// headers, set the headers that not exist(defaults), and add the headers. The values are templates
+type HeaderRules struct {
+	Remove           []string    `protobuf:"bytes,1,rep,name=remove" json:"remove,omitempty"`
+	Rename           []PairValue `protobuf:"bytes,2,rep,name=rename" json:"rename"`
+	Set              []PairValue `protobuf:"bytes,3,rep,name=set" json:"set"`
+	Defaults         []PairValue `protobuf:"bytes,4,rep,name=defaults" json:"defaults"`
+	Add              []PairValue `protobuf:"bytes,5,rep,name=add" json:"add"`
+	XXX_unrecognized []byte      `json:"-"`
+}
+
+func (m *HeaderRules) Reset()                    { *m = HeaderRules{} }
+func (m *HeaderRules) String() string            { return proto.CompactTextString(m) }
+func (*HeaderRules) ProtoMessage()               {}
+func (*HeaderRules) Descriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{31} }
+
+func (m *HeaderRules) GetRemove() []string {
+	if m != nil {
+		return m.Remove
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return nil
+}
+
+func (m *HeaderRules) GetRename() []PairValue {
+	if m != nil {
+		return m.Rename
 	}
-	return n
+	return nil
 }
 
-func (m *HTTPResult) Size() (n int) {
-	var l int
-	_ = l
-	if m.Body != nil {
-		l = len(m.Body)
-		n += 1 + l + sovMetapb(uint64(l))
+func (m *HeaderRules) GetSet() []PairValue {
+	if m != nil {
+		return m.Set
 	}
-	if len(m.Headers) > 0 {
-		for _, e := range m.Headers {
-			l = e.Size()
-			n += 1 + l + sovMetapb(uint64(l))
-		}
+	return nil
+}
+
+func (m *HeaderRules) GetDefaults() []PairValue {
+	if m != nil {
+		return m.Defaults
 	}
-	if len(m.Cookies) > 0 {
-		for _, e := range m.Cookies {
-			l = e.Size()
-			n += 1 + l + sovMetapb(uint64(l))
-		}
+	return nil
+}
+
+func (m *HeaderRules) GetAdd() []PairValue {
+	if m != nil {
+		return m.Add
 	}
-	n += 1 + sovMetapb(uint64(m.Code))
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return nil
+}
+
+// Mirror copies the requests of the api to the shadow cluster asynchronously, the responses are
+// discarded. rate is the percent of the mirrored requests, 0 means all
+type Mirror struct {
+	ClusterID        uint64 `protobuf:"varint,1,opt,name=clusterID" json:"clusterID"`
+	Rate             int32  `protobuf:"varint,2,opt,name=rate" json:"rate"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *Mirror) Reset()                    { *m = Mirror{} }
+func (m *Mirror) String() string            { return proto.CompactTextString(m) }
+func (*Mirror) ProtoMessage()               {}
+func (*Mirror) Descriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{32} }
+
+func (m *Mirror) GetClusterID() uint64 {
+	if m != nil {
+		return m.ClusterID
 	}
-	return n
+	return 0
 }
 
-func (m *Parameter) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Name)
-	n += 1 + l + sovMetapb(uint64(l))
-	n += 1 + sovMetapb(uint64(m.Source))
-	n += 1 + sovMetapb(uint64(m.Index))
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *Mirror) GetRate() int32 {
+	if m != nil {
+		return m.Rate
 	}
-	return n
+	return 0
 }
 
-func (m *ValidationRule) Size() (n int) {
-	var l int
-	_ = l
-	n += 1 + sovMetapb(uint64(m.RuleType))
-	l = len(m.Expression)
-	n += 1 + l + sovMetapb(uint64(l))
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+// Approval is the record of the api publish workflow, the times are unix seconds. It is maintained
+// by the api server, the values of the put requests are ignored
+type Approval struct {
+	Submitter        string `protobuf:"bytes,1,opt,name=submitter" json:"submitter"`
+	SubmittedAt      int64  `protobuf:"varint,2,opt,name=submittedAt" json:"submittedAt"`
+	Approver         string `protobuf:"bytes,3,opt,name=approver" json:"approver"`
+	ApprovedAt       int64  `protobuf:"varint,4,opt,name=approvedAt" json:"approvedAt"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *Approval) Reset()                    { *m = Approval{} }
+func (m *Approval) String() string            { return proto.CompactTextString(m) }
+func (*Approval) ProtoMessage()               {}
+func (*Approval) Descriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{33} }
+
+func (m *Approval) GetSubmitter() string {
+	if m != nil {
+		return m.Submitter
 	}
-	return n
+	return ""
 }
 
-func (m *Validation) Size() (n int) {
-	var l int
-	_ = l
-	l = m.Parameter.Size()
-	n += 1 + l + sovMetapb(uint64(l))
-	n += 2
-	if len(m.Rules) > 0 {
-		for _, e := range m.Rules {
-			l = e.Size()
-			n += 1 + l + sovMetapb(uint64(l))
-		}
+func (m *Approval) GetSubmittedAt() int64 {
+	if m != nil {
+		return m.SubmittedAt
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return 0
+}
+
+func (m *Approval) GetApprover() string {
+	if m != nil {
+		return m.Approver
 	}
-	return n
+	return ""
 }
 
-func (m *RetryStrategy) Size() (n int) {
-	var l int
-	_ = l
-	n += 1 + sovMetapb(uint64(m.Interval))
-	n += 1 + sovMetapb(uint64(m.MaxTimes))
-	if len(m.Codes) > 0 {
-		for _, e := range m.Codes {
-			n += 1 + sovMetapb(uint64(e))
-		}
+func (m *Approval) GetApprovedAt() int64 {
+	if m != nil {
+		return m.ApprovedAt
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return 0
+}
+
+// ProxyGroup is the proxies that have all the labels, the api is only loaded by the proxies of
+// the groups, empty means all proxies
+type ProxyGroup struct {
+	Labels           []PairValue `protobuf:"bytes,1,rep,name=labels" json:"labels"`
+	XXX_unrecognized []byte      `json:"-"`
+}
+
+func (m *ProxyGroup) Reset()                    { *m = ProxyGroup{} }
+func (m *ProxyGroup) String() string            { return proto.CompactTextString(m) }
+func (*ProxyGroup) ProtoMessage()               {}
+func (*ProxyGroup) Descriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{34} }
+
+func (m *ProxyGroup) GetLabels() []PairValue {
+	if m != nil {
+		return m.Labels
 	}
-	return n
+	return nil
 }
 
-func (m *DispatchNode) Size() (n int) {
-	var l int
-	_ = l
-	n += 1 + sovMetapb(uint64(m.ClusterID))
-	l = len(m.URLRewrite)
-	n += 1 + l + sovMetapb(uint64(l))
-	l = len(m.AttrName)
-	n += 1 + l + sovMetapb(uint64(l))
-	if len(m.Validations) > 0 {
-		for _, e := range m.Validations {
-			l = e.Size()
-			n += 1 + l + sovMetapb(uint64(l))
-		}
-	}
-	if m.Cache != nil {
-		l = m.Cache.Size()
-		n += 1 + l + sovMetapb(uint64(l))
-	}
-	if m.DefaultValue != nil {
-		l = m.DefaultValue.Size()
-		n += 1 + l + sovMetapb(uint64(l))
-	}
-	n += 2
-	n += 1 + sovMetapb(uint64(m.BatchIndex))
-	if m.RetryStrategy != nil {
-		l = m.RetryStrategy.Size()
-		n += 1 + l + sovMetapb(uint64(l))
-	}
-	n += 1 + sovMetapb(uint64(m.WriteTimeout))
-	n += 1 + sovMetapb(uint64(m.ReadTimeout))
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+// AccessLog is the access log options of the api, disabled disables the access log of the api,
+// sampling is the percentage(1-100) of the logged requests, 0 means using the proxy sampling.
+// fields are the fields of the log, empty means the default fields
+type AccessLog struct {
+	Disabled         bool            `protobuf:"varint,1,opt,name=disabled" json:"disabled"`
+	Sampling         int32           `protobuf:"varint,2,opt,name=sampling" json:"sampling"`
+	Format           AccessLogFormat `protobuf:"varint,3,opt,name=format,enum=metapb.AccessLogFormat" json:"format"`
+	Fields           []string        `protobuf:"bytes,4,rep,name=fields" json:"fields,omitempty"`
+	XXX_unrecognized []byte          `json:"-"`
 }
 
-func (m *Cache) Size() (n int) {
-	var l int
-	_ = l
-	if len(m.Keys) > 0 {
-		for _, e := range m.Keys {
-			l = e.Size()
-			n += 1 + l + sovMetapb(uint64(l))
-		}
-	}
-	n += 1 + sovMetapb(uint64(m.Deadline))
-	if len(m.Conditions) > 0 {
-		for _, e := range m.Conditions {
-			l = e.Size()
-			n += 1 + l + sovMetapb(uint64(l))
-		}
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *AccessLog) Reset()                    { *m = AccessLog{} }
+func (m *AccessLog) String() string            { return proto.CompactTextString(m) }
+func (*AccessLog) ProtoMessage()               {}
+func (*AccessLog) Descriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{35} }
+
+func (m *AccessLog) GetDisabled() bool {
+	if m != nil {
+		return m.Disabled
 	}
-	return n
+	return false
 }
 
-func (m *RenderTemplate) Size() (n int) {
-	var l int
-	_ = l
-	if len(m.Objects) > 0 {
-		for _, e := range m.Objects {
-			l = e.Size()
-			n += 1 + l + sovMetapb(uint64(l))
-		}
+func (m *AccessLog) GetSampling() int32 {
+	if m != nil {
+		return m.Sampling
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return 0
+}
+
+func (m *AccessLog) GetFormat() AccessLogFormat {
+	if m != nil {
+		return m.Format
 	}
-	return n
+	return TextLog
 }
 
-func (m *RenderObject) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Name)
-	n += 1 + l + sovMetapb(uint64(l))
-	if len(m.Attrs) > 0 {
-		for _, e := range m.Attrs {
-			l = e.Size()
-			n += 1 + l + sovMetapb(uint64(l))
-		}
+func (m *AccessLog) GetFields() []string {
+	if m != nil {
+		return m.Fields
 	}
-	n += 2
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return nil
+}
+
+// SecurityHeaders is the security headers that set to the responses, and the headers that
+// revealing the backends that removed from the responses. The policy of the api overrides the
+// fields of the proxy policy that are set, disabled disables the proxy policy for the api.
+// hstsMaxAge is the max-age(seconds) of Strict-Transport-Security, 0 means not set.
+// contentSecurityPolicy is a template, server replaces the Server header of the backends
+type SecurityHeaders struct {
+	Disabled              bool     `protobuf:"varint,1,opt,name=disabled" json:"disabled"`
+	HSTSMaxAge            int64    `protobuf:"varint,2,opt,name=hstsMaxAge" json:"hstsMaxAge"`
+	HSTSIncludeSubDomains bool     `protobuf:"varint,3,opt,name=hstsIncludeSubDomains" json:"hstsIncludeSubDomains"`
+	HSTSPreload           bool     `protobuf:"varint,4,opt,name=hstsPreload" json:"hstsPreload"`
+	NoSniff               bool     `protobuf:"varint,5,opt,name=noSniff" json:"noSniff"`
+	FrameOptions          string   `protobuf:"bytes,6,opt,name=frameOptions" json:"frameOptions"`
+	ContentSecurityPolicy string   `protobuf:"bytes,7,opt,name=contentSecurityPolicy" json:"contentSecurityPolicy"`
+	ReferrerPolicy        string   `protobuf:"bytes,8,opt,name=referrerPolicy" json:"referrerPolicy"`
+	Server                string   `protobuf:"bytes,9,opt,name=server" json:"server"`
+	RemoveHeaders         []string `protobuf:"bytes,10,rep,name=removeHeaders" json:"removeHeaders,omitempty"`
+	XXX_unrecognized      []byte   `json:"-"`
+}
+
+func (m *SecurityHeaders) Reset()                    { *m = SecurityHeaders{} }
+func (m *SecurityHeaders) String() string            { return proto.CompactTextString(m) }
+func (*SecurityHeaders) ProtoMessage()               {}
+func (*SecurityHeaders) Descriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{36} }
+
+func (m *SecurityHeaders) GetDisabled() bool {
+	if m != nil {
+		return m.Disabled
 	}
-	return n
+	return false
 }
 
-func (m *RenderAttr) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Name)
-	n += 1 + l + sovMetapb(uint64(l))
-	l = len(m.ExtractExp)
-	n += 1 + l + sovMetapb(uint64(l))
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *SecurityHeaders) GetHSTSMaxAge() int64 {
+	if m != nil {
+		return m.HSTSMaxAge
 	}
-	return n
+	return 0
 }
 
-func (m *API) Size() (n int) {
-	var l int
-	_ = l
-	n += 1 + sovMetapb(uint64(m.ID))
-	l = len(m.Name)
-	n += 1 + l + sovMetapb(uint64(l))
-	l = len(m.URLPattern)
-	n += 1 + l + sovMetapb(uint64(l))
-	l = len(m.Method)
-	n += 1 + l + sovMetapb(uint64(l))
-	l = len(m.Domain)
-	n += 1 + l + sovMetapb(uint64(l))
-	n += 1 + sovMetapb(uint64(m.Status))
-	if m.IPAccessControl != nil {
-		l = m.IPAccessControl.Size()
-		n += 1 + l + sovMetapb(uint64(l))
+func (m *SecurityHeaders) GetHSTSIncludeSubDomains() bool {
+	if m != nil {
+		return m.HSTSIncludeSubDomains
 	}
-	if m.DefaultValue != nil {
-		l = m.DefaultValue.Size()
-		n += 1 + l + sovMetapb(uint64(l))
+	return false
+}
+
+func (m *SecurityHeaders) GetHSTSPreload() bool {
+	if m != nil {
+		return m.HSTSPreload
 	}
-	if len(m.Nodes) > 0 {
-		for _, e := range m.Nodes {
-			l = e.Size()
-			n += 1 + l + sovMetapb(uint64(l))
-		}
+	return false
+}
+
+func (m *SecurityHeaders) GetNoSniff() bool {
+	if m != nil {
+		return m.NoSniff
 	}
-	if len(m.Perms) > 0 {
-		for _, s := range m.Perms {
-			l = len(s)
-			n += 1 + l + sovMetapb(uint64(l))
-		}
+	return false
+}
+
+func (m *SecurityHeaders) GetFrameOptions() string {
+	if m != nil {
+		return m.FrameOptions
 	}
-	l = len(m.AuthFilter)
-	n += 1 + l + sovMetapb(uint64(l))
-	if m.RenderTemplate != nil {
-		l = m.RenderTemplate.Size()
-		n += 1 + l + sovMetapb(uint64(l))
+	return ""
+}
+
+func (m *SecurityHeaders) GetContentSecurityPolicy() string {
+	if m != nil {
+		return m.ContentSecurityPolicy
 	}
-	n += 2
-	n += 1 + sovMetapb(uint64(m.MatchRule))
-	n += 1 + sovMetapb(uint64(m.Position))
-	if len(m.Tags) > 0 {
-		for _, e := range m.Tags {
-			l = e.Size()
-			n += 2 + l + sovMetapb(uint64(l))
-		}
+	return ""
+}
+
+func (m *SecurityHeaders) GetReferrerPolicy() string {
+	if m != nil {
+		return m.ReferrerPolicy
 	}
-	if m.WebSocketOptions != nil {
-		l = m.WebSocketOptions.Size()
-		n += 2 + l + sovMetapb(uint64(l))
+	return ""
+}
+
+func (m *SecurityHeaders) GetServer() string {
+	if m != nil {
+		return m.Server
 	}
-	n += 2 + sovMetapb(uint64(m.MaxQPS))
-	if m.CircuitBreaker != nil {
-		l = m.CircuitBreaker.Size()
-		n += 2 + l + sovMetapb(uint64(l))
+	return ""
+}
+
+func (m *SecurityHeaders) GetRemoveHeaders() []string {
+	if m != nil {
+		return m.RemoveHeaders
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return nil
+}
+
+// ContentTypes is the media types allowlist of the api, the request that content-type is not in
+// consumes is rejected with 415, the request that accepts none of produces is rejected with 406.
+// The media types support wildcards, e.g. image/*, empty means no limit
+type ContentTypes struct {
+	Consumes         []string `protobuf:"bytes,1,rep,name=consumes" json:"consumes,omitempty"`
+	Produces         []string `protobuf:"bytes,2,rep,name=produces" json:"produces,omitempty"`
+	XXX_unrecognized []byte   `json:"-"`
+}
+
+func (m *ContentTypes) Reset()                    { *m = ContentTypes{} }
+func (m *ContentTypes) String() string            { return proto.CompactTextString(m) }
+func (*ContentTypes) ProtoMessage()               {}
+func (*ContentTypes) Descriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{37} }
+
+func (m *ContentTypes) GetConsumes() []string {
+	if m != nil {
+		return m.Consumes
 	}
-	return n
+	return nil
 }
 
-func (m *Condition) Size() (n int) {
-	var l int
-	_ = l
-	l = m.Parameter.Size()
-	n += 1 + l + sovMetapb(uint64(l))
-	n += 1 + sovMetapb(uint64(m.Cmp))
-	l = len(m.Expect)
-	n += 1 + l + sovMetapb(uint64(l))
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *ContentTypes) GetProduces() []string {
+	if m != nil {
+		return m.Produces
 	}
-	return n
+	return nil
 }
 
-func (m *Routing) Size() (n int) {
-	var l int
-	_ = l
-	n += 1 + sovMetapb(uint64(m.ID))
-	n += 1 + sovMetapb(uint64(m.ClusterID))
-	if len(m.Conditions) > 0 {
-		for _, e := range m.Conditions {
-			l = e.Size()
-			n += 1 + l + sovMetapb(uint64(l))
-		}
+// RequestBodyPolicy is how the proxy buffers the request body, the body larger than maxBytes is
+// rejected. The body larger than maxBufferBytes is spilled to disk and streamed to the upstream
+// if spillToDisk, otherwise rejected. The multipart/form-data body that has a part larger than
+// maxPartBytes is rejected. 0 means no limit
+type RequestBodyPolicy struct {
+	MaxBytes         int64  `protobuf:"varint,1,opt,name=maxBytes" json:"maxBytes"`
+	MaxBufferBytes   int64  `protobuf:"varint,2,opt,name=maxBufferBytes" json:"maxBufferBytes"`
+	SpillToDisk      bool   `protobuf:"varint,3,opt,name=spillToDisk" json:"spillToDisk"`
+	MaxPartBytes     int64  `protobuf:"varint,4,opt,name=maxPartBytes" json:"maxPartBytes"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *RequestBodyPolicy) Reset()                    { *m = RequestBodyPolicy{} }
+func (m *RequestBodyPolicy) String() string            { return proto.CompactTextString(m) }
+func (*RequestBodyPolicy) ProtoMessage()               {}
+func (*RequestBodyPolicy) Descriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{38} }
+
+func (m *RequestBodyPolicy) GetMaxBytes() int64 {
+	if m != nil {
+		return m.MaxBytes
 	}
-	n += 1 + sovMetapb(uint64(m.Strategy))
-	n += 1 + sovMetapb(uint64(m.TrafficRate))
-	n += 1 + sovMetapb(uint64(m.Status))
-	n += 1 + sovMetapb(uint64(m.API))
-	l = len(m.Name)
-	n += 1 + l + sovMetapb(uint64(l))
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return 0
+}
+
+func (m *RequestBodyPolicy) GetMaxBufferBytes() int64 {
+	if m != nil {
+		return m.MaxBufferBytes
 	}
-	return n
+	return 0
 }
 
-func (m *WebSocketOptions) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Origin)
-	n += 1 + l + sovMetapb(uint64(l))
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *RequestBodyPolicy) GetSpillToDisk() bool {
+	if m != nil {
+		return m.SpillToDisk
 	}
-	return n
+	return false
 }
 
-func (m *System) Size() (n int) {
-	var l int
-	_ = l
-	l = m.Count.Size()
-	n += 1 + l + sovMetapb(uint64(l))
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *RequestBodyPolicy) GetMaxPartBytes() int64 {
+	if m != nil {
+		return m.MaxPartBytes
 	}
-	return n
+	return 0
+}
+
+// ResponseBodyPolicy is the max bytes of the upstream response body, the larger response is rejected
+// with 502 unless truncate, the truncated response is cut to maxBytes and has the header (default is
+// X-Gateway-Response-Truncated). 0 means no limit
+type ResponseBodyPolicy struct {
+	MaxBytes         int64  `protobuf:"varint,1,opt,name=maxBytes" json:"maxBytes"`
+	Truncate         bool   `protobuf:"varint,2,opt,name=truncate" json:"truncate"`
+	Header           string `protobuf:"bytes,3,opt,name=header" json:"header"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *ResponseBodyPolicy) Reset()                    { *m = ResponseBodyPolicy{} }
+func (m *ResponseBodyPolicy) String() string            { return proto.CompactTextString(m) }
+func (*ResponseBodyPolicy) ProtoMessage()               {}
+func (*ResponseBodyPolicy) Descriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{39} }
+
+func (m *ResponseBodyPolicy) GetMaxBytes() int64 {
+	if m != nil {
+		return m.MaxBytes
+	}
+	return 0
+}
+
+func (m *ResponseBodyPolicy) GetTruncate() bool {
+	if m != nil {
+		return m.Truncate
+	}
+	return false
+}
+
+func (m *ResponseBodyPolicy) GetHeader() string {
+	if m != nil {
+		return m.Header
+	}
+	return ""
+}
+
+// OIDCPolicy is the access token requirements of the api that authenticated by the OIDC filter, the
+// audience overrides the audience of the filter, the token must have all the scopes
+type OIDCPolicy struct {
+	Audience         string   `protobuf:"bytes,1,opt,name=audience" json:"audience"`
+	Scopes           []string `protobuf:"bytes,2,rep,name=scopes" json:"scopes,omitempty"`
+	XXX_unrecognized []byte   `json:"-"`
+}
+
+func (m *OIDCPolicy) Reset()                    { *m = OIDCPolicy{} }
+func (m *OIDCPolicy) String() string            { return proto.CompactTextString(m) }
+func (*OIDCPolicy) ProtoMessage()               {}
+func (*OIDCPolicy) Descriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{40} }
+
+func (m *OIDCPolicy) GetAudience() string {
+	if m != nil {
+		return m.Audience
+	}
+	return ""
+}
+
+func (m *OIDCPolicy) GetScopes() []string {
+	if m != nil {
+		return m.Scopes
+	}
+	return nil
+}
+
+// HeaderLimits is the max count and the max bytes of the request headers and the upstream response
+// headers, the bytes of a header is counted as "name: value\r\n", 0 means no limit
+type HeaderLimits struct {
+	MaxRequestHeaders      int32  `protobuf:"varint,1,opt,name=maxRequestHeaders" json:"maxRequestHeaders"`
+	MaxRequestHeaderBytes  int32  `protobuf:"varint,2,opt,name=maxRequestHeaderBytes" json:"maxRequestHeaderBytes"`
+	MaxResponseHeaders     int32  `protobuf:"varint,3,opt,name=maxResponseHeaders" json:"maxResponseHeaders"`
+	MaxResponseHeaderBytes int32  `protobuf:"varint,4,opt,name=maxResponseHeaderBytes" json:"maxResponseHeaderBytes"`
+	XXX_unrecognized       []byte `json:"-"`
+}
+
+func (m *HeaderLimits) Reset()                    { *m = HeaderLimits{} }
+func (m *HeaderLimits) String() string            { return proto.CompactTextString(m) }
+func (*HeaderLimits) ProtoMessage()               {}
+func (*HeaderLimits) Descriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{41} }
+
+func (m *HeaderLimits) GetMaxRequestHeaders() int32 {
+	if m != nil {
+		return m.MaxRequestHeaders
+	}
+	return 0
+}
+
+func (m *HeaderLimits) GetMaxRequestHeaderBytes() int32 {
+	if m != nil {
+		return m.MaxRequestHeaderBytes
+	}
+	return 0
+}
+
+func (m *HeaderLimits) GetMaxResponseHeaders() int32 {
+	if m != nil {
+		return m.MaxResponseHeaders
+	}
+	return 0
+}
+
+func (m *HeaderLimits) GetMaxResponseHeaderBytes() int32 {
+	if m != nil {
+		return m.MaxResponseHeaderBytes
+	}
+	return 0
+}
+
+// PreviewOptions the draft api is reachable by the requests that have the secret in the header
+// (default is X-Gateway-Preview), or from the ips(ipv4 prefix with *, CIDR or ipv6 address)
+type PreviewOptions struct {
+	Header           string   `protobuf:"bytes,1,opt,name=header" json:"header"`
+	Secret           string   `protobuf:"bytes,2,opt,name=secret" json:"secret"`
+	IPs              []string `protobuf:"bytes,3,rep,name=ips" json:"ips,omitempty"`
+	XXX_unrecognized []byte   `json:"-"`
+}
+
+func (m *PreviewOptions) Reset()                    { *m = PreviewOptions{} }
+func (m *PreviewOptions) String() string            { return proto.CompactTextString(m) }
+func (*PreviewOptions) ProtoMessage()               {}
+func (*PreviewOptions) Descriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{42} }
+
+func (m *PreviewOptions) GetHeader() string {
+	if m != nil {
+		return m.Header
+	}
+	return ""
+}
+
+func (m *PreviewOptions) GetSecret() string {
+	if m != nil {
+		return m.Secret
+	}
+	return ""
+}
+
+func (m *PreviewOptions) GetIPs() []string {
+	if m != nil {
+		return m.IPs
+	}
+	return nil
+}
+
+// PortalOptions publish the api in the developer portal with the description and the doc(markdown),
+// the api requires the api key of the consumers if keyRequired
+type PortalOptions struct {
+	Published        bool   `protobuf:"varint,1,opt,name=published" json:"published"`
+	Description      string `protobuf:"bytes,2,opt,name=description" json:"description"`
+	Doc              string `protobuf:"bytes,3,opt,name=doc" json:"doc"`
+	KeyRequired      bool   `protobuf:"varint,4,opt,name=keyRequired" json:"keyRequired"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *PortalOptions) Reset()                    { *m = PortalOptions{} }
+func (m *PortalOptions) String() string            { return proto.CompactTextString(m) }
+func (*PortalOptions) ProtoMessage()               {}
+func (*PortalOptions) Descriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{43} }
+
+func (m *PortalOptions) GetPublished() bool {
+	if m != nil {
+		return m.Published
+	}
+	return false
+}
+
+func (m *PortalOptions) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *PortalOptions) GetDoc() string {
+	if m != nil {
+		return m.Doc
+	}
+	return ""
+}
+
+func (m *PortalOptions) GetKeyRequired() bool {
+	if m != nil {
+		return m.KeyRequired
+	}
+	return false
+}
+
+// AccessPolicy restrict the api to the time windows and block the clients with anomaly traffic,
+// the clients(ip or the value of the anomaly header) in the overrides are never restricted
+type AccessPolicy struct {
+	TimeWindows      []*TimeWindow `protobuf:"bytes,1,rep,name=timeWindows" json:"timeWindows,omitempty"`
+	Anomaly          *AnomalyRule  `protobuf:"bytes,2,opt,name=anomaly" json:"anomaly,omitempty"`
+	Overrides        []string      `protobuf:"bytes,3,rep,name=overrides" json:"overrides,omitempty"`
+	XXX_unrecognized []byte        `json:"-"`
+}
+
+func (m *AccessPolicy) Reset()                    { *m = AccessPolicy{} }
+func (m *AccessPolicy) String() string            { return proto.CompactTextString(m) }
+func (*AccessPolicy) ProtoMessage()               {}
+func (*AccessPolicy) Descriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{44} }
+
+func (m *AccessPolicy) GetTimeWindows() []*TimeWindow {
+	if m != nil {
+		return m.TimeWindows
+	}
+	return nil
+}
+
+func (m *AccessPolicy) GetAnomaly() *AnomalyRule {
+	if m != nil {
+		return m.Anomaly
+	}
+	return nil
+}
+
+func (m *AccessPolicy) GetOverrides() []string {
+	if m != nil {
+		return m.Overrides
+	}
+	return nil
+}
+
+// TimeWindow the time window that the api is accessible, start and end format is HH:MM,
+// weekdays is 0(sunday) to 6, empty means every day, location is the time zone name, default is local
+type TimeWindow struct {
+	Weekdays         []int32 `protobuf:"varint,1,rep,name=weekdays" json:"weekdays,omitempty"`
+	Start            string  `protobuf:"bytes,2,opt,name=start" json:"start"`
+	End              string  `protobuf:"bytes,3,opt,name=end" json:"end"`
+	Location         string  `protobuf:"bytes,4,opt,name=location" json:"location"`
+	XXX_unrecognized []byte  `json:"-"`
+}
+
+func (m *TimeWindow) Reset()                    { *m = TimeWindow{} }
+func (m *TimeWindow) String() string            { return proto.CompactTextString(m) }
+func (*TimeWindow) ProtoMessage()               {}
+func (*TimeWindow) Descriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{45} }
+
+func (m *TimeWindow) GetWeekdays() []int32 {
+	if m != nil {
+		return m.Weekdays
+	}
+	return nil
+}
+
+func (m *TimeWindow) GetStart() string {
+	if m != nil {
+		return m.Start
+	}
+	return ""
+}
+
+func (m *TimeWindow) GetEnd() string {
+	if m != nil {
+		return m.End
+	}
+	return ""
+}
+
+func (m *TimeWindow) GetLocation() string {
+	if m != nil {
+		return m.Location
+	}
+	return ""
+}
+
+// AnomalyRule block the client for blockSeconds if the qps of the client is greater than multiple
+// times of the baseline qps of the client and greater than minQPS. The client is identified by the
+// header if set, otherwise the client ip
+type AnomalyRule struct {
+	Multiple         int32  `protobuf:"varint,1,opt,name=multiple" json:"multiple"`
+	MinQPS           int64  `protobuf:"varint,2,opt,name=minQPS" json:"minQPS"`
+	BlockSeconds     int64  `protobuf:"varint,3,opt,name=blockSeconds" json:"blockSeconds"`
+	Header           string `protobuf:"bytes,4,opt,name=header" json:"header"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *AnomalyRule) Reset()                    { *m = AnomalyRule{} }
+func (m *AnomalyRule) String() string            { return proto.CompactTextString(m) }
+func (*AnomalyRule) ProtoMessage()               {}
+func (*AnomalyRule) Descriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{46} }
+
+func (m *AnomalyRule) GetMultiple() int32 {
+	if m != nil {
+		return m.Multiple
+	}
+	return 0
+}
+
+func (m *AnomalyRule) GetMinQPS() int64 {
+	if m != nil {
+		return m.MinQPS
+	}
+	return 0
+}
+
+func (m *AnomalyRule) GetBlockSeconds() int64 {
+	if m != nil {
+		return m.BlockSeconds
+	}
+	return 0
+}
+
+func (m *AnomalyRule) GetHeader() string {
+	if m != nil {
+		return m.Header
+	}
+	return ""
+}
+
+// GraphQLOptions the api serve the graphql requests, the root fields are resolved by the resolvers
+// instead of the dispatch nodes, maxDepth and maxComplexity are the limits of the query, 0 means no limit
+type GraphQLOptions struct {
+	MaxDepth         int32              `protobuf:"varint,1,opt,name=maxDepth" json:"maxDepth"`
+	MaxComplexity    int32              `protobuf:"varint,2,opt,name=maxComplexity" json:"maxComplexity"`
+	Resolvers        []*GraphQLResolver `protobuf:"bytes,3,rep,name=resolvers" json:"resolvers,omitempty"`
+	XXX_unrecognized []byte             `json:"-"`
+}
+
+func (m *GraphQLOptions) Reset()                    { *m = GraphQLOptions{} }
+func (m *GraphQLOptions) String() string            { return proto.CompactTextString(m) }
+func (*GraphQLOptions) ProtoMessage()               {}
+func (*GraphQLOptions) Descriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{47} }
+
+func (m *GraphQLOptions) GetMaxDepth() int32 {
+	if m != nil {
+		return m.MaxDepth
+	}
+	return 0
+}
+
+func (m *GraphQLOptions) GetMaxComplexity() int32 {
+	if m != nil {
+		return m.MaxComplexity
+	}
+	return 0
+}
+
+func (m *GraphQLOptions) GetResolvers() []*GraphQLResolver {
+	if m != nil {
+		return m.Resolvers
+	}
+	return nil
+}
+
+// GraphQLResolver resolve a root field by a backend of the cluster, the arguments of the field are
+// used as the $name placeholders of the url, the others are sent by the query string for GET, or
+// by the json body for other methods. extractExp is the path of the field value in the response body
+type GraphQLResolver struct {
+	Operation        GraphQLOperation `protobuf:"varint,1,opt,name=operation,enum=metapb.GraphQLOperation" json:"operation"`
+	Field            string           `protobuf:"bytes,2,opt,name=field" json:"field"`
+	ClusterID        uint64           `protobuf:"varint,3,opt,name=clusterID" json:"clusterID"`
+	Method           string           `protobuf:"bytes,4,opt,name=method" json:"method"`
+	URL              string           `protobuf:"bytes,5,opt,name=url" json:"url"`
+	ExtractExp       string           `protobuf:"bytes,6,opt,name=extractExp" json:"extractExp"`
+	Complexity       int32            `protobuf:"varint,7,opt,name=complexity" json:"complexity"`
+	WriteTimeout     int64            `protobuf:"varint,8,opt,name=writeTimeout" json:"writeTimeout"`
+	ReadTimeout      int64            `protobuf:"varint,9,opt,name=readTimeout" json:"readTimeout"`
+	XXX_unrecognized []byte           `json:"-"`
+}
+
+func (m *GraphQLResolver) Reset()                    { *m = GraphQLResolver{} }
+func (m *GraphQLResolver) String() string            { return proto.CompactTextString(m) }
+func (*GraphQLResolver) ProtoMessage()               {}
+func (*GraphQLResolver) Descriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{48} }
+
+func (m *GraphQLResolver) GetOperation() GraphQLOperation {
+	if m != nil {
+		return m.Operation
+	}
+	return GraphQLQuery
+}
+
+func (m *GraphQLResolver) GetField() string {
+	if m != nil {
+		return m.Field
+	}
+	return ""
+}
+
+func (m *GraphQLResolver) GetClusterID() uint64 {
+	if m != nil {
+		return m.ClusterID
+	}
+	return 0
+}
+
+func (m *GraphQLResolver) GetMethod() string {
+	if m != nil {
+		return m.Method
+	}
+	return ""
+}
+
+func (m *GraphQLResolver) GetURL() string {
+	if m != nil {
+		return m.URL
+	}
+	return ""
+}
+
+func (m *GraphQLResolver) GetExtractExp() string {
+	if m != nil {
+		return m.ExtractExp
+	}
+	return ""
+}
+
+func (m *GraphQLResolver) GetComplexity() int32 {
+	if m != nil {
+		return m.Complexity
+	}
+	return 0
+}
+
+func (m *GraphQLResolver) GetWriteTimeout() int64 {
+	if m != nil {
+		return m.WriteTimeout
+	}
+	return 0
+}
+
+func (m *GraphQLResolver) GetReadTimeout() int64 {
+	if m != nil {
+		return m.ReadTimeout
+	}
+	return 0
+}
+
+// ResponseSchema is the json schema to validate the responses of the backends,
+// sampling is the percent of the responses to validate, 0 means all
+type ResponseSchema struct {
+	Body             string `protobuf:"bytes,1,opt,name=body" json:"body"`
+	Sampling         int32  `protobuf:"varint,2,opt,name=sampling" json:"sampling"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *ResponseSchema) Reset()                    { *m = ResponseSchema{} }
+func (m *ResponseSchema) String() string            { return proto.CompactTextString(m) }
+func (*ResponseSchema) ProtoMessage()               {}
+func (*ResponseSchema) Descriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{49} }
+
+func (m *ResponseSchema) GetBody() string {
+	if m != nil {
+		return m.Body
+	}
+	return ""
+}
+
+func (m *ResponseSchema) GetSampling() int32 {
+	if m != nil {
+		return m.Sampling
+	}
+	return 0
+}
+
+// RequestSchema is the json schemas to validate the requests,
+// query and header are validated as json objects with string values
+type RequestSchema struct {
+	Query            string `protobuf:"bytes,1,opt,name=query" json:"query"`
+	Header           string `protobuf:"bytes,2,opt,name=header" json:"header"`
+	Body             string `protobuf:"bytes,3,opt,name=body" json:"body"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *RequestSchema) Reset()                    { *m = RequestSchema{} }
+func (m *RequestSchema) String() string            { return proto.CompactTextString(m) }
+func (*RequestSchema) ProtoMessage()               {}
+func (*RequestSchema) Descriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{50} }
+
+func (m *RequestSchema) GetQuery() string {
+	if m != nil {
+		return m.Query
+	}
+	return ""
+}
+
+func (m *RequestSchema) GetHeader() string {
+	if m != nil {
+		return m.Header
+	}
+	return ""
+}
+
+func (m *RequestSchema) GetBody() string {
+	if m != nil {
+		return m.Body
+	}
+	return ""
+}
+
+// APIAlias is an additional match rule of the api, the request that matches
+// the alias is dispatched with the same nodes and policies of the api
+type APIAlias struct {
+	URLPattern       string    `protobuf:"bytes,1,opt,name=urlPattern" json:"urlPattern"`
+	Method           string    `protobuf:"bytes,2,opt,name=method" json:"method"`
+	Domain           string    `protobuf:"bytes,3,opt,name=domain" json:"domain"`
+	MatchRule        MatchRule `protobuf:"varint,4,opt,name=matchRule,enum=metapb.MatchRule" json:"matchRule"`
+	XXX_unrecognized []byte    `json:"-"`
+}
+
+func (m *APIAlias) Reset()                    { *m = APIAlias{} }
+func (m *APIAlias) String() string            { return proto.CompactTextString(m) }
+func (*APIAlias) ProtoMessage()               {}
+func (*APIAlias) Descriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{51} }
+
+func (m *APIAlias) GetURLPattern() string {
+	if m != nil {
+		return m.URLPattern
+	}
+	return ""
+}
+
+func (m *APIAlias) GetMethod() string {
+	if m != nil {
+		return m.Method
+	}
+	return ""
+}
+
+func (m *APIAlias) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
+}
+
+func (m *APIAlias) GetMatchRule() MatchRule {
+	if m != nil {
+		return m.MatchRule
+	}
+	return MatchDefault
+}
+
+// Deprecation is the deprecation of the api, the times are unix seconds,
+// deprecatedAt 0 means deprecated now, sunsetAt 0 means no sunset date
+type Deprecation struct {
+	DeprecatedAt       int64  `protobuf:"varint,1,opt,name=deprecatedAt" json:"deprecatedAt"`
+	SunsetAt           int64  `protobuf:"varint,2,opt,name=sunsetAt" json:"sunsetAt"`
+	Link               string `protobuf:"bytes,3,opt,name=link" json:"link"`
+	Warning            string `protobuf:"bytes,4,opt,name=warning" json:"warning"`
+	DisableAfterSunset bool   `protobuf:"varint,5,opt,name=disableAfterSunset" json:"disableAfterSunset"`
+	XXX_unrecognized   []byte `json:"-"`
+}
+
+func (m *Deprecation) Reset()                    { *m = Deprecation{} }
+func (m *Deprecation) String() string            { return proto.CompactTextString(m) }
+func (*Deprecation) ProtoMessage()               {}
+func (*Deprecation) Descriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{52} }
+
+func (m *Deprecation) GetDeprecatedAt() int64 {
+	if m != nil {
+		return m.DeprecatedAt
+	}
+	return 0
+}
+
+func (m *Deprecation) GetSunsetAt() int64 {
+	if m != nil {
+		return m.SunsetAt
+	}
+	return 0
+}
+
+func (m *Deprecation) GetLink() string {
+	if m != nil {
+		return m.Link
+	}
+	return ""
+}
+
+func (m *Deprecation) GetWarning() string {
+	if m != nil {
+		return m.Warning
+	}
+	return ""
+}
+
+func (m *Deprecation) GetDisableAfterSunset() bool {
+	if m != nil {
+		return m.DisableAfterSunset
+	}
+	return false
+}
+
+// Condition is a condition for routing
+type Condition struct {
+	Parameter        Parameter `protobuf:"bytes,1,opt,name=parameter" json:"parameter"`
+	Cmp              CMP       `protobuf:"varint,2,opt,name=cmp,enum=metapb.CMP" json:"cmp"`
+	Expect           string    `protobuf:"bytes,3,opt,name=expect" json:"expect"`
+	XXX_unrecognized []byte    `json:"-"`
+}
+
+func (m *Condition) Reset()                    { *m = Condition{} }
+func (m *Condition) String() string            { return proto.CompactTextString(m) }
+func (*Condition) ProtoMessage()               {}
+func (*Condition) Descriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{53} }
+
+func (m *Condition) GetParameter() Parameter {
+	if m != nil {
+		return m.Parameter
+	}
+	return Parameter{}
+}
+
+func (m *Condition) GetCmp() CMP {
+	if m != nil {
+		return m.Cmp
+	}
+	return CMPEQ
+}
+
+func (m *Condition) GetExpect() string {
+	if m != nil {
+		return m.Expect
+	}
+	return ""
+}
+
+// Routing is a routing
+type Routing struct {
+	ID               uint64          `protobuf:"varint,1,opt,name=id" json:"id"`
+	ClusterID        uint64          `protobuf:"varint,2,opt,name=clusterID" json:"clusterID"`
+	Conditions       []Condition     `protobuf:"bytes,3,rep,name=conditions" json:"conditions"`
+	Strategy         RoutingStrategy `protobuf:"varint,4,opt,name=strategy,enum=metapb.RoutingStrategy" json:"strategy"`
+	TrafficRate      int32           `protobuf:"varint,5,opt,name=trafficRate" json:"trafficRate"`
+	Status           Status          `protobuf:"varint,6,opt,name=status,enum=metapb.Status" json:"status"`
+	API              uint64          `protobuf:"varint,7,opt,name=api" json:"api"`
+	Name             string          `protobuf:"bytes,8,opt,name=name" json:"name"`
+	Tags             []*PairValue    `protobuf:"bytes,9,rep,name=tags" json:"tags,omitempty"`
+	XXX_unrecognized []byte          `json:"-"`
+}
+
+func (m *Routing) Reset()                    { *m = Routing{} }
+func (m *Routing) String() string            { return proto.CompactTextString(m) }
+func (*Routing) ProtoMessage()               {}
+func (*Routing) Descriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{54} }
+
+func (m *Routing) GetID() uint64 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *Routing) GetClusterID() uint64 {
+	if m != nil {
+		return m.ClusterID
+	}
+	return 0
+}
+
+func (m *Routing) GetConditions() []Condition {
+	if m != nil {
+		return m.Conditions
+	}
+	return nil
+}
+
+func (m *Routing) GetStrategy() RoutingStrategy {
+	if m != nil {
+		return m.Strategy
+	}
+	return Copy
+}
+
+func (m *Routing) GetTrafficRate() int32 {
+	if m != nil {
+		return m.TrafficRate
+	}
+	return 0
+}
+
+func (m *Routing) GetStatus() Status {
+	if m != nil {
+		return m.Status
+	}
+	return Down
+}
+
+func (m *Routing) GetAPI() uint64 {
+	if m != nil {
+		return m.API
+	}
+	return 0
+}
+
+func (m *Routing) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Routing) GetTags() []*PairValue {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+// WebSocketOptions websocket options, maxConns is the max concurrent connections of the api,
+// idleTimeout(secs) closes the connections without messages, 0 means using the proxy limits
+type WebSocketOptions struct {
+	Origin           string `protobuf:"bytes,1,opt,name=origin" json:"origin"`
+	MaxConns         int64  `protobuf:"varint,2,opt,name=maxConns" json:"maxConns"`
+	IdleTimeout      int64  `protobuf:"varint,3,opt,name=idleTimeout" json:"idleTimeout"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *WebSocketOptions) Reset()                    { *m = WebSocketOptions{} }
+func (m *WebSocketOptions) String() string            { return proto.CompactTextString(m) }
+func (*WebSocketOptions) ProtoMessage()               {}
+func (*WebSocketOptions) Descriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{55} }
+
+func (m *WebSocketOptions) GetOrigin() string {
+	if m != nil {
+		return m.Origin
+	}
+	return ""
+}
+
+func (m *WebSocketOptions) GetMaxConns() int64 {
+	if m != nil {
+		return m.MaxConns
+	}
+	return 0
+}
+
+func (m *WebSocketOptions) GetIdleTimeout() int64 {
+	if m != nil {
+		return m.IdleTimeout
+	}
+	return 0
+}
+
+// System system
+type System struct {
+	Count            CountMetric `protobuf:"bytes,1,opt,name=count" json:"count"`
+	Revision         int64       `protobuf:"varint,2,opt,name=revision" json:"revision"`
+	XXX_unrecognized []byte      `json:"-"`
+}
+
+func (m *System) Reset()                    { *m = System{} }
+func (m *System) String() string            { return proto.CompactTextString(m) }
+func (*System) ProtoMessage()               {}
+func (*System) Descriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{56} }
+
+func (m *System) GetCount() CountMetric {
+	if m != nil {
+		return m.Count
+	}
+	return CountMetric{}
+}
+
+func (m *System) GetRevision() int64 {
+	if m != nil {
+		return m.Revision
+	}
+	return 0
+}
+
+// CountMetric count metric
+type CountMetric struct {
+	Cluster          int64  `protobuf:"varint,1,opt,name=cluster" json:"cluster"`
+	Server           int64  `protobuf:"varint,2,opt,name=server" json:"server"`
+	API              int64  `protobuf:"varint,3,opt,name=api" json:"api"`
+	Routing          int64  `protobuf:"varint,4,opt,name=Routing" json:"Routing"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *CountMetric) Reset()                    { *m = CountMetric{} }
+func (m *CountMetric) String() string            { return proto.CompactTextString(m) }
+func (*CountMetric) ProtoMessage()               {}
+func (*CountMetric) Descriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{57} }
+
+func (m *CountMetric) GetCluster() int64 {
+	if m != nil {
+		return m.Cluster
+	}
+	return 0
+}
+
+func (m *CountMetric) GetServer() int64 {
+	if m != nil {
+		return m.Server
+	}
+	return 0
+}
+
+func (m *CountMetric) GetAPI() int64 {
+	if m != nil {
+		return m.API
+	}
+	return 0
+}
+
+func (m *CountMetric) GetRouting() int64 {
+	if m != nil {
+		return m.Routing
+	}
+	return 0
+}
+
+// ServerHealth is the backend server health that a proxy seen
+type ServerHealth struct {
+	ServerID         uint64         `protobuf:"varint,1,opt,name=serverID" json:"serverID"`
+	Addr             string         `protobuf:"bytes,2,opt,name=addr" json:"addr"`
+	Proxy            string         `protobuf:"bytes,3,opt,name=proxy" json:"proxy"`
+	Status           HealthStatus   `protobuf:"varint,4,opt,name=status,enum=metapb.HealthStatus" json:"status"`
+	LastCheckAt      int64          `protobuf:"varint,5,opt,name=lastCheckAt" json:"lastCheckAt"`
+	Reason           string         `protobuf:"bytes,6,opt,name=reason" json:"reason"`
+	Score            int32          `protobuf:"varint,7,opt,name=score" json:"score"`
+	LatencyEWMA      int64          `protobuf:"varint,8,opt,name=latencyEWMA" json:"latencyEWMA"`
+	Timeouts         []PhaseTimeout `protobuf:"bytes,9,rep,name=timeouts" json:"timeouts"`
+	Phases           []PhaseLatency `protobuf:"bytes,10,rep,name=phases" json:"phases"`
+	Weight           int32          `protobuf:"varint,11,opt,name=weight" json:"weight"`
+	Circuit          CircuitStatus  `protobuf:"varint,12,opt,name=circuit,enum=metapb.CircuitStatus" json:"circuit"`
+	XXX_unrecognized []byte         `json:"-"`
+}
+
+func (m *ServerHealth) Reset()                    { *m = ServerHealth{} }
+func (m *ServerHealth) String() string            { return proto.CompactTextString(m) }
+func (*ServerHealth) ProtoMessage()               {}
+func (*ServerHealth) Descriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{58} }
+
+func (m *ServerHealth) GetServerID() uint64 {
+	if m != nil {
+		return m.ServerID
+	}
+	return 0
+}
+
+func (m *ServerHealth) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *ServerHealth) GetProxy() string {
+	if m != nil {
+		return m.Proxy
+	}
+	return ""
+}
+
+func (m *ServerHealth) GetStatus() HealthStatus {
+	if m != nil {
+		return m.Status
+	}
+	return Healthy
+}
+
+func (m *ServerHealth) GetLastCheckAt() int64 {
+	if m != nil {
+		return m.LastCheckAt
+	}
+	return 0
+}
+
+func (m *ServerHealth) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *ServerHealth) GetScore() int32 {
+	if m != nil {
+		return m.Score
+	}
+	return 0
+}
+
+func (m *ServerHealth) GetLatencyEWMA() int64 {
+	if m != nil {
+		return m.LatencyEWMA
+	}
+	return 0
+}
+
+func (m *ServerHealth) GetTimeouts() []PhaseTimeout {
+	if m != nil {
+		return m.Timeouts
+	}
+	return nil
+}
+
+func (m *ServerHealth) GetPhases() []PhaseLatency {
+	if m != nil {
+		return m.Phases
+	}
+	return nil
+}
+
+func (m *ServerHealth) GetWeight() int32 {
+	if m != nil {
+		return m.Weight
+	}
+	return 0
+}
+
+func (m *ServerHealth) GetCircuit() CircuitStatus {
+	if m != nil {
+		return m.Circuit
+	}
+	return Open
+}
+
+// PhaseTimeout is the upstream timeouts of the request phase, the phase is dns, connect, write,
+// first_byte or body
+type PhaseTimeout struct {
+	Phase            string `protobuf:"bytes,1,opt,name=phase" json:"phase"`
+	Count            int64  `protobuf:"varint,2,opt,name=count" json:"count"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *PhaseTimeout) Reset()                    { *m = PhaseTimeout{} }
+func (m *PhaseTimeout) String() string            { return proto.CompactTextString(m) }
+func (*PhaseTimeout) ProtoMessage()               {}
+func (*PhaseTimeout) Descriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{59} }
+
+func (m *PhaseTimeout) GetPhase() string {
+	if m != nil {
+		return m.Phase
+	}
+	return ""
+}
+
+func (m *PhaseTimeout) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+// PhaseLatency is the latency EWMA of the upstream request phase, the connect phase is high
+// if the network is slow, the first_byte phase is high if the backend is slow
+type PhaseLatency struct {
+	Phase            string `protobuf:"bytes,1,opt,name=phase" json:"phase"`
+	LatencyEWMA      int64  `protobuf:"varint,2,opt,name=latencyEWMA" json:"latencyEWMA"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *PhaseLatency) Reset()                    { *m = PhaseLatency{} }
+func (m *PhaseLatency) String() string            { return proto.CompactTextString(m) }
+func (*PhaseLatency) ProtoMessage()               {}
+func (*PhaseLatency) Descriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{60} }
+
+func (m *PhaseLatency) GetPhase() string {
+	if m != nil {
+		return m.Phase
+	}
+	return ""
+}
+
+func (m *PhaseLatency) GetLatencyEWMA() int64 {
+	if m != nil {
+		return m.LatencyEWMA
+	}
+	return 0
+}
+
+// FleetServerHealth is the backend server health reconciled by all proxies
+type FleetServerHealth struct {
+	ServerID         uint64         `protobuf:"varint,1,opt,name=serverID" json:"serverID"`
+	Addr             string         `protobuf:"bytes,2,opt,name=addr" json:"addr"`
+	Status           HealthStatus   `protobuf:"varint,3,opt,name=status,enum=metapb.HealthStatus" json:"status"`
+	Proxies          []ServerHealth `protobuf:"bytes,4,rep,name=proxies" json:"proxies"`
+	Score            int32          `protobuf:"varint,5,opt,name=score" json:"score"`
+	XXX_unrecognized []byte         `json:"-"`
+}
+
+func (m *FleetServerHealth) Reset()                    { *m = FleetServerHealth{} }
+func (m *FleetServerHealth) String() string            { return proto.CompactTextString(m) }
+func (*FleetServerHealth) ProtoMessage()               {}
+func (*FleetServerHealth) Descriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{61} }
+
+func (m *FleetServerHealth) GetServerID() uint64 {
+	if m != nil {
+		return m.ServerID
+	}
+	return 0
+}
+
+func (m *FleetServerHealth) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *FleetServerHealth) GetStatus() HealthStatus {
+	if m != nil {
+		return m.Status
+	}
+	return Healthy
+}
+
+func (m *FleetServerHealth) GetProxies() []ServerHealth {
+	if m != nil {
+		return m.Proxies
+	}
+	return nil
+}
+
+func (m *FleetServerHealth) GetScore() int32 {
+	if m != nil {
+		return m.Score
+	}
+	return 0
+}
+
+// HealthTransition is the last heath check status change of the server that the proxy seen, the
+// proxies publish the transitions to the store. at is unix seconds
+type HealthTransition struct {
+	ServerID         uint64 `protobuf:"varint,1,opt,name=serverID" json:"serverID"`
+	Addr             string `protobuf:"bytes,2,opt,name=addr" json:"addr"`
+	Proxy            string `protobuf:"bytes,3,opt,name=proxy" json:"proxy"`
+	From             Status `protobuf:"varint,4,opt,name=from,enum=metapb.Status" json:"from"`
+	To               Status `protobuf:"varint,5,opt,name=to,enum=metapb.Status" json:"to"`
+	Reason           string `protobuf:"bytes,6,opt,name=reason" json:"reason"`
+	At               int64  `protobuf:"varint,7,opt,name=at" json:"at"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *HealthTransition) Reset()                    { *m = HealthTransition{} }
+func (m *HealthTransition) String() string            { return proto.CompactTextString(m) }
+func (*HealthTransition) ProtoMessage()               {}
+func (*HealthTransition) Descriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{62} }
+
+func (m *HealthTransition) GetServerID() uint64 {
+	if m != nil {
+		return m.ServerID
+	}
+	return 0
+}
+
+func (m *HealthTransition) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *HealthTransition) GetProxy() string {
+	if m != nil {
+		return m.Proxy
+	}
+	return ""
+}
+
+func (m *HealthTransition) GetFrom() Status {
+	if m != nil {
+		return m.From
+	}
+	return Down
+}
+
+func (m *HealthTransition) GetTo() Status {
+	if m != nil {
+		return m.To
+	}
+	return Down
+}
+
+func (m *HealthTransition) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *HealthTransition) GetAt() int64 {
+	if m != nil {
+		return m.At
+	}
+	return 0
+}
+
+// StandbyEvent is the standby server promoted or demoted in the cluster on the proxy, active is
+// the up active servers of the cluster when the event happened, at is the unix seconds
+type StandbyEvent struct {
+	ClusterID        uint64 `protobuf:"varint,1,opt,name=clusterID" json:"clusterID"`
+	ServerID         uint64 `protobuf:"varint,2,opt,name=serverID" json:"serverID"`
+	Proxy            string `protobuf:"bytes,3,opt,name=proxy" json:"proxy"`
+	Promoted         bool   `protobuf:"varint,4,opt,name=promoted" json:"promoted"`
+	Active           int32  `protobuf:"varint,5,opt,name=active" json:"active"`
+	At               int64  `protobuf:"varint,6,opt,name=at" json:"at"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *StandbyEvent) Reset()                    { *m = StandbyEvent{} }
+func (m *StandbyEvent) String() string            { return proto.CompactTextString(m) }
+func (*StandbyEvent) ProtoMessage()               {}
+func (*StandbyEvent) Descriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{63} }
+
+func (m *StandbyEvent) GetClusterID() uint64 {
+	if m != nil {
+		return m.ClusterID
+	}
+	return 0
+}
+
+func (m *StandbyEvent) GetServerID() uint64 {
+	if m != nil {
+		return m.ServerID
+	}
+	return 0
+}
+
+func (m *StandbyEvent) GetProxy() string {
+	if m != nil {
+		return m.Proxy
+	}
+	return ""
+}
+
+func (m *StandbyEvent) GetPromoted() bool {
+	if m != nil {
+		return m.Promoted
+	}
+	return false
+}
+
+func (m *StandbyEvent) GetActive() int32 {
+	if m != nil {
+		return m.Active
+	}
+	return 0
+}
+
+func (m *StandbyEvent) GetAt() int64 {
+	if m != nil {
+		return m.At
+	}
+	return 0
+}
+
+// ConfigPropagation is the latest meta change that the proxy applied, revision is the store revision
+// of the change, writeAt and appliedAt are the unix nanos that the change written to the store and
+// applied by the proxy, latency(ns) is the duration between them
+type ConfigPropagation struct {
+	Proxy            string `protobuf:"bytes,1,opt,name=proxy" json:"proxy"`
+	Revision         int64  `protobuf:"varint,2,opt,name=revision" json:"revision"`
+	WriteAt          int64  `protobuf:"varint,3,opt,name=writeAt" json:"writeAt"`
+	AppliedAt        int64  `protobuf:"varint,4,opt,name=appliedAt" json:"appliedAt"`
+	Latency          int64  `protobuf:"varint,5,opt,name=latency" json:"latency"`
+	LatencyEWMA      int64  `protobuf:"varint,6,opt,name=latencyEWMA" json:"latencyEWMA"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *ConfigPropagation) Reset()                    { *m = ConfigPropagation{} }
+func (m *ConfigPropagation) String() string            { return proto.CompactTextString(m) }
+func (*ConfigPropagation) ProtoMessage()               {}
+func (*ConfigPropagation) Descriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{64} }
+
+func (m *ConfigPropagation) GetProxy() string {
+	if m != nil {
+		return m.Proxy
+	}
+	return ""
+}
+
+func (m *ConfigPropagation) GetRevision() int64 {
+	if m != nil {
+		return m.Revision
+	}
+	return 0
+}
+
+func (m *ConfigPropagation) GetWriteAt() int64 {
+	if m != nil {
+		return m.WriteAt
+	}
+	return 0
+}
+
+func (m *ConfigPropagation) GetAppliedAt() int64 {
+	if m != nil {
+		return m.AppliedAt
+	}
+	return 0
+}
+
+func (m *ConfigPropagation) GetLatency() int64 {
+	if m != nil {
+		return m.Latency
+	}
+	return 0
+}
+
+func (m *ConfigPropagation) GetLatencyEWMA() int64 {
+	if m != nil {
+		return m.LatencyEWMA
+	}
+	return 0
+}
+
+// RouteTest is a sample request that dispatched by the proxy without sending any traffic, path is the
+// request uri with the query string, clientIP is the remote ip of the request, default is 127.0.0.1.
+// proxy is the addr of the proxy that dispatches the request, empty means any proxy. internal means
+// the request is from the internal listener
+type RouteTest struct {
+	Proxy            string      `protobuf:"bytes,1,opt,name=proxy" json:"proxy"`
+	Method           string      `protobuf:"bytes,2,opt,name=method" json:"method"`
+	Host             string      `protobuf:"bytes,3,opt,name=host" json:"host"`
+	Path             string      `protobuf:"bytes,4,opt,name=path" json:"path"`
+	Headers          []PairValue `protobuf:"bytes,5,rep,name=headers" json:"headers"`
+	Body             string      `protobuf:"bytes,6,opt,name=body" json:"body"`
+	ClientIP         string      `protobuf:"bytes,7,opt,name=clientIP" json:"clientIP"`
+	Internal         bool        `protobuf:"varint,8,opt,name=internal" json:"internal"`
+	XXX_unrecognized []byte      `json:"-"`
+}
+
+func (m *RouteTest) Reset()                    { *m = RouteTest{} }
+func (m *RouteTest) String() string            { return proto.CompactTextString(m) }
+func (*RouteTest) ProtoMessage()               {}
+func (*RouteTest) Descriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{65} }
+
+func (m *RouteTest) GetProxy() string {
+	if m != nil {
+		return m.Proxy
+	}
+	return ""
+}
+
+func (m *RouteTest) GetMethod() string {
+	if m != nil {
+		return m.Method
+	}
+	return ""
+}
+
+func (m *RouteTest) GetHost() string {
+	if m != nil {
+		return m.Host
+	}
+	return ""
+}
+
+func (m *RouteTest) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *RouteTest) GetHeaders() []PairValue {
+	if m != nil {
+		return m.Headers
+	}
+	return nil
+}
+
+func (m *RouteTest) GetBody() string {
+	if m != nil {
+		return m.Body
+	}
+	return ""
+}
+
+func (m *RouteTest) GetClientIP() string {
+	if m != nil {
+		return m.ClientIP
+	}
+	return ""
+}
+
+func (m *RouteTest) GetInternal() bool {
+	if m != nil {
+		return m.Internal
+	}
+	return false
+}
+
+// RouteTestResult is the dispatch result of the sample request, code is the status code that the proxy
+// returns before forwarding, 0 means the request is forwarded to the nodes, reason is the cause of the code.
+// filters are the filters of the proxy in order, they run for each node
+type RouteTestResult struct {
+	Proxy            string          `protobuf:"bytes,1,opt,name=proxy" json:"proxy"`
+	Code             int32           `protobuf:"varint,2,opt,name=code" json:"code"`
+	Reason           string          `protobuf:"bytes,3,opt,name=reason" json:"reason"`
+	API              uint64          `protobuf:"varint,4,opt,name=api" json:"api"`
+	APIName          string          `protobuf:"bytes,5,opt,name=apiName" json:"apiName"`
+	UseDefault       bool            `protobuf:"varint,6,opt,name=useDefault" json:"useDefault"`
+	Nodes            []RouteTestNode `protobuf:"bytes,7,rep,name=nodes" json:"nodes"`
+	Filters          []string        `protobuf:"bytes,8,rep,name=filters" json:"filters,omitempty"`
+	XXX_unrecognized []byte          `json:"-"`
+}
+
+func (m *RouteTestResult) Reset()                    { *m = RouteTestResult{} }
+func (m *RouteTestResult) String() string            { return proto.CompactTextString(m) }
+func (*RouteTestResult) ProtoMessage()               {}
+func (*RouteTestResult) Descriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{66} }
+
+func (m *RouteTestResult) GetProxy() string {
+	if m != nil {
+		return m.Proxy
+	}
+	return ""
+}
+
+func (m *RouteTestResult) GetCode() int32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *RouteTestResult) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *RouteTestResult) GetAPI() uint64 {
+	if m != nil {
+		return m.API
+	}
+	return 0
+}
+
+func (m *RouteTestResult) GetAPIName() string {
+	if m != nil {
+		return m.APIName
+	}
+	return ""
+}
+
+func (m *RouteTestResult) GetUseDefault() bool {
+	if m != nil {
+		return m.UseDefault
+	}
+	return false
+}
+
+func (m *RouteTestResult) GetNodes() []RouteTestNode {
+	if m != nil {
+		return m.Nodes
+	}
+	return nil
+}
+
+func (m *RouteTestResult) GetFilters() []string {
+	if m != nil {
+		return m.Filters
+	}
+	return nil
+}
+
+// RouteTestNode is the selected server of the dispatch node, routing is the matched routing that changes
+// the cluster, or copies the traffic to copyTo. The selection of the load balance and the traffic rate of
+// the routings are evaluated as a real request, so the result may differ between the tests
+type RouteTestNode struct {
+	ClusterID        uint64 `protobuf:"varint,1,opt,name=clusterID" json:"clusterID"`
+	ClusterName      string `protobuf:"bytes,2,opt,name=clusterName" json:"clusterName"`
+	ServerID         uint64 `protobuf:"varint,3,opt,name=serverID" json:"serverID"`
+	ServerAddr       string `protobuf:"bytes,4,opt,name=serverAddr" json:"serverAddr"`
+	Routing          uint64 `protobuf:"varint,5,opt,name=routing" json:"routing"`
+	RoutingName      string `protobuf:"bytes,6,opt,name=routingName" json:"routingName"`
+	CopyTo           string `protobuf:"bytes,7,opt,name=copyTo" json:"copyTo"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *RouteTestNode) Reset()                    { *m = RouteTestNode{} }
+func (m *RouteTestNode) String() string            { return proto.CompactTextString(m) }
+func (*RouteTestNode) ProtoMessage()               {}
+func (*RouteTestNode) Descriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{67} }
+
+func (m *RouteTestNode) GetClusterID() uint64 {
+	if m != nil {
+		return m.ClusterID
+	}
+	return 0
+}
+
+func (m *RouteTestNode) GetClusterName() string {
+	if m != nil {
+		return m.ClusterName
+	}
+	return ""
+}
+
+func (m *RouteTestNode) GetServerID() uint64 {
+	if m != nil {
+		return m.ServerID
+	}
+	return 0
+}
+
+func (m *RouteTestNode) GetServerAddr() string {
+	if m != nil {
+		return m.ServerAddr
+	}
+	return ""
+}
+
+func (m *RouteTestNode) GetRouting() uint64 {
+	if m != nil {
+		return m.Routing
+	}
+	return 0
+}
+
+func (m *RouteTestNode) GetRoutingName() string {
+	if m != nil {
+		return m.RoutingName
+	}
+	return ""
+}
+
+func (m *RouteTestNode) GetCopyTo() string {
+	if m != nil {
+		return m.CopyTo
+	}
+	return ""
+}
+
+// MetaDiff is the configuration changes between two revisions of the store
+type MetaDiff struct {
+	From             int64        `protobuf:"varint,1,opt,name=from" json:"from"`
+	To               int64        `protobuf:"varint,2,opt,name=to" json:"to"`
+	Changes          []MetaChange `protobuf:"bytes,3,rep,name=changes" json:"changes"`
+	XXX_unrecognized []byte       `json:"-"`
+}
+
+func (m *MetaDiff) Reset()                    { *m = MetaDiff{} }
+func (m *MetaDiff) String() string            { return proto.CompactTextString(m) }
+func (*MetaDiff) ProtoMessage()               {}
+func (*MetaDiff) Descriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{68} }
+
+func (m *MetaDiff) GetFrom() int64 {
+	if m != nil {
+		return m.From
+	}
+	return 0
+}
+
+func (m *MetaDiff) GetTo() int64 {
+	if m != nil {
+		return m.To
+	}
+	return 0
+}
+
+func (m *MetaDiff) GetChanges() []MetaChange {
+	if m != nil {
+		return m.Changes
+	}
+	return nil
+}
+
+// MetaChange is the change of a meta, kind is cluster, server, bind, api, routing, template,
+// override or consumer, the name of the bind is clusterID/serverID
+type MetaChange struct {
+	Kind             string        `protobuf:"bytes,1,opt,name=kind" json:"kind"`
+	ID               uint64        `protobuf:"varint,2,opt,name=id" json:"id"`
+	Name             string        `protobuf:"bytes,3,opt,name=name" json:"name"`
+	Type             ChangeType    `protobuf:"varint,4,opt,name=type,enum=metapb.ChangeType" json:"type"`
+	Fields           []FieldChange `protobuf:"bytes,5,rep,name=fields" json:"fields"`
+	XXX_unrecognized []byte        `json:"-"`
+}
+
+func (m *MetaChange) Reset()                    { *m = MetaChange{} }
+func (m *MetaChange) String() string            { return proto.CompactTextString(m) }
+func (*MetaChange) ProtoMessage()               {}
+func (*MetaChange) Descriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{69} }
+
+func (m *MetaChange) GetKind() string {
+	if m != nil {
+		return m.Kind
+	}
+	return ""
+}
+
+func (m *MetaChange) GetID() uint64 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *MetaChange) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *MetaChange) GetType() ChangeType {
+	if m != nil {
+		return m.Type
+	}
+	return MetaAdded
+}
+
+func (m *MetaChange) GetFields() []FieldChange {
+	if m != nil {
+		return m.Fields
+	}
+	return nil
+}
+
+// FieldChange is the change of a field, path is the json path of the field, e.g. nodes[0].clusterID,
+// the objects and the arrays are json encoded, empty means the field is missing
+type FieldChange struct {
+	Path             string `protobuf:"bytes,1,opt,name=path" json:"path"`
+	From             string `protobuf:"bytes,2,opt,name=from" json:"from"`
+	To               string `protobuf:"bytes,3,opt,name=to" json:"to"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *FieldChange) Reset()                    { *m = FieldChange{} }
+func (m *FieldChange) String() string            { return proto.CompactTextString(m) }
+func (*FieldChange) ProtoMessage()               {}
+func (*FieldChange) Descriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{70} }
+
+func (m *FieldChange) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *FieldChange) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *FieldChange) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+// Changeset is a named group of the admin mutations with the intent of the changes, the changeset is the
+// unit of the rollback and the webhook notification. The times are unix seconds, rollbackRevision is the
+// store revision that the changes are reverted
+type Changeset struct {
+	ID               uint64         `protobuf:"varint,1,opt,name=id" json:"id"`
+	Name             string         `protobuf:"bytes,2,opt,name=name" json:"name"`
+	Description      string         `protobuf:"bytes,3,opt,name=description" json:"description"`
+	Operator         string         `protobuf:"bytes,4,opt,name=operator" json:"operator"`
+	State            ChangesetState `protobuf:"varint,5,opt,name=state,enum=metapb.ChangesetState" json:"state"`
+	CreatedAt        int64          `protobuf:"varint,6,opt,name=createdAt" json:"createdAt"`
+	CommittedAt      int64          `protobuf:"varint,7,opt,name=committedAt" json:"committedAt"`
+	RolledBackAt     int64          `protobuf:"varint,8,opt,name=rolledBackAt" json:"rolledBackAt"`
+	RollbackRevision int64          `protobuf:"varint,9,opt,name=rollbackRevision" json:"rollbackRevision"`
+	Records          []ChangeRecord `protobuf:"bytes,10,rep,name=records" json:"records"`
+	XXX_unrecognized []byte         `json:"-"`
+}
+
+func (m *Changeset) Reset()                    { *m = Changeset{} }
+func (m *Changeset) String() string            { return proto.CompactTextString(m) }
+func (*Changeset) ProtoMessage()               {}
+func (*Changeset) Descriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{71} }
+
+func (m *Changeset) GetID() uint64 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *Changeset) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Changeset) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *Changeset) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
+}
+
+func (m *Changeset) GetState() ChangesetState {
+	if m != nil {
+		return m.State
+	}
+	return ChangesetOpen
+}
+
+func (m *Changeset) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+func (m *Changeset) GetCommittedAt() int64 {
+	if m != nil {
+		return m.CommittedAt
+	}
+	return 0
+}
+
+func (m *Changeset) GetRolledBackAt() int64 {
+	if m != nil {
+		return m.RolledBackAt
+	}
+	return 0
+}
+
+func (m *Changeset) GetRollbackRevision() int64 {
+	if m != nil {
+		return m.RollbackRevision
+	}
+	return 0
+}
+
+func (m *Changeset) GetRecords() []ChangeRecord {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
+// ChangeRecord is an admin mutation of the changeset, the meta changes of the mutation are the changes
+// between the from and the to revisions of the store. path is the route of the admin api, at is unix seconds
+type ChangeRecord struct {
+	Description      string `protobuf:"bytes,1,opt,name=description" json:"description"`
+	Operator         string `protobuf:"bytes,2,opt,name=operator" json:"operator"`
+	Method           string `protobuf:"bytes,3,opt,name=method" json:"method"`
+	Path             string `protobuf:"bytes,4,opt,name=path" json:"path"`
+	From             int64  `protobuf:"varint,5,opt,name=from" json:"from"`
+	To               int64  `protobuf:"varint,6,opt,name=to" json:"to"`
+	At               int64  `protobuf:"varint,7,opt,name=at" json:"at"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *ChangeRecord) Reset()                    { *m = ChangeRecord{} }
+func (m *ChangeRecord) String() string            { return proto.CompactTextString(m) }
+func (*ChangeRecord) ProtoMessage()               {}
+func (*ChangeRecord) Descriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{72} }
+
+func (m *ChangeRecord) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *ChangeRecord) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
+}
+
+func (m *ChangeRecord) GetMethod() string {
+	if m != nil {
+		return m.Method
+	}
+	return ""
+}
+
+func (m *ChangeRecord) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *ChangeRecord) GetFrom() int64 {
+	if m != nil {
+		return m.From
+	}
+	return 0
+}
+
+func (m *ChangeRecord) GetTo() int64 {
+	if m != nil {
+		return m.To
+	}
+	return 0
+}
+
+func (m *ChangeRecord) GetAt() int64 {
+	if m != nil {
+		return m.At
+	}
+	return 0
+}
+
+// ConsistencyReport is the config consistency findings of the store at checkedAt(unix secs)
+type ConsistencyReport struct {
+	CheckedAt        int64           `protobuf:"varint,1,opt,name=checkedAt" json:"checkedAt"`
+	Findings         []ConfigFinding `protobuf:"bytes,2,rep,name=findings" json:"findings"`
+	XXX_unrecognized []byte          `json:"-"`
+}
+
+func (m *ConsistencyReport) Reset()                    { *m = ConsistencyReport{} }
+func (m *ConsistencyReport) String() string            { return proto.CompactTextString(m) }
+func (*ConsistencyReport) ProtoMessage()               {}
+func (*ConsistencyReport) Descriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{73} }
+
+func (m *ConsistencyReport) GetCheckedAt() int64 {
+	if m != nil {
+		return m.CheckedAt
+	}
+	return 0
+}
+
+func (m *ConsistencyReport) GetFindings() []ConfigFinding {
+	if m != nil {
+		return m.Findings
+	}
+	return nil
+}
+
+// ConfigFinding is a problem of the meta, kind is the same as MetaChange,
+// suggestion is how to fix the problem
+type ConfigFinding struct {
+	Type             FindingType `protobuf:"varint,1,opt,name=type,enum=metapb.FindingType" json:"type"`
+	Kind             string      `protobuf:"bytes,2,opt,name=kind" json:"kind"`
+	ID               uint64      `protobuf:"varint,3,opt,name=id" json:"id"`
+	Name             string      `protobuf:"bytes,4,opt,name=name" json:"name"`
+	Message          string      `protobuf:"bytes,5,opt,name=message" json:"message"`
+	Suggestion       string      `protobuf:"bytes,6,opt,name=suggestion" json:"suggestion"`
+	XXX_unrecognized []byte      `json:"-"`
+}
+
+func (m *ConfigFinding) Reset()                    { *m = ConfigFinding{} }
+func (m *ConfigFinding) String() string            { return proto.CompactTextString(m) }
+func (*ConfigFinding) ProtoMessage()               {}
+func (*ConfigFinding) Descriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{74} }
+
+func (m *ConfigFinding) GetType() FindingType {
+	if m != nil {
+		return m.Type
+	}
+	return DanglingReference
+}
+
+func (m *ConfigFinding) GetKind() string {
+	if m != nil {
+		return m.Kind
+	}
+	return ""
+}
+
+func (m *ConfigFinding) GetID() uint64 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *ConfigFinding) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ConfigFinding) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *ConfigFinding) GetSuggestion() string {
+	if m != nil {
+		return m.Suggestion
+	}
+	return ""
+}
+
+// ProxyOverride overrides some configuration on the matched proxies,
+// a proxy is matched if it's addr equals the proxy field, or it has all the labels
+type ProxyOverride struct {
+	ID                uint64         `protobuf:"varint,1,opt,name=id" json:"id"`
+	Name              string         `protobuf:"bytes,2,opt,name=name" json:"name"`
+	Proxy             string         `protobuf:"bytes,3,opt,name=proxy" json:"proxy"`
+	Labels            []PairValue    `protobuf:"bytes,4,rep,name=labels" json:"labels"`
+	EnabledAPIs       []uint64       `protobuf:"varint,5,rep,name=enabledAPIs" json:"enabledAPIs,omitempty"`
+	DisabledAPIs      []uint64       `protobuf:"varint,6,rep,name=disabledAPIs" json:"disabledAPIs,omitempty"`
+	RateLimits        []APIRateLimit `protobuf:"bytes,7,rep,name=rateLimits" json:"rateLimits"`
+	LogLevel          string         `protobuf:"bytes,8,opt,name=logLevel" json:"logLevel"`
+	AccessLogSampling int32          `protobuf:"varint,9,opt,name=accessLogSampling" json:"accessLogSampling"`
+	XXX_unrecognized  []byte         `json:"-"`
+}
+
+func (m *ProxyOverride) Reset()                    { *m = ProxyOverride{} }
+func (m *ProxyOverride) String() string            { return proto.CompactTextString(m) }
+func (*ProxyOverride) ProtoMessage()               {}
+func (*ProxyOverride) Descriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{75} }
+
+func (m *ProxyOverride) GetID() uint64 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *ProxyOverride) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ProxyOverride) GetProxy() string {
+	if m != nil {
+		return m.Proxy
+	}
+	return ""
+}
+
+func (m *ProxyOverride) GetLabels() []PairValue {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+func (m *ProxyOverride) GetEnabledAPIs() []uint64 {
+	if m != nil {
+		return m.EnabledAPIs
+	}
+	return nil
+}
+
+func (m *ProxyOverride) GetDisabledAPIs() []uint64 {
+	if m != nil {
+		return m.DisabledAPIs
+	}
+	return nil
+}
+
+func (m *ProxyOverride) GetRateLimits() []APIRateLimit {
+	if m != nil {
+		return m.RateLimits
+	}
+	return nil
+}
+
+func (m *ProxyOverride) GetLogLevel() string {
+	if m != nil {
+		return m.LogLevel
+	}
+	return ""
+}
+
+func (m *ProxyOverride) GetAccessLogSampling() int32 {
+	if m != nil {
+		return m.AccessLogSampling
+	}
+	return 0
+}
+
+// KillSwitch is the emergency switch of the traffic, it's propagated to the proxies prior to the other meta.
+// apis are disabled at once, global disables all traffic except the allowedAPIs and the allowedIPs,
+// the formats of the allowedIPs are the same as the ip access control. code is the status code of the
+// killed requests, default is 503, body is a template, if body is empty, the error pages are used
+type KillSwitch struct {
+	Global           bool        `protobuf:"varint,1,opt,name=global" json:"global"`
+	APIs             []uint64    `protobuf:"varint,2,rep,name=apis" json:"apis,omitempty"`
+	AllowedAPIs      []uint64    `protobuf:"varint,3,rep,name=allowedAPIs" json:"allowedAPIs,omitempty"`
+	AllowedIPs       []string    `protobuf:"bytes,4,rep,name=allowedIPs" json:"allowedIPs,omitempty"`
+	Code             int32       `protobuf:"varint,5,opt,name=code" json:"code"`
+	ContentType      string      `protobuf:"bytes,6,opt,name=contentType" json:"contentType"`
+	Headers          []PairValue `protobuf:"bytes,7,rep,name=headers" json:"headers"`
+	Body             string      `protobuf:"bytes,8,opt,name=body" json:"body"`
+	Reason           string      `protobuf:"bytes,9,opt,name=reason" json:"reason"`
+	UpdatedAt        int64       `protobuf:"varint,10,opt,name=updatedAt" json:"updatedAt"`
+	XXX_unrecognized []byte      `json:"-"`
+}
+
+func (m *KillSwitch) Reset()                    { *m = KillSwitch{} }
+func (m *KillSwitch) String() string            { return proto.CompactTextString(m) }
+func (*KillSwitch) ProtoMessage()               {}
+func (*KillSwitch) Descriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{76} }
+
+func (m *KillSwitch) GetGlobal() bool {
+	if m != nil {
+		return m.Global
+	}
+	return false
+}
+
+func (m *KillSwitch) GetAPIs() []uint64 {
+	if m != nil {
+		return m.APIs
+	}
+	return nil
+}
+
+func (m *KillSwitch) GetAllowedAPIs() []uint64 {
+	if m != nil {
+		return m.AllowedAPIs
+	}
+	return nil
+}
+
+func (m *KillSwitch) GetAllowedIPs() []string {
+	if m != nil {
+		return m.AllowedIPs
+	}
+	return nil
+}
+
+func (m *KillSwitch) GetCode() int32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *KillSwitch) GetContentType() string {
+	if m != nil {
+		return m.ContentType
+	}
+	return ""
+}
+
+func (m *KillSwitch) GetHeaders() []PairValue {
+	if m != nil {
+		return m.Headers
+	}
+	return nil
+}
+
+func (m *KillSwitch) GetBody() string {
+	if m != nil {
+		return m.Body
+	}
+	return ""
+}
+
+func (m *KillSwitch) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *KillSwitch) GetUpdatedAt() int64 {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return 0
+}
+
+// Consumer is the developer of the portal, name is the identity of the developer.
+// quota is the max requests per day of the consumer on each proxy, 0 means no limit. The requests are
+// counted by each proxy separately, so N proxies allow N * quota requests per day.
+// webhook receives the expiry notifications of the keys, default is the webhook of the api server.
+// The keys of the restricted consumer can only call the allowedAPIs.
+// owner is the developer of the consumer created by the portal, empty means the consumer is created
+// by the admin api, the portal never manages the consumers without owner
+type Consumer struct {
+	ID               uint64   `protobuf:"varint,1,opt,name=id" json:"id"`
+	Name             string   `protobuf:"bytes,2,opt,name=name" json:"name"`
+	Quota            int64    `protobuf:"varint,3,opt,name=quota" json:"quota"`
+	Keys             []APIKey `protobuf:"bytes,4,rep,name=keys" json:"keys"`
+	Webhook          string   `protobuf:"bytes,5,opt,name=webhook" json:"webhook"`
+	RestrictAPIs     bool     `protobuf:"varint,6,opt,name=restrictAPIs" json:"restrictAPIs"`
+	AllowedAPIs      []uint64 `protobuf:"varint,7,rep,name=allowedAPIs" json:"allowedAPIs,omitempty"`
+	Owner            string   `protobuf:"bytes,8,opt,name=owner" json:"owner"`
+	XXX_unrecognized []byte   `json:"-"`
+}
+
+func (m *Consumer) Reset()                    { *m = Consumer{} }
+func (m *Consumer) String() string            { return proto.CompactTextString(m) }
+func (*Consumer) ProtoMessage()               {}
+func (*Consumer) Descriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{77} }
+
+func (m *Consumer) GetID() uint64 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *Consumer) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Consumer) GetQuota() int64 {
+	if m != nil {
+		return m.Quota
+	}
+	return 0
+}
+
+func (m *Consumer) GetKeys() []APIKey {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
+func (m *Consumer) GetWebhook() string {
+	if m != nil {
+		return m.Webhook
+	}
+	return ""
+}
+
+func (m *Consumer) GetRestrictAPIs() bool {
+	if m != nil {
+		return m.RestrictAPIs
+	}
+	return false
+}
+
+func (m *Consumer) GetAllowedAPIs() []uint64 {
+	if m != nil {
+		return m.AllowedAPIs
+	}
+	return nil
+}
+
+func (m *Consumer) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+// APIKey is the api key of the consumer, only the sha256 hash of the key is stored.
+// createdAt, expireAt and notifiedAt are unix seconds, expireAt 0 means never expire,
+// notifiedAt is the last time that the expiry notification is sent
+type APIKey struct {
+	ID               string `protobuf:"bytes,1,opt,name=id" json:"id"`
+	Hash             string `protobuf:"bytes,2,opt,name=hash" json:"hash"`
+	CreatedAt        int64  `protobuf:"varint,3,opt,name=createdAt" json:"createdAt"`
+	ExpireAt         int64  `protobuf:"varint,4,opt,name=expireAt" json:"expireAt"`
+	NotifiedAt       int64  `protobuf:"varint,5,opt,name=notifiedAt" json:"notifiedAt"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *APIKey) Reset()                    { *m = APIKey{} }
+func (m *APIKey) String() string            { return proto.CompactTextString(m) }
+func (*APIKey) ProtoMessage()               {}
+func (*APIKey) Descriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{78} }
+
+func (m *APIKey) GetID() string {
+	if m != nil {
+		return m.ID
+	}
+	return ""
+}
+
+func (m *APIKey) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *APIKey) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+func (m *APIKey) GetExpireAt() int64 {
+	if m != nil {
+		return m.ExpireAt
+	}
+	return 0
+}
+
+func (m *APIKey) GetNotifiedAt() int64 {
+	if m != nil {
+		return m.NotifiedAt
+	}
+	return 0
+}
+
+// ConsumerUsage is the requests of the consumer to the api in the day(yyyy-mm-dd) on the proxy,
+// epoch is the start time of the proxy(unix nanos), the counters are reset when the proxy restarts
+type ConsumerUsage struct {
+	Consumer         uint64 `protobuf:"varint,1,opt,name=consumer" json:"consumer"`
+	Day              string `protobuf:"bytes,2,opt,name=day" json:"day"`
+	Requests         int64  `protobuf:"varint,3,opt,name=requests" json:"requests"`
+	API              uint64 `protobuf:"varint,4,opt,name=api" json:"api"`
+	Epoch            int64  `protobuf:"varint,5,opt,name=epoch" json:"epoch"`
+	Proxy            string `protobuf:"bytes,6,opt,name=proxy" json:"proxy"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *ConsumerUsage) Reset()                    { *m = ConsumerUsage{} }
+func (m *ConsumerUsage) String() string            { return proto.CompactTextString(m) }
+func (*ConsumerUsage) ProtoMessage()               {}
+func (*ConsumerUsage) Descriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{79} }
+
+func (m *ConsumerUsage) GetConsumer() uint64 {
+	if m != nil {
+		return m.Consumer
+	}
+	return 0
+}
+
+func (m *ConsumerUsage) GetDay() string {
+	if m != nil {
+		return m.Day
+	}
+	return ""
+}
+
+func (m *ConsumerUsage) GetRequests() int64 {
+	if m != nil {
+		return m.Requests
+	}
+	return 0
+}
+
+func (m *ConsumerUsage) GetAPI() uint64 {
+	if m != nil {
+		return m.API
+	}
+	return 0
+}
+
+func (m *ConsumerUsage) GetEpoch() int64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *ConsumerUsage) GetProxy() string {
+	if m != nil {
+		return m.Proxy
+	}
+	return ""
+}
+
+// LatencyHeatmap is the latency distribution of the api over time, interval is the duration(secs)
+// of the rows, buckets are the upper bounds(ms) of the latency buckets
+type LatencyHeatmap struct {
+	API              string       `protobuf:"bytes,1,opt,name=api" json:"api"`
+	Interval         int64        `protobuf:"varint,2,opt,name=interval" json:"interval"`
+	Buckets          []float64    `protobuf:"fixed64,3,rep,name=buckets" json:"buckets,omitempty"`
+	Rows             []HeatmapRow `protobuf:"bytes,4,rep,name=rows" json:"rows"`
+	XXX_unrecognized []byte       `json:"-"`
+}
+
+func (m *LatencyHeatmap) Reset()                    { *m = LatencyHeatmap{} }
+func (m *LatencyHeatmap) String() string            { return proto.CompactTextString(m) }
+func (*LatencyHeatmap) ProtoMessage()               {}
+func (*LatencyHeatmap) Descriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{80} }
+
+func (m *LatencyHeatmap) GetAPI() string {
+	if m != nil {
+		return m.API
+	}
+	return ""
+}
+
+func (m *LatencyHeatmap) GetInterval() int64 {
+	if m != nil {
+		return m.Interval
+	}
+	return 0
+}
+
+func (m *LatencyHeatmap) GetBuckets() []float64 {
+	if m != nil {
+		return m.Buckets
+	}
+	return nil
+}
+
+func (m *LatencyHeatmap) GetRows() []HeatmapRow {
+	if m != nil {
+		return m.Rows
+	}
+	return nil
+}
+
+// HeatmapRow is the request counts of the latency buckets in the interval that starts at the
+// time(unix secs), the last count is the requests that exceed the last bucket
+type HeatmapRow struct {
+	Time             int64    `protobuf:"varint,1,opt,name=time" json:"time"`
+	Counts           []uint64 `protobuf:"varint,2,rep,name=counts" json:"counts,omitempty"`
+	XXX_unrecognized []byte   `json:"-"`
+}
+
+func (m *HeatmapRow) Reset()                    { *m = HeatmapRow{} }
+func (m *HeatmapRow) String() string            { return proto.CompactTextString(m) }
+func (*HeatmapRow) ProtoMessage()               {}
+func (*HeatmapRow) Descriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{81} }
+
+func (m *HeatmapRow) GetTime() int64 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
+}
+
+func (m *HeatmapRow) GetCounts() []uint64 {
+	if m != nil {
+		return m.Counts
+	}
+	return nil
+}
+
+// RateLimit is the token bucket rate limit of the apis, the buckets are on each proxy. api is the limited api,
+// 0 means all apis, and each api has its own buckets. key is the client identity of the buckets, LimitByAPI
+// means all clients share a bucket, header is the request header of LimitByHeader, the clients without the
+// api key or the header are keyed by the client ip, LimitByConsumer means the consumer of the api key.
+// The apis are a group that share the buckets, e.g. a daily quota of the reporting apis, api must be 0.
+// The bucket is refilled rate tokens every period seconds(default 1), and holds at most burst
+// tokens(default rate). The first profile whose time window contains the current time replaces the rate,
+// period and burst
+type RateLimit struct {
+	ID               uint64             `protobuf:"varint,1,opt,name=id" json:"id"`
+	Name             string             `protobuf:"bytes,2,opt,name=name" json:"name"`
+	API              uint64             `protobuf:"varint,3,opt,name=api" json:"api"`
+	Key              RateLimitKey       `protobuf:"varint,4,opt,name=key,enum=metapb.RateLimitKey" json:"key"`
+	Header           string             `protobuf:"bytes,5,opt,name=header" json:"header"`
+	Rate             int64              `protobuf:"varint,6,opt,name=rate" json:"rate"`
+	Period           int64              `protobuf:"varint,7,opt,name=period" json:"period"`
+	Burst            int64              `protobuf:"varint,8,opt,name=burst" json:"burst"`
+	Profiles         []RateLimitProfile `protobuf:"bytes,9,rep,name=profiles" json:"profiles"`
+	APIs             []uint64           `protobuf:"varint,10,rep,name=apis" json:"apis,omitempty"`
+	XXX_unrecognized []byte             `json:"-"`
+}
+
+func (m *RateLimit) Reset()                    { *m = RateLimit{} }
+func (m *RateLimit) String() string            { return proto.CompactTextString(m) }
+func (*RateLimit) ProtoMessage()               {}
+func (*RateLimit) Descriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{82} }
+
+func (m *RateLimit) GetID() uint64 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *RateLimit) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *RateLimit) GetAPI() uint64 {
+	if m != nil {
+		return m.API
+	}
+	return 0
+}
+
+func (m *RateLimit) GetKey() RateLimitKey {
+	if m != nil {
+		return m.Key
+	}
+	return LimitByAPI
+}
+
+func (m *RateLimit) GetHeader() string {
+	if m != nil {
+		return m.Header
+	}
+	return ""
+}
+
+func (m *RateLimit) GetRate() int64 {
+	if m != nil {
+		return m.Rate
+	}
+	return 0
+}
+
+func (m *RateLimit) GetPeriod() int64 {
+	if m != nil {
+		return m.Period
+	}
+	return 0
+}
+
+func (m *RateLimit) GetBurst() int64 {
+	if m != nil {
+		return m.Burst
+	}
+	return 0
+}
+
+func (m *RateLimit) GetProfiles() []RateLimitProfile {
+	if m != nil {
+		return m.Profiles
+	}
+	return nil
+}
+
+func (m *RateLimit) GetAPIs() []uint64 {
+	if m != nil {
+		return m.APIs
+	}
+	return nil
+}
+
+// RateLimitProfile is the scheduled rate, period and burst of the rate limit in the time window,
+// period and burst have the same defaults as the rate limit
+type RateLimitProfile struct {
+	Window           TimeWindow `protobuf:"bytes,1,opt,name=window" json:"window"`
+	Rate             int64      `protobuf:"varint,2,opt,name=rate" json:"rate"`
+	Period           int64      `protobuf:"varint,3,opt,name=period" json:"period"`
+	Burst            int64      `protobuf:"varint,4,opt,name=burst" json:"burst"`
+	XXX_unrecognized []byte     `json:"-"`
+}
+
+func (m *RateLimitProfile) Reset()                    { *m = RateLimitProfile{} }
+func (m *RateLimitProfile) String() string            { return proto.CompactTextString(m) }
+func (*RateLimitProfile) ProtoMessage()               {}
+func (*RateLimitProfile) Descriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{83} }
+
+func (m *RateLimitProfile) GetWindow() TimeWindow {
+	if m != nil {
+		return m.Window
+	}
+	return TimeWindow{}
+}
+
+func (m *RateLimitProfile) GetRate() int64 {
+	if m != nil {
+		return m.Rate
+	}
+	return 0
+}
+
+func (m *RateLimitProfile) GetPeriod() int64 {
+	if m != nil {
+		return m.Period
+	}
+	return 0
+}
+
+func (m *RateLimitProfile) GetBurst() int64 {
+	if m != nil {
+		return m.Burst
+	}
+	return 0
+}
+
+// ProtoDescriptor is the uploaded protobuf descriptors of the grpc services, data is the serialized
+// FileDescriptorSet that includes the imports, e.g. protoc --include_imports --descriptor_set_out
+type ProtoDescriptor struct {
+	ID               uint64 `protobuf:"varint,1,opt,name=id" json:"id"`
+	Name             string `protobuf:"bytes,2,opt,name=name" json:"name"`
+	Data             []byte `protobuf:"bytes,3,opt,name=data" json:"data,omitempty"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *ProtoDescriptor) Reset()                    { *m = ProtoDescriptor{} }
+func (m *ProtoDescriptor) String() string            { return proto.CompactTextString(m) }
+func (*ProtoDescriptor) ProtoMessage()               {}
+func (*ProtoDescriptor) Descriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{84} }
+
+func (m *ProtoDescriptor) GetID() uint64 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *ProtoDescriptor) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ProtoDescriptor) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+// APIRateLimit is the max qps of the api
+type APIRateLimit struct {
+	API              uint64 `protobuf:"varint,1,opt,name=api" json:"api"`
+	MaxQPS           int64  `protobuf:"varint,2,opt,name=maxQPS" json:"maxQPS"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *APIRateLimit) Reset()                    { *m = APIRateLimit{} }
+func (m *APIRateLimit) String() string            { return proto.CompactTextString(m) }
+func (*APIRateLimit) ProtoMessage()               {}
+func (*APIRateLimit) Descriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{85} }
+
+func (m *APIRateLimit) GetAPI() uint64 {
+	if m != nil {
+		return m.API
+	}
+	return 0
+}
+
+func (m *APIRateLimit) GetMaxQPS() int64 {
+	if m != nil {
+		return m.MaxQPS
+	}
+	return 0
+}
+
+// APITemplate is the common policy that APIs can inherit from, the API
+// overrides the template if it has the same policy
+type APITemplate struct {
+	ID               uint64           `protobuf:"varint,1,opt,name=id" json:"id"`
+	Name             string           `protobuf:"bytes,2,opt,name=name" json:"name"`
+	IPAccessControl  *IPAccessControl `protobuf:"bytes,3,opt,name=ipAccessControl" json:"ipAccessControl,omitempty"`
+	DefaultValue     *HTTPResult      `protobuf:"bytes,4,opt,name=defaultValue" json:"defaultValue,omitempty"`
+	Perms            []string         `protobuf:"bytes,5,rep,name=perms" json:"perms,omitempty"`
+	AuthFilter       string           `protobuf:"bytes,6,opt,name=authFilter" json:"authFilter"`
+	Tags             []*PairValue     `protobuf:"bytes,7,rep,name=tags" json:"tags,omitempty"`
+	MaxQPS           int64            `protobuf:"varint,8,opt,name=maxQPS" json:"maxQPS"`
+	CircuitBreaker   *CircuitBreaker  `protobuf:"bytes,9,opt,name=circuitBreaker" json:"circuitBreaker,omitempty"`
+	RetryStrategy    *RetryStrategy   `protobuf:"bytes,10,opt,name=retryStrategy" json:"retryStrategy,omitempty"`
+	WriteTimeout     int64            `protobuf:"varint,11,opt,name=writeTimeout" json:"writeTimeout"`
+	ReadTimeout      int64            `protobuf:"varint,12,opt,name=readTimeout" json:"readTimeout"`
+	ErrorPages       []*ErrorPage     `protobuf:"bytes,13,rep,name=errorPages" json:"errorPages,omitempty"`
+	Timeout          int64            `protobuf:"varint,14,opt,name=timeout" json:"timeout"`
+	XXX_unrecognized []byte           `json:"-"`
+}
+
+func (m *APITemplate) Reset()                    { *m = APITemplate{} }
+func (m *APITemplate) String() string            { return proto.CompactTextString(m) }
+func (*APITemplate) ProtoMessage()               {}
+func (*APITemplate) Descriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{86} }
+
+func (m *APITemplate) GetID() uint64 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *APITemplate) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *APITemplate) GetIPAccessControl() *IPAccessControl {
+	if m != nil {
+		return m.IPAccessControl
+	}
+	return nil
+}
+
+func (m *APITemplate) GetDefaultValue() *HTTPResult {
+	if m != nil {
+		return m.DefaultValue
+	}
+	return nil
+}
+
+func (m *APITemplate) GetPerms() []string {
+	if m != nil {
+		return m.Perms
+	}
+	return nil
+}
+
+func (m *APITemplate) GetAuthFilter() string {
+	if m != nil {
+		return m.AuthFilter
+	}
+	return ""
+}
+
+func (m *APITemplate) GetTags() []*PairValue {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func (m *APITemplate) GetMaxQPS() int64 {
+	if m != nil {
+		return m.MaxQPS
+	}
+	return 0
+}
+
+func (m *APITemplate) GetCircuitBreaker() *CircuitBreaker {
+	if m != nil {
+		return m.CircuitBreaker
+	}
+	return nil
+}
+
+func (m *APITemplate) GetRetryStrategy() *RetryStrategy {
+	if m != nil {
+		return m.RetryStrategy
+	}
+	return nil
+}
+
+func (m *APITemplate) GetWriteTimeout() int64 {
+	if m != nil {
+		return m.WriteTimeout
+	}
+	return 0
+}
+
+func (m *APITemplate) GetReadTimeout() int64 {
+	if m != nil {
+		return m.ReadTimeout
+	}
+	return 0
+}
+
+func (m *APITemplate) GetErrorPages() []*ErrorPage {
+	if m != nil {
+		return m.ErrorPages
+	}
+	return nil
+}
+
+func (m *APITemplate) GetTimeout() int64 {
+	if m != nil {
+		return m.Timeout
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Proxy)(nil), "metapb.Proxy")
+	proto.RegisterType((*Cluster)(nil), "metapb.Cluster")
+	proto.RegisterType((*ConnRecycle)(nil), "metapb.ConnRecycle")
+	proto.RegisterType((*Policy)(nil), "metapb.Policy")
+	proto.RegisterType((*RemoteGateway)(nil), "metapb.RemoteGateway")
+	proto.RegisterType((*DNSTarget)(nil), "metapb.DNSTarget")
+	proto.RegisterType((*HalfOpenProbe)(nil), "metapb.HalfOpenProbe")
+	proto.RegisterType((*OutboundAuth)(nil), "metapb.OutboundAuth")
+	proto.RegisterType((*UpstreamHost)(nil), "metapb.UpstreamHost")
+	proto.RegisterType((*HeathCheck)(nil), "metapb.HeathCheck")
+	proto.RegisterType((*CircuitBreaker)(nil), "metapb.CircuitBreaker")
+	proto.RegisterType((*Server)(nil), "metapb.Server")
+	proto.RegisterType((*ServerWeight)(nil), "metapb.ServerWeight")
+	proto.RegisterType((*Bind)(nil), "metapb.Bind")
+	proto.RegisterType((*PairValue)(nil), "metapb.PairValue")
+	proto.RegisterType((*IPAccessControl)(nil), "metapb.IPAccessControl")
+	proto.RegisterType((*HTTPResult)(nil), "metapb.HTTPResult")
+	proto.RegisterType((*ErrorPage)(nil), "metapb.ErrorPage")
+	proto.RegisterType((*Parameter)(nil), "metapb.Parameter")
+	proto.RegisterType((*ValidationRule)(nil), "metapb.ValidationRule")
+	proto.RegisterType((*Validation)(nil), "metapb.Validation")
+	proto.RegisterType((*RetryStrategy)(nil), "metapb.RetryStrategy")
+	proto.RegisterType((*DispatchNode)(nil), "metapb.DispatchNode")
+	proto.RegisterType((*GRPCTranscoding)(nil), "metapb.GRPCTranscoding")
+	proto.RegisterType((*Cache)(nil), "metapb.Cache")
+	proto.RegisterType((*RenderTemplate)(nil), "metapb.RenderTemplate")
+	proto.RegisterType((*RenderObject)(nil), "metapb.RenderObject")
+	proto.RegisterType((*RenderAttr)(nil), "metapb.RenderAttr")
+	proto.RegisterType((*API)(nil), "metapb.API")
+	proto.RegisterType((*PathRewrite)(nil), "metapb.PathRewrite")
+	proto.RegisterType((*HeaderTransform)(nil), "metapb.HeaderTransform")
+	proto.RegisterType((*HeaderRules)(nil), "metapb.HeaderRules")
+	proto.RegisterType((*Mirror)(nil), "metapb.Mirror")
+	proto.RegisterType((*Approval)(nil), "metapb.Approval")
+	proto.RegisterType((*ProxyGroup)(nil), "metapb.ProxyGroup")
+	proto.RegisterType((*AccessLog)(nil), "metapb.AccessLog")
+	proto.RegisterType((*SecurityHeaders)(nil), "metapb.SecurityHeaders")
+	proto.RegisterType((*ContentTypes)(nil), "metapb.ContentTypes")
+	proto.RegisterType((*RequestBodyPolicy)(nil), "metapb.RequestBodyPolicy")
+	proto.RegisterType((*ResponseBodyPolicy)(nil), "metapb.ResponseBodyPolicy")
+	proto.RegisterType((*OIDCPolicy)(nil), "metapb.OIDCPolicy")
+	proto.RegisterType((*HeaderLimits)(nil), "metapb.HeaderLimits")
+	proto.RegisterType((*PreviewOptions)(nil), "metapb.PreviewOptions")
+	proto.RegisterType((*PortalOptions)(nil), "metapb.PortalOptions")
+	proto.RegisterType((*AccessPolicy)(nil), "metapb.AccessPolicy")
+	proto.RegisterType((*TimeWindow)(nil), "metapb.TimeWindow")
+	proto.RegisterType((*AnomalyRule)(nil), "metapb.AnomalyRule")
+	proto.RegisterType((*GraphQLOptions)(nil), "metapb.GraphQLOptions")
+	proto.RegisterType((*GraphQLResolver)(nil), "metapb.GraphQLResolver")
+	proto.RegisterType((*ResponseSchema)(nil), "metapb.ResponseSchema")
+	proto.RegisterType((*RequestSchema)(nil), "metapb.RequestSchema")
+	proto.RegisterType((*APIAlias)(nil), "metapb.APIAlias")
+	proto.RegisterType((*Deprecation)(nil), "metapb.Deprecation")
+	proto.RegisterType((*Condition)(nil), "metapb.Condition")
+	proto.RegisterType((*Routing)(nil), "metapb.Routing")
+	proto.RegisterType((*WebSocketOptions)(nil), "metapb.WebSocketOptions")
+	proto.RegisterType((*System)(nil), "metapb.System")
+	proto.RegisterType((*CountMetric)(nil), "metapb.CountMetric")
+	proto.RegisterType((*ServerHealth)(nil), "metapb.ServerHealth")
+	proto.RegisterType((*PhaseTimeout)(nil), "metapb.PhaseTimeout")
+	proto.RegisterType((*PhaseLatency)(nil), "metapb.PhaseLatency")
+	proto.RegisterType((*FleetServerHealth)(nil), "metapb.FleetServerHealth")
+	proto.RegisterType((*HealthTransition)(nil), "metapb.HealthTransition")
+	proto.RegisterType((*StandbyEvent)(nil), "metapb.StandbyEvent")
+	proto.RegisterType((*ConfigPropagation)(nil), "metapb.ConfigPropagation")
+	proto.RegisterType((*RouteTest)(nil), "metapb.RouteTest")
+	proto.RegisterType((*RouteTestResult)(nil), "metapb.RouteTestResult")
+	proto.RegisterType((*RouteTestNode)(nil), "metapb.RouteTestNode")
+	proto.RegisterType((*MetaDiff)(nil), "metapb.MetaDiff")
+	proto.RegisterType((*MetaChange)(nil), "metapb.MetaChange")
+	proto.RegisterType((*FieldChange)(nil), "metapb.FieldChange")
+	proto.RegisterType((*Changeset)(nil), "metapb.Changeset")
+	proto.RegisterType((*ChangeRecord)(nil), "metapb.ChangeRecord")
+	proto.RegisterType((*ConsistencyReport)(nil), "metapb.ConsistencyReport")
+	proto.RegisterType((*ConfigFinding)(nil), "metapb.ConfigFinding")
+	proto.RegisterType((*ProxyOverride)(nil), "metapb.ProxyOverride")
+	proto.RegisterType((*KillSwitch)(nil), "metapb.KillSwitch")
+	proto.RegisterType((*Consumer)(nil), "metapb.Consumer")
+	proto.RegisterType((*APIKey)(nil), "metapb.APIKey")
+	proto.RegisterType((*ConsumerUsage)(nil), "metapb.ConsumerUsage")
+	proto.RegisterType((*LatencyHeatmap)(nil), "metapb.LatencyHeatmap")
+	proto.RegisterType((*HeatmapRow)(nil), "metapb.HeatmapRow")
+	proto.RegisterType((*RateLimit)(nil), "metapb.RateLimit")
+	proto.RegisterType((*RateLimitProfile)(nil), "metapb.RateLimitProfile")
+	proto.RegisterType((*ProtoDescriptor)(nil), "metapb.ProtoDescriptor")
+	proto.RegisterType((*APIRateLimit)(nil), "metapb.APIRateLimit")
+	proto.RegisterType((*APITemplate)(nil), "metapb.APITemplate")
+	proto.RegisterEnum("metapb.Status", Status_name, Status_value)
+	proto.RegisterEnum("metapb.CircuitStatus", CircuitStatus_name, CircuitStatus_value)
+	proto.RegisterEnum("metapb.LoadBalance", LoadBalance_name, LoadBalance_value)
+	proto.RegisterEnum("metapb.OutboundAuthType", OutboundAuthType_name, OutboundAuthType_value)
+	proto.RegisterEnum("metapb.HostType", HostType_name, HostType_value)
+	proto.RegisterEnum("metapb.PublishState", PublishState_name, PublishState_value)
+	proto.RegisterEnum("metapb.Visibility", Visibility_name, Visibility_value)
+	proto.RegisterEnum("metapb.RateLimitKey", RateLimitKey_name, RateLimitKey_value)
+	proto.RegisterEnum("metapb.ProbeStrategy", ProbeStrategy_name, ProbeStrategy_value)
+	proto.RegisterEnum("metapb.Protocol", Protocol_name, Protocol_value)
+	proto.RegisterEnum("metapb.Source", Source_name, Source_value)
+	proto.RegisterEnum("metapb.RuleType", RuleType_name, RuleType_value)
+	proto.RegisterEnum("metapb.CMP", CMP_name, CMP_value)
+	proto.RegisterEnum("metapb.RoutingStrategy", RoutingStrategy_name, RoutingStrategy_value)
+	proto.RegisterEnum("metapb.MatchRule", MatchRule_name, MatchRule_value)
+	proto.RegisterEnum("metapb.ChangeType", ChangeType_name, ChangeType_value)
+	proto.RegisterEnum("metapb.ChangesetState", ChangesetState_name, ChangesetState_value)
+	proto.RegisterEnum("metapb.HeathCheckType", HeathCheckType_name, HeathCheckType_value)
+	proto.RegisterEnum("metapb.HealthStatus", HealthStatus_name, HealthStatus_value)
+	proto.RegisterEnum("metapb.FindingType", FindingType_name, FindingType_value)
+	proto.RegisterEnum("metapb.AccessLogFormat", AccessLogFormat_name, AccessLogFormat_value)
+	proto.RegisterEnum("metapb.GraphQLOperation", GraphQLOperation_name, GraphQLOperation_value)
+}
+func (m *Proxy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Proxy) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Addr)))
+	i += copy(dAtA[i:], m.Addr)
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.AddrRPC)))
+	i += copy(dAtA[i:], m.AddrRPC)
+	if len(m.Labels) > 0 {
+		for _, msg := range m.Labels {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintMetapb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *Cluster) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Cluster) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0x8
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.ID))
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Name)))
+	i += copy(dAtA[i:], m.Name)
+	dAtA[i] = 0x18
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.LoadBalance))
+	if m.OutboundAuth != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintMetapb(dAtA, i, uint64(m.OutboundAuth.Size()))
+		n1, err := m.OutboundAuth.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n1
+	}
+	if m.HalfOpenProbe != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintMetapb(dAtA, i, uint64(m.HalfOpenProbe.Size()))
+		n2, err := m.HalfOpenProbe.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n2
+	}
+	if m.UpstreamHost != nil {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintMetapb(dAtA, i, uint64(m.UpstreamHost.Size()))
+		n3, err := m.UpstreamHost.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n3
+	}
+	if len(m.Tags) > 0 {
+		for _, msg := range m.Tags {
+			dAtA[i] = 0x3a
+			i++
+			i = encodeVarintMetapb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.DNS != nil {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintMetapb(dAtA, i, uint64(m.DNS.Size()))
+		n4, err := m.DNS.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n4
+	}
+	dAtA[i] = 0x48
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.MinActive))
+	if m.RemoteGateway != nil {
+		dAtA[i] = 0x52
+		i++
+		i = encodeVarintMetapb(dAtA, i, uint64(m.RemoteGateway.Size()))
+		n5, err := m.RemoteGateway.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n5
+	}
+	if m.Policy != nil {
+		dAtA[i] = 0x5a
+		i++
+		i = encodeVarintMetapb(dAtA, i, uint64(m.Policy.Size()))
+		n6, err := m.Policy.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n6
+	}
+	if m.CircuitBreaker != nil {
+		dAtA[i] = 0x62
+		i++
+		i = encodeVarintMetapb(dAtA, i, uint64(m.CircuitBreaker.Size()))
+		n7, err := m.CircuitBreaker.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n7
+	}
+	if m.ConnRecycle != nil {
+		dAtA[i] = 0x6a
+		i++
+		i = encodeVarintMetapb(dAtA, i, uint64(m.ConnRecycle.Size()))
+		n8, err := m.ConnRecycle.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n8
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ConnRecycle) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConnRecycle) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0x8
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.MaxLifetime))
+	dAtA[i] = 0x10
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.MaxRequests))
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *Policy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Policy) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.AuthFilter)))
+	i += copy(dAtA[i:], m.AuthFilter)
+	dAtA[i] = 0x10
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.MaxQPS))
+	if m.RetryStrategy != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintMetapb(dAtA, i, uint64(m.RetryStrategy.Size()))
+		n9, err := m.RetryStrategy.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n9
+	}
+	dAtA[i] = 0x20
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.WriteTimeout))
+	dAtA[i] = 0x28
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.ReadTimeout))
+	dAtA[i] = 0x30
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.Timeout))
+	if m.IPAccessControl != nil {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintMetapb(dAtA, i, uint64(m.IPAccessControl.Size()))
+		n10, err := m.IPAccessControl.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n10
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *RemoteGateway) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoteGateway) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Secret)))
+	i += copy(dAtA[i:], m.Secret)
+	dAtA[i] = 0x10
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.MaxHops))
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *DNSTarget) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DNSTarget) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Host)))
+	i += copy(dAtA[i:], m.Host)
+	dAtA[i] = 0x10
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.Port))
+	dAtA[i] = 0x18
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.Protocol))
+	dAtA[i] = 0x20
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.MaxQPS))
+	if m.HeathCheck != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintMetapb(dAtA, i, uint64(m.HeathCheck.Size()))
+		n11, err := m.HeathCheck.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n11
+	}
+	if m.CircuitBreaker != nil {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintMetapb(dAtA, i, uint64(m.CircuitBreaker.Size()))
+		n12, err := m.CircuitBreaker.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n12
+	}
+	dAtA[i] = 0x38
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.RefreshInterval))
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *HalfOpenProbe) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HalfOpenProbe) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0x8
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.Strategy))
+	dAtA[i] = 0x10
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.MaxRequests))
+	dAtA[i] = 0x18
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.TrafficRate))
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *OutboundAuth) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OutboundAuth) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0x8
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.Type))
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Token)))
+	i += copy(dAtA[i:], m.Token)
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.AccessKey)))
+	i += copy(dAtA[i:], m.AccessKey)
+	dAtA[i] = 0x22
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.SecretKey)))
+	i += copy(dAtA[i:], m.SecretKey)
+	dAtA[i] = 0x2a
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Region)))
+	i += copy(dAtA[i:], m.Region)
+	dAtA[i] = 0x32
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Service)))
+	i += copy(dAtA[i:], m.Service)
+	dAtA[i] = 0x3a
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Header)))
+	i += copy(dAtA[i:], m.Header)
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *UpstreamHost) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpstreamHost) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0x8
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.Type))
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Value)))
+	i += copy(dAtA[i:], m.Value)
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *HeathCheck) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HeathCheck) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Path)))
+	i += copy(dAtA[i:], m.Path)
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Body)))
+	i += copy(dAtA[i:], m.Body)
+	dAtA[i] = 0x18
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.CheckInterval))
+	dAtA[i] = 0x20
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.Timeout))
+	dAtA[i] = 0x28
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.Type))
+	dAtA[i] = 0x30
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.HealthyThreshold))
+	dAtA[i] = 0x38
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.UnhealthyThreshold))
+	if len(m.Command) > 0 {
+		for _, s := range m.Command {
+			dAtA[i] = 0x42
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	dAtA[i] = 0x4a
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Checker)))
+	i += copy(dAtA[i:], m.Checker)
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *CircuitBreaker) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CircuitBreaker) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0x8
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.CloseTimeout))
+	dAtA[i] = 0x10
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.HalfTrafficRate))
+	dAtA[i] = 0x18
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.RateCheckPeriod))
+	dAtA[i] = 0x20
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.FailureRateToClose))
+	dAtA[i] = 0x28
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.SucceedRateToOpen))
+	dAtA[i] = 0x30
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.ContinuousFailuresToClose))
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *Server) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Server) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0x8
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.ID))
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Addr)))
+	i += copy(dAtA[i:], m.Addr)
+	dAtA[i] = 0x18
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.Protocol))
+	dAtA[i] = 0x20
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.MaxQPS))
+	if m.HeathCheck != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintMetapb(dAtA, i, uint64(m.HeathCheck.Size()))
+		n13, err := m.HeathCheck.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n13
+	}
+	if m.CircuitBreaker != nil {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintMetapb(dAtA, i, uint64(m.CircuitBreaker.Size()))
+		n14, err := m.CircuitBreaker.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n14
+	}
+	if len(m.Tags) > 0 {
+		for _, msg := range m.Tags {
+			dAtA[i] = 0x3a
+			i++
+			i = encodeVarintMetapb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	dAtA[i] = 0x40
+	i++
+	if m.Drained {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i++
+	if m.Weight != nil {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintMetapb(dAtA, i, uint64(m.Weight.Size()))
+		n15, err := m.Weight.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n15
+	}
+	dAtA[i] = 0x50
+	i++
+	if m.Standby {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i++
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ServerWeight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ServerWeight) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0x8
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.From))
+	dAtA[i] = 0x10
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.Target))
+	dAtA[i] = 0x18
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.RampStartAt))
+	dAtA[i] = 0x20
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.RampSeconds))
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *Bind) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Bind) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0x8
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.ClusterID))
+	dAtA[i] = 0x10
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.ServerID))
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *PairValue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PairValue) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Name)))
+	i += copy(dAtA[i:], m.Name)
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Value)))
+	i += copy(dAtA[i:], m.Value)
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *IPAccessControl) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IPAccessControl) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Whitelist) > 0 {
+		for _, s := range m.Whitelist {
+			dAtA[i] = 0xa
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.Blacklist) > 0 {
+		for _, s := range m.Blacklist {
+			dAtA[i] = 0x12
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *HTTPResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HTTPResult) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Body != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintMetapb(dAtA, i, uint64(len(m.Body)))
+		i += copy(dAtA[i:], m.Body)
+	}
+	if len(m.Headers) > 0 {
+		for _, msg := range m.Headers {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintMetapb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Cookies) > 0 {
+		for _, msg := range m.Cookies {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintMetapb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	dAtA[i] = 0x20
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.Code))
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ErrorPage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ErrorPage) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0x8
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.Status))
+	dAtA[i] = 0x10
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.Code))
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.ContentType)))
+	i += copy(dAtA[i:], m.ContentType)
+	dAtA[i] = 0x22
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Body)))
+	i += copy(dAtA[i:], m.Body)
+	if len(m.Headers) > 0 {
+		for _, msg := range m.Headers {
+			dAtA[i] = 0x2a
+			i++
+			i = encodeVarintMetapb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *Parameter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Parameter) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Name)))
+	i += copy(dAtA[i:], m.Name)
+	dAtA[i] = 0x10
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.Source))
+	dAtA[i] = 0x18
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.Index))
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ValidationRule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidationRule) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0x8
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.RuleType))
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Expression)))
+	i += copy(dAtA[i:], m.Expression)
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *Validation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Validation) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.Parameter.Size()))
+	n16, err := m.Parameter.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n16
+	dAtA[i] = 0x10
+	i++
+	if m.Required {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i++
+	if len(m.Rules) > 0 {
+		for _, msg := range m.Rules {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintMetapb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *RetryStrategy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RetryStrategy) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0x8
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.Interval))
+	dAtA[i] = 0x10
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.MaxTimes))
+	if len(m.Codes) > 0 {
+		for _, num := range m.Codes {
+			dAtA[i] = 0x18
+			i++
+			i = encodeVarintMetapb(dAtA, i, uint64(num))
+		}
+	}
+	dAtA[i] = 0x20
+	i++
+	if m.ConnectionErrors {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i++
+	dAtA[i] = 0x28
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.MaxInterval))
+	dAtA[i] = 0x30
+	i++
+	if m.Jitter {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i++
+	dAtA[i] = 0x38
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.PerTryTimeout))
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *DispatchNode) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DispatchNode) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0x8
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.ClusterID))
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.URLRewrite)))
+	i += copy(dAtA[i:], m.URLRewrite)
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.AttrName)))
+	i += copy(dAtA[i:], m.AttrName)
+	if len(m.Validations) > 0 {
+		for _, msg := range m.Validations {
+			dAtA[i] = 0x22
+			i++
+			i = encodeVarintMetapb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.Cache != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintMetapb(dAtA, i, uint64(m.Cache.Size()))
+		n17, err := m.Cache.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n17
+	}
+	if m.DefaultValue != nil {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintMetapb(dAtA, i, uint64(m.DefaultValue.Size()))
+		n18, err := m.DefaultValue.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n18
+	}
+	dAtA[i] = 0x38
+	i++
+	if m.UseDefault {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i++
+	dAtA[i] = 0x40
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.BatchIndex))
+	if m.RetryStrategy != nil {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintMetapb(dAtA, i, uint64(m.RetryStrategy.Size()))
+		n19, err := m.RetryStrategy.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n19
+	}
+	dAtA[i] = 0x50
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.WriteTimeout))
+	dAtA[i] = 0x58
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.ReadTimeout))
+	if m.Affinity != nil {
+		dAtA[i] = 0x62
+		i++
+		i = encodeVarintMetapb(dAtA, i, uint64(m.Affinity.Size()))
+		n20, err := m.Affinity.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n20
+	}
+	if m.GRPCTranscoding != nil {
+		dAtA[i] = 0x6a
+		i++
+		i = encodeVarintMetapb(dAtA, i, uint64(m.GRPCTranscoding.Size()))
+		n21, err := m.GRPCTranscoding.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n21
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *GRPCTranscoding) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GRPCTranscoding) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0x8
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.DescriptorID))
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Method)))
+	i += copy(dAtA[i:], m.Method)
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *Cache) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Cache) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Keys) > 0 {
+		for _, msg := range m.Keys {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintMetapb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	dAtA[i] = 0x10
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.Deadline))
+	if len(m.Conditions) > 0 {
+		for _, msg := range m.Conditions {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintMetapb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	dAtA[i] = 0x20
+	i++
+	if m.IgnoreQuery {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i++
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *RenderTemplate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RenderTemplate) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Objects) > 0 {
+		for _, msg := range m.Objects {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintMetapb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Body)))
+	i += copy(dAtA[i:], m.Body)
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.ContentType)))
+	i += copy(dAtA[i:], m.ContentType)
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *RenderObject) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RenderObject) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Name)))
+	i += copy(dAtA[i:], m.Name)
+	if len(m.Attrs) > 0 {
+		for _, msg := range m.Attrs {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintMetapb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	dAtA[i] = 0x18
+	i++
+	if m.FlatAttrs {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i++
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *RenderAttr) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RenderAttr) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Name)))
+	i += copy(dAtA[i:], m.Name)
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.ExtractExp)))
+	i += copy(dAtA[i:], m.ExtractExp)
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *API) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *API) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0x8
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.ID))
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Name)))
+	i += copy(dAtA[i:], m.Name)
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.URLPattern)))
+	i += copy(dAtA[i:], m.URLPattern)
+	dAtA[i] = 0x22
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Method)))
+	i += copy(dAtA[i:], m.Method)
+	dAtA[i] = 0x2a
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Domain)))
+	i += copy(dAtA[i:], m.Domain)
+	dAtA[i] = 0x30
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.Status))
+	if m.IPAccessControl != nil {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintMetapb(dAtA, i, uint64(m.IPAccessControl.Size()))
+		n22, err := m.IPAccessControl.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n22
+	}
+	if m.DefaultValue != nil {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintMetapb(dAtA, i, uint64(m.DefaultValue.Size()))
+		n23, err := m.DefaultValue.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n23
+	}
+	if len(m.Nodes) > 0 {
+		for _, msg := range m.Nodes {
+			dAtA[i] = 0x4a
+			i++
+			i = encodeVarintMetapb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Perms) > 0 {
+		for _, s := range m.Perms {
+			dAtA[i] = 0x52
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	dAtA[i] = 0x5a
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.AuthFilter)))
+	i += copy(dAtA[i:], m.AuthFilter)
+	if m.RenderTemplate != nil {
+		dAtA[i] = 0x62
+		i++
+		i = encodeVarintMetapb(dAtA, i, uint64(m.RenderTemplate.Size()))
+		n24, err := m.RenderTemplate.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n24
+	}
+	dAtA[i] = 0x68
+	i++
+	if m.UseDefault {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i++
+	dAtA[i] = 0x70
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.MatchRule))
+	dAtA[i] = 0x78
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.Position))
+	if len(m.Tags) > 0 {
+		for _, msg := range m.Tags {
+			dAtA[i] = 0x82
+			i++
+			dAtA[i] = 0x1
+			i++
+			i = encodeVarintMetapb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.WebSocketOptions != nil {
+		dAtA[i] = 0x8a
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintMetapb(dAtA, i, uint64(m.WebSocketOptions.Size()))
+		n25, err := m.WebSocketOptions.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n25
+	}
+	dAtA[i] = 0x90
+	i++
+	dAtA[i] = 0x1
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.MaxQPS))
+	if m.CircuitBreaker != nil {
+		dAtA[i] = 0x9a
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintMetapb(dAtA, i, uint64(m.CircuitBreaker.Size()))
+		n26, err := m.CircuitBreaker.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n26
+	}
+	dAtA[i] = 0xa0
+	i++
+	dAtA[i] = 0x1
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.Template))
+	if len(m.ErrorPages) > 0 {
+		for _, msg := range m.ErrorPages {
+			dAtA[i] = 0xaa
+			i++
+			dAtA[i] = 0x1
+			i++
+			i = encodeVarintMetapb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.Deprecation != nil {
+		dAtA[i] = 0xb2
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintMetapb(dAtA, i, uint64(m.Deprecation.Size()))
+		n27, err := m.Deprecation.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n27
+	}
+	if len(m.Aliases) > 0 {
+		for _, msg := range m.Aliases {
+			dAtA[i] = 0xba
+			i++
+			dAtA[i] = 0x1
+			i++
+			i = encodeVarintMetapb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.RequestSchema != nil {
+		dAtA[i] = 0xc2
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintMetapb(dAtA, i, uint64(m.RequestSchema.Size()))
+		n28, err := m.RequestSchema.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n28
+	}
+	if m.ResponseSchema != nil {
+		dAtA[i] = 0xca
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintMetapb(dAtA, i, uint64(m.ResponseSchema.Size()))
+		n29, err := m.ResponseSchema.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n29
+	}
+	if m.GraphQL != nil {
+		dAtA[i] = 0xd2
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintMetapb(dAtA, i, uint64(m.GraphQL.Size()))
+		n30, err := m.GraphQL.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n30
+	}
+	if m.AccessPolicy != nil {
+		dAtA[i] = 0xda
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintMetapb(dAtA, i, uint64(m.AccessPolicy.Size()))
+		n31, err := m.AccessPolicy.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n31
+	}
+	if m.UpstreamHost != nil {
+		dAtA[i] = 0xe2
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintMetapb(dAtA, i, uint64(m.UpstreamHost.Size()))
+		n32, err := m.UpstreamHost.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n32
+	}
+	if m.Portal != nil {
+		dAtA[i] = 0xea
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintMetapb(dAtA, i, uint64(m.Portal.Size()))
+		n33, err := m.Portal.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n33
+	}
+	dAtA[i] = 0xf0
+	i++
+	dAtA[i] = 0x1
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.PublishState))
+	if m.Preview != nil {
+		dAtA[i] = 0xfa
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintMetapb(dAtA, i, uint64(m.Preview.Size()))
+		n34, err := m.Preview.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n34
+	}
+	if m.HeaderLimits != nil {
+		dAtA[i] = 0x82
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintMetapb(dAtA, i, uint64(m.HeaderLimits.Size()))
+		n35, err := m.HeaderLimits.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n35
+	}
+	if m.RequestBody != nil {
+		dAtA[i] = 0x8a
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintMetapb(dAtA, i, uint64(m.RequestBody.Size()))
+		n36, err := m.RequestBody.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n36
+	}
+	if m.ContentTypes != nil {
+		dAtA[i] = 0x92
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintMetapb(dAtA, i, uint64(m.ContentTypes.Size()))
+		n37, err := m.ContentTypes.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n37
+	}
+	dAtA[i] = 0x9a
+	i++
+	dAtA[i] = 0x2
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.DegradedAttr)))
+	i += copy(dAtA[i:], m.DegradedAttr)
+	if len(m.Constants) > 0 {
+		for _, msg := range m.Constants {
+			dAtA[i] = 0xa2
+			i++
+			dAtA[i] = 0x2
+			i++
+			i = encodeVarintMetapb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.AccessLog != nil {
+		dAtA[i] = 0xaa
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintMetapb(dAtA, i, uint64(m.AccessLog.Size()))
+		n38, err := m.AccessLog.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n38
+	}
+	if len(m.ProxyGroups) > 0 {
+		for _, msg := range m.ProxyGroups {
+			dAtA[i] = 0xb2
+			i++
+			dAtA[i] = 0x2
+			i++
+			i = encodeVarintMetapb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.SecurityHeaders != nil {
+		dAtA[i] = 0xba
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintMetapb(dAtA, i, uint64(m.SecurityHeaders.Size()))
+		n39, err := m.SecurityHeaders.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n39
+	}
+	if m.Approval != nil {
+		dAtA[i] = 0xc2
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintMetapb(dAtA, i, uint64(m.Approval.Size()))
+		n40, err := m.Approval.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n40
+	}
+	if m.Cache != nil {
+		dAtA[i] = 0xca
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintMetapb(dAtA, i, uint64(m.Cache.Size()))
+		n41, err := m.Cache.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n41
+	}
+	dAtA[i] = 0xd0
+	i++
+	dAtA[i] = 0x2
+	i++
+	if m.Debug {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i++
+	if m.Mirror != nil {
+		dAtA[i] = 0xda
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintMetapb(dAtA, i, uint64(m.Mirror.Size()))
+		n42, err := m.Mirror.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n42
+	}
+	dAtA[i] = 0xe0
+	i++
+	dAtA[i] = 0x2
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.ReadTimeout))
+	dAtA[i] = 0xe8
+	i++
+	dAtA[i] = 0x2
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.WriteTimeout))
+	dAtA[i] = 0xf0
+	i++
+	dAtA[i] = 0x2
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.Timeout))
+	if m.HeaderTransform != nil {
+		dAtA[i] = 0xfa
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintMetapb(dAtA, i, uint64(m.HeaderTransform.Size()))
+		n43, err := m.HeaderTransform.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n43
+	}
+	dAtA[i] = 0x80
+	i++
+	dAtA[i] = 0x3
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.Visibility))
+	if m.PathRewrite != nil {
+		dAtA[i] = 0x8a
+		i++
+		dAtA[i] = 0x3
+		i++
+		i = encodeVarintMetapb(dAtA, i, uint64(m.PathRewrite.Size()))
+		n44, err := m.PathRewrite.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n44
+	}
+	if m.ResponseBody != nil {
+		dAtA[i] = 0x92
+		i++
+		dAtA[i] = 0x3
+		i++
+		i = encodeVarintMetapb(dAtA, i, uint64(m.ResponseBody.Size()))
+		n45, err := m.ResponseBody.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n45
+	}
+	if m.OIDC != nil {
+		dAtA[i] = 0x9a
+		i++
+		dAtA[i] = 0x3
+		i++
+		i = encodeVarintMetapb(dAtA, i, uint64(m.OIDC.Size()))
+		n46, err := m.OIDC.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n46
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *PathRewrite) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PathRewrite) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.StripPrefix)))
+	i += copy(dAtA[i:], m.StripPrefix)
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Pattern)))
+	i += copy(dAtA[i:], m.Pattern)
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Replacement)))
+	i += copy(dAtA[i:], m.Replacement)
+	dAtA[i] = 0x22
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.AddPrefix)))
+	i += copy(dAtA[i:], m.AddPrefix)
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *HeaderTransform) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HeaderTransform) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Request != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintMetapb(dAtA, i, uint64(m.Request.Size()))
+		n47, err := m.Request.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n47
+	}
+	if m.Response != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintMetapb(dAtA, i, uint64(m.Response.Size()))
+		n48, err := m.Response.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n48
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *HeaderRules) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HeaderRules) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Remove) > 0 {
+		for _, s := range m.Remove {
+			dAtA[i] = 0xa
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.Rename) > 0 {
+		for _, msg := range m.Rename {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintMetapb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Set) > 0 {
+		for _, msg := range m.Set {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintMetapb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Defaults) > 0 {
+		for _, msg := range m.Defaults {
+			dAtA[i] = 0x22
+			i++
+			i = encodeVarintMetapb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Add) > 0 {
+		for _, msg := range m.Add {
+			dAtA[i] = 0x2a
+			i++
+			i = encodeVarintMetapb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *Mirror) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Mirror) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0x8
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.ClusterID))
+	dAtA[i] = 0x10
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.Rate))
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *Approval) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Approval) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Submitter)))
+	i += copy(dAtA[i:], m.Submitter)
+	dAtA[i] = 0x10
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.SubmittedAt))
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Approver)))
+	i += copy(dAtA[i:], m.Approver)
+	dAtA[i] = 0x20
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.ApprovedAt))
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ProxyGroup) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProxyGroup) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Labels) > 0 {
+		for _, msg := range m.Labels {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintMetapb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *AccessLog) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccessLog) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0x8
+	i++
+	if m.Disabled {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i++
+	dAtA[i] = 0x10
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.Sampling))
+	dAtA[i] = 0x18
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.Format))
+	if len(m.Fields) > 0 {
+		for _, s := range m.Fields {
+			dAtA[i] = 0x22
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *SecurityHeaders) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SecurityHeaders) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0x8
+	i++
+	if m.Disabled {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i++
+	dAtA[i] = 0x10
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.HSTSMaxAge))
+	dAtA[i] = 0x18
+	i++
+	if m.HSTSIncludeSubDomains {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i++
+	dAtA[i] = 0x20
+	i++
+	if m.HSTSPreload {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i++
+	dAtA[i] = 0x28
+	i++
+	if m.NoSniff {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i++
+	dAtA[i] = 0x32
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.FrameOptions)))
+	i += copy(dAtA[i:], m.FrameOptions)
+	dAtA[i] = 0x3a
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.ContentSecurityPolicy)))
+	i += copy(dAtA[i:], m.ContentSecurityPolicy)
+	dAtA[i] = 0x42
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.ReferrerPolicy)))
+	i += copy(dAtA[i:], m.ReferrerPolicy)
+	dAtA[i] = 0x4a
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Server)))
+	i += copy(dAtA[i:], m.Server)
+	if len(m.RemoveHeaders) > 0 {
+		for _, s := range m.RemoveHeaders {
+			dAtA[i] = 0x52
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ContentTypes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContentTypes) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Consumes) > 0 {
+		for _, s := range m.Consumes {
+			dAtA[i] = 0xa
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.Produces) > 0 {
+		for _, s := range m.Produces {
+			dAtA[i] = 0x12
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *RequestBodyPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RequestBodyPolicy) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0x8
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.MaxBytes))
+	dAtA[i] = 0x10
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.MaxBufferBytes))
+	dAtA[i] = 0x18
+	i++
+	if m.SpillToDisk {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i++
+	dAtA[i] = 0x20
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.MaxPartBytes))
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ResponseBodyPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResponseBodyPolicy) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0x8
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.MaxBytes))
+	dAtA[i] = 0x10
+	i++
+	if m.Truncate {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i++
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Header)))
+	i += copy(dAtA[i:], m.Header)
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *OIDCPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OIDCPolicy) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Audience)))
+	i += copy(dAtA[i:], m.Audience)
+	if len(m.Scopes) > 0 {
+		for _, s := range m.Scopes {
+			dAtA[i] = 0x12
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *HeaderLimits) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HeaderLimits) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0x8
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.MaxRequestHeaders))
+	dAtA[i] = 0x10
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.MaxRequestHeaderBytes))
+	dAtA[i] = 0x18
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.MaxResponseHeaders))
+	dAtA[i] = 0x20
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.MaxResponseHeaderBytes))
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *PreviewOptions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PreviewOptions) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Header)))
+	i += copy(dAtA[i:], m.Header)
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Secret)))
+	i += copy(dAtA[i:], m.Secret)
+	if len(m.IPs) > 0 {
+		for _, s := range m.IPs {
+			dAtA[i] = 0x1a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *PortalOptions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PortalOptions) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0x8
+	i++
+	if m.Published {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i++
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Description)))
+	i += copy(dAtA[i:], m.Description)
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Doc)))
+	i += copy(dAtA[i:], m.Doc)
+	dAtA[i] = 0x20
+	i++
+	if m.KeyRequired {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i++
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *AccessPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccessPolicy) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.TimeWindows) > 0 {
+		for _, msg := range m.TimeWindows {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintMetapb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.Anomaly != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintMetapb(dAtA, i, uint64(m.Anomaly.Size()))
+		n49, err := m.Anomaly.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n49
+	}
+	if len(m.Overrides) > 0 {
+		for _, s := range m.Overrides {
+			dAtA[i] = 0x1a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *TimeWindow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TimeWindow) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Weekdays) > 0 {
+		for _, num := range m.Weekdays {
+			dAtA[i] = 0x8
+			i++
+			i = encodeVarintMetapb(dAtA, i, uint64(num))
+		}
+	}
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Start)))
+	i += copy(dAtA[i:], m.Start)
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.End)))
+	i += copy(dAtA[i:], m.End)
+	dAtA[i] = 0x22
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Location)))
+	i += copy(dAtA[i:], m.Location)
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *AnomalyRule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AnomalyRule) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0x8
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.Multiple))
+	dAtA[i] = 0x10
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.MinQPS))
+	dAtA[i] = 0x18
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.BlockSeconds))
+	dAtA[i] = 0x22
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Header)))
+	i += copy(dAtA[i:], m.Header)
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *GraphQLOptions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GraphQLOptions) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0x8
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.MaxDepth))
+	dAtA[i] = 0x10
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.MaxComplexity))
+	if len(m.Resolvers) > 0 {
+		for _, msg := range m.Resolvers {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintMetapb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *GraphQLResolver) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GraphQLResolver) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0x8
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.Operation))
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Field)))
+	i += copy(dAtA[i:], m.Field)
+	dAtA[i] = 0x18
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.ClusterID))
+	dAtA[i] = 0x22
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Method)))
+	i += copy(dAtA[i:], m.Method)
+	dAtA[i] = 0x2a
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.URL)))
+	i += copy(dAtA[i:], m.URL)
+	dAtA[i] = 0x32
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.ExtractExp)))
+	i += copy(dAtA[i:], m.ExtractExp)
+	dAtA[i] = 0x38
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.Complexity))
+	dAtA[i] = 0x40
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.WriteTimeout))
+	dAtA[i] = 0x48
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.ReadTimeout))
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ResponseSchema) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResponseSchema) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Body)))
+	i += copy(dAtA[i:], m.Body)
+	dAtA[i] = 0x10
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.Sampling))
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *RequestSchema) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RequestSchema) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Query)))
+	i += copy(dAtA[i:], m.Query)
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Header)))
+	i += copy(dAtA[i:], m.Header)
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Body)))
+	i += copy(dAtA[i:], m.Body)
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *APIAlias) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *APIAlias) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.URLPattern)))
+	i += copy(dAtA[i:], m.URLPattern)
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Method)))
+	i += copy(dAtA[i:], m.Method)
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Domain)))
+	i += copy(dAtA[i:], m.Domain)
+	dAtA[i] = 0x20
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.MatchRule))
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *Deprecation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Deprecation) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0x8
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.DeprecatedAt))
+	dAtA[i] = 0x10
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.SunsetAt))
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Link)))
+	i += copy(dAtA[i:], m.Link)
+	dAtA[i] = 0x22
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Warning)))
+	i += copy(dAtA[i:], m.Warning)
+	dAtA[i] = 0x28
+	i++
+	if m.DisableAfterSunset {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i++
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *Condition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Condition) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.Parameter.Size()))
+	n50, err := m.Parameter.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n50
+	dAtA[i] = 0x10
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.Cmp))
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Expect)))
+	i += copy(dAtA[i:], m.Expect)
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *Routing) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Routing) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0x8
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.ID))
+	dAtA[i] = 0x10
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.ClusterID))
+	if len(m.Conditions) > 0 {
+		for _, msg := range m.Conditions {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintMetapb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	dAtA[i] = 0x20
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.Strategy))
+	dAtA[i] = 0x28
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.TrafficRate))
+	dAtA[i] = 0x30
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.Status))
+	dAtA[i] = 0x38
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.API))
+	dAtA[i] = 0x42
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Name)))
+	i += copy(dAtA[i:], m.Name)
+	if len(m.Tags) > 0 {
+		for _, msg := range m.Tags {
+			dAtA[i] = 0x4a
+			i++
+			i = encodeVarintMetapb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *WebSocketOptions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WebSocketOptions) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Origin)))
+	i += copy(dAtA[i:], m.Origin)
+	dAtA[i] = 0x10
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.MaxConns))
+	dAtA[i] = 0x18
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.IdleTimeout))
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *System) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *System) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.Count.Size()))
+	n51, err := m.Count.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n51
+	dAtA[i] = 0x10
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.Revision))
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *CountMetric) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CountMetric) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0x8
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.Cluster))
+	dAtA[i] = 0x10
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.Server))
+	dAtA[i] = 0x18
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.API))
+	dAtA[i] = 0x20
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.Routing))
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ServerHealth) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ServerHealth) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0x8
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.ServerID))
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Addr)))
+	i += copy(dAtA[i:], m.Addr)
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Proxy)))
+	i += copy(dAtA[i:], m.Proxy)
+	dAtA[i] = 0x20
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.Status))
+	dAtA[i] = 0x28
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.LastCheckAt))
+	dAtA[i] = 0x32
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Reason)))
+	i += copy(dAtA[i:], m.Reason)
+	dAtA[i] = 0x38
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.Score))
+	dAtA[i] = 0x40
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.LatencyEWMA))
+	if len(m.Timeouts) > 0 {
+		for _, msg := range m.Timeouts {
+			dAtA[i] = 0x4a
+			i++
+			i = encodeVarintMetapb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Phases) > 0 {
+		for _, msg := range m.Phases {
+			dAtA[i] = 0x52
+			i++
+			i = encodeVarintMetapb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	dAtA[i] = 0x58
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.Weight))
+	dAtA[i] = 0x60
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.Circuit))
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *PhaseTimeout) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PhaseTimeout) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Phase)))
+	i += copy(dAtA[i:], m.Phase)
+	dAtA[i] = 0x10
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.Count))
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *PhaseLatency) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PhaseLatency) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Phase)))
+	i += copy(dAtA[i:], m.Phase)
+	dAtA[i] = 0x10
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.LatencyEWMA))
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *FleetServerHealth) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FleetServerHealth) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0x8
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.ServerID))
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Addr)))
+	i += copy(dAtA[i:], m.Addr)
+	dAtA[i] = 0x18
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.Status))
+	if len(m.Proxies) > 0 {
+		for _, msg := range m.Proxies {
+			dAtA[i] = 0x22
+			i++
+			i = encodeVarintMetapb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	dAtA[i] = 0x28
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.Score))
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *HealthTransition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HealthTransition) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0x8
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.ServerID))
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Addr)))
+	i += copy(dAtA[i:], m.Addr)
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Proxy)))
+	i += copy(dAtA[i:], m.Proxy)
+	dAtA[i] = 0x20
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.From))
+	dAtA[i] = 0x28
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.To))
+	dAtA[i] = 0x32
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Reason)))
+	i += copy(dAtA[i:], m.Reason)
+	dAtA[i] = 0x38
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.At))
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *StandbyEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StandbyEvent) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0x8
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.ClusterID))
+	dAtA[i] = 0x10
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.ServerID))
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Proxy)))
+	i += copy(dAtA[i:], m.Proxy)
+	dAtA[i] = 0x20
+	i++
+	if m.Promoted {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i++
+	dAtA[i] = 0x28
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.Active))
+	dAtA[i] = 0x30
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.At))
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ConfigPropagation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConfigPropagation) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Proxy)))
+	i += copy(dAtA[i:], m.Proxy)
+	dAtA[i] = 0x10
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.Revision))
+	dAtA[i] = 0x18
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.WriteAt))
+	dAtA[i] = 0x20
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.AppliedAt))
+	dAtA[i] = 0x28
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.Latency))
+	dAtA[i] = 0x30
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.LatencyEWMA))
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *RouteTest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RouteTest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Proxy)))
+	i += copy(dAtA[i:], m.Proxy)
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Method)))
+	i += copy(dAtA[i:], m.Method)
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Host)))
+	i += copy(dAtA[i:], m.Host)
+	dAtA[i] = 0x22
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Path)))
+	i += copy(dAtA[i:], m.Path)
+	if len(m.Headers) > 0 {
+		for _, msg := range m.Headers {
+			dAtA[i] = 0x2a
+			i++
+			i = encodeVarintMetapb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	dAtA[i] = 0x32
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Body)))
+	i += copy(dAtA[i:], m.Body)
+	dAtA[i] = 0x3a
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.ClientIP)))
+	i += copy(dAtA[i:], m.ClientIP)
+	dAtA[i] = 0x40
+	i++
+	if m.Internal {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i++
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *RouteTestResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RouteTestResult) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Proxy)))
+	i += copy(dAtA[i:], m.Proxy)
+	dAtA[i] = 0x10
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.Code))
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Reason)))
+	i += copy(dAtA[i:], m.Reason)
+	dAtA[i] = 0x20
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.API))
+	dAtA[i] = 0x2a
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.APIName)))
+	i += copy(dAtA[i:], m.APIName)
+	dAtA[i] = 0x30
+	i++
+	if m.UseDefault {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i++
+	if len(m.Nodes) > 0 {
+		for _, msg := range m.Nodes {
+			dAtA[i] = 0x3a
+			i++
+			i = encodeVarintMetapb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Filters) > 0 {
+		for _, s := range m.Filters {
+			dAtA[i] = 0x42
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *RouteTestNode) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RouteTestNode) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0x8
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.ClusterID))
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.ClusterName)))
+	i += copy(dAtA[i:], m.ClusterName)
+	dAtA[i] = 0x18
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.ServerID))
+	dAtA[i] = 0x22
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.ServerAddr)))
+	i += copy(dAtA[i:], m.ServerAddr)
+	dAtA[i] = 0x28
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.Routing))
+	dAtA[i] = 0x32
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.RoutingName)))
+	i += copy(dAtA[i:], m.RoutingName)
+	dAtA[i] = 0x3a
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.CopyTo)))
+	i += copy(dAtA[i:], m.CopyTo)
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *MetaDiff) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MetaDiff) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0x8
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.From))
+	dAtA[i] = 0x10
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.To))
+	if len(m.Changes) > 0 {
+		for _, msg := range m.Changes {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintMetapb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *MetaChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MetaChange) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Kind)))
+	i += copy(dAtA[i:], m.Kind)
+	dAtA[i] = 0x10
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.ID))
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Name)))
+	i += copy(dAtA[i:], m.Name)
+	dAtA[i] = 0x20
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.Type))
+	if len(m.Fields) > 0 {
+		for _, msg := range m.Fields {
+			dAtA[i] = 0x2a
+			i++
+			i = encodeVarintMetapb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *FieldChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FieldChange) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Path)))
+	i += copy(dAtA[i:], m.Path)
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.From)))
+	i += copy(dAtA[i:], m.From)
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.To)))
+	i += copy(dAtA[i:], m.To)
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *Changeset) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Changeset) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0x8
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.ID))
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Name)))
+	i += copy(dAtA[i:], m.Name)
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Description)))
+	i += copy(dAtA[i:], m.Description)
+	dAtA[i] = 0x22
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Operator)))
+	i += copy(dAtA[i:], m.Operator)
+	dAtA[i] = 0x28
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.State))
+	dAtA[i] = 0x30
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.CreatedAt))
+	dAtA[i] = 0x38
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.CommittedAt))
+	dAtA[i] = 0x40
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.RolledBackAt))
+	dAtA[i] = 0x48
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.RollbackRevision))
+	if len(m.Records) > 0 {
+		for _, msg := range m.Records {
+			dAtA[i] = 0x52
+			i++
+			i = encodeVarintMetapb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ChangeRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChangeRecord) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Description)))
+	i += copy(dAtA[i:], m.Description)
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Operator)))
+	i += copy(dAtA[i:], m.Operator)
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Method)))
+	i += copy(dAtA[i:], m.Method)
+	dAtA[i] = 0x22
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Path)))
+	i += copy(dAtA[i:], m.Path)
+	dAtA[i] = 0x28
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.From))
+	dAtA[i] = 0x30
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.To))
+	dAtA[i] = 0x38
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.At))
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ConsistencyReport) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsistencyReport) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0x8
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.CheckedAt))
+	if len(m.Findings) > 0 {
+		for _, msg := range m.Findings {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintMetapb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ConfigFinding) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConfigFinding) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0x8
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.Type))
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Kind)))
+	i += copy(dAtA[i:], m.Kind)
+	dAtA[i] = 0x18
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.ID))
+	dAtA[i] = 0x22
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Name)))
+	i += copy(dAtA[i:], m.Name)
+	dAtA[i] = 0x2a
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Message)))
+	i += copy(dAtA[i:], m.Message)
+	dAtA[i] = 0x32
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Suggestion)))
+	i += copy(dAtA[i:], m.Suggestion)
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ProxyOverride) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProxyOverride) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0x8
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.ID))
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Name)))
+	i += copy(dAtA[i:], m.Name)
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Proxy)))
+	i += copy(dAtA[i:], m.Proxy)
+	if len(m.Labels) > 0 {
+		for _, msg := range m.Labels {
+			dAtA[i] = 0x22
+			i++
+			i = encodeVarintMetapb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.EnabledAPIs) > 0 {
+		for _, num := range m.EnabledAPIs {
+			dAtA[i] = 0x28
+			i++
+			i = encodeVarintMetapb(dAtA, i, uint64(num))
+		}
+	}
+	if len(m.DisabledAPIs) > 0 {
+		for _, num := range m.DisabledAPIs {
+			dAtA[i] = 0x30
+			i++
+			i = encodeVarintMetapb(dAtA, i, uint64(num))
+		}
+	}
+	if len(m.RateLimits) > 0 {
+		for _, msg := range m.RateLimits {
+			dAtA[i] = 0x3a
+			i++
+			i = encodeVarintMetapb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	dAtA[i] = 0x42
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.LogLevel)))
+	i += copy(dAtA[i:], m.LogLevel)
+	dAtA[i] = 0x48
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.AccessLogSampling))
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *KillSwitch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KillSwitch) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0x8
+	i++
+	if m.Global {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i++
+	if len(m.APIs) > 0 {
+		for _, num := range m.APIs {
+			dAtA[i] = 0x10
+			i++
+			i = encodeVarintMetapb(dAtA, i, uint64(num))
+		}
+	}
+	if len(m.AllowedAPIs) > 0 {
+		for _, num := range m.AllowedAPIs {
+			dAtA[i] = 0x18
+			i++
+			i = encodeVarintMetapb(dAtA, i, uint64(num))
+		}
+	}
+	if len(m.AllowedIPs) > 0 {
+		for _, s := range m.AllowedIPs {
+			dAtA[i] = 0x22
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	dAtA[i] = 0x28
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.Code))
+	dAtA[i] = 0x32
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.ContentType)))
+	i += copy(dAtA[i:], m.ContentType)
+	if len(m.Headers) > 0 {
+		for _, msg := range m.Headers {
+			dAtA[i] = 0x3a
+			i++
+			i = encodeVarintMetapb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	dAtA[i] = 0x42
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Body)))
+	i += copy(dAtA[i:], m.Body)
+	dAtA[i] = 0x4a
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Reason)))
+	i += copy(dAtA[i:], m.Reason)
+	dAtA[i] = 0x50
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.UpdatedAt))
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *Consumer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Consumer) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0x8
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.ID))
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Name)))
+	i += copy(dAtA[i:], m.Name)
+	dAtA[i] = 0x18
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.Quota))
+	if len(m.Keys) > 0 {
+		for _, msg := range m.Keys {
+			dAtA[i] = 0x22
+			i++
+			i = encodeVarintMetapb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	dAtA[i] = 0x2a
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Webhook)))
+	i += copy(dAtA[i:], m.Webhook)
+	dAtA[i] = 0x30
+	i++
+	if m.RestrictAPIs {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i++
+	if len(m.AllowedAPIs) > 0 {
+		for _, num := range m.AllowedAPIs {
+			dAtA[i] = 0x38
+			i++
+			i = encodeVarintMetapb(dAtA, i, uint64(num))
+		}
+	}
+	dAtA[i] = 0x42
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Owner)))
+	i += copy(dAtA[i:], m.Owner)
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *APIKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *APIKey) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.ID)))
+	i += copy(dAtA[i:], m.ID)
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Hash)))
+	i += copy(dAtA[i:], m.Hash)
+	dAtA[i] = 0x18
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.CreatedAt))
+	dAtA[i] = 0x20
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.ExpireAt))
+	dAtA[i] = 0x28
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.NotifiedAt))
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ConsumerUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerUsage) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0x8
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.Consumer))
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Day)))
+	i += copy(dAtA[i:], m.Day)
+	dAtA[i] = 0x18
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.Requests))
+	dAtA[i] = 0x20
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.API))
+	dAtA[i] = 0x28
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.Epoch))
+	dAtA[i] = 0x32
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Proxy)))
+	i += copy(dAtA[i:], m.Proxy)
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *LatencyHeatmap) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LatencyHeatmap) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.API)))
+	i += copy(dAtA[i:], m.API)
+	dAtA[i] = 0x10
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.Interval))
+	if len(m.Buckets) > 0 {
+		for _, num := range m.Buckets {
+			dAtA[i] = 0x19
+			i++
+			f52 := math.Float64bits(float64(num))
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(f52))
+			i += 8
+		}
+	}
+	if len(m.Rows) > 0 {
+		for _, msg := range m.Rows {
+			dAtA[i] = 0x22
+			i++
+			i = encodeVarintMetapb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *HeatmapRow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HeatmapRow) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0x8
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.Time))
+	if len(m.Counts) > 0 {
+		for _, num := range m.Counts {
+			dAtA[i] = 0x10
+			i++
+			i = encodeVarintMetapb(dAtA, i, uint64(num))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *RateLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RateLimit) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0x8
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.ID))
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Name)))
+	i += copy(dAtA[i:], m.Name)
+	dAtA[i] = 0x18
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.API))
+	dAtA[i] = 0x20
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.Key))
+	dAtA[i] = 0x2a
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Header)))
+	i += copy(dAtA[i:], m.Header)
+	dAtA[i] = 0x30
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.Rate))
+	dAtA[i] = 0x38
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.Period))
+	dAtA[i] = 0x40
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.Burst))
+	if len(m.Profiles) > 0 {
+		for _, msg := range m.Profiles {
+			dAtA[i] = 0x4a
+			i++
+			i = encodeVarintMetapb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.APIs) > 0 {
+		for _, num := range m.APIs {
+			dAtA[i] = 0x50
+			i++
+			i = encodeVarintMetapb(dAtA, i, uint64(num))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *RateLimitProfile) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RateLimitProfile) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.Window.Size()))
+	n53, err := m.Window.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n53
+	dAtA[i] = 0x10
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.Rate))
+	dAtA[i] = 0x18
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.Period))
+	dAtA[i] = 0x20
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.Burst))
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ProtoDescriptor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProtoDescriptor) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0x8
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.ID))
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Name)))
+	i += copy(dAtA[i:], m.Name)
+	if m.Data != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintMetapb(dAtA, i, uint64(len(m.Data)))
+		i += copy(dAtA[i:], m.Data)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *APIRateLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *APIRateLimit) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0x8
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.API))
+	dAtA[i] = 0x10
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.MaxQPS))
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *APITemplate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *APITemplate) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0x8
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.ID))
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Name)))
+	i += copy(dAtA[i:], m.Name)
+	if m.IPAccessControl != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintMetapb(dAtA, i, uint64(m.IPAccessControl.Size()))
+		n54, err := m.IPAccessControl.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n54
+	}
+	if m.DefaultValue != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintMetapb(dAtA, i, uint64(m.DefaultValue.Size()))
+		n55, err := m.DefaultValue.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n55
+	}
+	if len(m.Perms) > 0 {
+		for _, s := range m.Perms {
+			dAtA[i] = 0x2a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	dAtA[i] = 0x32
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.AuthFilter)))
+	i += copy(dAtA[i:], m.AuthFilter)
+	if len(m.Tags) > 0 {
+		for _, msg := range m.Tags {
+			dAtA[i] = 0x3a
+			i++
+			i = encodeVarintMetapb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	dAtA[i] = 0x40
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.MaxQPS))
+	if m.CircuitBreaker != nil {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintMetapb(dAtA, i, uint64(m.CircuitBreaker.Size()))
+		n56, err := m.CircuitBreaker.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n56
+	}
+	if m.RetryStrategy != nil {
+		dAtA[i] = 0x52
+		i++
+		i = encodeVarintMetapb(dAtA, i, uint64(m.RetryStrategy.Size()))
+		n57, err := m.RetryStrategy.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n57
+	}
+	dAtA[i] = 0x58
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.WriteTimeout))
+	dAtA[i] = 0x60
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.ReadTimeout))
+	if len(m.ErrorPages) > 0 {
+		for _, msg := range m.ErrorPages {
+			dAtA[i] = 0x6a
+			i++
+			i = encodeVarintMetapb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	dAtA[i] = 0x70
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.Timeout))
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func encodeFixed64Metapb(dAtA []byte, offset int, v uint64) int {
+	dAtA[offset] = uint8(v)
+	dAtA[offset+1] = uint8(v >> 8)
+	dAtA[offset+2] = uint8(v >> 16)
+	dAtA[offset+3] = uint8(v >> 24)
+	dAtA[offset+4] = uint8(v >> 32)
+	dAtA[offset+5] = uint8(v >> 40)
+	dAtA[offset+6] = uint8(v >> 48)
+	dAtA[offset+7] = uint8(v >> 56)
+	return offset + 8
+}
+func encodeFixed32Metapb(dAtA []byte, offset int, v uint32) int {
+	dAtA[offset] = uint8(v)
+	dAtA[offset+1] = uint8(v >> 8)
+	dAtA[offset+2] = uint8(v >> 16)
+	dAtA[offset+3] = uint8(v >> 24)
+	return offset + 4
+}
+func encodeVarintMetapb(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *Proxy) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Addr)
+	n += 1 + l + sovMetapb(uint64(l))
+	l = len(m.AddrRPC)
+	n += 1 + l + sovMetapb(uint64(l))
+	if len(m.Labels) > 0 {
+		for _, e := range m.Labels {
+			l = e.Size()
+			n += 1 + l + sovMetapb(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Cluster) Size() (n int) {
+	var l int
+	_ = l
+	n += 1 + sovMetapb(uint64(m.ID))
+	l = len(m.Name)
+	n += 1 + l + sovMetapb(uint64(l))
+	n += 1 + sovMetapb(uint64(m.LoadBalance))
+	if m.OutboundAuth != nil {
+		l = m.OutboundAuth.Size()
+		n += 1 + l + sovMetapb(uint64(l))
+	}
+	if m.HalfOpenProbe != nil {
+		l = m.HalfOpenProbe.Size()
+		n += 1 + l + sovMetapb(uint64(l))
+	}
+	if m.UpstreamHost != nil {
+		l = m.UpstreamHost.Size()
+		n += 1 + l + sovMetapb(uint64(l))
+	}
+	if len(m.Tags) > 0 {
+		for _, e := range m.Tags {
+			l = e.Size()
+			n += 1 + l + sovMetapb(uint64(l))
+		}
+	}
+	if m.DNS != nil {
+		l = m.DNS.Size()
+		n += 1 + l + sovMetapb(uint64(l))
+	}
+	n += 1 + sovMetapb(uint64(m.MinActive))
+	if m.RemoteGateway != nil {
+		l = m.RemoteGateway.Size()
+		n += 1 + l + sovMetapb(uint64(l))
+	}
+	if m.Policy != nil {
+		l = m.Policy.Size()
+		n += 1 + l + sovMetapb(uint64(l))
+	}
+	if m.CircuitBreaker != nil {
+		l = m.CircuitBreaker.Size()
+		n += 1 + l + sovMetapb(uint64(l))
+	}
+	if m.ConnRecycle != nil {
+		l = m.ConnRecycle.Size()
+		n += 1 + l + sovMetapb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ConnRecycle) Size() (n int) {
+	var l int
+	_ = l
+	n += 1 + sovMetapb(uint64(m.MaxLifetime))
+	n += 1 + sovMetapb(uint64(m.MaxRequests))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Policy) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.AuthFilter)
+	n += 1 + l + sovMetapb(uint64(l))
+	n += 1 + sovMetapb(uint64(m.MaxQPS))
+	if m.RetryStrategy != nil {
+		l = m.RetryStrategy.Size()
+		n += 1 + l + sovMetapb(uint64(l))
+	}
+	n += 1 + sovMetapb(uint64(m.WriteTimeout))
+	n += 1 + sovMetapb(uint64(m.ReadTimeout))
+	n += 1 + sovMetapb(uint64(m.Timeout))
+	if m.IPAccessControl != nil {
+		l = m.IPAccessControl.Size()
+		n += 1 + l + sovMetapb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RemoteGateway) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Secret)
+	n += 1 + l + sovMetapb(uint64(l))
+	n += 1 + sovMetapb(uint64(m.MaxHops))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DNSTarget) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Host)
+	n += 1 + l + sovMetapb(uint64(l))
+	n += 1 + sovMetapb(uint64(m.Port))
+	n += 1 + sovMetapb(uint64(m.Protocol))
+	n += 1 + sovMetapb(uint64(m.MaxQPS))
+	if m.HeathCheck != nil {
+		l = m.HeathCheck.Size()
+		n += 1 + l + sovMetapb(uint64(l))
+	}
+	if m.CircuitBreaker != nil {
+		l = m.CircuitBreaker.Size()
+		n += 1 + l + sovMetapb(uint64(l))
+	}
+	n += 1 + sovMetapb(uint64(m.RefreshInterval))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *HalfOpenProbe) Size() (n int) {
+	var l int
+	_ = l
+	n += 1 + sovMetapb(uint64(m.Strategy))
+	n += 1 + sovMetapb(uint64(m.MaxRequests))
+	n += 1 + sovMetapb(uint64(m.TrafficRate))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *OutboundAuth) Size() (n int) {
+	var l int
+	_ = l
+	n += 1 + sovMetapb(uint64(m.Type))
+	l = len(m.Token)
+	n += 1 + l + sovMetapb(uint64(l))
+	l = len(m.AccessKey)
+	n += 1 + l + sovMetapb(uint64(l))
+	l = len(m.SecretKey)
+	n += 1 + l + sovMetapb(uint64(l))
+	l = len(m.Region)
+	n += 1 + l + sovMetapb(uint64(l))
+	l = len(m.Service)
+	n += 1 + l + sovMetapb(uint64(l))
+	l = len(m.Header)
+	n += 1 + l + sovMetapb(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpstreamHost) Size() (n int) {
+	var l int
+	_ = l
+	n += 1 + sovMetapb(uint64(m.Type))
+	l = len(m.Value)
+	n += 1 + l + sovMetapb(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *HeathCheck) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Path)
+	n += 1 + l + sovMetapb(uint64(l))
+	l = len(m.Body)
+	n += 1 + l + sovMetapb(uint64(l))
+	n += 1 + sovMetapb(uint64(m.CheckInterval))
+	n += 1 + sovMetapb(uint64(m.Timeout))
+	n += 1 + sovMetapb(uint64(m.Type))
+	n += 1 + sovMetapb(uint64(m.HealthyThreshold))
+	n += 1 + sovMetapb(uint64(m.UnhealthyThreshold))
+	if len(m.Command) > 0 {
+		for _, s := range m.Command {
+			l = len(s)
+			n += 1 + l + sovMetapb(uint64(l))
+		}
+	}
+	l = len(m.Checker)
+	n += 1 + l + sovMetapb(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CircuitBreaker) Size() (n int) {
+	var l int
+	_ = l
+	n += 1 + sovMetapb(uint64(m.CloseTimeout))
+	n += 1 + sovMetapb(uint64(m.HalfTrafficRate))
+	n += 1 + sovMetapb(uint64(m.RateCheckPeriod))
+	n += 1 + sovMetapb(uint64(m.FailureRateToClose))
+	n += 1 + sovMetapb(uint64(m.SucceedRateToOpen))
+	n += 1 + sovMetapb(uint64(m.ContinuousFailuresToClose))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Server) Size() (n int) {
+	var l int
+	_ = l
+	n += 1 + sovMetapb(uint64(m.ID))
+	l = len(m.Addr)
+	n += 1 + l + sovMetapb(uint64(l))
+	n += 1 + sovMetapb(uint64(m.Protocol))
+	n += 1 + sovMetapb(uint64(m.MaxQPS))
+	if m.HeathCheck != nil {
+		l = m.HeathCheck.Size()
+		n += 1 + l + sovMetapb(uint64(l))
+	}
+	if m.CircuitBreaker != nil {
+		l = m.CircuitBreaker.Size()
+		n += 1 + l + sovMetapb(uint64(l))
+	}
+	if len(m.Tags) > 0 {
+		for _, e := range m.Tags {
+			l = e.Size()
+			n += 1 + l + sovMetapb(uint64(l))
+		}
+	}
+	n += 2
+	if m.Weight != nil {
+		l = m.Weight.Size()
+		n += 1 + l + sovMetapb(uint64(l))
+	}
+	n += 2
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ServerWeight) Size() (n int) {
+	var l int
+	_ = l
+	n += 1 + sovMetapb(uint64(m.From))
+	n += 1 + sovMetapb(uint64(m.Target))
+	n += 1 + sovMetapb(uint64(m.RampStartAt))
+	n += 1 + sovMetapb(uint64(m.RampSeconds))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Bind) Size() (n int) {
+	var l int
+	_ = l
+	n += 1 + sovMetapb(uint64(m.ClusterID))
+	n += 1 + sovMetapb(uint64(m.ServerID))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PairValue) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovMetapb(uint64(l))
+	l = len(m.Value)
+	n += 1 + l + sovMetapb(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *IPAccessControl) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Whitelist) > 0 {
+		for _, s := range m.Whitelist {
+			l = len(s)
+			n += 1 + l + sovMetapb(uint64(l))
+		}
+	}
+	if len(m.Blacklist) > 0 {
+		for _, s := range m.Blacklist {
+			l = len(s)
+			n += 1 + l + sovMetapb(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *HTTPResult) Size() (n int) {
+	var l int
+	_ = l
+	if m.Body != nil {
+		l = len(m.Body)
+		n += 1 + l + sovMetapb(uint64(l))
+	}
+	if len(m.Headers) > 0 {
+		for _, e := range m.Headers {
+			l = e.Size()
+			n += 1 + l + sovMetapb(uint64(l))
+		}
+	}
+	if len(m.Cookies) > 0 {
+		for _, e := range m.Cookies {
+			l = e.Size()
+			n += 1 + l + sovMetapb(uint64(l))
+		}
+	}
+	n += 1 + sovMetapb(uint64(m.Code))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ErrorPage) Size() (n int) {
+	var l int
+	_ = l
+	n += 1 + sovMetapb(uint64(m.Status))
+	n += 1 + sovMetapb(uint64(m.Code))
+	l = len(m.ContentType)
+	n += 1 + l + sovMetapb(uint64(l))
+	l = len(m.Body)
+	n += 1 + l + sovMetapb(uint64(l))
+	if len(m.Headers) > 0 {
+		for _, e := range m.Headers {
+			l = e.Size()
+			n += 1 + l + sovMetapb(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Parameter) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovMetapb(uint64(l))
+	n += 1 + sovMetapb(uint64(m.Source))
+	n += 1 + sovMetapb(uint64(m.Index))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidationRule) Size() (n int) {
+	var l int
+	_ = l
+	n += 1 + sovMetapb(uint64(m.RuleType))
+	l = len(m.Expression)
+	n += 1 + l + sovMetapb(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Validation) Size() (n int) {
+	var l int
+	_ = l
+	l = m.Parameter.Size()
+	n += 1 + l + sovMetapb(uint64(l))
+	n += 2
+	if len(m.Rules) > 0 {
+		for _, e := range m.Rules {
+			l = e.Size()
+			n += 1 + l + sovMetapb(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RetryStrategy) Size() (n int) {
+	var l int
+	_ = l
+	n += 1 + sovMetapb(uint64(m.Interval))
+	n += 1 + sovMetapb(uint64(m.MaxTimes))
+	if len(m.Codes) > 0 {
+		for _, e := range m.Codes {
+			n += 1 + sovMetapb(uint64(e))
+		}
+	}
+	n += 2
+	n += 1 + sovMetapb(uint64(m.MaxInterval))
+	n += 2
+	n += 1 + sovMetapb(uint64(m.PerTryTimeout))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DispatchNode) Size() (n int) {
+	var l int
+	_ = l
+	n += 1 + sovMetapb(uint64(m.ClusterID))
+	l = len(m.URLRewrite)
+	n += 1 + l + sovMetapb(uint64(l))
+	l = len(m.AttrName)
+	n += 1 + l + sovMetapb(uint64(l))
+	if len(m.Validations) > 0 {
+		for _, e := range m.Validations {
+			l = e.Size()
+			n += 1 + l + sovMetapb(uint64(l))
+		}
+	}
+	if m.Cache != nil {
+		l = m.Cache.Size()
+		n += 1 + l + sovMetapb(uint64(l))
+	}
+	if m.DefaultValue != nil {
+		l = m.DefaultValue.Size()
+		n += 1 + l + sovMetapb(uint64(l))
+	}
+	n += 2
+	n += 1 + sovMetapb(uint64(m.BatchIndex))
+	if m.RetryStrategy != nil {
+		l = m.RetryStrategy.Size()
+		n += 1 + l + sovMetapb(uint64(l))
+	}
+	n += 1 + sovMetapb(uint64(m.WriteTimeout))
+	n += 1 + sovMetapb(uint64(m.ReadTimeout))
+	if m.Affinity != nil {
+		l = m.Affinity.Size()
+		n += 1 + l + sovMetapb(uint64(l))
+	}
+	if m.GRPCTranscoding != nil {
+		l = m.GRPCTranscoding.Size()
+		n += 1 + l + sovMetapb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GRPCTranscoding) Size() (n int) {
+	var l int
+	_ = l
+	n += 1 + sovMetapb(uint64(m.DescriptorID))
+	l = len(m.Method)
+	n += 1 + l + sovMetapb(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Cache) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Keys) > 0 {
+		for _, e := range m.Keys {
+			l = e.Size()
+			n += 1 + l + sovMetapb(uint64(l))
+		}
+	}
+	n += 1 + sovMetapb(uint64(m.Deadline))
+	if len(m.Conditions) > 0 {
+		for _, e := range m.Conditions {
+			l = e.Size()
+			n += 1 + l + sovMetapb(uint64(l))
+		}
+	}
+	n += 2
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RenderTemplate) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Objects) > 0 {
+		for _, e := range m.Objects {
+			l = e.Size()
+			n += 1 + l + sovMetapb(uint64(l))
+		}
+	}
+	l = len(m.Body)
+	n += 1 + l + sovMetapb(uint64(l))
+	l = len(m.ContentType)
+	n += 1 + l + sovMetapb(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RenderObject) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovMetapb(uint64(l))
+	if len(m.Attrs) > 0 {
+		for _, e := range m.Attrs {
+			l = e.Size()
+			n += 1 + l + sovMetapb(uint64(l))
+		}
+	}
+	n += 2
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RenderAttr) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovMetapb(uint64(l))
+	l = len(m.ExtractExp)
+	n += 1 + l + sovMetapb(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *API) Size() (n int) {
+	var l int
+	_ = l
+	n += 1 + sovMetapb(uint64(m.ID))
+	l = len(m.Name)
+	n += 1 + l + sovMetapb(uint64(l))
+	l = len(m.URLPattern)
+	n += 1 + l + sovMetapb(uint64(l))
+	l = len(m.Method)
+	n += 1 + l + sovMetapb(uint64(l))
+	l = len(m.Domain)
+	n += 1 + l + sovMetapb(uint64(l))
+	n += 1 + sovMetapb(uint64(m.Status))
+	if m.IPAccessControl != nil {
+		l = m.IPAccessControl.Size()
+		n += 1 + l + sovMetapb(uint64(l))
+	}
+	if m.DefaultValue != nil {
+		l = m.DefaultValue.Size()
+		n += 1 + l + sovMetapb(uint64(l))
+	}
+	if len(m.Nodes) > 0 {
+		for _, e := range m.Nodes {
+			l = e.Size()
+			n += 1 + l + sovMetapb(uint64(l))
+		}
+	}
+	if len(m.Perms) > 0 {
+		for _, s := range m.Perms {
+			l = len(s)
+			n += 1 + l + sovMetapb(uint64(l))
+		}
+	}
+	l = len(m.AuthFilter)
+	n += 1 + l + sovMetapb(uint64(l))
+	if m.RenderTemplate != nil {
+		l = m.RenderTemplate.Size()
+		n += 1 + l + sovMetapb(uint64(l))
+	}
+	n += 2
+	n += 1 + sovMetapb(uint64(m.MatchRule))
+	n += 1 + sovMetapb(uint64(m.Position))
+	if len(m.Tags) > 0 {
+		for _, e := range m.Tags {
+			l = e.Size()
+			n += 2 + l + sovMetapb(uint64(l))
+		}
+	}
+	if m.WebSocketOptions != nil {
+		l = m.WebSocketOptions.Size()
+		n += 2 + l + sovMetapb(uint64(l))
+	}
+	n += 2 + sovMetapb(uint64(m.MaxQPS))
+	if m.CircuitBreaker != nil {
+		l = m.CircuitBreaker.Size()
+		n += 2 + l + sovMetapb(uint64(l))
+	}
+	n += 2 + sovMetapb(uint64(m.Template))
+	if len(m.ErrorPages) > 0 {
+		for _, e := range m.ErrorPages {
+			l = e.Size()
+			n += 2 + l + sovMetapb(uint64(l))
+		}
+	}
+	if m.Deprecation != nil {
+		l = m.Deprecation.Size()
+		n += 2 + l + sovMetapb(uint64(l))
+	}
+	if len(m.Aliases) > 0 {
+		for _, e := range m.Aliases {
+			l = e.Size()
+			n += 2 + l + sovMetapb(uint64(l))
+		}
+	}
+	if m.RequestSchema != nil {
+		l = m.RequestSchema.Size()
+		n += 2 + l + sovMetapb(uint64(l))
+	}
+	if m.ResponseSchema != nil {
+		l = m.ResponseSchema.Size()
+		n += 2 + l + sovMetapb(uint64(l))
+	}
+	if m.GraphQL != nil {
+		l = m.GraphQL.Size()
+		n += 2 + l + sovMetapb(uint64(l))
+	}
+	if m.AccessPolicy != nil {
+		l = m.AccessPolicy.Size()
+		n += 2 + l + sovMetapb(uint64(l))
+	}
+	if m.UpstreamHost != nil {
+		l = m.UpstreamHost.Size()
+		n += 2 + l + sovMetapb(uint64(l))
+	}
+	if m.Portal != nil {
+		l = m.Portal.Size()
+		n += 2 + l + sovMetapb(uint64(l))
+	}
+	n += 2 + sovMetapb(uint64(m.PublishState))
+	if m.Preview != nil {
+		l = m.Preview.Size()
+		n += 2 + l + sovMetapb(uint64(l))
+	}
+	if m.HeaderLimits != nil {
+		l = m.HeaderLimits.Size()
+		n += 2 + l + sovMetapb(uint64(l))
+	}
+	if m.RequestBody != nil {
+		l = m.RequestBody.Size()
+		n += 2 + l + sovMetapb(uint64(l))
+	}
+	if m.ContentTypes != nil {
+		l = m.ContentTypes.Size()
+		n += 2 + l + sovMetapb(uint64(l))
+	}
+	l = len(m.DegradedAttr)
+	n += 2 + l + sovMetapb(uint64(l))
+	if len(m.Constants) > 0 {
+		for _, e := range m.Constants {
+			l = e.Size()
+			n += 2 + l + sovMetapb(uint64(l))
+		}
+	}
+	if m.AccessLog != nil {
+		l = m.AccessLog.Size()
+		n += 2 + l + sovMetapb(uint64(l))
+	}
+	if len(m.ProxyGroups) > 0 {
+		for _, e := range m.ProxyGroups {
+			l = e.Size()
+			n += 2 + l + sovMetapb(uint64(l))
+		}
+	}
+	if m.SecurityHeaders != nil {
+		l = m.SecurityHeaders.Size()
+		n += 2 + l + sovMetapb(uint64(l))
+	}
+	if m.Approval != nil {
+		l = m.Approval.Size()
+		n += 2 + l + sovMetapb(uint64(l))
+	}
+	if m.Cache != nil {
+		l = m.Cache.Size()
+		n += 2 + l + sovMetapb(uint64(l))
+	}
+	n += 3
+	if m.Mirror != nil {
+		l = m.Mirror.Size()
+		n += 2 + l + sovMetapb(uint64(l))
+	}
+	n += 2 + sovMetapb(uint64(m.ReadTimeout))
+	n += 2 + sovMetapb(uint64(m.WriteTimeout))
+	n += 2 + sovMetapb(uint64(m.Timeout))
+	if m.HeaderTransform != nil {
+		l = m.HeaderTransform.Size()
+		n += 2 + l + sovMetapb(uint64(l))
+	}
+	n += 2 + sovMetapb(uint64(m.Visibility))
+	if m.PathRewrite != nil {
+		l = m.PathRewrite.Size()
+		n += 2 + l + sovMetapb(uint64(l))
+	}
+	if m.ResponseBody != nil {
+		l = m.ResponseBody.Size()
+		n += 2 + l + sovMetapb(uint64(l))
+	}
+	if m.OIDC != nil {
+		l = m.OIDC.Size()
+		n += 2 + l + sovMetapb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PathRewrite) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.StripPrefix)
+	n += 1 + l + sovMetapb(uint64(l))
+	l = len(m.Pattern)
+	n += 1 + l + sovMetapb(uint64(l))
+	l = len(m.Replacement)
+	n += 1 + l + sovMetapb(uint64(l))
+	l = len(m.AddPrefix)
+	n += 1 + l + sovMetapb(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *HeaderTransform) Size() (n int) {
+	var l int
+	_ = l
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 1 + l + sovMetapb(uint64(l))
+	}
+	if m.Response != nil {
+		l = m.Response.Size()
+		n += 1 + l + sovMetapb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *HeaderRules) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Remove) > 0 {
+		for _, s := range m.Remove {
+			l = len(s)
+			n += 1 + l + sovMetapb(uint64(l))
+		}
+	}
+	if len(m.Rename) > 0 {
+		for _, e := range m.Rename {
+			l = e.Size()
+			n += 1 + l + sovMetapb(uint64(l))
+		}
+	}
+	if len(m.Set) > 0 {
+		for _, e := range m.Set {
+			l = e.Size()
+			n += 1 + l + sovMetapb(uint64(l))
+		}
+	}
+	if len(m.Defaults) > 0 {
+		for _, e := range m.Defaults {
+			l = e.Size()
+			n += 1 + l + sovMetapb(uint64(l))
+		}
+	}
+	if len(m.Add) > 0 {
+		for _, e := range m.Add {
+			l = e.Size()
+			n += 1 + l + sovMetapb(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Mirror) Size() (n int) {
+	var l int
+	_ = l
+	n += 1 + sovMetapb(uint64(m.ClusterID))
+	n += 1 + sovMetapb(uint64(m.Rate))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Approval) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Submitter)
+	n += 1 + l + sovMetapb(uint64(l))
+	n += 1 + sovMetapb(uint64(m.SubmittedAt))
+	l = len(m.Approver)
+	n += 1 + l + sovMetapb(uint64(l))
+	n += 1 + sovMetapb(uint64(m.ApprovedAt))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ProxyGroup) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Labels) > 0 {
+		for _, e := range m.Labels {
+			l = e.Size()
+			n += 1 + l + sovMetapb(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AccessLog) Size() (n int) {
+	var l int
+	_ = l
+	n += 2
+	n += 1 + sovMetapb(uint64(m.Sampling))
+	n += 1 + sovMetapb(uint64(m.Format))
+	if len(m.Fields) > 0 {
+		for _, s := range m.Fields {
+			l = len(s)
+			n += 1 + l + sovMetapb(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SecurityHeaders) Size() (n int) {
+	var l int
+	_ = l
+	n += 2
+	n += 1 + sovMetapb(uint64(m.HSTSMaxAge))
+	n += 2
+	n += 2
+	n += 2
+	l = len(m.FrameOptions)
+	n += 1 + l + sovMetapb(uint64(l))
+	l = len(m.ContentSecurityPolicy)
+	n += 1 + l + sovMetapb(uint64(l))
+	l = len(m.ReferrerPolicy)
+	n += 1 + l + sovMetapb(uint64(l))
+	l = len(m.Server)
+	n += 1 + l + sovMetapb(uint64(l))
+	if len(m.RemoveHeaders) > 0 {
+		for _, s := range m.RemoveHeaders {
+			l = len(s)
+			n += 1 + l + sovMetapb(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ContentTypes) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Consumes) > 0 {
+		for _, s := range m.Consumes {
+			l = len(s)
+			n += 1 + l + sovMetapb(uint64(l))
+		}
+	}
+	if len(m.Produces) > 0 {
+		for _, s := range m.Produces {
+			l = len(s)
+			n += 1 + l + sovMetapb(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RequestBodyPolicy) Size() (n int) {
+	var l int
+	_ = l
+	n += 1 + sovMetapb(uint64(m.MaxBytes))
+	n += 1 + sovMetapb(uint64(m.MaxBufferBytes))
+	n += 2
+	n += 1 + sovMetapb(uint64(m.MaxPartBytes))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ResponseBodyPolicy) Size() (n int) {
+	var l int
+	_ = l
+	n += 1 + sovMetapb(uint64(m.MaxBytes))
+	n += 2
+	l = len(m.Header)
+	n += 1 + l + sovMetapb(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *OIDCPolicy) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Audience)
+	n += 1 + l + sovMetapb(uint64(l))
+	if len(m.Scopes) > 0 {
+		for _, s := range m.Scopes {
+			l = len(s)
+			n += 1 + l + sovMetapb(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *HeaderLimits) Size() (n int) {
+	var l int
+	_ = l
+	n += 1 + sovMetapb(uint64(m.MaxRequestHeaders))
+	n += 1 + sovMetapb(uint64(m.MaxRequestHeaderBytes))
+	n += 1 + sovMetapb(uint64(m.MaxResponseHeaders))
+	n += 1 + sovMetapb(uint64(m.MaxResponseHeaderBytes))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PreviewOptions) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Header)
+	n += 1 + l + sovMetapb(uint64(l))
+	l = len(m.Secret)
+	n += 1 + l + sovMetapb(uint64(l))
+	if len(m.IPs) > 0 {
+		for _, s := range m.IPs {
+			l = len(s)
+			n += 1 + l + sovMetapb(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PortalOptions) Size() (n int) {
+	var l int
+	_ = l
+	n += 2
+	l = len(m.Description)
+	n += 1 + l + sovMetapb(uint64(l))
+	l = len(m.Doc)
+	n += 1 + l + sovMetapb(uint64(l))
+	n += 2
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AccessPolicy) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.TimeWindows) > 0 {
+		for _, e := range m.TimeWindows {
+			l = e.Size()
+			n += 1 + l + sovMetapb(uint64(l))
+		}
+	}
+	if m.Anomaly != nil {
+		l = m.Anomaly.Size()
+		n += 1 + l + sovMetapb(uint64(l))
+	}
+	if len(m.Overrides) > 0 {
+		for _, s := range m.Overrides {
+			l = len(s)
+			n += 1 + l + sovMetapb(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TimeWindow) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Weekdays) > 0 {
+		for _, e := range m.Weekdays {
+			n += 1 + sovMetapb(uint64(e))
+		}
+	}
+	l = len(m.Start)
+	n += 1 + l + sovMetapb(uint64(l))
+	l = len(m.End)
+	n += 1 + l + sovMetapb(uint64(l))
+	l = len(m.Location)
+	n += 1 + l + sovMetapb(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AnomalyRule) Size() (n int) {
+	var l int
+	_ = l
+	n += 1 + sovMetapb(uint64(m.Multiple))
+	n += 1 + sovMetapb(uint64(m.MinQPS))
+	n += 1 + sovMetapb(uint64(m.BlockSeconds))
+	l = len(m.Header)
+	n += 1 + l + sovMetapb(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GraphQLOptions) Size() (n int) {
+	var l int
+	_ = l
+	n += 1 + sovMetapb(uint64(m.MaxDepth))
+	n += 1 + sovMetapb(uint64(m.MaxComplexity))
+	if len(m.Resolvers) > 0 {
+		for _, e := range m.Resolvers {
+			l = e.Size()
+			n += 1 + l + sovMetapb(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GraphQLResolver) Size() (n int) {
+	var l int
+	_ = l
+	n += 1 + sovMetapb(uint64(m.Operation))
+	l = len(m.Field)
+	n += 1 + l + sovMetapb(uint64(l))
+	n += 1 + sovMetapb(uint64(m.ClusterID))
+	l = len(m.Method)
+	n += 1 + l + sovMetapb(uint64(l))
+	l = len(m.URL)
+	n += 1 + l + sovMetapb(uint64(l))
+	l = len(m.ExtractExp)
+	n += 1 + l + sovMetapb(uint64(l))
+	n += 1 + sovMetapb(uint64(m.Complexity))
+	n += 1 + sovMetapb(uint64(m.WriteTimeout))
+	n += 1 + sovMetapb(uint64(m.ReadTimeout))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ResponseSchema) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Body)
+	n += 1 + l + sovMetapb(uint64(l))
+	n += 1 + sovMetapb(uint64(m.Sampling))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RequestSchema) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Query)
+	n += 1 + l + sovMetapb(uint64(l))
+	l = len(m.Header)
+	n += 1 + l + sovMetapb(uint64(l))
+	l = len(m.Body)
+	n += 1 + l + sovMetapb(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *APIAlias) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.URLPattern)
+	n += 1 + l + sovMetapb(uint64(l))
+	l = len(m.Method)
+	n += 1 + l + sovMetapb(uint64(l))
+	l = len(m.Domain)
+	n += 1 + l + sovMetapb(uint64(l))
+	n += 1 + sovMetapb(uint64(m.MatchRule))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Deprecation) Size() (n int) {
+	var l int
+	_ = l
+	n += 1 + sovMetapb(uint64(m.DeprecatedAt))
+	n += 1 + sovMetapb(uint64(m.SunsetAt))
+	l = len(m.Link)
+	n += 1 + l + sovMetapb(uint64(l))
+	l = len(m.Warning)
+	n += 1 + l + sovMetapb(uint64(l))
+	n += 2
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Condition) Size() (n int) {
+	var l int
+	_ = l
+	l = m.Parameter.Size()
+	n += 1 + l + sovMetapb(uint64(l))
+	n += 1 + sovMetapb(uint64(m.Cmp))
+	l = len(m.Expect)
+	n += 1 + l + sovMetapb(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Routing) Size() (n int) {
+	var l int
+	_ = l
+	n += 1 + sovMetapb(uint64(m.ID))
+	n += 1 + sovMetapb(uint64(m.ClusterID))
+	if len(m.Conditions) > 0 {
+		for _, e := range m.Conditions {
+			l = e.Size()
+			n += 1 + l + sovMetapb(uint64(l))
+		}
+	}
+	n += 1 + sovMetapb(uint64(m.Strategy))
+	n += 1 + sovMetapb(uint64(m.TrafficRate))
+	n += 1 + sovMetapb(uint64(m.Status))
+	n += 1 + sovMetapb(uint64(m.API))
+	l = len(m.Name)
+	n += 1 + l + sovMetapb(uint64(l))
+	if len(m.Tags) > 0 {
+		for _, e := range m.Tags {
+			l = e.Size()
+			n += 1 + l + sovMetapb(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WebSocketOptions) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Origin)
+	n += 1 + l + sovMetapb(uint64(l))
+	n += 1 + sovMetapb(uint64(m.MaxConns))
+	n += 1 + sovMetapb(uint64(m.IdleTimeout))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *System) Size() (n int) {
+	var l int
+	_ = l
+	l = m.Count.Size()
+	n += 1 + l + sovMetapb(uint64(l))
+	n += 1 + sovMetapb(uint64(m.Revision))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
 }
 
 func (m *CountMetric) Size() (n int) {
 	var l int
 	_ = l
-	n += 1 + sovMetapb(uint64(m.Cluster))
-	n += 1 + sovMetapb(uint64(m.Server))
+	n += 1 + sovMetapb(uint64(m.Cluster))
+	n += 1 + sovMetapb(uint64(m.Server))
+	n += 1 + sovMetapb(uint64(m.API))
+	n += 1 + sovMetapb(uint64(m.Routing))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ServerHealth) Size() (n int) {
+	var l int
+	_ = l
+	n += 1 + sovMetapb(uint64(m.ServerID))
+	l = len(m.Addr)
+	n += 1 + l + sovMetapb(uint64(l))
+	l = len(m.Proxy)
+	n += 1 + l + sovMetapb(uint64(l))
+	n += 1 + sovMetapb(uint64(m.Status))
+	n += 1 + sovMetapb(uint64(m.LastCheckAt))
+	l = len(m.Reason)
+	n += 1 + l + sovMetapb(uint64(l))
+	n += 1 + sovMetapb(uint64(m.Score))
+	n += 1 + sovMetapb(uint64(m.LatencyEWMA))
+	if len(m.Timeouts) > 0 {
+		for _, e := range m.Timeouts {
+			l = e.Size()
+			n += 1 + l + sovMetapb(uint64(l))
+		}
+	}
+	if len(m.Phases) > 0 {
+		for _, e := range m.Phases {
+			l = e.Size()
+			n += 1 + l + sovMetapb(uint64(l))
+		}
+	}
+	n += 1 + sovMetapb(uint64(m.Weight))
+	n += 1 + sovMetapb(uint64(m.Circuit))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PhaseTimeout) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Phase)
+	n += 1 + l + sovMetapb(uint64(l))
+	n += 1 + sovMetapb(uint64(m.Count))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PhaseLatency) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Phase)
+	n += 1 + l + sovMetapb(uint64(l))
+	n += 1 + sovMetapb(uint64(m.LatencyEWMA))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *FleetServerHealth) Size() (n int) {
+	var l int
+	_ = l
+	n += 1 + sovMetapb(uint64(m.ServerID))
+	l = len(m.Addr)
+	n += 1 + l + sovMetapb(uint64(l))
+	n += 1 + sovMetapb(uint64(m.Status))
+	if len(m.Proxies) > 0 {
+		for _, e := range m.Proxies {
+			l = e.Size()
+			n += 1 + l + sovMetapb(uint64(l))
+		}
+	}
+	n += 1 + sovMetapb(uint64(m.Score))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *HealthTransition) Size() (n int) {
+	var l int
+	_ = l
+	n += 1 + sovMetapb(uint64(m.ServerID))
+	l = len(m.Addr)
+	n += 1 + l + sovMetapb(uint64(l))
+	l = len(m.Proxy)
+	n += 1 + l + sovMetapb(uint64(l))
+	n += 1 + sovMetapb(uint64(m.From))
+	n += 1 + sovMetapb(uint64(m.To))
+	l = len(m.Reason)
+	n += 1 + l + sovMetapb(uint64(l))
+	n += 1 + sovMetapb(uint64(m.At))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StandbyEvent) Size() (n int) {
+	var l int
+	_ = l
+	n += 1 + sovMetapb(uint64(m.ClusterID))
+	n += 1 + sovMetapb(uint64(m.ServerID))
+	l = len(m.Proxy)
+	n += 1 + l + sovMetapb(uint64(l))
+	n += 2
+	n += 1 + sovMetapb(uint64(m.Active))
+	n += 1 + sovMetapb(uint64(m.At))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ConfigPropagation) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Proxy)
+	n += 1 + l + sovMetapb(uint64(l))
+	n += 1 + sovMetapb(uint64(m.Revision))
+	n += 1 + sovMetapb(uint64(m.WriteAt))
+	n += 1 + sovMetapb(uint64(m.AppliedAt))
+	n += 1 + sovMetapb(uint64(m.Latency))
+	n += 1 + sovMetapb(uint64(m.LatencyEWMA))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RouteTest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Proxy)
+	n += 1 + l + sovMetapb(uint64(l))
+	l = len(m.Method)
+	n += 1 + l + sovMetapb(uint64(l))
+	l = len(m.Host)
+	n += 1 + l + sovMetapb(uint64(l))
+	l = len(m.Path)
+	n += 1 + l + sovMetapb(uint64(l))
+	if len(m.Headers) > 0 {
+		for _, e := range m.Headers {
+			l = e.Size()
+			n += 1 + l + sovMetapb(uint64(l))
+		}
+	}
+	l = len(m.Body)
+	n += 1 + l + sovMetapb(uint64(l))
+	l = len(m.ClientIP)
+	n += 1 + l + sovMetapb(uint64(l))
+	n += 2
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RouteTestResult) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Proxy)
+	n += 1 + l + sovMetapb(uint64(l))
+	n += 1 + sovMetapb(uint64(m.Code))
+	l = len(m.Reason)
+	n += 1 + l + sovMetapb(uint64(l))
+	n += 1 + sovMetapb(uint64(m.API))
+	l = len(m.APIName)
+	n += 1 + l + sovMetapb(uint64(l))
+	n += 2
+	if len(m.Nodes) > 0 {
+		for _, e := range m.Nodes {
+			l = e.Size()
+			n += 1 + l + sovMetapb(uint64(l))
+		}
+	}
+	if len(m.Filters) > 0 {
+		for _, s := range m.Filters {
+			l = len(s)
+			n += 1 + l + sovMetapb(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RouteTestNode) Size() (n int) {
+	var l int
+	_ = l
+	n += 1 + sovMetapb(uint64(m.ClusterID))
+	l = len(m.ClusterName)
+	n += 1 + l + sovMetapb(uint64(l))
+	n += 1 + sovMetapb(uint64(m.ServerID))
+	l = len(m.ServerAddr)
+	n += 1 + l + sovMetapb(uint64(l))
+	n += 1 + sovMetapb(uint64(m.Routing))
+	l = len(m.RoutingName)
+	n += 1 + l + sovMetapb(uint64(l))
+	l = len(m.CopyTo)
+	n += 1 + l + sovMetapb(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *MetaDiff) Size() (n int) {
+	var l int
+	_ = l
+	n += 1 + sovMetapb(uint64(m.From))
+	n += 1 + sovMetapb(uint64(m.To))
+	if len(m.Changes) > 0 {
+		for _, e := range m.Changes {
+			l = e.Size()
+			n += 1 + l + sovMetapb(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *MetaChange) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Kind)
+	n += 1 + l + sovMetapb(uint64(l))
+	n += 1 + sovMetapb(uint64(m.ID))
+	l = len(m.Name)
+	n += 1 + l + sovMetapb(uint64(l))
+	n += 1 + sovMetapb(uint64(m.Type))
+	if len(m.Fields) > 0 {
+		for _, e := range m.Fields {
+			l = e.Size()
+			n += 1 + l + sovMetapb(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *FieldChange) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Path)
+	n += 1 + l + sovMetapb(uint64(l))
+	l = len(m.From)
+	n += 1 + l + sovMetapb(uint64(l))
+	l = len(m.To)
+	n += 1 + l + sovMetapb(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Changeset) Size() (n int) {
+	var l int
+	_ = l
+	n += 1 + sovMetapb(uint64(m.ID))
+	l = len(m.Name)
+	n += 1 + l + sovMetapb(uint64(l))
+	l = len(m.Description)
+	n += 1 + l + sovMetapb(uint64(l))
+	l = len(m.Operator)
+	n += 1 + l + sovMetapb(uint64(l))
+	n += 1 + sovMetapb(uint64(m.State))
+	n += 1 + sovMetapb(uint64(m.CreatedAt))
+	n += 1 + sovMetapb(uint64(m.CommittedAt))
+	n += 1 + sovMetapb(uint64(m.RolledBackAt))
+	n += 1 + sovMetapb(uint64(m.RollbackRevision))
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovMetapb(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ChangeRecord) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Description)
+	n += 1 + l + sovMetapb(uint64(l))
+	l = len(m.Operator)
+	n += 1 + l + sovMetapb(uint64(l))
+	l = len(m.Method)
+	n += 1 + l + sovMetapb(uint64(l))
+	l = len(m.Path)
+	n += 1 + l + sovMetapb(uint64(l))
+	n += 1 + sovMetapb(uint64(m.From))
+	n += 1 + sovMetapb(uint64(m.To))
+	n += 1 + sovMetapb(uint64(m.At))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ConsistencyReport) Size() (n int) {
+	var l int
+	_ = l
+	n += 1 + sovMetapb(uint64(m.CheckedAt))
+	if len(m.Findings) > 0 {
+		for _, e := range m.Findings {
+			l = e.Size()
+			n += 1 + l + sovMetapb(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ConfigFinding) Size() (n int) {
+	var l int
+	_ = l
+	n += 1 + sovMetapb(uint64(m.Type))
+	l = len(m.Kind)
+	n += 1 + l + sovMetapb(uint64(l))
+	n += 1 + sovMetapb(uint64(m.ID))
+	l = len(m.Name)
+	n += 1 + l + sovMetapb(uint64(l))
+	l = len(m.Message)
+	n += 1 + l + sovMetapb(uint64(l))
+	l = len(m.Suggestion)
+	n += 1 + l + sovMetapb(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ProxyOverride) Size() (n int) {
+	var l int
+	_ = l
+	n += 1 + sovMetapb(uint64(m.ID))
+	l = len(m.Name)
+	n += 1 + l + sovMetapb(uint64(l))
+	l = len(m.Proxy)
+	n += 1 + l + sovMetapb(uint64(l))
+	if len(m.Labels) > 0 {
+		for _, e := range m.Labels {
+			l = e.Size()
+			n += 1 + l + sovMetapb(uint64(l))
+		}
+	}
+	if len(m.EnabledAPIs) > 0 {
+		for _, e := range m.EnabledAPIs {
+			n += 1 + sovMetapb(uint64(e))
+		}
+	}
+	if len(m.DisabledAPIs) > 0 {
+		for _, e := range m.DisabledAPIs {
+			n += 1 + sovMetapb(uint64(e))
+		}
+	}
+	if len(m.RateLimits) > 0 {
+		for _, e := range m.RateLimits {
+			l = e.Size()
+			n += 1 + l + sovMetapb(uint64(l))
+		}
+	}
+	l = len(m.LogLevel)
+	n += 1 + l + sovMetapb(uint64(l))
+	n += 1 + sovMetapb(uint64(m.AccessLogSampling))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *KillSwitch) Size() (n int) {
+	var l int
+	_ = l
+	n += 2
+	if len(m.APIs) > 0 {
+		for _, e := range m.APIs {
+			n += 1 + sovMetapb(uint64(e))
+		}
+	}
+	if len(m.AllowedAPIs) > 0 {
+		for _, e := range m.AllowedAPIs {
+			n += 1 + sovMetapb(uint64(e))
+		}
+	}
+	if len(m.AllowedIPs) > 0 {
+		for _, s := range m.AllowedIPs {
+			l = len(s)
+			n += 1 + l + sovMetapb(uint64(l))
+		}
+	}
+	n += 1 + sovMetapb(uint64(m.Code))
+	l = len(m.ContentType)
+	n += 1 + l + sovMetapb(uint64(l))
+	if len(m.Headers) > 0 {
+		for _, e := range m.Headers {
+			l = e.Size()
+			n += 1 + l + sovMetapb(uint64(l))
+		}
+	}
+	l = len(m.Body)
+	n += 1 + l + sovMetapb(uint64(l))
+	l = len(m.Reason)
+	n += 1 + l + sovMetapb(uint64(l))
+	n += 1 + sovMetapb(uint64(m.UpdatedAt))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Consumer) Size() (n int) {
+	var l int
+	_ = l
+	n += 1 + sovMetapb(uint64(m.ID))
+	l = len(m.Name)
+	n += 1 + l + sovMetapb(uint64(l))
+	n += 1 + sovMetapb(uint64(m.Quota))
+	if len(m.Keys) > 0 {
+		for _, e := range m.Keys {
+			l = e.Size()
+			n += 1 + l + sovMetapb(uint64(l))
+		}
+	}
+	l = len(m.Webhook)
+	n += 1 + l + sovMetapb(uint64(l))
+	n += 2
+	if len(m.AllowedAPIs) > 0 {
+		for _, e := range m.AllowedAPIs {
+			n += 1 + sovMetapb(uint64(e))
+		}
+	}
+	l = len(m.Owner)
+	n += 1 + l + sovMetapb(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *APIKey) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ID)
+	n += 1 + l + sovMetapb(uint64(l))
+	l = len(m.Hash)
+	n += 1 + l + sovMetapb(uint64(l))
+	n += 1 + sovMetapb(uint64(m.CreatedAt))
+	n += 1 + sovMetapb(uint64(m.ExpireAt))
+	n += 1 + sovMetapb(uint64(m.NotifiedAt))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ConsumerUsage) Size() (n int) {
+	var l int
+	_ = l
+	n += 1 + sovMetapb(uint64(m.Consumer))
+	l = len(m.Day)
+	n += 1 + l + sovMetapb(uint64(l))
+	n += 1 + sovMetapb(uint64(m.Requests))
+	n += 1 + sovMetapb(uint64(m.API))
+	n += 1 + sovMetapb(uint64(m.Epoch))
+	l = len(m.Proxy)
+	n += 1 + l + sovMetapb(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *LatencyHeatmap) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.API)
+	n += 1 + l + sovMetapb(uint64(l))
+	n += 1 + sovMetapb(uint64(m.Interval))
+	if len(m.Buckets) > 0 {
+		n += 9 * len(m.Buckets)
+	}
+	if len(m.Rows) > 0 {
+		for _, e := range m.Rows {
+			l = e.Size()
+			n += 1 + l + sovMetapb(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *HeatmapRow) Size() (n int) {
+	var l int
+	_ = l
+	n += 1 + sovMetapb(uint64(m.Time))
+	if len(m.Counts) > 0 {
+		for _, e := range m.Counts {
+			n += 1 + sovMetapb(uint64(e))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RateLimit) Size() (n int) {
+	var l int
+	_ = l
+	n += 1 + sovMetapb(uint64(m.ID))
+	l = len(m.Name)
+	n += 1 + l + sovMetapb(uint64(l))
+	n += 1 + sovMetapb(uint64(m.API))
+	n += 1 + sovMetapb(uint64(m.Key))
+	l = len(m.Header)
+	n += 1 + l + sovMetapb(uint64(l))
+	n += 1 + sovMetapb(uint64(m.Rate))
+	n += 1 + sovMetapb(uint64(m.Period))
+	n += 1 + sovMetapb(uint64(m.Burst))
+	if len(m.Profiles) > 0 {
+		for _, e := range m.Profiles {
+			l = e.Size()
+			n += 1 + l + sovMetapb(uint64(l))
+		}
+	}
+	if len(m.APIs) > 0 {
+		for _, e := range m.APIs {
+			n += 1 + sovMetapb(uint64(e))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RateLimitProfile) Size() (n int) {
+	var l int
+	_ = l
+	l = m.Window.Size()
+	n += 1 + l + sovMetapb(uint64(l))
+	n += 1 + sovMetapb(uint64(m.Rate))
+	n += 1 + sovMetapb(uint64(m.Period))
+	n += 1 + sovMetapb(uint64(m.Burst))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ProtoDescriptor) Size() (n int) {
+	var l int
+	_ = l
+	n += 1 + sovMetapb(uint64(m.ID))
+	l = len(m.Name)
+	n += 1 + l + sovMetapb(uint64(l))
+	if m.Data != nil {
+		l = len(m.Data)
+		n += 1 + l + sovMetapb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *APIRateLimit) Size() (n int) {
+	var l int
+	_ = l
 	n += 1 + sovMetapb(uint64(m.API))
-	n += 1 + sovMetapb(uint64(m.Routing))
+	n += 1 + sovMetapb(uint64(m.MaxQPS))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *APITemplate) Size() (n int) {
+	var l int
+	_ = l
+	n += 1 + sovMetapb(uint64(m.ID))
+	l = len(m.Name)
+	n += 1 + l + sovMetapb(uint64(l))
+	if m.IPAccessControl != nil {
+		l = m.IPAccessControl.Size()
+		n += 1 + l + sovMetapb(uint64(l))
+	}
+	if m.DefaultValue != nil {
+		l = m.DefaultValue.Size()
+		n += 1 + l + sovMetapb(uint64(l))
+	}
+	if len(m.Perms) > 0 {
+		for _, s := range m.Perms {
+			l = len(s)
+			n += 1 + l + sovMetapb(uint64(l))
+		}
+	}
+	l = len(m.AuthFilter)
+	n += 1 + l + sovMetapb(uint64(l))
+	if len(m.Tags) > 0 {
+		for _, e := range m.Tags {
+			l = e.Size()
+			n += 1 + l + sovMetapb(uint64(l))
+		}
+	}
+	n += 1 + sovMetapb(uint64(m.MaxQPS))
+	if m.CircuitBreaker != nil {
+		l = m.CircuitBreaker.Size()
+		n += 1 + l + sovMetapb(uint64(l))
+	}
+	if m.RetryStrategy != nil {
+		l = m.RetryStrategy.Size()
+		n += 1 + l + sovMetapb(uint64(l))
+	}
+	n += 1 + sovMetapb(uint64(m.WriteTimeout))
+	n += 1 + sovMetapb(uint64(m.ReadTimeout))
+	if len(m.ErrorPages) > 0 {
+		for _, e := range m.ErrorPages {
+			l = e.Size()
+			n += 1 + l + sovMetapb(uint64(l))
+		}
+	}
+	n += 1 + sovMetapb(uint64(m.Timeout))
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
-	return n
-}
+	return n
+}
+
+func sovMetapb(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozMetapb(x uint64) (n int) {
+	return sovMetapb(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Proxy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetapb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Proxy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Proxy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddrRPC", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddrRPC = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Labels = append(m.Labels, PairValue{})
+			if err := m.Labels[len(m.Labels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetapb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Cluster) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetapb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Cluster: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Cluster: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LoadBalance", wireType)
+			}
+			m.LoadBalance = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LoadBalance |= (LoadBalance(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutboundAuth", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OutboundAuth == nil {
+				m.OutboundAuth = &OutboundAuth{}
+			}
+			if err := m.OutboundAuth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HalfOpenProbe", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HalfOpenProbe == nil {
+				m.HalfOpenProbe = &HalfOpenProbe{}
+			}
+			if err := m.HalfOpenProbe.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpstreamHost", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UpstreamHost == nil {
+				m.UpstreamHost = &UpstreamHost{}
+			}
+			if err := m.UpstreamHost.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tags = append(m.Tags, &PairValue{})
+			if err := m.Tags[len(m.Tags)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DNS", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DNS == nil {
+				m.DNS = &DNSTarget{}
+			}
+			if err := m.DNS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinActive", wireType)
+			}
+			m.MinActive = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinActive |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteGateway", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RemoteGateway == nil {
+				m.RemoteGateway = &RemoteGateway{}
+			}
+			if err := m.RemoteGateway.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Policy == nil {
+				m.Policy = &Policy{}
+			}
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreaker", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CircuitBreaker == nil {
+				m.CircuitBreaker = &CircuitBreaker{}
+			}
+			if err := m.CircuitBreaker.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnRecycle", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConnRecycle == nil {
+				m.ConnRecycle = &ConnRecycle{}
+			}
+			if err := m.ConnRecycle.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetapb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConnRecycle) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetapb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConnRecycle: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConnRecycle: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLifetime", wireType)
+			}
+			m.MaxLifetime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxLifetime |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRequests", wireType)
+			}
+			m.MaxRequests = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRequests |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetapb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Policy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetapb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Policy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Policy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthFilter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuthFilter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxQPS", wireType)
+			}
+			m.MaxQPS = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxQPS |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryStrategy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RetryStrategy == nil {
+				m.RetryStrategy = &RetryStrategy{}
+			}
+			if err := m.RetryStrategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WriteTimeout", wireType)
+			}
+			m.WriteTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WriteTimeout |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadTimeout", wireType)
+			}
+			m.ReadTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReadTimeout |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			m.Timeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timeout |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IPAccessControl", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.IPAccessControl == nil {
+				m.IPAccessControl = &IPAccessControl{}
+			}
+			if err := m.IPAccessControl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetapb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemoteGateway) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetapb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoteGateway: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoteGateway: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Secret", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Secret = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxHops", wireType)
+			}
+			m.MaxHops = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxHops |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetapb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DNSTarget) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetapb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DNSTarget: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DNSTarget: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Host", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Host = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Port", wireType)
+			}
+			m.Port = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Port |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Protocol", wireType)
+			}
+			m.Protocol = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Protocol |= (Protocol(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxQPS", wireType)
+			}
+			m.MaxQPS = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxQPS |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeathCheck", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HeathCheck == nil {
+				m.HeathCheck = &HeathCheck{}
+			}
+			if err := m.HeathCheck.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreaker", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CircuitBreaker == nil {
+				m.CircuitBreaker = &CircuitBreaker{}
+			}
+			if err := m.CircuitBreaker.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefreshInterval", wireType)
+			}
+			m.RefreshInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RefreshInterval |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetapb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HalfOpenProbe) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetapb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HalfOpenProbe: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HalfOpenProbe: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Strategy", wireType)
+			}
+			m.Strategy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Strategy |= (ProbeStrategy(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRequests", wireType)
+			}
+			m.MaxRequests = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRequests |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrafficRate", wireType)
+			}
+			m.TrafficRate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TrafficRate |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetapb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OutboundAuth) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetapb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OutboundAuth: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OutboundAuth: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= (OutboundAuthType(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Token = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccessKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SecretKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Region = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Service = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Header = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetapb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpstreamHost) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetapb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpstreamHost: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpstreamHost: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= (HostType(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetapb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HeathCheck) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetapb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HeathCheck: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HeathCheck: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Body", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Body = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckInterval", wireType)
+			}
+			m.CheckInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CheckInterval |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			m.Timeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timeout |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= (HeathCheckType(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HealthyThreshold", wireType)
+			}
+			m.HealthyThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HealthyThreshold |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnhealthyThreshold", wireType)
+			}
+			m.UnhealthyThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnhealthyThreshold |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Command", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Command = append(m.Command, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Checker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetapb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CircuitBreaker) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetapb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CircuitBreaker: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CircuitBreaker: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CloseTimeout", wireType)
+			}
+			m.CloseTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CloseTimeout |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HalfTrafficRate", wireType)
+			}
+			m.HalfTrafficRate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HalfTrafficRate |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateCheckPeriod", wireType)
+			}
+			m.RateCheckPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RateCheckPeriod |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailureRateToClose", wireType)
+			}
+			m.FailureRateToClose = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FailureRateToClose |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SucceedRateToOpen", wireType)
+			}
+			m.SucceedRateToOpen = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SucceedRateToOpen |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContinuousFailuresToClose", wireType)
+			}
+			m.ContinuousFailuresToClose = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContinuousFailuresToClose |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetapb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Server) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetapb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Server: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Server: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Protocol", wireType)
+			}
+			m.Protocol = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Protocol |= (Protocol(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxQPS", wireType)
+			}
+			m.MaxQPS = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxQPS |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeathCheck", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HeathCheck == nil {
+				m.HeathCheck = &HeathCheck{}
+			}
+			if err := m.HeathCheck.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreaker", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CircuitBreaker == nil {
+				m.CircuitBreaker = &CircuitBreaker{}
+			}
+			if err := m.CircuitBreaker.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tags = append(m.Tags, &PairValue{})
+			if err := m.Tags[len(m.Tags)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Drained", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Drained = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Weight == nil {
+				m.Weight = &ServerWeight{}
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Standby", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Standby = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetapb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ServerWeight) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetapb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ServerWeight: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ServerWeight: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			m.From = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.From |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+			}
+			m.Target = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Target |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RampStartAt", wireType)
+			}
+			m.RampStartAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RampStartAt |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RampSeconds", wireType)
+			}
+			m.RampSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RampSeconds |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetapb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Bind) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetapb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Bind: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Bind: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterID", wireType)
+			}
+			m.ClusterID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClusterID |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerID", wireType)
+			}
+			m.ServerID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ServerID |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetapb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PairValue) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetapb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PairValue: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PairValue: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetapb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IPAccessControl) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetapb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IPAccessControl: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IPAccessControl: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Whitelist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Whitelist = append(m.Whitelist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blacklist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Blacklist = append(m.Blacklist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetapb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HTTPResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetapb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HTTPResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HTTPResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Body", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Body = append(m.Body[:0], dAtA[iNdEx:postIndex]...)
+			if m.Body == nil {
+				m.Body = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Headers = append(m.Headers, &PairValue{})
+			if err := m.Headers[len(m.Headers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cookies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cookies = append(m.Cookies, &PairValue{})
+			if err := m.Cookies[len(m.Cookies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetapb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ErrorPage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetapb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ErrorPage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ErrorPage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContentType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Body", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Body = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Headers = append(m.Headers, &PairValue{})
+			if err := m.Headers[len(m.Headers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetapb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Parameter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetapb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Parameter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Parameter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			m.Source = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Source |= (Source(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetapb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidationRule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetapb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidationRule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidationRule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RuleType", wireType)
+			}
+			m.RuleType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RuleType |= (RuleType(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expression", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Expression = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetapb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Validation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetapb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Validation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Validation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Parameter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Required", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Required = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rules = append(m.Rules, ValidationRule{})
+			if err := m.Rules[len(m.Rules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetapb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RetryStrategy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetapb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RetryStrategy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RetryStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			m.Interval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Interval |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTimes", wireType)
+			}
+			m.MaxTimes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTimes |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMetapb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (int32(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Codes = append(m.Codes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMetapb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthMetapb
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMetapb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (int32(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Codes = append(m.Codes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Codes", wireType)
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionErrors", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ConnectionErrors = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxInterval", wireType)
+			}
+			m.MaxInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxInterval |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jitter", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Jitter = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerTryTimeout", wireType)
+			}
+			m.PerTryTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PerTryTimeout |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetapb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DispatchNode) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetapb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DispatchNode: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DispatchNode: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterID", wireType)
+			}
+			m.ClusterID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClusterID |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URLRewrite", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URLRewrite = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttrName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AttrName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validations = append(m.Validations, &Validation{})
+			if err := m.Validations[len(m.Validations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cache", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Cache == nil {
+				m.Cache = &Cache{}
+			}
+			if err := m.Cache.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultValue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DefaultValue == nil {
+				m.DefaultValue = &HTTPResult{}
+			}
+			if err := m.DefaultValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UseDefault", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UseDefault = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchIndex", wireType)
+			}
+			m.BatchIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchIndex |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryStrategy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RetryStrategy == nil {
+				m.RetryStrategy = &RetryStrategy{}
+			}
+			if err := m.RetryStrategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WriteTimeout", wireType)
+			}
+			m.WriteTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WriteTimeout |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadTimeout", wireType)
+			}
+			m.ReadTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReadTimeout |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Affinity", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Affinity == nil {
+				m.Affinity = &Parameter{}
+			}
+			if err := m.Affinity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GRPCTranscoding", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GRPCTranscoding == nil {
+				m.GRPCTranscoding = &GRPCTranscoding{}
+			}
+			if err := m.GRPCTranscoding.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetapb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GRPCTranscoding) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetapb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GRPCTranscoding: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GRPCTranscoding: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DescriptorID", wireType)
+			}
+			m.DescriptorID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DescriptorID |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Method = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetapb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Cache) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetapb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Cache: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Cache: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Keys = append(m.Keys, Parameter{})
+			if err := m.Keys[len(m.Keys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			m.Deadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Deadline |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Conditions = append(m.Conditions, Condition{})
+			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IgnoreQuery", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IgnoreQuery = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetapb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RenderTemplate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetapb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RenderTemplate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RenderTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Objects", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Objects = append(m.Objects, &RenderObject{})
+			if err := m.Objects[len(m.Objects)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Body", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Body = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContentType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetapb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RenderObject) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetapb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RenderObject: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RenderObject: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attrs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attrs = append(m.Attrs, &RenderAttr{})
+			if err := m.Attrs[len(m.Attrs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlatAttrs", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.FlatAttrs = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetapb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RenderAttr) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetapb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RenderAttr: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RenderAttr: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtractExp", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExtractExp = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetapb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *API) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetapb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: API: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: API: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URLPattern", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URLPattern = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Method = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= (Status(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IPAccessControl", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.IPAccessControl == nil {
+				m.IPAccessControl = &IPAccessControl{}
+			}
+			if err := m.IPAccessControl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultValue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DefaultValue == nil {
+				m.DefaultValue = &HTTPResult{}
+			}
+			if err := m.DefaultValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Nodes = append(m.Nodes, &DispatchNode{})
+			if err := m.Nodes[len(m.Nodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Perms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Perms = append(m.Perms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthFilter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuthFilter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RenderTemplate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RenderTemplate == nil {
+				m.RenderTemplate = &RenderTemplate{}
+			}
+			if err := m.RenderTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UseDefault", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UseDefault = bool(v != 0)
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MatchRule", wireType)
+			}
+			m.MatchRule = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MatchRule |= (MatchRule(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
+			}
+			m.Position = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Position |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tags = append(m.Tags, &PairValue{})
+			if err := m.Tags[len(m.Tags)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WebSocketOptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WebSocketOptions == nil {
+				m.WebSocketOptions = &WebSocketOptions{}
+			}
+			if err := m.WebSocketOptions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxQPS", wireType)
+			}
+			m.MaxQPS = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxQPS |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreaker", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CircuitBreaker == nil {
+				m.CircuitBreaker = &CircuitBreaker{}
+			}
+			if err := m.CircuitBreaker.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+			}
+			m.Template = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Template |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorPages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ErrorPages = append(m.ErrorPages, &ErrorPage{})
+			if err := m.ErrorPages[len(m.ErrorPages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deprecation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Deprecation == nil {
+				m.Deprecation = &Deprecation{}
+			}
+			if err := m.Deprecation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Aliases", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Aliases = append(m.Aliases, &APIAlias{})
+			if err := m.Aliases[len(m.Aliases)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestSchema", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RequestSchema == nil {
+				m.RequestSchema = &RequestSchema{}
+			}
+			if err := m.RequestSchema.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponseSchema", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ResponseSchema == nil {
+				m.ResponseSchema = &ResponseSchema{}
+			}
+			if err := m.ResponseSchema.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GraphQL", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GraphQL == nil {
+				m.GraphQL = &GraphQLOptions{}
+			}
+			if err := m.GraphQL.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AccessPolicy == nil {
+				m.AccessPolicy = &AccessPolicy{}
+			}
+			if err := m.AccessPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 28:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpstreamHost", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UpstreamHost == nil {
+				m.UpstreamHost = &UpstreamHost{}
+			}
+			if err := m.UpstreamHost.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Portal", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Portal == nil {
+				m.Portal = &PortalOptions{}
+			}
+			if err := m.Portal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 30:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublishState", wireType)
+			}
+			m.PublishState = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PublishState |= (PublishState(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 31:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Preview", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Preview == nil {
+				m.Preview = &PreviewOptions{}
+			}
+			if err := m.Preview.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 32:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeaderLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HeaderLimits == nil {
+				m.HeaderLimits = &HeaderLimits{}
+			}
+			if err := m.HeaderLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 33:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestBody", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RequestBody == nil {
+				m.RequestBody = &RequestBodyPolicy{}
+			}
+			if err := m.RequestBody.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 34:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentTypes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ContentTypes == nil {
+				m.ContentTypes = &ContentTypes{}
+			}
+			if err := m.ContentTypes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 35:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DegradedAttr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DegradedAttr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 36:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Constants = append(m.Constants, PairValue{})
+			if err := m.Constants[len(m.Constants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 37:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessLog", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AccessLog == nil {
+				m.AccessLog = &AccessLog{}
+			}
+			if err := m.AccessLog.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 38:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProxyGroups", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProxyGroups = append(m.ProxyGroups, ProxyGroup{})
+			if err := m.ProxyGroups[len(m.ProxyGroups)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 39:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecurityHeaders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SecurityHeaders == nil {
+				m.SecurityHeaders = &SecurityHeaders{}
+			}
+			if err := m.SecurityHeaders.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 40:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Approval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Approval == nil {
+				m.Approval = &Approval{}
+			}
+			if err := m.Approval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 41:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cache", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Cache == nil {
+				m.Cache = &Cache{}
+			}
+			if err := m.Cache.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 42:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Debug", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Debug = bool(v != 0)
+		case 43:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mirror", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Mirror == nil {
+				m.Mirror = &Mirror{}
+			}
+			if err := m.Mirror.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 44:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadTimeout", wireType)
+			}
+			m.ReadTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReadTimeout |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 45:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WriteTimeout", wireType)
+			}
+			m.WriteTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WriteTimeout |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 46:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			m.Timeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timeout |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 47:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeaderTransform", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HeaderTransform == nil {
+				m.HeaderTransform = &HeaderTransform{}
+			}
+			if err := m.HeaderTransform.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 48:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Visibility", wireType)
+			}
+			m.Visibility = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Visibility |= (Visibility(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 49:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PathRewrite", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PathRewrite == nil {
+				m.PathRewrite = &PathRewrite{}
+			}
+			if err := m.PathRewrite.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 50:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponseBody", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ResponseBody == nil {
+				m.ResponseBody = &ResponseBodyPolicy{}
+			}
+			if err := m.ResponseBody.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 51:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OIDC", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OIDC == nil {
+				m.OIDC = &OIDCPolicy{}
+			}
+			if err := m.OIDC.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetapb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PathRewrite) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetapb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PathRewrite: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PathRewrite: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StripPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StripPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pattern", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pattern = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replacement", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Replacement = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetapb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HeaderTransform) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetapb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HeaderTransform: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HeaderTransform: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Request == nil {
+				m.Request = &HeaderRules{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Response", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Response == nil {
+				m.Response = &HeaderRules{}
+			}
+			if err := m.Response.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetapb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HeaderRules) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetapb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HeaderRules: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HeaderRules: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remove", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Remove = append(m.Remove, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rename", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rename = append(m.Rename, PairValue{})
+			if err := m.Rename[len(m.Rename)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Set", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Set = append(m.Set, PairValue{})
+			if err := m.Set[len(m.Set)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Defaults", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Defaults = append(m.Defaults, PairValue{})
+			if err := m.Defaults[len(m.Defaults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Add", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Add = append(m.Add, PairValue{})
+			if err := m.Add[len(m.Add)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetapb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Mirror) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetapb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Mirror: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Mirror: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterID", wireType)
+			}
+			m.ClusterID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClusterID |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			m.Rate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Rate |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetapb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Approval) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetapb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Approval: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Approval: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Submitter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Submitter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmittedAt", wireType)
+			}
+			m.SubmittedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubmittedAt |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Approver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Approver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApprovedAt", wireType)
+			}
+			m.ApprovedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ApprovedAt |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetapb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProxyGroup) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetapb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProxyGroup: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProxyGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Labels = append(m.Labels, PairValue{})
+			if err := m.Labels[len(m.Labels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetapb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccessLog) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetapb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccessLog: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccessLog: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Disabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Disabled = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sampling", wireType)
+			}
+			m.Sampling = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sampling |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
+			}
+			m.Format = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Format |= (AccessLogFormat(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fields = append(m.Fields, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetapb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SecurityHeaders) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetapb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SecurityHeaders: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SecurityHeaders: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Disabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Disabled = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HSTSMaxAge", wireType)
+			}
+			m.HSTSMaxAge = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HSTSMaxAge |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HSTSIncludeSubDomains", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HSTSIncludeSubDomains = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HSTSPreload", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HSTSPreload = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoSniff", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.NoSniff = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrameOptions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FrameOptions = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentSecurityPolicy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContentSecurityPolicy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferrerPolicy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReferrerPolicy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Server", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Server = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoveHeaders", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemoveHeaders = append(m.RemoveHeaders, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetapb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContentTypes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetapb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContentTypes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContentTypes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Consumes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Consumes = append(m.Consumes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Produces", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Produces = append(m.Produces, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetapb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RequestBodyPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetapb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RequestBodyPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RequestBodyPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBytes", wireType)
+			}
+			m.MaxBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBytes |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBufferBytes", wireType)
+			}
+			m.MaxBufferBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBufferBytes |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpillToDisk", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SpillToDisk = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPartBytes", wireType)
+			}
+			m.MaxPartBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPartBytes |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetapb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResponseBodyPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetapb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResponseBodyPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResponseBodyPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBytes", wireType)
+			}
+			m.MaxBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBytes |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Truncate", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Truncate = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Header = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetapb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OIDCPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetapb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OIDCPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OIDCPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Audience", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Audience = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scopes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Scopes = append(m.Scopes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetapb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HeaderLimits) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetapb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HeaderLimits: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HeaderLimits: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRequestHeaders", wireType)
+			}
+			m.MaxRequestHeaders = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRequestHeaders |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRequestHeaderBytes", wireType)
+			}
+			m.MaxRequestHeaderBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRequestHeaderBytes |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxResponseHeaders", wireType)
+			}
+			m.MaxResponseHeaders = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxResponseHeaders |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxResponseHeaderBytes", wireType)
+			}
+			m.MaxResponseHeaderBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxResponseHeaderBytes |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetapb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PreviewOptions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetapb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PreviewOptions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PreviewOptions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Header = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Secret", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Secret = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IPs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IPs = append(m.IPs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetapb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PortalOptions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetapb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PortalOptions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PortalOptions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Published", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Published = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Doc", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Doc = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyRequired", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.KeyRequired = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetapb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccessPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetapb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccessPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccessPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeWindows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TimeWindows = append(m.TimeWindows, &TimeWindow{})
+			if err := m.TimeWindows[len(m.TimeWindows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Anomaly", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Anomaly == nil {
+				m.Anomaly = &AnomalyRule{}
+			}
+			if err := m.Anomaly.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Overrides", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Overrides = append(m.Overrides, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetapb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TimeWindow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetapb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TimeWindow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TimeWindow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMetapb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (int32(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Weekdays = append(m.Weekdays, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMetapb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthMetapb
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMetapb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (int32(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Weekdays = append(m.Weekdays, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weekdays", wireType)
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Start = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.End = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Location", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Location = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetapb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AnomalyRule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetapb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AnomalyRule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AnomalyRule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Multiple", wireType)
+			}
+			m.Multiple = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Multiple |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinQPS", wireType)
+			}
+			m.MinQPS = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinQPS |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockSeconds", wireType)
+			}
+			m.BlockSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockSeconds |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Header = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetapb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GraphQLOptions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetapb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GraphQLOptions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GraphQLOptions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDepth", wireType)
+			}
+			m.MaxDepth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxDepth |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxComplexity", wireType)
+			}
+			m.MaxComplexity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxComplexity |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resolvers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Resolvers = append(m.Resolvers, &GraphQLResolver{})
+			if err := m.Resolvers[len(m.Resolvers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetapb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GraphQLResolver) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetapb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GraphQLResolver: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GraphQLResolver: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
+			}
+			m.Operation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Operation |= (GraphQLOperation(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Field = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterID", wireType)
+			}
+			m.ClusterID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClusterID |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Method = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtractExp", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExtractExp = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Complexity", wireType)
+			}
+			m.Complexity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Complexity |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WriteTimeout", wireType)
+			}
+			m.WriteTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WriteTimeout |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadTimeout", wireType)
+			}
+			m.ReadTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReadTimeout |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetapb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResponseSchema) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetapb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResponseSchema: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResponseSchema: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Body", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Body = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sampling", wireType)
+			}
+			m.Sampling = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sampling |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetapb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RequestSchema) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetapb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RequestSchema: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RequestSchema: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Query = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Header = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Body", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Body = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetapb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *APIAlias) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetapb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: APIAlias: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: APIAlias: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URLPattern", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URLPattern = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Method = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MatchRule", wireType)
+			}
+			m.MatchRule = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MatchRule |= (MatchRule(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetapb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Deprecation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetapb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Deprecation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Deprecation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeprecatedAt", wireType)
+			}
+			m.DeprecatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeprecatedAt |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SunsetAt", wireType)
+			}
+			m.SunsetAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SunsetAt |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Link", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Link = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Warning", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Warning = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisableAfterSunset", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DisableAfterSunset = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetapb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Condition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetapb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Condition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Condition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Parameter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cmp", wireType)
+			}
+			m.Cmp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Cmp |= (CMP(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expect", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Expect = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetapb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Routing) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetapb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Routing: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Routing: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterID", wireType)
+			}
+			m.ClusterID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClusterID |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Conditions = append(m.Conditions, Condition{})
+			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Strategy", wireType)
+			}
+			m.Strategy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Strategy |= (RoutingStrategy(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrafficRate", wireType)
+			}
+			m.TrafficRate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TrafficRate |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= (Status(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field API", wireType)
+			}
+			m.API = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.API |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tags = append(m.Tags, &PairValue{})
+			if err := m.Tags[len(m.Tags)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetapb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WebSocketOptions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetapb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WebSocketOptions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WebSocketOptions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Origin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Origin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxConns", wireType)
+			}
+			m.MaxConns = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxConns |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IdleTimeout", wireType)
+			}
+			m.IdleTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IdleTimeout |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetapb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *System) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetapb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: System: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: System: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Count.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			m.Revision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Revision |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetapb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CountMetric) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetapb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CountMetric: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CountMetric: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cluster", wireType)
+			}
+			m.Cluster = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Cluster |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Server", wireType)
+			}
+			m.Server = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Server |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field API", wireType)
+			}
+			m.API = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.API |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Routing", wireType)
+			}
+			m.Routing = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Routing |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetapb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ServerHealth) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetapb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ServerHealth: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ServerHealth: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerID", wireType)
+			}
+			m.ServerID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ServerID |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proxy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proxy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= (HealthStatus(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastCheckAt", wireType)
+			}
+			m.LastCheckAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastCheckAt |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
+			}
+			m.Score = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Score |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatencyEWMA", wireType)
+			}
+			m.LatencyEWMA = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LatencyEWMA |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeouts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Timeouts = append(m.Timeouts, PhaseTimeout{})
+			if err := m.Timeouts[len(m.Timeouts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phases", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Phases = append(m.Phases, PhaseLatency{})
+			if err := m.Phases[len(m.Phases)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			m.Weight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Weight |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Circuit", wireType)
+			}
+			m.Circuit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Circuit |= (CircuitStatus(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetapb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PhaseTimeout) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetapb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PhaseTimeout: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PhaseTimeout: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Phase = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetapb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PhaseLatency) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetapb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PhaseLatency: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PhaseLatency: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Phase = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatencyEWMA", wireType)
+			}
+			m.LatencyEWMA = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LatencyEWMA |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetapb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FleetServerHealth) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetapb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FleetServerHealth: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FleetServerHealth: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerID", wireType)
+			}
+			m.ServerID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ServerID |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= (HealthStatus(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proxies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proxies = append(m.Proxies, ServerHealth{})
+			if err := m.Proxies[len(m.Proxies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
+			}
+			m.Score = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Score |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetapb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HealthTransition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetapb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HealthTransition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HealthTransition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerID", wireType)
+			}
+			m.ServerID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ServerID |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proxy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proxy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			m.From = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.From |= (Status(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			m.To = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.To |= (Status(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field At", wireType)
+			}
+			m.At = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.At |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetapb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StandbyEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetapb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StandbyEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StandbyEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterID", wireType)
+			}
+			m.ClusterID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClusterID |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerID", wireType)
+			}
+			m.ServerID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ServerID |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proxy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proxy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Promoted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Promoted = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+			}
+			m.Active = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Active |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field At", wireType)
+			}
+			m.At = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.At |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetapb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConfigPropagation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetapb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConfigPropagation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConfigPropagation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proxy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proxy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			m.Revision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Revision |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WriteAt", wireType)
+			}
+			m.WriteAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WriteAt |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppliedAt", wireType)
+			}
+			m.AppliedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AppliedAt |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Latency", wireType)
+			}
+			m.Latency = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Latency |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatencyEWMA", wireType)
+			}
+			m.LatencyEWMA = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LatencyEWMA |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetapb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RouteTest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetapb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RouteTest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RouteTest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proxy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proxy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Method = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Host", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Host = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Headers = append(m.Headers, PairValue{})
+			if err := m.Headers[len(m.Headers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Body", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Body = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientIP", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientIP = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Internal", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Internal = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetapb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RouteTestResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetapb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RouteTestResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RouteTestResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proxy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proxy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field API", wireType)
+			}
+			m.API = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.API |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field APIName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.APIName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UseDefault", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UseDefault = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Nodes = append(m.Nodes, RouteTestNode{})
+			if err := m.Nodes[len(m.Nodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filters", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Filters = append(m.Filters, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetapb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RouteTestNode) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetapb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RouteTestNode: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RouteTestNode: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterID", wireType)
+			}
+			m.ClusterID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClusterID |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClusterName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerID", wireType)
+			}
+			m.ServerID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ServerID |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServerAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Routing", wireType)
+			}
+			m.Routing = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Routing |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoutingName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RoutingName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CopyTo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CopyTo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetapb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MetaDiff) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetapb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MetaDiff: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MetaDiff: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			m.From = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.From |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			m.To = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.To |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Changes = append(m.Changes, MetaChange{})
+			if err := m.Changes[len(m.Changes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetapb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
 
-func sovMetapb(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-func sozMetapb(x uint64) (n int) {
-	return sovMetapb(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Proxy) Unmarshal(dAtA []byte) error {
+func (m *MetaChange) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3039,15 +25071,15 @@ func (m *Proxy) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Proxy: wiretype end group for non-group")
+			return fmt.Errorf("proto: MetaChange: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Proxy: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MetaChange: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3072,11 +25104,30 @@ func (m *Proxy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addr = string(dAtA[iNdEx:postIndex])
+			m.Kind = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AddrRPC", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3101,7 +25152,57 @@ func (m *Proxy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AddrRPC = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= (ChangeType(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fields = append(m.Fields, FieldChange{})
+			if err := m.Fields[len(m.Fields)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3125,7 +25226,7 @@ func (m *Proxy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Cluster) Unmarshal(dAtA []byte) error {
+func (m *FieldChange) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3148,17 +25249,17 @@ func (m *Cluster) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Cluster: wiretype end group for non-group")
+			return fmt.Errorf("proto: FieldChange: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Cluster: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FieldChange: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
-			m.ID = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -3168,14 +25269,24 @@ func (m *Cluster) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ID |= (uint64(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3200,13 +25311,13 @@ func (m *Cluster) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.From = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LoadBalance", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
 			}
-			m.LoadBalance = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -3216,11 +25327,21 @@ func (m *Cluster) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LoadBalance |= (LoadBalance(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.To = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMetapb(dAtA[iNdEx:])
@@ -3243,7 +25364,7 @@ func (m *Cluster) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HeathCheck) Unmarshal(dAtA []byte) error {
+func (m *Changeset) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3266,15 +25387,34 @@ func (m *HeathCheck) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HeathCheck: wiretype end group for non-group")
+			return fmt.Errorf("proto: Changeset: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HeathCheck: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Changeset: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3299,11 +25439,11 @@ func (m *HeathCheck) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Path = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Body", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3328,13 +25468,13 @@ func (m *HeathCheck) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Body = string(dAtA[iNdEx:postIndex])
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CheckInterval", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
-			m.CheckInterval = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -3344,16 +25484,26 @@ func (m *HeathCheck) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CheckInterval |= (int64(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
-			m.Timeout = 0
+			m.State = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -3363,67 +25513,16 @@ func (m *HeathCheck) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Timeout |= (int64(b) & 0x7F) << shift
+				m.State |= (ChangesetState(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMetapb(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthMetapb
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CircuitBreaker) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMetapb
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CircuitBreaker: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CircuitBreaker: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CloseTimeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
 			}
-			m.CloseTimeout = 0
+			m.CreatedAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -3433,16 +25532,16 @@ func (m *CircuitBreaker) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CloseTimeout |= (int64(b) & 0x7F) << shift
+				m.CreatedAt |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HalfTrafficRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CommittedAt", wireType)
 			}
-			m.HalfTrafficRate = 0
+			m.CommittedAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -3452,16 +25551,16 @@ func (m *CircuitBreaker) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.HalfTrafficRate |= (int32(b) & 0x7F) << shift
+				m.CommittedAt |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RateCheckPeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RolledBackAt", wireType)
 			}
-			m.RateCheckPeriod = 0
+			m.RolledBackAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -3471,16 +25570,16 @@ func (m *CircuitBreaker) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RateCheckPeriod |= (int64(b) & 0x7F) << shift
+				m.RolledBackAt |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 9:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FailureRateToClose", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RollbackRevision", wireType)
 			}
-			m.FailureRateToClose = 0
+			m.RollbackRevision = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -3490,16 +25589,16 @@ func (m *CircuitBreaker) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FailureRateToClose |= (int32(b) & 0x7F) << shift
+				m.RollbackRevision |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SucceedRateToOpen", wireType)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
 			}
-			m.SucceedRateToOpen = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -3509,11 +25608,23 @@ func (m *CircuitBreaker) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SucceedRateToOpen |= (int32(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, ChangeRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMetapb(dAtA[iNdEx:])
@@ -3536,7 +25647,7 @@ func (m *CircuitBreaker) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Server) Unmarshal(dAtA []byte) error {
+func (m *ChangeRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3559,17 +25670,17 @@ func (m *Server) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Server: wiretype end group for non-group")
+			return fmt.Errorf("proto: ChangeRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Server: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ChangeRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
-			m.ID = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -3579,14 +25690,24 @@ func (m *Server) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ID |= (uint64(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3611,13 +25732,13 @@ func (m *Server) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addr = string(dAtA[iNdEx:postIndex])
+			m.Operator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Protocol", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
 			}
-			m.Protocol = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -3627,16 +25748,26 @@ func (m *Server) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Protocol |= (Protocol(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Method = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxQPS", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
-			m.MaxQPS = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -3646,16 +25777,26 @@ func (m *Server) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxQPS |= (int64(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HeathCheck", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
 			}
-			var msglen int
+			m.From = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -3665,30 +25806,16 @@ func (m *Server) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.From |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthMetapb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.HeathCheck == nil {
-				m.HeathCheck = &HeathCheck{}
-			}
-			if err := m.HeathCheck.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreaker", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
 			}
-			var msglen int
+			m.To = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -3698,25 +25825,30 @@ func (m *Server) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.To |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthMetapb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.CircuitBreaker == nil {
-				m.CircuitBreaker = &CircuitBreaker{}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field At", wireType)
 			}
-			if err := m.CircuitBreaker.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.At = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.At |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMetapb(dAtA[iNdEx:])
@@ -3739,7 +25871,7 @@ func (m *Server) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Bind) Unmarshal(dAtA []byte) error {
+func (m *ConsistencyReport) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3762,17 +25894,17 @@ func (m *Bind) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Bind: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConsistencyReport: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Bind: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConsistencyReport: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckedAt", wireType)
 			}
-			m.ClusterID = 0
+			m.CheckedAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -3782,16 +25914,16 @@ func (m *Bind) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ClusterID |= (uint64(b) & 0x7F) << shift
+				m.CheckedAt |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServerID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Findings", wireType)
 			}
-			m.ServerID = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -3801,11 +25933,23 @@ func (m *Bind) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ServerID |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Findings = append(m.Findings, ConfigFinding{})
+			if err := m.Findings[len(m.Findings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMetapb(dAtA[iNdEx:])
@@ -3828,7 +25972,7 @@ func (m *Bind) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PairValue) Unmarshal(dAtA []byte) error {
+func (m *ConfigFinding) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3851,15 +25995,34 @@ func (m *PairValue) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PairValue: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConfigFinding: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PairValue: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConfigFinding: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= (FindingType(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3884,11 +26047,30 @@ func (m *PairValue) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Kind = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3913,62 +26095,11 @@ func (m *PairValue) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMetapb(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthMetapb
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *IPAccessControl) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMetapb
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: IPAccessControl: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IPAccessControl: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Whitelist", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3993,11 +26124,11 @@ func (m *IPAccessControl) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Whitelist = append(m.Whitelist, string(dAtA[iNdEx:postIndex]))
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Blacklist", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Suggestion", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4022,7 +26153,7 @@ func (m *IPAccessControl) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Blacklist = append(m.Blacklist, string(dAtA[iNdEx:postIndex]))
+			m.Suggestion = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4046,7 +26177,7 @@ func (m *IPAccessControl) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HTTPResult) Unmarshal(dAtA []byte) error {
+func (m *ProxyOverride) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4069,17 +26200,17 @@ func (m *HTTPResult) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HTTPResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProxyOverride: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HTTPResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProxyOverride: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Body", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
-			var byteLen int
+			m.ID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -4089,28 +26220,16 @@ func (m *HTTPResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				m.ID |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthMetapb
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Body = append(m.Body[:0], dAtA[iNdEx:postIndex]...)
-			if m.Body == nil {
-				m.Body = []byte{}
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -4120,28 +26239,26 @@ func (m *HTTPResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMetapb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Headers = append(m.Headers, &PairValue{})
-			if err := m.Headers[len(m.Headers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cookies", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proxy", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -4151,28 +26268,26 @@ func (m *HTTPResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMetapb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Cookies = append(m.Cookies, &PairValue{})
-			if err := m.Cookies[len(m.Cookies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Proxy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
 			}
-			m.Code = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -4182,67 +26297,152 @@ func (m *HTTPResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Code |= (int32(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMetapb(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMetapb
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Parameter) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMetapb
+			m.Labels = append(m.Labels, PairValue{})
+			if err := m.Labels[len(m.Labels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			iNdEx = postIndex
+		case 5:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMetapb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.EnabledAPIs = append(m.EnabledAPIs, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMetapb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthMetapb
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMetapb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.EnabledAPIs = append(m.EnabledAPIs, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnabledAPIs", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+		case 6:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMetapb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.DisabledAPIs = append(m.DisabledAPIs, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMetapb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthMetapb
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMetapb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.DisabledAPIs = append(m.DisabledAPIs, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisabledAPIs", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Parameter: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Parameter: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimits", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -4252,26 +26452,28 @@ func (m *Parameter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMetapb
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.RateLimits = append(m.RateLimits, APIRateLimit{})
+			if err := m.RateLimits[len(m.RateLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogLevel", wireType)
 			}
-			m.Source = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -4281,16 +26483,26 @@ func (m *Parameter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Source |= (Source(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LogLevel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessLogSampling", wireType)
 			}
-			m.Index = 0
+			m.AccessLogSampling = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -4300,7 +26512,7 @@ func (m *Parameter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Index |= (int32(b) & 0x7F) << shift
+				m.AccessLogSampling |= (int32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -4327,7 +26539,7 @@ func (m *Parameter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidationRule) Unmarshal(dAtA []byte) error {
+func (m *KillSwitch) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4350,17 +26562,17 @@ func (m *ValidationRule) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidationRule: wiretype end group for non-group")
+			return fmt.Errorf("proto: KillSwitch: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidationRule: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KillSwitch: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RuleType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Global", wireType)
 			}
-			m.RuleType = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -4370,14 +26582,139 @@ func (m *ValidationRule) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RuleType |= (RuleType(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Global = bool(v != 0)
 		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMetapb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.APIs = append(m.APIs, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMetapb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthMetapb
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMetapb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.APIs = append(m.APIs, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field APIs", wireType)
+			}
+		case 3:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMetapb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.AllowedAPIs = append(m.AllowedAPIs, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMetapb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthMetapb
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMetapb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.AllowedAPIs = append(m.AllowedAPIs, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedAPIs", wireType)
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Expression", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedIPs", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4402,62 +26739,59 @@ func (m *ValidationRule) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Expression = string(dAtA[iNdEx:postIndex])
+			m.AllowedIPs = append(m.AllowedIPs, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMetapb(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthMetapb
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentType", wireType)
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Validation) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMetapb
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Validation: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Validation: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.ContentType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parameter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4481,15 +26815,16 @@ func (m *Validation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Parameter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Headers = append(m.Headers, PairValue{})
+			if err := m.Headers[len(m.Headers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Required", wireType)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Body", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -4499,17 +26834,55 @@ func (m *Validation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Body = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Required = bool(v != 0)
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rules", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
 			}
-			var msglen int
+			m.UpdatedAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -4519,23 +26892,11 @@ func (m *Validation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.UpdatedAt |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthMetapb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Rules = append(m.Rules, ValidationRule{})
-			if err := m.Rules[len(m.Rules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMetapb(dAtA[iNdEx:])
@@ -4558,7 +26919,7 @@ func (m *Validation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RetryStrategy) Unmarshal(dAtA []byte) error {
+func (m *Consumer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4581,17 +26942,17 @@ func (m *RetryStrategy) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RetryStrategy: wiretype end group for non-group")
+			return fmt.Errorf("proto: Consumer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RetryStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Consumer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
-			m.Interval = 0
+			m.ID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -4601,16 +26962,16 @@ func (m *RetryStrategy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Interval |= (int32(b) & 0x7F) << shift
+				m.ID |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxTimes", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			m.MaxTimes = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -4620,129 +26981,26 @@ func (m *RetryStrategy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxTimes |= (int32(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType == 0 {
-				var v int32
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowMetapb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= (int32(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Codes = append(m.Codes, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowMetapb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= (int(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthMetapb
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				for iNdEx < postIndex {
-					var v int32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowMetapb
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= (int32(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Codes = append(m.Codes, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Codes", wireType)
-			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMetapb(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMetapb
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DispatchNode) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMetapb
-			}
-			if iNdEx >= l {
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DispatchNode: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DispatchNode: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Quota", wireType)
 			}
-			m.ClusterID = 0
+			m.Quota = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -4752,16 +27010,16 @@ func (m *DispatchNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ClusterID |= (uint64(b) & 0x7F) << shift
+				m.Quota |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URLRewrite", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -4771,24 +27029,26 @@ func (m *DispatchNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMetapb
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.URLRewrite = string(dAtA[iNdEx:postIndex])
+			m.Keys = append(m.Keys, APIKey{})
+			if err := m.Keys[len(m.Keys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AttrName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Webhook", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4813,13 +27073,95 @@ func (m *DispatchNode) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AttrName = string(dAtA[iNdEx:postIndex])
+			m.Webhook = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RestrictAPIs", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RestrictAPIs = bool(v != 0)
+		case 7:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMetapb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.AllowedAPIs = append(m.AllowedAPIs, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMetapb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthMetapb
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMetapb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.AllowedAPIs = append(m.AllowedAPIs, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedAPIs", wireType)
+			}
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -4829,61 +27171,77 @@ func (m *DispatchNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMetapb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Validations = append(m.Validations, &Validation{})
-			if err := m.Validations[len(m.Validations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cache", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMetapb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetapb(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthMetapb
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Cache == nil {
-				m.Cache = &Cache{}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *APIKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetapb
 			}
-			if err := m.Cache.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 6:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: APIKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: APIKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DefaultValue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -4893,30 +27251,26 @@ func (m *DispatchNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMetapb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DefaultValue == nil {
-				m.DefaultValue = &HTTPResult{}
-			}
-			if err := m.DefaultValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UseDefault", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -4926,36 +27280,26 @@ func (m *DispatchNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.UseDefault = bool(v != 0)
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BatchIndex", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
 			}
-			m.BatchIndex = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMetapb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BatchIndex |= (int32(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RetryStrategy", wireType)
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
 			}
-			var msglen int
+			m.CreatedAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -4965,30 +27309,16 @@ func (m *DispatchNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.CreatedAt |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthMetapb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.RetryStrategy == nil {
-				m.RetryStrategy = &RetryStrategy{}
-			}
-			if err := m.RetryStrategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 10:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WriteTimeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpireAt", wireType)
 			}
-			m.WriteTimeout = 0
+			m.ExpireAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -4998,16 +27328,16 @@ func (m *DispatchNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.WriteTimeout |= (int64(b) & 0x7F) << shift
+				m.ExpireAt |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReadTimeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NotifiedAt", wireType)
 			}
-			m.ReadTimeout = 0
+			m.NotifiedAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -5017,7 +27347,7 @@ func (m *DispatchNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ReadTimeout |= (int64(b) & 0x7F) << shift
+				m.NotifiedAt |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -5044,7 +27374,7 @@ func (m *DispatchNode) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Cache) Unmarshal(dAtA []byte) error {
+func (m *ConsumerUsage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5067,17 +27397,17 @@ func (m *Cache) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Cache: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConsumerUsage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Cache: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConsumerUsage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Consumer", wireType)
 			}
-			var msglen int
+			m.Consumer = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -5087,28 +27417,16 @@ func (m *Cache) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.Consumer |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthMetapb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Keys = append(m.Keys, Parameter{})
-			if err := m.Keys[len(m.Keys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Day", wireType)
 			}
-			m.Deadline = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -5118,16 +27436,26 @@ func (m *Cache) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Deadline |= (uint64(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Day = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
 			}
-			var msglen int
+			m.Requests = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -5135,81 +27463,56 @@ func (m *Cache) Unmarshal(dAtA []byte) error {
 				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthMetapb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Conditions = append(m.Conditions, Condition{})
-			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMetapb(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthMetapb
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RenderTemplate) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMetapb
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Requests |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field API", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			m.API = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.API |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RenderTemplate: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RenderTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Objects", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proxy", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -5219,22 +27522,20 @@ func (m *RenderTemplate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMetapb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Objects = append(m.Objects, &RenderObject{})
-			if err := m.Objects[len(m.Objects)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Proxy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5258,7 +27559,7 @@ func (m *RenderTemplate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RenderObject) Unmarshal(dAtA []byte) error {
+func (m *LatencyHeatmap) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5281,15 +27582,15 @@ func (m *RenderObject) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RenderObject: wiretype end group for non-group")
+			return fmt.Errorf("proto: LatencyHeatmap: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RenderObject: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LatencyHeatmap: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field API", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5314,13 +27615,13 @@ func (m *RenderObject) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.API = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attrs", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
 			}
-			var msglen int
+			m.Interval = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -5330,28 +27631,62 @@ func (m *RenderObject) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.Interval |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthMetapb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Attrs = append(m.Attrs, &RenderAttr{})
-			if err := m.Attrs[len(m.Attrs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FlatAttrs", wireType)
+			if wireType == 1 {
+				var v uint64
+				if (iNdEx + 8) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				v2 := float64(math.Float64frombits(v))
+				m.Buckets = append(m.Buckets, v2)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMetapb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthMetapb
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					v2 := float64(math.Float64frombits(v))
+					m.Buckets = append(m.Buckets, v2)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buckets", wireType)
 			}
-			var v int
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rows", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -5361,12 +27696,23 @@ func (m *RenderObject) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.FlatAttrs = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rows = append(m.Rows, HeatmapRow{})
+			if err := m.Rows[len(m.Rows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMetapb(dAtA[iNdEx:])
@@ -5389,7 +27735,7 @@ func (m *RenderObject) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RenderAttr) Unmarshal(dAtA []byte) error {
+func (m *HeatmapRow) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5412,17 +27758,17 @@ func (m *RenderAttr) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RenderAttr: wiretype end group for non-group")
+			return fmt.Errorf("proto: HeatmapRow: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RenderAttr: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HeatmapRow: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
-			var stringLen uint64
+			m.Time = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -5432,50 +27778,73 @@ func (m *RenderAttr) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.Time |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMetapb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExtractExp", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMetapb
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMetapb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
+				m.Counts = append(m.Counts, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMetapb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthMetapb
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMetapb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Counts = append(m.Counts, v)
 				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Counts", wireType)
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMetapb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ExtractExp = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMetapb(dAtA[iNdEx:])
@@ -5498,7 +27867,7 @@ func (m *RenderAttr) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *API) Unmarshal(dAtA []byte) error {
+func (m *RateLimit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5521,10 +27890,10 @@ func (m *API) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: API: wiretype end group for non-group")
+			return fmt.Errorf("proto: RateLimit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: API: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5576,10 +27945,10 @@ func (m *API) Unmarshal(dAtA []byte) error {
 			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URLPattern", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field API", wireType)
 			}
-			var stringLen uint64
+			m.API = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -5589,26 +27958,16 @@ func (m *API) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.API |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMetapb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+				}
 			}
-			m.URLPattern = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			var stringLen uint64
+			m.Key = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -5618,24 +27977,14 @@ func (m *API) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.Key |= (RateLimitKey(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMetapb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Method = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5660,13 +28009,13 @@ func (m *API) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Domain = string(dAtA[iNdEx:postIndex])
+			m.Header = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
 			}
-			m.Status = 0
+			m.Rate = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -5676,16 +28025,16 @@ func (m *API) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= (Status(b) & 0x7F) << shift
+				m.Rate |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IPAccessControl", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
 			}
-			var msglen int
+			m.Period = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -5695,30 +28044,16 @@ func (m *API) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.Period |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthMetapb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.IPAccessControl == nil {
-				m.IPAccessControl = &IPAccessControl{}
-			}
-			if err := m.IPAccessControl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DefaultValue", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burst", wireType)
 			}
-			var msglen int
+			m.Burst = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -5728,28 +28063,14 @@ func (m *API) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.Burst |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthMetapb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.DefaultValue == nil {
-				m.DefaultValue = &HTTPResult{}
-			}
-			if err := m.DefaultValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Profiles", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5773,45 +28094,129 @@ func (m *API) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Nodes = append(m.Nodes, &DispatchNode{})
-			if err := m.Nodes[len(m.Nodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Profiles = append(m.Profiles, RateLimitProfile{})
+			if err := m.Profiles[len(m.Profiles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Perms", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMetapb
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMetapb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
+				m.APIs = append(m.APIs, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMetapb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthMetapb
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMetapb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.APIs = append(m.APIs, v)
 				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field APIs", wireType)
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetapb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthMetapb
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Perms = append(m.Perms, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 11:
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RateLimitProfile) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetapb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RateLimitProfile: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RateLimitProfile: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuthFilter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -5821,26 +28226,27 @@ func (m *API) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMetapb
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AuthFilter = string(dAtA[iNdEx:postIndex])
+			if err := m.Window.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 12:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RenderTemplate", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
 			}
-			var msglen int
+			m.Rate = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -5850,30 +28256,16 @@ func (m *API) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.Rate |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthMetapb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.RenderTemplate == nil {
-				m.RenderTemplate = &RenderTemplate{}
-			}
-			if err := m.RenderTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 13:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UseDefault", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
 			}
-			var v int
+			m.Period = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -5883,17 +28275,16 @@ func (m *API) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				m.Period |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.UseDefault = bool(v != 0)
-		case 14:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MatchRule", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Burst", wireType)
 			}
-			m.MatchRule = 0
+			m.Burst = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -5903,35 +28294,67 @@ func (m *API) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MatchRule |= (MatchRule(b) & 0x7F) << shift
+				m.Burst |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 15:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetapb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProtoDescriptor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetapb
 			}
-			m.Position = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMetapb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Position |= (uint32(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-		case 16:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			var msglen int
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProtoDescriptor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProtoDescriptor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -5941,28 +28364,16 @@ func (m *API) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.ID |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthMetapb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Tags = append(m.Tags, &PairValue{})
-			if err := m.Tags[len(m.Tags)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 17:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WebSocketOptions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -5972,49 +28383,26 @@ func (m *API) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMetapb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WebSocketOptions == nil {
-				m.WebSocketOptions = &WebSocketOptions{}
-			}
-			if err := m.WebSocketOptions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 18:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxQPS", wireType)
-			}
-			m.MaxQPS = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMetapb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MaxQPS |= (int64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 19:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreaker", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -6024,23 +28412,21 @@ func (m *API) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthMetapb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CircuitBreaker == nil {
-				m.CircuitBreaker = &CircuitBreaker{}
-			}
-			if err := m.CircuitBreaker.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -6065,7 +28451,7 @@ func (m *API) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Condition) Unmarshal(dAtA []byte) error {
+func (m *APIRateLimit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6088,17 +28474,17 @@ func (m *Condition) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Condition: wiretype end group for non-group")
+			return fmt.Errorf("proto: APIRateLimit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Condition: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: APIRateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parameter", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field API", wireType)
 			}
-			var msglen int
+			m.API = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -6108,46 +28494,16 @@ func (m *Condition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.API |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthMetapb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Parameter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cmp", wireType)
-			}
-			m.Cmp = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMetapb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Cmp |= (CMP(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Expect", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxQPS", wireType)
 			}
-			var stringLen uint64
+			m.MaxQPS = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -6157,21 +28513,11 @@ func (m *Condition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.MaxQPS |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMetapb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Expect = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMetapb(dAtA[iNdEx:])
@@ -6194,7 +28540,7 @@ func (m *Condition) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Routing) Unmarshal(dAtA []byte) error {
+func (m *APITemplate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6217,10 +28563,10 @@ func (m *Routing) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Routing: wiretype end group for non-group")
+			return fmt.Errorf("proto: APITemplate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Routing: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: APITemplate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6243,10 +28589,10 @@ func (m *Routing) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			m.ClusterID = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -6256,14 +28602,24 @@ func (m *Routing) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ClusterID |= (uint64(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IPAccessControl", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6287,16 +28643,18 @@ func (m *Routing) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Conditions = append(m.Conditions, Condition{})
-			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.IPAccessControl == nil {
+				m.IPAccessControl = &IPAccessControl{}
+			}
+			if err := m.IPAccessControl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Strategy", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultValue", wireType)
 			}
-			m.Strategy = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -6306,16 +28664,30 @@ func (m *Routing) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Strategy |= (RoutingStrategy(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DefaultValue == nil {
+				m.DefaultValue = &HTTPResult{}
+			}
+			if err := m.DefaultValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TrafficRate", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Perms", wireType)
 			}
-			m.TrafficRate = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -6325,16 +28697,26 @@ func (m *Routing) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TrafficRate |= (int32(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Perms = append(m.Perms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthFilter", wireType)
 			}
-			m.Status = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -6344,16 +28726,26 @@ func (m *Routing) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= (Status(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuthFilter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field API", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
 			}
-			m.API = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -6363,16 +28755,28 @@ func (m *Routing) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.API |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tags = append(m.Tags, &PairValue{})
+			if err := m.Tags[len(m.Tags)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxQPS", wireType)
 			}
-			var stringLen uint64
+			m.MaxQPS = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -6382,77 +28786,16 @@ func (m *Routing) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.MaxQPS |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMetapb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMetapb(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthMetapb
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *WebSocketOptions) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMetapb
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: WebSocketOptions: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WebSocketOptions: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Origin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreaker", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -6462,75 +28805,28 @@ func (m *WebSocketOptions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMetapb
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Origin = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMetapb(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthMetapb
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *System) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMetapb
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.CircuitBreaker == nil {
+				m.CircuitBreaker = &CircuitBreaker{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.CircuitBreaker.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: System: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: System: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryStrategy", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6554,66 +28850,18 @@ func (m *System) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Count.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.RetryStrategy == nil {
+				m.RetryStrategy = &RetryStrategy{}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMetapb(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.RetryStrategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthMetapb
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CountMetric) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMetapb
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CountMetric: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CountMetric: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 11:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cluster", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WriteTimeout", wireType)
 			}
-			m.Cluster = 0
+			m.WriteTimeout = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -6623,16 +28871,16 @@ func (m *CountMetric) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Cluster |= (int64(b) & 0x7F) << shift
+				m.WriteTimeout |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 12:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Server", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadTimeout", wireType)
 			}
-			m.Server = 0
+			m.ReadTimeout = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -6642,16 +28890,16 @@ func (m *CountMetric) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Server |= (int64(b) & 0x7F) << shift
+				m.ReadTimeout |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field API", wireType)
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorPages", wireType)
 			}
-			m.API = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -6661,16 +28909,28 @@ func (m *CountMetric) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.API |= (int64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+			if msglen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ErrorPages = append(m.ErrorPages, &ErrorPage{})
+			if err := m.ErrorPages[len(m.ErrorPages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Routing", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
 			}
-			m.Routing = 0
+			m.Timeout = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -6680,7 +28940,7 @@ func (m *CountMetric) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Routing |= (int64(b) & 0x7F) << shift
+				m.Timeout |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -6815,122 +29075,420 @@ var (
 func init() { proto.RegisterFile("metapb.proto", fileDescriptorMetapb) }
 
 var fileDescriptorMetapb = []byte{
-	// 1872 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x58, 0x4d, 0x8f, 0x1b, 0x49,
-	0x19, 0x9e, 0xf6, 0xd7, 0xd8, 0xaf, 0x67, 0x3c, 0x9d, 0x4a, 0x60, 0xad, 0x08, 0x26, 0x51, 0x2f,
-	0x84, 0xc1, 0x8b, 0xb2, 0xc8, 0xda, 0x15, 0x0a, 0x8b, 0x10, 0x63, 0x3b, 0xbb, 0x31, 0x9a, 0x49,
-	0x9c, 0xb6, 0xb3, 0x2b, 0x21, 0x2e, 0xe5, 0xee, 0x1a, 0xbb, 0x76, 0xda, 0x5d, 0x4d, 0x75, 0xf5,
-	0x64, 0x2c, 0x71, 0x84, 0x0b, 0x42, 0xe2, 0xc2, 0x01, 0xfe, 0xd1, 0x1e, 0x38, 0xe4, 0x17, 0x44,
-	0x30, 0x88, 0x7f, 0xc1, 0x01, 0xd5, 0x57, 0xbb, 0xdb, 0x33, 0x09, 0x9b, 0x9c, 0xdc, 0xfd, 0xbc,
-	0x4f, 0x75, 0x55, 0xbd, 0x1f, 0xcf, 0x5b, 0x65, 0xd8, 0x5b, 0x11, 0x81, 0x93, 0xf9, 0xc3, 0x84,
-	0x33, 0xc1, 0x50, 0x43, 0xbf, 0xdd, 0xbd, 0xb3, 0x60, 0x0b, 0xa6, 0xa0, 0x8f, 0xe5, 0x93, 0xb6,
-	0x7a, 0xc7, 0x50, 0x9f, 0x70, 0x76, 0xb9, 0x46, 0x5d, 0xa8, 0xe1, 0x30, 0xe4, 0x5d, 0xe7, 0xbe,
-	0x73, 0xd4, 0x1a, 0xd4, 0xbe, 0x79, 0x7d, 0x6f, 0xc7, 0x57, 0x08, 0x3a, 0x84, 0x5d, 0xf9, 0xeb,
-	0x4f, 0x86, 0xdd, 0x4a, 0xc1, 0x68, 0x41, 0xef, 0xf7, 0xb0, 0x3b, 0x8c, 0xb2, 0x54, 0x10, 0x8e,
-	0xee, 0x42, 0x85, 0x86, 0xea, 0x13, 0xb5, 0x01, 0x48, 0xd6, 0xd5, 0xeb, 0x7b, 0x95, 0xf1, 0xc8,
-	0xaf, 0xd0, 0x50, 0x4e, 0x10, 0xe3, 0x15, 0x29, 0x7d, 0x43, 0x21, 0xe8, 0x33, 0x68, 0x47, 0x0c,
-	0x87, 0x03, 0x1c, 0xe1, 0x38, 0x20, 0xdd, 0xea, 0x7d, 0xe7, 0xa8, 0xd3, 0xbf, 0xfd, 0xd0, 0xec,
-	0xe2, 0x64, 0x63, 0x32, 0xa3, 0x8a, 0x6c, 0xef, 0xcf, 0x0e, 0xc0, 0x13, 0x82, 0xc5, 0x72, 0xb8,
-	0x24, 0xc1, 0xb9, 0x9c, 0x25, 0xc1, 0x62, 0x59, 0xde, 0x86, 0x44, 0xa4, 0x65, 0xce, 0xc2, 0x75,
-	0x79, 0x7e, 0x89, 0xa0, 0x1e, 0xec, 0x07, 0x72, 0xf0, 0x38, 0x16, 0x84, 0x5f, 0xe0, 0x48, 0xad,
-	0xa0, 0x6a, 0x28, 0x65, 0x93, 0x74, 0x86, 0xa0, 0x2b, 0xc2, 0x32, 0xd1, 0xad, 0x15, 0x58, 0x16,
-	0xf4, 0xfe, 0x50, 0x81, 0xce, 0x90, 0xf2, 0x20, 0xa3, 0x62, 0xc0, 0x09, 0x3e, 0x27, 0x1c, 0x1d,
-	0xc1, 0x5e, 0x10, 0xb1, 0x94, 0xcc, 0xcc, 0x38, 0xa7, 0x30, 0xae, 0x64, 0x41, 0x0f, 0xe1, 0x60,
-	0x89, 0xa3, 0xb3, 0x19, 0xc7, 0x67, 0x67, 0x34, 0xf0, 0xb1, 0xd0, 0xde, 0xaa, 0x1b, 0xf2, 0xb6,
-	0x51, 0xf2, 0x39, 0x16, 0x44, 0xed, 0x7c, 0x42, 0x38, 0x65, 0x61, 0x69, 0xe9, 0xdb, 0x46, 0xf4,
-	0x09, 0xa0, 0x33, 0x4c, 0xa3, 0x8c, 0x13, 0x39, 0x7c, 0xc6, 0x86, 0x72, 0x72, 0xb5, 0x0f, 0x3b,
-	0xc5, 0x0d, 0x76, 0xd4, 0x87, 0x5b, 0x69, 0x16, 0x04, 0x84, 0x84, 0x1a, 0x7d, 0x96, 0x90, 0xb8,
-	0x5b, 0x2f, 0x0c, 0xba, 0x6e, 0x96, 0x6e, 0x68, 0x4c, 0x09, 0xbf, 0xf8, 0xff, 0x39, 0xa1, 0x92,
-	0xae, 0x72, 0x2d, 0xe9, 0xfa, 0xd0, 0x54, 0x09, 0x1a, 0xb0, 0xc8, 0x24, 0x84, 0x6b, 0x13, 0x62,
-	0x62, 0x70, 0xc3, 0xcf, 0x79, 0xe8, 0x7b, 0xd0, 0x58, 0xe1, 0xcb, 0xe7, 0x93, 0x69, 0x29, 0x34,
-	0x06, 0x43, 0x7d, 0x80, 0x65, 0x9e, 0x27, 0x6a, 0xfd, 0xed, 0x3e, 0xb2, 0xdf, 0xdc, 0x64, 0x90,
-	0x5f, 0x60, 0xa1, 0x5f, 0x42, 0x27, 0x28, 0x05, 0xb3, 0xdb, 0x50, 0xe3, 0xbe, 0x6b, 0xc7, 0x95,
-	0x43, 0xed, 0x6f, 0xb1, 0xbd, 0x13, 0xa8, 0x0d, 0x68, 0x1c, 0x22, 0x0f, 0x5a, 0x81, 0x2e, 0x91,
-	0xf1, 0xc8, 0xb8, 0x42, 0x2f, 0x6e, 0x03, 0xa3, 0xfb, 0xd0, 0x4c, 0x95, 0xc7, 0xc6, 0x23, 0xe5,
-	0x0f, 0x4b, 0xc9, 0x51, 0xef, 0x18, 0x5a, 0x13, 0x4c, 0xf9, 0x97, 0x38, 0xca, 0x48, 0x5e, 0x4e,
-	0xce, 0xb5, 0x72, 0xba, 0x0b, 0xf5, 0x0b, 0x49, 0x29, 0x79, 0x55, 0x43, 0xde, 0x29, 0x1c, 0x8c,
-	0x27, 0xc7, 0x41, 0x40, 0xd2, 0x74, 0xc8, 0x62, 0xc1, 0x95, 0xd7, 0x5a, 0x2f, 0x97, 0x54, 0x90,
-	0x88, 0xa6, 0x32, 0x37, 0xab, 0x47, 0x2d, 0x7f, 0x03, 0x48, 0xeb, 0x3c, 0xc2, 0xc1, 0xb9, 0xb2,
-	0x56, 0xb4, 0x35, 0x07, 0xbc, 0xbf, 0xca, 0xe2, 0x9b, 0xcd, 0x26, 0x3e, 0x49, 0xb3, 0x48, 0x20,
-	0x64, 0x4a, 0x4c, 0xae, 0x69, 0xcf, 0x14, 0xd7, 0x47, 0xb0, 0xbb, 0x24, 0x38, 0x24, 0x3c, 0x55,
-	0xc3, 0xdb, 0xfd, 0x5b, 0x79, 0x1c, 0xed, 0x5e, 0x7c, 0xcb, 0x90, 0xe4, 0x80, 0xb1, 0x73, 0x4a,
-	0xd2, 0x6e, 0xf5, 0x8d, 0x64, 0xc3, 0x90, 0x1e, 0x08, 0x58, 0x58, 0xce, 0x5f, 0x85, 0x78, 0x4c,
-	0x3a, 0x8a, 0xe3, 0x15, 0x91, 0x9a, 0xf4, 0x66, 0x47, 0xfd, 0x04, 0x1a, 0x29, 0xcb, 0x78, 0xa0,
-	0x3d, 0xd5, 0xe9, 0x77, 0xec, 0x64, 0x53, 0x85, 0xda, 0xfc, 0xd1, 0x1c, 0xe9, 0x56, 0x1a, 0x87,
-	0xe4, 0x52, 0xa5, 0xa3, 0x9d, 0x4f, 0x43, 0xde, 0xd7, 0xd0, 0xf9, 0x12, 0x47, 0x34, 0xc4, 0x82,
-	0xb2, 0xd8, 0xcf, 0x22, 0x59, 0x34, 0x4d, 0x9e, 0x45, 0x64, 0xb6, 0x4e, 0xf4, 0xcc, 0x85, 0xfc,
-	0xf5, 0x0d, 0x6e, 0xe3, 0x6b, 0x79, 0xe8, 0x07, 0x00, 0xe4, 0x32, 0xe1, 0x24, 0x4d, 0x29, 0x8b,
-	0x4b, 0xd1, 0x2b, 0xe0, 0xde, 0xdf, 0x1d, 0x80, 0xcd, 0x64, 0xe8, 0x53, 0x68, 0x25, 0x76, 0xaf,
-	0x6a, 0xa6, 0x92, 0xd3, 0x8c, 0xc1, 0x66, 0x5b, 0xce, 0x94, 0xd9, 0xc6, 0xc9, 0xef, 0x32, 0xca,
-	0x49, 0xa8, 0x66, 0x6a, 0xe6, 0xab, 0x31, 0x28, 0xea, 0x43, 0x5d, 0xae, 0xcc, 0x46, 0x22, 0x4f,
-	0xf9, 0xf2, 0x46, 0xad, 0x1f, 0x14, 0xd5, 0xa3, 0xb0, 0xef, 0x13, 0xc1, 0xd7, 0x53, 0x21, 0xa5,
-	0x67, 0xb1, 0x96, 0xd3, 0x50, 0xab, 0xaa, 0x4e, 0xc1, 0x6f, 0x39, 0x2a, 0x19, 0x2b, 0x7c, 0x29,
-	0x15, 0x30, 0x2d, 0x89, 0x5d, 0x8e, 0xa2, 0x3b, 0x50, 0x97, 0x51, 0xd5, 0x0b, 0xa9, 0xfb, 0xfa,
-	0xc5, 0xfb, 0x6f, 0x15, 0xf6, 0x46, 0x34, 0x4d, 0xb0, 0x08, 0x96, 0x4f, 0x59, 0x48, 0xbe, 0x55,
-	0x8d, 0xf5, 0x01, 0x32, 0x1e, 0xf9, 0xe4, 0x25, 0xa7, 0xc2, 0xd6, 0x07, 0x32, 0x9a, 0x04, 0x2f,
-	0xfc, 0x13, 0x63, 0xf1, 0x0b, 0x2c, 0xb9, 0x40, 0x2c, 0x04, 0x7f, 0x2a, 0x73, 0xa8, 0x5a, 0x88,
-	0x49, 0x8e, 0xa2, 0x4f, 0xa0, 0x7d, 0x91, 0x3b, 0x25, 0xed, 0xd6, 0x94, 0xbf, 0xd0, 0x0d, 0xfe,
-	0x2a, 0xd2, 0xd0, 0x87, 0x50, 0x0f, 0x70, 0xb0, 0x24, 0x46, 0x8a, 0xf6, 0x73, 0x49, 0x91, 0xa0,
-	0xaf, 0x6d, 0xe8, 0x17, 0xb0, 0x17, 0x92, 0x33, 0x9c, 0x45, 0x42, 0x25, 0xbf, 0x91, 0x9f, 0x8d,
-	0x6c, 0xe5, 0xb5, 0xa7, 0x16, 0xe5, 0xf8, 0x25, 0xb6, 0x4c, 0xa8, 0x2c, 0x25, 0x23, 0x0d, 0x75,
-	0x77, 0x0b, 0x61, 0x2e, 0xe0, 0x92, 0x35, 0x97, 0x5e, 0x1c, 0xab, 0xec, 0x6e, 0x16, 0x62, 0x50,
-	0xc0, 0xd1, 0x67, 0xb0, 0xcf, 0x8b, 0xa1, 0xed, 0xb6, 0xd4, 0x52, 0xbe, 0x93, 0x67, 0x75, 0xd1,
-	0xe8, 0x97, 0xb9, 0xb2, 0x05, 0x2a, 0x67, 0xda, 0x16, 0x08, 0xc5, 0x16, 0x58, 0xb4, 0xa0, 0x07,
-	0xd0, 0xe6, 0x04, 0x87, 0x96, 0xd8, 0x2e, 0x10, 0x8b, 0x06, 0xef, 0x2f, 0x0e, 0xd4, 0x95, 0xa7,
-	0xd0, 0x47, 0x50, 0x3b, 0x27, 0xeb, 0x54, 0x49, 0xd7, 0x5b, 0x72, 0x5f, 0x91, 0x64, 0x30, 0x43,
-	0x82, 0xc3, 0x88, 0xc6, 0xa4, 0x2c, 0xb2, 0x16, 0x45, 0x3f, 0x03, 0x08, 0x58, 0x1c, 0x52, 0x1d,
-	0xcb, 0x2d, 0x15, 0x1a, 0x5a, 0x8b, 0x75, 0xd0, 0x86, 0xea, 0xfd, 0x0a, 0x3a, 0x3e, 0x89, 0x43,
-	0xc2, 0x67, 0x64, 0x95, 0x44, 0xba, 0x3d, 0xef, 0xb2, 0xf9, 0xd7, 0x24, 0x10, 0x76, 0x71, 0x77,
-	0x36, 0xce, 0x92, 0xc4, 0x67, 0xca, 0xe8, 0x5b, 0x92, 0x77, 0x01, 0x7b, 0x45, 0xc3, 0x5b, 0x94,
-	0xeb, 0x08, 0xea, 0x32, 0xfb, 0xac, 0xa4, 0xa2, 0xf2, 0x77, 0x8f, 0x85, 0xe0, 0xbe, 0x26, 0xc8,
-	0xaa, 0x38, 0x8b, 0xb0, 0x38, 0x56, 0xec, 0x6a, 0x21, 0x03, 0x36, 0xb0, 0x77, 0x02, 0xb0, 0x19,
-	0xf8, 0x96, 0x59, 0x95, 0x3e, 0x09, 0x8e, 0x03, 0xf1, 0xf8, 0x32, 0xd9, 0xd6, 0x27, 0x8b, 0x7b,
-	0xff, 0x69, 0x40, 0xf5, 0x78, 0x32, 0x7e, 0xcf, 0xb3, 0xa0, 0xae, 0xd0, 0x09, 0x16, 0x82, 0xf0,
-	0xd8, 0xd4, 0x5b, 0xb1, 0x42, 0x8d, 0xc5, 0x2f, 0xb0, 0x54, 0xdf, 0x27, 0x62, 0xc9, 0x42, 0xd5,
-	0x0a, 0x5a, 0x79, 0xdf, 0x57, 0x98, 0xb4, 0x86, 0x6c, 0x85, 0xa9, 0x3e, 0xb3, 0xe4, 0x56, 0x8d,
-	0xa9, 0x1e, 0x20, 0xb0, 0xc8, 0x52, 0x55, 0x5a, 0xc5, 0x1e, 0xa0, 0xd0, 0xbc, 0x07, 0xa8, 0x37,
-	0xf4, 0x1b, 0x38, 0xa0, 0x49, 0xa9, 0x7d, 0xaa, 0xaa, 0x6a, 0xf7, 0x3f, 0xb0, 0xc3, 0xb6, 0xba,
-	0xeb, 0xe0, 0x03, 0x59, 0x96, 0x57, 0xaf, 0xef, 0x6d, 0xb7, 0x5d, 0x7f, 0xfb, 0x43, 0xd7, 0x4a,
-	0xbd, 0xf9, 0x4e, 0xa5, 0xde, 0x83, 0x7a, 0xac, 0x44, 0xb2, 0x55, 0xce, 0xb4, 0xa2, 0x44, 0xfa,
-	0x9a, 0x22, 0x05, 0x35, 0x21, 0x7c, 0x95, 0x76, 0x41, 0xf5, 0x73, 0xfd, 0x22, 0xa3, 0x8b, 0x33,
-	0xb1, 0xfc, 0x9c, 0x46, 0xb2, 0x93, 0xb4, 0x8b, 0xd1, 0xdd, 0xe0, 0xf2, 0x44, 0xc4, 0x4b, 0x59,
-	0xde, 0xdd, 0x2b, 0x9f, 0x88, 0xca, 0x35, 0xe0, 0x6f, 0xb1, 0xb7, 0x24, 0x69, 0xff, 0x0d, 0x92,
-	0xf4, 0x29, 0xb4, 0x56, 0x72, 0xd5, 0xb2, 0xc3, 0x74, 0x3b, 0x2a, 0x30, 0x79, 0x0d, 0x9e, 0x5a,
-	0x83, 0x4d, 0xe4, 0x9c, 0x29, 0xab, 0x3b, 0x61, 0xa9, 0xaa, 0xc7, 0xee, 0xc1, 0x7d, 0xe7, 0x68,
-	0x3f, 0x3f, 0x22, 0x1a, 0x14, 0xfd, 0x10, 0x6a, 0x02, 0x2f, 0xd2, 0xae, 0xfb, 0xa6, 0xd3, 0x85,
-	0x32, 0xa3, 0x11, 0xb8, 0x2f, 0xc9, 0x7c, 0xca, 0x82, 0x73, 0x22, 0x9e, 0x25, 0x5a, 0x0a, 0x6e,
-	0xa9, 0x7d, 0x76, 0xed, 0x90, 0xaf, 0xb6, 0xec, 0xfe, 0xb5, 0x11, 0x85, 0xf3, 0x28, 0xba, 0xe1,
-	0x3c, 0x7a, 0xfd, 0x6c, 0x79, 0xfb, 0x9d, 0xce, 0x96, 0x7f, 0x74, 0xa0, 0x95, 0xeb, 0xd1, 0xfb,
-	0x1e, 0x03, 0x3e, 0x84, 0x6a, 0xb0, 0x4a, 0xcc, 0xf9, 0xa7, 0x9d, 0xcf, 0x7c, 0x3a, 0x31, 0x54,
-	0x69, 0x95, 0xfb, 0x20, 0x97, 0x09, 0x09, 0x44, 0xa9, 0xff, 0x19, 0xcc, 0xfb, 0x47, 0x05, 0x76,
-	0x7d, 0x96, 0x09, 0x1a, 0x2f, 0xde, 0x5a, 0xf3, 0xa5, 0xfe, 0x5c, 0xb9, 0xb9, 0x3f, 0xbf, 0xaf,
-	0xf8, 0xa2, 0x47, 0xd0, 0x4c, 0x6d, 0x63, 0xaa, 0xa9, 0xcd, 0xe4, 0x15, 0x69, 0xd6, 0x66, 0x7b,
-	0x51, 0x7e, 0xaa, 0xb6, 0xbd, 0xe9, 0x01, 0xb4, 0x45, 0xe1, 0xc2, 0x55, 0xbc, 0xd8, 0x14, 0x0d,
-	0xef, 0xa8, 0x14, 0xdf, 0x87, 0x2a, 0x4e, 0xa8, 0x52, 0x87, 0xda, 0xa0, 0x6d, 0x5c, 0x21, 0x75,
-	0xd1, 0x97, 0x78, 0x2e, 0x80, 0xcd, 0x6d, 0x01, 0xf4, 0x7e, 0x0a, 0xee, 0x57, 0x37, 0x24, 0x12,
-	0xe3, 0x74, 0x41, 0xe3, 0x92, 0x28, 0x1b, 0xcc, 0x7b, 0x04, 0x8d, 0xe9, 0x3a, 0x15, 0x64, 0x85,
-	0x3e, 0x96, 0x27, 0xa5, 0x2c, 0x16, 0x26, 0x01, 0x6e, 0x6f, 0x3c, 0x97, 0xc5, 0xe2, 0x94, 0x08,
-	0x4e, 0x03, 0x7b, 0x5e, 0x53, 0x3c, 0xef, 0x4f, 0x0e, 0xb4, 0x0b, 0x46, 0x79, 0xbb, 0x35, 0xc1,
-	0x28, 0xdd, 0x52, 0x2d, 0x28, 0x17, 0xa2, 0x6f, 0x23, 0x2a, 0x80, 0x79, 0x46, 0x6b, 0xcc, 0xee,
-	0x59, 0x5f, 0x41, 0xaf, 0xef, 0xf9, 0x30, 0xcf, 0x93, 0xf2, 0xd5, 0xd9, 0x80, 0xbd, 0x1f, 0x41,
-	0x43, 0xbb, 0x12, 0x35, 0xa1, 0x36, 0x62, 0x2f, 0x63, 0x77, 0x07, 0x35, 0xa0, 0xf2, 0x22, 0x71,
-	0x1d, 0xd4, 0x86, 0xdd, 0x17, 0xf1, 0x79, 0x2c, 0xc1, 0x4a, 0xef, 0x21, 0xec, 0x9b, 0xda, 0xd8,
-	0xf0, 0xe5, 0xad, 0xd3, 0xdd, 0x91, 0x4f, 0x4f, 0x70, 0x74, 0xe6, 0x3a, 0xa8, 0x05, 0x75, 0x75,
-	0x7d, 0x75, 0x2b, 0xbd, 0x1f, 0x43, 0xbb, 0xf0, 0x27, 0x02, 0xea, 0x00, 0xf8, 0x2c, 0x8b, 0x43,
-	0x9f, 0xcd, 0xa9, 0x1c, 0x03, 0xd0, 0x18, 0x4f, 0x9e, 0xe0, 0x74, 0xe9, 0x3a, 0xbd, 0x9f, 0x43,
-	0xd3, 0x5e, 0x2f, 0xd5, 0xb7, 0x66, 0xb3, 0x89, 0xfe, 0xea, 0x17, 0x3c, 0x09, 0xf4, 0x57, 0x47,
-	0xd9, 0x7c, 0xce, 0xdc, 0x0a, 0x3a, 0x80, 0xf6, 0x34, 0xe1, 0x34, 0x5e, 0x0c, 0x23, 0x96, 0x85,
-	0x6e, 0xb5, 0xf7, 0x5b, 0x68, 0xe8, 0x8b, 0x83, 0x34, 0x3d, 0xcf, 0x88, 0x3a, 0xff, 0xd0, 0x78,
-	0xe1, 0xee, 0xa0, 0x3d, 0x68, 0x7e, 0xce, 0xf8, 0x6a, 0x84, 0x05, 0x76, 0x1d, 0xf9, 0xf6, 0xeb,
-	0xe9, 0xb3, 0xa7, 0x03, 0x16, 0xae, 0xdd, 0x8a, 0x9c, 0xfe, 0x89, 0xba, 0xfe, 0xb8, 0x55, 0xf9,
-	0x3c, 0x54, 0xb7, 0x1b, 0xb7, 0x86, 0xf6, 0xe5, 0x25, 0x46, 0x2c, 0x95, 0x2c, 0xb9, 0xf5, 0xde,
-	0x5d, 0x68, 0xda, 0x8b, 0x83, 0xda, 0x41, 0x16, 0x11, 0x9f, 0x2c, 0xc8, 0x65, 0xe2, 0xee, 0xf4,
-	0x5e, 0x40, 0x75, 0x78, 0x3a, 0x51, 0x5b, 0x3e, 0x9d, 0x3c, 0x7e, 0xee, 0xee, 0x98, 0xc7, 0x93,
-	0x99, 0x71, 0xc4, 0xe9, 0xe4, 0xe4, 0xb1, 0x5b, 0x31, 0x8f, 0x5f, 0xcc, 0xdc, 0xaa, 0x7d, 0x7c,
-	0xec, 0xd6, 0xcc, 0xe3, 0x38, 0x76, 0xeb, 0x72, 0x65, 0xc3, 0xd3, 0x89, 0x52, 0x58, 0xb7, 0xd1,
-	0x7b, 0x00, 0x07, 0x5b, 0xc5, 0x23, 0x3d, 0x31, 0x64, 0xc9, 0x5a, 0xcf, 0x30, 0x4d, 0x22, 0x2a,
-	0x5c, 0xa7, 0xf7, 0x08, 0x5a, 0xb9, 0x28, 0x23, 0x17, 0xf6, 0xd4, 0x8b, 0x91, 0x72, 0xbd, 0x79,
-	0x85, 0x1c, 0x47, 0x91, 0xde, 0xbc, 0x7e, 0x8b, 0xd7, 0x6e, 0x65, 0x70, 0xe7, 0xd5, 0xbf, 0x0e,
-	0x77, 0xbe, 0xb9, 0x3a, 0x74, 0x5e, 0x5d, 0x1d, 0x3a, 0xff, 0xbc, 0x3a, 0x74, 0xfe, 0xf6, 0xef,
-	0xc3, 0x9d, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0xee, 0x39, 0xce, 0x37, 0xc1, 0x12, 0x00, 0x00,
+	// 6631 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x7c, 0x4b, 0x6c, 0x24, 0xd7,
+	0x75, 0xf6, 0x54, 0xbf, 0xd8, 0x7d, 0xba, 0x49, 0xd6, 0xd4, 0xcc, 0x48, 0xa5, 0xf9, 0xa5, 0x11,
+	0xff, 0xb2, 0x2c, 0x8f, 0xa9, 0xd1, 0x6b, 0x2c, 0xc5, 0xb6, 0x6c, 0x0b, 0x68, 0x92, 0x33, 0x1a,
+	0x46, 0xe4, 0xa8, 0x55, 0x4d, 0x49, 0x41, 0x9c, 0x2c, 0x8a, 0x55, 0x97, 0xdd, 0x65, 0x56, 0x57,
+	0x95, 0xaa, 0xaa, 0x49, 0x76, 0x16, 0x41, 0xe0, 0x20, 0x9b, 0x00, 0x5e, 0x04, 0x48, 0x0c, 0x1b,
+	0x41, 0x1c, 0x24, 0xcb, 0x6c, 0x82, 0x04, 0x30, 0xb2, 0xca, 0x26, 0x8b, 0xc0, 0xd9, 0x19, 0x79,
+	0x2d, 0xb2, 0x10, 0xec, 0xc9, 0x32, 0x41, 0x16, 0x49, 0x80, 0x6c, 0xb2, 0x08, 0xce, 0x7d, 0xd5,
+	0xbd, 0xd5, 0x8f, 0xe1, 0x8c, 0xe3, 0x4d, 0x56, 0x64, 0x7d, 0xe7, 0xdc, 0xaa, 0xfb, 0x38, 0xf7,
+	0xdc, 0xf3, 0xba, 0x0d, 0xbd, 0x09, 0x29, 0xbc, 0xf4, 0xf8, 0xb5, 0x34, 0x4b, 0x8a, 0xc4, 0x6a,
+	0xb1, 0xa7, 0x9b, 0xd7, 0x47, 0xc9, 0x28, 0xa1, 0xd0, 0xeb, 0xf8, 0x1f, 0xa3, 0x3a, 0x19, 0x34,
+	0x07, 0x59, 0x72, 0x31, 0xb3, 0x6c, 0x68, 0x78, 0x41, 0x90, 0xd9, 0xc6, 0x96, 0x71, 0xbb, 0xb3,
+	0xd3, 0xf8, 0xd1, 0x67, 0x2f, 0x5e, 0x71, 0x29, 0x62, 0xdd, 0x82, 0x35, 0xfc, 0xeb, 0x0e, 0x76,
+	0xed, 0x9a, 0x42, 0x14, 0xa0, 0xf5, 0x3a, 0xb4, 0x22, 0xef, 0x98, 0x44, 0xb9, 0x5d, 0xdf, 0xaa,
+	0xdf, 0xee, 0xde, 0xbd, 0xfa, 0x1a, 0xff, 0xfe, 0xc0, 0x0b, 0xb3, 0x8f, 0xbd, 0x68, 0x4a, 0x78,
+	0x0b, 0xce, 0xe6, 0x7c, 0xbb, 0x09, 0x6b, 0xbb, 0xd1, 0x34, 0x2f, 0x48, 0x66, 0xdd, 0x84, 0x5a,
+	0x18, 0xd0, 0x8f, 0x36, 0x76, 0x00, 0xb9, 0x1e, 0x7d, 0xf6, 0x62, 0x6d, 0x7f, 0xcf, 0xad, 0x85,
+	0x01, 0x76, 0x29, 0xf6, 0x26, 0x44, 0xfb, 0x2a, 0x45, 0xac, 0xaf, 0x41, 0x37, 0x4a, 0xbc, 0x60,
+	0xc7, 0x8b, 0xbc, 0xd8, 0x27, 0x76, 0x7d, 0xcb, 0xb8, 0xbd, 0x71, 0xf7, 0x9a, 0xf8, 0xee, 0x41,
+	0x49, 0xe2, 0xad, 0x54, 0x6e, 0xeb, 0x2b, 0xd0, 0x4b, 0xa6, 0xc5, 0x71, 0x32, 0x8d, 0x83, 0xfe,
+	0xb4, 0x18, 0xdb, 0x8d, 0x2d, 0xe3, 0x76, 0xf7, 0xee, 0x75, 0xd1, 0xfa, 0x03, 0x85, 0xe6, 0x6a,
+	0x9c, 0xd6, 0xd7, 0x60, 0x7d, 0xec, 0x45, 0x27, 0x1f, 0xa4, 0x24, 0x1e, 0x64, 0xc9, 0x31, 0xb1,
+	0x9b, 0xb4, 0xe9, 0x0d, 0xd1, 0xf4, 0x81, 0x4a, 0x74, 0x75, 0x5e, 0xfc, 0xec, 0x34, 0xcd, 0x8b,
+	0x8c, 0x78, 0x93, 0x07, 0x49, 0x5e, 0xd8, 0x2d, 0xfd, 0xb3, 0x1f, 0x29, 0x34, 0x57, 0xe3, 0xb4,
+	0x3e, 0x0f, 0x8d, 0xc2, 0x1b, 0xe5, 0xf6, 0xda, 0x92, 0xe9, 0x75, 0x29, 0xd9, 0xba, 0x03, 0xf5,
+	0x20, 0xce, 0xed, 0xf6, 0x96, 0xa1, 0x72, 0xed, 0x3d, 0x1c, 0x1e, 0x79, 0xd9, 0x88, 0x14, 0x3b,
+	0x6b, 0x8f, 0x3e, 0x7b, 0xb1, 0xbe, 0xf7, 0x70, 0xe8, 0x22, 0x9b, 0xe5, 0x40, 0x67, 0x12, 0xc6,
+	0x7d, 0xbf, 0x08, 0xcf, 0x88, 0xdd, 0xd9, 0x32, 0x6e, 0x37, 0xf9, 0x5c, 0x95, 0x30, 0x8e, 0x37,
+	0x23, 0x93, 0xa4, 0x20, 0xef, 0x79, 0x05, 0x39, 0xf7, 0x66, 0x36, 0xe8, 0xe3, 0x75, 0x55, 0xa2,
+	0xab, 0xf3, 0x5a, 0x2f, 0x43, 0x2b, 0x4d, 0xa2, 0xd0, 0x9f, 0xd9, 0x5d, 0xda, 0x6a, 0x43, 0xf6,
+	0x9b, 0xa2, 0x2e, 0xa7, 0x5a, 0xef, 0xc2, 0x86, 0x1f, 0x66, 0xfe, 0x34, 0x2c, 0x76, 0x32, 0xe2,
+	0x9d, 0x92, 0xcc, 0xee, 0x51, 0xfe, 0x67, 0x04, 0xff, 0xae, 0x46, 0x75, 0x2b, 0xdc, 0xd6, 0xdb,
+	0xd0, 0xf5, 0x93, 0x38, 0x76, 0x89, 0x3f, 0xf3, 0x23, 0x62, 0xaf, 0xd3, 0xc6, 0x52, 0x16, 0x76,
+	0x4b, 0x92, 0xab, 0xf2, 0x39, 0xbf, 0x0a, 0x5d, 0x85, 0x66, 0xbd, 0x0c, 0xdd, 0x89, 0x77, 0x71,
+	0x10, 0x9e, 0x90, 0x22, 0x9c, 0x10, 0x2a, 0x90, 0x75, 0x21, 0x3c, 0x0a, 0x81, 0xf3, 0xb9, 0xe4,
+	0xd3, 0x29, 0xc9, 0x8b, 0xdc, 0xae, 0x55, 0xf8, 0x04, 0xc1, 0xf9, 0xbb, 0x1a, 0xb4, 0xd8, 0x40,
+	0xad, 0x97, 0x00, 0xbc, 0x69, 0x31, 0xbe, 0x1f, 0x46, 0x05, 0xd1, 0xf7, 0x97, 0x82, 0x5b, 0xcf,
+	0x43, 0x6b, 0xe2, 0x5d, 0x7c, 0x38, 0x18, 0x6a, 0xef, 0xe4, 0x18, 0x5b, 0x89, 0x22, 0x9b, 0x0d,
+	0x8b, 0xcc, 0x2b, 0xc8, 0x68, 0x66, 0xd7, 0xab, 0x2b, 0xa1, 0x10, 0x5d, 0x9d, 0xd7, 0xba, 0x0d,
+	0xbd, 0xf3, 0x2c, 0x2c, 0xc8, 0x51, 0x38, 0x21, 0xc9, 0xb4, 0xb0, 0x1b, 0xca, 0x07, 0x34, 0x0a,
+	0x8e, 0x2e, 0x23, 0x5e, 0x20, 0x18, 0x9b, 0xea, 0xe8, 0x14, 0x02, 0xaa, 0x84, 0x82, 0xf3, 0xb4,
+	0x14, 0x1e, 0x01, 0x5a, 0x1f, 0xc3, 0x66, 0x98, 0xf6, 0x7d, 0x9f, 0xe4, 0xf9, 0x6e, 0x12, 0x17,
+	0x59, 0x12, 0xd9, 0x6b, 0xb4, 0xc3, 0xcf, 0x8a, 0x0e, 0xef, 0x0f, 0x34, 0xf2, 0xce, 0xb5, 0x47,
+	0x9f, 0xbd, 0xb8, 0x59, 0x01, 0xdd, 0xea, 0x4b, 0x9c, 0x43, 0x58, 0xd7, 0x64, 0x0e, 0x67, 0x2d,
+	0x27, 0x7e, 0x46, 0x0a, 0x6d, 0x5e, 0x39, 0x86, 0xdd, 0x9c, 0x78, 0x17, 0x0f, 0x92, 0x94, 0x2d,
+	0x94, 0x90, 0x70, 0x01, 0x3a, 0x3f, 0xac, 0x41, 0x47, 0xee, 0x0f, 0x54, 0x37, 0xe3, 0x24, 0xd7,
+	0xdf, 0x44, 0x11, 0xa4, 0xa4, 0x49, 0x56, 0x68, 0x2f, 0xa1, 0x88, 0x75, 0x17, 0xda, 0x54, 0x8f,
+	0xfa, 0x49, 0xc4, 0xb5, 0x90, 0x29, 0xc5, 0x9c, 0xe3, 0x9c, 0x5f, 0xf2, 0x29, 0x2b, 0xdd, 0x58,
+	0xb0, 0xd2, 0x77, 0x01, 0xc6, 0xc4, 0x2b, 0xc6, 0xbb, 0x63, 0xe2, 0x9f, 0x72, 0x05, 0x63, 0x49,
+	0x05, 0x23, 0x29, 0xae, 0xc2, 0xb5, 0x60, 0x0b, 0xb5, 0x9e, 0x68, 0x0b, 0xbd, 0x06, 0x9b, 0x19,
+	0x39, 0xc9, 0x48, 0x3e, 0xde, 0x8f, 0x0b, 0x92, 0x9d, 0x79, 0x6c, 0xb9, 0x44, 0xd7, 0xaa, 0x44,
+	0xe7, 0x7b, 0x06, 0xac, 0x6b, 0xba, 0xce, 0xfa, 0x32, 0xb4, 0x73, 0x21, 0x9a, 0x06, 0x9d, 0x87,
+	0x1b, 0xca, 0x3c, 0x1c, 0x13, 0x21, 0x8b, 0x62, 0x32, 0x04, 0xf3, 0xa2, 0xfd, 0xd4, 0x5c, 0xb0,
+	0x9f, 0x90, 0xaf, 0xc8, 0xbc, 0x93, 0x93, 0xd0, 0x77, 0xbd, 0x82, 0x69, 0x7c, 0xc9, 0xa7, 0x10,
+	0x9c, 0x6f, 0xd7, 0xa0, 0xa7, 0x6a, 0x70, 0xeb, 0x2e, 0x34, 0x8a, 0x59, 0x4a, 0x78, 0xaf, 0xec,
+	0x45, 0x5a, 0xfe, 0x68, 0x96, 0x8a, 0x83, 0x82, 0xf2, 0x5a, 0x37, 0xa1, 0x59, 0x24, 0xa7, 0x24,
+	0xd6, 0x4e, 0x1e, 0x06, 0xa1, 0xde, 0xf4, 0xa8, 0x4c, 0xbe, 0x4f, 0xd8, 0x2e, 0x14, 0xf4, 0x12,
+	0x46, 0x1e, 0x26, 0x81, 0xc8, 0xd3, 0x50, 0x79, 0x24, 0x8c, 0x52, 0x90, 0x91, 0x51, 0x98, 0xc4,
+	0x76, 0x53, 0x61, 0xe0, 0x18, 0x4a, 0x6e, 0x4e, 0xb2, 0xb3, 0xd0, 0x27, 0x76, 0x4b, 0x21, 0x0b,
+	0x10, 0x5b, 0x8f, 0x89, 0x17, 0x90, 0xcc, 0x5e, 0x53, 0xc8, 0x1c, 0x73, 0x3e, 0x86, 0x9e, 0x7a,
+	0x9c, 0x58, 0xdb, 0xda, 0x1c, 0x48, 0x09, 0x45, 0xda, 0xa2, 0xb1, 0x9f, 0xe1, 0xa1, 0xa2, 0x8f,
+	0x9d, 0x42, 0xce, 0x4f, 0x6a, 0x00, 0xa5, 0x08, 0xd2, 0x6d, 0xe1, 0x15, 0x63, 0x7d, 0xc3, 0x20,
+	0x82, 0x94, 0xe3, 0x24, 0x98, 0xe9, 0x27, 0x37, 0x22, 0xd6, 0x36, 0xac, 0xfb, 0xd8, 0x58, 0x0a,
+	0x5a, 0x5d, 0x11, 0x34, 0x9d, 0xa4, 0x6a, 0x99, 0xc6, 0x22, 0x2d, 0xf3, 0x06, 0x1f, 0x56, 0x93,
+	0x0e, 0xeb, 0x99, 0xf9, 0x4d, 0x32, 0x37, 0xb8, 0x37, 0xc0, 0x1c, 0x13, 0x2f, 0x2a, 0xc6, 0xb3,
+	0xa3, 0x31, 0x4a, 0x74, 0x12, 0x05, 0x76, 0x4b, 0x11, 0xa5, 0x39, 0xaa, 0xf5, 0x16, 0x58, 0xd3,
+	0x78, 0xae, 0xcd, 0x9a, 0xd2, 0x66, 0x01, 0xdd, 0xb2, 0x61, 0xcd, 0x4f, 0x26, 0x13, 0x2f, 0x0e,
+	0xec, 0xf6, 0x56, 0xfd, 0x76, 0xc7, 0x15, 0x8f, 0x38, 0x26, 0x3a, 0x48, 0x92, 0xd9, 0x1d, 0x65,
+	0x72, 0x04, 0xe8, 0xfc, 0xa8, 0x06, 0x1b, 0xfa, 0x6e, 0x45, 0xf5, 0xed, 0x47, 0x49, 0x2e, 0xd5,
+	0xb7, 0x7a, 0x36, 0x69, 0x14, 0xdc, 0xc7, 0x68, 0x73, 0x1c, 0x29, 0x1b, 0x45, 0xdd, 0x50, 0x55,
+	0x22, 0xdd, 0xf7, 0x5e, 0x41, 0xe8, 0x5c, 0x0d, 0x48, 0x16, 0x26, 0x81, 0xb6, 0x1c, 0x55, 0x22,
+	0x4e, 0xc6, 0x89, 0x17, 0x46, 0xd3, 0x8c, 0x60, 0xf3, 0xa3, 0x64, 0x17, 0x3f, 0x6e, 0x37, 0x94,
+	0x4f, 0x2c, 0xa0, 0x5b, 0x77, 0xe1, 0x6a, 0x3e, 0xf5, 0x7d, 0x42, 0x02, 0x86, 0xa2, 0xd6, 0xb0,
+	0x9b, 0x4a, 0xa3, 0x79, 0xb2, 0xb5, 0x03, 0xcf, 0xf9, 0x49, 0x5c, 0x84, 0xf1, 0x34, 0x99, 0xe6,
+	0xf7, 0xd9, 0x3b, 0x73, 0xf1, 0x41, 0x75, 0xc5, 0x96, 0xb3, 0x39, 0xdf, 0xaf, 0x43, 0x6b, 0x48,
+	0xb2, 0xb3, 0xc7, 0x5b, 0x99, 0xd4, 0xf0, 0xad, 0xcd, 0x19, 0xbe, 0xff, 0x37, 0x94, 0xfb, 0x25,
+	0xad, 0xc7, 0x5b, 0xb0, 0x16, 0x64, 0x5e, 0x18, 0x93, 0x80, 0x5a, 0x90, 0x6d, 0x21, 0x98, 0x1c,
+	0xb4, 0xee, 0x40, 0xeb, 0x9c, 0x84, 0xa3, 0x71, 0x61, 0x77, 0x74, 0xc3, 0x95, 0x4d, 0xf1, 0x27,
+	0x94, 0xe6, 0x72, 0x1e, 0xaa, 0xbf, 0x0a, 0x2f, 0x0e, 0x8e, 0x99, 0xcd, 0x28, 0xdf, 0xc6, 0x41,
+	0xe7, 0xbb, 0x06, 0xf4, 0xd4, 0x86, 0xb8, 0x0a, 0x27, 0x59, 0x32, 0xb1, 0x0d, 0x65, 0x6d, 0x29,
+	0x82, 0x33, 0x5a, 0xd0, 0x03, 0x5a, 0x93, 0x65, 0x8e, 0x51, 0x8b, 0xc5, 0x9b, 0xa4, 0xc3, 0xc2,
+	0xcb, 0x8a, 0x7e, 0xa1, 0x89, 0xaf, 0x4a, 0x90, 0x7c, 0xc4, 0x4f, 0xe2, 0x20, 0xd7, 0x16, 0x47,
+	0x25, 0x38, 0x07, 0xd0, 0xd8, 0x09, 0xe3, 0x00, 0x55, 0xb8, 0xcf, 0x5c, 0x94, 0xfd, 0x3d, 0x2e,
+	0x38, 0x5c, 0x85, 0x4b, 0xd8, 0xda, 0x82, 0x76, 0x4e, 0xc7, 0xb0, 0xbf, 0x67, 0xd7, 0x14, 0x16,
+	0x89, 0x3a, 0x7d, 0xe8, 0xc8, 0x79, 0x96, 0xee, 0x8c, 0x31, 0xe7, 0xce, 0xac, 0xd2, 0xb9, 0x87,
+	0x50, 0x35, 0x8b, 0xac, 0xe7, 0xa1, 0x73, 0x3e, 0x0e, 0x0b, 0x12, 0x85, 0xd4, 0x5a, 0x41, 0xfd,
+	0x52, 0x02, 0x48, 0x3d, 0x8e, 0x3c, 0xff, 0x94, 0x52, 0x6b, 0x8c, 0x2a, 0x01, 0xe7, 0x77, 0x0d,
+	0x80, 0x07, 0x47, 0x47, 0x03, 0x97, 0xe4, 0xd3, 0xa8, 0xb0, 0x2c, 0xae, 0xa8, 0xb1, 0x4f, 0x3d,
+	0xae, 0xa2, 0x5f, 0x81, 0x35, 0x76, 0x8e, 0xe4, 0x76, 0x6d, 0x99, 0xcc, 0x08, 0x0e, 0x64, 0xf6,
+	0x93, 0xe4, 0x34, 0x24, 0xcb, 0xbd, 0x3f, 0x57, 0x70, 0xe0, 0x0c, 0xf8, 0x49, 0xa0, 0x6b, 0x0c,
+	0x8a, 0x38, 0x7f, 0x6e, 0x40, 0xe7, 0x5e, 0x96, 0x25, 0xd9, 0xc0, 0x1b, 0xd1, 0xd3, 0x2d, 0x2f,
+	0xbc, 0x62, 0x9a, 0x6b, 0xe2, 0xc0, 0x31, 0xf9, 0x96, 0x5a, 0xf5, 0x2d, 0xb8, 0xc8, 0xa8, 0x0e,
+	0x48, 0x4c, 0x8f, 0x35, 0xed, 0x74, 0x56, 0x09, 0xf2, 0x78, 0x6a, 0xcc, 0x1d, 0x4f, 0xca, 0xd8,
+	0x9b, 0x8f, 0x1b, 0xbb, 0x93, 0xe0, 0xea, 0x66, 0xde, 0x84, 0xa0, 0xfd, 0xbe, 0x7c, 0x75, 0xef,
+	0x40, 0x2b, 0x4f, 0xa6, 0x99, 0xcf, 0x7a, 0xbc, 0x51, 0x3a, 0x42, 0x43, 0x8a, 0xca, 0xd1, 0xd1,
+	0x27, 0x94, 0x85, 0x30, 0x0e, 0xc8, 0x85, 0x66, 0xe2, 0x30, 0xc8, 0xf9, 0x16, 0x6c, 0x7c, 0xec,
+	0x45, 0x61, 0xe0, 0x15, 0x61, 0x12, 0xbb, 0xd3, 0x08, 0x75, 0x6b, 0x3b, 0x9b, 0x46, 0xe4, 0x68,
+	0xc1, 0xe9, 0xee, 0x72, 0x5c, 0x08, 0xa5, 0xe0, 0x43, 0x7f, 0x84, 0x5c, 0xa4, 0x19, 0xc9, 0x73,
+	0xb4, 0x3e, 0x54, 0x91, 0x53, 0x70, 0xe7, 0xfb, 0x06, 0x40, 0xf9, 0x31, 0xeb, 0x6d, 0xe8, 0xa4,
+	0x62, 0xac, 0xf4, 0x4b, 0xda, 0xd4, 0x70, 0x82, 0xd8, 0x22, 0x92, 0x13, 0xb7, 0x48, 0x46, 0x3e,
+	0x9d, 0x86, 0x19, 0x09, 0xec, 0x9a, 0xa2, 0x08, 0x24, 0x6a, 0xdd, 0x85, 0x26, 0xf6, 0x4c, 0x88,
+	0x8f, 0xd4, 0x6a, 0xfa, 0x40, 0xc5, 0x3c, 0x50, 0x56, 0xe7, 0x3b, 0x35, 0xf4, 0x03, 0x54, 0x17,
+	0x67, 0x0b, 0xda, 0xa1, 0xb0, 0x28, 0x54, 0x99, 0x91, 0x28, 0x72, 0x4c, 0xbc, 0x0b, 0x3c, 0x29,
+	0x75, 0x2b, 0x53, 0xa2, 0xd6, 0x75, 0x68, 0xa2, 0x14, 0xb1, 0x9e, 0x34, 0x5d, 0xf6, 0x80, 0x26,
+	0x03, 0xba, 0x8d, 0xc4, 0xc7, 0xae, 0x50, 0x11, 0x65, 0xda, 0x43, 0x8c, 0x64, 0x8e, 0xca, 0x4d,
+	0x5a, 0x69, 0xe0, 0x34, 0x2b, 0x26, 0xad, 0x20, 0xa0, 0x94, 0x7f, 0x2b, 0x2c, 0x0a, 0xae, 0xd0,
+	0xc5, 0xfb, 0x38, 0x86, 0x86, 0x52, 0x4a, 0xb2, 0xa3, 0x6c, 0x26, 0x8e, 0x7d, 0xd5, 0x22, 0xd7,
+	0x49, 0xce, 0x6f, 0x36, 0xa1, 0xb7, 0x17, 0xe6, 0xa9, 0x57, 0xf8, 0xe3, 0x87, 0xb8, 0x11, 0x2e,
+	0xa3, 0xbd, 0xee, 0x02, 0x4c, 0xb3, 0xc8, 0x25, 0xd4, 0x01, 0xe4, 0x62, 0x60, 0xf1, 0xb3, 0x11,
+	0x3e, 0x72, 0x0f, 0x38, 0xc5, 0x55, 0xb8, 0x70, 0x12, 0xbd, 0xa2, 0xc8, 0x1e, 0xa2, 0xa0, 0xab,
+	0xbb, 0x4b, 0xa2, 0xd6, 0x5b, 0xd0, 0x3d, 0x93, 0x2b, 0x87, 0x33, 0x55, 0x57, 0x8f, 0x38, 0x65,
+	0x51, 0x55, 0x36, 0xeb, 0x73, 0xd0, 0xf4, 0x3d, 0x7f, 0x2c, 0x02, 0x2a, 0xeb, 0xf2, 0x68, 0x43,
+	0xd0, 0x65, 0x34, 0xeb, 0xeb, 0xd0, 0x0b, 0xc8, 0x89, 0x37, 0x8d, 0x0a, 0xba, 0x0f, 0xf9, 0x31,
+	0x58, 0x1e, 0x9f, 0x52, 0xab, 0xd1, 0x4e, 0x19, 0xae, 0xc6, 0x8d, 0x52, 0x3f, 0xcd, 0xc9, 0x1e,
+	0x83, 0xec, 0x35, 0x65, 0xc6, 0x15, 0x1c, 0xb9, 0x8e, 0x71, 0x16, 0xf7, 0xe9, 0x16, 0x6c, 0x2b,
+	0x4b, 0xa7, 0xe0, 0xf3, 0xde, 0x78, 0xe7, 0x67, 0xf0, 0xc6, 0xe1, 0xb2, 0xde, 0x78, 0x77, 0x99,
+	0x37, 0xfe, 0x2a, 0xb4, 0xd1, 0xa6, 0x8b, 0xc3, 0x62, 0x66, 0xf7, 0x96, 0x6c, 0x4d, 0x57, 0xb2,
+	0xa0, 0x73, 0x3e, 0xca, 0x52, 0xff, 0x28, 0xf3, 0xe2, 0xdc, 0x4f, 0x82, 0x30, 0x1e, 0xd9, 0xeb,
+	0xba, 0x73, 0xfe, 0x9e, 0x3b, 0xd8, 0x55, 0xc8, 0xcc, 0x39, 0xaf, 0x80, 0x6e, 0xf5, 0x25, 0x0e,
+	0x81, 0x2a, 0x8f, 0xf5, 0x16, 0x40, 0x40, 0x72, 0x3f, 0x0b, 0xd3, 0x22, 0xc9, 0xb8, 0x20, 0x5e,
+	0xe7, 0x32, 0xd6, 0xdb, 0x93, 0x94, 0xfd, 0x3d, 0x57, 0xe1, 0xa3, 0x36, 0x14, 0x29, 0xc6, 0x49,
+	0xa0, 0x29, 0x27, 0x8e, 0x39, 0x7f, 0x61, 0x40, 0x93, 0xca, 0x85, 0xf5, 0x0a, 0x34, 0x4e, 0xc9,
+	0x2c, 0xa7, 0x47, 0xe0, 0x0a, 0x75, 0x44, 0x99, 0x50, 0x74, 0x03, 0xe2, 0x05, 0x51, 0x18, 0x13,
+	0xfd, 0xb0, 0x16, 0xa8, 0xf5, 0x65, 0x00, 0xb4, 0x01, 0x42, 0x26, 0xb9, 0x95, 0xd3, 0x6c, 0x57,
+	0x50, 0x84, 0x38, 0x94, 0xac, 0xb8, 0x4e, 0xe1, 0x28, 0x4e, 0x32, 0xf2, 0xe1, 0x94, 0x64, 0x33,
+	0x4d, 0x3b, 0xa8, 0x04, 0xe7, 0xdb, 0x06, 0x6c, 0xb8, 0x24, 0x0e, 0x48, 0x76, 0x44, 0x26, 0x69,
+	0xc4, 0x2c, 0xf0, 0xb5, 0xe4, 0xf8, 0x5b, 0xc4, 0x2f, 0xc4, 0x28, 0xae, 0x97, 0x32, 0x84, 0x8c,
+	0x1f, 0x50, 0xa2, 0x2b, 0x98, 0x56, 0x38, 0x56, 0x97, 0x3c, 0xfb, 0x9c, 0x33, 0xe8, 0xa9, 0xaf,
+	0x5e, 0x71, 0x6e, 0xdd, 0x86, 0x26, 0x6e, 0x6b, 0x61, 0x05, 0x58, 0x7a, 0xcf, 0xfa, 0x45, 0x91,
+	0xb9, 0x8c, 0x01, 0xd5, 0xcd, 0x49, 0xe4, 0x15, 0x7d, 0xca, 0x5d, 0x57, 0x86, 0x5f, 0xc2, 0xce,
+	0x01, 0x40, 0xd9, 0x70, 0xc5, 0x57, 0xe9, 0xe9, 0x54, 0x64, 0x9e, 0x5f, 0xdc, 0xbb, 0x48, 0xab,
+	0xa7, 0x93, 0xc0, 0x9d, 0xbf, 0xbd, 0x06, 0xf5, 0xfe, 0x60, 0xff, 0x29, 0xc3, 0xc7, 0x4c, 0xf5,
+	0x0d, 0x3c, 0x54, 0xb4, 0xb1, 0x5d, 0x9f, 0x53, 0x7d, 0x9c, 0xe2, 0x2a, 0x5c, 0x8a, 0x50, 0x36,
+	0xe6, 0x85, 0x12, 0xa9, 0x41, 0x32, 0xf1, 0xc2, 0x8a, 0x37, 0xcf, 0x30, 0x6a, 0x01, 0x30, 0x7b,
+	0xa6, 0x55, 0xb1, 0x00, 0x28, 0x5a, 0xb1, 0x6f, 0x7e, 0xf9, 0x89, 0x83, 0x67, 0xcf, 0xa2, 0xbe,
+	0xbb, 0x4c, 0x00, 0x6d, 0x4e, 0x87, 0xb6, 0x9f, 0x48, 0x87, 0x6e, 0x43, 0x33, 0xa6, 0x27, 0x64,
+	0x47, 0x97, 0x55, 0xf5, 0xec, 0x71, 0x19, 0x0b, 0x9e, 0xa6, 0x29, 0xc9, 0x26, 0xb9, 0x0d, 0xd4,
+	0x04, 0x65, 0x0f, 0x95, 0x58, 0x68, 0x77, 0x49, 0x2c, 0xf4, 0x5d, 0xd8, 0xc8, 0xb4, 0x7d, 0x52,
+	0x0d, 0x09, 0xeb, 0xbb, 0xc8, 0xad, 0x70, 0x57, 0x74, 0xfd, 0xfa, 0x12, 0x5d, 0xff, 0x36, 0x74,
+	0x26, 0xd8, 0x6b, 0xb4, 0x2f, 0xec, 0x0d, 0xba, 0x30, 0x72, 0xbb, 0x1f, 0x0a, 0x82, 0x0c, 0x8a,
+	0x0b, 0x00, 0x15, 0x49, 0x9a, 0xe4, 0x74, 0xeb, 0xdb, 0x9b, 0x5b, 0xc6, 0xed, 0x75, 0xe9, 0x03,
+	0x72, 0x54, 0x7a, 0x5c, 0xe6, 0x6a, 0x8f, 0x6b, 0x0f, 0xcc, 0x73, 0x72, 0x3c, 0x4c, 0xfc, 0x53,
+	0x52, 0x7c, 0x90, 0x32, 0xad, 0x73, 0x95, 0x8e, 0x53, 0x46, 0xa9, 0x3e, 0xa9, 0xd0, 0xdd, 0xb9,
+	0x16, 0x8a, 0xc3, 0x69, 0x2d, 0x70, 0x38, 0xe7, 0x9d, 0xc7, 0x6b, 0x4f, 0xe4, 0x3c, 0x6e, 0x41,
+	0xbb, 0x10, 0x6b, 0x70, 0x5d, 0xd5, 0x9a, 0x02, 0xb5, 0xde, 0x04, 0x20, 0xc2, 0x70, 0xcf, 0xed,
+	0x1b, 0xfa, 0x90, 0xa5, 0x49, 0xef, 0x2a, 0x4c, 0x18, 0xb1, 0x0f, 0x48, 0x9a, 0x11, 0x9f, 0x9e,
+	0xfe, 0xf6, 0x33, 0x7a, 0xc4, 0x7e, 0xaf, 0x24, 0xb9, 0x2a, 0x9f, 0xb5, 0x0d, 0x6b, 0x5e, 0x14,
+	0x7a, 0x39, 0xc9, 0xed, 0x67, 0xe9, 0x67, 0xa4, 0xa9, 0xdb, 0x1f, 0xec, 0xf7, 0x91, 0xe2, 0x0a,
+	0x06, 0x76, 0x42, 0xd3, 0xd0, 0xe1, 0xd0, 0x1f, 0x93, 0x89, 0x67, 0xdb, 0xd5, 0x13, 0x5a, 0x21,
+	0xba, 0x3a, 0x2f, 0x13, 0xbf, 0x3c, 0x4d, 0xe2, 0x9c, 0xf0, 0xd6, 0xcf, 0x55, 0xc5, 0x4f, 0xa5,
+	0xba, 0x15, 0x6e, 0xeb, 0x0d, 0x58, 0x1b, 0x65, 0x5e, 0x3a, 0xfe, 0xf0, 0xc0, 0xbe, 0xa9, 0x37,
+	0x7c, 0x8f, 0xc1, 0x62, 0x35, 0x05, 0x1b, 0xe6, 0x86, 0x58, 0xf4, 0x90, 0xa5, 0x0c, 0xec, 0xff,
+	0xa7, 0xbb, 0xd8, 0x7d, 0x85, 0xe6, 0x6a, 0x9c, 0x73, 0x59, 0xa5, 0xe7, 0x2f, 0x9d, 0x55, 0x7a,
+	0x15, 0xf3, 0x33, 0x59, 0xe1, 0x45, 0xf6, 0x0b, 0xfa, 0xdc, 0x0c, 0x28, 0x2a, 0xfa, 0xc8, 0x99,
+	0xac, 0x77, 0xa1, 0x97, 0x4e, 0x8f, 0xa3, 0x30, 0x1f, 0xa3, 0xd2, 0x22, 0xf6, 0x2d, 0xba, 0x61,
+	0xe4, 0x87, 0x06, 0x0a, 0x4d, 0x18, 0x33, 0x2a, 0x3f, 0x4e, 0x4a, 0x9a, 0x91, 0xb3, 0x90, 0x9c,
+	0xdb, 0x2f, 0xea, 0x93, 0x32, 0x60, 0xb0, 0x9c, 0x14, 0xce, 0x86, 0x43, 0x63, 0x9e, 0xd6, 0x41,
+	0x38, 0x09, 0x8b, 0xdc, 0xde, 0xd2, 0x87, 0xf6, 0x40, 0xa1, 0xb9, 0x1a, 0x27, 0xa6, 0x07, 0xf9,
+	0x8a, 0xee, 0xe0, 0x61, 0xf9, 0xff, 0x69, 0xc3, 0xe7, 0x2a, 0x6b, 0x8f, 0x24, 0x3e, 0xa5, 0x2a,
+	0x37, 0x7e, 0x56, 0x39, 0x2f, 0x73, 0xdb, 0xd1, 0x3f, 0xbb, 0xab, 0xd0, 0x5c, 0x8d, 0x13, 0x2d,
+	0xbb, 0x80, 0x8c, 0x32, 0x2f, 0x20, 0x01, 0x1e, 0x72, 0xf6, 0xe7, 0x14, 0xf5, 0xa6, 0x51, 0x50,
+	0xf5, 0xf8, 0x49, 0x8c, 0xc1, 0x90, 0x22, 0xb7, 0x5f, 0x5a, 0x9d, 0x35, 0x2d, 0x39, 0xad, 0xd7,
+	0x45, 0xec, 0xf9, 0x20, 0x19, 0xd9, 0x9f, 0xd7, 0x2d, 0xbd, 0xbe, 0x20, 0xb8, 0x25, 0x8f, 0xf5,
+	0x0e, 0x74, 0x53, 0xcc, 0xee, 0xbe, 0x97, 0x25, 0xd3, 0x34, 0xb7, 0x5f, 0xd6, 0x0f, 0xf2, 0x81,
+	0x24, 0x09, 0x43, 0x41, 0x61, 0xb6, 0xfa, 0xb0, 0x99, 0x13, 0x7f, 0x9a, 0x85, 0xc5, 0xec, 0x01,
+	0x77, 0x89, 0xbf, 0xa0, 0x1f, 0x43, 0x43, 0x9d, 0xec, 0x56, 0xf9, 0xad, 0x3b, 0xd0, 0xf6, 0xd2,
+	0x34, 0x4b, 0xd0, 0x0d, 0xba, 0xbd, 0x65, 0x68, 0x5b, 0x96, 0xe3, 0xae, 0xe4, 0x28, 0x9d, 0x80,
+	0x2f, 0xae, 0x70, 0x02, 0x6e, 0x42, 0x33, 0x20, 0xc7, 0xd3, 0x91, 0xbd, 0xad, 0x68, 0x75, 0x06,
+	0x61, 0xc6, 0x71, 0x12, 0xa2, 0x9a, 0xb1, 0x5f, 0xd1, 0x33, 0x8e, 0x87, 0x14, 0x75, 0x39, 0xb5,
+	0x6a, 0x57, 0xdf, 0x59, 0x66, 0x57, 0x57, 0x2d, 0xf5, 0x57, 0x97, 0x5a, 0xea, 0x4a, 0xa4, 0xfa,
+	0xb5, 0x45, 0x91, 0xea, 0x3e, 0x6c, 0x32, 0x01, 0xa5, 0xc6, 0xf1, 0x49, 0x92, 0x4d, 0xec, 0xd7,
+	0xf5, 0xb9, 0x7c, 0xa0, 0x93, 0xdd, 0x2a, 0xbf, 0xf5, 0x15, 0x80, 0xb3, 0x30, 0x0f, 0x8f, 0xc3,
+	0x08, 0xcd, 0xfc, 0x37, 0xe8, 0xee, 0x2b, 0xfd, 0x2a, 0x49, 0x11, 0xe7, 0x5c, 0xc9, 0x8b, 0xea,
+	0x16, 0x83, 0xf2, 0xc2, 0xd3, 0x7b, 0x53, 0x57, 0xb7, 0x83, 0x92, 0xe4, 0xaa, 0x7c, 0xb8, 0xe1,
+	0x85, 0x5e, 0xa3, 0xbb, 0xe8, 0x2e, 0x6d, 0x77, 0xb3, 0xaa, 0x03, 0x95, 0x6d, 0xa4, 0xf1, 0x63,
+	0x74, 0x3e, 0x09, 0x03, 0xdf, 0xfe, 0x92, 0x6e, 0x62, 0x7c, 0xb0, 0xbf, 0xb7, 0xcb, 0xf8, 0x77,
+	0xda, 0x8f, 0x3e, 0x7b, 0xb1, 0x81, 0xcf, 0x2e, 0xe5, 0x74, 0xfe, 0xc8, 0x80, 0xae, 0xd2, 0x1d,
+	0x5c, 0xa7, 0xbc, 0xc8, 0xc2, 0x74, 0x90, 0x91, 0x93, 0xf0, 0x42, 0xb3, 0x15, 0x55, 0x02, 0xce,
+	0x7e, 0xca, 0x6d, 0x39, 0xad, 0x40, 0x81, 0x83, 0x6c, 0xbd, 0xd3, 0xc8, 0xf3, 0xc9, 0x84, 0xc4,
+	0x85, 0x6e, 0x1a, 0x2b, 0x04, 0x9a, 0xda, 0x09, 0x02, 0xfe, 0x35, 0x2d, 0x6d, 0x23, 0x61, 0xe7,
+	0x53, 0xd8, 0xac, 0x2c, 0x95, 0xf5, 0x2a, 0xac, 0x71, 0xfd, 0x61, 0x1b, 0xfa, 0xdc, 0x32, 0x4e,
+	0xb4, 0x1a, 0x72, 0x57, 0xf0, 0x58, 0xaf, 0x43, 0x5b, 0xcc, 0x93, 0x5d, 0x5b, 0xce, 0x2f, 0x99,
+	0x9c, 0x9f, 0x1a, 0xd0, 0x55, 0x28, 0xd6, 0x33, 0x98, 0x39, 0x9a, 0x24, 0x67, 0x84, 0xc7, 0xfe,
+	0xf8, 0x13, 0xd6, 0x61, 0x64, 0x84, 0x5b, 0xbc, 0xab, 0xeb, 0x30, 0x18, 0x9b, 0xf5, 0x45, 0xa8,
+	0xe7, 0xa4, 0x78, 0x5c, 0xd5, 0x06, 0xf2, 0x58, 0x5f, 0x42, 0xef, 0x89, 0x9a, 0x4d, 0xc2, 0xa7,
+	0x5f, 0xca, 0x2f, 0x19, 0xf1, 0xfd, 0x5e, 0x10, 0xd8, 0xcd, 0xd5, 0xfc, 0xc8, 0xe3, 0xdc, 0x87,
+	0x16, 0xdb, 0xa4, 0x97, 0x0a, 0x5d, 0xd8, 0xd0, 0xc8, 0xaa, 0xc9, 0x0d, 0x8a, 0x38, 0x3f, 0x30,
+	0xa0, 0x2d, 0x54, 0x0b, 0xbe, 0x2a, 0x9f, 0x1e, 0x4f, 0x58, 0x8c, 0xc5, 0xd0, 0xd2, 0x70, 0x02,
+	0xa6, 0x32, 0xc6, 0x1f, 0x82, 0x7e, 0xa1, 0xe7, 0xf3, 0x15, 0x02, 0x8d, 0x7c, 0xd0, 0xf7, 0x92,
+	0xac, 0x12, 0xf9, 0xe0, 0x28, 0x35, 0x6d, 0xd9, 0xff, 0xf8, 0x22, 0x35, 0xc0, 0xac, 0xe0, 0xce,
+	0x37, 0x00, 0x4a, 0xb5, 0xab, 0x94, 0xce, 0x18, 0x97, 0x2b, 0x9d, 0xf9, 0x81, 0x01, 0x1d, 0xa9,
+	0xe9, 0xa9, 0x4f, 0x1b, 0xe6, 0xde, 0x71, 0x44, 0x98, 0x0f, 0x24, 0xa3, 0x6b, 0x02, 0x45, 0x8e,
+	0xdc, 0x9b, 0xa4, 0x11, 0x3a, 0xf9, 0x5a, 0xd4, 0x4b, 0xa0, 0xd6, 0xdb, 0xd0, 0x42, 0x29, 0xf6,
+	0x0a, 0x9e, 0xe2, 0x78, 0x76, 0xee, 0x40, 0xb9, 0x4f, 0xc9, 0xa2, 0x23, 0x8c, 0x19, 0x85, 0xf0,
+	0x24, 0x24, 0x51, 0xc0, 0xc4, 0xa1, 0xe3, 0xf2, 0x27, 0xe7, 0x5f, 0xea, 0xb0, 0x59, 0x39, 0x17,
+	0x2e, 0xd1, 0x4d, 0xcc, 0x8b, 0xe4, 0x45, 0x7e, 0xe8, 0x5d, 0xf4, 0x47, 0x84, 0x2f, 0x82, 0x74,
+	0xc8, 0x1e, 0x0c, 0x8f, 0x86, 0x8c, 0xe2, 0x2a, 0x5c, 0xd6, 0x10, 0x6e, 0xe0, 0xd3, 0x7e, 0xec,
+	0x47, 0xd3, 0x80, 0x0c, 0xa7, 0xc7, 0x7b, 0xd4, 0xd9, 0x12, 0x0e, 0xe8, 0x0b, 0xbc, 0xf9, 0x0d,
+	0x6c, 0x3e, 0xc7, 0xe4, 0x2e, 0x6e, 0x8b, 0xba, 0x12, 0x09, 0x83, 0x8c, 0x60, 0xc5, 0x10, 0x77,
+	0xe5, 0xaf, 0xf1, 0x57, 0x75, 0xf1, 0x55, 0x9c, 0xe4, 0xaa, 0x7c, 0xa8, 0x81, 0xe2, 0x64, 0x18,
+	0x87, 0x27, 0x27, 0x76, 0x53, 0x19, 0xa0, 0x00, 0xf1, 0x24, 0x39, 0xc1, 0xa0, 0x84, 0x30, 0xf3,
+	0xd5, 0x9c, 0xae, 0x46, 0xb1, 0xde, 0x81, 0x1b, 0xdc, 0xa6, 0x10, 0xb3, 0xc8, 0x4d, 0x42, 0x35,
+	0xcf, 0xbb, 0x98, 0xc5, 0xba, 0x83, 0x76, 0xeb, 0x09, 0xc9, 0x32, 0x92, 0xf1, 0x46, 0x6d, 0xa5,
+	0x51, 0x85, 0xc6, 0x4a, 0x27, 0x30, 0x4f, 0xa1, 0x25, 0x22, 0x39, 0x66, 0xbd, 0xc4, 0x4a, 0x7f,
+	0xce, 0x88, 0x38, 0xfb, 0x99, 0x1b, 0xa7, 0x83, 0xce, 0x7d, 0xe8, 0xa9, 0xf6, 0x90, 0x75, 0x13,
+	0xda, 0x68, 0xad, 0x4c, 0x27, 0x84, 0x49, 0x74, 0xc7, 0x95, 0xcf, 0x48, 0x4b, 0xb3, 0x24, 0x98,
+	0xfa, 0x24, 0xe7, 0x69, 0x09, 0xf9, 0xec, 0xfc, 0xd0, 0x80, 0xab, 0x73, 0x66, 0x19, 0x0f, 0xd9,
+	0xee, 0xcc, 0x0a, 0x92, 0x6b, 0x49, 0x4f, 0x89, 0xe2, 0x88, 0xf1, 0xff, 0xe9, 0xc9, 0x09, 0xc9,
+	0x18, 0x9f, 0xba, 0x81, 0x2b, 0x34, 0xba, 0xd7, 0xd3, 0x30, 0x8a, 0x8e, 0x92, 0xbd, 0x30, 0x3f,
+	0xd5, 0x02, 0x15, 0x2a, 0x01, 0x57, 0x6b, 0xe2, 0x5d, 0x0c, 0xbc, 0xac, 0x60, 0xef, 0xd4, 0xea,
+	0x65, 0x54, 0x8a, 0x73, 0x06, 0xd6, 0xfc, 0x39, 0x78, 0x89, 0x7e, 0xa3, 0x5b, 0x95, 0x4d, 0x63,
+	0x5f, 0x28, 0x31, 0xb9, 0x23, 0x04, 0xaa, 0x24, 0xf8, 0xeb, 0x0b, 0x12, 0xfc, 0xf7, 0x01, 0xca,
+	0x73, 0x94, 0xea, 0xa6, 0x69, 0x10, 0x12, 0x2c, 0x85, 0x33, 0x34, 0xdd, 0xc4, 0x51, 0xdc, 0xad,
+	0xb9, 0x9f, 0xa4, 0x72, 0xe6, 0xf9, 0x93, 0xf3, 0xef, 0x06, 0xf4, 0x54, 0x3b, 0x1a, 0x73, 0xb5,
+	0x65, 0xd5, 0x85, 0x58, 0x7a, 0x35, 0xa0, 0x3e, 0x4f, 0x46, 0x91, 0xad, 0x82, 0xe5, 0x5a, 0x88,
+	0x76, 0x8b, 0x59, 0x30, 0xa3, 0x4c, 0x09, 0x6c, 0x0e, 0xc5, 0x07, 0xd5, 0xd4, 0xc7, 0x02, 0xba,
+	0xf5, 0x75, 0x78, 0x66, 0x0e, 0x2d, 0x97, 0x4a, 0xb4, 0x5c, 0xc2, 0xe3, 0x8c, 0x60, 0x43, 0x77,
+	0x39, 0x94, 0xc9, 0x36, 0xe6, 0x27, 0x5b, 0xa9, 0x31, 0xaa, 0x2d, 0xa8, 0x31, 0x7a, 0x0e, 0xea,
+	0x61, 0xca, 0xc2, 0x85, 0x1d, 0x56, 0x62, 0xb7, 0x3f, 0xc8, 0x5d, 0xc4, 0x9c, 0xdf, 0x37, 0x60,
+	0x5d, 0x73, 0xa6, 0xf0, 0x44, 0xe2, 0x4e, 0x51, 0x45, 0x15, 0x96, 0x30, 0x4a, 0xa9, 0x88, 0x85,
+	0x56, 0xf3, 0x33, 0x2a, 0xc1, 0x7a, 0x06, 0xea, 0x41, 0xe2, 0x6b, 0xe2, 0x81, 0x00, 0xb6, 0x3f,
+	0x25, 0x33, 0x57, 0x64, 0x5d, 0xb4, 0x68, 0xa4, 0x42, 0x70, 0x7e, 0xc7, 0x80, 0x9e, 0xea, 0x58,
+	0x62, 0xe8, 0x1e, 0xed, 0xd5, 0x4f, 0xc2, 0x38, 0x48, 0xce, 0xc5, 0x89, 0x24, 0xed, 0xb6, 0x23,
+	0x49, 0x72, 0x55, 0x36, 0xb4, 0x7e, 0xbc, 0x38, 0x99, 0x78, 0xd1, 0xac, 0x6a, 0xcd, 0xf4, 0x19,
+	0x8c, 0x46, 0x8b, 0x2b, 0x78, 0x30, 0x3b, 0x89, 0xa7, 0x65, 0x16, 0x8a, 0x44, 0x4b, 0xc7, 0x2d,
+	0x01, 0xe7, 0xd7, 0x01, 0xca, 0xef, 0xa0, 0xc6, 0x38, 0x27, 0xe4, 0x34, 0xf0, 0x78, 0x8c, 0xb7,
+	0xe9, 0xca, 0x67, 0xf4, 0x03, 0xf2, 0xc2, 0xcb, 0xf4, 0x35, 0x61, 0x10, 0xce, 0x0c, 0x89, 0x03,
+	0x7d, 0x66, 0x48, 0x4c, 0x0f, 0xc3, 0x28, 0xe1, 0x41, 0x07, 0xd5, 0xbc, 0x93, 0xa8, 0xf3, 0x87,
+	0x06, 0x74, 0x95, 0x6e, 0xd3, 0x9d, 0x3c, 0x8d, 0x8a, 0x30, 0x8d, 0x88, 0x9e, 0x56, 0x12, 0x28,
+	0xf3, 0x39, 0xe2, 0xb2, 0x6c, 0x6f, 0x83, 0x9f, 0x15, 0xad, 0x43, 0x8a, 0xba, 0x9c, 0x8a, 0x3a,
+	0xe5, 0x38, 0x4a, 0xfc, 0x53, 0x91, 0x80, 0x56, 0x13, 0xd5, 0x1a, 0x45, 0x11, 0xc6, 0xc6, 0x82,
+	0x9d, 0xff, 0x7b, 0x06, 0x6c, 0xe8, 0x51, 0x04, 0xae, 0x6e, 0xf6, 0x48, 0x5a, 0x8c, 0x2b, 0x9d,
+	0xe4, 0x28, 0xe6, 0x92, 0x26, 0xde, 0xc5, 0x6e, 0x32, 0x49, 0x23, 0x72, 0x81, 0xee, 0x83, 0xba,
+	0x33, 0x75, 0x12, 0xba, 0xa6, 0x19, 0xc9, 0x93, 0xe8, 0x8c, 0x6d, 0xc4, 0xba, 0x96, 0x17, 0x60,
+	0x1f, 0x76, 0x39, 0xdd, 0x2d, 0x39, 0x9d, 0xff, 0xaa, 0xc1, 0x66, 0x85, 0x6c, 0x7d, 0x1d, 0x3a,
+	0x49, 0x4a, 0x32, 0x36, 0xe1, 0x95, 0xfa, 0x2b, 0x39, 0x06, 0x4e, 0x17, 0xfb, 0x40, 0x36, 0xc0,
+	0x15, 0xa6, 0x36, 0x85, 0xbe, 0xc2, 0x14, 0x42, 0x47, 0xb8, 0x34, 0x12, 0xeb, 0xd4, 0x48, 0xbc,
+	0xca, 0x27, 0xbe, 0xb3, 0x2b, 0x08, 0xaa, 0xc5, 0xb8, 0x3a, 0x7a, 0xfb, 0x02, 0xd4, 0xa7, 0x59,
+	0xc4, 0x43, 0xb7, 0x5d, 0xfe, 0xa2, 0x3a, 0xe6, 0xc0, 0x10, 0xaf, 0x84, 0xa4, 0x5b, 0x8b, 0x43,
+	0xd2, 0xc8, 0xe5, 0x97, 0x33, 0xac, 0x56, 0x08, 0x29, 0xf8, 0x9c, 0x4f, 0xd9, 0xbe, 0x6c, 0xf6,
+	0xa7, 0xb3, 0xc4, 0x4b, 0x75, 0x0e, 0x60, 0x43, 0x68, 0x39, 0x1e, 0x7f, 0xb2, 0x95, 0xa4, 0xbe,
+	0x9e, 0x24, 0x78, 0xac, 0x39, 0xe8, 0xf8, 0xb0, 0xce, 0xd5, 0x34, 0x7f, 0xd9, 0x4d, 0x68, 0x7e,
+	0x4a, 0xd3, 0x1a, 0xea, 0xdb, 0x18, 0xa4, 0x88, 0x6a, 0x6d, 0x81, 0xde, 0x14, 0xdd, 0xa8, 0x57,
+	0xbb, 0xe1, 0xfc, 0x19, 0x5a, 0xe9, 0x3c, 0x66, 0x57, 0x09, 0xc6, 0x1b, 0x4f, 0x18, 0x8c, 0xaf,
+	0xad, 0x0c, 0xc6, 0xd7, 0x17, 0x04, 0xe3, 0xb5, 0xb0, 0x6f, 0xe3, 0xb2, 0x61, 0x5f, 0xe7, 0x6f,
+	0x0c, 0xe8, 0x2a, 0xa1, 0x49, 0x16, 0xec, 0x61, 0x8f, 0xd4, 0xe0, 0xd7, 0xaa, 0xb2, 0x54, 0x0a,
+	0x9d, 0xf4, 0x69, 0x9c, 0x93, 0xa2, 0xe2, 0x5f, 0x48, 0x14, 0x67, 0x2a, 0x0a, 0xe3, 0x53, 0x7d,
+	0xa6, 0x10, 0x41, 0xc3, 0xf2, 0xdc, 0xcb, 0x62, 0x5c, 0x2f, 0x55, 0x70, 0x05, 0x88, 0xe7, 0x27,
+	0x37, 0xa2, 0xfb, 0x27, 0x05, 0xc9, 0x86, 0xf4, 0x8d, 0x9a, 0x0d, 0xba, 0x80, 0xee, 0xfc, 0x96,
+	0x01, 0x1d, 0x99, 0xd0, 0x7a, 0xda, 0xd4, 0xfe, 0xe7, 0xa0, 0xee, 0x4f, 0x52, 0x5e, 0xd3, 0xd0,
+	0x95, 0xc1, 0x9a, 0xc3, 0x81, 0x50, 0xb9, 0xfe, 0x24, 0xc5, 0xa5, 0x20, 0x17, 0x29, 0xf1, 0x75,
+	0xaf, 0x9b, 0x63, 0xce, 0x7f, 0xd4, 0x60, 0xcd, 0x4d, 0xa6, 0x05, 0x8e, 0x64, 0x55, 0x26, 0x47,
+	0xf3, 0x09, 0x6b, 0x8b, 0x7d, 0xc2, 0xa7, 0xce, 0xde, 0x7d, 0x55, 0x29, 0x5d, 0x6d, 0xe8, 0x2e,
+	0x10, 0xef, 0xdb, 0xaa, 0xe2, 0x55, 0xb5, 0x28, 0xb5, 0xb9, 0xa4, 0x28, 0xf5, 0x09, 0xf3, 0x3f,
+	0x2f, 0x40, 0xdd, 0x4b, 0x43, 0xaa, 0x41, 0x1a, 0xa5, 0x36, 0xea, 0x0f, 0xf6, 0x5d, 0xc4, 0x65,
+	0x5a, 0xab, 0x3d, 0x97, 0xd6, 0x12, 0x79, 0x87, 0xce, 0xca, 0xbc, 0x83, 0xf3, 0x6b, 0x60, 0x7e,
+	0xb2, 0x20, 0x8b, 0x90, 0x64, 0xe1, 0x28, 0x8c, 0x75, 0x0b, 0x88, 0x61, 0xfc, 0x84, 0xc1, 0x72,
+	0x79, 0xdd, 0xc0, 0x96, 0x28, 0x4d, 0x81, 0x06, 0x91, 0xd4, 0x6a, 0x5a, 0x19, 0x96, 0x42, 0x70,
+	0xbe, 0x09, 0xad, 0xe1, 0x2c, 0x2f, 0xc8, 0xc4, 0x7a, 0x1d, 0xab, 0x2d, 0xa6, 0xf1, 0x5c, 0xcc,
+	0x64, 0x17, 0xc1, 0x43, 0x52, 0x64, 0xa1, 0x2f, 0x94, 0x0d, 0xe5, 0x63, 0xa5, 0x24, 0x18, 0xd6,
+	0xe2, 0x46, 0x51, 0xbd, 0x2c, 0x25, 0x61, 0xa8, 0xf3, 0xdb, 0x06, 0x74, 0x95, 0xe6, 0xb4, 0xd6,
+	0x92, 0xc9, 0x87, 0xb6, 0x3b, 0x05, 0xa8, 0x78, 0x40, 0xea, 0xfb, 0x38, 0x26, 0x96, 0x81, 0x0d,
+	0x65, 0x7e, 0x19, 0x6e, 0x49, 0xd1, 0xd5, 0x8b, 0x53, 0x39, 0xe8, 0xfc, 0x67, 0x5d, 0x54, 0xb8,
+	0x3d, 0xa0, 0xd5, 0xa1, 0x5a, 0xb5, 0x98, 0xb1, 0xa8, 0x5a, 0x6c, 0x45, 0x25, 0xe2, 0x4d, 0x68,
+	0xd2, 0xd0, 0xac, 0xb6, 0x8b, 0x18, 0x64, 0xdd, 0x95, 0xc2, 0xd5, 0xd0, 0x43, 0xf2, 0xec, 0xbb,
+	0x0b, 0x45, 0xec, 0x65, 0xe8, 0x46, 0x5e, 0x5e, 0xd0, 0x02, 0xc3, 0x7e, 0xa5, 0xce, 0x5f, 0x21,
+	0xb0, 0x22, 0x65, 0x2f, 0x4f, 0x62, 0xed, 0xd4, 0xe3, 0x18, 0xb5, 0xc1, 0xfc, 0x24, 0x23, 0xda,
+	0x61, 0xc7, 0x20, 0x74, 0xa4, 0x31, 0x3d, 0x14, 0xfb, 0xb3, 0x7b, 0x9f, 0x1c, 0xf6, 0xf9, 0x31,
+	0x27, 0x1d, 0xe9, 0x83, 0x92, 0xe4, 0xaa, 0x7c, 0xd6, 0x2f, 0x40, 0x9b, 0xc7, 0x4c, 0xe7, 0x92,
+	0x8c, 0x83, 0xb1, 0x27, 0x2b, 0x5d, 0xa5, 0xbb, 0xc4, 0x79, 0x71, 0x12, 0xd2, 0x31, 0x4d, 0x0d,
+	0xc1, 0x82, 0x56, 0xfc, 0x73, 0xa2, 0xfb, 0x8c, 0x13, 0x07, 0xc7, 0x2b, 0x1a, 0xbb, 0x6a, 0x95,
+	0x19, 0xc3, 0xac, 0xb7, 0x61, 0x8d, 0xe7, 0xc2, 0xec, 0x9e, 0x5e, 0xd0, 0xce, 0x53, 0x66, 0xda,
+	0xc4, 0x0a, 0x5e, 0xf4, 0x88, 0xd5, 0x8e, 0xd2, 0x95, 0xc3, 0x67, 0xfd, 0xf8, 0xa4, 0x10, 0xd2,
+	0xd8, 0x16, 0x50, 0xc5, 0x8f, 0x41, 0x8e, 0x07, 0x3d, 0xb5, 0xeb, 0x2b, 0xdf, 0x53, 0x99, 0xeb,
+	0xda, 0xe5, 0xe6, 0xda, 0xf9, 0x07, 0x03, 0xae, 0xde, 0x8f, 0x08, 0x29, 0xfe, 0xd7, 0xc4, 0xb4,
+	0x14, 0xc5, 0xfa, 0xa5, 0x45, 0xf1, 0x2d, 0xcc, 0x0b, 0x25, 0x17, 0x21, 0x11, 0x81, 0xc5, 0x4a,
+	0x61, 0x29, 0x6b, 0x2a, 0x43, 0xba, 0x8c, 0xb5, 0x14, 0xbd, 0xe6, 0x9c, 0xe8, 0x39, 0xff, 0x66,
+	0x80, 0xc9, 0x5a, 0xd1, 0x18, 0x2d, 0x3b, 0xe4, 0x7e, 0x5e, 0xbb, 0xef, 0x36, 0xaf, 0x5b, 0x6d,
+	0xac, 0x50, 0xec, 0x94, 0xc3, 0x7a, 0x09, 0x6a, 0x45, 0x62, 0x37, 0x57, 0xf0, 0xd5, 0x8a, 0xe4,
+	0x31, 0x3b, 0xee, 0x3a, 0xd4, 0x3c, 0xbd, 0x12, 0xac, 0xe6, 0x15, 0xce, 0x5f, 0x63, 0x31, 0x2d,
+	0x2b, 0xac, 0xbd, 0x77, 0xc6, 0x03, 0xd9, 0x3f, 0x7b, 0xf1, 0xea, 0xca, 0x61, 0x6f, 0xd1, 0x60,
+	0xce, 0x24, 0x29, 0x2a, 0x1e, 0xa6, 0x44, 0x71, 0x20, 0x1e, 0xbb, 0x5c, 0xa6, 0x2e, 0x11, 0xc7,
+	0xf8, 0x40, 0x5a, 0x95, 0x81, 0xfc, 0xab, 0x01, 0x57, 0x77, 0x93, 0xf8, 0x24, 0x1c, 0x0d, 0xb2,
+	0x24, 0xf5, 0x46, 0xd2, 0x11, 0x60, 0xfd, 0x30, 0x16, 0xf6, 0x63, 0xf5, 0xa1, 0x40, 0x2d, 0x28,
+	0x34, 0xab, 0x2b, 0xc5, 0xc1, 0x02, 0xa4, 0x41, 0xff, 0x34, 0x8d, 0xc2, 0xb9, 0xa8, 0x6d, 0x09,
+	0xe3, 0x3b, 0xf8, 0xc6, 0xd1, 0x54, 0xa5, 0x00, 0xab, 0x1b, 0xb0, 0x75, 0xc9, 0x0d, 0xf8, 0xdd,
+	0x1a, 0x74, 0xf0, 0xb8, 0x20, 0x47, 0x24, 0x2f, 0x56, 0x0e, 0x73, 0xb5, 0xbd, 0x2b, 0x2e, 0x2e,
+	0xd5, 0x17, 0x5e, 0x5c, 0xf2, 0xf8, 0x15, 0x47, 0xfd, 0x86, 0xc6, 0x9b, 0x8f, 0x2f, 0x74, 0x15,
+	0xa3, 0xe4, 0x7c, 0xd2, 0x9e, 0x6f, 0xcd, 0xb9, 0x15, 0x77, 0xa0, 0xed, 0x47, 0x21, 0x89, 0x8b,
+	0xfd, 0x01, 0x8f, 0x53, 0x9a, 0x7c, 0xf0, 0xed, 0x5d, 0x8e, 0xbb, 0x92, 0x43, 0xd6, 0x6a, 0xc6,
+	0x5e, 0xa4, 0x95, 0x9a, 0x4b, 0xd4, 0xf9, 0xe3, 0x1a, 0x6c, 0xca, 0x89, 0xe1, 0x95, 0xca, 0xab,
+	0xa6, 0x67, 0x79, 0x45, 0x70, 0xb9, 0x9d, 0xea, 0x0b, 0xb6, 0x13, 0x3f, 0xe2, 0x1b, 0x4b, 0x2c,
+	0xad, 0x2f, 0xc2, 0x9a, 0x97, 0x86, 0xb4, 0xd8, 0x91, 0xb9, 0x86, 0x9b, 0x9c, 0x65, 0xad, 0x3f,
+	0xd8, 0x47, 0xd8, 0x15, 0xf4, 0x4a, 0xc5, 0x49, 0x6b, 0x49, 0xc5, 0xc9, 0x9b, 0xa2, 0x7e, 0x86,
+	0xd5, 0xe2, 0xdf, 0x50, 0xed, 0x4c, 0x3a, 0x56, 0x2c, 0xa0, 0x11, 0x43, 0xa3, 0x9c, 0x78, 0x93,
+	0xe4, 0x84, 0x16, 0xc5, 0xe4, 0xe2, 0x26, 0x09, 0x7f, 0xc4, 0x49, 0x5a, 0xd7, 0x1a, 0xea, 0x5e,
+	0xb1, 0x71, 0x09, 0xaf, 0x18, 0x6b, 0xc6, 0xd8, 0xc3, 0xc3, 0x6a, 0xa1, 0x94, 0x4a, 0xc0, 0xf5,
+	0x95, 0xba, 0x82, 0x79, 0xdb, 0x72, 0x7d, 0x87, 0x1c, 0x57, 0xf4, 0xc6, 0x4b, 0x00, 0xec, 0xff,
+	0x3e, 0xaa, 0x53, 0x55, 0xf4, 0x14, 0x1c, 0xf7, 0x54, 0xc6, 0xed, 0xa7, 0xa6, 0xa2, 0x7e, 0x04,
+	0x48, 0xdd, 0x5f, 0xf6, 0x2f, 0xed, 0x9b, 0x2a, 0x74, 0x2a, 0x01, 0x57, 0xd8, 0x4f, 0xd2, 0xd9,
+	0x51, 0xa2, 0xdf, 0x84, 0x62, 0x98, 0x13, 0x43, 0xfb, 0x90, 0x14, 0xde, 0x1e, 0x06, 0xe1, 0xd5,
+	0x2b, 0x06, 0x75, 0x4d, 0x35, 0x5f, 0xa7, 0xaa, 0x59, 0xd5, 0x1f, 0xa8, 0x8a, 0xef, 0xe2, 0x55,
+	0x1d, 0x2f, 0x1e, 0xc9, 0xda, 0x64, 0x19, 0x0b, 0xc3, 0x57, 0xee, 0x52, 0x52, 0x79, 0x7d, 0x87,
+	0x32, 0x3a, 0x7f, 0x69, 0x00, 0x94, 0x54, 0xfc, 0xe4, 0x69, 0x18, 0x07, 0xba, 0x27, 0x8e, 0x08,
+	0x77, 0x77, 0x6a, 0x2b, 0x0b, 0xd7, 0xea, 0x0b, 0x4a, 0xc9, 0xd9, 0x8d, 0xa7, 0x86, 0x9e, 0xfe,
+	0x65, 0x5f, 0x9b, 0xbb, 0xed, 0xf4, 0xa6, 0xcc, 0xd1, 0xb0, 0x2d, 0x2e, 0x6d, 0xec, 0xfb, 0x88,
+	0x6a, 0x03, 0x10, 0xe9, 0x9b, 0x4f, 0xa0, 0xab, 0x10, 0x57, 0xdf, 0xf0, 0xa2, 0x93, 0xa9, 0x9d,
+	0x96, 0xca, 0x64, 0xaa, 0x7d, 0xaf, 0x15, 0x89, 0xf3, 0x07, 0x75, 0xe8, 0xb0, 0x97, 0xe6, 0xa4,
+	0x78, 0xca, 0xb2, 0xbd, 0x4a, 0x64, 0xb4, 0xbe, 0x2c, 0x32, 0xba, 0x05, 0x6d, 0x16, 0x46, 0x4a,
+	0x74, 0xf1, 0x93, 0x28, 0x16, 0x9d, 0xe7, 0x85, 0x57, 0xcc, 0x5d, 0x1d, 0x93, 0x3d, 0x54, 0xeb,
+	0x58, 0x18, 0x2b, 0x3d, 0x54, 0x33, 0xc2, 0xbd, 0x7d, 0xf5, 0xe4, 0x2a, 0x61, 0x56, 0x84, 0x39,
+	0x91, 0xd9, 0x44, 0xf5, 0xa0, 0x56, 0x09, 0x18, 0x3c, 0xc8, 0x92, 0x28, 0x22, 0xc1, 0x8e, 0x47,
+	0x0d, 0x70, 0x2d, 0x0a, 0xa4, 0x52, 0xb0, 0xfc, 0x1c, 0x9f, 0x8f, 0x3d, 0xff, 0xd4, 0x15, 0x07,
+	0x9d, 0x1a, 0x0a, 0x9a, 0xa3, 0xa2, 0x41, 0x95, 0x11, 0x3f, 0xc9, 0x82, 0x39, 0x5b, 0x98, 0x8d,
+	0xce, 0xa5, 0x44, 0xb9, 0xdd, 0x18, 0xab, 0xf3, 0x8f, 0x06, 0xf4, 0x54, 0x7a, 0x75, 0xb2, 0x8d,
+	0xcb, 0x4c, 0x76, 0x6d, 0xe1, 0x64, 0x97, 0x87, 0x57, 0x7d, 0xf1, 0xe1, 0xb5, 0xe4, 0x88, 0x12,
+	0x22, 0xd6, 0x5c, 0xb2, 0x5f, 0x5b, 0x95, 0xfd, 0xba, 0xd8, 0x38, 0x4a, 0xa9, 0x49, 0x91, 0x87,
+	0x39, 0x3d, 0x77, 0x5d, 0x42, 0xaf, 0xed, 0xe2, 0x5a, 0xd2, 0x0b, 0x77, 0xd5, 0xc8, 0x4d, 0x09,
+	0xe3, 0x95, 0xd6, 0x93, 0x30, 0xc6, 0x32, 0x66, 0x51, 0x01, 0x7b, 0x43, 0x09, 0x27, 0x9c, 0x84,
+	0xa3, 0xfb, 0x8c, 0x2a, 0xc6, 0x2b, 0x98, 0x9d, 0xbf, 0x37, 0x60, 0x5d, 0xe3, 0xb0, 0x5e, 0xd5,
+	0xee, 0x5f, 0x2a, 0xdb, 0x90, 0x92, 0xe7, 0xf6, 0xad, 0xd0, 0x1a, 0xb5, 0x25, 0x5a, 0xa3, 0xbe,
+	0x72, 0xdf, 0x34, 0xe6, 0xf6, 0x0d, 0x5e, 0x83, 0x26, 0x79, 0xee, 0x8d, 0x88, 0x56, 0x9d, 0x2a,
+	0x40, 0xaa, 0xb0, 0xa7, 0xa3, 0x11, 0xc9, 0xe9, 0x4a, 0x6b, 0xf1, 0xcd, 0x12, 0x77, 0xbe, 0x53,
+	0x87, 0x75, 0x9a, 0xba, 0xfe, 0x80, 0x87, 0xeb, 0x9f, 0x72, 0x17, 0xaf, 0x32, 0x2b, 0xcb, 0x7c,
+	0x78, 0xe3, 0x52, 0xf9, 0x70, 0xeb, 0x4d, 0xe8, 0x92, 0x98, 0xe6, 0x90, 0xfb, 0x83, 0x7d, 0xa6,
+	0xe7, 0x1a, 0x3b, 0x9b, 0x68, 0x75, 0xdd, 0x2b, 0x61, 0x57, 0xe5, 0xb1, 0xde, 0x82, 0x9e, 0xc8,
+	0x3b, 0xd3, 0x36, 0x2d, 0xda, 0xc6, 0xa4, 0x15, 0xe9, 0x0a, 0xee, 0x6a, 0x5c, 0xd6, 0x3b, 0x00,
+	0x99, 0x57, 0x10, 0x5e, 0x8a, 0xb6, 0xa6, 0x6f, 0x2c, 0xb4, 0x18, 0x04, 0x51, 0xcc, 0x5c, 0xc9,
+	0xcd, 0xf2, 0x0e, 0xa3, 0x03, 0x72, 0x46, 0x22, 0x2d, 0x6a, 0x23, 0x51, 0x4c, 0xbb, 0xc9, 0xa2,
+	0xad, 0xa1, 0x08, 0xd0, 0xaa, 0x3f, 0xca, 0x30, 0x4f, 0x76, 0xfe, 0xbb, 0x06, 0xf0, 0x7e, 0x18,
+	0x45, 0xc3, 0xf3, 0xb0, 0xf0, 0xc7, 0xb8, 0xcb, 0x46, 0x51, 0x72, 0xcc, 0xef, 0xbf, 0xc8, 0xdb,
+	0x24, 0x0c, 0xb3, 0x9e, 0x87, 0x86, 0x97, 0x86, 0x4c, 0x90, 0x1b, 0xac, 0xf0, 0x86, 0x0e, 0x92,
+	0xa2, 0x38, 0x8b, 0x5e, 0x14, 0x25, 0xe7, 0x7c, 0x46, 0xea, 0xe5, 0x2c, 0xf6, 0x4b, 0xd8, 0x55,
+	0x79, 0xac, 0xd7, 0x00, 0xf8, 0xe3, 0xfe, 0x80, 0xd7, 0x00, 0xec, 0x6c, 0x60, 0xc4, 0xb6, 0x2f,
+	0x51, 0x57, 0xe1, 0x90, 0x26, 0x5a, 0xf3, 0x71, 0x97, 0xb6, 0x5a, 0xcb, 0x2e, 0x6d, 0x29, 0x16,
+	0xeb, 0xda, 0x13, 0x5a, 0xac, 0xed, 0x39, 0x8b, 0xb5, 0xb4, 0x0b, 0x3b, 0x0b, 0xec, 0x42, 0x07,
+	0x3a, 0xd3, 0x34, 0xe0, 0xaa, 0x5e, 0xbd, 0x9f, 0x51, 0xc2, 0xce, 0x9f, 0xd6, 0xa0, 0xbd, 0xcb,
+	0x72, 0xdb, 0xd9, 0xd3, 0xef, 0x84, 0x4f, 0xa7, 0x49, 0xe1, 0x69, 0x8e, 0x09, 0x83, 0xd0, 0xaf,
+	0xa4, 0x77, 0x1b, 0xd8, 0x3e, 0xd8, 0x50, 0x24, 0xed, 0x7d, 0x32, 0xd3, 0x2e, 0x36, 0xa0, 0x83,
+	0x43, 0x8e, 0xc7, 0x49, 0x72, 0xaa, 0xef, 0x6e, 0x0e, 0x62, 0x3d, 0x63, 0x46, 0x72, 0x0c, 0x88,
+	0x15, 0x5c, 0xde, 0x51, 0x3c, 0xe4, 0x2d, 0x0c, 0x57, 0xa1, 0xb9, 0x1a, 0x67, 0x55, 0x2c, 0xd6,
+	0x2e, 0x21, 0x16, 0x37, 0xa1, 0x99, 0x9c, 0xc7, 0x24, 0xd3, 0xa6, 0x9c, 0x41, 0xce, 0x9f, 0x18,
+	0xd0, 0x62, 0xfd, 0x57, 0xe6, 0xab, 0xb3, 0x68, 0xbe, 0xc6, 0x5e, 0x3e, 0xd6, 0xe7, 0x0b, 0x11,
+	0xfd, 0x04, 0xae, 0x2f, 0x3e, 0x81, 0xb7, 0xa0, 0x4d, 0x2e, 0xd2, 0x30, 0x23, 0x15, 0x6f, 0x4e,
+	0xa2, 0xa8, 0xed, 0xe2, 0xa4, 0x08, 0x4f, 0x98, 0xc7, 0xa7, 0x1e, 0x2e, 0x0a, 0xee, 0xfc, 0x15,
+	0x53, 0xe2, 0x74, 0x79, 0x3f, 0xa2, 0x5a, 0x72, 0x4b, 0xd6, 0x36, 0x64, 0x7a, 0x04, 0x41, 0xa0,
+	0x34, 0x23, 0xeb, 0xe9, 0x77, 0x33, 0x10, 0x10, 0x97, 0xe0, 0xe8, 0x0f, 0x1c, 0xd4, 0x75, 0x27,
+	0x95, 0xa1, 0x8f, 0x73, 0x44, 0x6e, 0x42, 0x93, 0xa4, 0x89, 0x3f, 0xd6, 0x7a, 0xcb, 0xa0, 0x52,
+	0x9d, 0xb6, 0xe6, 0xd4, 0x29, 0xde, 0xe1, 0xdb, 0xe0, 0xde, 0x27, 0x5e, 0x2e, 0x9e, 0x78, 0xa9,
+	0xf8, 0x92, 0xa1, 0xa7, 0xba, 0xe4, 0x97, 0xd4, 0x7b, 0x74, 0x9a, 0x3f, 0x2d, 0x50, 0x74, 0x48,
+	0x8e, 0xa7, 0x18, 0x3a, 0x66, 0x7a, 0xc2, 0x70, 0xc5, 0x23, 0x1a, 0xa7, 0x59, 0x72, 0x2e, 0x44,
+	0x56, 0xbb, 0xd6, 0x3c, 0xf1, 0x52, 0x37, 0x39, 0x17, 0x8b, 0x89, 0x5c, 0xce, 0xbb, 0x00, 0x25,
+	0x05, 0x17, 0x7d, 0xee, 0x77, 0x57, 0x28, 0x82, 0xa5, 0x0b, 0x34, 0x22, 0xc6, 0x75, 0x97, 0xcb,
+	0x9f, 0x9c, 0x7f, 0x42, 0xe7, 0x59, 0xe8, 0xd8, 0xa7, 0xdc, 0x80, 0x4a, 0x88, 0x77, 0xd1, 0xb4,
+	0xdf, 0x81, 0xfa, 0x29, 0x99, 0x55, 0xc3, 0xaa, 0xf2, 0xa3, 0xe5, 0x46, 0x44, 0x36, 0x25, 0x19,
+	0xd6, 0x5c, 0x9c, 0x0c, 0xa3, 0x25, 0x6b, 0xaa, 0xd1, 0x42, 0x11, 0x6c, 0x97, 0xb2, 0xbb, 0xf7,
+	0xaa, 0xe9, 0xc2, 0x31, 0x5c, 0xde, 0xe3, 0x69, 0x96, 0xeb, 0x26, 0x22, 0x83, 0xac, 0x77, 0x68,
+	0x10, 0xe6, 0x24, 0x8c, 0xe4, 0x8d, 0x0c, 0x7b, 0xae, 0x93, 0x03, 0xc6, 0xa0, 0x84, 0x67, 0x28,
+	0xbf, 0x3c, 0x10, 0x60, 0xd1, 0x81, 0x80, 0x3f, 0xf0, 0x61, 0x56, 0x5f, 0x61, 0xbd, 0x01, 0xad,
+	0x73, 0x9a, 0x98, 0xe7, 0x21, 0xfb, 0x05, 0xa5, 0x01, 0x32, 0x86, 0x4a, 0x9f, 0xb4, 0x3a, 0xbd,
+	0x65, 0x83, 0xae, 0xaf, 0x1a, 0x74, 0x63, 0x6e, 0xd0, 0xce, 0x37, 0x61, 0x93, 0x5e, 0xbe, 0x2f,
+	0x6f, 0x8f, 0x3d, 0xe5, 0xe2, 0x5b, 0xd0, 0x08, 0x3c, 0xae, 0x7c, 0x7b, 0x2e, 0xfd, 0xdf, 0x79,
+	0x1f, 0x7a, 0xea, 0x59, 0xae, 0xee, 0x96, 0x45, 0x02, 0xb2, 0xf2, 0x37, 0x7b, 0x9c, 0xdf, 0x68,
+	0x42, 0xb7, 0x3f, 0xd8, 0x97, 0xb7, 0x52, 0x9e, 0xae, 0x9b, 0x0b, 0x6e, 0x03, 0xd5, 0x7f, 0x5e,
+	0xb7, 0x81, 0x1a, 0x4f, 0x74, 0x1b, 0x48, 0xde, 0xf0, 0x69, 0x2e, 0xbf, 0xe1, 0xd3, 0x5a, 0x72,
+	0xc3, 0xe7, 0x92, 0x3f, 0x4a, 0x50, 0x4e, 0x70, 0xfb, 0x52, 0x97, 0x5b, 0x3a, 0x4f, 0x74, 0xb9,
+	0x65, 0xee, 0x1a, 0x27, 0xfc, 0x0c, 0xd7, 0x38, 0xbb, 0x97, 0x4d, 0xe4, 0xf7, 0x96, 0x95, 0x9b,
+	0xeb, 0x37, 0x69, 0xd6, 0x2f, 0x73, 0x93, 0x46, 0xa9, 0x3b, 0xdf, 0x58, 0x50, 0x77, 0xbe, 0xfd,
+	0x05, 0x68, 0xb1, 0x08, 0xb3, 0xd5, 0x86, 0xc6, 0x5e, 0x72, 0x1e, 0x9b, 0x57, 0xac, 0x16, 0xd4,
+	0x3e, 0x4a, 0x4d, 0xc3, 0xea, 0xc2, 0xda, 0x47, 0xf1, 0x69, 0x8c, 0x60, 0x6d, 0xfb, 0x35, 0x58,
+	0xd7, 0xd2, 0x1a, 0xc8, 0x8f, 0x3f, 0xc4, 0x61, 0x5e, 0xc1, 0xff, 0xf0, 0xb7, 0x7e, 0x4c, 0xc3,
+	0xea, 0x40, 0x93, 0xfe, 0xb2, 0x86, 0x59, 0xdb, 0x7e, 0x07, 0xba, 0xca, 0xaf, 0xac, 0x59, 0x1b,
+	0x00, 0x2e, 0xfe, 0x9a, 0x8e, 0x9b, 0x1c, 0x87, 0xd8, 0x06, 0xa0, 0xb5, 0x3f, 0x78, 0xe0, 0xe5,
+	0x63, 0xd3, 0xb0, 0x36, 0xa1, 0xcb, 0x7f, 0x1c, 0x82, 0x12, 0x6b, 0xdb, 0xbf, 0x04, 0x66, 0xf5,
+	0xd7, 0x77, 0x2c, 0x0b, 0x36, 0x1e, 0x26, 0x2a, 0x6a, 0x5e, 0xc1, 0x86, 0x3b, 0xc4, 0xcb, 0x48,
+	0x76, 0x84, 0x3f, 0xbc, 0x63, 0x1a, 0xd6, 0x55, 0x58, 0x7f, 0x70, 0xd8, 0xdf, 0x1d, 0x86, 0xa3,
+	0xd8, 0x2b, 0xa6, 0x19, 0x31, 0x6b, 0x56, 0x0f, 0xda, 0xfd, 0x4f, 0x86, 0xc3, 0x70, 0xf4, 0xf1,
+	0x5b, 0x66, 0x7d, 0xfb, 0x1b, 0xd0, 0x16, 0xbf, 0x69, 0x83, 0x6f, 0x1c, 0xca, 0x68, 0x13, 0xa2,
+	0xe6, 0x15, 0xec, 0x26, 0x8b, 0x47, 0xd2, 0x67, 0xc3, 0x5a, 0x87, 0xce, 0xfd, 0xf0, 0x82, 0x04,
+	0xf4, 0xb1, 0xb6, 0xbd, 0x07, 0x3d, 0xf5, 0x1a, 0x0b, 0x92, 0x07, 0xa2, 0x2c, 0xcb, 0xbc, 0x82,
+	0xc3, 0xdf, 0xcb, 0xbc, 0x13, 0x6c, 0x08, 0xd0, 0x72, 0x69, 0x05, 0x99, 0x59, 0xc3, 0x97, 0xee,
+	0xc9, 0x74, 0xbf, 0x59, 0xdf, 0x7e, 0x19, 0xa0, 0x2c, 0xc7, 0x47, 0x4e, 0xfa, 0x0e, 0xdf, 0xbc,
+	0x82, 0x9d, 0xdd, 0xe7, 0x21, 0x4e, 0xd3, 0xd8, 0x1e, 0x43, 0x4f, 0x3d, 0x4a, 0xf0, 0x3d, 0xf4,
+	0xff, 0x9d, 0x59, 0x7f, 0xb0, 0x6f, 0x5e, 0xc1, 0xd1, 0x96, 0xcf, 0xef, 0x93, 0x19, 0xeb, 0x2f,
+	0x87, 0xf6, 0x07, 0x66, 0x4d, 0xe1, 0x60, 0xe5, 0x6d, 0x66, 0xdd, 0xba, 0x06, 0x9b, 0x1c, 0x12,
+	0xc6, 0x8b, 0xd9, 0xd8, 0x7e, 0x0b, 0xd6, 0xb5, 0x1f, 0x61, 0xc2, 0x99, 0x75, 0x89, 0x17, 0xf1,
+	0x9f, 0x82, 0x31, 0xaf, 0xd0, 0xc9, 0x9a, 0xc5, 0xc5, 0x98, 0x14, 0xa1, 0x4f, 0x59, 0x4d, 0x63,
+	0xfb, 0x1d, 0x68, 0x8b, 0x5f, 0x39, 0xa1, 0x32, 0x70, 0x74, 0x34, 0x60, 0xd2, 0xf0, 0x5e, 0x96,
+	0xfa, 0x4c, 0x1a, 0xf6, 0xa6, 0xc7, 0xc7, 0x89, 0x59, 0xc3, 0xf7, 0x0d, 0xd3, 0x2c, 0x8c, 0x47,
+	0xbb, 0x51, 0x32, 0xc5, 0x39, 0xf8, 0x15, 0x68, 0xb1, 0x1f, 0x37, 0x40, 0x12, 0xbd, 0xfc, 0x3a,
+	0x2c, 0x90, 0xce, 0x26, 0x01, 0x0b, 0x8a, 0xf7, 0xbc, 0xc2, 0x33, 0x0d, 0x7c, 0xfa, 0xc5, 0xe1,
+	0x07, 0x0f, 0xb1, 0x78, 0xd2, 0xac, 0xe1, 0x64, 0xc9, 0x91, 0x00, 0xb4, 0x76, 0xe9, 0xcf, 0x46,
+	0x98, 0x0d, 0xba, 0x10, 0x5e, 0x31, 0xa6, 0x9a, 0xc1, 0x6c, 0x6e, 0xdf, 0x84, 0xb6, 0xf8, 0x71,
+	0x03, 0x2a, 0x79, 0x58, 0x60, 0x46, 0x46, 0xe4, 0x22, 0x35, 0xaf, 0x6c, 0x7f, 0x04, 0xf5, 0xdd,
+	0xc3, 0x01, 0x15, 0xd5, 0xc3, 0xc1, 0xbd, 0x0f, 0xd9, 0xb2, 0xed, 0x1e, 0x0e, 0x0e, 0x8e, 0xb8,
+	0x00, 0x1f, 0x0e, 0x0e, 0xee, 0x99, 0x35, 0xfe, 0xef, 0x7b, 0x47, 0x66, 0x5d, 0xfc, 0x7b, 0xcf,
+	0x6c, 0xf0, 0x7f, 0xf7, 0x63, 0xb3, 0x89, 0x3d, 0xdb, 0x3d, 0x1c, 0xd0, 0x82, 0x10, 0xb3, 0xb5,
+	0xfd, 0x32, 0x6c, 0x56, 0x8a, 0x01, 0x70, 0x26, 0x76, 0x93, 0x74, 0xc6, 0xbe, 0x30, 0x4c, 0xa3,
+	0xb0, 0x30, 0x8d, 0xed, 0xaf, 0x42, 0x47, 0xd6, 0x90, 0x58, 0x26, 0xf4, 0xe8, 0x03, 0x0f, 0xff,
+	0xb2, 0xc1, 0x53, 0xa4, 0x1f, 0x45, 0xa6, 0x51, 0x3e, 0xc5, 0x33, 0xb3, 0xb6, 0xfd, 0x2e, 0x40,
+	0x19, 0xc7, 0xc3, 0x21, 0x63, 0x1c, 0xb1, 0x1f, 0x04, 0x54, 0xf6, 0x36, 0xa1, 0x8b, 0x8f, 0x2e,
+	0xad, 0xbe, 0x0d, 0x4c, 0x83, 0xbe, 0x9b, 0x14, 0xde, 0x61, 0x12, 0x50, 0x8b, 0xd5, 0xac, 0x6d,
+	0x1f, 0xc1, 0x86, 0x1e, 0xbe, 0x42, 0xf9, 0x90, 0x08, 0xdf, 0xcc, 0xcf, 0x80, 0x25, 0xa1, 0x5d,
+	0x11, 0x90, 0x32, 0x0d, 0xeb, 0x59, 0xb8, 0x26, 0x71, 0x57, 0xc6, 0x9f, 0xcc, 0xda, 0xf6, 0x43,
+	0xd8, 0xd0, 0x7f, 0x4f, 0x09, 0x7b, 0x86, 0xb2, 0x40, 0x01, 0x36, 0xa4, 0xa3, 0x5d, 0xfe, 0x44,
+	0x25, 0xf4, 0xde, 0x05, 0xf1, 0xd9, 0x63, 0x0d, 0x7b, 0x49, 0xff, 0x25, 0x19, 0x43, 0xea, 0xdb,
+	0x5f, 0x81, 0x9e, 0x9a, 0x0c, 0x44, 0x2d, 0xc4, 0x9e, 0x67, 0xec, 0x5d, 0x7b, 0xf8, 0x73, 0x33,
+	0x28, 0x29, 0xf4, 0x5d, 0x1f, 0x89, 0x9f, 0x56, 0x32, 0x6b, 0xdb, 0xef, 0x43, 0x57, 0x09, 0x98,
+	0x58, 0x37, 0xe0, 0xea, 0x9e, 0x17, 0x8f, 0xd0, 0x15, 0x76, 0xb1, 0xb0, 0x19, 0xeb, 0x5c, 0xcd,
+	0x2b, 0xf8, 0xc5, 0x7b, 0x93, 0xb4, 0x98, 0xf1, 0x78, 0xb7, 0x69, 0x58, 0xd7, 0xe4, 0xd2, 0x61,
+	0xe0, 0xe2, 0x24, 0x4a, 0xce, 0xcd, 0xda, 0xf6, 0x2b, 0xb0, 0x59, 0xa9, 0x6f, 0xc7, 0x9e, 0x1c,
+	0x91, 0x8b, 0xe2, 0x20, 0x41, 0x29, 0xed, 0xc2, 0x1a, 0xca, 0x25, 0x3e, 0xe0, 0xa2, 0x9a, 0xd5,
+	0x72, 0x35, 0xfc, 0x0e, 0xc7, 0xa8, 0x78, 0x9b, 0x57, 0xf0, 0x3b, 0x1c, 0x39, 0x9c, 0x16, 0x94,
+	0xc9, 0x34, 0x76, 0xae, 0xff, 0xf8, 0xa7, 0xb7, 0xae, 0xfc, 0xe8, 0xd1, 0x2d, 0xe3, 0xc7, 0x8f,
+	0x6e, 0x19, 0x3f, 0x79, 0x74, 0xcb, 0xf8, 0xde, 0x3f, 0xdf, 0xba, 0xf2, 0x3f, 0x03, 0x00, 0x57,
+	0x93, 0xf7, 0xe6, 0xa1, 0x53, 0x00, 0x00,
 }
@@ -0,0 +1,179 @@
+package pb
+
+import (
+	"github.com/fagongzi/gateway/pkg/pb/metapb"
+	"github.com/fagongzi/util/protoc"
+)
+
+const (
+	// PolicySourceAPI the policy is defined in the api
+	PolicySourceAPI = "api"
+	// PolicySourceTemplate the policy is inherited from the api template
+	PolicySourceTemplate = "template"
+	// PolicySourceCluster the policy is inherited from the cluster
+	PolicySourceCluster = "cluster"
+	// PolicySourceGlobal the policy is inherited from the global policy
+	PolicySourceGlobal = "global"
+	// PolicySourceDefault the policy is not defined, the proxy uses its default
+	PolicySourceDefault = "default"
+)
+
+// PolicyValue is the effective value of a policy, and where the value comes from
+type PolicyValue struct {
+	Value  interface{} `json:"value,omitempty"`
+	Source string      `json:"source"`
+}
+
+// NodePolicy is the effective policies of a dispatch node
+type NodePolicy struct {
+	ClusterID     uint64      `json:"clusterID"`
+	RetryStrategy PolicyValue `json:"retryStrategy"`
+	WriteTimeout  PolicyValue `json:"writeTimeout"`
+	ReadTimeout   PolicyValue `json:"readTimeout"`
+}
+
+// ResolvedPolicy is the effective policies of an api
+type ResolvedPolicy struct {
+	AuthFilter PolicyValue   `json:"authFilter"`
+	MaxQPS     PolicyValue   `json:"maxQPS"`
+	Timeout    PolicyValue   `json:"timeout"`
+	Nodes      []*NodePolicy `json:"nodes"`
+}
+
+type policyLayer struct {
+	source string
+	value  *metapb.Policy
+}
+
+// ResolvePolicy returns the effective policies of the api, the precedence is api, template, cluster
+// and global, the timeouts of the nodes override the timeouts of the api. The tpl, the clusters and the
+// global are optional
+func ResolvePolicy(api *metapb.API, tpl *metapb.APITemplate, clusters func(uint64) *metapb.Cluster, global *metapb.Policy) *ResolvedPolicy {
+	var tplPolicy *metapb.Policy
+	if tpl != nil {
+		tplPolicy = &metapb.Policy{
+			AuthFilter:    tpl.AuthFilter,
+			MaxQPS:        tpl.MaxQPS,
+			RetryStrategy: tpl.RetryStrategy,
+			WriteTimeout:  tpl.WriteTimeout,
+			ReadTimeout:   tpl.ReadTimeout,
+			Timeout:       tpl.Timeout,
+		}
+	}
+
+	var first uint64
+	if len(api.Nodes) > 0 {
+		first = api.Nodes[0].ClusterID
+	}
+
+	layers := []policyLayer{
+		{source: PolicySourceAPI, value: &metapb.Policy{AuthFilter: api.AuthFilter, MaxQPS: api.MaxQPS, Timeout: api.Timeout}},
+		{source: PolicySourceTemplate, value: tplPolicy},
+		{source: PolicySourceCluster, value: clusterPolicy(clusters, first)},
+		{source: PolicySourceGlobal, value: global},
+	}
+
+	value := &ResolvedPolicy{
+		AuthFilter: pickPolicy(layers, func(p *metapb.Policy) (interface{}, bool) {
+			return p.AuthFilter, p.AuthFilter != ""
+		}),
+		MaxQPS: pickPolicy(layers, func(p *metapb.Policy) (interface{}, bool) {
+			return p.MaxQPS, p.MaxQPS != 0
+		}),
+		Timeout: pickPolicy(layers, func(p *metapb.Policy) (interface{}, bool) {
+			return p.Timeout, p.Timeout != 0
+		}),
+	}
+
+	// the node layer is prior to the api layer
+	layers = append([]policyLayer{{source: PolicySourceAPI}}, layers...)
+	layers[1].value = &metapb.Policy{
+		WriteTimeout: api.WriteTimeout,
+		ReadTimeout:  api.ReadTimeout,
+	}
+	for _, node := range api.Nodes {
+		layers[0].value = &metapb.Policy{
+			RetryStrategy: node.RetryStrategy,
+			WriteTimeout:  node.WriteTimeout,
+			ReadTimeout:   node.ReadTimeout,
+		}
+		layers[3].value = clusterPolicy(clusters, node.ClusterID)
+
+		value.Nodes = append(value.Nodes, &NodePolicy{
+			ClusterID: node.ClusterID,
+			RetryStrategy: pickPolicy(layers, func(p *metapb.Policy) (interface{}, bool) {
+				return p.RetryStrategy, p.RetryStrategy != nil
+			}),
+			WriteTimeout: pickPolicy(layers, func(p *metapb.Policy) (interface{}, bool) {
+				return p.WriteTimeout, p.WriteTimeout != 0
+			}),
+			ReadTimeout: pickPolicy(layers, func(p *metapb.Policy) (interface{}, bool) {
+				return p.ReadTimeout, p.ReadTimeout != 0
+			}),
+		})
+	}
+
+	return value
+}
+
+// MergePolicies returns a new api that inherits the policies from the clusters and the global policy,
+// the api should be merged with its template first
+func MergePolicies(api *metapb.API, clusters func(uint64) *metapb.Cluster, global *metapb.Policy) *metapb.API {
+	value := &metapb.API{}
+	protoc.MustUnmarshal(value, protoc.MustMarshal(api))
+
+	policy := ResolvePolicy(value, nil, clusters, global)
+	if policy.AuthFilter.Source != PolicySourceDefault {
+		value.AuthFilter = policy.AuthFilter.Value.(string)
+	}
+	if policy.MaxQPS.Source != PolicySourceDefault {
+		value.MaxQPS = policy.MaxQPS.Value.(int64)
+	}
+	if policy.Timeout.Source != PolicySourceDefault {
+		value.Timeout = policy.Timeout.Value.(int64)
+	}
+
+	for i, node := range value.Nodes {
+		p := policy.Nodes[i]
+		if p.RetryStrategy.Source != PolicySourceAPI && p.RetryStrategy.Source != PolicySourceDefault {
+			node.RetryStrategy = &metapb.RetryStrategy{}
+			protoc.MustUnmarshal(node.RetryStrategy, protoc.MustMarshal(p.RetryStrategy.Value.(*metapb.RetryStrategy)))
+		}
+		if p.WriteTimeout.Source != PolicySourceDefault {
+			node.WriteTimeout = p.WriteTimeout.Value.(int64)
+		}
+		if p.ReadTimeout.Source != PolicySourceDefault {
+			node.ReadTimeout = p.ReadTimeout.Value.(int64)
+		}
+	}
+
+	return value
+}
+
+func clusterPolicy(clusters func(uint64) *metapb.Cluster, id uint64) *metapb.Policy {
+	if clusters == nil {
+		return nil
+	}
+
+	cluster := clusters(id)
+	if cluster == nil {
+		return nil
+	}
+
+	return cluster.Policy
+}
+
+// pickPolicy returns the value of the first layer that defines the policy
+func pickPolicy(layers []policyLayer, get func(*metapb.Policy) (interface{}, bool)) PolicyValue {
+	for _, layer := range layers {
+		if layer.value == nil {
+			continue
+		}
+
+		if value, ok := get(layer.value); ok {
+			return PolicyValue{Value: value, Source: layer.source}
+		}
+	}
+
+	return PolicyValue{Source: PolicySourceDefault}
+}
@@ -0,0 +1,94 @@
+package pb
+
+import (
+	"github.com/fagongzi/gateway/pkg/pb/metapb"
+	"github.com/fagongzi/util/protoc"
+)
+
+// MergeAPITemplate returns a new api that inherits the policies from the template,
+// the policies that already defined in the api are not changed
+func MergeAPITemplate(api *metapb.API, tpl *metapb.APITemplate) *metapb.API {
+	value := &metapb.API{}
+	protoc.MustUnmarshal(value, protoc.MustMarshal(api))
+
+	if tpl == nil {
+		return value
+	}
+
+	if value.IPAccessControl == nil && tpl.IPAccessControl != nil {
+		value.IPAccessControl = &metapb.IPAccessControl{}
+		protoc.MustUnmarshal(value.IPAccessControl, protoc.MustMarshal(tpl.IPAccessControl))
+	}
+
+	if value.DefaultValue == nil && tpl.DefaultValue != nil {
+		value.DefaultValue = &metapb.HTTPResult{}
+		protoc.MustUnmarshal(value.DefaultValue, protoc.MustMarshal(tpl.DefaultValue))
+	}
+
+	if len(value.Perms) == 0 {
+		value.Perms = append(value.Perms, tpl.Perms...)
+	}
+
+	if value.AuthFilter == "" {
+		value.AuthFilter = tpl.AuthFilter
+	}
+
+	for _, tag := range tpl.Tags {
+		if !hasTag(value.Tags, tag.Name) {
+			value.Tags = append(value.Tags, &metapb.PairValue{Name: tag.Name, Value: tag.Value})
+		}
+	}
+
+	if value.MaxQPS == 0 {
+		value.MaxQPS = tpl.MaxQPS
+	}
+
+	if value.Timeout == 0 {
+		value.Timeout = tpl.Timeout
+	}
+
+	if value.CircuitBreaker == nil && tpl.CircuitBreaker != nil {
+		cb := *tpl.CircuitBreaker
+		value.CircuitBreaker = &cb
+	}
+
+	if len(value.ErrorPages) == 0 {
+		for _, page := range tpl.ErrorPages {
+			value.ErrorPages = append(value.ErrorPages, &metapb.ErrorPage{
+				Status:      page.Status,
+				Code:        page.Code,
+				ContentType: page.ContentType,
+				Body:        page.Body,
+				Headers:     append([]*metapb.PairValue(nil), page.Headers...),
+			})
+		}
+	}
+
+	for _, node := range value.Nodes {
+		if node.RetryStrategy == nil && tpl.RetryStrategy != nil {
+			node.RetryStrategy = &metapb.RetryStrategy{}
+			protoc.MustUnmarshal(node.RetryStrategy, protoc.MustMarshal(tpl.RetryStrategy))
+		}
+
+		// the timeouts of the api override the template
+		if node.WriteTimeout == 0 && value.WriteTimeout == 0 {
+			node.WriteTimeout = tpl.WriteTimeout
+		}
+
+		if node.ReadTimeout == 0 && value.ReadTimeout == 0 {
+			node.ReadTimeout = tpl.ReadTimeout
+		}
+	}
+
+	return value
+}
+
+func hasTag(tags []*metapb.PairValue, name string) bool {
+	for _, tag := range tags {
+		if tag.Name == name {
+			return true
+		}
+	}
+
+	return false
+}
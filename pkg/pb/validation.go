@@ -2,27 +2,56 @@ package pb
 
 import (
 	"fmt"
+	"mime"
+	"net"
+	"net/url"
 	"regexp"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/fagongzi/gateway/pkg/pb/metapb"
+	"github.com/fagongzi/gateway/pkg/util"
+)
+
+var (
+	accessLogFields = map[string]bool{
+		"remoteIP":      true,
+		"method":        true,
+		"uri":           true,
+		"host":          true,
+		"status":        true,
+		"userAgent":     true,
+		"referer":       true,
+		"server":        true,
+		"cost":          true,
+		"costMS":        true,
+		"requestID":     true,
+		"api":           true,
+		"requestBytes":  true,
+		"responseBytes": true,
+	}
+
+	// replacementGroup the capture group references of the regexp replacements, e.g. $1, ${1}, ${name}
+	replacementGroup = regexp.MustCompile(`\$(\w+)|\$\{(\w+)\}`)
 )
 
 // ValidateRouting validate routing
 func ValidateRouting(value *metapb.Routing) error {
 	if value.API == 0 {
-		return fmt.Errorf("missing api")
+		return fieldError("api", "missing api")
 	}
 
 	if value.ClusterID == 0 {
-		return fmt.Errorf("missing cluster")
+		return fieldError("clusterID", "missing cluster")
 	}
 
 	if value.Name == "" {
-		return fmt.Errorf("missing name")
+		return fieldError("name", "missing name")
 	}
 
 	if value.TrafficRate <= 0 || value.TrafficRate > 100 {
-		return fmt.Errorf("error traffic rate: %d", value.TrafficRate)
+		return fieldError("trafficRate", "error traffic rate: %d", value.TrafficRate)
 	}
 
 	return nil
@@ -31,7 +60,177 @@ func ValidateRouting(value *metapb.Routing) error {
 // ValidateCluster validate cluster
 func ValidateCluster(value *metapb.Cluster) error {
 	if value.Name == "" {
-		return fmt.Errorf("missing name")
+		return fieldError("name", "missing name")
+	}
+
+	if auth := value.OutboundAuth; auth != nil {
+		switch auth.Type {
+		case metapb.BearerToken:
+			if auth.Token == "" {
+				return fieldError("outboundAuth.token", "missing outbound auth token")
+			}
+		case metapb.HMACSignature:
+			if auth.SecretKey == "" {
+				return fieldError("outboundAuth.secretKey", "missing outbound auth secret key")
+			}
+		case metapb.AWSSigV4:
+			if auth.AccessKey == "" || auth.SecretKey == "" ||
+				auth.Region == "" || auth.Service == "" {
+				return fieldError("outboundAuth", "missing outbound auth access key, secret key, region or service")
+			}
+		}
+	}
+
+	if probe := value.HalfOpenProbe; probe != nil {
+		if probe.MaxRequests < 0 {
+			return fieldError("halfOpenProbe.maxRequests", "error half-open probe max requests: %d", probe.MaxRequests)
+		}
+
+		if probe.TrafficRate < 0 || probe.TrafficRate > 100 {
+			return fieldError("halfOpenProbe.trafficRate", "error half-open probe traffic rate: %d", probe.TrafficRate)
+		}
+	}
+
+	if value.MinActive < 0 {
+		return fieldError("minActive", "error min active servers: %d", value.MinActive)
+	}
+
+	if err := validateCircuitBreaker(value.CircuitBreaker); err != nil {
+		return withField("circuitBreaker", err)
+	}
+
+	if r := value.ConnRecycle; r != nil {
+		if r.MaxLifetime < 0 {
+			return fieldError("connRecycle.maxLifetime", "error max lifetime: %d", r.MaxLifetime)
+		}
+
+		if r.MaxRequests < 0 {
+			return fieldError("connRecycle.maxRequests", "error max requests: %d", r.MaxRequests)
+		}
+	}
+
+	if value.Policy != nil {
+		if err := ValidatePolicy(value.Policy); err != nil {
+			return withField("policy", err)
+		}
+
+		if value.Policy.IPAccessControl != nil {
+			return fieldError("policy.ipAccessControl", "the ip access control is only defined by the global policy")
+		}
+	}
+
+	if remote := value.RemoteGateway; remote != nil {
+		if remote.Secret == "" {
+			return fieldError("remoteGateway.secret", "missing remote gateway secret")
+		}
+
+		if remote.MaxHops < 0 {
+			return fieldError("remoteGateway.maxHops", "error remote gateway max hops: %d", remote.MaxHops)
+		}
+	}
+
+	if dns := value.DNS; dns != nil {
+		if dns.Host == "" {
+			return fieldError("dns.host", "missing dns host")
+		}
+
+		if dns.Port <= 0 || dns.Port > 65535 {
+			return fieldError("dns.port", "error dns port: %d", dns.Port)
+		}
+
+		if dns.MaxQPS <= 0 {
+			return fieldError("dns.maxQPS", "missing dns server max qps")
+		}
+
+		if dns.RefreshInterval < 0 {
+			return fieldError("dns.refreshInterval", "error dns refresh interval: %d", dns.RefreshInterval)
+		}
+
+		if err := validateCircuitBreaker(dns.CircuitBreaker); err != nil {
+			return withField("dns.circuitBreaker", err)
+		}
+
+		if err := validateHeathCheck(dns.HeathCheck); err != nil {
+			return withField("dns.heathCheck", err)
+		}
+	}
+
+	return withField("upstreamHost", validateUpstreamHost(value.UpstreamHost))
+}
+
+// validateCircuitBreaker validate the circuit breaker, the circuit must be closed by the failure rate
+// or the continuous failures
+func validateCircuitBreaker(value *metapb.CircuitBreaker) error {
+	if value == nil {
+		return nil
+	}
+
+	if value.CloseTimeout <= 0 {
+		return fieldError("closeTimeout", "missing circuit close timeout")
+	}
+
+	if value.HalfTrafficRate < 0 || value.HalfTrafficRate > 100 {
+		return fieldError("halfTrafficRate", "error half traffic rate: %d", value.HalfTrafficRate)
+	}
+
+	if value.FailureRateToClose < 0 || value.FailureRateToClose > 100 {
+		return fieldError("failureRateToClose", "error failure rate to close: %d", value.FailureRateToClose)
+	}
+
+	if value.SucceedRateToOpen < 0 || value.SucceedRateToOpen > 100 {
+		return fieldError("succeedRateToOpen", "error succeed rate to open: %d", value.SucceedRateToOpen)
+	}
+
+	if value.ContinuousFailuresToClose < 0 {
+		return fieldError("continuousFailuresToClose", "error continuous failures to close: %d", value.ContinuousFailuresToClose)
+	}
+
+	if value.FailureRateToClose == 0 && value.ContinuousFailuresToClose == 0 {
+		return fieldError("failureRateToClose", "missing failure rate or continuous failures to close")
+	}
+
+	if value.RateCheckPeriod <= 0 {
+		return fieldError("rateCheckPeriod", "missing rate check period")
+	}
+
+	return nil
+}
+
+func validateHeathCheck(value *metapb.HeathCheck) error {
+	if value == nil {
+		return nil
+	}
+
+	if _, ok := metapb.HeathCheckType_name[int32(value.Type)]; !ok {
+		return fieldError("type", "error heath check type: %d", value.Type)
+	}
+
+	switch value.Type {
+	case metapb.ExecCheck:
+		if len(value.Command) == 0 || value.Command[0] == "" {
+			return fieldError("command", "missing heath check command")
+		}
+	case metapb.CheckerCheck:
+		u, err := url.Parse(value.Checker)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fieldError("checker", "error heath checker: %s", value.Checker)
+		}
+	}
+
+	if value.HealthyThreshold < 0 {
+		return fieldError("healthyThreshold", "error healthy threshold: %d", value.HealthyThreshold)
+	}
+
+	if value.UnhealthyThreshold < 0 {
+		return fieldError("unhealthyThreshold", "error unhealthy threshold: %d", value.UnhealthyThreshold)
+	}
+
+	return nil
+}
+
+func validateUpstreamHost(value *metapb.UpstreamHost) error {
+	if value != nil && value.Type == metapb.FixedHost && value.Value == "" {
+		return fieldError("value", "missing upstream host value")
 	}
 
 	return nil
@@ -40,26 +239,829 @@ func ValidateCluster(value *metapb.Cluster) error {
 // ValidateServer validate server
 func ValidateServer(value *metapb.Server) error {
 	if value.Addr == "" {
-		return fmt.Errorf("missing server address")
+		return fieldError("addr", "missing server address")
 	}
 
 	if value.MaxQPS == 0 {
-		return fmt.Errorf("missing server max qps")
+		return fieldError("maxQPS", "missing server max qps")
 	}
 
-	return nil
+	if w := value.Weight; w != nil {
+		if w.From < 0 || w.From > MaxServerWeight || w.Target < 0 || w.Target > MaxServerWeight {
+			return fieldError("weight", "error server weight: %+v", w)
+		}
+
+		if w.RampSeconds < 0 {
+			return fieldError("weight.rampSeconds", "error server weight ramp seconds: %d", w.RampSeconds)
+		}
+	}
+
+	if err := validateHeathCheck(value.HeathCheck); err != nil {
+		return withField("heathCheck", err)
+	}
+
+	return withField("circuitBreaker", validateCircuitBreaker(value.CircuitBreaker))
 }
 
 // ValidateAPI validate api
 func ValidateAPI(value *metapb.API) error {
 	if value.Name == "" {
-		return fmt.Errorf("missing api name")
+		return fieldError("name", "missing api name")
 	}
 
 	if value.URLPattern != "" {
 		if _, err := regexp.Compile(value.URLPattern); err != nil {
+			return withField("urlPattern", err)
+		}
+	}
+
+	for i, alias := range value.Aliases {
+		if alias.URLPattern == "" && alias.Domain == "" {
+			return fieldError(fmt.Sprintf("aliases[%d]", i), "missing alias urlPattern or domain")
+		}
+
+		if alias.URLPattern != "" {
+			if _, err := regexp.Compile(alias.URLPattern); err != nil {
+				return withField(fmt.Sprintf("aliases[%d].urlPattern", i), err)
+			}
+		}
+	}
+
+	if value.Cache != nil && value.Cache.Deadline == 0 {
+		return fieldError("cache.deadline", "missing cache deadline")
+	}
+
+	if err := validatePathRewrite(value.PathRewrite); err != nil {
+		return withField("pathRewrite", err)
+	}
+
+	if value.ReadTimeout < 0 {
+		return fieldError("readTimeout", "error read timeout: %d", value.ReadTimeout)
+	}
+
+	if value.WriteTimeout < 0 {
+		return fieldError("writeTimeout", "error write timeout: %d", value.WriteTimeout)
+	}
+
+	if value.Timeout < 0 {
+		return fieldError("timeout", "error timeout: %d", value.Timeout)
+	}
+
+	for i, node := range value.Nodes {
+		field := fmt.Sprintf("nodes[%d]", i)
+		if param := node.Affinity; param != nil && param.Source != metapb.PathValue && param.Name == "" {
+			return fieldError(field+".affinity.name", "missing affinity parameter name of the node: %d", node.ClusterID)
+		}
+
+		if node.Cache != nil && node.Cache.Deadline == 0 {
+			return fieldError(field+".cache.deadline", "missing cache deadline of the node: %d", node.ClusterID)
+		}
+
+		if node.RetryStrategy != nil {
+			if err := validateRetryStrategy(field+".retryStrategy", node.RetryStrategy); err != nil {
+				return err
+			}
+		}
+
+		if value.DegradedAttr != "" && value.DegradedAttr == node.AttrName {
+			return fieldError("degradedAttr", "degraded attr conflict with the node attr: %s", node.AttrName)
+		}
+
+		if err := validateTemplate(node.URLRewrite); err != nil {
+			return withField(field+".urlRewrite", err)
+		}
+
+		if node.DefaultValue != nil {
+			if err := validateTemplate(string(node.DefaultValue.Body)); err != nil {
+				return withField(field+".defaultValue.body", err)
+			}
+		}
+
+		if t := node.GRPCTranscoding; t != nil {
+			if t.DescriptorID == 0 {
+				return fieldError(field+".grpcTranscoding.descriptor", "missing proto descriptor of the node: %d", node.ClusterID)
+			}
+
+			if t.Method == "" {
+				return fieldError(field+".grpcTranscoding.method", "missing grpc method of the node: %d", node.ClusterID)
+			}
+		}
+	}
+
+	if value.RequestSchema != nil {
+		for _, schema := range []string{value.RequestSchema.Query,
+			value.RequestSchema.Header,
+			value.RequestSchema.Body} {
+			if schema == "" {
+				continue
+			}
+
+			if _, err := util.ParseSchema([]byte(schema)); err != nil {
+				return fieldError("requestSchema", "error request schema: %s", err)
+			}
+		}
+	}
+
+	if value.ResponseSchema != nil {
+		if _, err := util.ParseSchema([]byte(value.ResponseSchema.Body)); err != nil {
+			return fieldError("responseSchema.body", "error response schema: %s", err)
+		}
+
+		if value.ResponseSchema.Sampling < 0 || value.ResponseSchema.Sampling > 100 {
+			return fieldError("responseSchema.sampling", "error response schema sampling: %d", value.ResponseSchema.Sampling)
+		}
+	}
+
+	if value.Deprecation != nil {
+		if value.Deprecation.SunsetAt > 0 &&
+			value.Deprecation.SunsetAt < value.Deprecation.DeprecatedAt {
+			return fieldError("deprecation.sunsetAt", "api sunset before deprecated")
+		}
+
+		if value.Deprecation.DisableAfterSunset && value.Deprecation.SunsetAt == 0 {
+			return fieldError("deprecation.sunsetAt", "missing api sunset time")
+		}
+	}
+
+	if value.GraphQL != nil {
+		err := validateGraphQL(value.GraphQL)
+		if err != nil {
+			return withField("graphQL", err)
+		}
+	}
+
+	if value.AccessPolicy != nil {
+		err := validateAccessPolicy(value.AccessPolicy)
+		if err != nil {
+			return withField("accessPolicy", err)
+		}
+	}
+
+	if value.IPAccessControl != nil {
+		if err := validateIPAccessControl(value.IPAccessControl); err != nil {
+			return withField("ipAccessControl", err)
+		}
+	}
+
+	if value.HeaderLimits != nil {
+		l := value.HeaderLimits
+		if l.MaxRequestHeaders < 0 || l.MaxRequestHeaderBytes < 0 ||
+			l.MaxResponseHeaders < 0 || l.MaxResponseHeaderBytes < 0 {
+			return fieldError("headerLimits", "error header limits: %+v", l)
+		}
+	}
+
+	if value.RequestBody != nil {
+		b := value.RequestBody
+		if b.MaxBytes < 0 || b.MaxBufferBytes < 0 || b.MaxPartBytes < 0 {
+			return fieldError("requestBody", "error request body policy: %+v", b)
+		}
+
+		if b.SpillToDisk {
+			if b.MaxBufferBytes == 0 {
+				return fieldError("requestBody.maxBufferBytes", "missing max buffer bytes of the spilled request body")
+			}
+
+			// the spilled body is not visible to the body validation and graphQL
+			if value.GraphQL != nil || (value.RequestSchema != nil && value.RequestSchema.Body != "") {
+				return fieldError("requestBody.spillToDisk", "the request body of the graphQL or body validation api can't be spilled")
+			}
+		}
+	}
+
+	if value.OIDC != nil {
+		for _, scope := range value.OIDC.Scopes {
+			if scope == "" || strings.ContainsAny(scope, " \t\"") {
+				return fieldError("oidc.scopes", "error oidc scope: %q", scope)
+			}
+		}
+	}
+
+	if value.ResponseBody != nil {
+		b := value.ResponseBody
+		if b.MaxBytes < 0 {
+			return fieldError("responseBody.maxBytes", "error max bytes of the response body: %d", b.MaxBytes)
+		}
+
+		if b.Truncate && b.MaxBytes == 0 {
+			return fieldError("responseBody.maxBytes", "missing max bytes of the truncated response body")
+		}
+	}
+
+	if value.ContentTypes != nil {
+		for name, types := range map[string][]string{
+			"consumes": value.ContentTypes.Consumes,
+			"produces": value.ContentTypes.Produces} {
+			for i, t := range types {
+				if err := validateMediaType(t); err != nil {
+					return withField(fmt.Sprintf("contentTypes.%s[%d]", name, i), err)
+				}
+			}
+		}
+	}
+
+	for i, c := range value.Constants {
+		if c.Name == "" {
+			return fieldError(fmt.Sprintf("constants[%d].name", i), "missing constant name")
+		}
+	}
+
+	if value.DefaultValue != nil {
+		if err := validateTemplate(string(value.DefaultValue.Body)); err != nil {
+			return withField("defaultValue.body", err)
+		}
+	}
+
+	if t := value.RenderTemplate; t != nil {
+		if err := validateTemplate(t.Body); err != nil {
+			return withField("renderTemplate.body", err)
+		}
+
+		if t.ContentType != "" {
+			if _, _, err := mime.ParseMediaType(t.ContentType); err != nil {
+				return fieldError("renderTemplate.contentType", "error content type: %s", t.ContentType)
+			}
+		}
+	}
+
+	if l := value.AccessLog; l != nil {
+		if l.Sampling < 0 || l.Sampling > 100 {
+			return fieldError("accessLog.sampling", "error access log sampling: %d", l.Sampling)
+		}
+
+		for i, name := range l.Fields {
+			if !accessLogFields[name] {
+				return fieldError(fmt.Sprintf("accessLog.fields[%d]", i), "error access log field: %s", name)
+			}
+		}
+	}
+
+	if m := value.Mirror; m != nil {
+		if m.ClusterID == 0 {
+			return fieldError("mirror.clusterID", "missing mirror cluster")
+		}
+
+		if m.Rate < 0 || m.Rate > 100 {
+			return fieldError("mirror.rate", "error mirror rate: %d", m.Rate)
+		}
+	}
+
+	for i, group := range value.ProxyGroups {
+		if len(group.Labels) == 0 {
+			return fieldError(fmt.Sprintf("proxyGroups[%d].labels", i), "missing proxy group labels")
+		}
+
+		for j, label := range group.Labels {
+			if label.Name == "" {
+				return fieldError(fmt.Sprintf("proxyGroups[%d].labels[%d].name", i, j), "missing proxy group label name")
+			}
+		}
+	}
+
+	if value.SecurityHeaders != nil {
+		if err := ValidateSecurityHeaders(value.SecurityHeaders); err != nil {
+			return withField("securityHeaders", err)
+		}
+	}
+
+	if value.HeaderTransform != nil {
+		if err := validateHeaderRules(value.HeaderTransform.Request); err != nil {
+			return withField("headerTransform.request", err)
+		}
+
+		if err := validateHeaderRules(value.HeaderTransform.Response); err != nil {
+			return withField("headerTransform.response", err)
+		}
+	}
+
+	if _, ok := metapb.Visibility_name[int32(value.Visibility)]; !ok {
+		return fieldError("visibility", "error visibility: %d", value.Visibility)
+	}
+
+	if (value.PublishState == metapb.Draft || value.PublishState == metapb.Review) &&
+		(value.Preview == nil || (value.Preview.Secret == "" && len(value.Preview.IPs) == 0)) {
+		return fieldError("preview", "missing preview secret or ips of the draft api")
+	}
+
+	err := validateUpstreamHost(value.UpstreamHost)
+	if err != nil {
+		return withField("upstreamHost", err)
+	}
+
+	return withField("errorPages", ValidateErrorPages(value.ErrorPages))
+}
+
+// ValidateSecurityHeaders validate security headers
+func ValidateSecurityHeaders(value *metapb.SecurityHeaders) error {
+	if value.HSTSMaxAge < 0 {
+		return fieldError("hstsMaxAge", "error hsts max age: %d", value.HSTSMaxAge)
+	}
+
+	switch strings.ToUpper(value.FrameOptions) {
+	case "", "DENY", "SAMEORIGIN":
+	default:
+		return fieldError("frameOptions", "frame options must be DENY or SAMEORIGIN: %s", value.FrameOptions)
+	}
+
+	if err := validateTemplate(value.ContentSecurityPolicy); err != nil {
+		return withField("contentSecurityPolicy", err)
+	}
+
+	for i, name := range value.RemoveHeaders {
+		if name == "" {
+			return fieldError(fmt.Sprintf("removeHeaders[%d]", i), "missing header name")
+		}
+	}
+
+	return nil
+}
+
+func validatePathRewrite(value *metapb.PathRewrite) error {
+	if value == nil {
+		return nil
+	}
+
+	if value.StripPrefix != "" && !strings.HasPrefix(value.StripPrefix, "/") {
+		return fieldError("stripPrefix", "error strip prefix: %s, must start with /", value.StripPrefix)
+	}
+
+	if value.AddPrefix != "" && !strings.HasPrefix(value.AddPrefix, "/") {
+		return fieldError("addPrefix", "error add prefix: %s, must start with /", value.AddPrefix)
+	}
+
+	if value.Pattern == "" {
+		if value.Replacement != "" {
+			return fieldError("pattern", "missing pattern of the replacement")
+		}
+		return nil
+	}
+
+	pattern, err := regexp.Compile(value.Pattern)
+	if err != nil {
+		return withField("pattern", err)
+	}
+
+	groups := make(map[string]bool)
+	for i, name := range pattern.SubexpNames() {
+		groups[strconv.Itoa(i)] = true
+		if name != "" {
+			groups[name] = true
+		}
+	}
+	// $$ is the escaped $
+	replacement := strings.Replace(value.Replacement, "$$", "", -1)
+	for _, match := range replacementGroup.FindAllStringSubmatch(replacement, -1) {
+		if group := match[1] + match[2]; !groups[group] {
+			return fieldError("replacement", "missing capture group %s in pattern", group)
+		}
+	}
+
+	return nil
+}
+
+func validateHeaderRules(value *metapb.HeaderRules) error {
+	if value == nil {
+		return nil
+	}
+
+	for i, name := range value.Remove {
+		if name == "" {
+			return fieldError(fmt.Sprintf("remove[%d]", i), "missing header name")
+		}
+	}
+
+	for i, pair := range value.Rename {
+		if pair.Name == "" || pair.Value == "" {
+			return fieldError(fmt.Sprintf("rename[%d]", i), "missing header name or new name")
+		}
+	}
+
+	rules := []struct {
+		field  string
+		values []metapb.PairValue
+	}{
+		{"set", value.Set},
+		{"defaults", value.Defaults},
+		{"add", value.Add},
+	}
+	for _, rule := range rules {
+		for i, pair := range rule.values {
+			if pair.Name == "" {
+				return fieldError(fmt.Sprintf("%s[%d].name", rule.field, i), "missing header name")
+			}
+
+			if err := validateTemplate(pair.Value); err != nil {
+				return withField(fmt.Sprintf("%s[%d].value", rule.field, i), err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateTemplate the text that has the template actions must be a valid template
+func validateTemplate(value string) error {
+	if !util.IsTemplate(value) {
+		return nil
+	}
+
+	if _, err := util.ParseTemplate(value); err != nil {
+		return fmt.Errorf("error template: %s", err)
+	}
+
+	return nil
+}
+
+// validateMediaType the media type is type/subtype without parameters, the subtype or both can be *
+func validateMediaType(value string) error {
+	t, params, err := mime.ParseMediaType(value)
+	if err != nil || len(params) > 0 {
+		return fmt.Errorf("error media type: %s", value)
+	}
+
+	idx := strings.Index(t, "/")
+	if idx <= 0 || idx == len(t)-1 || (t[:idx] == "*" && t[idx+1:] != "*") {
+		return fmt.Errorf("error media type: %s", value)
+	}
+
+	return nil
+}
+
+func validateGraphQL(value *metapb.GraphQLOptions) error {
+	if value.MaxDepth < 0 || value.MaxComplexity < 0 {
+		return fieldError("maxDepth", "error graphql limits: %d, %d", value.MaxDepth, value.MaxComplexity)
+	}
+
+	fields := make(map[string]bool)
+	for i, r := range value.Resolvers {
+		field := fmt.Sprintf("resolvers[%d]", i)
+		if r.Field == "" {
+			return fieldError(field+".field", "missing graphql resolver field")
+		}
+
+		if r.ClusterID == 0 || r.URL == "" {
+			return fieldError(field, "missing graphql resolver cluster or url: %s", r.Field)
+		}
+
+		key := r.Operation.String() + "." + r.Field
+		if fields[key] {
+			return fieldError(field, "duplicate graphql resolver: %s", key)
+		}
+		fields[key] = true
+	}
+
+	return nil
+}
+
+func validateAccessPolicy(value *metapb.AccessPolicy) error {
+	for i := range value.TimeWindows {
+		err := validateTimeWindow(fmt.Sprintf("timeWindows[%d]", i), value.TimeWindows[i])
+		if err != nil {
+			return err
+		}
+	}
+
+	if value.Anomaly != nil &&
+		(value.Anomaly.Multiple < 0 || value.Anomaly.MinQPS < 0 || value.Anomaly.BlockSeconds < 0) {
+		return fieldError("anomaly", "error anomaly rule: %+v", value.Anomaly)
+	}
+
+	return nil
+}
+
+// ValidateProxyOverride validate proxy override
+func ValidateProxyOverride(value *metapb.ProxyOverride) error {
+	if value.Name == "" {
+		return fieldError("name", "missing name")
+	}
+
+	if value.Proxy == "" && len(value.Labels) == 0 {
+		return fieldError("proxy", "missing proxy or labels")
+	}
+
+	for i, limit := range value.RateLimits {
+		if limit.API == 0 {
+			return fieldError(fmt.Sprintf("rateLimits[%d].api", i), "missing rate limit api")
+		}
+
+		if limit.MaxQPS <= 0 {
+			return fieldError(fmt.Sprintf("rateLimits[%d].maxQPS", i), "error rate limit max qps: %d", limit.MaxQPS)
+		}
+	}
+
+	if value.AccessLogSampling < 0 || value.AccessLogSampling > 100 {
+		return fieldError("accessLogSampling", "error access log sampling: %d", value.AccessLogSampling)
+	}
+
+	return nil
+}
+
+// ValidateKillSwitch validate kill switch
+func ValidateKillSwitch(value *metapb.KillSwitch) error {
+	if !value.Global && len(value.APIs) == 0 {
+		return fieldError("apis", "missing global or apis")
+	}
+
+	if value.Code != 0 && (value.Code < 100 || value.Code > 599) {
+		return fieldError("code", "error kill switch code: %d", value.Code)
+	}
+
+	for i, ip := range value.AllowedIPs {
+		if ip == "" {
+			return fieldError(fmt.Sprintf("allowedIPs[%d]", i), "missing allowed ip")
+		}
+	}
+
+	for i, h := range value.Headers {
+		if h.Name == "" {
+			return fieldError(fmt.Sprintf("headers[%d].name", i), "missing kill switch header name")
+		}
+	}
+
+	return withField("body", validateTemplate(value.Body))
+}
+
+// ValidatePolicy validate policy
+func ValidatePolicy(value *metapb.Policy) error {
+	if value.MaxQPS < 0 {
+		return fieldError("maxQPS", "error max qps: %d", value.MaxQPS)
+	}
+
+	if value.WriteTimeout < 0 {
+		return fieldError("writeTimeout", "error write timeout: %d", value.WriteTimeout)
+	}
+
+	if value.ReadTimeout < 0 {
+		return fieldError("readTimeout", "error read timeout: %d", value.ReadTimeout)
+	}
+
+	if value.Timeout < 0 {
+		return fieldError("timeout", "error timeout: %d", value.Timeout)
+	}
+
+	if value.IPAccessControl != nil {
+		if err := validateIPAccessControl(value.IPAccessControl); err != nil {
+			return withField("ipAccessControl", err)
+		}
+	}
+
+	if value.RetryStrategy != nil {
+		return validateRetryStrategy("retryStrategy", value.RetryStrategy)
+	}
+
+	return nil
+}
+
+// validateIPAccessControl validate the ips of the access control, the formats are ipv4 prefix with *
+// segments (e.g. 192.168.*), CIDR and ipv6 address
+func validateIPAccessControl(value *metapb.IPAccessControl) error {
+	for _, list := range []struct {
+		name string
+		ips  []string
+	}{{"whitelist", value.Whitelist}, {"blacklist", value.Blacklist}} {
+		for i, ip := range list.ips {
+			if !validIPRule(ip) {
+				return fieldError(fmt.Sprintf("%s[%d]", list.name, i), "error ip: %s", ip)
+			}
+		}
+	}
+
+	return nil
+}
+
+func validIPRule(value string) bool {
+	if _, _, err := net.ParseCIDR(value); err == nil {
+		return true
+	}
+
+	if strings.Contains(value, ":") {
+		return net.ParseIP(value) != nil
+	}
+
+	segments := strings.Split(value, ".")
+	if len(segments) > 4 {
+		return false
+	}
+
+	for _, segment := range segments {
+		if segment == "*" {
+			continue
+		}
+
+		if n, err := strconv.Atoi(segment); err != nil || n < 0 || n > 255 {
+			return false
+		}
+	}
+
+	return true
+}
+
+func validateRetryStrategy(field string, value *metapb.RetryStrategy) error {
+	if value.MaxTimes < 0 {
+		return fieldError(field+".maxTimes", "error retry max times: %d", value.MaxTimes)
+	}
+
+	if value.Interval < 0 {
+		return fieldError(field+".interval", "error retry interval: %d", value.Interval)
+	}
+
+	if value.MaxInterval != 0 && value.MaxInterval < value.Interval {
+		return fieldError(field+".maxInterval", "error retry max interval: %d", value.MaxInterval)
+	}
+
+	if value.PerTryTimeout < 0 {
+		return fieldError(field+".perTryTimeout", "error retry per try timeout: %d", value.PerTryTimeout)
+	}
+
+	return nil
+}
+
+// ValidateRouteTest validate the sample request of the route test
+func ValidateRouteTest(value *metapb.RouteTest) error {
+	if !strings.HasPrefix(value.Path, "/") {
+		return fieldError("path", "error path: %s", value.Path)
+	}
+
+	if value.ClientIP != "" && net.ParseIP(value.ClientIP) == nil {
+		return fieldError("clientIP", "error client ip: %s", value.ClientIP)
+	}
+
+	for i, h := range value.Headers {
+		if h.Name == "" {
+			return fieldError(fmt.Sprintf("headers[%d].name", i), "missing header name")
+		}
+	}
+
+	return nil
+}
+
+// ValidateConsumer validate consumer
+func ValidateConsumer(value *metapb.Consumer) error {
+	if value.Name == "" {
+		return fieldError("name", "missing name")
+	}
+
+	if value.Quota < 0 {
+		return fieldError("quota", "error quota: %d", value.Quota)
+	}
+
+	if value.Webhook != "" {
+		u, err := url.Parse(value.Webhook)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fieldError("webhook", "error webhook: %s", value.Webhook)
+		}
+	}
+
+	for i, key := range value.Keys {
+		if key.ID == "" {
+			return fieldError(fmt.Sprintf("keys[%d].id", i), "missing api key id")
+		}
+
+		if key.Hash == "" {
+			return fieldError(fmt.Sprintf("keys[%d].hash", i), "missing api key hash: %s", key.ID)
+		}
+	}
+
+	for i, id := range value.AllowedAPIs {
+		if id == 0 {
+			return fieldError(fmt.Sprintf("allowedAPIs[%d]", i), "missing allowed api id")
+		}
+	}
+
+	return nil
+}
+
+func validateTimeWindow(field string, w *metapb.TimeWindow) error {
+	for _, day := range w.Weekdays {
+		if day < 0 || day > 6 {
+			return fieldError(field+".weekdays", "error time window weekday: %d", day)
+		}
+	}
+
+	if _, err := time.Parse("15:04", w.Start); err != nil {
+		return fieldError(field+".start", "error time window clock: %s", w.Start)
+	}
+
+	if _, err := time.Parse("15:04", w.End); err != nil {
+		return fieldError(field+".end", "error time window clock: %s", w.End)
+	}
+
+	if w.Location != "" {
+		if _, err := time.LoadLocation(w.Location); err != nil {
+			return withField(field+".location", err)
+		}
+	}
+
+	return nil
+}
+
+// ValidateRateLimit validate rate limit
+func ValidateRateLimit(value *metapb.RateLimit) error {
+	if value.Name == "" {
+		return fieldError("name", "missing name")
+	}
+
+	if _, ok := metapb.RateLimitKey_name[int32(value.Key)]; !ok {
+		return fieldError("key", "error key: %d", value.Key)
+	}
+
+	if value.Key == metapb.LimitByHeader && value.Header == "" {
+		return fieldError("header", "missing header")
+	}
+
+	if value.API > 0 && len(value.APIs) > 0 {
+		return fieldError("apis", "api and apis are exclusive")
+	}
+
+	if value.Rate <= 0 {
+		return fieldError("rate", "error rate: %d", value.Rate)
+	}
+
+	if value.Period < 0 {
+		return fieldError("period", "error period: %d", value.Period)
+	}
+
+	if value.Burst < 0 {
+		return fieldError("burst", "error burst: %d", value.Burst)
+	}
+
+	for i := range value.Profiles {
+		profile := &value.Profiles[i]
+		field := fmt.Sprintf("profiles[%d]", i)
+		if err := validateTimeWindow(field+".window", &profile.Window); err != nil {
 			return err
 		}
+
+		if profile.Rate <= 0 {
+			return fieldError(field+".rate", "error rate: %d", profile.Rate)
+		}
+
+		if profile.Period < 0 {
+			return fieldError(field+".period", "error period: %d", profile.Period)
+		}
+
+		if profile.Burst < 0 {
+			return fieldError(field+".burst", "error burst: %d", profile.Burst)
+		}
+	}
+
+	return nil
+}
+
+// ValidateProtoDescriptor validate proto descriptor
+func ValidateProtoDescriptor(value *metapb.ProtoDescriptor) error {
+	if value.Name == "" {
+		return fieldError("name", "missing name")
+	}
+
+	if len(value.Data) == 0 {
+		return fieldError("data", "missing data")
+	}
+
+	if _, err := util.ParseProtoDescriptors(value.Data); err != nil {
+		return withField("data", err)
+	}
+
+	return nil
+}
+
+// ValidateChangeset validate changeset
+func ValidateChangeset(value *metapb.Changeset) error {
+	if value.Name == "" {
+		return fieldError("name", "missing name")
+	}
+
+	if _, ok := metapb.ChangesetState_name[int32(value.State)]; !ok {
+		return fieldError("state", "error state: %d", value.State)
+	}
+
+	return nil
+}
+
+// ValidateAPITemplate validate api template
+func ValidateAPITemplate(value *metapb.APITemplate) error {
+	if value.Name == "" {
+		return fieldError("name", "missing name")
+	}
+
+	return withField("errorPages", ValidateErrorPages(value.ErrorPages))
+}
+
+// ValidateErrorPages validate error pages
+func ValidateErrorPages(values []*metapb.ErrorPage) error {
+	for i, value := range values {
+		field := fmt.Sprintf("[%d]", i)
+		if value.Status != 0 && (value.Status < 400 || value.Status > 599) {
+			return fieldError(field+".status", "error page status must be 4xx or 5xx: %d", value.Status)
+		}
+
+		if value.Code != 0 && (value.Code < 100 || value.Code > 599) {
+			return fieldError(field+".code", "error page code: %d", value.Code)
+		}
+
+		if err := validateTemplate(value.Body); err != nil {
+			return withField(field+".body", err)
+		}
 	}
 
 	return nil
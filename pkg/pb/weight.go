@@ -0,0 +1,41 @@
+package pb
+
+import (
+	"github.com/fagongzi/gateway/pkg/pb/metapb"
+)
+
+const (
+	// DefaultServerWeight the weight of the server that not set the weight
+	DefaultServerWeight = 100
+	// MaxServerWeight the max weight of the server
+	MaxServerWeight = 10000
+)
+
+// ServerWeightAt returns the weight of the server at the time(unix seconds), the weight ramps
+// linearly from the from weight to the target weight
+func ServerWeightAt(value *metapb.ServerWeight, now int64) int32 {
+	if value == nil {
+		return DefaultServerWeight
+	}
+
+	if value.RampSeconds <= 0 || now >= value.RampStartAt+value.RampSeconds {
+		return value.Target
+	}
+
+	if now <= value.RampStartAt {
+		return value.From
+	}
+
+	delta := int64(value.Target-value.From) * (now - value.RampStartAt) / value.RampSeconds
+	return value.From + int32(delta)
+}
+
+// RampServerWeight returns the weight that ramps from the current weight of the server to the target
+func RampServerWeight(value *metapb.ServerWeight, target int32, rampSeconds, now int64) *metapb.ServerWeight {
+	return &metapb.ServerWeight{
+		From:        ServerWeightAt(value, now),
+		Target:      target,
+		RampStartAt: now,
+		RampSeconds: rampSeconds,
+	}
+}
@@ -0,0 +1,151 @@
+package proxy
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/fagongzi/gateway/pkg/filter"
+	"github.com/fagongzi/gateway/pkg/pb/metapb"
+	"github.com/fagongzi/util/hack"
+)
+
+var (
+	// defaultAccessLogFields the fields of the access log if the api not specified
+	defaultAccessLogFields = []string{"remoteIP", "method", "uri", "status", "userAgent", "server", "cost"}
+
+	accessLogFields = map[string]accessLogField{
+		"remoteIP": {value: func(c filter.Context) interface{} {
+			return GetRealClientIP(c.OriginRequest())
+		}},
+		"method": {value: func(c filter.Context) interface{} {
+			return hack.SliceToString(c.OriginRequest().Method())
+		}},
+		"uri": {quoted: true, value: func(c filter.Context) interface{} {
+			return hack.SliceToString(c.ForwardRequest().RequestURI())
+		}},
+		"host": {value: func(c filter.Context) interface{} {
+			return hack.SliceToString(c.OriginRequest().Host())
+		}},
+		"status": {value: func(c filter.Context) interface{} {
+			return c.Response().StatusCode()
+		}},
+		"userAgent": {quoted: true, value: func(c filter.Context) interface{} {
+			return hack.SliceToString(c.OriginRequest().UserAgent())
+		}},
+		"referer": {quoted: true, value: func(c filter.Context) interface{} {
+			return hack.SliceToString(c.OriginRequest().Referer())
+		}},
+		"server": {value: func(c filter.Context) interface{} {
+			if c.(*proxyContext).result.dest == nil {
+				return ""
+			}
+			return c.Server().Addr
+		}},
+		"cost": {value: func(c filter.Context) interface{} {
+			return c.EndAt().Sub(c.StartAt()).String()
+		}},
+		"costMS": {value: func(c filter.Context) interface{} {
+			return int64(c.EndAt().Sub(c.StartAt()) / time.Millisecond)
+		}},
+		"requestID": {value: func(c filter.Context) interface{} {
+			return getRequestID(c.OriginRequest())
+		}},
+		"api": {value: func(c filter.Context) interface{} {
+			return c.API().Name
+		}},
+		"consumer": {value: func(c filter.Context) interface{} {
+			if value, ok := c.GetAttr(consumerAttr).(string); ok {
+				return value
+			}
+			return ""
+		}},
+		"requestBytes": {value: func(c filter.Context) interface{} {
+			return len(c.ForwardRequest().Body())
+		}},
+		"responseBytes": {value: func(c filter.Context) interface{} {
+			return len(c.Response().Body())
+		}},
+	}
+)
+
+type accessLogField struct {
+	quoted bool
+	value  func(filter.Context) interface{}
+}
+
+// accessLog is the access log runtime of the api
+type accessLog struct {
+	meta   *metapb.AccessLog
+	fields []string
+}
+
+func newAccessLog(meta *metapb.AccessLog) *accessLog {
+	l := &accessLog{
+		meta:   meta,
+		fields: defaultAccessLogFields,
+	}
+
+	if len(meta.Fields) > 0 {
+		l.fields = meta.Fields
+	}
+
+	return l
+}
+
+// sample returns true if the request should be logged, the sampling of the api is prior to the
+// sampling of the proxy override
+func (l *accessLog) sample(override *overrideRuntime) bool {
+	if l.meta.Disabled {
+		return false
+	}
+
+	if l.meta.Sampling > 0 {
+		return rand.Int31n(100) < l.meta.Sampling
+	}
+
+	return override.sampleAccessLog()
+}
+
+func (l *accessLog) format(c filter.Context) string {
+	var buf bytes.Buffer
+	if l.meta.Format == metapb.JSONLog {
+		buf.WriteByte('{')
+		for idx, name := range l.fields {
+			if idx > 0 {
+				buf.WriteByte(',')
+			}
+
+			key, _ := json.Marshal(name)
+			value, _ := json.Marshal(l.value(name, c))
+			buf.Write(key)
+			buf.WriteByte(':')
+			buf.Write(value)
+		}
+		buf.WriteByte('}')
+		return buf.String()
+	}
+
+	for idx, name := range l.fields {
+		if idx > 0 {
+			buf.WriteByte(' ')
+		}
+
+		if accessLogFields[name].quoted {
+			fmt.Fprintf(&buf, "\"%v\"", l.value(name, c))
+		} else {
+			fmt.Fprintf(&buf, "%v", l.value(name, c))
+		}
+	}
+	return buf.String()
+}
+
+func (l *accessLog) value(name string, c filter.Context) interface{} {
+	if field, ok := accessLogFields[name]; ok {
+		return field.value(c)
+	}
+
+	return ""
+}
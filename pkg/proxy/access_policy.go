@@ -0,0 +1,233 @@
+package proxy
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/fagongzi/gateway/pkg/pb/metapb"
+	"github.com/fagongzi/log"
+)
+
+const (
+	defaultAnomalyMultiple     = 100
+	defaultAnomalyMinQPS       = 10
+	defaultAnomalyBlockSeconds = 60
+
+	// anomalyBaselineFactor is the weight of the latest second in the baseline qps
+	anomalyBaselineFactor = 0.05
+	// anomalyExpireSeconds the idle clients are removed after the seconds
+	anomalyExpireSeconds = 300
+)
+
+type timeWindow struct {
+	weekdays   map[time.Weekday]bool
+	start, end int
+	location   *time.Location
+}
+
+func parseTimeWindow(meta *metapb.TimeWindow) (*timeWindow, error) {
+	w := &timeWindow{
+		weekdays: make(map[time.Weekday]bool),
+		location: time.Local,
+	}
+
+	for _, day := range meta.Weekdays {
+		w.weekdays[time.Weekday(day)] = true
+	}
+
+	var err error
+	w.start, err = parseClock(meta.Start)
+	if err != nil {
+		return nil, err
+	}
+
+	w.end, err = parseClock(meta.End)
+	if err != nil {
+		return nil, err
+	}
+
+	if meta.Location != "" {
+		w.location, err = time.LoadLocation(meta.Location)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return w, nil
+}
+
+// parseClock returns the minutes of the day of HH:MM
+func parseClock(value string) (int, error) {
+	t, err := time.Parse("15:04", value)
+	if err != nil {
+		return 0, fmt.Errorf("error time window clock: %s", value)
+	}
+
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+// contains returns true if the time in the window, the window crosses midnight if start > end,
+// the weekday of the crossed part is the weekday of the start
+func (w *timeWindow) contains(now time.Time) bool {
+	now = now.In(w.location)
+	minutes := now.Hour()*60 + now.Minute()
+	day := now.Weekday()
+
+	if w.start <= w.end {
+		return minutes >= w.start && minutes < w.end && w.matchesDay(day)
+	}
+
+	if minutes >= w.start {
+		return w.matchesDay(day)
+	}
+
+	return minutes < w.end && w.matchesDay((day+6)%7)
+}
+
+func (w *timeWindow) matchesDay(day time.Weekday) bool {
+	return len(w.weekdays) == 0 || w.weekdays[day]
+}
+
+type clientRate struct {
+	second       int64
+	count        int64
+	baseline     float64
+	blockedUntil int64
+}
+
+type anomalyDetector struct {
+	sync.Mutex
+
+	meta      *metapb.AnomalyRule
+	multiple  float64
+	minQPS    int64
+	block     int64
+	clients   map[string]*clientRate
+	lastSweep int64
+}
+
+func newAnomalyDetector(meta *metapb.AnomalyRule) *anomalyDetector {
+	d := &anomalyDetector{
+		meta:     meta,
+		multiple: float64(meta.Multiple),
+		minQPS:   meta.MinQPS,
+		block:    meta.BlockSeconds,
+		clients:  make(map[string]*clientRate),
+	}
+
+	if d.multiple <= 0 {
+		d.multiple = defaultAnomalyMultiple
+	}
+	if d.minQPS <= 0 {
+		d.minQPS = defaultAnomalyMinQPS
+	}
+	if d.block <= 0 {
+		d.block = defaultAnomalyBlockSeconds
+	}
+
+	return d
+}
+
+// allow returns false if the client is blocked, the client is blocked when the qps of the
+// current second is over the multiple of its baseline, and the block expires automatically
+func (d *anomalyDetector) allow(client string, now int64) bool {
+	d.Lock()
+	defer d.Unlock()
+
+	d.sweep(now)
+
+	r, ok := d.clients[client]
+	if !ok {
+		r = &clientRate{second: now}
+		d.clients[client] = r
+	}
+
+	if r.blockedUntil > now {
+		return false
+	}
+
+	if r.second != now {
+		r.baseline = r.baseline*(1-anomalyBaselineFactor) + float64(r.count)*anomalyBaselineFactor
+		for idle := now - r.second - 1; idle > 0 && r.baseline > 0.01; idle-- {
+			r.baseline *= 1 - anomalyBaselineFactor
+		}
+		r.second, r.count = now, 0
+	}
+
+	// the baseline is at least 1 qps, avoid blocking the new clients
+	baseline := r.baseline
+	if baseline < 1 {
+		baseline = 1
+	}
+
+	r.count++
+	if r.count > d.minQPS && float64(r.count) > baseline*d.multiple {
+		r.blockedUntil = now + d.block
+		r.count = 0
+		return false
+	}
+
+	return true
+}
+
+func (d *anomalyDetector) sweep(now int64) {
+	if now-d.lastSweep < anomalyExpireSeconds {
+		return
+	}
+
+	for client, r := range d.clients {
+		if r.second+anomalyExpireSeconds < now && r.blockedUntil < now {
+			delete(d.clients, client)
+		}
+	}
+	d.lastSweep = now
+}
+
+type accessPolicy struct {
+	windows   []*timeWindow
+	anomaly   *anomalyDetector
+	overrides map[string]bool
+}
+
+func newAccessPolicy(id uint64, meta *metapb.AccessPolicy) *accessPolicy {
+	p := &accessPolicy{
+		overrides: make(map[string]bool),
+	}
+
+	for _, value := range meta.TimeWindows {
+		w, err := parseTimeWindow(value)
+		if err != nil {
+			log.Errorf("api <%d> parse time window failed, errors:\n%+v",
+				id,
+				err)
+			continue
+		}
+
+		p.windows = append(p.windows, w)
+	}
+
+	if meta.Anomaly != nil {
+		p.anomaly = newAnomalyDetector(meta.Anomaly)
+	}
+
+	for _, value := range meta.Overrides {
+		p.overrides[value] = true
+	}
+
+	return p
+}
+
+func (p *accessPolicy) inTimeWindows(now time.Time) bool {
+	if len(p.windows) == 0 {
+		return true
+	}
+
+	for _, w := range p.windows {
+		if w.contains(now) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,108 @@
+package proxy
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+
+	"github.com/fagongzi/gateway/pkg/pb/metapb"
+	"github.com/valyala/fasthttp"
+)
+
+var (
+	// ErrRequestBodyTooLarge the request body exceeds the request body policy of the api
+	ErrRequestBodyTooLarge = errors.New("request body too large")
+)
+
+// spilledBody is the request body that spilled to a temp file, it's shared by the dispatch nodes
+// of the request, and removed after the request completed
+type spilledBody struct {
+	file string
+	size int
+}
+
+// setTo stream the body from the file to the upstream, the file is opened at the first read,
+// so every retry reads the body from the beginning
+func (b *spilledBody) setTo(req *fasthttp.Request) {
+	req.SetBodyStream(&spilledReader{file: b.file}, b.size)
+}
+
+func (b *spilledBody) remove() {
+	os.Remove(b.file)
+}
+
+type spilledReader struct {
+	file string
+	f    *os.File
+}
+
+func (r *spilledReader) Read(p []byte) (int, error) {
+	if r.f == nil {
+		f, err := os.Open(r.file)
+		if err != nil {
+			return 0, err
+		}
+		r.f = f
+	}
+
+	return r.f.Read(p)
+}
+
+func (r *spilledReader) Close() error {
+	if r.f == nil {
+		return nil
+	}
+
+	return r.f.Close()
+}
+
+// bufferRequestBody apply the request body policy of the api, the body larger than the max buffer
+// bytes is spilled to a temp file and removed from the request if the policy allows, and the
+// clusters of the dispatches not sign the requests. Returns the status code if the body is rejected
+func (p *Proxy) bufferRequestBody(ctx *fasthttp.RequestCtx, api *apiRuntime, dispatches []*dispathNode) (*spilledBody, int, error) {
+	policy := api.meta.RequestBody
+	if policy == nil {
+		return nil, 0, nil
+	}
+
+	size := int64(len(ctx.Request.Body()))
+	if policy.MaxBytes > 0 && size > policy.MaxBytes {
+		return nil, fasthttp.StatusRequestEntityTooLarge, ErrRequestBodyTooLarge
+	}
+
+	if policy.MaxBufferBytes == 0 || size <= policy.MaxBufferBytes {
+		return nil, 0, nil
+	}
+
+	if !policy.SpillToDisk {
+		return nil, fasthttp.StatusRequestEntityTooLarge, ErrRequestBodyTooLarge
+	}
+
+	// the signatures of the outbound auth contain the hash of the body, keep it in memory
+	for _, dn := range dispatches {
+		if dn.cluster != nil && dn.cluster.OutboundAuth != nil &&
+			(dn.cluster.OutboundAuth.Type == metapb.HMACSignature ||
+				dn.cluster.OutboundAuth.Type == metapb.AWSSigV4) {
+			return nil, 0, nil
+		}
+	}
+
+	f, err := ioutil.TempFile(p.cfg.Option.SpillDir, "gateway-body-")
+	if err != nil {
+		return nil, fasthttp.StatusInternalServerError, err
+	}
+
+	_, err = f.Write(ctx.Request.Body())
+	if err == nil {
+		err = f.Close()
+	} else {
+		f.Close()
+	}
+	if err != nil {
+		os.Remove(f.Name())
+		return nil, fasthttp.StatusInternalServerError, err
+	}
+
+	ctx.Request.ResetBody()
+	return &spilledBody{file: f.Name(), size: int(size)}, 0, nil
+}
@@ -0,0 +1,153 @@
+package proxy
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/fagongzi/gateway/pkg/util"
+	"github.com/garyburd/redigo/redis"
+)
+
+const (
+	redisCachePrefix      = "gateway:cache:"
+	redisCacheScanCount   = 1000
+	redisCacheMaxIdle     = 16
+	redisCacheIdleTimeout = time.Minute
+	redisCacheTimeout     = time.Second
+)
+
+// responseCache is the storage of the cached responses, the keys are prefixed by the api id and a "/",
+// so the cached responses of an api can be removed together
+type responseCache interface {
+	get(key string) ([]byte, bool, error)
+	set(key string, value []byte, ttl time.Duration) error
+	// removeAPI removes the cached responses of the api, returns the number of the removed responses
+	removeAPI(id uint64) (int, error)
+}
+
+func cacheAPIPrefix(id uint64) string {
+	return fmt.Sprintf("%d/", id)
+}
+
+// localCache is the lru cache in the proxy, the expire time is stored before the value, so the value
+// that cached again is not removed by the previous ttl
+type localCache struct {
+	cache *util.Cache
+}
+
+func newLocalCache(maxBytes uint64) responseCache {
+	return &localCache{
+		cache: util.NewLRUCache(maxBytes),
+	}
+}
+
+func (c *localCache) get(key string) ([]byte, bool, error) {
+	value, ok := c.cache.Get(key)
+	if !ok {
+		return nil, false, nil
+	}
+
+	if int64(binary.BigEndian.Uint64(value)) <= time.Now().UnixNano() {
+		c.cache.Remove(key)
+		return nil, false, nil
+	}
+
+	return value[8:], true, nil
+}
+
+func (c *localCache) set(key string, value []byte, ttl time.Duration) error {
+	data := make([]byte, 8+len(value))
+	binary.BigEndian.PutUint64(data, uint64(time.Now().Add(ttl).UnixNano()))
+	copy(data[8:], value)
+	c.cache.Add(key, data)
+	return nil
+}
+
+func (c *localCache) removeAPI(id uint64) (int, error) {
+	prefix := cacheAPIPrefix(id)
+	return c.cache.RemoveIf(func(key util.Key) bool {
+		return strings.HasPrefix(key.(string), prefix)
+	}), nil
+}
+
+// redisCache is the cache that shared by the proxies, the ttl is the ttl of the redis keys
+type redisCache struct {
+	pool *redis.Pool
+}
+
+func newRedisCache(addr string) responseCache {
+	return &redisCache{
+		pool: &redis.Pool{
+			MaxIdle:     redisCacheMaxIdle,
+			IdleTimeout: redisCacheIdleTimeout,
+			Dial: func() (redis.Conn, error) {
+				return redis.Dial("tcp",
+					addr,
+					redis.DialConnectTimeout(redisCacheTimeout),
+					redis.DialReadTimeout(redisCacheTimeout),
+					redis.DialWriteTimeout(redisCacheTimeout))
+			},
+		},
+	}
+}
+
+func (c *redisCache) get(key string) ([]byte, bool, error) {
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	value, err := redis.Bytes(conn.Do("GET", redisCachePrefix+key))
+	if err == redis.ErrNil {
+		return nil, false, nil
+	} else if err != nil {
+		return nil, false, err
+	}
+
+	return value, true, nil
+}
+
+func (c *redisCache) set(key string, value []byte, ttl time.Duration) error {
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	_, err := conn.Do("SET", redisCachePrefix+key, value, "PX", int64(ttl/time.Millisecond))
+	return err
+}
+
+func (c *redisCache) removeAPI(id uint64) (int, error) {
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	match := redisCachePrefix + cacheAPIPrefix(id) + "*"
+	removed := 0
+	cursor := 0
+	for {
+		values, err := redis.Values(conn.Do("SCAN", cursor, "MATCH", match, "COUNT", redisCacheScanCount))
+		if err != nil {
+			return removed, err
+		}
+
+		cursor, err = redis.Int(values[0], nil)
+		if err != nil {
+			return removed, err
+		}
+
+		keys, err := redis.Values(values[1], nil)
+		if err != nil {
+			return removed, err
+		}
+
+		if len(keys) > 0 {
+			n, err := redis.Int(conn.Do("DEL", keys...))
+			if err != nil {
+				return removed, err
+			}
+			removed += n
+		}
+
+		if cursor == 0 {
+			return removed, nil
+		}
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/fagongzi/gateway/pkg/pb/metapb"
 	"github.com/fagongzi/gateway/pkg/util"
 )
 
@@ -24,20 +25,96 @@ type Option struct {
 	LimitBytesBody             int
 	LimitBytesCaching          uint64
 
-	JWTCfgFile string
+	// LimitCountAnalysisBuffer the max buffered analysis updates, the updates are dropped if
+	// the buffer is full, 0 means the updates are applied on the request goroutines
+	LimitCountAnalysisBuffer int
+
+	// LimitCountHeavyWorker the max cpu-heavy operations(template rendering, aggregation, body transcoding and
+	// schema validation) that running at the same time, 0 means no limit
+	LimitCountHeavyWorker int
+
+	// LimitCountWebSocketConn the max concurrent websocket connections, 0 means no limit
+	LimitCountWebSocketConn    int
+	LimitDurationWebSocketIdle time.Duration
+	LimitTimeoutWebSocketDrain time.Duration
+
+	// LimitCountConnPerIP the max concurrent connections of a client ip, 0 means no limit
+	LimitCountConnPerIP int
+	// LimitRateConnPerIP the max new connections per second of a client ip, 0 means no limit
+	LimitRateConnPerIP int
+	// LimitRateRequestPerIP the max requests per second of a client ip, 0 means no limit
+	LimitRateRequestPerIP int
+	// LimitDurationIPBan the client ip that exceeds the rates is banned for the duration, 0 means no ban
+	LimitDurationIPBan time.Duration
+
+	JWTCfgFile           string
+	TokenExchangeCfgFile string
+	OIDCCfgFile          string
+	ErrorPagesFile       string
+	SecurityHeadersFile  string
+	StreamListenersFile  string
+	ResolverCfgFile      string
+
+	// SpillDir the directory of the spilled request bodies, empty means the temp directory of the os
+	SpillDir string
+
+	// DeadlineHeader the header to propagate the remaining time(ms) of the request to the backends
+	DeadlineHeader string
+
+	// FederationSecret the secret that shared with the gateways that forward the requests to this
+	// gateway, the consumers signed by the secret are trusted, empty means no gateway is trusted
+	FederationSecret string
+
+	// TraceHeader the header of the trace id of the requests, the trace ids are the exemplars of the
+	// latency histograms, empty means tracing is disabled
+	TraceHeader string
+
+	// TracingEndpoint the otlp/http endpoint that the spans of the requests are exported to, e.g.
+	// http://127.0.0.1:4318/v1/traces, empty means the spans are disabled
+	TracingEndpoint string
+	// TracingService the service name of the spans
+	TracingService string
+	// TracingSampling the percent of the new traces that sampled, 0 means all
+	TracingSampling int
+
+	// TrustedProxies the ips or cidrs of the proxies in front of the gateway, the X-Forwarded-For is only
+	// trusted from them, empty means the X-Forwarded-For is never trusted
+	TrustedProxies []string
+
+	// DebugSecret the secret of the X-Gateway-Debug header, the responses of the requests with the secret
+	// have the debug headers, empty means only the apis that enable debug
+	DebugSecret string
+
+	// CachingRedisAddr the redis that shared by the proxies to cache the responses, empty means the
+	// responses are cached in the proxy
+	CachingRedisAddr string
+
+	// OAuth2Secret the secret(HS256) that shared by the proxies to sign the access tokens, empty means
+	// the token endpoint is disabled
+	OAuth2Secret string
+	// OAuth2TokenPath the path of the token endpoint
+	OAuth2TokenPath string
+	// OAuth2TokenTTL the ttl of the access tokens
+	OAuth2TokenTTL time.Duration
 
 	EnableWebSocket bool
+	// EnableExecHeathCheck enable the heath checks that run the commands on the proxy
+	EnableExecHeathCheck bool
 }
 
 // Cfg proxy config
 type Cfg struct {
-	Addr      string
-	AddrRPC   string
-	AddrStore string
-	AddrPPROF string
-	Namespace string
-	TTLProxy  int64
-	Filers    []*FilterSpec
+	Addr         string
+	AddrV6       string
+	AddrInternal string
+	V6Only       bool
+	AddrRPC      string
+	AddrStore    string
+	AddrPPROF    string
+	Namespace    string
+	TTLProxy     int64
+	Labels       []metapb.PairValue
+	Filers       []*FilterSpec
 
 	Option *Option
 	Metric *util.MetricCfg
@@ -78,3 +155,25 @@ func ParseFilter(filter string) (*FilterSpec, error) {
 		return nil, fmt.Errorf("error format: %s", filter)
 	}
 }
+
+// ParseLabels returns the proxy labels, format is name=value[,name=value]
+func ParseLabels(value string) ([]metapb.PairValue, error) {
+	var labels []metapb.PairValue
+	if value == "" {
+		return labels, nil
+	}
+
+	for _, label := range strings.Split(value, ",") {
+		pair := strings.SplitN(label, "=", 2)
+		if len(pair) != 2 || pair[0] == "" {
+			return nil, fmt.Errorf("error label format: %s", label)
+		}
+
+		labels = append(labels, metapb.PairValue{
+			Name:  pair[0],
+			Value: pair[1],
+		})
+	}
+
+	return labels, nil
+}
@@ -2,8 +2,12 @@ package proxy
 
 import (
 	"context"
+	"fmt"
+	"net"
+	"sync/atomic"
 	"time"
 
+	pbutil "github.com/fagongzi/gateway/pkg/pb"
 	"github.com/fagongzi/gateway/pkg/pb/metapb"
 	"github.com/fagongzi/gateway/pkg/util"
 	"github.com/fagongzi/log"
@@ -66,20 +70,22 @@ func (r *dispatcher) check(id uint64) {
 	}()
 
 	prev := svr.status
+	svr.lastCheckAt = time.Now().Unix()
 
-	if svr.meta.HeathCheck == nil {
+	if svr.meta.Drained {
+		// the drained server is removed from the clusters until it's undrained
+		svr.changeTo(metapb.Down)
+	} else if svr.meta.HeathCheck == nil {
 		log.Warnf("server <%d> heath check not setting", svr.meta.ID)
 		svr.changeTo(metapb.Up)
 	} else {
-
-		if r.doCheck(svr) {
-			svr.changeTo(metapb.Up)
-		} else {
-			svr.changeTo(metapb.Down)
-		}
+		svr.checked(r.doCheck(svr))
 	}
 
+	svr.updateScore(r.analysiser)
+
 	if prev != svr.status {
+		r.publishHealth(svr, prev)
 		clusters, ok := r.binds[svr.meta.ID]
 
 		if svr.status == metapb.Up {
@@ -105,6 +111,35 @@ func (r *dispatcher) check(id uint64) {
 }
 
 func (r *dispatcher) doCheck(svr *serverRuntime) bool {
+	reason := r.checkServer(svr)
+	if reason != "" {
+		log.Warnf("server <%d, %s, %s, %d> check failed, %s",
+			svr.meta.ID,
+			svr.meta.Addr,
+			svr.meta.HeathCheck.Path,
+			svr.checkFailCount+1,
+			reason)
+		svr.fail()
+		svr.lastFailure = reason
+		return false
+	}
+
+	svr.reset()
+	svr.lastFailure = ""
+	return true
+}
+
+// checkServer send the heath check request to the server, returns the failure reason
+func (r *dispatcher) checkServer(svr *serverRuntime) string {
+	switch svr.meta.HeathCheck.Type {
+	case metapb.TCPCheck:
+		return r.checkServerConn(svr)
+	case metapb.ExecCheck:
+		return r.checkServerExec(svr)
+	case metapb.CheckerCheck:
+		return r.checkServerByChecker(svr)
+	}
+
 	req := fasthttp.AcquireRequest()
 	defer fasthttp.ReleaseRequest(req)
 
@@ -115,37 +150,143 @@ func (r *dispatcher) doCheck(svr *serverRuntime) bool {
 	resp, err := r.httpClient.Do(req, svr.meta.Addr, opt)
 	defer fasthttp.ReleaseResponse(resp)
 	if err != nil {
-		log.Warnf("server <%d, %s, %d> check failed, errors:\n%+v",
-			svr.meta.ID,
-			svr.meta.HeathCheck.Path,
-			svr.checkFailCount+1,
-			err)
-		svr.fail()
-		return false
+		return err.Error()
 	}
 
 	if fasthttp.StatusOK != resp.StatusCode() {
-		log.Warnf("server <%d, %s, %d, %d> check failed",
-			svr.meta.ID,
-			svr.meta.HeathCheck.Path,
-			resp.StatusCode(),
-			svr.checkFailCount+1)
-		svr.fail()
-		return false
+		return fmt.Sprintf("unexpect status code %d", resp.StatusCode())
 	}
 
 	if svr.meta.HeathCheck.Body != "" &&
 		svr.meta.HeathCheck.Body != string(resp.Body()) {
-		log.Warnf("server <%s, %s, %d> check failed, body <%s>, expect <%s>",
-			svr.meta.Addr,
-			svr.meta.HeathCheck.Path,
-			svr.checkFailCount+1,
+		return fmt.Sprintf("unexpect response body <%s>, expect <%s>",
 			resp.Body(),
 			svr.meta.HeathCheck.Body)
-		svr.fail()
-		return false
 	}
 
-	svr.reset()
-	return true
+	return ""
+}
+
+// checkServerConn connect to the server as the tcp heath check, returns the failure reason
+func (r *dispatcher) checkServerConn(svr *serverRuntime) string {
+	conn, err := net.DialTimeout("tcp", svr.meta.Addr, heathCheckTimeout(svr.meta.HeathCheck))
+	if err != nil {
+		return err.Error()
+	}
+
+	conn.Close()
+	return ""
+}
+
+// publishHealth publish the heath check status transition of the server to the store in background,
+// the transition is dropped if the publishing is too slow
+func (r *dispatcher) publishHealth(svr *serverRuntime, prev metapb.Status) {
+	value := &metapb.HealthTransition{
+		ServerID: svr.meta.ID,
+		Addr:     svr.meta.Addr,
+		Proxy:    r.cnf.Addr,
+		From:     prev,
+		To:       svr.status,
+		Reason:   svr.lastFailure,
+		At:       svr.lastCheckAt,
+	}
+
+	select {
+	case r.healthC <- value:
+	default:
+		log.Warnf("server <%d> health transition %s -> %s dropped",
+			value.ServerID,
+			value.From.String(),
+			value.To.String())
+	}
+}
+
+func (r *dispatcher) readyToPublishHealth() {
+	r.runner.RunCancelableTask(func(ctx context.Context) {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case value := <-r.healthC:
+				err := r.store.PutHealthTransition(value)
+				if err != nil {
+					log.Errorf("server <%d> publish health transition failed, errors:\n%+v",
+						value.ServerID,
+						err)
+				}
+			}
+		}
+	})
+}
+
+// probeServer send a synthetic probe request to the half-open server,
+// the circuit change to open if succeed, otherwise change to close
+func (r *dispatcher) probeServer(svr *serverRuntime) {
+	go func() {
+		reason := r.checkServer(svr)
+		if reason != "" {
+			log.Warnf("server <%d> half-open probe failed, %s",
+				svr.meta.ID,
+				reason)
+			svr.circuitToClose()
+			return
+		}
+
+		log.Infof("server <%d> half-open probe succeed",
+			svr.meta.ID)
+		svr.circuitToOpen()
+	}()
+}
+
+func (r *dispatcher) serversHealth(proxy string) []*metapb.ServerHealth {
+	r.RLock()
+	defer r.RUnlock()
+
+	values := make([]*metapb.ServerHealth, 0, len(r.servers))
+	for _, svr := range r.servers {
+		values = append(values, svr.health(proxy, r.analysiser))
+	}
+
+	return values
+}
+
+func (r *dispatcher) readyToRefreshHealthScore() {
+	r.runner.RunCancelableTask(func(ctx context.Context) {
+		ticker := time.NewTicker(time.Second)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				now := time.Now()
+				r.RLock()
+				for _, svr := range r.servers {
+					svr.updateScore(r.analysiser)
+					svr.updateWeight(now)
+				}
+				r.RUnlock()
+			}
+		}
+	})
+}
+
+// serverWeight returns the health score multiplied by the weight of the server, used by the load balance
+func (r *dispatcher) serverWeight(id uint64) int {
+	svr, ok := r.servers[id]
+	if !ok {
+		return 0
+	}
+
+	return int(svr.getScore()) * int(svr.getWeight())
+}
+
+// updateWeight update the weight of the server, the weight is ramping if the server set the ramp
+func (s *serverRuntime) updateWeight(now time.Time) {
+	atomic.StoreInt32(&s.weight, pbutil.ServerWeightAt(s.meta.Weight, now.Unix()))
+}
+
+func (s *serverRuntime) getWeight() int32 {
+	return atomic.LoadInt32(&s.weight)
 }
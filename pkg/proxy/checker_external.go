@@ -0,0 +1,165 @@
+package proxy
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/fagongzi/gateway/pkg/pb/metapb"
+	"github.com/fagongzi/gateway/pkg/util"
+)
+
+const (
+	// maxHeathCheckOutput the max bytes of the output of the commands and the responses of the checkers
+	maxHeathCheckOutput = 4096
+	// maxHeathCheckReason the max length of the failure reason that logged and published
+	maxHeathCheckReason = 256
+)
+
+var (
+	heathCheckerClient = &http.Client{}
+)
+
+// heathCheckerRequest is the json body that sent to the checker service
+type heathCheckerRequest struct {
+	ID       uint64 `json:"id"`
+	Addr     string `json:"addr"`
+	Protocol string `json:"protocol"`
+}
+
+// heathCheckerResult is the json response of the checker service
+type heathCheckerResult struct {
+	Healthy bool   `json:"healthy"`
+	Reason  string `json:"reason"`
+}
+
+func heathCheckTimeout(check *metapb.HeathCheck) time.Duration {
+	if check.Timeout <= 0 {
+		return util.DefaultHTTPOption().ReadTimeout
+	}
+
+	return time.Duration(check.Timeout)
+}
+
+// checkServerExec run the command as the heath check, the server is passed by the environment variables
+// GATEWAY_SERVER_ID, GATEWAY_SERVER_ADDR and GATEWAY_SERVER_PROTOCOL, the output of the failed command
+// is the failure reason
+func (r *dispatcher) checkServerExec(svr *serverRuntime) string {
+	if !r.cnf.Option.EnableExecHeathCheck {
+		return "exec heath check is disabled"
+	}
+
+	check := svr.meta.HeathCheck
+	ctx, cancel := context.WithTimeout(context.Background(), heathCheckTimeout(check))
+	defer cancel()
+
+	output := &limitedBuffer{limit: maxHeathCheckOutput}
+	cmd := exec.CommandContext(ctx, check.Command[0], check.Command[1:]...)
+	cmd.Env = append(os.Environ(),
+		"GATEWAY_SERVER_ID="+strconv.FormatUint(svr.meta.ID, 10),
+		"GATEWAY_SERVER_ADDR="+svr.meta.Addr,
+		"GATEWAY_SERVER_PROTOCOL="+svr.meta.Protocol.String())
+	cmd.Stdout = output
+	cmd.Stderr = output
+
+	err := cmd.Run()
+	if ctx.Err() == context.DeadlineExceeded {
+		return "heath check command timeout"
+	}
+	if err != nil {
+		if reason := heathCheckReason(output.String()); reason != "" {
+			return fmt.Sprintf("%s: %s", err, reason)
+		}
+		return err.Error()
+	}
+
+	return ""
+}
+
+// checkServerByChecker post the server to the checker service as the heath check, the checker returns the
+// health of the server as a json, the errors and the non-200 responses are failures
+func (r *dispatcher) checkServerByChecker(svr *serverRuntime) string {
+	check := svr.meta.HeathCheck
+	data, err := json.Marshal(&heathCheckerRequest{
+		ID:       svr.meta.ID,
+		Addr:     svr.meta.Addr,
+		Protocol: svr.meta.Protocol.String(),
+	})
+	if err != nil {
+		return err.Error()
+	}
+
+	req, err := http.NewRequest(http.MethodPost, check.Checker, bytes.NewReader(data))
+	if err != nil {
+		return err.Error()
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	ctx, cancel := context.WithTimeout(context.Background(), heathCheckTimeout(check))
+	defer cancel()
+
+	rsp, err := heathCheckerClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return err.Error()
+	}
+	defer rsp.Body.Close()
+
+	body, err := ioutil.ReadAll(io.LimitReader(rsp.Body, maxHeathCheckOutput))
+	if err != nil {
+		return err.Error()
+	}
+
+	if rsp.StatusCode != http.StatusOK {
+		return fmt.Sprintf("checker returns status code %d", rsp.StatusCode)
+	}
+
+	result := &heathCheckerResult{}
+	if err := json.Unmarshal(body, result); err != nil {
+		return fmt.Sprintf("error checker response <%s>", heathCheckReason(string(body)))
+	}
+
+	if !result.Healthy {
+		if reason := heathCheckReason(result.Reason); reason != "" {
+			return reason
+		}
+		return "unhealthy by checker"
+	}
+
+	return ""
+}
+
+func heathCheckReason(value string) string {
+	value = strings.TrimSpace(value)
+	if len(value) > maxHeathCheckReason {
+		value = value[:maxHeathCheckReason]
+	}
+
+	return value
+}
+
+// limitedBuffer keep the first limit bytes of the written data, the rest are dropped
+type limitedBuffer struct {
+	bytes.Buffer
+	limit int
+}
+
+func (b *limitedBuffer) Write(p []byte) (int, error) {
+	if n := b.limit - b.Len(); n > 0 {
+		if len(p) > n {
+			b.Buffer.Write(p[:n])
+		} else {
+			b.Buffer.Write(p)
+		}
+	}
+
+	return len(p), nil
+}
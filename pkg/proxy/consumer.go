@@ -0,0 +1,131 @@
+package proxy
+
+import (
+	"sync"
+	"time"
+
+	"github.com/fagongzi/gateway/pkg/pb/metapb"
+)
+
+const (
+	usageDayFormat = "2006-01-02"
+)
+
+type consumerRuntime struct {
+	meta *metapb.Consumer
+	// expires is the expire time of the keys, key is the hash of the api key
+	expires map[string]int64
+	// allowed is the apis that the restricted consumer can call
+	allowed map[uint64]struct{}
+}
+
+func newConsumerRuntime(meta *metapb.Consumer) *consumerRuntime {
+	c := &consumerRuntime{
+		meta:    meta,
+		expires: make(map[string]int64),
+		allowed: make(map[uint64]struct{}),
+	}
+
+	for _, key := range meta.Keys {
+		c.expires[key.Hash] = key.ExpireAt
+	}
+
+	for _, id := range meta.AllowedAPIs {
+		c.allowed[id] = struct{}{}
+	}
+
+	return c
+}
+
+// isAPIAllowed returns true if the consumer can call the api, the consumer that not restricted can call
+// all apis
+func (c *consumerRuntime) isAPIAllowed(id uint64) bool {
+	if !c.meta.RestrictAPIs {
+		return true
+	}
+
+	_, ok := c.allowed[id]
+	return ok
+}
+
+func (c *consumerRuntime) isKeyExpired(hash string, now int64) bool {
+	expireAt := c.expires[hash]
+	return expireAt > 0 && expireAt <= now
+}
+
+type usageKey struct {
+	consumer, api uint64
+}
+
+// consumerUsage is the requests of the consumers to the apis in the current day on this proxy,
+// the counters are reset when the day changes, and the counters of the previous day are kept
+// until the next day, so that the api server can collect the whole day
+type consumerUsage struct {
+	sync.Mutex
+
+	epoch    int64
+	day      string
+	totals   map[uint64]int64
+	requests map[usageKey]int64
+	prevDay  string
+	prev     map[usageKey]int64
+}
+
+func newConsumerUsage() *consumerUsage {
+	return &consumerUsage{
+		epoch:    time.Now().UnixNano(),
+		totals:   make(map[uint64]int64),
+		requests: make(map[usageKey]int64),
+	}
+}
+
+// incr increase the requests of the consumer to the api, returns false if the quota is exceeded. The quota
+// is checked against the requests on this proxy only, it's not shared with the other proxies
+func (u *consumerUsage) incr(id, api uint64, quota int64, now time.Time) bool {
+	u.Lock()
+	defer u.Unlock()
+
+	u.rotate(now)
+	if quota > 0 && u.totals[id] >= quota {
+		return false
+	}
+
+	u.totals[id]++
+	u.requests[usageKey{id, api}]++
+	return true
+}
+
+func (u *consumerUsage) usages(now time.Time) []*metapb.ConsumerUsage {
+	u.Lock()
+	defer u.Unlock()
+
+	u.rotate(now)
+	values := make([]*metapb.ConsumerUsage, 0, len(u.requests)+len(u.prev))
+	values = u.appendUsages(values, u.prevDay, u.prev)
+	values = u.appendUsages(values, u.day, u.requests)
+	return values
+}
+
+func (u *consumerUsage) appendUsages(values []*metapb.ConsumerUsage, day string, requests map[usageKey]int64) []*metapb.ConsumerUsage {
+	for key, value := range requests {
+		values = append(values, &metapb.ConsumerUsage{
+			Consumer: key.consumer,
+			API:      key.api,
+			Day:      day,
+			Epoch:    u.epoch,
+			Requests: value,
+		})
+	}
+
+	return values
+}
+
+func (u *consumerUsage) rotate(now time.Time) {
+	day := now.Format(usageDayFormat)
+	if day != u.day {
+		u.prevDay, u.prev = u.day, u.requests
+		u.day = day
+		u.totals = make(map[uint64]int64)
+		u.requests = make(map[usageKey]int64)
+	}
+}
@@ -0,0 +1,87 @@
+package proxy
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/fagongzi/gateway/pkg/pb/metapb"
+	"github.com/fagongzi/gateway/pkg/util"
+	"github.com/fagongzi/util/hack"
+	"github.com/valyala/fasthttp"
+)
+
+const (
+	grpcTimeoutHeader = "Grpc-Timeout"
+)
+
+var (
+	// ErrDeadlineExceeded the deadline of the request exceeded before sent to the backend
+	ErrDeadlineExceeded = errors.New("request deadline exceeded")
+	// ErrUpstreamTimeout the request to the backend timed out
+	ErrUpstreamTimeout = errors.New("upstream timeout")
+)
+
+// remainingTimeout returns the remaining time of the request, it is the read timeout of the
+// dispatch node minus the elapsed time in the gateway, and not greater than the overall timeout of
+// the api and the timeout that propagated by the client in the deadline header. Returns false if the
+// request has no deadline
+func (p *Proxy) remainingTimeout(dn *dispathNode, now time.Time) (time.Duration, bool) {
+	elapsed := now.Sub(dn.ctx.Time())
+	remaining, ok := dn.httpOption().ReadTimeout-elapsed, dn.httpOption().ReadTimeout > 0
+
+	if overall, has := dn.api.overallRemaining(dn.ctx, now); has && (!ok || overall < remaining) {
+		remaining, ok = overall, true
+	}
+
+	value := dn.ctx.Request.Header.Peek(p.cfg.Option.DeadlineHeader)
+	if ms, err := strconv.ParseInt(hack.SliceToString(value), 10, 64); err == nil && ms >= 0 {
+		client := time.Duration(ms)*time.Millisecond - elapsed
+		if !ok || client < remaining {
+			remaining, ok = client, true
+		}
+	}
+
+	return remaining, ok
+}
+
+// setDeadlineHeaders propagate the remaining time to the backend, the grpc backends use grpc-timeout
+func (p *Proxy) setDeadlineHeaders(req *fasthttp.Request, svr *serverRuntime, remaining time.Duration) {
+	ms := int64(remaining / time.Millisecond)
+	req.Header.Set(p.cfg.Option.DeadlineHeader, strconv.FormatInt(ms, 10))
+	if svr.meta.Protocol == metapb.Grpc {
+		req.Header.Set(grpcTimeoutHeader, fmt.Sprintf("%dm", ms))
+	}
+}
+
+// upstreamTimeoutError is the backend timeout with the phase that timed out, and the durations
+// of the phases of the request
+type upstreamTimeoutError struct {
+	trace util.HTTPTrace
+	err   error
+}
+
+func (e *upstreamTimeoutError) Error() string {
+	return fmt.Sprintf("%s in %s phase (%s): %s", ErrUpstreamTimeout, e.trace.Phase, e.trace.String(), e.err)
+}
+
+func (e *upstreamTimeoutError) contentType() string {
+	return "application/json"
+}
+
+func (e *upstreamTimeoutError) body() []byte {
+	durations := make(map[string]int64)
+	for _, phase := range util.Phases() {
+		durations[phase.String()] = int64(e.trace.Durations[phase] / time.Millisecond)
+	}
+
+	data, _ := json.Marshal(&struct {
+		Code      int              `json:"code"`
+		Message   string           `json:"message"`
+		Phase     string           `json:"phase"`
+		Durations map[string]int64 `json:"durations"`
+	}{fasthttp.StatusGatewayTimeout, ErrUpstreamTimeout.Error(), e.trace.Phase.String(), durations})
+	return data
+}
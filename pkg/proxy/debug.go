@@ -0,0 +1,74 @@
+package proxy
+
+import (
+	"crypto/subtle"
+	"strconv"
+	"strings"
+
+	"github.com/valyala/fasthttp"
+)
+
+const (
+	debugHeader        = "X-Gateway-Debug"
+	debugAPIHeader     = "X-Gateway-Debug-API"
+	debugServerHeader  = "X-Gateway-Debug-Server"
+	debugLBHeader      = "X-Gateway-Debug-LB"
+	debugRetriesHeader = "X-Gateway-Debug-Retries"
+	debugCircuitHeader = "X-Gateway-Debug-Circuit"
+
+	// debugAffinity is the lb of the dispatch node that selected by the affinity key
+	debugAffinity = "Affinity"
+	debugNone     = "-"
+)
+
+// isDebug returns true if the api enables debug or the request has the debug secret
+func (p *Proxy) isDebug(ctx *fasthttp.RequestCtx, api *apiRuntime) bool {
+	if api.meta.Debug {
+		return true
+	}
+
+	return p.cfg.Option.DebugSecret != "" &&
+		subtle.ConstantTimeCompare(ctx.Request.Header.Peek(debugHeader), []byte(p.cfg.Option.DebugSecret)) == 1
+}
+
+// setDebugHeaders set the routing decisions to the response, the values of the dispatch nodes are
+// joined by comma in the order of the nodes, "-" means the node is not sent to a server
+func setDebugHeaders(header *fasthttp.ResponseHeader, api *apiRuntime, dispatches []*dispathNode) {
+	header.Set(debugAPIHeader, api.meta.Name)
+	if len(dispatches) == 0 {
+		return
+	}
+
+	servers := make([]string, 0, len(dispatches))
+	lbs := make([]string, 0, len(dispatches))
+	retries := make([]string, 0, len(dispatches))
+	circuits := make([]string, 0, len(dispatches))
+	for _, dn := range dispatches {
+		retries = append(retries, strconv.Itoa(int(dn.retries)))
+
+		switch {
+		case dn.dest == nil:
+			lbs = append(lbs, debugNone)
+		case dn.affinity != "":
+			lbs = append(lbs, debugAffinity)
+		case dn.cluster != nil:
+			lbs = append(lbs, dn.cluster.LoadBalance.String())
+		default:
+			lbs = append(lbs, debugNone)
+		}
+
+		if dn.dest == nil {
+			servers = append(servers, debugNone)
+			circuits = append(circuits, debugNone)
+			continue
+		}
+
+		servers = append(servers, dn.dest.meta.Addr)
+		circuits = append(circuits, dn.dest.getCircuitStatus().String())
+	}
+
+	header.Set(debugServerHeader, strings.Join(servers, ", "))
+	header.Set(debugLBHeader, strings.Join(lbs, ", "))
+	header.Set(debugRetriesHeader, strings.Join(retries, ", "))
+	header.Set(debugCircuitHeader, strings.Join(circuits, ", "))
+}
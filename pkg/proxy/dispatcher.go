@@ -1,6 +1,13 @@
 package proxy
 
 import (
+	"bytes"
+	"io"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -20,6 +27,8 @@ type copyReq struct {
 	to         *serverRuntime
 	idx        int
 	requestTag string
+	// key the analysis key of the mirrored requests, the mirrored requests have no node
+	key uint64
 }
 
 func (req *copyReq) prepare() {
@@ -39,7 +48,7 @@ func (req *copyReq) prepare() {
 }
 
 func (req *copyReq) needRewrite() bool {
-	return req.node.meta.URLRewrite != ""
+	return req.node != nil && req.node.meta.URLRewrite != ""
 }
 
 func (req *copyReq) rewiteURL() string {
@@ -49,6 +58,7 @@ func (req *copyReq) rewiteURL() string {
 type dispathNode struct {
 	rd       *render
 	ctx      *fasthttp.RequestCtx
+	span     *span
 	multiCtx *multiContext
 	wg       *sync.WaitGroup
 
@@ -57,17 +67,60 @@ type dispathNode struct {
 	api                  *apiRuntime
 	node                 *apiNode
 	dest                 *serverRuntime
+	cluster              *metapb.Cluster
+	probe                *halfOpenProbe
 	copyTo               *serverRuntime
+	routing              *routingRuntime
+	stable               *routingRuntime
 	res                  *fasthttp.Response
+	stream               io.ReadCloser
+	body                 *spilledBody
+	affinity             string
+	retries              int32
 	cachedBody, cachedCT []byte
 	err                  error
 	code                 int
+	// filtered the err is returned by the filters
+	filtered bool
 }
 
 func (dn *dispathNode) reset() {
 	*dn = emptyDispathNode
 }
 
+// failureKind returns the class of the failure of the node, false if the node is not failed. The 4xx
+// responses of the backends are not failures
+func (dn *dispathNode) failureKind() (util.FailureKind, bool) {
+	if dn.err == nil {
+		return util.FailureUpstream5xx, dn.code >= fasthttp.StatusInternalServerError
+	}
+
+	if strings.HasPrefix(dn.err.Error(), ErrPrefixRequestCancel) {
+		return util.FailureClientAbort, true
+	}
+
+	switch dn.err {
+	case ErrDeadlineExceeded, ErrUpstreamTimeout:
+		return util.FailureUpstreamTimeout, true
+	case ErrNoServer, ErrCircuitClose, ErrCircuitHalfLimited, ErrRateLimited, ErrQuotaExceeded,
+		ErrRewriteNotMatch, ErrResponseHeadersTooLarge, ErrResponseBodyTooLarge:
+		return util.FailureGatewayReject, true
+	}
+
+	if dn.filtered {
+		return util.FailureFilterDenied, true
+	}
+
+	if _, ok := dn.err.(*upstreamTimeoutError); ok {
+		return util.FailureUpstreamTimeout, true
+	}
+	if err, ok := dn.err.(net.Error); ok && err.Timeout() {
+		return util.FailureUpstreamTimeout, true
+	}
+
+	return util.FailureUpstreamRefused, true
+}
+
 func (dn *dispathNode) hasRetryStrategy() bool {
 	return dn.retryStrategy() != nil
 }
@@ -82,18 +135,93 @@ func (dn *dispathNode) matchRetryStrategy(target int32) bool {
 	return false
 }
 
-func (dn *dispathNode) matchAllRetryStrategy() bool {
-	return len(dn.retryStrategy().Codes) == 0
+// matchRetryOn returns true if the failed request should be retried, the strategy without the codes and
+// the connection errors retries all failures
+func (dn *dispathNode) matchRetryOn(res *fasthttp.Response, err error) bool {
+	retry := dn.retryStrategy()
+	if len(retry.Codes) == 0 && !retry.ConnectionErrors {
+		return true
+	}
+
+	if err != nil {
+		return retry.ConnectionErrors
+	}
+
+	return dn.matchRetryStrategy(int32(res.StatusCode()))
 }
 
+// retryBackoff returns the wait before the retry(from 1), the interval is doubled for each retry up to the
+// max interval if the max interval is set, the jitter randomizes the wait in [wait/2, wait]
+func (dn *dispathNode) retryBackoff(n int) time.Duration {
+	retry := dn.retryStrategy()
+	wait := int64(retry.Interval)
+	if retry.MaxInterval > 0 {
+		for i := 1; i < n && wait < int64(retry.MaxInterval); i++ {
+			wait <<= 1
+		}
+
+		if wait > int64(retry.MaxInterval) {
+			wait = int64(retry.MaxInterval)
+		}
+	}
+
+	wait *= int64(time.Millisecond)
+	if retry.Jitter && wait > 1 {
+		wait = wait/2 + rand.Int63n(wait/2+1)
+	}
+
+	return time.Duration(wait)
+}
+
+// httpOption returns the http option of the node, the connections are recycled by the cluster
 func (dn *dispathNode) httpOption() *util.HTTPOption {
-	return &dn.node.httpOption
+	if dn.cluster == nil || dn.cluster.ConnRecycle == nil {
+		return &dn.node.httpOption
+	}
+
+	r := dn.cluster.ConnRecycle
+	value := dn.node.httpOption
+	if r.MaxLifetime > 0 {
+		value.MaxConnDuration = time.Duration(r.MaxLifetime)
+	}
+	value.MaxConnRequests = int(r.MaxRequests)
+	return &value
 }
 
 func (dn *dispathNode) retryStrategy() *metapb.RetryStrategy {
 	return dn.node.meta.RetryStrategy
 }
 
+// cache returns the response cache of the node, the nodes without cache use the cache of the api
+func (dn *dispathNode) cache() *metapb.Cache {
+	if dn.node.meta.Cache != nil {
+		return dn.node.meta.Cache
+	}
+
+	return dn.api.meta.Cache
+}
+
+// upstreamHost returns the host header that sent to the server, the api option overrides the cluster option
+func (dn *dispathNode) upstreamHost() string {
+	host := dn.api.meta.UpstreamHost
+	if host == nil && dn.cluster != nil {
+		host = dn.cluster.UpstreamHost
+	}
+
+	if host != nil {
+		switch host.Type {
+		case metapb.ClientHost:
+			if value := dn.ctx.Request.Header.Host(); len(value) > 0 {
+				return string(value)
+			}
+		case metapb.FixedHost:
+			return host.Value
+		}
+	}
+
+	return dn.dest.meta.Addr
+}
+
 func (dn *dispathNode) hasError() bool {
 	return dn.err != nil ||
 		dn.code >= fasthttp.StatusBadRequest
@@ -107,6 +235,10 @@ func (dn *dispathNode) release() {
 	if nil != dn.res {
 		fasthttp.ReleaseResponse(dn.res)
 	}
+
+	if nil != dn.stream {
+		dn.stream.Close()
+	}
 }
 
 func (dn *dispathNode) needRewrite() bool {
@@ -134,9 +266,12 @@ func (dn *dispathNode) getResponseBody() []byte {
 		return dn.cachedBody
 	}
 
-	if dn.node.meta.UseDefault ||
-		(dn.hasError() && dn.hasDefaultValue()) {
-		return dn.node.meta.DefaultValue.Body
+	if dn.node.meta.UseDefault {
+		return dn.defaultBody()
+	}
+
+	if dn.hasError() && dn.hasDefaultValue() {
+		return dn.fallbackBody()
 	}
 
 	if nil != dn.res {
@@ -146,6 +281,34 @@ func (dn *dispathNode) getResponseBody() []byte {
 	return nil
 }
 
+// defaultBody returns the default value of the node, the templates are executed with the request
+func (dn *dispathNode) defaultBody() []byte {
+	var req *fasthttp.Request
+	if dn.ctx != nil {
+		req = &dn.ctx.Request
+	}
+
+	return applyTemplateBytes(dn.node.meta.DefaultValue.Body, dn.api, req)
+}
+
+// fallbackBody returns the default value of the failed node, the $code and $message in the body
+// are replaced with the failure
+func (dn *dispathNode) fallbackBody() []byte {
+	body := dn.defaultBody()
+	if bytes.IndexByte(body, '$') < 0 {
+		return body
+	}
+
+	code := dn.code
+	if code == 0 {
+		code = fasthttp.StatusInternalServerError
+	}
+
+	replacer := strings.NewReplacer("$code", strconv.Itoa(code),
+		"$message", http.StatusText(code))
+	return []byte(replacer.Replace(string(body)))
+}
+
 func (dn *dispathNode) copyHeaderTo(ctx *fasthttp.RequestCtx) {
 	if dn.node.meta.UseDefault ||
 		(dn.hasError() && dn.hasDefaultValue()) {
@@ -180,14 +343,34 @@ type dispatcher struct {
 	cnf           *Cfg
 	routings      map[uint64]*routingRuntime
 	apis          map[uint64]*apiRuntime
+	templates     map[uint64]*metapb.APITemplate
+	policy        *metapb.Policy
+	ipAccess      *ipAccessControl
 	apiSortedKeys []uint64
 	clusters      map[uint64]*clusterRuntime
 	servers       map[uint64]*serverRuntime
 	binds         map[uint64]map[uint64]*clusterRuntime
 	proxies       map[string]*metapb.Proxy
+	overrides     map[uint64]*metapb.ProxyOverride
+	override      *overrideRuntime
+	killLock      sync.RWMutex
+	killSwitch    *killSwitchRuntime
+	killWatched   bool
+	consumers     map[uint64]*consumerRuntime
+	apiKeys       map[string]*consumerRuntime
+	rateLimits    map[uint64]*rateLimitRuntime
+	protos        map[uint64]*protoDescriptorRuntime
+	usage         *consumerUsage
+	oauth2        *oauth2Issuer
+	heatmap       *latencyHeatmap
+	propagation   *configPropagation
+	originLevel   log.Level
 	checkerC      chan uint64
+	healthC       chan *metapb.HealthTransition
 	watchStopC    chan bool
 	watchEventC   chan *store.Evt
+	killEventC    chan *store.Evt
+	resyncC       chan struct{}
 	analysiser    *util.Analysis
 	store         store.Store
 	httpClient    *util.FastHTTPClient
@@ -207,16 +390,38 @@ func newDispatcher(cnf *Cfg, db store.Store, runner *task.Runner) *dispatcher {
 		clusters:      make(map[uint64]*clusterRuntime),
 		servers:       make(map[uint64]*serverRuntime),
 		apis:          make(map[uint64]*apiRuntime),
+		templates:     make(map[uint64]*metapb.APITemplate),
 		apiSortedKeys: make([]uint64, 0),
 		routings:      make(map[uint64]*routingRuntime),
 		binds:         make(map[uint64]map[uint64]*clusterRuntime),
 		proxies:       make(map[string]*metapb.Proxy),
+		overrides:     make(map[uint64]*metapb.ProxyOverride),
+		override:      newOverrideRuntime(),
+		consumers:     make(map[uint64]*consumerRuntime),
+		apiKeys:       make(map[string]*consumerRuntime),
+		rateLimits:    make(map[uint64]*rateLimitRuntime),
+		protos:        make(map[uint64]*protoDescriptorRuntime),
+		usage:         newConsumerUsage(),
+		oauth2:        newOAuth2Issuer(cnf.Option),
+		heatmap:       newLatencyHeatmap(),
+		propagation:   &configPropagation{},
+		originLevel:   log.GetLogLevel(),
 		checkerC:      make(chan uint64, 1024),
+		healthC:       make(chan *metapb.HealthTransition, 1024),
 		watchStopC:    make(chan bool),
 		watchEventC:   make(chan *store.Evt),
+		killEventC:    make(chan *store.Evt),
+		resyncC:       make(chan struct{}, 1),
 	}
 
+	rt.analysiser.EnableRecorder(cnf.Option.LimitCountAnalysisBuffer)
+	runner.RunCancelableTask(rt.analysiser.RunRecorder)
+	registerAnalysisDropped(rt.analysiser)
+	registerAnalysisCollector(rt)
+
 	rt.readyToHeathChecker()
+	rt.readyToRefreshHealthScore()
+	rt.readyToPublishHealth()
 	return rt
 }
 
@@ -224,14 +429,19 @@ func (r *dispatcher) dispatchCompleted() {
 	r.RUnlock()
 }
 
-func (r *dispatcher) dispatch(req *fasthttp.Request, requestTag string) (*apiRuntime, []*dispathNode) {
+func (r *dispatcher) dispatch(ctx *fasthttp.RequestCtx, requestTag string) (*apiRuntime, []*dispathNode) {
 	r.RLock()
 
+	req := &ctx.Request
 	var targetAPI *apiRuntime
 	var dispathes []*dispathNode
 	for _, apiKey := range r.apiSortedKeys {
+		if !r.override.isAPIEnabled(apiKey) {
+			continue
+		}
+
 		api := r.apis[apiKey]
-		if api.matches(req) {
+		if api.matches(req) && api.isReachable(ctx) {
 			targetAPI = api
 			if api.meta.UseDefault {
 				log.Debugf("%s: match api %s, and use default force",
@@ -256,24 +466,45 @@ func (r *dispatcher) dispatch(req *fasthttp.Request, requestTag string) (*apiRun
 }
 
 func (r *dispatcher) selectServer(req *fasthttp.Request, dn *dispathNode, requestTag string) {
-	dn.dest = r.selectServerFromCluster(req, dn.node.meta.ClusterID)
+	// the affinity key is kept by the retries, the request body may be spilled after the first selection
+	if param := dn.node.meta.Affinity; param != nil && dn.affinity == "" {
+		dn.affinity = paramValue(param, req)
+	}
+
+	dn.dest, dn.cluster, dn.probe = r.selectServerFromCluster(req, dn.node.meta.ClusterID, dn.affinity)
 	r.adjustByRouting(dn.api.meta.ID, req, dn, requestTag)
 }
 
+// adjustByRouting send the request to the cluster of the matched routing, the request that matches the
+// conditions of a split routing but not selected by the traffic rate is the stable variant of the routing
 func (r *dispatcher) adjustByRouting(apiID uint64, req *fasthttp.Request, dn *dispathNode, requestTag string) {
+	dn.routing = nil
+	dn.stable = nil
 	for _, routing := range r.routings {
-		if routing.isUp() && routing.matches(apiID, req, requestTag) {
+		if !routing.isUp() {
+			continue
+		}
+
+		matched, allowed := routing.matches(apiID, req, requestTag)
+		if matched && !allowed && routing.isCanary() && dn.stable == nil {
+			dn.stable = routing
+		}
+
+		if allowed {
 			log.Infof("%s: match routing %s, %s traffic to cluster %d",
 				requestTag,
 				routing.meta.Name,
 				routing.meta.Status.String(),
 				routing.meta.ClusterID)
 
-			svr := r.selectServerFromCluster(req, routing.meta.ClusterID)
+			svr, cluster, probe := r.selectServerFromCluster(req, routing.meta.ClusterID, dn.affinity)
+			dn.routing = routing
 
 			switch routing.meta.Strategy {
 			case metapb.Split:
 				dn.dest = svr
+				dn.cluster = cluster
+				dn.probe = probe
 			case metapb.Copy:
 				dn.copyTo = svr
 			}
@@ -282,12 +513,22 @@ func (r *dispatcher) adjustByRouting(apiID uint64, req *fasthttp.Request, dn *di
 	}
 }
 
-func (r *dispatcher) selectServerFromCluster(req *fasthttp.Request, id uint64) *serverRuntime {
+// selectStreamServer select a server of the cluster for the layer-4 connection
+func (r *dispatcher) selectStreamServer(id uint64) *serverRuntime {
+	r.RLock()
+	svr, _, _ := r.selectServerFromCluster(nil, id, "")
+	r.RUnlock()
+	return svr
+}
+
+// selectServerFromCluster select a server of the cluster by the load balance, or by the consistent
+// hash of the affinity key if not empty
+func (r *dispatcher) selectServerFromCluster(req *fasthttp.Request, id uint64, affinity string) (*serverRuntime, *metapb.Cluster, *halfOpenProbe) {
 	cluster, ok := r.clusters[id]
 	if !ok {
-		return nil
+		return nil, nil, nil
 	}
 
-	sid := cluster.selectServer(req)
-	return r.servers[sid]
+	sid := cluster.selectServer(req, affinity)
+	return r.servers[sid], cluster.meta, cluster.probe
 }
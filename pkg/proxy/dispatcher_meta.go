@@ -2,9 +2,11 @@ package proxy
 
 import (
 	"errors"
+	"reflect"
 	"sort"
 	"time"
 
+	pbutil "github.com/fagongzi/gateway/pkg/pb"
 	"github.com/fagongzi/gateway/pkg/pb/metapb"
 	"github.com/fagongzi/gateway/pkg/store"
 	"github.com/fagongzi/gateway/pkg/util"
@@ -13,31 +15,46 @@ import (
 )
 
 var (
-	errServerExists    = errors.New("Server already exist")
-	errClusterExists   = errors.New("Cluster already exist")
-	errBindExists      = errors.New("Bind already exist")
-	errAPIExists       = errors.New("API already exist")
-	errProxyExists     = errors.New("Proxy already exist")
-	errRoutingExists   = errors.New("Routing already exist")
-	errServerNotFound  = errors.New("Server not found")
-	errClusterNotFound = errors.New("Cluster not found")
-	errBindNotFound    = errors.New("Bind not found")
-	errProxyNotFound   = errors.New("Proxy not found")
-	errAPINotFound     = errors.New("API not found")
-	errRoutingNotFound = errors.New("Routing not found")
+	errServerExists     = errors.New("Server already exist")
+	errClusterExists    = errors.New("Cluster already exist")
+	errBindExists       = errors.New("Bind already exist")
+	errAPIExists        = errors.New("API already exist")
+	errProxyExists      = errors.New("Proxy already exist")
+	errRoutingExists    = errors.New("Routing already exist")
+	errServerNotFound   = errors.New("Server not found")
+	errClusterNotFound  = errors.New("Cluster not found")
+	errBindNotFound     = errors.New("Bind not found")
+	errProxyNotFound    = errors.New("Proxy not found")
+	errAPINotFound      = errors.New("API not found")
+	errRoutingNotFound  = errors.New("Routing not found")
+	errOverrideExists   = errors.New("Proxy override already exist")
+	errOverrideNotFound = errors.New("Proxy override not found")
+	errTemplateExists   = errors.New("API template already exist")
+	errTemplateNotFound = errors.New("API template not found")
+	errConsumerExists   = errors.New("Consumer already exist")
+	errConsumerNotFound = errors.New("Consumer not found")
 
 	limit = int64(32)
 )
 
 func (r *dispatcher) load() {
+	go r.watchKillSwitch()
+	r.loadKillSwitch()
+
 	go r.watch()
 
 	r.loadProxies()
 	r.loadClusters()
 	r.loadServers()
 	r.loadBinds()
+	r.loadAPITemplates()
+	r.loadProtoDescriptors()
+	r.loadPolicy()
 	r.loadAPIs()
 	r.loadRoutings()
+	r.loadProxyOverrides()
+	r.loadConsumers()
+	r.loadRateLimits()
 }
 
 func (r *dispatcher) loadProxies() {
@@ -118,6 +135,19 @@ func (r *dispatcher) loadBinds() {
 	}
 }
 
+func (r *dispatcher) loadAPITemplates() {
+	log.Infof("load api templates")
+
+	err := r.store.GetAPITemplates(limit, func(value interface{}) error {
+		return r.addAPITemplate(value.(*metapb.APITemplate))
+	})
+	if nil != err {
+		log.Errorf("load api templates failed, errors:\n%+v",
+			err)
+		return
+	}
+}
+
 func (r *dispatcher) loadAPIs() {
 	log.Infof("load apis")
 
@@ -135,6 +165,32 @@ func (r *dispatcher) loadAPIs() {
 	r.Unlock()
 }
 
+func (r *dispatcher) loadProxyOverrides() {
+	log.Infof("load proxy overrides")
+
+	err := r.store.GetProxyOverrides(limit, func(value interface{}) error {
+		return r.addProxyOverride(value.(*metapb.ProxyOverride))
+	})
+	if nil != err {
+		log.Errorf("load proxy overrides failed, errors:\n%+v",
+			err)
+		return
+	}
+}
+
+func (r *dispatcher) loadConsumers() {
+	log.Infof("load consumers")
+
+	err := r.store.GetConsumers(limit, func(value interface{}) error {
+		return r.addConsumer(value.(*metapb.Consumer))
+	})
+	if nil != err {
+		log.Errorf("load consumers failed, errors:\n%+v",
+			err)
+		return
+	}
+}
+
 func (r *dispatcher) watch() {
 	log.Info("router start watch meta data")
 
@@ -146,26 +202,49 @@ func (r *dispatcher) watch() {
 
 func (r *dispatcher) readyToReceiveWatchEvent() {
 	for {
-		evt := <-r.watchEventC
-
-		if evt.Src == store.EventSrcCluster {
-			r.doClusterEvent(evt)
-		} else if evt.Src == store.EventSrcServer {
-			r.doServerEvent(evt)
-		} else if evt.Src == store.EventSrcBind {
-			r.doBindEvent(evt)
-		} else if evt.Src == store.EventSrcAPI {
-			r.doAPIEvent(evt)
-		} else if evt.Src == store.EventSrcRouting {
-			r.doRoutingEvent(evt)
-		} else if evt.Src == store.EventSrcProxy {
-			r.doProxyEvent(evt)
-		} else {
-			log.Warnf("unknown event <%+v>", evt)
+		select {
+		case evt := <-r.watchEventC:
+			r.doEvent(evt)
+
+			if evt.WriteAt > 0 {
+				r.propagation.applied(evt.Revision, evt.WriteAt, time.Now())
+			}
+		case <-r.resyncC:
+			r.resync()
 		}
 	}
 }
 
+func (r *dispatcher) doEvent(evt *store.Evt) {
+	if evt.Src == store.EventSrcCluster {
+		r.doClusterEvent(evt)
+	} else if evt.Src == store.EventSrcServer {
+		r.doServerEvent(evt)
+	} else if evt.Src == store.EventSrcBind {
+		r.doBindEvent(evt)
+	} else if evt.Src == store.EventSrcAPI {
+		r.doAPIEvent(evt)
+	} else if evt.Src == store.EventSrcRouting {
+		r.doRoutingEvent(evt)
+	} else if evt.Src == store.EventSrcProxy {
+		r.doProxyEvent(evt)
+	} else if evt.Src == store.EventSrcProxyOverride {
+		r.doProxyOverrideEvent(evt)
+	} else if evt.Src == store.EventSrcAPITemplate {
+		r.doAPITemplateEvent(evt)
+	} else if evt.Src == store.EventSrcConsumer {
+		r.doConsumerEvent(evt)
+	} else if evt.Src == store.EventSrcPolicy {
+		r.doPolicyEvent(evt)
+	} else if evt.Src == store.EventSrcRateLimit {
+		r.doRateLimitEvent(evt)
+	} else if evt.Src == store.EventSrcProtoDescriptor {
+		r.doProtoDescriptorEvent(evt)
+	} else {
+		log.Warnf("unknown event <%+v>", evt)
+	}
+}
+
 func (r *dispatcher) doRoutingEvent(evt *store.Evt) {
 	routing, _ := evt.Value.(*metapb.Routing)
 
@@ -188,6 +267,42 @@ func (r *dispatcher) doProxyEvent(evt *store.Evt) {
 	}
 }
 
+func (r *dispatcher) doProxyOverrideEvent(evt *store.Evt) {
+	override, _ := evt.Value.(*metapb.ProxyOverride)
+
+	if evt.Type == store.EventTypeNew {
+		r.addProxyOverride(override)
+	} else if evt.Type == store.EventTypeDelete {
+		r.removeProxyOverride(format.MustParseStrUInt64(evt.Key))
+	} else if evt.Type == store.EventTypeUpdate {
+		r.updateProxyOverride(override)
+	}
+}
+
+func (r *dispatcher) doConsumerEvent(evt *store.Evt) {
+	consumer, _ := evt.Value.(*metapb.Consumer)
+
+	if evt.Type == store.EventTypeNew {
+		r.addConsumer(consumer)
+	} else if evt.Type == store.EventTypeDelete {
+		r.removeConsumer(format.MustParseStrUInt64(evt.Key))
+	} else if evt.Type == store.EventTypeUpdate {
+		r.updateConsumer(consumer)
+	}
+}
+
+func (r *dispatcher) doAPITemplateEvent(evt *store.Evt) {
+	tpl, _ := evt.Value.(*metapb.APITemplate)
+
+	if evt.Type == store.EventTypeNew {
+		r.addAPITemplate(tpl)
+	} else if evt.Type == store.EventTypeDelete {
+		r.removeAPITemplate(format.MustParseStrUInt64(evt.Key))
+	} else if evt.Type == store.EventTypeUpdate {
+		r.updateAPITemplate(tpl)
+	}
+}
+
 func (r *dispatcher) doAPIEvent(evt *store.Evt) {
 	api, _ := evt.Value.(*metapb.API)
 
@@ -242,7 +357,9 @@ func (r *dispatcher) addRouting(meta *metapb.Routing) error {
 		return errRoutingExists
 	}
 
-	r.routings[meta.ID] = newRoutingRuntime(meta)
+	rt := newRoutingRuntime(meta)
+	r.routings[meta.ID] = rt
+	r.addRoutingAnalysis(rt)
 	log.Infof("routing <%d> added, data <%s>",
 		meta.ID,
 		meta.String())
@@ -260,6 +377,7 @@ func (r *dispatcher) updateRouting(meta *metapb.Routing) error {
 	}
 
 	rt.updateMeta(meta)
+	r.addRoutingAnalysis(rt)
 	log.Infof("routing <%d> updated, data <%s>",
 		meta.ID,
 		meta.String())
@@ -276,6 +394,8 @@ func (r *dispatcher) removeRouting(id uint64) error {
 	}
 
 	delete(r.routings, id)
+	r.analysiser.RemoveTarget(id)
+	r.analysiser.RemoveTarget(shadowKey(id))
 	log.Infof("routing <%d> deleted",
 		id)
 
@@ -314,16 +434,260 @@ func (r *dispatcher) removeProxy(addr string) error {
 	return nil
 }
 
+func (r *dispatcher) addProxyOverride(meta *metapb.ProxyOverride) error {
+	r.Lock()
+	defer r.Unlock()
+
+	if _, ok := r.overrides[meta.ID]; ok {
+		return errOverrideExists
+	}
+
+	r.overrides[meta.ID] = meta
+	r.refreshOverride()
+	log.Infof("proxy override <%d> added, data <%s>",
+		meta.ID,
+		meta.String())
+
+	return nil
+}
+
+func (r *dispatcher) updateProxyOverride(meta *metapb.ProxyOverride) error {
+	r.Lock()
+	defer r.Unlock()
+
+	if _, ok := r.overrides[meta.ID]; !ok {
+		return errOverrideNotFound
+	}
+
+	r.overrides[meta.ID] = meta
+	r.refreshOverride()
+	log.Infof("proxy override <%d> updated, data <%s>",
+		meta.ID,
+		meta.String())
+
+	return nil
+}
+
+func (r *dispatcher) removeProxyOverride(id uint64) error {
+	r.Lock()
+	defer r.Unlock()
+
+	if _, ok := r.overrides[id]; !ok {
+		return errOverrideNotFound
+	}
+
+	delete(r.overrides, id)
+	r.refreshOverride()
+	log.Infof("proxy override <%d> removed", id)
+
+	return nil
+}
+
+func (r *dispatcher) addConsumer(meta *metapb.Consumer) error {
+	r.Lock()
+	defer r.Unlock()
+
+	if _, ok := r.consumers[meta.ID]; ok {
+		return errConsumerExists
+	}
+
+	r.putConsumer(newConsumerRuntime(meta))
+	log.Infof("consumer <%d> added, name <%s>, keys <%d>",
+		meta.ID,
+		meta.Name,
+		len(meta.Keys))
+
+	return nil
+}
+
+func (r *dispatcher) updateConsumer(meta *metapb.Consumer) error {
+	r.Lock()
+	defer r.Unlock()
+
+	old, ok := r.consumers[meta.ID]
+	if !ok {
+		return errConsumerNotFound
+	}
+
+	r.deleteConsumer(old)
+	r.putConsumer(newConsumerRuntime(meta))
+	log.Infof("consumer <%d> updated, name <%s>, keys <%d>",
+		meta.ID,
+		meta.Name,
+		len(meta.Keys))
+
+	return nil
+}
+
+func (r *dispatcher) removeConsumer(id uint64) error {
+	r.Lock()
+	defer r.Unlock()
+
+	old, ok := r.consumers[id]
+	if !ok {
+		return errConsumerNotFound
+	}
+
+	r.deleteConsumer(old)
+	log.Infof("consumer <%d> removed", id)
+
+	return nil
+}
+
+// NOTE: MUST Lock on call this function!!
+func (r *dispatcher) putConsumer(consumer *consumerRuntime) {
+	r.consumers[consumer.meta.ID] = consumer
+	for hash := range consumer.expires {
+		r.apiKeys[hash] = consumer
+	}
+}
+
+// NOTE: MUST Lock on call this function!!
+func (r *dispatcher) deleteConsumer(consumer *consumerRuntime) {
+	delete(r.consumers, consumer.meta.ID)
+	for hash := range consumer.expires {
+		delete(r.apiKeys, hash)
+	}
+}
+
+// NOTE: MUST Lock on call this function!!
+func (r *dispatcher) refreshOverride() {
+	var ids []uint64
+	for id := range r.overrides {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		return ids[i] < ids[j]
+	})
+
+	override := newOverrideRuntime()
+	for _, id := range ids {
+		meta := r.overrides[id]
+		if override.matches(meta, r.cnf) {
+			override.merge(meta)
+			log.Infof("proxy override <%d> matched", id)
+		}
+	}
+	r.override = override
+
+	for _, api := range r.apis {
+		override.applyTo(api)
+	}
+
+	if override.logLevel != "" {
+		log.SetLevelByString(override.logLevel)
+	} else {
+		log.SetLevel(r.originLevel)
+	}
+}
+
+func (r *dispatcher) addAPITemplate(tpl *metapb.APITemplate) error {
+	r.Lock()
+	defer r.Unlock()
+
+	if _, ok := r.templates[tpl.ID]; ok {
+		return errTemplateExists
+	}
+
+	r.templates[tpl.ID] = tpl
+	r.refreshTemplateAPIs(tpl.ID)
+	log.Infof("api template <%d> added, data <%s>",
+		tpl.ID,
+		tpl.String())
+
+	return nil
+}
+
+func (r *dispatcher) updateAPITemplate(tpl *metapb.APITemplate) error {
+	r.Lock()
+	defer r.Unlock()
+
+	if _, ok := r.templates[tpl.ID]; !ok {
+		return errTemplateNotFound
+	}
+
+	r.templates[tpl.ID] = tpl
+	r.refreshTemplateAPIs(tpl.ID)
+	log.Infof("api template <%d> updated, data <%s>",
+		tpl.ID,
+		tpl.String())
+
+	return nil
+}
+
+func (r *dispatcher) removeAPITemplate(id uint64) error {
+	r.Lock()
+	defer r.Unlock()
+
+	if _, ok := r.templates[id]; !ok {
+		return errTemplateNotFound
+	}
+
+	delete(r.templates, id)
+	r.refreshTemplateAPIs(id)
+	log.Infof("api template <%d> removed", id)
+
+	return nil
+}
+
+// NOTE: MUST Lock on call this function!!
+func (r *dispatcher) refreshTemplateAPIs(id uint64) {
+	r.refreshAPIs(func(rt *apiRuntime) bool {
+		return rt.origin.Template == id
+	})
+}
+
+// refreshAPIs resolve the matched apis again, after the inherited templates or policies changed
+// NOTE: MUST Lock on call this function!!
+func (r *dispatcher) refreshAPIs(matches func(*apiRuntime) bool) {
+	for _, rt := range r.apis {
+		if matches(rt) {
+			origin := rt.origin
+			rt.updateMeta(r.resolveAPI(origin))
+			rt.origin = origin
+			r.override.applyTo(rt)
+		}
+	}
+}
+
+// NOTE: MUST Lock on call this function!!
+func (r *dispatcher) resolveAPI(api *metapb.API) *metapb.API {
+	if api.Template != 0 {
+		if tpl, ok := r.templates[api.Template]; ok {
+			api = pbutil.MergeAPITemplate(api, tpl)
+		} else {
+			log.Warnf("api <%d> template <%d> not found",
+				api.ID,
+				api.Template)
+		}
+	}
+
+	return pbutil.MergePolicies(api, r.clusterMeta, r.policy)
+}
+
 func (r *dispatcher) addAPI(api *metapb.API) error {
+	if !r.servesAPI(api) {
+		log.Infof("api <%d> skipped, not in the proxy groups", api.ID)
+		return nil
+	}
+
 	r.Lock()
 	defer r.Unlock()
 
+	return r.doAddAPI(api)
+}
+
+func (r *dispatcher) doAddAPI(api *metapb.API) error {
 	if _, ok := r.apis[api.ID]; ok {
 		return errAPIExists
 	}
 
-	r.apis[api.ID] = newAPIRuntime(api, r.tw)
+	rt := newAPIRuntime(r.resolveAPI(api), r.tw)
+	rt.origin = api
+	r.override.applyTo(rt)
+	r.apis[api.ID] = rt
 	r.sortAPIs()
+	r.addAPIAnalysis(rt)
 
 	log.Infof("api <%d> added, data <%s>",
 		api.ID,
@@ -332,17 +696,26 @@ func (r *dispatcher) addAPI(api *metapb.API) error {
 	return nil
 }
 
+// updateAPI update the api, the api is added if it's moved into the proxy groups of this proxy,
+// and removed if it's moved out
 func (r *dispatcher) updateAPI(api *metapb.API) error {
+	if !r.servesAPI(api) {
+		return r.removeAPI(api.ID)
+	}
+
 	r.Lock()
 	defer r.Unlock()
 
 	rt, ok := r.apis[api.ID]
 	if !ok {
-		return errAPINotFound
+		return r.doAddAPI(api)
 	}
 
-	rt.updateMeta(api)
+	rt.updateMeta(r.resolveAPI(api))
+	rt.origin = api
+	r.override.applyTo(rt)
 	r.sortAPIs()
+	r.addAPIAnalysis(rt)
 	log.Infof("api <%d> updated, data <%s>",
 		api.ID,
 		api.String())
@@ -350,6 +723,21 @@ func (r *dispatcher) updateAPI(api *metapb.API) error {
 	return nil
 }
 
+// servesAPI returns true if this proxy is in any proxy group of the api
+func (r *dispatcher) servesAPI(api *metapb.API) bool {
+	if len(api.ProxyGroups) == 0 {
+		return true
+	}
+
+	for _, group := range api.ProxyGroups {
+		if hasLabels(r.cnf.Labels, group.Labels) {
+			return true
+		}
+	}
+
+	return false
+}
+
 func (r *dispatcher) removeAPI(id uint64) error {
 	r.Lock()
 	defer r.Unlock()
@@ -359,6 +747,9 @@ func (r *dispatcher) removeAPI(id uint64) error {
 	}
 
 	delete(r.apis, id)
+	r.analysiser.RemoveTarget(id)
+	r.analysiser.RemoveTarget(shadowKey(id))
+	r.heatmap.remove(id)
 	// delete sorted keys
 	for i, v := range r.apiSortedKeys {
 		if v == id {
@@ -406,7 +797,7 @@ func (r *dispatcher) refreshAllQPS() {
 		qps := r.refreshQPS(svr.meta)
 		svr.updateMeta(svr.meta)
 		svr.meta.MaxQPS = qps
-		r.addAnalysis(svr.meta.ID, svr.meta.CircuitBreaker)
+		r.refreshServerCircuitBreaker(svr)
 		r.addToCheck(svr)
 	}
 }
@@ -433,7 +824,7 @@ func (r *dispatcher) addServer(svr *metapb.Server) error {
 	svr.MaxQPS = qps
 	r.servers[svr.ID] = rt
 
-	r.addAnalysis(rt.meta.ID, rt.meta.CircuitBreaker)
+	r.refreshServerCircuitBreaker(rt)
 	r.addToCheck(rt)
 
 	log.Infof("server <%d> added, data <%s>",
@@ -452,10 +843,17 @@ func (r *dispatcher) updateServer(meta *metapb.Server) error {
 		return errServerNotFound
 	}
 
+	if rt.meta.Standby != meta.Standby {
+		// the server is added to the clusters with the new role after the heath check
+		for _, c := range r.binds[meta.ID] {
+			c.remove(meta.ID)
+		}
+	}
+
 	qps := r.refreshQPS(meta)
 	rt.updateMeta(meta)
 	meta.MaxQPS = qps
-	r.addAnalysis(rt.meta.ID, rt.meta.CircuitBreaker)
+	r.refreshServerCircuitBreaker(rt)
 	r.addToCheck(rt)
 
 	log.Infof("server <%d> updated, data <%s>",
@@ -485,6 +883,35 @@ func (r *dispatcher) removeServer(id uint64) error {
 	return nil
 }
 
+// addAPIAnalysis track the remaining traffic of the deprecated api and the contract violations, and the
+// mirrored traffic under the shadow key
+func (r *dispatcher) addAPIAnalysis(rt *apiRuntime) {
+	if rt.mirror == nil {
+		r.analysiser.RemoveTarget(shadowKey(rt.meta.ID))
+	} else {
+		r.analysiser.AddTarget(shadowKey(rt.meta.ID), apiAnalysisPeriod)
+	}
+
+	if !rt.needAnalysis() {
+		r.analysiser.RemoveTarget(rt.meta.ID)
+		return
+	}
+
+	r.analysiser.AddTarget(rt.meta.ID, apiAnalysisPeriod)
+}
+
+// addRoutingAnalysis track the canary and the stable variants of the split routing
+func (r *dispatcher) addRoutingAnalysis(rt *routingRuntime) {
+	if !rt.isCanary() {
+		r.analysiser.RemoveTarget(rt.meta.ID)
+		r.analysiser.RemoveTarget(shadowKey(rt.meta.ID))
+		return
+	}
+
+	r.analysiser.AddTarget(rt.meta.ID, apiAnalysisPeriod)
+	r.analysiser.AddTarget(shadowKey(rt.meta.ID), apiAnalysisPeriod)
+}
+
 func (r *dispatcher) addAnalysis(id uint64, cb *metapb.CircuitBreaker) {
 	r.analysiser.RemoveTarget(id)
 	r.analysiser.AddTarget(id, time.Second)
@@ -493,6 +920,44 @@ func (r *dispatcher) addAnalysis(id uint64, cb *metapb.CircuitBreaker) {
 	}
 }
 
+// refreshServerCircuitBreaker use the circuit breaker of the server, or the circuit breaker of the bound
+// cluster with the min id if the server has no circuit breaker
+func (r *dispatcher) refreshServerCircuitBreaker(svr *serverRuntime) {
+	cb := svr.meta.CircuitBreaker
+	if cb == nil {
+		var ids []uint64
+		for id := range r.binds[svr.meta.ID] {
+			ids = append(ids, id)
+		}
+		sort.Slice(ids, func(i, j int) bool {
+			return ids[i] < ids[j]
+		})
+
+		for _, id := range ids {
+			if value := r.binds[svr.meta.ID][id].meta.CircuitBreaker; value != nil {
+				cb = value
+				break
+			}
+		}
+	}
+
+	svr.setCircuitBreaker(cb)
+	r.addAnalysis(svr.meta.ID, cb)
+}
+
+// refreshClusterCircuitBreakerServers refresh the circuit breaker of the servers that bound to the cluster
+func (r *dispatcher) refreshClusterCircuitBreakerServers(id uint64) {
+	for svrID, clusters := range r.binds {
+		if _, ok := clusters[id]; !ok {
+			continue
+		}
+
+		if svr, ok := r.servers[svrID]; ok {
+			r.refreshServerCircuitBreaker(svr)
+		}
+	}
+}
+
 func (r *dispatcher) addCluster(cluster *metapb.Cluster) error {
 	r.Lock()
 	defer r.Unlock()
@@ -501,7 +966,10 @@ func (r *dispatcher) addCluster(cluster *metapb.Cluster) error {
 		return errClusterExists
 	}
 
-	r.clusters[cluster.ID] = newClusterRuntime(cluster)
+	r.clusters[cluster.ID] = newClusterRuntime(cluster, r.serverWeight, r.isStandbyServer)
+	if cluster.Policy != nil {
+		r.refreshClusterPolicyAPIs(cluster.ID)
+	}
 	log.Infof("cluster <%d> added, data <%s>",
 		cluster.ID,
 		cluster.String())
@@ -518,7 +986,17 @@ func (r *dispatcher) updateCluster(meta *metapb.Cluster) error {
 		return errClusterNotFound
 	}
 
+	policyChanged := !reflect.DeepEqual(rt.meta.Policy, meta.Policy)
+	cbChanged := !reflect.DeepEqual(rt.meta.CircuitBreaker, meta.CircuitBreaker)
 	rt.updateMeta(meta)
+	rt.updateStandbys()
+	r.updateDNSServers(rt)
+	if policyChanged {
+		r.refreshClusterPolicyAPIs(meta.ID)
+	}
+	if cbChanged {
+		r.refreshClusterCircuitBreakerServers(meta.ID)
+	}
 	log.Infof("cluster <%d> updated, data <%s>",
 		meta.ID,
 		meta.String())
@@ -535,12 +1013,27 @@ func (r *dispatcher) removeCluster(id uint64) error {
 		return errClusterNotFound
 	}
 
+	var servers []uint64
 	// TODO: check API node loose cluster
-	for _, clusters := range r.binds {
+	for svrID, clusters := range r.binds {
+		if _, ok := clusters[id]; ok {
+			servers = append(servers, svrID)
+		}
 		delete(clusters, id)
 	}
 
+	r.removeDNSServers(cluster)
 	delete(r.clusters, cluster.meta.ID)
+	if cluster.meta.Policy != nil {
+		r.refreshClusterPolicyAPIs(id)
+	}
+	if cluster.meta.CircuitBreaker != nil {
+		for _, svrID := range servers {
+			if svr, ok := r.servers[svrID]; ok {
+				r.refreshServerCircuitBreaker(svr)
+			}
+		}
+	}
 	log.Infof("cluster <%d> removed",
 		cluster.meta.ID)
 
@@ -574,6 +1067,10 @@ func (r *dispatcher) addBind(bind *metapb.Bind) error {
 
 	log.Infof("bind <%d,%d> created", bind.ClusterID, bind.ServerID)
 
+	if cluster.meta.CircuitBreaker != nil && server.meta.CircuitBreaker == nil {
+		r.refreshServerCircuitBreaker(server)
+	}
+
 	if server.status == metapb.Up {
 		cluster.add(server.meta.ID)
 	}
@@ -584,7 +1081,8 @@ func (r *dispatcher) removeBind(bind *metapb.Bind) error {
 	r.Lock()
 	defer r.Unlock()
 
-	if _, ok := r.servers[bind.ServerID]; !ok {
+	server, ok := r.servers[bind.ServerID]
+	if !ok {
 		log.Errorf("remove bind failed: server <%d> not found",
 			bind.ServerID)
 		return errServerNotFound
@@ -604,5 +1102,9 @@ func (r *dispatcher) removeBind(bind *metapb.Bind) error {
 		log.Infof("bind <%d,%d> removed", bind.ClusterID, bind.ServerID)
 	}
 
+	if cluster.meta.CircuitBreaker != nil && server.meta.CircuitBreaker == nil {
+		r.refreshServerCircuitBreaker(server)
+	}
+
 	return nil
 }
@@ -2,13 +2,18 @@ package proxy
 
 import (
 	"container/list"
+	"crypto/subtle"
 	"fmt"
+	"math/rand"
+	"net"
+	"net/http"
 	"net/url"
 	"regexp"
 	"sort"
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/buger/jsonparser"
@@ -21,30 +26,71 @@ import (
 	"github.com/fagongzi/util/hack"
 	pbutil "github.com/fagongzi/util/protoc"
 	"github.com/valyala/fasthttp"
-	"golang.org/x/time/rate"
 )
 
 var (
 	dependP = regexp.MustCompile(`\$\w+\.\w+`)
 )
 
-type clusterRuntime struct {
-	meta *metapb.Cluster
-	svrs *list.List
-	lb   lb.LoadBalance
-}
+const (
+	// the latency EWMA that scores 50 in the health score
+	healthScoreLatencyBase = time.Millisecond * 100
+	// the period to track the remaining traffic of the deprecated apis and the contract violations
+	apiAnalysisPeriod = time.Minute
+	// the header of the truncated upstream response if the api not specified
+	defaultTruncatedHeader = "X-Gateway-Response-Truncated"
+)
 
-func newClusterRuntime(meta *metapb.Cluster) *clusterRuntime {
+type clusterRuntime struct {
+	meta   *metapb.Cluster
+	svrs   *list.List
+	lb     lb.LoadBalance
+	weight lb.WeightFunc
+	probe  *halfOpenProbe
+	// the addresses and the server ids of the dns target
+	dnsServers map[string]uint64
+	resolveAt  time.Time
+	// the up standby servers, the value is true if the server is promoted
+	standbyLock sync.Mutex
+	isStandby   func(uint64) bool
+	standbys    map[uint64]bool
+	events      []metapb.StandbyEvent
+}
+
+func newClusterRuntime(meta *metapb.Cluster, weight lb.WeightFunc, isStandby func(uint64) bool) *clusterRuntime {
 	return &clusterRuntime{
-		meta: meta,
-		svrs: list.New(),
-		lb:   lb.NewLoadBalance(meta.LoadBalance),
+		meta:       meta,
+		svrs:       list.New(),
+		lb:         lb.NewLoadBalance(meta.LoadBalance, weight),
+		weight:     weight,
+		probe:      newHalfOpenProbe(meta.HalfOpenProbe),
+		dnsServers: make(map[string]uint64),
+		isStandby:  isStandby,
+		standbys:   make(map[uint64]bool),
 	}
 }
 
 func (c *clusterRuntime) updateMeta(meta *metapb.Cluster) {
 	c.meta = meta
-	c.lb = lb.NewLoadBalance(meta.LoadBalance)
+	c.lb = lb.NewLoadBalance(meta.LoadBalance, c.weight)
+	c.probe = newHalfOpenProbe(meta.HalfOpenProbe)
+}
+
+type halfOpenProbe struct {
+	meta    *metapb.HalfOpenProbe
+	barrier *util.RateBarrier
+}
+
+func newHalfOpenProbe(meta *metapb.HalfOpenProbe) *halfOpenProbe {
+	if meta == nil {
+		return nil
+	}
+
+	probe := &halfOpenProbe{meta: meta}
+	if meta.TrafficRate > 0 {
+		probe.barrier = util.NewRateBarrier(int(meta.TrafficRate))
+	}
+	return probe
 }
 
 func (c *clusterRuntime) foreach(do func(uint64)) {
@@ -55,21 +101,39 @@ func (c *clusterRuntime) foreach(do func(uint64)) {
 }
 
 func (c *clusterRuntime) remove(id uint64) {
+	c.standbyLock.Lock()
+	defer c.standbyLock.Unlock()
+
 	collection.Remove(c.svrs, id)
+	delete(c.standbys, id)
 	log.Infof("bind <%d,%d> inactived", c.meta.ID, id)
+	c.balanceStandbys()
 }
 
 func (c *clusterRuntime) add(id uint64) {
-	if collection.IndexOf(c.svrs, id) >= 0 {
-		return
+	c.standbyLock.Lock()
+	defer c.standbyLock.Unlock()
+
+	if c.isStandby(id) {
+		if _, ok := c.standbys[id]; !ok {
+			c.standbys[id] = false
+			log.Infof("bind <%d,%d> standby", c.meta.ID, id)
+		}
+	} else if collection.IndexOf(c.svrs, id) < 0 {
+		c.svrs.PushBack(id)
+		log.Infof("bind <%d,%d> actived", c.meta.ID, id)
 	}
 
-	c.svrs.PushBack(id)
-	log.Infof("bind <%d,%d> actived", c.meta.ID, id)
+	c.balanceStandbys()
 }
 
-func (c *clusterRuntime) selectServer(req *fasthttp.Request) uint64 {
-	index := c.lb.Select(req, c.svrs)
+func (c *clusterRuntime) selectServer(req *fasthttp.Request, affinity string) uint64 {
+	var index int
+	if affinity != "" {
+		index = lb.SelectByKey(affinity, c.svrs)
+	} else {
+		index = c.lb.Select(req, c.svrs)
+	}
 	if 0 > index {
 		return 0
 	}
@@ -87,10 +151,11 @@ type abstractSupportProtectedRuntime struct {
 
 	id      uint64
 	tw      *goetty.TimeoutWheel
-	limiter *rate.Limiter
+	limiter *util.RateLimiter
 	circuit metapb.CircuitStatus
 	cb      *metapb.CircuitBreaker
 	barrier *util.RateBarrier
+	probes  int32
 }
 
 func (s *abstractSupportProtectedRuntime) getCircuitStatus() metapb.CircuitStatus {
@@ -132,11 +197,17 @@ func (s *abstractSupportProtectedRuntime) circuitToHalf(arg interface{}) {
 	s.Lock()
 	if s.cb != nil {
 		s.circuit = metapb.Half
+		atomic.StoreInt32(&s.probes, 0)
 		log.Warnf("protected resource <%d> change to half", s.id)
 	}
 	s.Unlock()
 }
 
+// allowProbe returns true if the probe requests in current half-open period not reach the max
+func (s *abstractSupportProtectedRuntime) allowProbe(max int32) bool {
+	return max <= 0 || atomic.AddInt32(&s.probes, 1) <= max
+}
+
 type serverRuntime struct {
 	abstractSupportProtectedRuntime
 
@@ -144,7 +215,12 @@ type serverRuntime struct {
 	status           metapb.Status
 	heathTimeout     goetty.Timeout
 	checkFailCount   int
+	checkOKCount     int
 	useCheckDuration time.Duration
+	lastCheckAt      int64
+	lastFailure      string
+	score            int32
+	weight           int32
 }
 
 func newServerRuntime(meta *metapb.Server, tw *goetty.TimeoutWheel) *serverRuntime {
@@ -168,44 +244,187 @@ func (s *serverRuntime) updateMeta(meta *metapb.Server) {
 	s.meta = meta
 	s.id = meta.ID
 	s.cb = meta.CircuitBreaker
-	s.limiter = rate.NewLimiter(rate.Every(time.Second/time.Duration(meta.MaxQPS)), int(meta.MaxQPS))
+	s.limiter = util.NewRateLimiter(meta.MaxQPS)
 	s.status = metapb.Down
 	s.circuit = metapb.Open
+	s.updateWeight(time.Now())
 	if s.cb != nil {
 		s.barrier = util.NewRateBarrier(int(s.cb.HalfTrafficRate))
 	}
 }
 
+// setCircuitBreaker set the circuit breaker that the server used, the circuit breaker of the server or
+// the cluster. The circuit is open if the server has no circuit breaker
+func (s *serverRuntime) setCircuitBreaker(cb *metapb.CircuitBreaker) {
+	s.Lock()
+	s.cb = cb
+	s.barrier = nil
+	if cb != nil {
+		s.barrier = util.NewRateBarrier(int(cb.HalfTrafficRate))
+	} else {
+		s.circuit = metapb.Open
+	}
+	s.Unlock()
+}
+
 func (s *serverRuntime) getCheckURL() string {
 	return fmt.Sprintf("%s://%s%s", strings.ToLower(s.meta.Protocol.String()), s.meta.Addr, s.meta.HeathCheck.Path)
 }
 
 func (s *serverRuntime) fail() {
 	s.checkFailCount++
+	s.checkOKCount = 0
 	s.useCheckDuration += s.useCheckDuration / 2
 }
 
 func (s *serverRuntime) reset() {
 	s.checkFailCount = 0
+	s.checkOKCount++
 	s.useCheckDuration = time.Duration(s.meta.HeathCheck.CheckInterval)
 }
 
+// checked change the status by the heath check result, the unknown server changes by the first check,
+// otherwise the status changes after the continuous results reach the threshold
+func (s *serverRuntime) checked(ok bool) {
+	if ok {
+		if s.status == metapb.Unknown ||
+			s.checkOKCount >= checkThreshold(s.meta.HeathCheck.HealthyThreshold) {
+			s.changeTo(metapb.Up)
+		}
+		return
+	}
+
+	if s.status == metapb.Unknown ||
+		s.checkFailCount >= checkThreshold(s.meta.HeathCheck.UnhealthyThreshold) {
+		s.changeTo(metapb.Down)
+	}
+}
+
+func checkThreshold(value int32) int {
+	if value <= 0 {
+		return 1
+	}
+
+	return int(value)
+}
+
 func (s *serverRuntime) changeTo(status metapb.Status) {
 	s.status = status
 }
 
+// updateScore update the 0-100 health score, it combines the heath check result,
+// the failure rate, the latency EWMA and the circuit status.
+func (s *serverRuntime) updateScore(analysiser *util.Analysis) {
+	score := int64(0)
+	if s.status == metapb.Up {
+		failureScore := int64(100)
+		if rate := analysiser.GetRecentlyRequestFailureRate(s.meta.ID, time.Second); rate >= 0 {
+			failureScore = int64(100 - rate)
+		}
+
+		ewma := analysiser.GetLatencyEWMA(s.meta.ID)
+		latencyScore := int64(100 * healthScoreLatencyBase / (healthScoreLatencyBase + ewma))
+
+		circuitScore := int64(0)
+		switch s.getCircuitStatus() {
+		case metapb.Open:
+			circuitScore = 100
+		case metapb.Half:
+			circuitScore = 50
+		}
+
+		score = (failureScore*40 + latencyScore*30 + circuitScore*30) / 100
+	}
+
+	atomic.StoreInt32(&s.score, int32(score))
+}
+
+func (s *serverRuntime) getScore() int32 {
+	return atomic.LoadInt32(&s.score)
+}
+
+func (s *serverRuntime) health(proxy string, analysiser *util.Analysis) *metapb.ServerHealth {
+	value := &metapb.ServerHealth{
+		ServerID:    s.meta.ID,
+		Addr:        s.meta.Addr,
+		Proxy:       proxy,
+		LastCheckAt: s.lastCheckAt,
+		Reason:      s.lastFailure,
+		Score:       s.getScore(),
+		LatencyEWMA: int64(analysiser.GetLatencyEWMA(s.meta.ID)),
+		Weight:      s.getWeight(),
+		Circuit:     s.getCircuitStatus(),
+	}
+
+	for _, phase := range util.Phases() {
+		if count := analysiser.GetTimeoutCount(s.meta.ID, phase); count > 0 {
+			value.Timeouts = append(value.Timeouts, metapb.PhaseTimeout{
+				Phase: phase.String(),
+				Count: int64(count),
+			})
+		}
+
+		if ewma := analysiser.GetPhaseLatencyEWMA(s.meta.ID, phase); ewma > 0 {
+			value.Phases = append(value.Phases, metapb.PhaseLatency{
+				Phase:       phase.String(),
+				LatencyEWMA: int64(ewma),
+			})
+		}
+	}
+
+	switch {
+	case s.meta.Drained:
+		value.Status = metapb.Draining
+		value.Reason = "server is drained"
+	case s.status != metapb.Up:
+		value.Status = metapb.Unhealthy
+		if value.Reason == "" {
+			value.Reason = "waiting for heath check"
+		}
+	case s.circuit != metapb.Open:
+		value.Status = metapb.Draining
+		value.Reason = fmt.Sprintf("circuit breaker is %s", s.circuit.String())
+	default:
+		value.Status = metapb.Healthy
+	}
+
+	return value
+}
+
+// ipSegment is the ip rule of the access control, the formats are ipv4 prefix with * segments
+// (e.g. 192.168.*), CIDR (e.g. 10.0.0.0/8, 2001:db8::/32) and ipv6 address
 type ipSegment struct {
 	value []string
+	ipNet *net.IPNet
+	ip    net.IP
 }
 
 func parseFrom(value string) *ipSegment {
 	ip := &ipSegment{}
-	ip.value = strings.Split(value, ".")
+	if _, ipNet, err := net.ParseCIDR(value); err == nil {
+		ip.ipNet = ipNet
+	} else if strings.Contains(value, ":") {
+		ip.ip = net.ParseIP(value)
+	} else {
+		ip.value = strings.Split(value, ".")
+	}
 	return ip
 }
 
 func (ip *ipSegment) matches(value string) bool {
+	if ip.ipNet != nil {
+		target := net.ParseIP(value)
+		return target != nil && ip.ipNet.Contains(target)
+	}
+
+	if ip.ip != nil {
+		return ip.ip.Equal(net.ParseIP(value))
+	}
+
 	tmp := strings.Split(value, ".")
+	if len(tmp) < len(ip.value) {
+		return false
+	}
 
 	for index, v := range ip.value {
 		if v != "*" && v != tmp[index] {
@@ -277,6 +496,11 @@ func newAPINode(meta *metapb.DispatchNode) *apiNode {
 	if meta.WriteTimeout > 0 {
 		rn.httpOption.WriteTimeout = time.Duration(meta.WriteTimeout)
 	}
+	// each attempt of the retries has its own read timeout
+	if retry := meta.RetryStrategy; retry != nil && retry.PerTryTimeout > 0 &&
+		(rn.httpOption.ReadTimeout <= 0 || time.Duration(retry.PerTryTimeout) < rn.httpOption.ReadTimeout) {
+		rn.httpOption.ReadTimeout = time.Duration(retry.PerTryTimeout)
+	}
 	return rn
 }
 
@@ -314,9 +538,20 @@ type apiRuntime struct {
 	abstractSupportProtectedRuntime
 
 	meta                *metapb.API
+	origin              *metapb.API
 	nodes               []*apiNode
 	urlPattern          *regexp.Regexp
+	pathRewrite         *regexp.Regexp
+	aliases             []*apiAlias
+	schema              *requestSchema
+	contract            *responseContract
+	graphQL             *graphQLRuntime
+	policy              *accessPolicy
+	preview             *apiPreview
 	defaultCookies      []*fasthttp.Cookie
+	constants           map[string]string
+	accessLog           *accessLog
+	mirror              *util.RateBarrier
 	parsedWhitelist     []*ipSegment
 	parsedBlacklist     []*ipSegment
 	parsedRenderObjects []*renderObject
@@ -353,10 +588,49 @@ func (a *apiRuntime) init() {
 		a.urlPattern = regexp.MustCompile(a.meta.URLPattern)
 	}
 
+	if a.meta.PathRewrite != nil && a.meta.PathRewrite.Pattern != "" {
+		a.pathRewrite = regexp.MustCompile(a.meta.PathRewrite.Pattern)
+	}
+
+	for _, alias := range a.meta.Aliases {
+		a.aliases = append(a.aliases, newAPIAlias(alias))
+	}
+
+	if a.meta.RequestSchema != nil {
+		a.schema = newRequestSchema(a.meta.ID, a.meta.RequestSchema)
+	}
+
+	if a.meta.ResponseSchema != nil {
+		a.contract = newResponseContract(a.meta.ID, a.meta.ResponseSchema)
+	}
+
+	if a.meta.GraphQL != nil {
+		a.graphQL = newGraphQLRuntime(a.meta.GraphQL)
+	}
+
+	if a.meta.AccessPolicy != nil {
+		a.policy = newAccessPolicy(a.meta.ID, a.meta.AccessPolicy)
+	}
+
+	if a.meta.Preview != nil {
+		a.preview = newAPIPreview(a.meta.Preview)
+	}
+
 	for _, n := range a.meta.Nodes {
 		a.nodes = append(a.nodes, newAPINode(n))
 	}
 
+	if len(a.meta.Constants) > 0 {
+		a.constants = make(map[string]string, len(a.meta.Constants))
+		for _, c := range a.meta.Constants {
+			a.constants[c.Name] = c.Value
+		}
+	}
+
+	if a.meta.AccessLog != nil {
+		a.accessLog = newAccessLog(a.meta.AccessLog)
+	}
+
 	sort.Slice(a.nodes, a.compare)
 
 	if nil != a.meta.DefaultValue {
@@ -412,13 +686,184 @@ func (a *apiRuntime) init() {
 	if a.cb != nil {
 		a.barrier = util.NewRateBarrier(int(a.cb.HalfTrafficRate))
 	}
-	if a.meta.MaxQPS > 0 {
-		a.limiter = rate.NewLimiter(rate.Every(time.Second/time.Duration(a.meta.MaxQPS)), int(a.meta.MaxQPS))
+	a.updateMaxQPS(a.meta.MaxQPS)
+
+	if m := a.meta.Mirror; m != nil {
+		rate := int(m.Rate)
+		if rate == 0 {
+			rate = 100
+		}
+		a.mirror = util.NewRateBarrier(rate)
 	}
 
 	return
 }
 
+func (a *apiRuntime) updateMaxQPS(maxQPS int64) {
+	a.limiter = nil
+	if maxQPS > 0 {
+		a.limiter = util.NewRateLimiter(maxQPS)
+	}
+}
+
+// needAnalysis returns true if the requests of the api need to be tracked in analysis
+func (a *apiRuntime) needAnalysis() bool {
+	return a.meta.Deprecation != nil || a.contract != nil
+}
+
+func (a *apiRuntime) isDeprecated(now int64) bool {
+	return a.meta.PublishState == metapb.Deprecated ||
+		(a.meta.Deprecation != nil && a.meta.Deprecation.DeprecatedAt <= now)
+}
+
+func (a *apiRuntime) isSunset(now int64) bool {
+	return a.meta.Deprecation != nil &&
+		a.meta.Deprecation.DisableAfterSunset &&
+		a.meta.Deprecation.SunsetAt > 0 &&
+		a.meta.Deprecation.SunsetAt <= now
+}
+
+// addDeprecationHeaders add the Deprecation, Sunset, Link and Warning headers
+func (a *apiRuntime) addDeprecationHeaders(header *fasthttp.ResponseHeader) {
+	value := a.meta.Deprecation
+	if value == nil {
+		header.Set("Deprecation", "true")
+		return
+	}
+
+	if value.DeprecatedAt > 0 {
+		header.Set("Deprecation", fmt.Sprintf("@%d", value.DeprecatedAt))
+	} else {
+		header.Set("Deprecation", "true")
+	}
+
+	if value.SunsetAt > 0 {
+		header.Set("Sunset", time.Unix(value.SunsetAt, 0).UTC().Format(http.TimeFormat))
+	}
+
+	if value.Link != "" {
+		header.Add("Link", fmt.Sprintf("<%s>; rel=\"deprecation\"", value.Link))
+	}
+
+	if value.Warning != "" {
+		header.Add("Warning", fmt.Sprintf("299 - %q", value.Warning))
+	}
+}
+
+// addRateLimitHeaders add the rate limit headers of the max qps of the api, the clients can self-throttle
+// by the headers before they get 429
+func (a *apiRuntime) addRateLimitHeaders(header *fasthttp.ResponseHeader, now time.Time) {
+	limit, remaining, reset := a.limiter.State(now)
+	header.Set("RateLimit-Limit", strconv.FormatInt(limit, 10))
+	header.Set("RateLimit-Remaining", strconv.FormatInt(remaining, 10))
+	header.Set("RateLimit-Reset", strconv.FormatInt(reset, 10))
+}
+
+// exceedRequestHeaders returns true if the request headers exceed the limits of the api
+func (a *apiRuntime) exceedRequestHeaders(header *fasthttp.RequestHeader) bool {
+	l := a.meta.HeaderLimits
+	return l != nil && exceedHeaders(header.VisitAll, l.MaxRequestHeaders, l.MaxRequestHeaderBytes)
+}
+
+// exceedResponseHeaders returns true if the upstream response headers exceed the limits of the api
+func (a *apiRuntime) exceedResponseHeaders(header *fasthttp.ResponseHeader) bool {
+	l := a.meta.HeaderLimits
+	return l != nil && exceedHeaders(header.VisitAll, l.MaxResponseHeaders, l.MaxResponseHeaderBytes)
+}
+
+// maxResponseBody returns the max bytes of the upstream response body that read by the client, and true if
+// the larger body is truncated by the client. The api stops reading at its max bytes, so the large response
+// is never buffered, the api that truncates reads one more byte to know the body is truncated
+func (a *apiRuntime) maxResponseBody(max int) (int, bool) {
+	b := a.meta.ResponseBody
+	if b == nil || b.MaxBytes <= 0 {
+		return max, false
+	}
+
+	if b.Truncate {
+		return int(a.maxTruncatedBody(max)) + 1, true
+	}
+
+	if max > 0 && int64(max) <= b.MaxBytes {
+		return max, false
+	}
+
+	return int(b.MaxBytes), false
+}
+
+// rejectsLargeResponse returns true if the upstream response body larger than the max bytes of the api
+// is rejected rather than truncated
+func (a *apiRuntime) rejectsLargeResponse() bool {
+	b := a.meta.ResponseBody
+	return b != nil && b.MaxBytes > 0 && !b.Truncate
+}
+
+// maxTruncatedBody returns the max bytes of the truncated response body, the body and the extra byte read
+// to know the truncation can not exceed the max body bytes(0 means no limit) of the proxy
+func (a *apiRuntime) maxTruncatedBody(max int) int64 {
+	value := a.meta.ResponseBody.MaxBytes
+	if max > 0 && int64(max) <= value {
+		return int64(max) - 1
+	}
+
+	return value
+}
+
+// limitResponseBody truncate the upstream response body that larger than the max bytes of the api, and
+// add the truncation header. max is the max body bytes of the proxy. Returns false if the response is
+// rejected, the encoded body is never truncated
+func (a *apiRuntime) limitResponseBody(res *fasthttp.Response, max int) bool {
+	b := a.meta.ResponseBody
+	if b == nil || b.MaxBytes == 0 {
+		return true
+	}
+
+	limit := b.MaxBytes
+	if b.Truncate {
+		limit = a.maxTruncatedBody(max)
+	}
+	if int64(len(res.Body())) <= limit {
+		return true
+	}
+
+	if !b.Truncate || len(res.Header.Peek("Content-Encoding")) > 0 {
+		return false
+	}
+
+	res.SetBody(res.Body()[:limit])
+	header := b.Header
+	if header == "" {
+		header = defaultTruncatedHeader
+	}
+	res.Header.Set(header, "true")
+	return true
+}
+
+func exceedHeaders(visitAll func(func(key, value []byte)), maxCount, maxBytes int32) bool {
+	if maxCount == 0 && maxBytes == 0 {
+		return false
+	}
+
+	count, bytes := int32(0), int32(0)
+	visitAll(func(key, value []byte) {
+		count++
+		// name: value\r\n
+		bytes += int32(len(key) + len(value) + 4)
+	})
+
+	return (maxCount > 0 && count > maxCount) || (maxBytes > 0 && bytes > maxBytes)
+}
+
+// overallRemaining returns the remaining time of the overall timeout of the api, the elapsed time is from
+// the request received. Returns false if the api has no overall timeout
+func (a *apiRuntime) overallRemaining(ctx *fasthttp.RequestCtx, now time.Time) (time.Duration, bool) {
+	if a.meta.Timeout <= 0 {
+		return 0, false
+	}
+
+	return time.Duration(a.meta.Timeout) - now.Sub(ctx.Time()), true
+}
+
 func (a *apiRuntime) isWebSocket() bool {
 	return a.meta.WebSocketOptions != nil
 }
@@ -469,13 +914,66 @@ func (a *apiRuntime) rewriteURL(req *fasthttp.Request, node *apiNode, ctx *multi
 		return ""
 	}
 
+	// the template is executed before the $ of the regexp and the dependencies expanded
+	if util.IsTemplate(rewrite) {
+		value, err := util.ExpandTemplate(rewrite, newTemplateData(a, req))
+		if err != nil {
+			log.Errorf("api <%d> execute rewrite template failed, errors:\n%+v",
+				a.meta.ID,
+				err)
+		} else {
+			rewrite = value
+		}
+	}
+
 	if nil != ctx && len(node.dependencies) > 0 {
 		for idx, dep := range node.dependencies {
 			rewrite = strings.Replace(rewrite, dep, ctx.getAttr(node.dependenciesPaths[idx]...), -1)
 		}
 	}
 
-	return a.urlPattern.ReplaceAllString(hack.SliceToString(req.URI().RequestURI()), rewrite)
+	pattern := a.urlPattern
+	if !a.isURIMatches(req) {
+		for _, alias := range a.aliases {
+			if alias.isURIMatches(req) {
+				pattern = alias.urlPattern
+				break
+			}
+		}
+	}
+
+	return pattern.ReplaceAllString(hack.SliceToString(req.URI().RequestURI()), rewrite)
+}
+
+// rewritePath returns the path that rewritten by the path rewrite of the api, false if the api has no
+// path rewrite. The strip prefix only matches the whole segments, e.g. /api matches /api/users but not
+// /apis
+func (a *apiRuntime) rewritePath(path string) (string, bool) {
+	r := a.meta.PathRewrite
+	if r == nil {
+		return "", false
+	}
+
+	if prefix := strings.TrimSuffix(r.StripPrefix, "/"); prefix != "" &&
+		(path == prefix || strings.HasPrefix(path, prefix+"/")) {
+		path = path[len(prefix):]
+		if path == "" {
+			path = "/"
+		}
+	}
+
+	if a.pathRewrite != nil {
+		path = a.pathRewrite.ReplaceAllString(path, r.Replacement)
+	}
+
+	if prefix := strings.TrimSuffix(r.AddPrefix, "/"); prefix != "" {
+		if !strings.HasPrefix(path, "/") {
+			path = "/" + path
+		}
+		path = prefix + path
+	}
+
+	return path, true
 }
 
 func (a *apiRuntime) matches(req *fasthttp.Request) bool {
@@ -483,6 +981,20 @@ func (a *apiRuntime) matches(req *fasthttp.Request) bool {
 		return false
 	}
 
+	if a.matchesPrimary(req) {
+		return true
+	}
+
+	for _, alias := range a.aliases {
+		if alias.matches(req) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (a *apiRuntime) matchesPrimary(req *fasthttp.Request) bool {
 	switch a.matchRule() {
 	case metapb.MatchAll:
 		return a.isDomainMatches(req) && a.isMethodMatches(req) && a.isURIMatches(req)
@@ -493,6 +1005,20 @@ func (a *apiRuntime) matches(req *fasthttp.Request) bool {
 	}
 }
 
+// isReachable returns false if the api is internal and the request is not from the internal listener,
+// or the api is draft or in review and the request is not a preview request
+func (a *apiRuntime) isReachable(ctx *fasthttp.RequestCtx) bool {
+	if a.meta.Visibility == metapb.Internal && !isInternalRequest(ctx) {
+		return false
+	}
+
+	if a.meta.PublishState != metapb.Draft && a.meta.PublishState != metapb.Review {
+		return true
+	}
+
+	return a.preview != nil && a.preview.allow(ctx)
+}
+
 func (a *apiRuntime) isUp() bool {
 	return a.meta.Status == metapb.Up
 }
@@ -513,6 +1039,180 @@ func (a *apiRuntime) isDomainMatches(req *fasthttp.Request) bool {
 	return a.meta.Domain != "" && hack.SliceToString(req.Header.Host()) == a.meta.Domain
 }
 
+type requestSchema struct {
+	query, header, body *util.Schema
+}
+
+func newRequestSchema(id uint64, meta *metapb.RequestSchema) *requestSchema {
+	parse := func(value string) *util.Schema {
+		if value == "" {
+			return nil
+		}
+
+		schema, err := util.ParseSchema([]byte(value))
+		if err != nil {
+			log.Errorf("api <%d> parse request schema failed, errors:\n%+v",
+				id,
+				err)
+			return nil
+		}
+
+		return schema
+	}
+
+	return &requestSchema{
+		query:  parse(meta.Query),
+		header: parse(meta.Header),
+		body:   parse(meta.Body),
+	}
+}
+
+func (s *requestSchema) validate(req *fasthttp.Request) []util.SchemaError {
+	var errs []util.SchemaError
+	if s.query != nil {
+		values := make(map[string]string)
+		req.URI().QueryArgs().VisitAll(func(key, value []byte) {
+			values[string(key)] = string(value)
+		})
+		errs = append(errs, prefixSchemaErrors("query", s.query.ValidateStrings(values))...)
+	}
+
+	if s.header != nil {
+		values := make(map[string]string)
+		req.Header.VisitAll(func(key, value []byte) {
+			values[string(key)] = string(value)
+		})
+		errs = append(errs, prefixSchemaErrors("header", s.header.ValidateStrings(values))...)
+	}
+
+	if s.body != nil {
+		errs = append(errs, prefixSchemaErrors("body", s.body.ValidateJSON(req.Body()))...)
+	}
+
+	return errs
+}
+
+type responseContract struct {
+	schema  *util.Schema
+	barrier *util.RateBarrier
+}
+
+func newResponseContract(id uint64, meta *metapb.ResponseSchema) *responseContract {
+	schema, err := util.ParseSchema([]byte(meta.Body))
+	if err != nil {
+		log.Errorf("api <%d> parse response schema failed, errors:\n%+v",
+			id,
+			err)
+		return nil
+	}
+
+	sampling := int(meta.Sampling)
+	if sampling <= 0 {
+		sampling = 100
+	}
+
+	return &responseContract{
+		schema:  schema,
+		barrier: util.NewRateBarrier(sampling),
+	}
+}
+
+func (c *responseContract) validate(res *fasthttp.Response) []util.SchemaError {
+	if !c.barrier.Allow() {
+		return nil
+	}
+
+	return prefixSchemaErrors("body", c.schema.ValidateJSON(res.Body()))
+}
+
+func prefixSchemaErrors(prefix string, errs []util.SchemaError) []util.SchemaError {
+	for idx := range errs {
+		errs[idx].Path = prefix + errs[idx].Path[1:]
+	}
+	return errs
+}
+
+const (
+	defaultPreviewHeader = "X-Gateway-Preview"
+)
+
+type apiPreview struct {
+	header string
+	secret []byte
+	ips    []*ipSegment
+}
+
+func newAPIPreview(meta *metapb.PreviewOptions) *apiPreview {
+	p := &apiPreview{
+		header: meta.Header,
+		secret: []byte(meta.Secret),
+	}
+
+	if p.header == "" {
+		p.header = defaultPreviewHeader
+	}
+
+	for _, ip := range meta.IPs {
+		p.ips = append(p.ips, parseFrom(ip))
+	}
+
+	return p
+}
+
+func (p *apiPreview) allow(ctx *fasthttp.RequestCtx) bool {
+	if len(p.secret) > 0 &&
+		subtle.ConstantTimeCompare(ctx.Request.Header.Peek(p.header), p.secret) == 1 {
+		return true
+	}
+
+	if len(p.ips) > 0 {
+		ip := GetRealClientIP(ctx)
+		for _, segment := range p.ips {
+			if segment.matches(ip) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+type apiAlias struct {
+	meta       *metapb.APIAlias
+	urlPattern *regexp.Regexp
+}
+
+func newAPIAlias(meta *metapb.APIAlias) *apiAlias {
+	alias := &apiAlias{meta: meta}
+	if meta.URLPattern != "" {
+		alias.urlPattern = regexp.MustCompile(meta.URLPattern)
+	}
+	return alias
+}
+
+func (a *apiAlias) matches(req *fasthttp.Request) bool {
+	switch a.meta.MatchRule {
+	case metapb.MatchAll:
+		return a.isDomainMatches(req) && a.isMethodMatches(req) && a.isURIMatches(req)
+	case metapb.MatchAny:
+		return a.isDomainMatches(req) || a.isMethodMatches(req) || a.isURIMatches(req)
+	default:
+		return a.isDomainMatches(req) || (a.isMethodMatches(req) && a.isURIMatches(req))
+	}
+}
+
+func (a *apiAlias) isMethodMatches(req *fasthttp.Request) bool {
+	return a.meta.Method == "*" || strings.ToUpper(hack.SliceToString(req.Header.Method())) == a.meta.Method
+}
+
+func (a *apiAlias) isURIMatches(req *fasthttp.Request) bool {
+	return a.urlPattern != nil && a.urlPattern.Match(req.URI().RequestURI())
+}
+
+func (a *apiAlias) isDomainMatches(req *fasthttp.Request) bool {
+	return a.meta.Domain != "" && hack.SliceToString(req.Header.Host()) == a.meta.Domain
+}
+
 func (a *apiRuntime) position() uint32 {
 	return a.meta.GetPosition()
 }
@@ -563,9 +1263,11 @@ func (a *routingRuntime) updateMeta(meta *metapb.Routing) {
 	a.barrier = util.NewRateBarrier(int(a.meta.TrafficRate))
 }
 
-func (a *routingRuntime) matches(apiID uint64, req *fasthttp.Request, requestTag string) bool {
+// matches returns true if the request matches the api and the conditions of the routing, and allowed is
+// true if the request is selected by the traffic rate
+func (a *routingRuntime) matches(apiID uint64, req *fasthttp.Request, requestTag string) (matched bool, allowed bool) {
 	if a.meta.API > 0 && apiID != a.meta.API {
-		return false
+		return false, false
 	}
 
 	for _, c := range a.meta.Conditions {
@@ -574,18 +1276,24 @@ func (a *routingRuntime) matches(apiID uint64, req *fasthttp.Request, requestTag
 				requestTag,
 				a.meta.Name,
 				c)
-			return false
+			return false, false
 		}
 	}
 
-	value := a.barrier.Allow()
-	if !value {
+	allowed = a.barrier.Allow()
+	if !allowed {
 		log.Debugf("%s: skip routing %s by rate",
 			requestTag,
 			a.meta.Name)
 	}
 
-	return value
+	return true, allowed
+}
+
+// isCanary returns true if the routing splits the traffic to the canary cluster, the variants of the
+// split routing are analyzed
+func (a *routingRuntime) isCanary() bool {
+	return a.meta.Strategy == metapb.Split
 }
 
 func (a *routingRuntime) isUp() bool {
@@ -736,3 +1444,96 @@ func getPathValue(idx int, req *fasthttp.Request) string {
 func getFormValue(name string, req *fasthttp.Request) string {
 	return string(req.PostArgs().Peek(name))
 }
+
+type overrideRuntime struct {
+	enabledAPIs       map[uint64]struct{}
+	disabledAPIs      map[uint64]struct{}
+	maxQPS            map[uint64]int64
+	logLevel          string
+	accessLogSampling int32
+}
+
+func newOverrideRuntime() *overrideRuntime {
+	return &overrideRuntime{
+		enabledAPIs:  make(map[uint64]struct{}),
+		disabledAPIs: make(map[uint64]struct{}),
+		maxQPS:       make(map[uint64]int64),
+	}
+}
+
+func (o *overrideRuntime) matches(meta *metapb.ProxyOverride, cnf *Cfg) bool {
+	if meta.Proxy != "" {
+		return meta.Proxy == cnf.Addr
+	}
+
+	return len(meta.Labels) > 0 && hasLabels(cnf.Labels, meta.Labels)
+}
+
+// hasLabels returns true if the labels contains all the expect labels
+func hasLabels(labels, expects []metapb.PairValue) bool {
+	for _, expect := range expects {
+		found := false
+		for _, label := range labels {
+			if label.Name == expect.Name && label.Value == expect.Value {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+// merge merge the override into this runtime, the later one overrides the prev value
+func (o *overrideRuntime) merge(meta *metapb.ProxyOverride) {
+	for _, id := range meta.EnabledAPIs {
+		o.enabledAPIs[id] = struct{}{}
+	}
+
+	for _, id := range meta.DisabledAPIs {
+		o.disabledAPIs[id] = struct{}{}
+	}
+
+	for _, limit := range meta.RateLimits {
+		o.maxQPS[limit.API] = limit.MaxQPS
+	}
+
+	if meta.LogLevel != "" {
+		o.logLevel = meta.LogLevel
+	}
+
+	if meta.AccessLogSampling > 0 {
+		o.accessLogSampling = meta.AccessLogSampling
+	}
+}
+
+func (o *overrideRuntime) isAPIEnabled(id uint64) bool {
+	if _, ok := o.disabledAPIs[id]; ok {
+		return false
+	}
+
+	if len(o.enabledAPIs) == 0 {
+		return true
+	}
+
+	_, ok := o.enabledAPIs[id]
+	return ok
+}
+
+func (o *overrideRuntime) applyTo(api *apiRuntime) {
+	if maxQPS, ok := o.maxQPS[api.meta.ID]; ok {
+		api.updateMaxQPS(maxQPS)
+		return
+	}
+
+	api.updateMaxQPS(api.meta.MaxQPS)
+}
+
+func (o *overrideRuntime) sampleAccessLog() bool {
+	return o.accessLogSampling == 0 ||
+		rand.Int31n(100) < o.accessLogSampling
+}
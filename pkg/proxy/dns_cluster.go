@@ -0,0 +1,178 @@
+package proxy
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"net"
+	"strconv"
+	"time"
+
+	"github.com/fagongzi/gateway/pkg/pb/metapb"
+	"github.com/fagongzi/log"
+)
+
+const (
+	defaultDNSRefreshInterval = time.Second * 30
+	// the servers of the dns clusters use the ids that the highest bit is set, the ids of the store never
+	dnsServerIDMask = uint64(1) << 63
+)
+
+// readyToResolveClusters resolve the hosts of the dns clusters periodically, the addresses are used
+// as the servers of the clusters that not registered in the store
+func (r *dispatcher) readyToResolveClusters(lookup func(host string) ([]string, error)) {
+	r.runner.RunCancelableTask(func(ctx context.Context) {
+		ticker := time.NewTicker(time.Second)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				r.resolveClusters(lookup, time.Now())
+			}
+		}
+	})
+}
+
+func (r *dispatcher) resolveClusters(lookup func(host string) ([]string, error), now time.Time) {
+	var targets []*clusterRuntime
+	r.RLock()
+	for _, c := range r.clusters {
+		if c.meta.DNS != nil && !now.Before(c.resolveAt) {
+			targets = append(targets, c)
+		}
+	}
+	r.RUnlock()
+
+	for _, c := range targets {
+		dns := c.meta.DNS
+		addrs, err := lookup(dns.Host)
+
+		r.Lock()
+		// the cluster is removed or updated during the lookup
+		if r.clusters[c.meta.ID] == c && c.meta.DNS == dns {
+			c.resolveAt = now.Add(dnsRefreshInterval(dns))
+			if err != nil {
+				log.Errorf("cluster <%d> resolve %s failed, keep the %d servers, errors:\n%+v",
+					c.meta.ID,
+					dns.Host,
+					len(c.dnsServers),
+					err)
+			} else {
+				r.refreshDNSServers(c, addrs)
+			}
+		}
+		r.Unlock()
+	}
+}
+
+// refreshDNSServers add the servers of the new addresses to the cluster,
+// and remove the servers that the addresses are gone
+func (r *dispatcher) refreshDNSServers(c *clusterRuntime, addrs []string) {
+	current := make(map[string]bool, len(addrs))
+	for _, ip := range addrs {
+		addr := net.JoinHostPort(ip, strconv.Itoa(int(c.meta.DNS.Port)))
+		current[addr] = true
+
+		if _, ok := c.dnsServers[addr]; ok {
+			continue
+		}
+
+		svr := newDNSServer(c.meta, addr)
+		qps := r.refreshQPS(svr)
+		rt := newServerRuntime(svr, r.tw)
+		svr.MaxQPS = qps
+
+		c.dnsServers[addr] = svr.ID
+		r.servers[svr.ID] = rt
+		r.binds[svr.ID] = map[uint64]*clusterRuntime{c.meta.ID: c}
+		r.refreshServerCircuitBreaker(rt)
+		r.addToCheck(rt)
+
+		log.Infof("cluster <%d> dns server <%d, %s> added",
+			c.meta.ID,
+			svr.ID,
+			addr)
+	}
+
+	for addr, id := range c.dnsServers {
+		if !current[addr] {
+			r.removeDNSServer(c, addr, id)
+		}
+	}
+}
+
+// updateDNSServers update the servers of the cluster with the dns target, the addresses are
+// resolved at the next tick
+func (r *dispatcher) updateDNSServers(c *clusterRuntime) {
+	c.resolveAt = time.Time{}
+	if c.meta.DNS == nil {
+		r.removeDNSServers(c)
+		return
+	}
+
+	for addr, id := range c.dnsServers {
+		rt, ok := r.servers[id]
+		if !ok {
+			continue
+		}
+
+		svr := newDNSServer(c.meta, addr)
+		qps := r.refreshQPS(svr)
+		rt.updateMeta(svr)
+		svr.MaxQPS = qps
+		r.refreshServerCircuitBreaker(rt)
+		r.addToCheck(rt)
+	}
+}
+
+func (r *dispatcher) removeDNSServers(c *clusterRuntime) {
+	for addr, id := range c.dnsServers {
+		r.removeDNSServer(c, addr, id)
+	}
+}
+
+func (r *dispatcher) removeDNSServer(c *clusterRuntime, addr string, id uint64) {
+	if svr, ok := r.servers[id]; ok {
+		svr.heathTimeout.Stop()
+	}
+
+	delete(c.dnsServers, addr)
+	delete(r.servers, id)
+	delete(r.binds, id)
+	c.remove(id)
+	r.analysiser.RemoveTarget(id)
+
+	log.Infof("cluster <%d> dns server <%d, %s> removed",
+		c.meta.ID,
+		id,
+		addr)
+}
+
+func newDNSServer(cluster *metapb.Cluster, addr string) *metapb.Server {
+	dns := cluster.DNS
+	return &metapb.Server{
+		ID:             dnsServerID(cluster.ID, addr),
+		Addr:           addr,
+		Protocol:       dns.Protocol,
+		MaxQPS:         dns.MaxQPS,
+		HeathCheck:     dns.HeathCheck,
+		CircuitBreaker: dns.CircuitBreaker,
+	}
+}
+
+func dnsServerID(cluster uint64, addr string) uint64 {
+	h := fnv.New64a()
+	fmt.Fprintf(h, "%d/%s", cluster, addr)
+	return h.Sum64() | dnsServerIDMask
+}
+
+func dnsRefreshInterval(dns *metapb.DNSTarget) time.Duration {
+	if dns.RefreshInterval > 0 {
+		return time.Second * time.Duration(dns.RefreshInterval)
+	}
+
+	return defaultDNSRefreshInterval
+}
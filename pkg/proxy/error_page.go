@@ -0,0 +1,125 @@
+package proxy
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+
+	pbutil "github.com/fagongzi/gateway/pkg/pb"
+	"github.com/fagongzi/gateway/pkg/pb/metapb"
+	"github.com/valyala/fasthttp"
+)
+
+const (
+	requestIDHeader = "X-Request-Id"
+)
+
+type errorPages []*metapb.ErrorPage
+
+func parseErrorPages(file string) (errorPages, error) {
+	if file == "" {
+		return nil, nil
+	}
+
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	var pages errorPages
+	err = json.Unmarshal(data, &pages)
+	if err != nil {
+		return nil, err
+	}
+
+	err = pbutil.ValidateErrorPages(pages)
+	if err != nil {
+		return nil, err
+	}
+
+	return pages, nil
+}
+
+// match returns the error page that match the status code,
+// the page with the same status is preferred over the page that match all
+func (pages errorPages) match(code int) *metapb.ErrorPage {
+	var all *metapb.ErrorPage
+	for _, page := range pages {
+		if int(page.Status) == code {
+			return page
+		}
+
+		if page.Status == 0 && all == nil {
+			all = page
+		}
+	}
+
+	return all
+}
+
+// bodyError is the error that has a structured response body
+type bodyError interface {
+	error
+	contentType() string
+	body() []byte
+}
+
+// headerError is the error that has the response headers
+type headerError interface {
+	error
+	headers(*fasthttp.ResponseHeader)
+}
+
+// writeError write the gateway generated error, using the api error pages first,
+// then the proxy error pages, then the body of the error, otherwise only the status code
+func writeError(ctx *fasthttp.RequestCtx, api *apiRuntime, defaultPages errorPages, code int, err error) {
+	if value, ok := err.(headerError); ok {
+		value.headers(&ctx.Response.Header)
+	}
+
+	var page *metapb.ErrorPage
+	if api != nil {
+		page = errorPages(api.meta.ErrorPages).match(code)
+	}
+	if page == nil {
+		page = defaultPages.match(code)
+	}
+
+	if page == nil {
+		ctx.SetStatusCode(code)
+		if value, ok := err.(bodyError); ok {
+			ctx.Response.Header.SetContentType(value.contentType())
+			ctx.SetBody(value.body())
+		}
+		return
+	}
+
+	if page.Code > 0 {
+		ctx.SetStatusCode(int(page.Code))
+	} else {
+		ctx.SetStatusCode(code)
+	}
+
+	header := &ctx.Response.Header
+	if page.ContentType != "" {
+		header.SetContentType(page.ContentType)
+	}
+	for _, h := range page.Headers {
+		header.Add(h.Name, h.Value)
+	}
+
+	replacer := strings.NewReplacer("$requestID", getRequestID(ctx),
+		"$code", strconv.Itoa(code),
+		"$message", http.StatusText(code))
+	ctx.SetBodyString(replacer.Replace(applyTemplate(page.Body, api, &ctx.Request)))
+}
+
+func getRequestID(ctx *fasthttp.RequestCtx) string {
+	if value := ctx.Request.Header.Peek(requestIDHeader); len(value) > 0 {
+		return string(value)
+	}
+
+	return strconv.FormatUint(ctx.ID(), 10)
+}
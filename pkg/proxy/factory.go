@@ -28,6 +28,8 @@ const (
 	FilterAnalysis = "ANALYSIS"
 	// FilterRateLimiting limit filter
 	FilterRateLimiting = "RATE-LIMITING"
+	// FilterRateLimits token bucket rate limits filter
+	FilterRateLimits = "RATE-LIMITS"
 	// FilterCircuitBreake circuit breake filter
 	FilterCircuitBreake = "CIRCUIT-BREAKER"
 	// FilterValidation validation request filter
@@ -36,6 +38,20 @@ const (
 	FilterCaching = "CACHING"
 	// FilterJWT jwt filter
 	FilterJWT = "JWT"
+	// FilterOutboundAuth outbound auth filter
+	FilterOutboundAuth = "OUTBOUND-AUTH"
+	// FilterTokenExchange token exchange filter
+	FilterTokenExchange = "TOKEN-EXCHANGE"
+	// FilterOIDC openid connect access token filter
+	FilterOIDC = "OIDC"
+	// FilterAccessPolicy access policy filter
+	FilterAccessPolicy = "ACCESS-POLICY"
+	// FilterKeyAuth api key auth filter
+	FilterKeyAuth = "KEY-AUTH"
+	// FilterContentType content type allowlist filter
+	FilterContentType = "CONTENT-TYPE"
+	// FilterFederation remote gateway federation filter
+	FilterFederation = "FEDERATION"
 )
 
 func (p *Proxy) newFilter(filterSpec *FilterSpec) (filter.Filter, error) {
@@ -56,18 +72,34 @@ func (p *Proxy) newFilter(filterSpec *FilterSpec) (filter.Filter, error) {
 		return newAnalysisFilter(), nil
 	case FilterBlackList:
 		return newBlackListFilter(), nil
+	case FilterAccessPolicy:
+		return newAccessPolicyFilter(), nil
+	case FilterKeyAuth:
+		return newKeyAuthFilter(), nil
+	case FilterContentType:
+		return newContentTypeFilter(), nil
 	case FilterWhiteList:
 		return newWhiteListFilter(), nil
 	case FilterRateLimiting:
 		return newRateLimitingFilter(), nil
+	case FilterRateLimits:
+		return newRateLimitsFilter(), nil
 	case FilterCircuitBreake:
 		return newCircuitBreakeFilter(), nil
 	case FilterValidation:
 		return newValidationFilter(), nil
 	case FilterCaching:
-		return newCachingFilter(p.cfg.Option.LimitBytesCaching, p.dispatcher.tw), nil
+		return newCachingFilter(p.cfg.Option.LimitBytesCaching, p.cfg.Option.CachingRedisAddr), nil
 	case FilterJWT:
 		return newJWTFilter(p.cfg.Option.JWTCfgFile)
+	case FilterFederation:
+		return newFederationFilter(), nil
+	case FilterOutboundAuth:
+		return newOutboundAuthFilter(), nil
+	case FilterTokenExchange:
+		return newTokenExchangeFilter(p.cfg.Option.TokenExchangeCfgFile, p.dispatcher.tw)
+	case FilterOIDC:
+		return newOIDCFilter(p.cfg.Option.OIDCCfgFile)
 	default:
 		return nil, ErrUnknownFilter
 	}
@@ -8,14 +8,17 @@ import (
 	"github.com/fagongzi/gateway/pkg/pb/metapb"
 	"github.com/fagongzi/gateway/pkg/util"
 	"github.com/valyala/fasthttp"
-	"golang.org/x/time/rate"
 )
 
 func (f *Proxy) doPreFilters(c filter.Context) (filterName string, statusCode int, err error) {
+	parent := contextSpan(c)
 	for _, f := range f.filters {
 		filterName = f.Name()
 
+		sp := parent.filter(filterName, "pre")
 		statusCode, err = f.Pre(c)
+		sp.setError(err)
+		sp.finish()
 		if nil != err {
 			return filterName, statusCode, err
 		}
@@ -25,10 +28,14 @@ func (f *Proxy) doPreFilters(c filter.Context) (filterName string, statusCode in
 }
 
 func (f *Proxy) doPostFilters(c filter.Context) (filterName string, statusCode int, err error) {
+	parent := contextSpan(c)
 	l := len(f.filters)
 	for i := l - 1; i >= 0; i-- {
 		f := f.filters[i]
+		sp := parent.filter(f.Name(), "post")
 		statusCode, err = f.Post(c)
+		sp.setError(err)
+		sp.finish()
 		if nil != err {
 			return filterName, statusCode, err
 		}
@@ -45,6 +52,15 @@ func (f *Proxy) doPostErrFilters(c filter.Context) {
 	}
 }
 
+// contextSpan returns the span of the request of the filter context, nil if not traced
+func contextSpan(c filter.Context) *span {
+	if pc, ok := c.(*proxyContext); ok && pc.result != nil {
+		return pc.result.span
+	}
+
+	return nil
+}
+
 type proxyContext struct {
 	startAt    time.Time
 	endAt      time.Time
@@ -100,6 +116,10 @@ func (c *proxyContext) Server() *metapb.Server {
 	return c.result.dest.meta
 }
 
+func (c *proxyContext) cluster() *metapb.Cluster {
+	return c.result.cluster
+}
+
 func (c *proxyContext) ForwardRequest() *fasthttp.Request {
 	return c.forwardReq
 }
@@ -140,7 +160,7 @@ func (c *proxyContext) circuitResourceID() uint64 {
 	return c.result.dest.id
 }
 
-func (c *proxyContext) rateLimiter() *rate.Limiter {
+func (c *proxyContext) rateLimiter() *util.RateLimiter {
 	if c.result.api.limiter != nil {
 		return c.result.api.limiter
 	}
@@ -164,6 +184,37 @@ func (c *proxyContext) circuitStatus() metapb.CircuitStatus {
 	return c.result.dest.getCircuitStatus()
 }
 
+// allowHalfOpen returns true if the request can go through the half-open circuit,
+// the server circuit using the half-open probe setting of the cluster if exists
+func (c *proxyContext) allowHalfOpen(barrier *util.RateBarrier) bool {
+	probe := c.result.probe
+	if c.result.api.cb != nil || probe == nil {
+		return barrier.Allow()
+	}
+
+	svr := c.result.dest
+	switch probe.meta.Strategy {
+	case metapb.SyntheticProbe:
+		if svr.meta.HeathCheck == nil {
+			break
+		}
+
+		max := probe.meta.MaxRequests
+		if max <= 0 {
+			max = 1
+		}
+		if svr.allowProbe(max) {
+			c.rt.probeServer(svr)
+		}
+		return false
+	}
+
+	if probe.barrier != nil {
+		barrier = probe.barrier
+	}
+	return barrier.Allow() && svr.allowProbe(probe.meta.MaxRequests)
+}
+
 func (c *proxyContext) changeCircuitStatusToClose() {
 	if c.result.api.cb != nil {
 		c.result.api.circuitToClose()
@@ -6,7 +6,8 @@ import (
 )
 
 // AccessFilter record the http access log
-// log format: $remoteip "$method $path" $code "$agent" $svr $cost
+// log format: $remoteip "$method $path" $code "$agent" $svr $cost, the apis can custom the format,
+// the fields and the sampling
 type AccessFilter struct {
 	filter.BaseFilter
 }
@@ -27,9 +28,20 @@ func (f *AccessFilter) Name() string {
 
 // Post execute after proxy
 func (f *AccessFilter) Post(c filter.Context) (statusCode int, err error) {
-	cost := c.EndAt().Sub(c.StartAt())
+	if !log.InfoEnabled() {
+		return f.BaseFilter.Post(c)
+	}
 
-	if log.InfoEnabled() {
+	pc := c.(*proxyContext)
+	if l := pc.result.api.accessLog; l != nil {
+		if l.sample(pc.rt.override) {
+			log.Infof("filter: %s", l.format(c))
+		}
+		return f.BaseFilter.Post(c)
+	}
+
+	cost := c.EndAt().Sub(c.StartAt())
+	if pc.rt.override.sampleAccessLog() {
 		log.Infof("filter: %s %s \"%s\" %d \"%s\" %s %s",
 			GetRealClientIP(c.OriginRequest()),
 			c.OriginRequest().Method(),
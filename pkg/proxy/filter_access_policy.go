@@ -0,0 +1,70 @@
+package proxy
+
+import (
+	"errors"
+	"time"
+
+	"github.com/fagongzi/gateway/pkg/filter"
+	"github.com/fagongzi/log"
+	"github.com/valyala/fasthttp"
+)
+
+var (
+	// ErrOutOfTimeWindow the api is not accessible at this time
+	ErrOutOfTimeWindow = errors.New("api is not accessible at this time")
+	// ErrAnomalyBlocked the client is blocked by the anomaly traffic
+	ErrAnomalyBlocked = errors.New("client is blocked by anomaly traffic")
+)
+
+// AccessPolicyFilter restrict the api by the time windows and the anomaly traffic of the clients
+type AccessPolicyFilter struct {
+	filter.BaseFilter
+}
+
+func newAccessPolicyFilter() filter.Filter {
+	return &AccessPolicyFilter{}
+}
+
+// Init init filter
+func (f *AccessPolicyFilter) Init(cfg string) error {
+	return nil
+}
+
+// Name return name of this filter
+func (f *AccessPolicyFilter) Name() string {
+	return FilterAccessPolicy
+}
+
+// Pre execute before proxy
+func (f *AccessPolicyFilter) Pre(c filter.Context) (statusCode int, err error) {
+	policy := c.(*proxyContext).result.api.policy
+	if policy == nil {
+		return f.BaseFilter.Pre(c)
+	}
+
+	ip := GetRealClientIP(c.OriginRequest())
+	client := ip
+	if policy.anomaly != nil && policy.anomaly.meta.Header != "" {
+		if value := c.OriginRequest().Request.Header.Peek(policy.anomaly.meta.Header); len(value) > 0 {
+			client = string(value)
+		}
+	}
+
+	if policy.overrides[ip] || policy.overrides[client] {
+		return f.BaseFilter.Pre(c)
+	}
+
+	now := time.Now()
+	if !policy.inTimeWindows(now) {
+		return fasthttp.StatusForbidden, ErrOutOfTimeWindow
+	}
+
+	if policy.anomaly != nil && !policy.anomaly.allow(client, now.Unix()) {
+		log.Warnf("filter-access-policy: client %s of api %s is blocked by anomaly traffic",
+			client,
+			c.API().Name)
+		return fasthttp.StatusTooManyRequests, ErrAnomalyBlocked
+	}
+
+	return f.BaseFilter.Pre(c)
+}
@@ -1,35 +1,46 @@
 package proxy
 
 import (
+	"bytes"
+	"errors"
+	"fmt"
 	"strings"
 	"sync"
 	"time"
 
 	"github.com/fagongzi/gateway/pkg/filter"
 	"github.com/fagongzi/gateway/pkg/pb/metapb"
-	"github.com/fagongzi/gateway/pkg/util"
-	"github.com/fagongzi/goetty"
+	"github.com/fagongzi/log"
 	"github.com/fagongzi/util/hack"
 	"github.com/valyala/fasthttp"
 )
 
 var (
+	errCachingDisabled = errors.New("caching filter is disabled")
+
 	cachePool sync.Pool
+
+	noStore = []byte("no-store")
+	private = []byte("private")
 )
 
-// CachingFilter cache api result
+// CachingFilter cache the successful GET responses of the apis, the responses are cached in the proxy
+// or in the redis that shared by the proxies
 type CachingFilter struct {
 	filter.BaseFilter
 
-	tw    *goetty.TimeoutWheel
-	cache *util.Cache
+	cache responseCache
 }
 
-func newCachingFilter(maxBytes uint64, tw *goetty.TimeoutWheel) filter.Filter {
-	return &CachingFilter{
-		tw:    tw,
-		cache: util.NewLRUCache(maxBytes),
+func newCachingFilter(maxBytes uint64, redisAddr string) filter.Filter {
+	f := &CachingFilter{}
+	if redisAddr != "" {
+		f.cache = newRedisCache(redisAddr)
+	} else {
+		f.cache = newLocalCache(maxBytes)
 	}
+
+	return f
 }
 
 // Name return name of this filter
@@ -39,51 +50,86 @@ func (f *CachingFilter) Name() string {
 
 // Pre execute before proxy
 func (f *CachingFilter) Pre(c filter.Context) (statusCode int, err error) {
-	if c.DispatchNode().Cache == nil {
-		return f.BaseFilter.Post(c)
+	dn := c.(*proxyContext).result
+	cache := dn.cache()
+	if cache == nil || !c.ForwardRequest().Header.IsGet() {
+		return f.BaseFilter.Pre(c)
 	}
 
-	matches, id := getCachingID(c)
+	matches, id := getCachingID(c, cache)
 	if !matches {
-		return f.BaseFilter.Post(c)
+		return f.BaseFilter.Pre(c)
+	}
+
+	value, ok, err := f.cache.get(id)
+	if err != nil {
+		log.Errorf("%s: get cached response of dispatch node %d failed, errors:\n%+v",
+			dn.requestTag,
+			dn.idx,
+			err)
 	}
 
-	if value, ok := f.cache.Get(id); ok {
+	if ok {
 		c.SetAttr(filter.UsingCachingValue, value)
+		incrNodeCacheHit(dn.api.meta.Name, dn.idx)
+	} else {
+		incrNodeCacheMiss(dn.api.meta.Name, dn.idx)
 	}
 
-	return f.BaseFilter.Post(c)
+	return f.BaseFilter.Pre(c)
 }
 
 // Post execute after proxy
 func (f *CachingFilter) Post(c filter.Context) (statusCode int, err error) {
-	if c.DispatchNode().Cache == nil {
+	dn := c.(*proxyContext).result
+	cache := dn.cache()
+	if cache == nil || cache.Deadline == 0 ||
+		!c.ForwardRequest().Header.IsGet() ||
+		!cacheable(c.Response()) {
 		return f.BaseFilter.Post(c)
 	}
 
-	matches, id := getCachingID(c)
+	matches, id := getCachingID(c, cache)
 	if !matches {
 		return f.BaseFilter.Post(c)
 	}
 
-	f.cache.Add(id, genCachedValue(c))
-	f.tw.Schedule(time.Second*time.Duration(c.DispatchNode().Cache.Deadline),
-		f.removeCache, id)
+	err = f.cache.set(id, genCachedValue(c), time.Second*time.Duration(cache.Deadline))
+	if err != nil {
+		log.Errorf("%s: cache response of dispatch node %d failed, errors:\n%+v",
+			dn.requestTag,
+			dn.idx,
+			err)
+	}
+
 	return f.BaseFilter.Post(c)
 }
 
-func (f *CachingFilter) removeCache(id interface{}) {
-	f.cache.Remove(id)
+// removeAPI removes the cached responses of the api, returns the number of the removed responses
+func (f *CachingFilter) removeAPI(id uint64) (int, error) {
+	return f.cache.removeAPI(id)
 }
 
-func getCachingID(c filter.Context) (bool, string) {
+// cacheable returns true if the response is a successful response that allowed to be cached by
+// the shared caches
+func cacheable(res *fasthttp.Response) bool {
+	if res.StatusCode() != fasthttp.StatusOK {
+		return false
+	}
+
+	value := bytes.ToLower(res.Header.Peek("Cache-Control"))
+	return !bytes.Contains(value, noStore) && !bytes.Contains(value, private)
+}
+
+func getCachingID(c filter.Context, cache *metapb.Cache) (bool, string) {
+	dn := c.(*proxyContext).result
 	req := c.ForwardRequest()
-	if len(c.DispatchNode().Cache.Conditions) == 0 {
-		return true, getID(req, c.DispatchNode().Cache.Keys)
+	if len(cache.Conditions) == 0 {
+		return true, getID(dn, req, cache)
 	}
 
 	matches := true
-	for _, cond := range c.DispatchNode().Cache.Conditions {
+	for _, cond := range cache.Conditions {
 		matches = conditionsMatches(&cond, req)
 		if !matches {
 			break
@@ -94,19 +140,23 @@ func getCachingID(c filter.Context) (bool, string) {
 		return false, ""
 	}
 
-	return matches, getID(req, c.DispatchNode().Cache.Keys)
+	return matches, getID(dn, req, cache)
 }
 
-func getID(req *fasthttp.Request, keys []metapb.Parameter) string {
-	size := len(keys)
-	if size == 0 {
-		return hack.SliceToString(req.RequestURI())
+// getID returns the cache id of the dispatch node, every node of the api has its own cached values
+// and deadline even if the forward requests of the nodes are the same. The id is prefixed by the api id,
+// so the cached responses of the api can be removed together
+func getID(dn *dispathNode, req *fasthttp.Request, cache *metapb.Cache) string {
+	size := len(cache.Keys)
+	ids := make([]string, size+2, size+2)
+	ids[0] = fmt.Sprintf("%s%d", cacheAPIPrefix(dn.api.meta.ID), dn.idx)
+	if cache.IgnoreQuery {
+		ids[1] = hack.SliceToString(req.URI().Path())
+	} else {
+		ids[1] = hack.SliceToString(req.RequestURI())
 	}
-
-	ids := make([]string, size+1, size+1)
-	ids[0] = hack.SliceToString(req.RequestURI())
-	for idx, param := range keys {
-		ids[idx+1] = paramValue(&param, req)
+	for idx, param := range cache.Keys {
+		ids[idx+2] = paramValue(&param, req)
 	}
 
 	return strings.Join(ids, "-")
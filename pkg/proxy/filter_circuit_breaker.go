@@ -7,6 +7,7 @@ import (
 
 	"github.com/fagongzi/gateway/pkg/filter"
 	"github.com/fagongzi/gateway/pkg/pb/metapb"
+	"github.com/fagongzi/gateway/pkg/util"
 )
 
 var (
@@ -48,7 +49,7 @@ func (f *CircuitBreakeFilter) Pre(c filter.Context) (statusCode int, err error)
 
 	switch protectedResourceStatus {
 	case metapb.Open:
-		if c.Analysis().GetRecentlyRequestFailureRate(protectedResource, time.Duration(cb.RateCheckPeriod)) >= int(cb.FailureRateToClose) {
+		if circuitShouldClose(c.Analysis(), protectedResource, cb) {
 			pc.changeCircuitStatusToClose()
 			c.Analysis().Reject(protectedResource)
 			return http.StatusServiceUnavailable, ErrCircuitClose
@@ -56,7 +57,7 @@ func (f *CircuitBreakeFilter) Pre(c filter.Context) (statusCode int, err error)
 
 		return http.StatusOK, nil
 	case metapb.Half:
-		if barrier.Allow() {
+		if pc.allowHalfOpen(barrier) {
 			return f.BaseFilter.Pre(c)
 		}
 
@@ -100,7 +101,19 @@ func (f *CircuitBreakeFilter) PostErr(c filter.Context) {
 	protectedResource := pc.circuitResourceID()
 
 	if protectedResourceStatus == metapb.Half &&
-		c.Analysis().GetRecentlyRequestFailureRate(protectedResource, time.Duration(cb.RateCheckPeriod)) >= int(cb.FailureRateToClose) {
+		circuitShouldClose(c.Analysis(), protectedResource, cb) {
 		pc.changeCircuitStatusToClose()
 	}
 }
+
+// circuitShouldClose returns true if the continuous failures or the failure rate of the resource
+// reach the circuit breaker, the conditions with 0 are disabled
+func circuitShouldClose(analysis *util.Analysis, id uint64, cb *metapb.CircuitBreaker) bool {
+	if cb.ContinuousFailuresToClose > 0 &&
+		analysis.GetContinuousFailureCount(id) >= int(cb.ContinuousFailuresToClose) {
+		return true
+	}
+
+	return cb.FailureRateToClose > 0 &&
+		analysis.GetRecentlyRequestFailureRate(id, time.Duration(cb.RateCheckPeriod)) >= int(cb.FailureRateToClose)
+}
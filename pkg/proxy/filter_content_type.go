@@ -0,0 +1,140 @@
+package proxy
+
+import (
+	"bytes"
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/fagongzi/gateway/pkg/filter"
+	"github.com/fagongzi/util/hack"
+	"github.com/valyala/fasthttp"
+)
+
+var (
+	// ErrUnsupportedMediaType the content-type of the request is not consumed by the api
+	ErrUnsupportedMediaType = errors.New("unsupported media type")
+	// ErrNotAcceptable the request accepts none of the media types that produced by the api
+	ErrNotAcceptable = errors.New("not acceptable")
+)
+
+// ContentTypeFilter reject the requests that the api can't consume or produce, so the backends
+// not waste the cycles on the payloads that they'll reject anyway
+type ContentTypeFilter struct {
+	filter.BaseFilter
+}
+
+func newContentTypeFilter() filter.Filter {
+	return &ContentTypeFilter{}
+}
+
+// Init init filter
+func (f *ContentTypeFilter) Init(cfg string) error {
+	return nil
+}
+
+// Name return name of this filter
+func (f *ContentTypeFilter) Name() string {
+	return FilterContentType
+}
+
+// Pre execute before proxy
+func (f *ContentTypeFilter) Pre(c filter.Context) (statusCode int, err error) {
+	types := c.API().ContentTypes
+	if types == nil {
+		return f.BaseFilter.Pre(c)
+	}
+
+	req := &c.OriginRequest().Request
+	if len(types.Consumes) > 0 && !consumable(req, types.Consumes) {
+		return fasthttp.StatusUnsupportedMediaType, ErrUnsupportedMediaType
+	}
+
+	if len(types.Produces) > 0 && !acceptable(req.Header.Peek("Accept"), types.Produces) {
+		return fasthttp.StatusNotAcceptable, ErrNotAcceptable
+	}
+
+	return f.BaseFilter.Pre(c)
+}
+
+// consumable returns true if the content-type of the request is in the types, the request without
+// content-type is consumable only if it has no body
+func consumable(req *fasthttp.Request, types []string) bool {
+	value := mediaType(req.Header.ContentType())
+	if value == "" {
+		return req.Header.ContentLength() <= 0 && len(req.Body()) == 0
+	}
+
+	for _, t := range types {
+		if matchMediaType(t, value) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// acceptable returns true if one of the media ranges of the accept header matches one of the types,
+// the request without accept header accepts all, the media range with q=0 is not acceptable
+func acceptable(accept []byte, types []string) bool {
+	if len(bytes.TrimSpace(accept)) == 0 {
+		return true
+	}
+
+	for _, value := range strings.Split(hack.SliceToString(accept), ",") {
+		if quality(value) == 0 {
+			continue
+		}
+
+		r := mediaType([]byte(value))
+		for _, t := range types {
+			if matchMediaType(t, r) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// mediaType returns the lower case media type without the parameters
+func mediaType(value []byte) string {
+	if idx := bytes.IndexByte(value, ';'); idx >= 0 {
+		value = value[:idx]
+	}
+
+	return strings.ToLower(string(bytes.TrimSpace(value)))
+}
+
+// quality returns the q parameter of the media range, default is 1
+func quality(value string) float64 {
+	for _, param := range strings.Split(value, ";")[1:] {
+		param = strings.TrimSpace(param)
+		if strings.HasPrefix(param, "q=") {
+			q, err := strconv.ParseFloat(param[2:], 64)
+			if err != nil {
+				return 0
+			}
+			return q
+		}
+	}
+
+	return 1
+}
+
+// matchMediaType returns true if the media types match, both can have the wildcards
+func matchMediaType(a, b string) bool {
+	at, as := splitMediaType(strings.ToLower(a))
+	bt, bs := splitMediaType(b)
+
+	return (at == "*" || bt == "*" || at == bt) &&
+		(as == "*" || bs == "*" || as == bs)
+}
+
+func splitMediaType(value string) (string, string) {
+	if idx := strings.IndexByte(value, '/'); idx >= 0 {
+		return value[:idx], value[idx+1:]
+	}
+
+	return value, ""
+}
@@ -0,0 +1,131 @@
+package proxy
+
+import (
+	"crypto/hmac"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/fagongzi/gateway/pkg/filter"
+	"github.com/valyala/fasthttp"
+)
+
+const (
+	gatewayHopsHeader      = "X-Gateway-Hops"
+	gatewayConsumerSign    = "X-Gateway-Consumer-Signature"
+	gatewayConsumerTime    = "X-Gateway-Consumer-Timestamp"
+	defaultGatewayMaxHops  = 3
+	maxGatewaySignatureAge = time.Minute * 5
+)
+
+var (
+	// ErrTooManyGatewayHops the request go through too many gateways, maybe the gateways are looped
+	ErrTooManyGatewayHops = errors.New("too many gateway hops")
+)
+
+// FederationFilter forward the requests to the remote gateways, the request id and the consumer of
+// the request are forwarded, so the remote gateways trace and authenticate the request as the same one.
+// The trace headers of the client are forwarded as the other headers
+type FederationFilter struct {
+	filter.BaseFilter
+}
+
+func newFederationFilter() filter.Filter {
+	return &FederationFilter{}
+}
+
+// Init init filter
+func (f *FederationFilter) Init(cfg string) error {
+	return nil
+}
+
+// Name return name of this filter
+func (f *FederationFilter) Name() string {
+	return FilterFederation
+}
+
+// Pre execute before proxy
+func (f *FederationFilter) Pre(c filter.Context) (statusCode int, err error) {
+	cluster := c.(*proxyContext).cluster()
+	if cluster == nil || cluster.RemoteGateway == nil {
+		return f.BaseFilter.Pre(c)
+	}
+
+	remote := cluster.RemoteGateway
+	maxHops := int(remote.MaxHops)
+	if maxHops == 0 {
+		maxHops = defaultGatewayMaxHops
+	}
+
+	hops, _ := strconv.Atoi(string(c.OriginRequest().Request.Header.Peek(gatewayHopsHeader)))
+	if hops+1 > maxHops {
+		return fasthttp.StatusLoopDetected, ErrTooManyGatewayHops
+	}
+
+	req := c.ForwardRequest()
+	requestID := getRequestID(c.OriginRequest())
+	req.Header.Set(requestIDHeader, requestID)
+	req.Header.Set(gatewayHopsHeader, strconv.Itoa(hops+1))
+
+	// only the consumer that authenticated by the KEY-AUTH filter is signed, the consumer header of
+	// the client is never trusted
+	consumer, _ := c.GetAttr(consumerAttr).(string)
+	if consumer == "" {
+		stripConsumerHeaders(&req.Header)
+		return f.BaseFilter.Pre(c)
+	}
+
+	ts := fmt.Sprintf("%d", time.Now().Unix())
+	req.Header.Set(consumerNameHeader, consumer)
+	req.Header.Set(gatewayConsumerTime, ts)
+	req.Header.Set(gatewayConsumerSign, signConsumer(remote.Secret, consumer, requestID, ts))
+	return f.BaseFilter.Pre(c)
+}
+
+// stripConsumerHeaders remove the consumer and the signature headers, the headers of the clients are
+// never trusted, they are only set by the filters
+func stripConsumerHeaders(header *fasthttp.RequestHeader) {
+	header.Del(consumerNameHeader)
+	header.Del(gatewayConsumerSign)
+	header.Del(gatewayConsumerTime)
+}
+
+// signConsumer returns hex(hmac-sha256(secret, consumer\nrequest id\ntimestamp))
+func signConsumer(secret, consumer, requestID, ts string) string {
+	data := strings.Join([]string{consumer, requestID, ts}, "\n")
+	return hex.EncodeToString(hmacSHA256([]byte(secret), data))
+}
+
+// federatedConsumer returns the consumer that signed by the gateway that shares the secret
+func federatedConsumer(req *fasthttp.Request, secret string, now time.Time) (string, bool) {
+	if secret == "" {
+		return "", false
+	}
+
+	consumer := string(req.Header.Peek(consumerNameHeader))
+	sign := string(req.Header.Peek(gatewayConsumerSign))
+	ts := string(req.Header.Peek(gatewayConsumerTime))
+	if consumer == "" || sign == "" || ts == "" {
+		return "", false
+	}
+
+	value, err := strconv.ParseInt(ts, 10, 64)
+	if err != nil {
+		return "", false
+	}
+
+	age := now.Sub(time.Unix(value, 0))
+	if age > maxGatewaySignatureAge || age < -maxGatewaySignatureAge {
+		return "", false
+	}
+
+	expect := signConsumer(secret, consumer, string(req.Header.Peek(requestIDHeader)), ts)
+	if !hmac.Equal([]byte(sign), []byte(expect)) {
+		return "", false
+	}
+
+	return consumer, true
+}
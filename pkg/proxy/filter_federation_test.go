@@ -0,0 +1,50 @@
+package proxy
+
+import (
+	"testing"
+	"time"
+
+	"github.com/fagongzi/gateway/pkg/pb/metapb"
+	"github.com/valyala/fasthttp"
+)
+
+func newTestFederationContext(consumer string) *proxyContext {
+	ctx := &fasthttp.RequestCtx{}
+	ctx.Request.SetRequestURI("/")
+	ctx.Request.Header.Set(requestIDHeader, "1")
+	ctx.Request.Header.Set(consumerNameHeader, consumer)
+
+	c := &proxyContext{}
+	c.init(nil, ctx, copyRequest(&ctx.Request), &dispathNode{
+		cluster: &metapb.Cluster{RemoteGateway: &metapb.RemoteGateway{Secret: "secret"}},
+	})
+	return c
+}
+
+func TestFederationNeverSignClientConsumer(t *testing.T) {
+	c := newTestFederationContext("admin")
+	if _, err := newFederationFilter().Pre(c); err != nil {
+		t.Fatalf("pre failed, errors:%+v", err)
+	}
+
+	req := c.ForwardRequest()
+	if len(req.Header.Peek(consumerNameHeader)) > 0 || len(req.Header.Peek(gatewayConsumerSign)) > 0 {
+		t.Errorf("expect the consumer of the client is not signed, but %s", req.Header.String())
+	}
+	if _, ok := federatedConsumer(req, "secret", time.Now()); ok {
+		t.Errorf("expect the consumer of the client is not federated")
+	}
+}
+
+func TestFederationSignAuthenticatedConsumer(t *testing.T) {
+	c := newTestFederationContext("admin")
+	c.SetAttr(consumerAttr, "alice")
+	if _, err := newFederationFilter().Pre(c); err != nil {
+		t.Fatalf("pre failed, errors:%+v", err)
+	}
+
+	consumer, ok := federatedConsumer(c.ForwardRequest(), "secret", time.Now())
+	if !ok || consumer != "alice" {
+		t.Errorf("expect the authenticated consumer alice is federated, but %s, %v", consumer, ok)
+	}
+}
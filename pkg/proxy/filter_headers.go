@@ -40,7 +40,7 @@ func (f *HeadersFilter) Pre(c filter.Context) (statusCode int, err error) {
 		c.ForwardRequest().Header.Del(h)
 	}
 
-	c.ForwardRequest().Header.SetHost(c.Server().Addr)
+	c.ForwardRequest().Header.SetHost(c.(*proxyContext).result.upstreamHost())
 	return f.BaseFilter.Pre(c)
 }
 
@@ -2,6 +2,7 @@ package proxy
 
 import (
 	"bytes"
+	"crypto/rsa"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -24,6 +25,8 @@ const (
 	actionFetchToCookie string = "fetch_to_cookie"
 	ctxRenewTokenAttr   string = "__jwt_renew_token__"
 	jwtClaimsFieldExp   string = "exp"
+	defaultJWTLookup    string = "header:Authorization"
+	defaultJWTSchema    string = "Bearer"
 )
 
 var (
@@ -34,10 +37,13 @@ var (
 type tokenGetter func(filter.Context) (string, error)
 type action func(map[string]interface{}, string, jwt.MapClaims, filter.Context) (bool, error)
 
-// JWTCfg cfg
+// JWTCfg cfg, the HS methods use the secret, the RS methods use the PEM public key file or the JWKS url
 type JWTCfg struct {
 	Secret               string   `json:"secret"`
 	Method               string   `json:"method"`
+	PublicKey            string   `json:"publicKey,omitempty"`
+	JWKSURL              string   `json:"jwksURL,omitempty"`
+	JWKSRefresh          int64    `json:"jwksRefresh,omitempty"`
 	TokenLookup          string   `json:"tokenLookup"`
 	AuthSchema           string   `json:"authSchema"`
 	RenewTokenHeaderName string   `json:"renewTokenHeaderName,omitempty"`
@@ -65,10 +71,12 @@ type JWTFilter struct {
 
 	cfg              *JWTCfg
 	secretBytes      []byte
+	publicKey        *rsa.PublicKey
+	keySet           *jwks
 	getter           tokenGetter
 	redisPool        *redis.Pool
 	leaseTTLDuration time.Duration
-	signing          *jwt.SigningMethodHMAC
+	signing          jwt.SigningMethod
 	actions          []action
 	actionArgs       []map[string]interface{}
 }
@@ -109,12 +117,12 @@ func (f *JWTFilter) Pre(c filter.Context) (statusCode int, err error) {
 
 	token, err := f.getter(c)
 	if err != nil {
-		return fasthttp.StatusForbidden, err
+		return fasthttp.StatusUnauthorized, err
 	}
 
 	claims, err := f.parseJWTToken(token)
 	if err != nil {
-		return fasthttp.StatusForbidden, err
+		return fasthttp.StatusUnauthorized, err
 	}
 
 	for idx, act := range f.actions {
@@ -124,7 +132,7 @@ func (f *JWTFilter) Pre(c filter.Context) (statusCode int, err error) {
 		}
 
 		if !ok {
-			return fasthttp.StatusForbidden, nil
+			return fasthttp.StatusUnauthorized, errJWTInvalid
 		}
 	}
 
@@ -152,6 +160,14 @@ func (f *JWTFilter) parseCfg(file string) error {
 		return err
 	}
 
+	if cfg.TokenLookup == "" {
+		cfg.TokenLookup = defaultJWTLookup
+		cfg.AuthSchema = defaultJWTSchema
+	}
+	if len(strings.SplitN(cfg.TokenLookup, ":", 2)) != 2 {
+		return fmt.Errorf("error token lookup: %s", cfg.TokenLookup)
+	}
+
 	f.cfg = cfg
 	f.secretBytes = []byte(f.cfg.Secret)
 	return nil
@@ -173,17 +189,51 @@ func (f *JWTFilter) initRedisPool() {
 }
 
 func (f *JWTFilter) initSigningMethod() error {
-	if f.cfg.Method == "HS256" {
+	switch f.cfg.Method {
+	case "HS256":
 		f.signing = jwt.SigningMethodHS256
-	} else if f.cfg.Method == "HS384" {
+	case "HS384":
 		f.signing = jwt.SigningMethodHS384
-	} else if f.cfg.Method == "HS512" {
+	case "HS512":
 		f.signing = jwt.SigningMethodHS512
-	} else {
+	case "RS256":
+		f.signing = jwt.SigningMethodRS256
+	case "RS384":
+		f.signing = jwt.SigningMethodRS384
+	case "RS512":
+		f.signing = jwt.SigningMethodRS512
+	default:
 		return fmt.Errorf("unsupport method: %s", f.cfg.Method)
 	}
 
-	return nil
+	if !f.isRSA() {
+		if f.cfg.Secret == "" {
+			return fmt.Errorf("missing secret of method: %s", f.cfg.Method)
+		}
+		return nil
+	}
+
+	if f.cfg.PublicKey != "" {
+		data, err := ioutil.ReadFile(f.cfg.PublicKey)
+		if err != nil {
+			return err
+		}
+
+		f.publicKey, err = jwt.ParseRSAPublicKeyFromPEM(data)
+		return err
+	}
+
+	if f.cfg.JWKSURL != "" {
+		f.keySet = newJWKS(f.cfg.JWKSURL, time.Second*time.Duration(f.cfg.JWKSRefresh))
+		return nil
+	}
+
+	return fmt.Errorf("missing public key or jwks url of method: %s", f.cfg.Method)
+}
+
+func (f *JWTFilter) isRSA() bool {
+	_, ok := f.signing.(*jwt.SigningMethodRSA)
+	return ok
 }
 
 func (f *JWTFilter) initTokenLookup() {
@@ -205,6 +255,9 @@ func (f *JWTFilter) initActions() error {
 		case actionTokenInRedis:
 			f.actions = append(f.actions, f.tokenInRedisAction)
 		case actionRenewByRaw:
+			if f.isRSA() {
+				return fmt.Errorf("action %s not support method: %s", c.Method, f.cfg.Method)
+			}
 			f.actions = append(f.actions, f.renewByRawAction)
 		case actionRenewByRedis:
 			f.actions = append(f.actions, f.renewByRedisAction)
@@ -222,11 +275,24 @@ func (f *JWTFilter) initActions() error {
 
 func (f *JWTFilter) parseJWTToken(tokenString string) (jwt.MapClaims, error) {
 	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+		if !f.isRSA() {
+			if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+				return nil, fmt.Errorf("Unexpected signing method: %v", token.Header["alg"])
+			}
+
+			return f.secretBytes, nil
+		}
+
+		if _, ok := token.Method.(*jwt.SigningMethodRSA); !ok {
 			return nil, fmt.Errorf("Unexpected signing method: %v", token.Header["alg"])
 		}
 
-		return f.secretBytes, nil
+		if f.keySet != nil {
+			kid, _ := token.Header["kid"].(string)
+			return f.keySet.get(kid)
+		}
+
+		return f.publicKey, nil
 	})
 
 	if err != nil {
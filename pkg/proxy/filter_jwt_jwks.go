@@ -0,0 +1,188 @@
+package proxy
+
+import (
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/fagongzi/log"
+)
+
+const (
+	defaultJWKSRefresh = time.Minute * 5
+	// minJWKSRefresh is the min interval of the refreshes that triggered by the unknown key ids, so the
+	// tokens with the random key ids can not flood the jwks server
+	minJWKSRefresh = time.Second * 10
+	jwksTimeout    = time.Second * 5
+)
+
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+}
+
+// jwks is the rsa public keys of the json web key set, the keys are refreshed periodically, and
+// refreshed if the key id of the token is unknown
+type jwks struct {
+	sync.RWMutex
+
+	url         string
+	cli         *http.Client
+	keys        map[string]*rsa.PublicKey
+	refreshedAt time.Time
+}
+
+func newJWKS(url string, refresh time.Duration) *jwks {
+	if refresh <= 0 {
+		refresh = defaultJWKSRefresh
+	}
+
+	k := &jwks{
+		url: url,
+		cli: &http.Client{
+			Timeout: jwksTimeout,
+		},
+	}
+
+	err := k.refresh()
+	if err != nil {
+		log.Errorf("jwks: refresh %s failed, errors:\n%+v", url, err)
+	}
+
+	go func() {
+		ticker := time.NewTicker(refresh)
+		defer ticker.Stop()
+
+		for range ticker.C {
+			err := k.refresh()
+			if err != nil {
+				log.Errorf("jwks: refresh %s failed, errors:\n%+v", url, err)
+			}
+		}
+	}()
+
+	return k
+}
+
+// get returns the key of the key id, empty key id is allowed if the key set has only one key
+func (k *jwks) get(kid string) (*rsa.PublicKey, error) {
+	if key, ok := k.lookup(kid); ok {
+		return key, nil
+	}
+
+	k.RLock()
+	refreshed := time.Since(k.refreshedAt) < minJWKSRefresh
+	k.RUnlock()
+
+	if !refreshed {
+		err := k.refresh()
+		if err != nil {
+			log.Errorf("jwks: refresh %s failed, errors:\n%+v", k.url, err)
+		}
+
+		if key, ok := k.lookup(kid); ok {
+			return key, nil
+		}
+	}
+
+	return nil, fmt.Errorf("unknown jwt key id: %s", kid)
+}
+
+func (k *jwks) lookup(kid string) (*rsa.PublicKey, bool) {
+	k.RLock()
+	defer k.RUnlock()
+
+	if kid == "" && len(k.keys) == 1 {
+		for _, key := range k.keys {
+			return key, true
+		}
+	}
+
+	key, ok := k.keys[kid]
+	return key, ok
+}
+
+func (k *jwks) refresh() error {
+	k.Lock()
+	k.refreshedAt = time.Now()
+	k.Unlock()
+
+	rsp, err := k.cli.Get(k.url)
+	if err != nil {
+		return err
+	}
+	defer rsp.Body.Close()
+
+	data, err := ioutil.ReadAll(rsp.Body)
+	if err != nil {
+		return err
+	}
+
+	if rsp.StatusCode != http.StatusOK {
+		return fmt.Errorf("jwks response %d: %s", rsp.StatusCode, strings.TrimSpace(string(data)))
+	}
+
+	keys, err := parseJWKS(data)
+	if err != nil {
+		return err
+	}
+
+	k.Lock()
+	k.keys = keys
+	k.Unlock()
+	return nil
+}
+
+// parseJWKS returns the rsa signing keys of the key set by the key id
+func parseJWKS(data []byte) (map[string]*rsa.PublicKey, error) {
+	value := struct {
+		Keys []jsonWebKey `json:"keys"`
+	}{}
+	err := json.Unmarshal(data, &value)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make(map[string]*rsa.PublicKey, len(value.Keys))
+	for _, key := range value.Keys {
+		if key.Kty != "RSA" || (key.Use != "" && key.Use != "sig") {
+			continue
+		}
+
+		n, err := decodeJWKInt(key.N)
+		if err != nil {
+			return nil, err
+		}
+
+		e, err := decodeJWKInt(key.E)
+		if err != nil {
+			return nil, err
+		}
+
+		keys[key.Kid] = &rsa.PublicKey{
+			N: n,
+			E: int(e.Int64()),
+		}
+	}
+
+	return keys, nil
+}
+
+func decodeJWKInt(value string) (*big.Int, error) {
+	data, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(value, "="))
+	if err != nil {
+		return nil, err
+	}
+
+	return new(big.Int).SetBytes(data), nil
+}
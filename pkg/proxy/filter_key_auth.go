@@ -0,0 +1,126 @@
+package proxy
+
+import (
+	"bytes"
+	"errors"
+	"time"
+
+	"github.com/fagongzi/gateway/pkg/filter"
+	"github.com/fagongzi/gateway/pkg/util"
+	"github.com/fagongzi/log"
+	"github.com/valyala/fasthttp"
+)
+
+const (
+	apiKeyHeader       = "X-Api-Key"
+	apiKeyQuery        = "apikey"
+	consumerNameHeader = "X-Consumer-Name"
+	// consumerAttr is the name of the authenticated consumer in the filter context
+	consumerAttr = "__consumer__"
+)
+
+var (
+	// ErrMissingAPIKey the api key is required
+	ErrMissingAPIKey = errors.New("missing api key")
+	// ErrInvalidAPIKey the api key is unknown
+	ErrInvalidAPIKey = errors.New("invalid api key")
+	// ErrExpiredAPIKey the api key is expired
+	ErrExpiredAPIKey = errors.New("api key expired")
+	// ErrQuotaExceeded the consumer exceeds the quota of the day
+	ErrQuotaExceeded = errors.New("consumer quota exceeded")
+	// ErrAPINotAllowed the api is not bound to the restricted consumer
+	ErrAPINotAllowed = errors.New("api not allowed for the consumer")
+)
+
+// KeyAuthFilter authenticate the consumers of the portal apis by the api keys or the access tokens
+// that issued by the oauth2 token endpoint, and restrict the consumers by the allowed apis and the
+// daily quota. The consumers that forwarded by the trusted gateways are already authenticated and
+// restricted by those gateways
+type KeyAuthFilter struct {
+	filter.BaseFilter
+}
+
+func newKeyAuthFilter() filter.Filter {
+	return &KeyAuthFilter{}
+}
+
+// Init init filter
+func (f *KeyAuthFilter) Init(cfg string) error {
+	return nil
+}
+
+// Name return name of this filter
+func (f *KeyAuthFilter) Name() string {
+	return FilterKeyAuth
+}
+
+// Pre execute before proxy
+func (f *KeyAuthFilter) Pre(c filter.Context) (statusCode int, err error) {
+	// the consumer header of the client is never trusted, it is only set by the authentication
+	c.ForwardRequest().Header.Del(consumerNameHeader)
+
+	portal := c.API().Portal
+	if portal == nil || !portal.KeyRequired {
+		return f.BaseFilter.Pre(c)
+	}
+
+	req := &c.OriginRequest().Request
+	key := string(req.Header.Peek(apiKeyHeader))
+	if key == "" {
+		key = string(req.URI().QueryArgs().Peek(apiKeyQuery))
+	}
+
+	now := time.Now()
+	rt := c.(*proxyContext).rt
+	var consumer *consumerRuntime
+	if key != "" {
+		hash := util.APIKeyHash(key)
+		value, ok := rt.apiKeys[hash]
+		if !ok {
+			return fasthttp.StatusUnauthorized, ErrInvalidAPIKey
+		}
+
+		if value.isKeyExpired(hash, now.Unix()) {
+			return fasthttp.StatusUnauthorized, ErrExpiredAPIKey
+		}
+		consumer = value
+	} else if token := req.Header.Peek("Authorization"); rt.oauth2 != nil && bytes.HasPrefix(token, bearerAuthPrefix) {
+		// the access token that issued by the oauth2 token endpoint
+		value, ok := rt.oauth2.validate(string(token[len(bearerAuthPrefix):]), rt, now.Unix())
+		if !ok {
+			return fasthttp.StatusUnauthorized, ErrInvalidAccessToken
+		}
+		consumer = value
+		c.ForwardRequest().Header.Del("Authorization")
+	} else {
+		// the consumer is authenticated by the gateway that forwarded the request
+		if name, ok := federatedConsumer(req, rt.cnf.Option.FederationSecret, now); ok {
+			c.SetAttr(consumerAttr, name)
+			c.ForwardRequest().Header.Set(consumerNameHeader, name)
+			c.ForwardRequest().Header.Del(gatewayConsumerSign)
+			c.ForwardRequest().Header.Del(gatewayConsumerTime)
+			return f.BaseFilter.Pre(c)
+		}
+
+		return fasthttp.StatusUnauthorized, ErrMissingAPIKey
+	}
+
+	if !consumer.isAPIAllowed(c.API().ID) {
+		log.Warnf("filter-key-auth: consumer %s is not allowed to call the api %d",
+			consumer.meta.Name,
+			c.API().ID)
+		return fasthttp.StatusForbidden, ErrAPINotAllowed
+	}
+
+	if !rt.usage.incr(consumer.meta.ID, c.API().ID, consumer.meta.Quota, now) {
+		log.Warnf("filter-key-auth: consumer %s exceeds the quota %d",
+			consumer.meta.Name,
+			consumer.meta.Quota)
+		return fasthttp.StatusTooManyRequests, ErrQuotaExceeded
+	}
+
+	c.ForwardRequest().Header.Del(apiKeyHeader)
+	c.ForwardRequest().Header.Set(consumerNameHeader, consumer.meta.Name)
+	c.SetAttr(consumerAttr, consumer.meta.Name)
+	return f.BaseFilter.Pre(c)
+}
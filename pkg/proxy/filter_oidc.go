@@ -0,0 +1,309 @@
+package proxy
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	jwt "github.com/dgrijalva/jwt-go"
+	"github.com/fagongzi/gateway/pkg/filter"
+	"github.com/fagongzi/gateway/pkg/pb/metapb"
+	"github.com/fagongzi/log"
+	"github.com/valyala/fasthttp"
+)
+
+const (
+	oidcDiscoveryPath = "/.well-known/openid-configuration"
+)
+
+var (
+	errOIDCDiscovery    = errors.New("oidc discovery not ready")
+	errOIDCIssuer       = errors.New("error oidc token issuer")
+	errOIDCAudience     = errors.New("error oidc token audience")
+	errOIDCExpired      = errors.New("missing or expired oidc token exp")
+	errOIDCInsufficient = errors.New("insufficient oidc token scopes")
+)
+
+// OIDCCfg oidc cfg, the jwks of the issuer is found by the discovery url, default is the
+// openid-configuration of the issuer
+type OIDCCfg struct {
+	Issuer        string `json:"issuer"`
+	DiscoveryURL  string `json:"discoveryURL,omitempty"`
+	Audience      string `json:"audience,omitempty"`
+	JWKSRefresh   int64  `json:"jwksRefresh,omitempty"`
+	SubjectHeader string `json:"subjectHeader,omitempty"`
+}
+
+type oidcDiscovery struct {
+	Issuer  string `json:"issuer"`
+	JWKSURI string `json:"jwks_uri"`
+}
+
+// oidcError is the rejected request of the oidc filter, the client gets the bearer challenge of rfc6750
+type oidcError struct {
+	err   error
+	code  string
+	scope string
+}
+
+func (e *oidcError) Error() string {
+	return e.err.Error()
+}
+
+func (e *oidcError) headers(header *fasthttp.ResponseHeader) {
+	value := "Bearer"
+	if e.code != "" {
+		value = fmt.Sprintf("%s error=\"%s\"", value, e.code)
+	}
+	if e.scope != "" {
+		value = fmt.Sprintf("%s, scope=\"%s\"", value, e.scope)
+	}
+	header.Set("WWW-Authenticate", value)
+}
+
+// OIDCFilter validate the bearer access tokens of the apis that the authFilter is OIDC by the issuer,
+// the signing keys are the jwks of the issuer that found by the discovery
+type OIDCFilter struct {
+	filter.BaseFilter
+	sync.Mutex
+
+	cfg          *OIDCCfg
+	cli          *http.Client
+	getter       tokenGetter
+	keySet       *jwks
+	discoveredAt time.Time
+}
+
+func newOIDCFilter(file string) (filter.Filter, error) {
+	f := &OIDCFilter{
+		cli: &http.Client{
+			Timeout: jwksTimeout,
+		},
+		getter: jwtFromHeader("Authorization", defaultJWTSchema),
+	}
+
+	err := f.parseCfg(file)
+	if err != nil {
+		return nil, err
+	}
+
+	// the issuer may be not ready, the discovery is retried by the requests
+	f.keys()
+	return f, nil
+}
+
+func (f *OIDCFilter) parseCfg(file string) error {
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return err
+	}
+
+	cfg := &OIDCCfg{}
+	err = json.Unmarshal(data, cfg)
+	if err != nil {
+		return err
+	}
+
+	if cfg.Issuer == "" {
+		return fmt.Errorf("missing oidc issuer")
+	}
+
+	if cfg.DiscoveryURL == "" {
+		cfg.DiscoveryURL = strings.TrimSuffix(cfg.Issuer, "/") + oidcDiscoveryPath
+	}
+
+	f.cfg = cfg
+	return nil
+}
+
+// Init init filter
+func (f *OIDCFilter) Init(cfg string) error {
+	return nil
+}
+
+// Name return name of this filter
+func (f *OIDCFilter) Name() string {
+	return FilterOIDC
+}
+
+// Pre execute before proxy
+func (f *OIDCFilter) Pre(c filter.Context) (statusCode int, err error) {
+	if strings.ToUpper(c.API().AuthFilter) != f.Name() {
+		return f.BaseFilter.Pre(c)
+	}
+
+	token, err := f.getter(c)
+	if err != nil {
+		return fasthttp.StatusUnauthorized, &oidcError{err: err}
+	}
+
+	keySet, err := f.keys()
+	if err != nil {
+		return fasthttp.StatusServiceUnavailable, err
+	}
+
+	claims, err := f.parseToken(keySet, token, c.API().OIDC)
+	if err != nil {
+		return fasthttp.StatusUnauthorized, &oidcError{err: err, code: "invalid_token"}
+	}
+
+	if policy := c.API().OIDC; policy != nil {
+		scopes := oidcScopes(claims)
+		for _, scope := range policy.Scopes {
+			if !scopes[scope] {
+				return fasthttp.StatusForbidden, &oidcError{
+					err:   errOIDCInsufficient,
+					code:  "insufficient_scope",
+					scope: strings.Join(policy.Scopes, " "),
+				}
+			}
+		}
+	}
+
+	// the subject header from the client is never trusted
+	if f.cfg.SubjectHeader != "" {
+		c.ForwardRequest().Header.Del(f.cfg.SubjectHeader)
+		if sub, ok := claims["sub"].(string); ok && sub != "" {
+			c.ForwardRequest().Header.Set(f.cfg.SubjectHeader, sub)
+		}
+	}
+
+	return f.BaseFilter.Pre(c)
+}
+
+func (f *OIDCFilter) parseToken(keySet *jwks, value string, policy *metapb.OIDCPolicy) (jwt.MapClaims, error) {
+	token, err := jwt.Parse(value, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodRSA); !ok {
+			return nil, fmt.Errorf("Unexpected signing method: %v", token.Header["alg"])
+		}
+
+		kid, _ := token.Header["kid"].(string)
+		return keySet.get(kid)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok || !token.Valid {
+		return nil, errJWTInvalid
+	}
+
+	if !claims.VerifyExpiresAt(time.Now().Unix(), true) {
+		return nil, errOIDCExpired
+	}
+
+	if !claims.VerifyIssuer(f.cfg.Issuer, true) {
+		return nil, errOIDCIssuer
+	}
+
+	audience := f.cfg.Audience
+	if policy != nil && policy.Audience != "" {
+		audience = policy.Audience
+	}
+	if audience != "" && !oidcHasAudience(claims, audience) {
+		return nil, errOIDCAudience
+	}
+
+	return claims, nil
+}
+
+// keys returns the jwks of the issuer, the discovery is retried at most every 10 seconds until succeed
+func (f *OIDCFilter) keys() (*jwks, error) {
+	f.Lock()
+	defer f.Unlock()
+
+	if f.keySet != nil {
+		return f.keySet, nil
+	}
+
+	if time.Since(f.discoveredAt) < minJWKSRefresh {
+		return nil, errOIDCDiscovery
+	}
+	f.discoveredAt = time.Now()
+
+	value, err := f.discover()
+	if err != nil {
+		log.Errorf("filter-oidc: discovery %s failed, errors:\n%+v", f.cfg.DiscoveryURL, err)
+		return nil, errOIDCDiscovery
+	}
+
+	f.keySet = newJWKS(value.JWKSURI, time.Second*time.Duration(f.cfg.JWKSRefresh))
+	return f.keySet, nil
+}
+
+func (f *OIDCFilter) discover() (*oidcDiscovery, error) {
+	rsp, err := f.cli.Get(f.cfg.DiscoveryURL)
+	if err != nil {
+		return nil, err
+	}
+	defer rsp.Body.Close()
+
+	data, err := ioutil.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if rsp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("discovery response %d: %s", rsp.StatusCode, strings.TrimSpace(string(data)))
+	}
+
+	value := &oidcDiscovery{}
+	err = json.Unmarshal(data, value)
+	if err != nil {
+		return nil, err
+	}
+
+	if value.Issuer != f.cfg.Issuer {
+		return nil, fmt.Errorf("discovery issuer %s not match %s", value.Issuer, f.cfg.Issuer)
+	}
+
+	if value.JWKSURI == "" {
+		return nil, fmt.Errorf("missing jwks_uri in discovery")
+	}
+
+	return value, nil
+}
+
+// oidcHasAudience returns true if the aud claim, a string or an array, has the audience
+func oidcHasAudience(claims jwt.MapClaims, audience string) bool {
+	switch aud := claims["aud"].(type) {
+	case string:
+		return aud == audience
+	case []interface{}:
+		for _, value := range aud {
+			if value == audience {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// oidcScopes returns the scopes of the token, the scope claim is space separated, some issuers use the
+// scp claim of a string or an array
+func oidcScopes(claims jwt.MapClaims) map[string]bool {
+	scopes := make(map[string]bool)
+	for _, name := range []string{"scope", "scp"} {
+		switch value := claims[name].(type) {
+		case string:
+			for _, scope := range strings.Fields(value) {
+				scopes[scope] = true
+			}
+		case []interface{}:
+			for _, scope := range value {
+				if s, ok := scope.(string); ok {
+					scopes[s] = true
+				}
+			}
+		}
+	}
+
+	return scopes
+}
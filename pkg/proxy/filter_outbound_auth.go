@@ -0,0 +1,156 @@
+package proxy
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/fagongzi/gateway/pkg/filter"
+	"github.com/fagongzi/gateway/pkg/pb/metapb"
+	"github.com/valyala/fasthttp"
+)
+
+const (
+	defaultSignatureHeader = "X-Signature"
+	signatureTimeHeader    = "X-Signature-Timestamp"
+	signatureKeyHeader     = "X-Signature-Key"
+
+	sigV4Algorithm  = "AWS4-HMAC-SHA256"
+	sigV4TimeFormat = "20060102T150405Z"
+)
+
+// OutboundAuthFilter sign the requests that sent to the upstreams with the cluster outbound auth
+type OutboundAuthFilter struct {
+	filter.BaseFilter
+}
+
+func newOutboundAuthFilter() filter.Filter {
+	return &OutboundAuthFilter{}
+}
+
+// Init init filter
+func (f *OutboundAuthFilter) Init(cfg string) error {
+	return nil
+}
+
+// Name return name of this filter
+func (f *OutboundAuthFilter) Name() string {
+	return FilterOutboundAuth
+}
+
+// Pre execute before proxy
+func (f *OutboundAuthFilter) Pre(c filter.Context) (statusCode int, err error) {
+	cluster := c.(*proxyContext).cluster()
+	if cluster == nil || cluster.OutboundAuth == nil {
+		return f.BaseFilter.Pre(c)
+	}
+
+	auth := cluster.OutboundAuth
+	req := c.ForwardRequest()
+	switch auth.Type {
+	case metapb.BearerToken:
+		req.Header.Set("Authorization", "Bearer "+auth.Token)
+	case metapb.HMACSignature:
+		signHMAC(req, auth, time.Now())
+	case metapb.AWSSigV4:
+		signSigV4(req, auth, c.Server().Addr, time.Now())
+	}
+
+	return f.BaseFilter.Pre(c)
+}
+
+// signHMAC sign the request with hex(hmac-sha256(secret, method\nuri\ntimestamp\nsha256(body)))
+func signHMAC(req *fasthttp.Request, auth *metapb.OutboundAuth, now time.Time) {
+	ts := fmt.Sprintf("%d", now.Unix())
+	data := strings.Join([]string{
+		string(req.Header.Method()),
+		string(req.RequestURI()),
+		ts,
+		hashSHA256(req.Body()),
+	}, "\n")
+
+	header := auth.Header
+	if header == "" {
+		header = defaultSignatureHeader
+	}
+
+	req.Header.Set(signatureTimeHeader, ts)
+	if auth.AccessKey != "" {
+		req.Header.Set(signatureKeyHeader, auth.AccessKey)
+	}
+	req.Header.Set(header, hex.EncodeToString(hmacSHA256([]byte(auth.SecretKey), data)))
+}
+
+// signSigV4 sign the request with AWS signature version 4, only the host and x-amz-* headers are signed
+func signSigV4(req *fasthttp.Request, auth *metapb.OutboundAuth, addr string, now time.Time) {
+	amzDate := now.UTC().Format(sigV4TimeFormat)
+	date := amzDate[:8]
+	payloadHash := hashSHA256(req.Body())
+	host := strings.TrimSuffix(strings.TrimSuffix(addr, ":80"), ":443")
+
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	signedHeaders := "host;x-amz-content-sha256;x-amz-date"
+	canonicalHeaders := "host:" + host + "\n" +
+		"x-amz-content-sha256:" + payloadHash + "\n" +
+		"x-amz-date:" + amzDate + "\n"
+
+	canonicalRequest := strings.Join([]string{
+		string(req.Header.Method()),
+		(&url.URL{Path: string(req.URI().Path())}).EscapedPath(),
+		canonicalQueryString(req.URI().QueryArgs()),
+		canonicalHeaders,
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := strings.Join([]string{date, auth.Region, auth.Service, "aws4_request"}, "/")
+	stringToSign := strings.Join([]string{
+		sigV4Algorithm,
+		amzDate,
+		scope,
+		hashSHA256([]byte(canonicalRequest)),
+	}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+auth.SecretKey), date)
+	key = hmacSHA256(key, auth.Region)
+	key = hmacSHA256(key, auth.Service)
+	key = hmacSHA256(key, "aws4_request")
+
+	req.Header.Set("Authorization", fmt.Sprintf("%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		sigV4Algorithm,
+		auth.AccessKey,
+		scope,
+		signedHeaders,
+		hex.EncodeToString(hmacSHA256(key, stringToSign))))
+}
+
+func canonicalQueryString(args *fasthttp.Args) string {
+	var pairs []string
+	args.VisitAll(func(key, value []byte) {
+		pairs = append(pairs, sigV4Escape(string(key))+"="+sigV4Escape(string(value)))
+	})
+	sort.Strings(pairs)
+	return strings.Join(pairs, "&")
+}
+
+func sigV4Escape(value string) string {
+	return strings.Replace(url.QueryEscape(value), "+", "%20", -1)
+}
+
+func hashSHA256(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
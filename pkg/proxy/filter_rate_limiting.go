@@ -30,7 +30,7 @@ func (f *RateLimitingFilter) Name() string {
 func (f *RateLimitingFilter) Pre(c filter.Context) (statusCode int, err error) {
 	err = c.(*proxyContext).rateLimiter().Wait(context.Background())
 	if err != nil {
-		return http.StatusInternalServerError, err
+		return http.StatusTooManyRequests, err
 	}
 
 	return f.BaseFilter.Pre(c)
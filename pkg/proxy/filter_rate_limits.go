@@ -0,0 +1,107 @@
+package proxy
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/fagongzi/gateway/pkg/filter"
+	"github.com/fagongzi/gateway/pkg/pb/metapb"
+	"github.com/fagongzi/gateway/pkg/util"
+	"github.com/valyala/fasthttp"
+)
+
+var (
+	// ErrRateLimited the client exceeds the rate limit
+	ErrRateLimited = errors.New("rate limit exceeded")
+)
+
+// rateLimitedError is the rejected request of the rate limit, the client should retry after the seconds
+type rateLimitedError struct {
+	retryAfter int64
+}
+
+func (e *rateLimitedError) Error() string {
+	return ErrRateLimited.Error()
+}
+
+func (e *rateLimitedError) Unwrap() error {
+	return ErrRateLimited
+}
+
+func (e *rateLimitedError) headers(header *fasthttp.ResponseHeader) {
+	header.Set("Retry-After", strconv.FormatInt(e.retryAfter, 10))
+}
+
+// RateLimitsFilter limit the requests by the token bucket rate limits in the store, the buckets are keyed by
+// the api and the client identity of the rate limit
+type RateLimitsFilter struct {
+	filter.BaseFilter
+}
+
+func newRateLimitsFilter() filter.Filter {
+	return &RateLimitsFilter{}
+}
+
+// Init init filter
+func (f *RateLimitsFilter) Init(cfg string) error {
+	return nil
+}
+
+// Name return name of this filter
+func (f *RateLimitsFilter) Name() string {
+	return FilterRateLimits
+}
+
+// Pre execute before proxy
+func (f *RateLimitsFilter) Pre(c filter.Context) (statusCode int, err error) {
+	pc := c.(*proxyContext)
+	// the filters are called for each dispatch node, a request is limited once
+	if pc.result.idx > 0 {
+		return f.BaseFilter.Pre(c)
+	}
+
+	api := c.API().ID
+	now := time.Now()
+	for _, l := range pc.rt.matchedRateLimits(api) {
+		ok, wait := l.take(api, rateLimitClient(l.meta, c), now)
+		if !ok {
+			return fasthttp.StatusTooManyRequests, &rateLimitedError{
+				retryAfter: int64(math.Ceil(wait.Seconds())),
+			}
+		}
+	}
+
+	return f.BaseFilter.Pre(c)
+}
+
+// rateLimitClient returns the client identity of the request, the clients without the api key, the consumer
+// or the header are identified by the client ip. The identities are prefixed by the kinds, so a header value
+// can not take the bucket of an ip
+func rateLimitClient(meta *metapb.RateLimit, c filter.Context) string {
+	ctx := c.OriginRequest()
+	switch meta.Key {
+	case metapb.LimitByAPI:
+		return ""
+	case metapb.LimitByAPIKey:
+		key := ctx.Request.Header.Peek(apiKeyHeader)
+		if len(key) == 0 {
+			key = ctx.Request.URI().QueryArgs().Peek(apiKeyQuery)
+		}
+		if len(key) > 0 {
+			return "key:" + util.APIKeyHash(string(key))
+		}
+	case metapb.LimitByConsumer:
+		// the consumer is authenticated by the KEY-AUTH filter
+		if value, ok := c.GetAttr(consumerAttr).(string); ok {
+			return "consumer:" + value
+		}
+	case metapb.LimitByHeader:
+		if value := ctx.Request.Header.Peek(meta.Header); len(value) > 0 {
+			return "header:" + string(value)
+		}
+	}
+
+	return "ip:" + GetRealClientIP(ctx)
+}
@@ -0,0 +1,59 @@
+package proxy
+
+import (
+	"testing"
+	"time"
+
+	"github.com/fagongzi/gateway/pkg/pb/metapb"
+	"github.com/valyala/fasthttp"
+)
+
+func TestRateLimitsPreWithPendingWriter(t *testing.T) {
+	r := &dispatcher{
+		rateLimits: make(map[uint64]*rateLimitRuntime),
+	}
+	r.rateLimits[1] = newRateLimitRuntime(&metapb.RateLimit{
+		ID:     1,
+		API:    1,
+		Rate:   1,
+		Period: 1,
+		Burst:  1,
+	})
+
+	c := &proxyContext{
+		rt:        r,
+		originCtx: &fasthttp.RequestCtx{},
+		result: &dispathNode{
+			api: &apiRuntime{meta: &metapb.API{ID: 1}},
+		},
+	}
+
+	// the request holds the read lock from the dispatch to the dispatch completed
+	r.RLock()
+	locked := make(chan struct{})
+	go func() {
+		r.Lock()
+		close(locked)
+		r.Unlock()
+	}()
+	// wait the writer pending
+	time.Sleep(time.Millisecond * 100)
+
+	done := make(chan int)
+	go func() {
+		code, _ := newRateLimitsFilter().Pre(c)
+		done <- code
+	}()
+
+	select {
+	case code := <-done:
+		if code != fasthttp.StatusOK {
+			t.Errorf("expect %d, but %d", fasthttp.StatusOK, code)
+		}
+	case <-time.After(time.Second * 5):
+		t.Fatalf("rate limits pre blocked by the pending writer")
+	}
+
+	r.RUnlock()
+	<-locked
+}
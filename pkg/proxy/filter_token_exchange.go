@@ -0,0 +1,247 @@
+package proxy
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/fagongzi/gateway/pkg/filter"
+	"github.com/fagongzi/gateway/pkg/util"
+	"github.com/fagongzi/goetty"
+	"github.com/fagongzi/log"
+	"github.com/valyala/fasthttp"
+)
+
+const (
+	defaultTokenExchangeTTL     = 60
+	defaultTokenExchangeTimeout = 3
+	defaultTokenExchangeHeader  = "Authorization"
+	defaultTokenExchangeSchema  = "Bearer"
+)
+
+var (
+	errTokenExchangeMissing  = errors.New("missing credential for token exchange")
+	errTokenExchangeRejected = errors.New("credential rejected by token service")
+)
+
+// TokenExchangeCfg token exchange cfg
+type TokenExchangeCfg struct {
+	// TokenLookup is the place of the end-user credential, header:<name>, cookie:<name> or query:<name>
+	TokenLookup  string `json:"tokenLookup"`
+	AuthSchema   string `json:"authSchema,omitempty"`
+	Service      string `json:"service"`
+	TargetHeader string `json:"targetHeader,omitempty"`
+	TargetSchema string `json:"targetSchema,omitempty"`
+	TTL          int64  `json:"ttl,omitempty"`
+	Timeout      int64  `json:"timeout,omitempty"`
+}
+
+type tokenExchangeRequest struct {
+	Token string `json:"token"`
+}
+
+type tokenExchangeResponse struct {
+	Token     string `json:"token"`
+	ExpiresIn int64  `json:"expiresIn,omitempty"`
+}
+
+// TokenExchangeFilter exchange the end-user credential for an internal jwt by the token service,
+// the credential is removed from the forward request, so the backends only see the internal jwt
+type TokenExchangeFilter struct {
+	filter.BaseFilter
+
+	cfg     *TokenExchangeCfg
+	lookup  []string
+	getter  tokenGetter
+	timeout time.Duration
+	tw      *goetty.TimeoutWheel
+	cache   *util.Cache
+}
+
+func newTokenExchangeFilter(file string, tw *goetty.TimeoutWheel) (filter.Filter, error) {
+	f := &TokenExchangeFilter{
+		tw:    tw,
+		cache: util.NewLRUCache(0),
+	}
+
+	err := f.parseCfg(file)
+	if err != nil {
+		return nil, err
+	}
+
+	return f, nil
+}
+
+func (f *TokenExchangeFilter) parseCfg(file string) error {
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return err
+	}
+
+	cfg := &TokenExchangeCfg{}
+	err = json.Unmarshal(data, cfg)
+	if err != nil {
+		return err
+	}
+
+	if cfg.Service == "" {
+		return fmt.Errorf("missing token exchange service")
+	}
+
+	f.lookup = strings.SplitN(cfg.TokenLookup, ":", 2)
+	if len(f.lookup) != 2 {
+		return fmt.Errorf("error token lookup: %s", cfg.TokenLookup)
+	}
+
+	switch f.lookup[0] {
+	case "header":
+		f.getter = jwtFromHeader(f.lookup[1], cfg.AuthSchema)
+		if cfg.AuthSchema == "" {
+			f.getter = valueFromHeader(f.lookup[1])
+		}
+	case "cookie":
+		f.getter = jwtFromCookie(f.lookup[1])
+	case "query":
+		f.getter = jwtFromQuery(f.lookup[1])
+	default:
+		return fmt.Errorf("error token lookup: %s", cfg.TokenLookup)
+	}
+
+	if cfg.TargetHeader == "" {
+		cfg.TargetHeader = defaultTokenExchangeHeader
+		cfg.TargetSchema = defaultTokenExchangeSchema
+	}
+	if cfg.TTL <= 0 {
+		cfg.TTL = defaultTokenExchangeTTL
+	}
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = defaultTokenExchangeTimeout
+	}
+
+	f.cfg = cfg
+	f.timeout = time.Second * time.Duration(cfg.Timeout)
+	return nil
+}
+
+// Init init filter
+func (f *TokenExchangeFilter) Init(cfg string) error {
+	return nil
+}
+
+// Name return name of this filter
+func (f *TokenExchangeFilter) Name() string {
+	return FilterTokenExchange
+}
+
+// Pre execute before proxy
+func (f *TokenExchangeFilter) Pre(c filter.Context) (statusCode int, err error) {
+	credential, err := f.getter(c)
+	if err != nil {
+		return http.StatusUnauthorized, errTokenExchangeMissing
+	}
+
+	key := hashSHA256([]byte(credential))
+	token, ok := f.cache.Get(key)
+	if !ok {
+		value, ttl, err := f.exchange(credential)
+		if err == errTokenExchangeRejected {
+			return http.StatusUnauthorized, err
+		} else if err != nil {
+			log.Errorf("filter-token-exchange: exchange failed, errors:\n%+v", err)
+			return http.StatusBadGateway, err
+		}
+
+		token = []byte(value)
+		f.cache.Add(key, token)
+		f.tw.Schedule(ttl, f.removeCache, key)
+	}
+
+	req := c.ForwardRequest()
+	f.removeCredential(req)
+	if f.cfg.TargetSchema != "" {
+		req.Header.Set(f.cfg.TargetHeader, f.cfg.TargetSchema+" "+string(token))
+	} else {
+		req.Header.Set(f.cfg.TargetHeader, string(token))
+	}
+
+	return f.BaseFilter.Pre(c)
+}
+
+func (f *TokenExchangeFilter) exchange(credential string) (string, time.Duration, error) {
+	body, err := json.Marshal(&tokenExchangeRequest{Token: credential})
+	if err != nil {
+		return "", 0, err
+	}
+
+	req := fasthttp.AcquireRequest()
+	resp := fasthttp.AcquireResponse()
+	defer func() {
+		fasthttp.ReleaseRequest(req)
+		fasthttp.ReleaseResponse(resp)
+	}()
+
+	req.SetRequestURI(f.cfg.Service)
+	req.Header.SetMethod("POST")
+	req.Header.SetContentType("application/json")
+	req.SetBody(body)
+
+	err = fasthttp.DoTimeout(req, resp, f.timeout)
+	if err != nil {
+		return "", 0, err
+	}
+
+	if resp.StatusCode() == fasthttp.StatusUnauthorized ||
+		resp.StatusCode() == fasthttp.StatusForbidden {
+		return "", 0, errTokenExchangeRejected
+	}
+
+	if resp.StatusCode() != fasthttp.StatusOK {
+		return "", 0, fmt.Errorf("token service return with %d", resp.StatusCode())
+	}
+
+	value := &tokenExchangeResponse{}
+	err = json.Unmarshal(resp.Body(), value)
+	if err != nil {
+		return "", 0, err
+	}
+
+	if value.Token == "" {
+		return "", 0, errTokenExchangeRejected
+	}
+
+	ttl := f.cfg.TTL
+	if value.ExpiresIn > 0 && value.ExpiresIn < ttl {
+		ttl = value.ExpiresIn
+	}
+
+	return value.Token, time.Second * time.Duration(ttl), nil
+}
+
+func (f *TokenExchangeFilter) removeCredential(req *fasthttp.Request) {
+	switch f.lookup[0] {
+	case "header":
+		req.Header.Del(f.lookup[1])
+	case "cookie":
+		req.Header.DelCookie(f.lookup[1])
+	case "query":
+		req.URI().QueryArgs().Del(f.lookup[1])
+	}
+}
+
+func (f *TokenExchangeFilter) removeCache(key interface{}) {
+	f.cache.Remove(key)
+}
+
+func valueFromHeader(header string) tokenGetter {
+	return func(c filter.Context) (string, error) {
+		value := string(c.OriginRequest().Request.Header.Peek(header))
+		if value == "" {
+			return "", errTokenExchangeMissing
+		}
+		return value, nil
+	}
+}
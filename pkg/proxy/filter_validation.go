@@ -1,12 +1,20 @@
 package proxy
 
 import (
+	"encoding/json"
 	"errors"
+	"fmt"
 
 	"github.com/fagongzi/gateway/pkg/filter"
+	"github.com/fagongzi/gateway/pkg/util"
+	"github.com/fagongzi/log"
 	"github.com/valyala/fasthttp"
 )
 
+const (
+	maxViolationExampleSize = 256
+)
+
 var (
 	// ErrValidationFailure validation failure
 	ErrValidationFailure = errors.New("request validation failure")
@@ -33,9 +41,70 @@ func (f *ValidationFilter) Name() string {
 
 // Pre pre filter, before proxy reuqest
 func (f *ValidationFilter) Pre(c filter.Context) (statusCode int, err error) {
-	if c.(*proxyContext).validateRequest() {
-		return f.BaseFilter.Pre(c)
+	pc := c.(*proxyContext)
+	if !pc.validateRequest() {
+		return fasthttp.StatusBadRequest, ErrValidationFailure
+	}
+
+	if schema := pc.result.api.schema; schema != nil {
+		var errs []util.SchemaError
+		runHeavy(func() {
+			errs = schema.validate(c.ForwardRequest())
+		})
+		if len(errs) > 0 {
+			return fasthttp.StatusBadRequest, &schemaValidationError{errs: errs}
+		}
+	}
+
+	return f.BaseFilter.Pre(c)
+}
+
+// Post validate the response of the backend if the api has a response schema
+func (f *ValidationFilter) Post(c filter.Context) (statusCode int, err error) {
+	api := c.(*proxyContext).result.api
+	if api.contract == nil {
+		return f.BaseFilter.Post(c)
 	}
 
-	return fasthttp.StatusBadRequest, ErrValidationFailure
+	var errs []util.SchemaError
+	runHeavy(func() {
+		errs = api.contract.validate(c.Response())
+	})
+	if len(errs) > 0 {
+		c.Analysis().Violation(api.meta.ID)
+		incrContractViolation(api.meta.Name)
+
+		body := c.Response().Body()
+		if len(body) > maxViolationExampleSize {
+			body = body[:maxViolationExampleSize]
+		}
+		log.Warnf("api <%s> response from server <%s> violate the contract, errors: %+v, body <%s>",
+			api.meta.Name,
+			c.Server().Addr,
+			errs,
+			body)
+	}
+
+	return f.BaseFilter.Post(c)
+}
+
+type schemaValidationError struct {
+	errs []util.SchemaError
+}
+
+func (e *schemaValidationError) Error() string {
+	return fmt.Sprintf("%s: %+v", ErrValidationFailure, e.errs)
+}
+
+func (e *schemaValidationError) contentType() string {
+	return "application/json"
+}
+
+func (e *schemaValidationError) body() []byte {
+	data, _ := json.Marshal(&struct {
+		Code    int                `json:"code"`
+		Message string             `json:"message"`
+		Errors  []util.SchemaError `json:"errors"`
+	}{fasthttp.StatusBadRequest, ErrValidationFailure.Error(), e.errs})
+	return data
 }
@@ -0,0 +1,425 @@
+package proxy
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/fagongzi/gateway/pkg/pb/metapb"
+	"github.com/fagongzi/gateway/pkg/util"
+	"github.com/fagongzi/log"
+	"github.com/valyala/fasthttp"
+)
+
+const (
+	graphQLTypeName = "__typename"
+)
+
+var (
+	graphQLParamP = regexp.MustCompile(`\$[A-Za-z_][A-Za-z0-9_]*`)
+)
+
+type graphQLRequest struct {
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName"`
+	Variables     map[string]interface{} `json:"variables"`
+}
+
+type graphQLError struct {
+	Message string   `json:"message"`
+	Path    []string `json:"path,omitempty"`
+}
+
+type graphQLResponse struct {
+	Data   interface{}    `json:"data,omitempty"`
+	Errors []graphQLError `json:"errors,omitempty"`
+}
+
+// graphQLObject is a json object that keeps the order of the selection set
+type graphQLObject struct {
+	keys   []string
+	values []interface{}
+}
+
+func (o *graphQLObject) add(key string, value interface{}) {
+	o.keys = append(o.keys, key)
+	o.values = append(o.values, value)
+}
+
+func (o *graphQLObject) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for idx, key := range o.keys {
+		if idx > 0 {
+			buf.WriteByte(',')
+		}
+
+		k, err := json.Marshal(key)
+		if err != nil {
+			return nil, err
+		}
+
+		v, err := json.Marshal(o.values[idx])
+		if err != nil {
+			return nil, err
+		}
+
+		buf.Write(k)
+		buf.WriteByte(':')
+		buf.Write(v)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+type graphQLRuntime struct {
+	meta      *metapb.GraphQLOptions
+	resolvers map[metapb.GraphQLOperation]map[string]*graphQLResolver
+}
+
+func newGraphQLRuntime(meta *metapb.GraphQLOptions) *graphQLRuntime {
+	rt := &graphQLRuntime{
+		meta:      meta,
+		resolvers: make(map[metapb.GraphQLOperation]map[string]*graphQLResolver),
+	}
+
+	for _, r := range meta.Resolvers {
+		if _, ok := rt.resolvers[r.Operation]; !ok {
+			rt.resolvers[r.Operation] = make(map[string]*graphQLResolver)
+		}
+
+		rt.resolvers[r.Operation][r.Field] = newGraphQLResolver(r)
+	}
+
+	return rt
+}
+
+func (rt *graphQLRuntime) resolver(op *util.GraphQLOperation, field *util.GraphQLField) *graphQLResolver {
+	value := metapb.GraphQLQuery
+	if op.Type == "mutation" {
+		value = metapb.GraphQLMutation
+	}
+
+	return rt.resolvers[value][field.Name]
+}
+
+type graphQLResolver struct {
+	meta     *metapb.GraphQLResolver
+	node     *apiNode
+	params   map[string]bool
+	extracts []string
+}
+
+func newGraphQLResolver(meta *metapb.GraphQLResolver) *graphQLResolver {
+	r := &graphQLResolver{
+		meta: meta,
+		node: newAPINode(&metapb.DispatchNode{
+			ClusterID:    meta.ClusterID,
+			WriteTimeout: meta.WriteTimeout,
+			ReadTimeout:  meta.ReadTimeout,
+		}),
+		params: make(map[string]bool),
+	}
+
+	for _, param := range graphQLParamP.FindAllString(meta.URL, -1) {
+		r.params[param[1:]] = true
+	}
+
+	if meta.ExtractExp != "" {
+		r.extracts = strings.Split(meta.ExtractExp, ".")
+	}
+
+	return r
+}
+
+func (r *graphQLResolver) complexity() int {
+	if r.meta.Complexity > 0 {
+		return int(r.meta.Complexity)
+	}
+
+	return 1
+}
+
+// buildRequest change the forward request to call the backend, the arguments are used as the
+// url params, the others are sent by the query string for GET, or by the json body for others
+func (r *graphQLResolver) buildRequest(req *fasthttp.Request, field *util.GraphQLField) {
+	method := r.meta.Method
+	if method == "" {
+		method = "GET"
+	}
+
+	uri := graphQLParamP.ReplaceAllStringFunc(r.meta.URL, func(param string) string {
+		return url.PathEscape(graphQLArgString(field.Arguments[param[1:]]))
+	})
+
+	req.Header.SetMethod(method)
+	req.SetRequestURI(uri)
+	req.ResetBody()
+
+	others := make(map[string]interface{})
+	for name, value := range field.Arguments {
+		if !r.params[name] {
+			others[name] = value
+		}
+	}
+
+	if method == "GET" {
+		for name, value := range others {
+			req.URI().QueryArgs().Add(name, graphQLArgString(value))
+		}
+		return
+	}
+
+	body, _ := json.Marshal(others)
+	req.Header.SetContentType("application/json")
+	req.SetBody(body)
+}
+
+func (r *graphQLResolver) resolve(field *util.GraphQLField, body []byte) (interface{}, error) {
+	var value interface{}
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	err := decoder.Decode(&value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid json response: %s", err)
+	}
+
+	for _, key := range r.extracts {
+		obj, ok := value.(map[string]interface{})
+		if !ok {
+			return nil, nil
+		}
+		value = obj[key]
+	}
+
+	return graphQLProject(value, field.Fields), nil
+}
+
+// graphQLProject returns the value that only contains the fields of the selection set
+func graphQLProject(value interface{}, fields []*util.GraphQLField) interface{} {
+	if len(fields) == 0 {
+		return value
+	}
+
+	switch v := value.(type) {
+	case []interface{}:
+		values := make([]interface{}, 0, len(v))
+		for _, item := range v {
+			values = append(values, graphQLProject(item, fields))
+		}
+		return values
+	case map[string]interface{}:
+		obj := &graphQLObject{}
+		for _, f := range fields {
+			obj.add(f.ResponseName(), graphQLProject(v[f.Name], f.Fields))
+		}
+		return obj
+	}
+
+	return value
+}
+
+func graphQLArgString(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case json.Number:
+		return v.String()
+	}
+
+	data, _ := json.Marshal(value)
+	return string(data)
+}
+
+func parseGraphQLRequest(ctx *fasthttp.RequestCtx) (*graphQLRequest, error) {
+	req := &graphQLRequest{}
+	if ctx.IsGet() {
+		args := ctx.QueryArgs()
+		req.Query = string(args.Peek("query"))
+		req.OperationName = string(args.Peek("operationName"))
+		if variables := args.Peek("variables"); len(variables) > 0 {
+			decoder := json.NewDecoder(bytes.NewReader(variables))
+			decoder.UseNumber()
+			if err := decoder.Decode(&req.Variables); err != nil {
+				return nil, fmt.Errorf("error graphql variables: %s", err)
+			}
+		}
+	} else if bytes.HasPrefix(ctx.Request.Header.ContentType(), []byte("application/graphql")) {
+		req.Query = string(ctx.Request.Body())
+	} else {
+		decoder := json.NewDecoder(bytes.NewReader(ctx.Request.Body()))
+		decoder.UseNumber()
+		if err := decoder.Decode(req); err != nil {
+			return nil, fmt.Errorf("error graphql request: %s", err)
+		}
+	}
+
+	if req.Query == "" {
+		return nil, fmt.Errorf("missing graphql query")
+	}
+
+	return req, nil
+}
+
+// serveGraphQL serve the graphql request, every root field is sent to the backend of the resolver
+// as a dispatch node, so the filters, retry strategy and circuit breaker work as the normal requests.
+// The dispatch nodes are returned for the metrics
+func (p *Proxy) serveGraphQL(ctx *fasthttp.RequestCtx, api *apiRuntime, requestTag string) []*dispathNode {
+	req, err := parseGraphQLRequest(ctx)
+	if err != nil {
+		writeGraphQLResponse(ctx, fasthttp.StatusBadRequest, &graphQLResponse{
+			Errors: []graphQLError{{Message: err.Error()}},
+		})
+		return nil
+	}
+
+	op, err := util.ParseGraphQL(req.Query, req.OperationName, req.Variables)
+	if err != nil {
+		writeGraphQLResponse(ctx, fasthttp.StatusBadRequest, &graphQLResponse{
+			Errors: []graphQLError{{Message: err.Error()}},
+		})
+		return nil
+	}
+
+	if op.Type == "mutation" && ctx.IsGet() {
+		writeGraphQLResponse(ctx, fasthttp.StatusMethodNotAllowed, &graphQLResponse{
+			Errors: []graphQLError{{Message: "graphql mutation is not allowed by GET"}},
+		})
+		return nil
+	}
+
+	var errs []graphQLError
+	resolvers := make([]*graphQLResolver, len(op.Fields))
+	for idx, field := range op.Fields {
+		if field.Name == graphQLTypeName {
+			continue
+		}
+
+		resolvers[idx] = api.graphQL.resolver(op, field)
+		if resolvers[idx] == nil {
+			errs = append(errs, graphQLError{
+				Message: fmt.Sprintf("graphql %s field %s is not defined", op.Type, field.Name),
+				Path:    []string{field.ResponseName()},
+			})
+		}
+	}
+
+	if len(errs) > 0 {
+		writeGraphQLResponse(ctx, fasthttp.StatusBadRequest, &graphQLResponse{Errors: errs})
+		return nil
+	}
+
+	meta := api.graphQL.meta
+	if depth := op.Depth(); meta.MaxDepth > 0 && depth > int(meta.MaxDepth) {
+		writeGraphQLResponse(ctx, fasthttp.StatusBadRequest, &graphQLResponse{
+			Errors: []graphQLError{{Message: fmt.Sprintf("graphql query depth %d exceeds the max depth %d", depth, meta.MaxDepth)}},
+		})
+		return nil
+	}
+
+	complexity := op.Complexity(func(field *util.GraphQLField) int {
+		if r := api.graphQL.resolver(op, field); r != nil {
+			return r.complexity()
+		}
+		return 1
+	})
+	if meta.MaxComplexity > 0 && complexity > int(meta.MaxComplexity) {
+		writeGraphQLResponse(ctx, fasthttp.StatusBadRequest, &graphQLResponse{
+			Errors: []graphQLError{{Message: fmt.Sprintf("graphql query complexity %d exceeds the max complexity %d", complexity, meta.MaxComplexity)}},
+		})
+		return nil
+	}
+
+	dispatches := make([]*dispathNode, len(op.Fields))
+	var wg sync.WaitGroup
+	for idx, field := range op.Fields {
+		if resolvers[idx] == nil {
+			continue
+		}
+
+		dn := acquireDispathNode()
+		dn.idx = idx
+		dn.api = api
+		dn.node = resolvers[idx].node
+		dn.requestTag = requestTag
+		dn.ctx = ctx
+		p.dispatcher.selectServer(&ctx.Request, dn, requestTag)
+		dispatches[idx] = dn
+
+		r, f := resolvers[idx], field
+		adjust := func(c *proxyContext) {
+			r.buildRequest(c.ForwardRequest(), f)
+		}
+
+		// the root fields of the mutation are executed serially
+		if op.Type == "mutation" {
+			p.doProxy(dn, adjust)
+			continue
+		}
+
+		wg.Add(1)
+		go func() {
+			p.doProxy(dn, adjust)
+			wg.Done()
+		}()
+	}
+	wg.Wait()
+
+	data := &graphQLObject{}
+	var nodes []*dispathNode
+	for idx, field := range op.Fields {
+		dn := dispatches[idx]
+		if dn == nil {
+			data.add(field.ResponseName(), strings.Title(op.Type))
+			continue
+		}
+		nodes = append(nodes, dn)
+
+		var value interface{}
+		var err error
+		if dn.err != nil {
+			err = dn.err
+		} else if dn.code >= fasthttp.StatusBadRequest {
+			err = fmt.Errorf("backend return with %d", dn.code)
+		} else if dn.res != nil {
+			value, err = resolvers[idx].resolve(field, dn.res.Body())
+		}
+
+		if err != nil {
+			log.Errorf("%s: graphql field %s failed with error %s",
+				requestTag,
+				field.ResponseName(),
+				err)
+			errs = append(errs, graphQLError{
+				Message: err.Error(),
+				Path:    []string{field.ResponseName()},
+			})
+		}
+
+		data.add(field.ResponseName(), value)
+		dn.release()
+		dn.res = nil
+	}
+
+	writeGraphQLResponse(ctx, fasthttp.StatusOK, &graphQLResponse{Data: data, Errors: errs})
+	return nodes
+}
+
+func writeGraphQLResponse(ctx *fasthttp.RequestCtx, code int, resp *graphQLResponse) {
+	data, err := json.Marshal(resp)
+	if err != nil {
+		log.Errorf("graphql: marshal response failed, errors:\n%+v", err)
+		ctx.SetStatusCode(fasthttp.StatusInternalServerError)
+		return
+	}
+
+	ctx.SetStatusCode(code)
+	ctx.SetContentType("application/json")
+	ctx.SetBody(data)
+}
@@ -0,0 +1,91 @@
+package proxy
+
+import (
+	"bytes"
+
+	"github.com/fagongzi/gateway/pkg/pb/metapb"
+	"github.com/valyala/fasthttp"
+)
+
+// transformHeader is the request header or the response header of fasthttp
+type transformHeader interface {
+	Peek(key string) []byte
+	Del(key string)
+	Set(key, value string)
+	Add(key, value string)
+	VisitAll(f func(key, value []byte))
+}
+
+// transformRequestHeaders apply the request header rules of the api to the request that sent to the backend
+func transformRequestHeaders(api *apiRuntime, forwardReq *fasthttp.Request, req *fasthttp.Request) {
+	if api.meta.HeaderTransform == nil || api.meta.HeaderTransform.Request == nil {
+		return
+	}
+
+	transformHeaders(&forwardReq.Header, api.meta.HeaderTransform.Request, api, req)
+}
+
+// transformResponseHeaders apply the response header rules of the api to the response that returned to
+// the client, including the error responses of the proxy
+func transformResponseHeaders(ctx *fasthttp.RequestCtx, api *apiRuntime) {
+	if api == nil || api.meta.HeaderTransform == nil || api.meta.HeaderTransform.Response == nil {
+		return
+	}
+
+	transformHeaders(&ctx.Response.Header, api.meta.HeaderTransform.Response, api, &ctx.Request)
+}
+
+// transformHeaders apply the rules to the header, the values are executed as the templates with the
+// client request
+func transformHeaders(header transformHeader, rules *metapb.HeaderRules, api *apiRuntime, req *fasthttp.Request) {
+	for _, name := range rules.Remove {
+		delHeaders(header, name)
+	}
+
+	for _, pair := range rules.Rename {
+		values := headerValues(header, pair.Name)
+		if len(values) == 0 {
+			continue
+		}
+
+		delHeaders(header, pair.Name)
+		delHeaders(header, pair.Value)
+		for _, value := range values {
+			header.Add(pair.Value, value)
+		}
+	}
+
+	for _, pair := range rules.Set {
+		delHeaders(header, pair.Name)
+		header.Set(pair.Name, applyTemplate(pair.Value, api, req))
+	}
+
+	for _, pair := range rules.Defaults {
+		if len(header.Peek(pair.Name)) == 0 {
+			header.Set(pair.Name, applyTemplate(pair.Value, api, req))
+		}
+	}
+
+	for _, pair := range rules.Add {
+		header.Add(pair.Name, applyTemplate(pair.Value, api, req))
+	}
+}
+
+// headerValues returns all values of the header
+func headerValues(header transformHeader, name string) []string {
+	var values []string
+	header.VisitAll(func(key, value []byte) {
+		if bytes.EqualFold(key, []byte(name)) {
+			values = append(values, string(value))
+		}
+	})
+
+	return values
+}
+
+// delHeaders delete all values of the header, the Del of fasthttp only deletes the first value
+func delHeaders(header transformHeader, name string) {
+	for n := len(headerValues(header, name)); n > 0; n-- {
+		header.Del(name)
+	}
+}
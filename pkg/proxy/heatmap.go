@@ -0,0 +1,94 @@
+package proxy
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/fagongzi/gateway/pkg/pb/metapb"
+)
+
+const (
+	heatmapInterval = 10
+	heatmapRows     = 360
+)
+
+var (
+	// heatmapBuckets are the upper bounds(ms) of the api response histogram
+	heatmapBuckets = func() []float64 {
+		values := make([]float64, len(apiResponseBuckets))
+		for i, value := range apiResponseBuckets {
+			values[i] = value * 1000
+		}
+		return values
+	}()
+)
+
+// latencyHeatmap is the latency distribution of the apis on this proxy, the succeed requests are
+// counted into the buckets of the api response histogram, one row per interval, and the rows of
+// the last hour are kept
+type latencyHeatmap struct {
+	sync.Mutex
+
+	apis map[uint64][]metapb.HeatmapRow
+}
+
+func newLatencyHeatmap() *latencyHeatmap {
+	return &latencyHeatmap{
+		apis: make(map[uint64][]metapb.HeatmapRow),
+	}
+}
+
+func (h *latencyHeatmap) observe(api uint64, cost time.Duration, now time.Time) {
+	ms := float64(cost) / float64(time.Millisecond)
+	idx := sort.SearchFloat64s(heatmapBuckets, ms)
+	start := now.Unix() - now.Unix()%heatmapInterval
+
+	h.Lock()
+	defer h.Unlock()
+
+	rows := h.apis[api]
+	if n := len(rows); n == 0 || rows[n-1].Time != start {
+		rows = append(h.expire(rows, start), metapb.HeatmapRow{
+			Time:   start,
+			Counts: make([]uint64, len(heatmapBuckets)+1),
+		})
+	}
+	rows[len(rows)-1].Counts[idx]++
+	h.apis[api] = rows
+}
+
+func (h *latencyHeatmap) heatmap(api uint64, now time.Time) *metapb.LatencyHeatmap {
+	value := &metapb.LatencyHeatmap{
+		Interval: heatmapInterval,
+		Buckets:  heatmapBuckets,
+	}
+
+	h.Lock()
+	defer h.Unlock()
+
+	rows := h.expire(h.apis[api], now.Unix()-now.Unix()%heatmapInterval)
+	for _, row := range rows {
+		value.Rows = append(value.Rows, metapb.HeatmapRow{
+			Time:   row.Time,
+			Counts: append([]uint64(nil), row.Counts...),
+		})
+	}
+	return value
+}
+
+func (h *latencyHeatmap) remove(api uint64) {
+	h.Lock()
+	delete(h.apis, api)
+	h.Unlock()
+}
+
+// expire drop the rows that out of the window ends with the interval that starts at the start
+func (h *latencyHeatmap) expire(rows []metapb.HeatmapRow, start int64) []metapb.HeatmapRow {
+	min := start - (heatmapRows-1)*heatmapInterval
+	for len(rows) > 0 && rows[0].Time < min {
+		rows = rows[1:]
+	}
+
+	return rows
+}
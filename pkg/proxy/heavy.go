@@ -0,0 +1,44 @@
+package proxy
+
+import (
+	"sync/atomic"
+)
+
+var (
+	// heavyWorkers the slots of the cpu-heavy operations, nil means no limit
+	heavyWorkers chan struct{}
+	// heavyWaiting the count of the cpu-heavy operations that waiting for a slot
+	heavyWaiting int64
+)
+
+// setHeavyWorkers set the max cpu-heavy operations that running at the same time, 0 means no limit
+func setHeavyWorkers(count int) {
+	heavyWorkers = nil
+	if count > 0 {
+		heavyWorkers = make(chan struct{}, count)
+	}
+}
+
+// runHeavy run the cpu-heavy operation, e.g. the template rendering, the body transcoding and the
+// schema validation, on the request goroutine after got a slot of the heavy workers. The bursty
+// heavy apis wait for the slots, so the light requests that just forward the bodies always have
+// the cpus to run
+func runHeavy(fn func()) {
+	if heavyWorkers == nil {
+		fn()
+		return
+	}
+
+	select {
+	case heavyWorkers <- struct{}{}:
+	default:
+		atomic.AddInt64(&heavyWaiting, 1)
+		heavyWorkers <- struct{}{}
+		atomic.AddInt64(&heavyWaiting, -1)
+	}
+
+	defer func() {
+		<-heavyWorkers
+	}()
+	fn()
+}
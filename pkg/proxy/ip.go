@@ -1,17 +1,104 @@
 package proxy
 
 import (
+	"net"
 	"strings"
 
+	"github.com/fagongzi/gateway/pkg/pb/metapb"
 	"github.com/valyala/fasthttp"
 )
 
-// GetRealClientIP get read client ip
+var (
+	// trustedProxies the X-Forwarded-For is only trusted from the proxies
+	trustedProxies []*ipSegment
+)
+
+// setTrustedProxies set the proxies in front of the gateway, the formats are the same as the ip
+// access control, empty means the X-Forwarded-For is never trusted
+func setTrustedProxies(values []string) {
+	trustedProxies = nil
+	for _, value := range values {
+		trustedProxies = append(trustedProxies, parseFrom(value))
+	}
+}
+
+// GetRealClientIP get read client ip, the ipv6 address is returned without brackets. The X-Forwarded-For
+// is set by the clients, so it is ignored unless the request is from a trusted proxy, and the client ip
+// is the last ip in the X-Forwarded-For that is not a trusted proxy
 func GetRealClientIP(ctx *fasthttp.RequestCtx) string {
 	xforward := ctx.Request.Header.Peek("X-Forwarded-For")
-	if nil == xforward {
-		return strings.SplitN(ctx.RemoteAddr().String(), ":", 2)[0]
+	if nil == xforward || !isTrustedProxy(ctx.RemoteIP().String()) {
+		return ctx.RemoteIP().String()
+	}
+
+	ips := strings.Split(string(xforward), ",")
+	for i := len(ips) - 1; i > 0; i-- {
+		if ip := trimIPPort(strings.TrimSpace(ips[i])); !isTrustedProxy(ip) {
+			return ip
+		}
+	}
+
+	return trimIPPort(strings.TrimSpace(ips[0]))
+}
+
+func isTrustedProxy(ip string) bool {
+	for _, proxy := range trustedProxies {
+		if proxy.matches(ip) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ipAccessControl is the global ip access control, the ips in the blacklist are denied, and only the
+// ips in the whitelist are allowed if the whitelist is not empty
+type ipAccessControl struct {
+	whitelist []*ipSegment
+	blacklist []*ipSegment
+}
+
+func newIPAccessControl(meta *metapb.IPAccessControl) *ipAccessControl {
+	if meta == nil || (len(meta.Whitelist) == 0 && len(meta.Blacklist) == 0) {
+		return nil
+	}
+
+	c := &ipAccessControl{}
+	for _, ip := range meta.Whitelist {
+		c.whitelist = append(c.whitelist, parseFrom(ip))
+	}
+	for _, ip := range meta.Blacklist {
+		c.blacklist = append(c.blacklist, parseFrom(ip))
+	}
+
+	return c
+}
+
+func (c *ipAccessControl) allow(ip string) bool {
+	for _, segment := range c.blacklist {
+		if segment.matches(ip) {
+			return false
+		}
+	}
+
+	if len(c.whitelist) == 0 {
+		return true
+	}
+
+	for _, segment := range c.whitelist {
+		if segment.matches(ip) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// trimIPPort returns the ip of the ip:port, [ipv6]:port or [ipv6]
+func trimIPPort(value string) string {
+	if host, _, err := net.SplitHostPort(value); err == nil {
+		return host
 	}
 
-	return strings.SplitN(string(xforward), ",", 2)[0]
+	return strings.TrimSuffix(strings.TrimPrefix(value, "["), "]")
 }
@@ -0,0 +1,47 @@
+package proxy
+
+import (
+	"net"
+	"testing"
+
+	"github.com/valyala/fasthttp"
+)
+
+func newTestForwardedRequest(remote, xforward string) *fasthttp.RequestCtx {
+	req := fasthttp.AcquireRequest()
+	req.SetRequestURI("/")
+	if xforward != "" {
+		req.Header.Set("X-Forwarded-For", xforward)
+	}
+
+	ctx := &fasthttp.RequestCtx{}
+	ctx.Init(req, &net.TCPAddr{IP: net.ParseIP(remote), Port: 1234}, nil)
+	return ctx
+}
+
+func TestGetRealClientIP(t *testing.T) {
+	defer setTrustedProxies(nil)
+
+	cases := []struct {
+		trusted  []string
+		remote   string
+		xforward string
+		expect   string
+	}{
+		{nil, "1.1.1.1", "", "1.1.1.1"},
+		// the X-Forwarded-For is spoofed by the client
+		{nil, "1.1.1.1", "10.0.0.1", "1.1.1.1"},
+		{[]string{"10.0.0.0/8"}, "1.1.1.1", "10.0.0.1", "1.1.1.1"},
+		{[]string{"10.0.0.0/8"}, "10.0.0.2", "2.2.2.2", "2.2.2.2"},
+		// the client appends the spoofed ips before the ip that the trusted proxy appended
+		{[]string{"10.0.0.0/8"}, "10.0.0.2", "3.3.3.3, 2.2.2.2, 10.0.0.3", "2.2.2.2"},
+		{[]string{"10.0.0.0/8"}, "10.0.0.2", "10.0.0.4, 10.0.0.3", "10.0.0.4"},
+	}
+
+	for i, c := range cases {
+		setTrustedProxies(c.trusted)
+		if ip := GetRealClientIP(newTestForwardedRequest(c.remote, c.xforward)); ip != c.expect {
+			t.Errorf("case %d: expect %s, but %s", i, c.expect, ip)
+		}
+	}
+}
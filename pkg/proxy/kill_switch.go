@@ -0,0 +1,170 @@
+package proxy
+
+import (
+	"github.com/fagongzi/gateway/pkg/pb/metapb"
+	"github.com/fagongzi/gateway/pkg/store"
+	"github.com/fagongzi/log"
+	"github.com/valyala/fasthttp"
+)
+
+// killSwitchRuntime is the runtime of the kill switch, the meta is nil if the kill switch is removed
+type killSwitchRuntime struct {
+	meta        *metapb.KillSwitch
+	apis        map[uint64]struct{}
+	allowedAPIs map[uint64]struct{}
+	allowedIPs  []*ipSegment
+}
+
+func newKillSwitchRuntime(meta *metapb.KillSwitch) *killSwitchRuntime {
+	k := &killSwitchRuntime{
+		meta:        meta,
+		apis:        make(map[uint64]struct{}),
+		allowedAPIs: make(map[uint64]struct{}),
+	}
+
+	if meta == nil {
+		return k
+	}
+
+	for _, id := range meta.APIs {
+		k.apis[id] = struct{}{}
+	}
+	for _, id := range meta.AllowedAPIs {
+		k.allowedAPIs[id] = struct{}{}
+	}
+	for _, ip := range meta.AllowedIPs {
+		k.allowedIPs = append(k.allowedIPs, parseFrom(ip))
+	}
+
+	return k
+}
+
+// killed returns true if the request to the api is disabled, the api is nil if no api matched
+func (k *killSwitchRuntime) killed(api *apiRuntime, ctx *fasthttp.RequestCtx) bool {
+	if k.meta == nil {
+		return false
+	}
+
+	if api != nil {
+		if _, ok := k.apis[api.meta.ID]; ok {
+			return true
+		}
+	}
+
+	if !k.meta.Global {
+		return false
+	}
+
+	if api != nil {
+		if _, ok := k.allowedAPIs[api.meta.ID]; ok {
+			return false
+		}
+	}
+
+	if len(k.allowedIPs) > 0 {
+		ip := GetRealClientIP(ctx)
+		for _, segment := range k.allowedIPs {
+			if segment.matches(ip) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+func (k *killSwitchRuntime) write(ctx *fasthttp.RequestCtx, api *apiRuntime, defaultPages errorPages) {
+	code := int(k.meta.Code)
+	if code == 0 {
+		code = fasthttp.StatusServiceUnavailable
+	}
+
+	if k.meta.Body == "" {
+		writeError(ctx, api, defaultPages, code, nil)
+		return
+	}
+
+	ctx.SetStatusCode(code)
+	header := &ctx.Response.Header
+	if k.meta.ContentType != "" {
+		header.SetContentType(k.meta.ContentType)
+	}
+	for _, h := range k.meta.Headers {
+		header.Add(h.Name, h.Value)
+	}
+	ctx.SetBodyString(applyTemplate(k.meta.Body, api, &ctx.Request))
+}
+
+// getKillSwitch returns the kill switch, the kill switch has its own lock, so the changes of the
+// kill switch are not blocked by the requests that hold the dispatcher lock
+func (r *dispatcher) getKillSwitch() *killSwitchRuntime {
+	r.killLock.RLock()
+	k := r.killSwitch
+	r.killLock.RUnlock()
+	return k
+}
+
+func (r *dispatcher) setKillSwitch(meta *metapb.KillSwitch, watched bool) {
+	r.killLock.Lock()
+	defer r.killLock.Unlock()
+
+	// the loaded value is stale if the kill switch is already changed by the watcher
+	if !watched && r.killWatched {
+		return
+	}
+
+	r.killSwitch = newKillSwitchRuntime(meta)
+	r.killWatched = r.killWatched || watched
+
+	if meta == nil {
+		log.Infof("kill switch removed")
+		return
+	}
+
+	log.Warnf("kill switch set, global %v, apis %+v, reason %s",
+		meta.Global,
+		meta.APIs,
+		meta.Reason)
+}
+
+func (r *dispatcher) loadKillSwitch() {
+	log.Infof("load kill switch")
+
+	meta, err := r.store.GetKillSwitch()
+	if nil != err {
+		log.Errorf("load kill switch failed, errors:\n%+v",
+			err)
+		return
+	}
+
+	if meta != nil {
+		r.setKillSwitch(meta, false)
+	}
+}
+
+// watchKillSwitch watch the kill switch using the priority channel, the events are handled at once
+// without the dispatcher lock
+func (r *dispatcher) watchKillSwitch() {
+	log.Info("router start watch kill switch")
+
+	go r.readyToReceiveKillSwitchEvent()
+	err := r.store.WatchKillSwitch(r.killEventC, r.watchStopC)
+	log.Errorf("router watch kill switch failed, errors:\n%+v",
+		err)
+}
+
+func (r *dispatcher) readyToReceiveKillSwitchEvent() {
+	for {
+		evt := <-r.killEventC
+		r.doKillSwitchEvent(evt)
+	}
+}
+
+func (r *dispatcher) doKillSwitchEvent(evt *store.Evt) {
+	if evt.Type == store.EventTypeDelete {
+		r.setKillSwitch(nil, true)
+		return
+	}
+
+	r.setKillSwitch(evt.Value.(*metapb.KillSwitch), true)
+}
@@ -0,0 +1,227 @@
+package proxy
+
+import (
+	"context"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/fagongzi/log"
+)
+
+const (
+	ipLimitConn        = "conn"
+	ipLimitConnRate    = "conn_rate"
+	ipLimitRequestRate = "request_rate"
+	ipLimitBanned      = "banned"
+
+	ipLimitGCInterval = time.Second * 10
+)
+
+// ipLimiter limit the connections and the requests of the client ips at the listener level, the
+// ips that exceed the rates are banned for a while, the connections and the requests of the banned
+// ips are rejected before the route matching
+type ipLimiter struct {
+	sync.Mutex
+
+	maxConns       int
+	maxConnRate    int
+	maxRequestRate int
+	ban            time.Duration
+	ips            map[string]*ipLimitState
+}
+
+type ipLimitState struct {
+	conns       int
+	window      int64
+	newConns    int
+	requests    int
+	bannedUntil time.Time
+}
+
+func newIPLimiter(opt *Option) *ipLimiter {
+	if opt.LimitCountConnPerIP <= 0 &&
+		opt.LimitRateConnPerIP <= 0 &&
+		opt.LimitRateRequestPerIP <= 0 {
+		return nil
+	}
+
+	return &ipLimiter{
+		maxConns:       opt.LimitCountConnPerIP,
+		maxConnRate:    opt.LimitRateConnPerIP,
+		maxRequestRate: opt.LimitRateRequestPerIP,
+		ban:            opt.LimitDurationIPBan,
+		ips:            make(map[string]*ipLimitState),
+	}
+}
+
+// acquireConn returns true if the new connection of the ip is allowed, the allowed connection
+// must be released
+func (l *ipLimiter) acquireConn(ip string, now time.Time) bool {
+	l.Lock()
+	defer l.Unlock()
+
+	state := l.get(ip, now)
+	if now.Before(state.bannedUntil) {
+		incrIPLimit(ipLimitBanned)
+		return false
+	}
+
+	state.newConns++
+	if l.maxConnRate > 0 && state.newConns > l.maxConnRate {
+		l.doBan(ip, state, now, ipLimitConnRate)
+		return false
+	}
+
+	if l.maxConns > 0 && state.conns >= l.maxConns {
+		incrIPLimit(ipLimitConn)
+		return false
+	}
+
+	state.conns++
+	return true
+}
+
+func (l *ipLimiter) releaseConn(ip string) {
+	l.Lock()
+	if state, ok := l.ips[ip]; ok && state.conns > 0 {
+		state.conns--
+	}
+	l.Unlock()
+}
+
+// allowRequest returns true if the new request of the ip is allowed
+func (l *ipLimiter) allowRequest(ip string, now time.Time) bool {
+	l.Lock()
+	defer l.Unlock()
+
+	state := l.get(ip, now)
+	if now.Before(state.bannedUntil) {
+		incrIPLimit(ipLimitBanned)
+		return false
+	}
+
+	state.requests++
+	if l.maxRequestRate > 0 && state.requests > l.maxRequestRate {
+		l.doBan(ip, state, now, ipLimitRequestRate)
+		return false
+	}
+
+	return true
+}
+
+// get returns the state of the ip, the counters of the rates are reset every second
+func (l *ipLimiter) get(ip string, now time.Time) *ipLimitState {
+	state, ok := l.ips[ip]
+	if !ok {
+		state = &ipLimitState{}
+		l.ips[ip] = state
+	}
+
+	if window := now.Unix(); state.window != window {
+		state.window = window
+		state.newConns = 0
+		state.requests = 0
+	}
+
+	return state
+}
+
+func (l *ipLimiter) doBan(ip string, state *ipLimitState, now time.Time, reason string) {
+	incrIPLimit(reason)
+	if l.ban <= 0 {
+		return
+	}
+
+	state.bannedUntil = now.Add(l.ban)
+	log.Warnf("client ip %s exceeds the %s limit, banned until %s",
+		ip,
+		reason,
+		state.bannedUntil.Format(time.RFC3339))
+}
+
+// gc remove the states of the ips that have no connections and are not banned
+func (l *ipLimiter) gc(now time.Time) {
+	l.Lock()
+	defer l.Unlock()
+
+	for ip, state := range l.ips {
+		if state.conns == 0 &&
+			state.window < now.Unix() &&
+			!now.Before(state.bannedUntil) {
+			delete(l.ips, ip)
+		}
+	}
+}
+
+func (p *Proxy) readyToGCIPLimiter() {
+	if p.ipLimiter == nil {
+		return
+	}
+
+	p.runner.RunCancelableTask(func(ctx context.Context) {
+		ticker := time.NewTicker(ipLimitGCInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case now := <-ticker.C:
+				p.ipLimiter.gc(now)
+			}
+		}
+	})
+}
+
+// limitListener close the connections that rejected by the ip limiter as soon as they are accepted
+type limitListener struct {
+	net.Listener
+	limiter *ipLimiter
+}
+
+func newLimitListener(l net.Listener, limiter *ipLimiter) net.Listener {
+	if limiter == nil {
+		return l
+	}
+
+	return &limitListener{
+		Listener: l,
+		limiter:  limiter,
+	}
+}
+
+func (l *limitListener) Accept() (net.Conn, error) {
+	for {
+		conn, err := l.Listener.Accept()
+		if err != nil {
+			return nil, err
+		}
+
+		ip := trimIPPort(conn.RemoteAddr().String())
+		if !l.limiter.acquireConn(ip, time.Now()) {
+			conn.Close()
+			continue
+		}
+
+		return &limitConn{
+			Conn:    conn,
+			ip:      ip,
+			limiter: l.limiter,
+		}, nil
+	}
+}
+
+type limitConn struct {
+	net.Conn
+	ip      string
+	limiter *ipLimiter
+	once    sync.Once
+}
+
+func (c *limitConn) Close() error {
+	c.once.Do(func() {
+		c.limiter.releaseConn(c.ip)
+	})
+	return c.Conn.Close()
+}
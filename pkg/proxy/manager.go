@@ -0,0 +1,141 @@
+package proxy
+
+import (
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/fagongzi/gateway/pkg/pb/metapb"
+	"github.com/fagongzi/gateway/pkg/util"
+	"github.com/fagongzi/grpcx"
+	"github.com/fagongzi/log"
+	"github.com/fagongzi/util/format"
+	"github.com/labstack/echo"
+)
+
+const (
+	managerAPIVersion = "/v1"
+)
+
+func (p *Proxy) startRPC() {
+	if p.cfg.AddrRPC == "" {
+		return
+	}
+
+	l, err := net.Listen("tcp", p.cfg.AddrRPC)
+	if err != nil {
+		log.Fatalf("gateway proxy manager start failed, errors:\n%+v",
+			err)
+	}
+	p.rpcListener = l
+
+	server := echo.New()
+	server.HideBanner = true
+	server.HidePort = true
+	server.Listener = l
+	p.initManagerRouter(server)
+
+	go func() {
+		err := server.Start(p.cfg.AddrRPC)
+		if err != nil && !p.isStopped() {
+			log.Errorf("gateway proxy manager stopped, errors:\n%+v",
+				err)
+		}
+	}()
+
+	log.Infof("gateway proxy manager started at <%s>", p.cfg.AddrRPC)
+}
+
+func (p *Proxy) initManagerRouter(server *echo.Echo) {
+	server.GET("/metrics", echo.WrapHandler(util.NewMetricHandler(apiResponseExemplars)))
+
+	versionGroup := server.Group(managerAPIVersion)
+	versionGroup.GET("/health/servers",
+		grpcx.NewGetHTTPHandle(emptyManagerParamFactory, p.getServersHealthHandler))
+	versionGroup.GET("/consumers/usage",
+		grpcx.NewGetHTTPHandle(emptyManagerParamFactory, p.getConsumersUsageHandler))
+	versionGroup.GET("/clusters/standby/events",
+		grpcx.NewGetHTTPHandle(emptyManagerParamFactory, p.getStandbyEventsHandler))
+	versionGroup.GET("/analysis/heatmap",
+		grpcx.NewGetHTTPHandle(apiQueryManagerParamFactory, p.getHeatmapHandler))
+	versionGroup.GET("/analysis/stats",
+		grpcx.NewGetHTTPHandle(emptyManagerParamFactory, p.getAnalysisStatsHandler))
+	versionGroup.GET("/analysis/recently",
+		grpcx.NewGetHTTPHandle(emptyManagerParamFactory, p.getAnalysisRecentlyHandler))
+	versionGroup.GET("/config/propagation",
+		grpcx.NewGetHTTPHandle(emptyManagerParamFactory, p.getConfigPropagationHandler))
+	versionGroup.POST("/config/resync",
+		grpcx.NewGetHTTPHandle(emptyManagerParamFactory, p.postConfigResyncHandler))
+	versionGroup.POST("/route-test",
+		grpcx.NewJSONBodyHTTPHandle(routeTestManagerFactory, p.postRouteTestHandler))
+	versionGroup.DELETE("/cache",
+		grpcx.NewGetHTTPHandle(apiQueryManagerParamFactory, p.deleteCacheHandler))
+}
+
+func (p *Proxy) getServersHealthHandler(value interface{}) (*grpcx.JSONResult, error) {
+	return &grpcx.JSONResult{Data: p.dispatcher.serversHealth(p.cfg.Addr)}, nil
+}
+
+func (p *Proxy) getConsumersUsageHandler(value interface{}) (*grpcx.JSONResult, error) {
+	return &grpcx.JSONResult{Data: p.dispatcher.usage.usages(time.Now())}, nil
+}
+
+func (p *Proxy) getStandbyEventsHandler(value interface{}) (*grpcx.JSONResult, error) {
+	return &grpcx.JSONResult{Data: p.dispatcher.standbyEvents(p.cfg.Addr)}, nil
+}
+
+func (p *Proxy) getHeatmapHandler(value interface{}) (*grpcx.JSONResult, error) {
+	return &grpcx.JSONResult{Data: p.dispatcher.heatmap.heatmap(value.(uint64), time.Now())}, nil
+}
+
+func (p *Proxy) getAnalysisStatsHandler(value interface{}) (*grpcx.JSONResult, error) {
+	return &grpcx.JSONResult{Data: p.dispatcher.analysisStats()}, nil
+}
+
+func (p *Proxy) getAnalysisRecentlyHandler(value interface{}) (*grpcx.JSONResult, error) {
+	return &grpcx.JSONResult{Data: p.dispatcher.analysisRecently()}, nil
+}
+
+func (p *Proxy) getConfigPropagationHandler(value interface{}) (*grpcx.JSONResult, error) {
+	return &grpcx.JSONResult{Data: p.dispatcher.propagation.value(p.cfg.Addr)}, nil
+}
+
+func (p *Proxy) postConfigResyncHandler(value interface{}) (*grpcx.JSONResult, error) {
+	return &grpcx.JSONResult{Data: p.dispatcher.requestResync()}, nil
+}
+
+func (p *Proxy) postRouteTestHandler(value interface{}) (*grpcx.JSONResult, error) {
+	return &grpcx.JSONResult{Data: p.routeTest(value.(*metapb.RouteTest))}, nil
+}
+
+// deleteCacheHandler removes the cached responses of the api, the shared cache is removed once by any proxy
+func (p *Proxy) deleteCacheHandler(value interface{}) (*grpcx.JSONResult, error) {
+	f, ok := p.filtersMap[FilterCaching]
+	if !ok {
+		return nil, errCachingDisabled
+	}
+
+	removed, err := f.(*CachingFilter).removeAPI(value.(uint64))
+	if err != nil {
+		return nil, err
+	}
+
+	return &grpcx.JSONResult{Data: removed}, nil
+}
+
+func routeTestManagerFactory() interface{} {
+	return &metapb.RouteTest{}
+}
+
+func apiQueryManagerParamFactory(ctx echo.Context) (interface{}, error) {
+	value := ctx.QueryParam("api")
+	if value == "" {
+		return nil, fmt.Errorf("missing api query value")
+	}
+
+	return format.ParseStrUInt64(value)
+}
+
+func emptyManagerParamFactory(ctx echo.Context) (interface{}, error) {
+	return nil, nil
+}
@@ -1,8 +1,12 @@
 package proxy
 
 import (
+	"strconv"
+	"strings"
+	"sync/atomic"
 	"time"
 
+	"github.com/fagongzi/gateway/pkg/util"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/valyala/fasthttp"
 )
@@ -13,6 +17,18 @@ const (
 	typeRequestSucceed = "succeed"
 	typeRequestLimit   = "limit"
 	typeRequestReject  = "reject"
+
+	typeCacheHit  = "hit"
+	typeCacheMiss = "miss"
+
+	analysisKindServer = "server"
+	analysisKindAPI    = "api"
+	analysisKindCanary = "canary"
+	analysisKindStable = "stable"
+	analysisKindMirror = "mirror"
+
+	apiResponseMetricName = "gateway_proxy_api_response_duration_seconds"
+	traceParentHeader     = "traceparent"
 )
 
 var (
@@ -24,24 +40,115 @@ var (
 			Help:      "Total number of request made.",
 		}, []string{"name", "type"})
 
+	deprecatedAPIRequestCounterVec = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "gateway",
+			Subsystem: "proxy",
+			Name:      "deprecated_api_request_total",
+			Help:      "Total number of request made to the deprecated apis.",
+		}, []string{"name"})
+
+	apiContractViolationCounterVec = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "gateway",
+			Subsystem: "proxy",
+			Name:      "api_contract_violation_total",
+			Help:      "Total number of responses that violate the api response schema.",
+		}, []string{"name"})
+
+	apiNodeCacheCounterVec = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "gateway",
+			Subsystem: "proxy",
+			Name:      "api_node_cache_total",
+			Help:      "Total number of the cache lookups of the api dispatch nodes.",
+		}, []string{"name", "node", "type"})
+
+	apiWebSocketConnGaugeVec = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "gateway",
+			Subsystem: "proxy",
+			Name:      "api_websocket_connections",
+			Help:      "Current number of websocket connections.",
+		}, []string{"name"})
+
+	apiResponseBuckets      = prometheus.ExponentialBuckets(0.0005, 2.0, 20)
 	apiResponseHistogramVec = prometheus.NewHistogramVec(
 		prometheus.HistogramOpts{
 			Namespace: "gateway",
 			Subsystem: "proxy",
 			Name:      "api_response_duration_seconds",
 			Help:      "Bucketed histogram of api response time duration",
-			Buckets:   prometheus.ExponentialBuckets(0.0005, 2.0, 20),
+			Buckets:   apiResponseBuckets,
+		}, []string{"name"})
+	// apiResponseExemplars the trace ids of the api response histogram buckets
+	apiResponseExemplars = util.NewExemplars()
+
+	apiUploadBytesCounterVec = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "gateway",
+			Subsystem: "proxy",
+			Name:      "api_upload_bytes_total",
+			Help:      "Total bytes of the multipart uploads sent to the backends.",
+		}, []string{"name"})
+
+	apiUploadThroughputHistogramVec = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: "gateway",
+			Subsystem: "proxy",
+			Name:      "api_upload_throughput_bytes_per_second",
+			Help:      "Bucketed histogram of the throughput of the multipart uploads sent to the backends",
+			Buckets:   prometheus.ExponentialBuckets(1024, 4, 10),
 		}, []string{"name"})
+
+	ipLimitCounterVec = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "gateway",
+			Subsystem: "proxy",
+			Name:      "ip_limit_total",
+			Help:      "Total number of the connections and the requests rejected by the client ip limits.",
+		}, []string{"type"})
+
+	upstreamPhaseHistogramVec = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: "gateway",
+			Subsystem: "proxy",
+			Name:      "upstream_phase_duration_seconds",
+			Help:      "Bucketed histogram of the phase duration of the upstream requests",
+			Buckets:   prometheus.ExponentialBuckets(0.0001, 2.0, 20),
+		}, []string{"server", "phase"})
+
+	configPropagationHistogram = prometheus.NewHistogram(
+		prometheus.HistogramOpts{
+			Namespace: "gateway",
+			Subsystem: "proxy",
+			Name:      "config_propagation_seconds",
+			Help:      "Bucketed histogram of the duration from the meta changes written to the store to applied by the proxy",
+			Buckets:   prometheus.ExponentialBuckets(0.001, 2.0, 16),
+		})
 )
 
 func init() {
 	prometheus.Register(apiRequestCounterVec)
 	prometheus.Register(apiResponseHistogramVec)
+	prometheus.Register(deprecatedAPIRequestCounterVec)
+	prometheus.Register(apiContractViolationCounterVec)
+	prometheus.Register(apiWebSocketConnGaugeVec)
+	prometheus.Register(apiNodeCacheCounterVec)
+	prometheus.Register(upstreamPhaseHistogramVec)
+	prometheus.Register(apiUploadBytesCounterVec)
+	prometheus.Register(apiUploadThroughputHistogramVec)
+	prometheus.Register(ipLimitCounterVec)
+	prometheus.Register(configPropagationHistogram)
+	registerHeavyWaiting()
 }
 
-func (p *Proxy) postRequest(api *apiRuntime, dispatches []*dispathNode, startAt time.Time) {
+func (p *Proxy) postRequest(ctx *fasthttp.RequestCtx, api *apiRuntime, dispatches []*dispathNode, startAt time.Time) {
 	doMetrics := true
+	cost := time.Since(startAt)
 	for _, dn := range dispatches {
+		p.dispatcher.analyzeVariant(dn, cost)
+		p.dispatcher.analyzeFailure(api, dn)
 		if doMetrics &&
 			(dn.err == ErrCircuitClose || dn.err == ErrBlacklist || dn.err == ErrWhitelist) {
 			incrRequestReject(api.meta.Name)
@@ -61,8 +168,10 @@ func (p *Proxy) postRequest(api *apiRuntime, dispatches []*dispathNode, startAt
 	}
 
 	if doMetrics {
+		now := time.Now()
 		incrRequestSucceed(api.meta.Name)
-		observeAPIResponse(api.meta.Name, startAt)
+		observeAPIResponse(api.meta.Name, startAt, p.traceID(ctx))
+		p.dispatcher.heatmap.observe(api.meta.ID, now.Sub(startAt), now)
 	}
 }
 
@@ -70,6 +179,30 @@ func incrRequest(name string) {
 	apiRequestCounterVec.WithLabelValues(name, typeRequestAll).Inc()
 }
 
+func incrDeprecatedRequest(name string) {
+	deprecatedAPIRequestCounterVec.WithLabelValues(name).Inc()
+}
+
+func incrContractViolation(name string) {
+	apiContractViolationCounterVec.WithLabelValues(name).Inc()
+}
+
+func incrNodeCacheHit(name string, node int) {
+	apiNodeCacheCounterVec.WithLabelValues(name, strconv.Itoa(node), typeCacheHit).Inc()
+}
+
+func incrNodeCacheMiss(name string, node int) {
+	apiNodeCacheCounterVec.WithLabelValues(name, strconv.Itoa(node), typeCacheMiss).Inc()
+}
+
+func incrWebSocketConn(name string) {
+	apiWebSocketConnGaugeVec.WithLabelValues(name).Inc()
+}
+
+func decrWebSocketConn(name string) {
+	apiWebSocketConnGaugeVec.WithLabelValues(name).Dec()
+}
+
 func incrRequestFailed(name string) {
 	apiRequestCounterVec.WithLabelValues(name, typeRequestFail).Inc()
 }
@@ -86,7 +219,209 @@ func incrRequestReject(name string) {
 	apiRequestCounterVec.WithLabelValues(name, typeRequestReject).Inc()
 }
 
-func observeAPIResponse(name string, startAt time.Time) {
+func observeAPIResponse(name string, startAt time.Time, traceID string) {
 	now := time.Now()
-	apiResponseHistogramVec.WithLabelValues(name).Observe(now.Sub(startAt).Seconds())
+	cost := now.Sub(startAt).Seconds()
+	apiResponseHistogramVec.WithLabelValues(name).Observe(cost)
+	if traceID != "" {
+		apiResponseExemplars.Observe(apiResponseMetricName, prometheus.Labels{"name": name},
+			apiResponseBuckets, cost, traceID, now)
+	}
+}
+
+// traceID returns the trace id of the request if tracing is enabled, the trace id of the traceparent
+// header is the second field, e.g. 00-<trace-id>-<parent-id>-01
+func (p *Proxy) traceID(ctx *fasthttp.RequestCtx) string {
+	if p.cfg.Option.TraceHeader == "" {
+		return ""
+	}
+
+	value := string(ctx.Request.Header.Peek(p.cfg.Option.TraceHeader))
+	if strings.EqualFold(p.cfg.Option.TraceHeader, traceParentHeader) {
+		if fields := strings.Split(value, "-"); len(fields) == 4 {
+			return fields[1]
+		}
+		return ""
+	}
+
+	return value
+}
+
+// observeUpload the throughput of the upload is the body size divided by the duration of the write phase
+func observeUpload(name string, size int, trace *util.HTTPTrace) {
+	if size <= 0 {
+		return
+	}
+
+	apiUploadBytesCounterVec.WithLabelValues(name).Add(float64(size))
+	if cost := trace.Durations[util.PhaseWrite]; cost > 0 {
+		apiUploadThroughputHistogramVec.WithLabelValues(name).Observe(float64(size) / cost.Seconds())
+	}
+}
+
+func observeUpstreamPhases(addr string, trace *util.HTTPTrace) {
+	for _, phase := range util.Phases() {
+		if cost := trace.Durations[phase]; cost > 0 {
+			upstreamPhaseHistogramVec.WithLabelValues(addr, phase.String()).Observe(cost.Seconds())
+		}
+	}
+}
+
+// registerAnalysisDropped register the counter of the analysis updates that dropped by the recorder
+func registerAnalysisDropped(analysiser *util.Analysis) {
+	prometheus.Register(prometheus.NewCounterFunc(
+		prometheus.CounterOpts{
+			Namespace: "gateway",
+			Subsystem: "proxy",
+			Name:      "analysis_dropped_total",
+			Help:      "Total number of analysis updates dropped because the recorder buffer is full.",
+		}, func() float64 {
+			return float64(analysiser.Dropped())
+		}))
+}
+
+// registerHeavyWaiting register the gauge of the cpu-heavy operations that waiting for the heavy workers
+func registerHeavyWaiting() {
+	prometheus.Register(prometheus.NewGaugeFunc(
+		prometheus.GaugeOpts{
+			Namespace: "gateway",
+			Subsystem: "proxy",
+			Name:      "heavy_waiting",
+			Help:      "Number of the cpu-heavy operations that waiting for the heavy workers.",
+		}, func() float64 {
+			return float64(atomic.LoadInt64(&heavyWaiting))
+		}))
+}
+
+// registerAnalysisCollector register the collector of the analysis stats of the servers and the apis
+func registerAnalysisCollector(r *dispatcher) {
+	prometheus.Register(util.NewAnalysisCollector(nil, func(fn func(*util.AnalysisStat, ...string)) {
+		for _, stat := range r.analysisStats() {
+			fn(stat)
+		}
+	}))
+}
+
+// analysisStats returns the analysis stats of the servers and the apis on this proxy
+func (r *dispatcher) analysisStats() []*util.AnalysisStat {
+	r.RLock()
+	defer r.RUnlock()
+
+	var values []*util.AnalysisStat
+	for _, svr := range r.servers {
+		if stat, ok := r.analysiser.GetStat(svr.meta.ID, time.Second); ok {
+			stat.Kind = analysisKindServer
+			stat.Name = svr.meta.Addr
+			values = append(values, stat)
+		}
+	}
+	for _, api := range r.apis {
+		if stat, ok := r.analysiser.GetStat(api.meta.ID, apiAnalysisPeriod); ok {
+			stat.Kind = analysisKindAPI
+			stat.Name = api.meta.Name
+			values = append(values, stat)
+		}
+		if api.mirror == nil {
+			continue
+		}
+		if stat, ok := r.analysiser.GetStat(shadowKey(api.meta.ID), apiAnalysisPeriod); ok {
+			stat.Kind = analysisKindMirror
+			stat.Name = api.meta.Name
+			values = append(values, stat)
+		}
+	}
+	for _, routing := range r.routings {
+		if !routing.isCanary() {
+			continue
+		}
+
+		if stat, ok := r.analysiser.GetStat(routing.meta.ID, apiAnalysisPeriod); ok {
+			stat.Kind = analysisKindCanary
+			stat.Name = routing.meta.Name
+			values = append(values, stat)
+		}
+		if stat, ok := r.analysiser.GetStat(shadowKey(routing.meta.ID), apiAnalysisPeriod); ok {
+			stat.Kind = analysisKindStable
+			stat.Name = routing.meta.Name
+			values = append(values, stat)
+		}
+	}
+
+	return values
+}
+
+// analysisRecently returns the recently points of all intervals of the servers and the apis on this proxy
+func (r *dispatcher) analysisRecently() []*util.AnalysisRecently {
+	r.RLock()
+	defer r.RUnlock()
+
+	var values []*util.AnalysisRecently
+	for _, svr := range r.servers {
+		values = append(values, &util.AnalysisRecently{
+			Kind:     analysisKindServer,
+			ID:       svr.meta.ID,
+			Name:     svr.meta.Addr,
+			Recently: r.analysiser.GetRecentlyStats(svr.meta.ID),
+		})
+	}
+	for _, api := range r.apis {
+		values = append(values, &util.AnalysisRecently{
+			Kind:     analysisKindAPI,
+			ID:       api.meta.ID,
+			Name:     api.meta.Name,
+			Recently: r.analysiser.GetRecentlyStats(api.meta.ID),
+		})
+	}
+
+	return values
+}
+
+// analyzeFailure record the class of the failed dispatch node to the api, and the upstream failures to the
+// server
+func (r *dispatcher) analyzeFailure(api *apiRuntime, dn *dispathNode) {
+	kind, ok := dn.failureKind()
+	if !ok {
+		return
+	}
+
+	if api.needAnalysis() {
+		r.analysiser.Classify(api.meta.ID, kind)
+	}
+
+	if dn.dest != nil && (kind == util.FailureUpstreamTimeout || kind == util.FailureUpstreamRefused ||
+		kind == util.FailureUpstream5xx) {
+		r.analysiser.Classify(dn.dest.id, kind)
+	}
+}
+
+// analyzeVariant record the result of the dispatch node to the canary or the stable variant of the split
+// routing, the failures are the errors and the responses with the error status codes
+func (r *dispatcher) analyzeVariant(dn *dispathNode, cost time.Duration) {
+	var key uint64
+	if dn.routing != nil && dn.routing.isCanary() {
+		key = dn.routing.meta.ID
+	} else if dn.stable != nil {
+		key = shadowKey(dn.stable.meta.ID)
+	} else {
+		return
+	}
+
+	r.analysiser.Request(key)
+	if dn.err != nil || dn.code >= fasthttp.StatusBadRequest {
+		r.analysiser.Failure(key)
+		return
+	}
+
+	r.analysiser.Response(key, cost.Nanoseconds())
+}
+
+// shadowKey returns the analysis key of the traffic that tracked apart from the meta, e.g. the stable
+// variant of the routing and the mirrored traffic of the api. The ids of the meta are allocated from 1
+// and unique in all kinds, so the complements never conflict
+func shadowKey(id uint64) uint64 {
+	return ^id
+}
+
+func incrIPLimit(reason string) {
+	ipLimitCounterVec.WithLabelValues(reason).Inc()
 }
@@ -0,0 +1,55 @@
+package proxy
+
+import (
+	"time"
+
+	"github.com/fagongzi/log"
+	"github.com/valyala/fasthttp"
+)
+
+// mirror copy the request to the shadow cluster of the api asynchronously, the responses are discarded.
+// The mirrored requests are tracked under the shadow key of the api, the requests that dropped since the
+// copy workers are busy are the rejects, the spilled request bodies are not mirrored
+func (p *Proxy) mirror(ctx *fasthttp.RequestCtx, api *apiRuntime, body *spilledBody, requestTag string) {
+	if api.mirror == nil || body != nil || !api.mirror.Allow() {
+		return
+	}
+
+	key := shadowKey(api.meta.ID)
+	svr, _, _ := p.dispatcher.selectServerFromCluster(&ctx.Request, api.meta.Mirror.ClusterID, "")
+	if svr == nil {
+		p.dispatcher.analysiser.Reject(key)
+		log.Debugf("%s: mirror cluster %d has no server",
+			requestTag,
+			api.meta.Mirror.ClusterID)
+		return
+	}
+
+	req := &copyReq{
+		origin:     copyRequest(&ctx.Request),
+		to:         svr.clone(),
+		requestTag: requestTag,
+		key:        key,
+	}
+	select {
+	case p.copies[getIndex(&p.copyIndex, p.cfg.Option.LimitCountCopyWorker)] <- req:
+	default:
+		fasthttp.ReleaseRequest(req.origin)
+		p.dispatcher.analysiser.Reject(key)
+		log.Debugf("%s: mirror to %s dropped, copy workers are busy",
+			requestTag,
+			svr.meta.Addr)
+	}
+}
+
+// analyzeMirror record the result of the mirrored request, the failures are the errors and the responses
+// with the error status codes
+func (p *Proxy) analyzeMirror(key uint64, res *fasthttp.Response, err error, cost time.Duration) {
+	p.dispatcher.analysiser.Request(key)
+	if err != nil || res.StatusCode() >= fasthttp.StatusBadRequest {
+		p.dispatcher.analysiser.Failure(key)
+		return
+	}
+
+	p.dispatcher.analysiser.Response(key, cost.Nanoseconds())
+}
@@ -0,0 +1,145 @@
+package proxy
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"io/ioutil"
+	"mime/multipart"
+	"net/http"
+	"os"
+
+	"github.com/fagongzi/gateway/pkg/filter"
+	"github.com/fagongzi/util/hack"
+	"github.com/valyala/fasthttp"
+)
+
+var (
+	// ErrPartTooLarge a part of the multipart/form-data request body exceeds the max part bytes of the api
+	ErrPartTooLarge = errors.New("multipart part too large")
+)
+
+var (
+	multipartContentType = []byte("multipart/form-data")
+)
+
+// isMultipart returns true if the request body is multipart/form-data
+func isMultipart(req *fasthttp.Request) bool {
+	return bytes.HasPrefix(req.Header.ContentType(), multipartContentType)
+}
+
+// addPartFilter add the filter to the part filters if it inspects the parts
+func (p *Proxy) addPartFilter(f filter.Filter) {
+	if pf, ok := f.(filter.PartFilter); ok {
+		p.parts = append(p.parts, pf)
+	}
+}
+
+// doPartFilters read the parts of the multipart/form-data request body, check the max part bytes
+// of the api, and call the part filters with each part. The body is read once by each part filter
+// from the memory or the spilled file, the parts are never buffered
+func (p *Proxy) doPartFilters(c *proxyContext) (filterName string, statusCode int, err error) {
+	req := c.ForwardRequest()
+	if !isMultipart(req) {
+		return "", http.StatusOK, nil
+	}
+
+	maxPartBytes := int64(0)
+	if policy := c.result.api.meta.RequestBody; policy != nil {
+		maxPartBytes = policy.MaxPartBytes
+	}
+
+	if len(p.parts) == 0 {
+		if maxPartBytes == 0 {
+			return "", http.StatusOK, nil
+		}
+
+		statusCode, err = c.scanParts(maxPartBytes, nil)
+		return "", statusCode, err
+	}
+
+	for _, f := range p.parts {
+		filterName = f.Name()
+
+		statusCode, err = c.scanParts(maxPartBytes, f)
+		if nil != err {
+			return filterName, statusCode, err
+		}
+	}
+
+	return "", http.StatusOK, nil
+}
+
+// scanParts read the parts of the request body, the part filter is called with each part if not nil
+func (c *proxyContext) scanParts(maxPartBytes int64, pf filter.PartFilter) (int, error) {
+	var src io.Reader
+	if body := c.result.body; body != nil {
+		f, err := os.Open(body.file)
+		if err != nil {
+			return fasthttp.StatusInternalServerError, err
+		}
+		defer f.Close()
+		src = f
+	} else {
+		src = bytes.NewReader(c.ForwardRequest().Body())
+	}
+
+	boundary := hack.SliceToString(c.ForwardRequest().Header.MultipartFormBoundary())
+	mr := multipart.NewReader(src, boundary)
+	for {
+		part, err := mr.NextRawPart()
+		if err == io.EOF {
+			return http.StatusOK, nil
+		}
+		if err != nil {
+			return fasthttp.StatusBadRequest, err
+		}
+
+		r := &partReader{r: part, max: maxPartBytes}
+		if pf != nil {
+			statusCode, err := pf.Part(c, &filter.Part{
+				FormName: part.FormName(),
+				FileName: part.FileName(),
+				Header:   part.Header,
+			}, r)
+			if r.over {
+				return fasthttp.StatusRequestEntityTooLarge, ErrPartTooLarge
+			}
+			if err != nil {
+				return statusCode, err
+			}
+		}
+
+		// the part filter may not read all the content, check the size of the rest
+		_, err = io.Copy(ioutil.Discard, r)
+		if err == ErrPartTooLarge {
+			return fasthttp.StatusRequestEntityTooLarge, err
+		}
+		if err != nil {
+			return fasthttp.StatusBadRequest, err
+		}
+	}
+}
+
+// partReader returns ErrPartTooLarge if the part exceeds the max bytes
+type partReader struct {
+	r    io.Reader
+	n    int64
+	max  int64
+	over bool
+}
+
+func (r *partReader) Read(p []byte) (int, error) {
+	if r.over {
+		return 0, ErrPartTooLarge
+	}
+
+	n, err := r.r.Read(p)
+	r.n += int64(n)
+	if r.max > 0 && r.n > r.max {
+		r.over = true
+		return n, ErrPartTooLarge
+	}
+
+	return n, err
+}
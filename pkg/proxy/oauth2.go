@@ -0,0 +1,226 @@
+package proxy
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	jwt "github.com/dgrijalva/jwt-go"
+	"github.com/fagongzi/gateway/pkg/pb/metapb"
+	"github.com/fagongzi/gateway/pkg/util"
+	"github.com/fagongzi/log"
+	"github.com/valyala/fasthttp"
+)
+
+const (
+	oauth2GrantClientCredentials = "client_credentials"
+	oauth2TokenType              = "Bearer"
+	// oauth2ClaimConsumer and oauth2ClaimKey are the consumer id and the api key id of the token, the token
+	// is invalid after the key is revoked or expired
+	oauth2ClaimConsumer = "cid"
+	oauth2ClaimKey      = "kid"
+
+	oauth2InvalidRequest       = "invalid_request"
+	oauth2InvalidClient        = "invalid_client"
+	oauth2UnsupportedGrantType = "unsupported_grant_type"
+)
+
+var (
+	basicAuthPrefix  = []byte("Basic ")
+	bearerAuthPrefix = []byte("Bearer ")
+
+	// ErrInvalidAccessToken the access token is not issued by the gateway or expired
+	ErrInvalidAccessToken = errors.New("invalid access token")
+)
+
+// oauth2Token is the response of the token endpoint
+type oauth2Token struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	ExpiresIn   int64  `json:"expires_in"`
+}
+
+// oauth2Error is the error response of the token endpoint, see RFC 6749 5.2
+type oauth2Error struct {
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description,omitempty"`
+}
+
+// oauth2Issuer issues the short-lived jwt to the consumers by the client credentials grant, the client id
+// is the id of the api key and the client secret is the api key. The tokens are signed by the secret(HS256)
+// that shared by the proxies, and validated by the KEY-AUTH filter
+type oauth2Issuer struct {
+	path   []byte
+	secret []byte
+	ttl    time.Duration
+}
+
+func newOAuth2Issuer(opt *Option) *oauth2Issuer {
+	if opt.OAuth2Secret == "" {
+		return nil
+	}
+
+	return &oauth2Issuer{
+		path:   []byte(opt.OAuth2TokenPath),
+		secret: []byte(opt.OAuth2Secret),
+		ttl:    opt.OAuth2TokenTTL,
+	}
+}
+
+func (o *oauth2Issuer) match(ctx *fasthttp.RequestCtx) bool {
+	return bytes.Equal(ctx.Path(), o.path)
+}
+
+// issue handle the token request, the client is authenticated by the basic auth or the client_id and the
+// client_secret form values
+func (o *oauth2Issuer) issue(ctx *fasthttp.RequestCtx, r *dispatcher) {
+	if !ctx.IsPost() {
+		ctx.Response.Header.Set("Allow", "POST")
+		writeOAuth2Error(ctx, fasthttp.StatusMethodNotAllowed, oauth2InvalidRequest, "the token request must be POST")
+		return
+	}
+
+	args := ctx.PostArgs()
+	if grant := string(args.Peek("grant_type")); grant != oauth2GrantClientCredentials {
+		writeOAuth2Error(ctx, fasthttp.StatusBadRequest, oauth2UnsupportedGrantType,
+			fmt.Sprintf("unsupported grant type <%s>", grant))
+		return
+	}
+
+	id, secret, ok := parseBasicAuth(ctx)
+	if !ok {
+		id = string(args.Peek("client_id"))
+		secret = string(args.Peek("client_secret"))
+	}
+	if id == "" || secret == "" {
+		writeOAuth2Error(ctx, fasthttp.StatusUnauthorized, oauth2InvalidClient, "missing client credentials")
+		return
+	}
+
+	now := time.Now()
+	consumer, key, ok := r.oauth2Client(id, secret, now.Unix())
+	if !ok {
+		log.Warnf("oauth2: client %s authenticate failed", id)
+		writeOAuth2Error(ctx, fasthttp.StatusUnauthorized, oauth2InvalidClient, "invalid client credentials")
+		return
+	}
+
+	expireAt := now.Add(o.ttl).Unix()
+	if key.ExpireAt > 0 && key.ExpireAt < expireAt {
+		expireAt = key.ExpireAt
+	}
+
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"sub":               consumer.meta.Name,
+		"iat":               now.Unix(),
+		"exp":               expireAt,
+		oauth2ClaimConsumer: consumer.meta.ID,
+		oauth2ClaimKey:      key.ID,
+	}).SignedString(o.secret)
+	if err != nil {
+		log.Errorf("oauth2: client %s sign token failed, errors:\n%+v", id, err)
+		writeOAuth2Error(ctx, fasthttp.StatusInternalServerError, oauth2InvalidRequest, "sign token failed")
+		return
+	}
+
+	writeOAuth2(ctx, fasthttp.StatusOK, &oauth2Token{
+		AccessToken: token,
+		TokenType:   oauth2TokenType,
+		ExpiresIn:   expireAt - now.Unix(),
+	})
+}
+
+// validate returns the consumer of the access token that issued by the gateway
+func (o *oauth2Issuer) validate(value string, r *dispatcher, now int64) (*consumerRuntime, bool) {
+	token, err := jwt.Parse(value, func(token *jwt.Token) (interface{}, error) {
+		if token.Method != jwt.SigningMethodHS256 {
+			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+		}
+		return o.secret, nil
+	})
+	if err != nil || !token.Valid {
+		return nil, false
+	}
+
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok {
+		return nil, false
+	}
+
+	id, _ := claims[oauth2ClaimConsumer].(float64)
+	kid, _ := claims[oauth2ClaimKey].(string)
+	consumer, ok := r.consumers[uint64(id)]
+	if !ok {
+		return nil, false
+	}
+
+	for _, key := range consumer.meta.Keys {
+		if key.ID == kid {
+			return consumer, !consumer.isKeyExpired(key.Hash, now)
+		}
+	}
+
+	return nil, false
+}
+
+// oauth2Client returns the consumer and the api key of the client credentials
+func (r *dispatcher) oauth2Client(id, secret string, now int64) (*consumerRuntime, *metapb.APIKey, bool) {
+	r.RLock()
+	defer r.RUnlock()
+
+	hash := util.APIKeyHash(secret)
+	consumer, ok := r.apiKeys[hash]
+	if !ok || consumer.isKeyExpired(hash, now) {
+		return nil, nil, false
+	}
+
+	for idx := range consumer.meta.Keys {
+		if key := &consumer.meta.Keys[idx]; key.Hash == hash && key.ID == id {
+			return consumer, key, true
+		}
+	}
+
+	return nil, nil, false
+}
+
+// parseBasicAuth returns the client id and the client secret of the basic auth
+func parseBasicAuth(ctx *fasthttp.RequestCtx) (string, string, bool) {
+	value := ctx.Request.Header.Peek("Authorization")
+	if !bytes.HasPrefix(value, basicAuthPrefix) {
+		return "", "", false
+	}
+
+	data, err := base64.StdEncoding.DecodeString(string(value[len(basicAuthPrefix):]))
+	if err != nil {
+		return "", "", false
+	}
+
+	idx := bytes.IndexByte(data, ':')
+	if idx < 0 {
+		return "", "", false
+	}
+
+	return string(data[:idx]), string(data[idx+1:]), true
+}
+
+func writeOAuth2Error(ctx *fasthttp.RequestCtx, code int, value, description string) {
+	if code == fasthttp.StatusUnauthorized {
+		ctx.Response.Header.Set("WWW-Authenticate", "Basic")
+	}
+
+	writeOAuth2(ctx, code, &oauth2Error{
+		Error:            value,
+		ErrorDescription: description,
+	})
+}
+
+func writeOAuth2(ctx *fasthttp.RequestCtx, code int, value interface{}) {
+	data, _ := json.Marshal(value)
+	ctx.Response.Header.Set("Cache-Control", "no-store")
+	ctx.SetContentType("application/json")
+	ctx.SetStatusCode(code)
+	ctx.SetBody(data)
+}
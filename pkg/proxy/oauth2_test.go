@@ -0,0 +1,82 @@
+package proxy
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/fagongzi/gateway/pkg/pb/metapb"
+	"github.com/valyala/fasthttp"
+)
+
+func newTestTokenRequest(ip string) *fasthttp.RequestCtx {
+	req := fasthttp.AcquireRequest()
+	req.Header.SetMethod("POST")
+	req.SetRequestURI("/oauth2/token")
+	req.Header.SetContentType("application/x-www-form-urlencoded")
+	req.SetBodyString("grant_type=client_credentials")
+
+	ctx := &fasthttp.RequestCtx{}
+	ctx.Init(req, &net.TCPAddr{IP: net.ParseIP(ip), Port: 1234}, nil)
+	return ctx
+}
+
+func TestTokenEndpointWithIPAccessControl(t *testing.T) {
+	r := &dispatcher{
+		oauth2: newOAuth2Issuer(&Option{
+			OAuth2Secret:    "secret",
+			OAuth2TokenPath: "/oauth2/token",
+			OAuth2TokenTTL:  time.Minute,
+		}),
+		ipAccess: newIPAccessControl(&metapb.IPAccessControl{
+			Blacklist: []string{"10.0.0.1"},
+		}),
+	}
+	p := &Proxy{
+		cfg:        &Cfg{Option: &Option{}},
+		dispatcher: r,
+	}
+
+	ctx := newTestTokenRequest("10.0.0.1")
+	p.ServeFastHTTP(ctx)
+	if ctx.Response.StatusCode() != fasthttp.StatusForbidden {
+		t.Errorf("expect %d for the denied ip, but %d", fasthttp.StatusForbidden, ctx.Response.StatusCode())
+	}
+
+	ctx = newTestTokenRequest("10.0.0.2")
+	p.ServeFastHTTP(ctx)
+	if ctx.Response.StatusCode() != fasthttp.StatusUnauthorized {
+		t.Errorf("expect %d for the allowed ip, but %d", fasthttp.StatusUnauthorized, ctx.Response.StatusCode())
+	}
+}
+
+func TestTokenEndpointWithKillSwitch(t *testing.T) {
+	r := &dispatcher{
+		oauth2: newOAuth2Issuer(&Option{
+			OAuth2Secret:    "secret",
+			OAuth2TokenPath: "/oauth2/token",
+			OAuth2TokenTTL:  time.Minute,
+		}),
+		killSwitch: newKillSwitchRuntime(&metapb.KillSwitch{
+			Global:     true,
+			AllowedIPs: []string{"10.0.0.2"},
+		}),
+	}
+	p := &Proxy{
+		cfg:        &Cfg{Option: &Option{}},
+		dispatcher: r,
+	}
+
+	ctx := newTestTokenRequest("10.0.0.1")
+	p.ServeFastHTTP(ctx)
+	if ctx.Response.StatusCode() != fasthttp.StatusServiceUnavailable {
+		t.Errorf("expect %d for the killed request, but %d", fasthttp.StatusServiceUnavailable,
+			ctx.Response.StatusCode())
+	}
+
+	ctx = newTestTokenRequest("10.0.0.2")
+	p.ServeFastHTTP(ctx)
+	if ctx.Response.StatusCode() != fasthttp.StatusUnauthorized {
+		t.Errorf("expect %d for the allowed ip, but %d", fasthttp.StatusUnauthorized, ctx.Response.StatusCode())
+	}
+}
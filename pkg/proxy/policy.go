@@ -0,0 +1,86 @@
+package proxy
+
+import (
+	"github.com/fagongzi/gateway/pkg/pb/metapb"
+	"github.com/fagongzi/gateway/pkg/store"
+	"github.com/fagongzi/log"
+)
+
+// policyKey is the resync key of the global policy, the global policy is a single value
+const policyKey = "policy"
+
+func (r *dispatcher) loadPolicy() {
+	log.Infof("load global policy")
+
+	meta, err := r.store.GetPolicy()
+	if nil != err {
+		log.Errorf("load global policy failed, errors:\n%+v",
+			err)
+		return
+	}
+
+	if meta != nil {
+		r.setPolicy(meta)
+	}
+}
+
+func (r *dispatcher) doPolicyEvent(evt *store.Evt) {
+	if evt.Type == store.EventTypeDelete {
+		r.setPolicy(nil)
+		return
+	}
+
+	r.setPolicy(evt.Value.(*metapb.Policy))
+}
+
+// setPolicy set the global policy, the apis inherit the policy are refreshed
+func (r *dispatcher) setPolicy(meta *metapb.Policy) {
+	r.Lock()
+	defer r.Unlock()
+
+	r.policy = meta
+	r.ipAccess = nil
+	if meta != nil {
+		r.ipAccess = newIPAccessControl(meta.IPAccessControl)
+	}
+	r.refreshAPIs(func(*apiRuntime) bool { return true })
+
+	if meta == nil {
+		log.Infof("global policy removed")
+		return
+	}
+
+	log.Infof("global policy set, data <%s>",
+		meta.String())
+}
+
+// NOTE: MUST Lock on call this function!!
+func (r *dispatcher) refreshClusterPolicyAPIs(id uint64) {
+	r.refreshAPIs(func(rt *apiRuntime) bool {
+		for _, node := range rt.origin.Nodes {
+			if node.ClusterID == id {
+				return true
+			}
+		}
+
+		return false
+	})
+}
+
+// NOTE: MUST Lock on call this function!!
+func (r *dispatcher) clusterMeta(id uint64) *metapb.Cluster {
+	if rt, ok := r.clusters[id]; ok {
+		return rt.meta
+	}
+
+	return nil
+}
+
+// allowIP returns true if the client ip is allowed by the ip access control of the global policy
+func (r *dispatcher) allowIP(ip string) bool {
+	r.RLock()
+	c := r.ipAccess
+	r.RUnlock()
+
+	return c == nil || c.allow(ip)
+}
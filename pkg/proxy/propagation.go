@@ -0,0 +1,66 @@
+package proxy
+
+import (
+	"sync"
+	"time"
+
+	"github.com/fagongzi/gateway/pkg/pb/metapb"
+)
+
+const (
+	// the weight of the latest latency in the moving average
+	propagationEWMAWeight = 0.2
+)
+
+// configPropagation is the latency from the meta changes written to the store to applied by this
+// proxy, the events of the same revision are written in one txn, so only the first one is counted
+type configPropagation struct {
+	sync.Mutex
+
+	revision  int64
+	writeAt   int64
+	appliedAt int64
+	latency   time.Duration
+	ewma      float64
+}
+
+func (c *configPropagation) applied(revision, writeAt int64, now time.Time) {
+	c.Lock()
+	defer c.Unlock()
+
+	if revision <= c.revision {
+		return
+	}
+
+	latency := now.Sub(time.Unix(0, writeAt))
+	if latency < 0 {
+		// clock skew between the proxy and the writer
+		latency = 0
+	}
+
+	if c.revision == 0 {
+		c.ewma = float64(latency)
+	} else {
+		c.ewma = propagationEWMAWeight*float64(latency) + (1-propagationEWMAWeight)*c.ewma
+	}
+
+	c.revision = revision
+	c.writeAt = writeAt
+	c.appliedAt = now.UnixNano()
+	c.latency = latency
+	configPropagationHistogram.Observe(latency.Seconds())
+}
+
+func (c *configPropagation) value(proxy string) *metapb.ConfigPropagation {
+	c.Lock()
+	defer c.Unlock()
+
+	return &metapb.ConfigPropagation{
+		Proxy:       proxy,
+		Revision:    c.revision,
+		WriteAt:     c.writeAt,
+		AppliedAt:   c.appliedAt,
+		Latency:     int64(c.latency),
+		LatencyEWMA: int64(c.ewma),
+	}
+}
@@ -0,0 +1,121 @@
+package proxy
+
+import (
+	"errors"
+
+	"github.com/fagongzi/gateway/pkg/pb/metapb"
+	"github.com/fagongzi/gateway/pkg/store"
+	"github.com/fagongzi/gateway/pkg/util"
+	"github.com/fagongzi/log"
+	"github.com/fagongzi/util/format"
+)
+
+var (
+	errProtoDescriptorExists   = errors.New("ProtoDescriptor already exist")
+	errProtoDescriptorNotFound = errors.New("ProtoDescriptor not found")
+)
+
+// protoDescriptorRuntime is the parsed proto descriptor used to transcode the requests of the grpc methods
+type protoDescriptorRuntime struct {
+	meta        *metapb.ProtoDescriptor
+	descriptors *util.ProtoDescriptors
+}
+
+func newProtoDescriptorRuntime(meta *metapb.ProtoDescriptor) (*protoDescriptorRuntime, error) {
+	descriptors, err := util.ParseProtoDescriptors(meta.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &protoDescriptorRuntime{
+		meta:        meta,
+		descriptors: descriptors,
+	}, nil
+}
+
+func (r *dispatcher) loadProtoDescriptors() {
+	log.Infof("load proto descriptors")
+
+	err := r.store.GetProtoDescriptors(limit, func(value interface{}) error {
+		return r.addProtoDescriptor(value.(*metapb.ProtoDescriptor))
+	})
+	if nil != err {
+		log.Errorf("load proto descriptors failed, errors:\n%+v",
+			err)
+		return
+	}
+}
+
+func (r *dispatcher) doProtoDescriptorEvent(evt *store.Evt) {
+	value, _ := evt.Value.(*metapb.ProtoDescriptor)
+
+	if evt.Type == store.EventTypeNew {
+		r.addProtoDescriptor(value)
+	} else if evt.Type == store.EventTypeDelete {
+		r.removeProtoDescriptor(format.MustParseStrUInt64(evt.Key))
+	} else if evt.Type == store.EventTypeUpdate {
+		r.updateProtoDescriptor(value)
+	}
+}
+
+func (r *dispatcher) addProtoDescriptor(meta *metapb.ProtoDescriptor) error {
+	r.Lock()
+	defer r.Unlock()
+
+	if _, ok := r.protos[meta.ID]; ok {
+		return errProtoDescriptorExists
+	}
+
+	rt, err := newProtoDescriptorRuntime(meta)
+	if err != nil {
+		log.Errorf("proto descriptor <%d> add failed, errors:\n%+v",
+			meta.ID,
+			err)
+		return err
+	}
+
+	r.protos[meta.ID] = rt
+	log.Infof("proto descriptor <%d> added, name <%s>",
+		meta.ID,
+		meta.Name)
+
+	return nil
+}
+
+func (r *dispatcher) updateProtoDescriptor(meta *metapb.ProtoDescriptor) error {
+	r.Lock()
+	defer r.Unlock()
+
+	if _, ok := r.protos[meta.ID]; !ok {
+		return errProtoDescriptorNotFound
+	}
+
+	rt, err := newProtoDescriptorRuntime(meta)
+	if err != nil {
+		log.Errorf("proto descriptor <%d> update failed, errors:\n%+v",
+			meta.ID,
+			err)
+		return err
+	}
+
+	r.protos[meta.ID] = rt
+	log.Infof("proto descriptor <%d> updated, name <%s>",
+		meta.ID,
+		meta.Name)
+
+	return nil
+}
+
+func (r *dispatcher) removeProtoDescriptor(id uint64) error {
+	r.Lock()
+	defer r.Unlock()
+
+	if _, ok := r.protos[id]; !ok {
+		return errProtoDescriptorNotFound
+	}
+
+	delete(r.protos, id)
+	log.Infof("proto descriptor <%d> removed", id)
+
+	return nil
+}
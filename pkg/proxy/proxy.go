@@ -29,6 +29,10 @@ var (
 	ErrNoServer = errors.New("has no server")
 	// ErrRewriteNotMatch rewrite not match request url
 	ErrRewriteNotMatch = errors.New("rewrite not match request url")
+	// ErrResponseHeadersTooLarge the upstream response headers exceed the limits of the api
+	ErrResponseHeadersTooLarge = errors.New("response headers too large")
+	// ErrResponseBodyTooLarge the upstream response body exceeds the max bytes of the api
+	ErrResponseBodyTooLarge = errors.New("response body too large")
 )
 
 var (
@@ -40,6 +44,9 @@ var (
 		"Content-Type",
 		"Date",
 	}
+	// MultiResultsDegradedHeader merge operation using the header to list the attrs of the nodes that
+	// failed and replaced by the default values
+	MultiResultsDegradedHeader = "X-Gateway-Degraded"
 )
 
 var (
@@ -49,8 +56,14 @@ var (
 const (
 	charLeft  = byte('[')
 	charRight = byte(']')
+
+	// internalListenerKey the user value of the requests from the internal listener
+	internalListenerKey = "__internal_listener__"
 )
 
+// internalListenerContext the context key of the websocket requests from the internal listener
+type internalListenerContext struct{}
+
 // Proxy Proxy
 type Proxy struct {
 	sync.RWMutex
@@ -62,10 +75,21 @@ type Proxy struct {
 	cfg        *Cfg
 	filtersMap map[string]filter.Filter
 	filters    []filter.Filter
+	parts      []filter.PartFilter
 	client     *util.FastHTTPClient
+	grpcClient *http.Client
+	resolver   *util.Resolver
+	websockets *websocketConns
 	dispatcher *dispatcher
+	errorPages errorPages
+	streamCfgs []*StreamListener
+	ipLimiter  *ipLimiter
+	tracer     *tracer
 
-	rpcListener net.Listener
+	securityHeaders *metapb.SecurityHeaders
+
+	rpcListener     net.Listener
+	streamListeners []net.Listener
 
 	runner   *task.Runner
 	stopped  int32
@@ -87,8 +111,12 @@ func NewProxy(cfg *Cfg) *Proxy {
 		MaxConns:            cfg.Option.LimitCountConn,
 	}
 
+	setTrustedProxies(cfg.Option.TrustedProxies)
+	setHeavyWorkers(cfg.Option.LimitCountHeavyWorker)
+
 	p := &Proxy{
 		client:        util.NewFastHTTPClientOption(globalHTTPOptions),
+		websockets:    newWebsocketConns(),
 		cfg:           cfg,
 		filtersMap:    make(map[string]filter.Filter),
 		stopC:         make(chan struct{}),
@@ -97,8 +125,11 @@ func NewProxy(cfg *Cfg) *Proxy {
 		dispatches:    make([]chan *dispathNode, cfg.Option.LimitCountDispatchWorker, cfg.Option.LimitCountDispatchWorker),
 		dispatchIndex: 0,
 		copyIndex:     0,
+		ipLimiter:     newIPLimiter(cfg.Option),
+		tracer:        newTracer(cfg.Option),
 	}
 
+	p.grpcClient = newGRPCClient(p.dialTimeout)
 	p.init()
 
 	return p
@@ -112,32 +143,88 @@ func (p *Proxy) Start() {
 
 	p.readyToCopy()
 	p.readyToDispatch()
+	p.startRPC()
+	p.startStreamListeners()
+	p.readyToGCIPLimiter()
+	p.readyToExportTraces()
 
-	log.Infof("gateway proxy started at <%s>", p.cfg.Addr)
+	if p.cfg.AddrInternal != "" {
+		go p.serve(p.listenInternal(), true)
+	}
 
-	if !p.cfg.Option.EnableWebSocket {
-		err := fasthttp.ListenAndServe(p.cfg.Addr, p.ServeFastHTTP)
+	listeners := p.listen()
+	for _, l := range listeners[1:] {
+		go p.serve(l, false)
+	}
+	p.serve(listeners[0], false)
+}
+
+// listen returns the http listeners, the ipv4 addr and the ipv6 addr are listened by the separate
+// sockets, the ipv6 socket only accept the ipv6 connections
+func (p *Proxy) listen() []net.Listener {
+	var listeners []net.Listener
+	if !p.cfg.V6Only {
+		l, err := net.Listen("tcp4", p.cfg.Addr)
 		if err != nil {
 			log.Fatalf("gateway proxy start failed, errors:\n%+v",
 				err)
 		}
-		return
+
+		listeners = append(listeners, newLimitListener(l, p.ipLimiter))
+		log.Infof("gateway proxy started at <%s>", p.cfg.Addr)
+	}
+
+	if p.cfg.AddrV6 != "" {
+		l, err := net.Listen("tcp6", p.cfg.AddrV6)
+		if err != nil {
+			log.Fatalf("gateway proxy start failed, errors:\n%+v",
+				err)
+		}
+
+		listeners = append(listeners, newLimitListener(l, p.ipLimiter))
+		log.Infof("gateway proxy started at <%s>", p.cfg.AddrV6)
 	}
 
-	l, err := net.Listen("tcp", p.cfg.Addr)
+	if len(listeners) == 0 {
+		log.Fatalf("gateway proxy start failed, errors:\n%+v",
+			errors.New("missing ipv6 addr with v6 only"))
+	}
+
+	return listeners
+}
+
+// listenInternal returns the listener of the internal apis
+func (p *Proxy) listenInternal() net.Listener {
+	l, err := net.Listen("tcp", p.cfg.AddrInternal)
 	if err != nil {
 		log.Fatalf("gateway proxy start failed, errors:\n%+v",
 			err)
 	}
 
+	log.Infof("gateway proxy internal apis started at <%s>", p.cfg.AddrInternal)
+	return newLimitListener(l, p.ipLimiter)
+}
+
+// serve serve the requests of the listener, the requests of the internal listener can reach the
+// internal apis
+func (p *Proxy) serve(l net.Listener, internal bool) {
+	if !p.cfg.Option.EnableWebSocket {
+		httpS := p.newHTTPServer(internal)
+		err := httpS.Serve(l)
+		if err != nil {
+			log.Fatalf("gateway proxy start failed, errors:\n%+v",
+				err)
+		}
+		return
+	}
+
+	var err error
 	m := cmux.New(l)
 	webSocketL := m.Match(cmux.HTTP1HeaderField("Upgrade", "websocket"))
 	httpL := m.Match(cmux.Any())
 
 	go func() {
-		httpS := fasthttp.Server{
-			Handler: p.ServeFastHTTP,
-		}
+		httpS := p.newHTTPServer(internal)
 		err = httpS.Serve(httpL)
 		if err != nil {
 			log.Fatalf("gateway proxy start failed, errors:\n%+v",
@@ -146,8 +233,15 @@ func (p *Proxy) Start() {
 	}()
 
 	go func() {
+		var handler http.Handler = p
+		if internal {
+			handler = http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+				p.ServeHTTP(rw, req.WithContext(context.WithValue(req.Context(), internalListenerContext{}, true)))
+			})
+		}
+
 		webSocketS := &http.Server{
-			Handler: p,
+			Handler: handler,
 		}
 		err = webSocketS.Serve(webSocketL)
 		if err != nil {
@@ -163,6 +257,28 @@ func (p *Proxy) Start() {
 	}
 }
 
+// newHTTPServer returns the http server, the request body is limited by the body limit
+func (p *Proxy) newHTTPServer(internal bool) *fasthttp.Server {
+	handler := p.ServeFastHTTP
+	if internal {
+		handler = func(ctx *fasthttp.RequestCtx) {
+			ctx.SetUserValue(internalListenerKey, true)
+			p.ServeFastHTTP(ctx)
+		}
+	}
+
+	return &fasthttp.Server{
+		Handler:            handler,
+		MaxRequestBodySize: p.cfg.Option.LimitBytesBody,
+	}
+}
+
+// isInternalRequest returns true if the request is from the internal listener
+func isInternalRequest(ctx *fasthttp.RequestCtx) bool {
+	internal, _ := ctx.UserValue(internalListenerKey).(bool)
+	return internal
+}
+
 // Stop stop the proxy
 func (p *Proxy) Stop() {
 	log.Infof("stop: start to stop gateway proxy")
@@ -183,11 +299,18 @@ func (p *Proxy) doStop() {
 	p.stopOnce.Do(func() {
 		defer p.stopWG.Done()
 		p.setStopped()
+		p.stopRPC()
+		p.stopStreamListeners()
+		p.websockets.drain(p.cfg.Option.LimitTimeoutWebSocketDrain)
 		p.runner.Stop()
 	})
 }
 
 func (p *Proxy) stopRPC() error {
+	if p.rpcListener == nil {
+		return nil
+	}
+
 	return p.rpcListener.Close()
 }
 
@@ -206,11 +329,43 @@ func (p *Proxy) init() {
 			err)
 	}
 
+	p.resolver, err = parseResolver(p.cfg.Option.ResolverCfgFile)
+	if err != nil {
+		log.Fatalf("init resolver failed, errors:\n%+v",
+			err)
+	}
+	lookup := net.LookupHost
+	if p.resolver != nil {
+		p.client.SetDial(p.dial)
+		p.dispatcher.httpClient.SetDial(p.dial)
+		lookup = p.resolver.LookupHost
+	}
+	p.dispatcher.readyToResolveClusters(lookup)
+
 	p.initFilters()
 
+	p.errorPages, err = parseErrorPages(p.cfg.Option.ErrorPagesFile)
+	if err != nil {
+		log.Fatalf("init error pages failed, errors:\n%+v",
+			err)
+	}
+
+	p.securityHeaders, err = parseSecurityHeaders(p.cfg.Option.SecurityHeadersFile)
+	if err != nil {
+		log.Fatalf("init security headers failed, errors:\n%+v",
+			err)
+	}
+
+	p.streamCfgs, err = parseStreamListeners(p.cfg.Option.StreamListenersFile)
+	if err != nil {
+		log.Fatalf("init stream listeners failed, errors:\n%+v",
+			err)
+	}
+
 	err = p.dispatcher.store.RegistryProxy(&metapb.Proxy{
 		Addr:    p.cfg.Addr,
 		AddrRPC: p.cfg.AddrRPC,
+		Labels:  p.cfg.Labels,
 	}, p.cfg.TTLProxy)
 	if err != nil {
 		log.Fatalf("init route table failed, errors:\n%+v",
@@ -250,6 +405,7 @@ func (p *Proxy) initFilters() {
 		log.Infof("filter added, filter=<%+v>", filter)
 		p.filters = append(p.filters, f)
 		p.filtersMap[f.Name()] = f
+		p.addPartFilter(f)
 	}
 }
 
@@ -299,6 +455,15 @@ func (p *Proxy) readyToCopy() {
 	}
 }
 
+// dispatchCompleted transform the response headers and set the security headers of the api, and release
+// the read lock of the dispatch. The api MUST NOT be used after the dispatch completed, it may be reset by
+// the updates of the api
+func (p *Proxy) dispatchCompleted(ctx *fasthttp.RequestCtx, api *apiRuntime) {
+	transformResponseHeaders(ctx, api)
+	p.setSecurityHeaders(ctx, api)
+	p.dispatcher.dispatchCompleted()
+}
+
 // ServeFastHTTP http reverse handler by fasthttp
 func (p *Proxy) ServeFastHTTP(ctx *fasthttp.RequestCtx) {
 	var buf bytes.Buffer
@@ -308,24 +473,135 @@ func (p *Proxy) ServeFastHTTP(ctx *fasthttp.RequestCtx) {
 	buf.Write(ctx.RequestURI())
 	requestTag := hack.SliceToString(buf.Bytes())
 
+	var api *apiRuntime
+	dispatched := false
+	defer func() {
+		// the requests that returned before the dispatch match no api
+		if !dispatched {
+			p.setSecurityHeaders(ctx, nil)
+		}
+	}()
+
+	sp := p.tracer.start(ctx)
+	defer func() {
+		sp.setStatusCode(ctx.Response.StatusCode())
+		sp.finish()
+	}()
+
 	if p.isStopped() {
 		log.Infof("proxy is stopped")
-		ctx.SetStatusCode(fasthttp.StatusServiceUnavailable)
+		writeError(ctx, nil, p.errorPages, fasthttp.StatusServiceUnavailable, nil)
 		return
 	}
 
 	startAt := time.Now()
-	api, dispatches := p.dispatcher.dispatch(&ctx.Request, requestTag)
+	if p.ipLimiter != nil && !p.ipLimiter.allowRequest(trimIPPort(ctx.RemoteAddr().String()), startAt) {
+		ctx.SetConnectionClose()
+		writeError(ctx, nil, p.errorPages, fasthttp.StatusTooManyRequests, nil)
+
+		log.Debugf("%s: client ip requests over limit, return with 429",
+			requestTag)
+		return
+	}
+
+	if ip := GetRealClientIP(ctx); !p.dispatcher.allowIP(ip) {
+		writeError(ctx, nil, p.errorPages, fasthttp.StatusForbidden, nil)
+
+		log.Infof("%s: client ip %s denied by the ip access control, return with 403",
+			requestTag,
+			ip)
+		return
+	}
+
+	if o := p.dispatcher.oauth2; o != nil && o.match(ctx) {
+		// the token endpoint matches no api, so it is only disabled by the global kill switch
+		if ks := p.dispatcher.getKillSwitch(); ks != nil && ks.killed(nil, ctx) {
+			ks.write(ctx, nil, p.errorPages)
+
+			log.Warnf("%s: killed by the kill switch, reason %s, return with %d",
+				requestTag,
+				ks.meta.Reason,
+				ctx.Response.StatusCode())
+			return
+		}
+
+		o.issue(ctx, p.dispatcher)
+		return
+	}
+
+	api, dispatches := p.dispatcher.dispatch(ctx, requestTag)
+	dispatched = true
+	sp.setAPI(api)
+	if ks := p.dispatcher.getKillSwitch(); ks != nil && ks.killed(api, ctx) {
+		ks.write(ctx, api, p.errorPages)
+		for _, dn := range dispatches {
+			releaseDispathNode(dn)
+		}
+		p.dispatchCompleted(ctx, api)
+
+		log.Warnf("%s: killed by the kill switch, reason %s, return with %d",
+			requestTag,
+			ks.meta.Reason,
+			ctx.Response.StatusCode())
+		return
+	}
+
 	if len(dispatches) == 0 &&
-		(nil == api || api.meta.DefaultValue == nil) {
-		ctx.SetStatusCode(fasthttp.StatusNotFound)
-		p.dispatcher.dispatchCompleted()
+		(nil == api || (api.meta.DefaultValue == nil && api.graphQL == nil)) {
+		writeError(ctx, api, p.errorPages, fasthttp.StatusNotFound, nil)
+		p.dispatchCompleted(ctx, api)
 
 		log.Infof("%s: not match, return with 404",
 			requestTag)
 		return
 	}
 
+	now := startAt.Unix()
+	if api.isSunset(now) {
+		writeError(ctx, api, p.errorPages, fasthttp.StatusGone, nil)
+		for _, dn := range dispatches {
+			releaseDispathNode(dn)
+		}
+		p.dispatchCompleted(ctx, api)
+
+		log.Infof("%s: api %s is sunset, return with 410",
+			requestTag,
+			api.meta.Name)
+		return
+	}
+
+	if api.exceedRequestHeaders(&ctx.Request.Header) {
+		writeError(ctx, api, p.errorPages, fasthttp.StatusRequestHeaderFieldsTooLarge, nil)
+		for _, dn := range dispatches {
+			releaseDispathNode(dn)
+		}
+		p.dispatchCompleted(ctx, api)
+
+		log.Infof("%s: api %s request headers too large, return with 431",
+			requestTag,
+			api.meta.Name)
+		return
+	}
+
+	body, code, err := p.bufferRequestBody(ctx, api, dispatches)
+	if err != nil {
+		writeError(ctx, api, p.errorPages, code, nil)
+		for _, dn := range dispatches {
+			releaseDispathNode(dn)
+		}
+		p.dispatchCompleted(ctx, api)
+
+		log.Infof("%s: api %s buffer request body failed with %s, return with %d",
+			requestTag,
+			api.meta.Name,
+			err,
+			code)
+		return
+	}
+	if body != nil {
+		defer body.remove()
+	}
+
 	log.Infof("%s: match api %s, has %d dispatches",
 		requestTag,
 		api.meta.Name,
@@ -333,8 +609,40 @@ func (p *Proxy) ServeFastHTTP(ctx *fasthttp.RequestCtx) {
 
 	incrRequest(api.meta.Name)
 
+	if api.needAnalysis() {
+		p.dispatcher.analysiser.Request(api.meta.ID)
+	}
+
+	debug := p.isDebug(ctx, api)
+	deprecated := api.isDeprecated(now)
+	if deprecated {
+		incrDeprecatedRequest(api.meta.Name)
+	}
+
+	p.mirror(ctx, api, body, requestTag)
+
+	_, rateLimiting := p.filtersMap[FilterRateLimiting]
+	rateLimiting = rateLimiting && api.limiter != nil
+
+	if api.graphQL != nil {
+		dispatches = p.serveGraphQL(ctx, api, requestTag)
+		if debug {
+			setDebugHeaders(&ctx.Response.Header, api, dispatches)
+		}
+		if deprecated {
+			api.addDeprecationHeaders(&ctx.Response.Header)
+		}
+		if rateLimiting {
+			api.addRateLimitHeaders(&ctx.Response.Header, time.Now())
+		}
+
+		p.postRequest(ctx, api, dispatches, startAt)
+		p.dispatchCompleted(ctx, api)
+		return
+	}
+
 	rd := acquireRender()
-	rd.init(requestTag, api, dispatches)
+	rd.init(requestTag, api, dispatches, p.errorPages)
 
 	var multiCtx *multiContext
 	var wg *sync.WaitGroup
@@ -367,7 +675,14 @@ func (p *Proxy) ServeFastHTTP(ctx *fasthttp.RequestCtx) {
 		dn.requestTag = requestTag
 		dn.rd = rd
 		dn.ctx = ctx
-		if dn.copyTo != nil {
+		dn.span = sp
+		dn.body = body
+		if dn.copyTo != nil && body != nil {
+			log.Infof("%s: dipatch node %d skip copy the spilled request body to %s",
+				requestTag,
+				idx,
+				dn.copyTo.meta.Addr)
+		} else if dn.copyTo != nil {
 			log.Infof("%s: dipatch node %d copy to %s",
 				requestTag,
 				idx,
@@ -396,12 +711,28 @@ func (p *Proxy) ServeFastHTTP(ctx *fasthttp.RequestCtx) {
 		releaseWG(wg)
 	}
 
-	rd.render(ctx, multiCtx)
+	// the aggregation and the template rendering are cpu-heavy
+	if num > 1 || api.hasRenderTemplate() {
+		runHeavy(func() {
+			rd.render(ctx, multiCtx)
+		})
+	} else {
+		rd.render(ctx, multiCtx)
+	}
+	if debug {
+		setDebugHeaders(&ctx.Response.Header, api, dispatches)
+	}
+	if deprecated {
+		api.addDeprecationHeaders(&ctx.Response.Header)
+	}
+	if rateLimiting {
+		api.addRateLimitHeaders(&ctx.Response.Header, time.Now())
+	}
 	releaseRender(rd)
 	releaseMultiContext(multiCtx)
 
-	p.postRequest(api, dispatches, startAt)
-	p.dispatcher.dispatchCompleted()
+	p.postRequest(ctx, api, dispatches, startAt)
+	p.dispatchCompleted(ctx, api)
 
 	log.Debugf("%s: dispatch complete",
 		requestTag)
@@ -415,13 +746,18 @@ func (p *Proxy) doCopy(req *copyReq) {
 	}
 
 	req.prepare()
+	stripConsumerHeaders(&req.origin.Header)
 
 	log.Infof("%s: dipatch node %d copy to %s",
 		req.requestTag,
 		req.idx,
 		req.to.meta.Addr)
 
+	startAt := time.Now()
 	res, err := p.client.Do(req.origin, svr.meta.Addr, nil)
+	if req.key > 0 {
+		p.analyzeMirror(req.key, res, err, time.Since(startAt))
+	}
 	if err != nil {
 		log.Errorf("%s: dipatch node %d copy to %s with error %s",
 			req.requestTag,
@@ -461,6 +797,14 @@ func (p *Proxy) doProxy(dn *dispathNode, adjustH func(*proxyContext)) {
 	}
 
 	forwardReq := copyRequest(&ctx.Request)
+	if dn.api.preview != nil {
+		forwardReq.Header.Del(dn.api.preview.header)
+	}
+	if p.cfg.Option.DebugSecret != "" {
+		forwardReq.Header.Del(debugHeader)
+	}
+	stripConsumerHeaders(&forwardReq.Header)
+	transformRequestHeaders(dn.api, forwardReq, &ctx.Request)
 
 	// change url
 	if dn.needRewrite() {
@@ -483,6 +827,13 @@ func (p *Proxy) doProxy(dn *dispathNode, adjustH func(*proxyContext)) {
 				dn.idx)
 			return
 		}
+	} else if path, ok := dn.api.rewritePath(string(ctx.Request.URI().Path())); ok {
+		log.Infof("%s: dipatch node %d rewrite path to %s",
+			dn.requestTag,
+			dn.idx,
+			path)
+
+		forwardReq.URI().SetPath(path)
 	}
 
 	c := acquireContext()
@@ -496,6 +847,7 @@ func (p *Proxy) doProxy(dn *dispathNode, adjustH func(*proxyContext)) {
 	if nil != err {
 		dn.err = err
 		dn.code = code
+		dn.filtered = true
 		dn.maybeDone()
 		releaseContext(c)
 
@@ -507,6 +859,23 @@ func (p *Proxy) doProxy(dn *dispathNode, adjustH func(*proxyContext)) {
 		return
 	}
 
+	// part filters
+	filterName, code, err = p.doPartFilters(c)
+	if nil != err {
+		dn.err = err
+		dn.code = code
+		dn.filtered = true
+		dn.maybeDone()
+		releaseContext(c)
+
+		log.Errorf("%s: dipatch node %d call filter %s part failed with error %s",
+			dn.requestTag,
+			dn.idx,
+			filterName,
+			err)
+		return
+	}
+
 	// hit cache
 	if value := c.GetAttr(filter.UsingCachingValue); nil != value {
 		dn.cachedCT, dn.cachedBody = filter.ParseCachedValue(value.([]byte))
@@ -520,6 +889,7 @@ func (p *Proxy) doProxy(dn *dispathNode, adjustH func(*proxyContext)) {
 	}
 
 	var res *fasthttp.Response
+	var trace util.HTTPTrace
 	times := int32(0)
 	for {
 		log.Infof("%s: dipatch node %d sent for %d times",
@@ -527,14 +897,95 @@ func (p *Proxy) doProxy(dn *dispathNode, adjustH func(*proxyContext)) {
 			dn.idx,
 			times)
 
-		if !dn.api.isWebSocket() {
-			forwardReq.SetHost(svr.meta.Addr)
-			res, err = p.client.Do(forwardReq, svr.meta.Addr, dn.httpOption())
-		} else {
+		option := dn.httpOption()
+		if max, truncate := dn.api.maxResponseBody(option.MaxResponseBodySize); max != option.MaxResponseBodySize || truncate {
+			value := *option
+			value.MaxResponseBodySize = max
+			value.TruncateResponseBody = truncate
+			option = &value
+		}
+
+		if overall, ok := dn.api.overallRemaining(dn.ctx, time.Now()); ok && !dn.api.isWebSocket() {
+			if overall <= 0 {
+				dn.err = ErrDeadlineExceeded
+				dn.code = fasthttp.StatusGatewayTimeout
+				dn.maybeDone()
+				releaseContext(c)
+
+				log.Warnf("%s: dipatch node %d api timeout exceeded, return with 504",
+					dn.requestTag,
+					dn.idx)
+				return
+			}
+
+			// the attempt can not exceed the overall timeout of the api
+			if option.ReadTimeout <= 0 || overall < option.ReadTimeout {
+				value := *option
+				value.ReadTimeout = overall
+				option = &value
+			}
+		}
+
+		if p.cfg.Option.DeadlineHeader != "" && !dn.api.isWebSocket() {
+			remaining, ok := p.remainingTimeout(dn, time.Now())
+			if ok && remaining <= 0 {
+				dn.err = ErrDeadlineExceeded
+				dn.code = fasthttp.StatusGatewayTimeout
+				dn.maybeDone()
+				releaseContext(c)
+
+				log.Warnf("%s: dipatch node %d deadline exceeded, return with 504",
+					dn.requestTag,
+					dn.idx)
+				return
+			}
+
+			if ok {
+				p.setDeadlineHeaders(forwardReq, svr, remaining)
+			}
+		}
+
+		if dn.body != nil {
+			dn.body.setTo(forwardReq)
+		}
+
+		csp := dn.span.backend(svr.meta.Addr, times)
+		csp.inject(&forwardReq.Header)
+
+		if dn.api.isWebSocket() {
 			res, err = p.onWebsocket(c, svr.meta.Addr)
+		} else if dn.node.meta.GRPCTranscoding != nil {
+			res, err = p.onGRPCTranscoding(c, svr.meta.Addr)
+		} else if svr.meta.Protocol == metapb.Grpc && isGRPCWebRequest(forwardReq) {
+			res, err = p.onGRPCWeb(c, svr.meta.Addr)
+		} else {
+			forwardReq.SetHost(dn.upstreamHost())
+			res, err = p.client.DoWithTrace(forwardReq, svr.meta.Addr, option, &trace)
+			if err == nil {
+				p.dispatcher.analysiser.Trace(svr.id, &trace)
+				observeUpstreamPhases(svr.meta.Addr, &trace)
+				if isMultipart(forwardReq) {
+					observeUpload(dn.api.meta.Name, forwardReq.Header.ContentLength(), &trace)
+				}
+
+				if log.DebugEnabled() {
+					log.Debugf("%s: dipatch node %d sent to %s, %s",
+						dn.requestTag,
+						dn.idx,
+						svr.meta.Addr,
+						trace.String())
+				}
+			}
 		}
 		c.setEndAt(time.Now())
 
+		if err != nil {
+			csp.setError(err)
+		} else {
+			csp.setStatusCode(res.StatusCode())
+		}
+		csp.finish()
+
 		times++
 
 		// skip succeed
@@ -548,8 +999,7 @@ func (p *Proxy) doProxy(dn *dispathNode, adjustH func(*proxyContext)) {
 		}
 
 		// skip not match
-		if !dn.matchAllRetryStrategy() &&
-			!dn.matchRetryStrategy(int32(res.StatusCode())) {
+		if !dn.matchRetryOn(res, err) {
 			break
 		}
 
@@ -563,11 +1013,16 @@ func (p *Proxy) doProxy(dn *dispathNode, adjustH func(*proxyContext)) {
 			break
 		}
 
-		if retry.Interval > 0 {
-			time.Sleep(time.Millisecond * time.Duration(retry.Interval))
+		if wait := dn.retryBackoff(int(times)); wait > 0 {
+			time.Sleep(wait)
 		}
 
-		fasthttp.ReleaseResponse(res)
+		if res != nil {
+			fasthttp.ReleaseResponse(res)
+		}
+		dn.retries++
+		p.dispatcher.analysiser.Retry(svr.id)
+		p.dispatcher.analysiser.Retry(dn.api.meta.ID)
 		p.dispatcher.selectServer(&ctx.Request, dn, dn.requestTag)
 		svr = dn.dest
 		if nil == svr {
@@ -582,11 +1037,56 @@ func (p *Proxy) doProxy(dn *dispathNode, adjustH func(*proxyContext)) {
 		}
 	}
 
+	if err == nil && dn.api.exceedResponseHeaders(&res.Header) {
+		fasthttp.ReleaseResponse(res)
+		p.doPostErrFilters(c)
+
+		dn.err = ErrResponseHeadersTooLarge
+		dn.code = fasthttp.StatusBadGateway
+		dn.maybeDone()
+		releaseContext(c)
+
+		log.Errorf("%s: dipatch node %d response headers from %s too large, return with 502",
+			dn.requestTag,
+			dn.idx,
+			svr.meta.Addr)
+		return
+	}
+
+	if (err == nil && !dn.api.limitResponseBody(res, dn.httpOption().MaxResponseBodySize)) ||
+		(err == fasthttp.ErrBodyTooLarge && dn.api.rejectsLargeResponse()) {
+		if res != nil {
+			fasthttp.ReleaseResponse(res)
+		}
+		p.doPostErrFilters(c)
+
+		dn.err = ErrResponseBodyTooLarge
+		dn.code = fasthttp.StatusBadGateway
+		dn.maybeDone()
+		releaseContext(c)
+
+		log.Errorf("%s: dipatch node %d response body from %s too large, return with 502",
+			dn.requestTag,
+			dn.idx,
+			svr.meta.Addr)
+		return
+	}
+
 	dn.res = res
 	if err != nil || res.StatusCode() >= fasthttp.StatusBadRequest {
 		resCode := fasthttp.StatusInternalServerError
 
-		if nil != err {
+		if nil != err && trace.Timeout {
+			p.dispatcher.analysiser.Timeout(svr.id, trace.Phase)
+
+			resCode = fasthttp.StatusGatewayTimeout
+			err = &upstreamTimeoutError{trace: trace, err: err}
+			log.Errorf("%s: dipatch node %d timed out in %s phase, %s, return with 504",
+				dn.requestTag,
+				dn.idx,
+				trace.Phase,
+				trace.String())
+		} else if nil != err {
 			log.Errorf("%s: dipatch node %d failed with error %s",
 				dn.requestTag,
 				dn.idx,
@@ -630,6 +1130,7 @@ func (p *Proxy) doProxy(dn *dispathNode, adjustH func(*proxyContext)) {
 
 		dn.err = err
 		dn.code = code
+		dn.filtered = true
 		dn.maybeDone()
 		releaseContext(c)
 		return
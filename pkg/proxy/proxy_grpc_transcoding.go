@@ -0,0 +1,218 @@
+package proxy
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/valyala/fasthttp"
+)
+
+const (
+	grpcStatusOK              = 0
+	grpcStatusInvalidArgument = 3
+	grpcFrameHeaderSize       = 5
+)
+
+var (
+	// grpcHTTPStatus the http status codes of the grpc status codes
+	grpcHTTPStatus = map[int]int{
+		0:  fasthttp.StatusOK,
+		1:  499,
+		2:  fasthttp.StatusInternalServerError,
+		3:  fasthttp.StatusBadRequest,
+		4:  fasthttp.StatusGatewayTimeout,
+		5:  fasthttp.StatusNotFound,
+		6:  fasthttp.StatusConflict,
+		7:  fasthttp.StatusForbidden,
+		8:  fasthttp.StatusTooManyRequests,
+		9:  fasthttp.StatusBadRequest,
+		10: fasthttp.StatusConflict,
+		11: fasthttp.StatusBadRequest,
+		12: fasthttp.StatusNotImplemented,
+		13: fasthttp.StatusInternalServerError,
+		14: fasthttp.StatusServiceUnavailable,
+		15: fasthttp.StatusInternalServerError,
+		16: fasthttp.StatusUnauthorized,
+	}
+)
+
+// grpcTranscodingError is the json body of the failed transcoded request
+type grpcTranscodingError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// onGRPCTranscoding transcode the http/json request to the unary grpc method of the node, the json body
+// and the query values are the input message, the output message is returned as the json body
+func (p *Proxy) onGRPCTranscoding(c *proxyContext, addr string) (*fasthttp.Response, error) {
+	t := c.result.node.meta.GRPCTranscoding
+	rt, ok := p.dispatcher.protos[t.DescriptorID]
+	if !ok {
+		return nil, fmt.Errorf("missing proto descriptor %d", t.DescriptorID)
+	}
+
+	method, ok := rt.descriptors.Method(t.Method)
+	if !ok {
+		return nil, fmt.Errorf("missing grpc method %s in proto descriptor %d", t.Method, t.DescriptorID)
+	}
+
+	req := c.forwardReq
+	var msg []byte
+	var err error
+	runHeavy(func() {
+		var value map[string]interface{}
+		value, err = grpcTranscodingValue(req)
+		if err == nil {
+			msg, err = rt.descriptors.EncodeJSON(method.InputType, value)
+		}
+	})
+	if err != nil {
+		return grpcTranscodingResponse(grpcStatusInvalidArgument, err.Error()), nil
+	}
+
+	frame := make([]byte, grpcFrameHeaderSize, grpcFrameHeaderSize+len(msg))
+	binary.BigEndian.PutUint32(frame[1:], uint32(len(msg)))
+	frame = append(frame, msg...)
+
+	r, err := http.NewRequest("POST", fmt.Sprintf("http://%s%s", addr, method.Path), bytes.NewReader(frame))
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.VisitAll(func(key, value []byte) {
+		if !grpcWebRemoveHeaders[string(key)] {
+			r.Header.Add(string(key), string(value))
+		}
+	})
+	r.Host = c.result.upstreamHost()
+	r.Header.Set("Content-Type", grpcContentType)
+	r.Header.Set("Te", "trailers")
+
+	res, err := p.grpcClient.Do(r)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		resp := fasthttp.AcquireResponse()
+		resp.SetStatusCode(res.StatusCode)
+		return resp, nil
+	}
+
+	// the trailers are available after the body is read
+	body, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	trailers := res.Trailer
+	if trailers.Get("Grpc-Status") == "" {
+		trailers = res.Header
+	}
+
+	status, err := strconv.Atoi(trailers.Get("Grpc-Status"))
+	if err != nil {
+		return nil, fmt.Errorf("error grpc-status: %s", trailers.Get("Grpc-Status"))
+	}
+
+	if status != grpcStatusOK {
+		// the grpc-message is percent encoded
+		message, err := url.PathUnescape(trailers.Get("Grpc-Message"))
+		if err != nil {
+			message = trailers.Get("Grpc-Message")
+		}
+		return grpcTranscodingResponse(status, message), nil
+	}
+
+	if len(body) < grpcFrameHeaderSize || body[0] != 0 ||
+		len(body)-grpcFrameHeaderSize < int(binary.BigEndian.Uint32(body[1:])) {
+		return nil, fmt.Errorf("error grpc response message of %s", method.Path)
+	}
+
+	var data []byte
+	runHeavy(func() {
+		var output map[string]interface{}
+		output, err = rt.descriptors.DecodeJSON(method.OutputType,
+			body[grpcFrameHeaderSize:grpcFrameHeaderSize+binary.BigEndian.Uint32(body[1:])])
+		if err == nil {
+			data, err = json.Marshal(output)
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := fasthttp.AcquireResponse()
+	for key, values := range res.Header {
+		if key == "Content-Type" || key == "Content-Length" || key == "Trailer" ||
+			strings.HasPrefix(key, "Grpc-") {
+			continue
+		}
+
+		for _, value := range values {
+			resp.Header.Add(key, value)
+		}
+	}
+	resp.Header.SetContentType("application/json")
+	resp.SetBody(data)
+	return resp, nil
+}
+
+// grpcTranscodingValue returns the json value of the input message, the json body must be an object,
+// the query values that not in the body are added, a query value with many values is an array
+func grpcTranscodingValue(req *fasthttp.Request) (map[string]interface{}, error) {
+	value := make(map[string]interface{})
+	if body := bytes.TrimSpace(req.Body()); len(body) > 0 {
+		decoder := json.NewDecoder(bytes.NewReader(body))
+		decoder.UseNumber()
+		if err := decoder.Decode(&value); err != nil {
+			return nil, fmt.Errorf("error json body: %s", err)
+		}
+	}
+
+	args := req.URI().QueryArgs()
+	args.VisitAll(func(key, _ []byte) {
+		name := string(key)
+		if _, ok := value[name]; ok {
+			return
+		}
+
+		values := args.PeekMulti(name)
+		if len(values) == 1 {
+			value[name] = string(values[0])
+			return
+		}
+
+		items := make([]interface{}, 0, len(values))
+		for _, v := range values {
+			items = append(items, string(v))
+		}
+		value[name] = items
+	})
+
+	return value, nil
+}
+
+// grpcTranscodingResponse returns the response of the failed grpc status
+func grpcTranscodingResponse(status int, message string) *fasthttp.Response {
+	code, ok := grpcHTTPStatus[status]
+	if !ok {
+		code = fasthttp.StatusInternalServerError
+	}
+
+	data, _ := json.Marshal(&grpcTranscodingError{Code: status, Message: message})
+
+	resp := fasthttp.AcquireResponse()
+	resp.SetStatusCode(code)
+	resp.Header.SetContentType("application/json")
+	resp.SetBody(data)
+	return resp
+}
@@ -0,0 +1,238 @@
+package proxy
+
+import (
+	"bytes"
+	"crypto/tls"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/fagongzi/gateway/pkg/util"
+	"github.com/fagongzi/log"
+	"github.com/valyala/fasthttp"
+	"golang.org/x/net/http2"
+)
+
+const (
+	grpcWebContentType     = "application/grpc-web"
+	grpcWebTextContentType = "application/grpc-web-text"
+	grpcContentType        = "application/grpc"
+	grpcTrailerFlag        = 0x80
+	grpcStatusUnknown      = "2"
+	grpcStatusUnavailable  = "14"
+	grpcDialTimeout        = time.Second * 3
+)
+
+var (
+	// grpcWebRemoveHeaders the headers of the grpc-web request that not sent to the grpc backend
+	grpcWebRemoveHeaders = map[string]bool{
+		"Content-Length":    true,
+		"Content-Type":      true,
+		"Host":              true,
+		"Connection":        true,
+		"Accept":            true,
+		"Accept-Encoding":   true,
+		"X-Grpc-Web":        true,
+		"X-User-Agent":      true,
+		"Transfer-Encoding": true,
+	}
+)
+
+func newGRPCClient(dial func(string, time.Duration, *util.HTTPTrace) (net.Conn, error)) *http.Client {
+	return &http.Client{
+		Transport: &http2.Transport{
+			// grpc backends use h2c
+			AllowHTTP: true,
+			DialTLS: func(network, addr string, cfg *tls.Config) (net.Conn, error) {
+				return dial(addr, grpcDialTimeout, nil)
+			},
+		},
+	}
+}
+
+// isGRPCWebRequest returns true if the request is sent by the grpc-web client
+func isGRPCWebRequest(req *fasthttp.Request) bool {
+	return bytes.HasPrefix(req.Header.ContentType(), []byte(grpcWebContentType))
+}
+
+// onGRPCWeb translate the grpc-web request to the grpc request, and the grpc response to
+// the grpc-web response. The response body is streamed to the client, and the grpc trailers
+// are sent as the last trailer frame of the body
+func (p *Proxy) onGRPCWeb(c *proxyContext, addr string) (*fasthttp.Response, error) {
+	req := c.forwardReq
+	contentType := string(req.Header.ContentType())
+	text := strings.HasPrefix(contentType, grpcWebTextContentType)
+
+	body := req.Body()
+	if text {
+		value, err := base64.StdEncoding.DecodeString(string(body))
+		if err != nil {
+			return nil, fmt.Errorf("error grpc-web-text body: %s", err)
+		}
+		body = value
+	}
+
+	r, err := http.NewRequest("POST", fmt.Sprintf("http://%s%s", addr, req.URI().Path()), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.VisitAll(func(key, value []byte) {
+		if !grpcWebRemoveHeaders[string(key)] {
+			r.Header.Add(string(key), string(value))
+		}
+	})
+	r.Host = c.result.upstreamHost()
+	r.Header.Set("Content-Type", grpcContentType+grpcContentTypeSuffix(contentType))
+	r.Header.Set("Te", "trailers")
+
+	res, err := p.grpcClient.Do(r)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := fasthttp.AcquireResponse()
+	resp.SetStatusCode(res.StatusCode)
+	for key, values := range res.Header {
+		if key == "Content-Type" || key == "Content-Length" || key == "Trailer" {
+			continue
+		}
+
+		for _, value := range values {
+			resp.Header.Add(key, value)
+		}
+	}
+
+	if res.StatusCode != http.StatusOK {
+		res.Body.Close()
+		return resp, nil
+	}
+
+	if text {
+		resp.Header.SetContentType(grpcWebTextContentType + grpcContentTypeSuffix(contentType))
+	} else {
+		resp.Header.SetContentType(grpcWebContentType + grpcContentTypeSuffix(contentType))
+	}
+
+	c.result.stream = newGRPCWebStream(res, text)
+	return resp, nil
+}
+
+// grpcContentTypeSuffix returns the message format suffix of the content type, e.g. +proto
+func grpcContentTypeSuffix(contentType string) string {
+	if idx := strings.IndexByte(contentType, '+'); idx > 0 {
+		return contentType[idx:]
+	}
+
+	return ""
+}
+
+// grpcWebStream is the grpc-web response body, the data frames of the grpc response
+// are the same as grpc-web, the trailers are encoded as the trailer frame at the end.
+// For grpc-web-text, every chunk is base64 encoded with padding
+type grpcWebStream struct {
+	res     *http.Response
+	text    bool
+	eof     bool
+	pending []byte
+	raw     []byte
+}
+
+func newGRPCWebStream(res *http.Response, text bool) *grpcWebStream {
+	return &grpcWebStream{
+		res:  res,
+		text: text,
+	}
+}
+
+func (s *grpcWebStream) Read(p []byte) (int, error) {
+	if !s.text {
+		return s.read(p)
+	}
+
+	size := len(p) / 4 * 3
+	if size == 0 {
+		return 0, io.ErrShortBuffer
+	}
+
+	if cap(s.raw) < size {
+		s.raw = make([]byte, size)
+	}
+
+	n, err := s.read(s.raw[:size])
+	if n > 0 {
+		base64.StdEncoding.Encode(p, s.raw[:n])
+	}
+	return base64.StdEncoding.EncodedLen(n), err
+}
+
+func (s *grpcWebStream) read(p []byte) (int, error) {
+	for !s.eof {
+		n, err := s.res.Body.Read(p)
+		if err == io.EOF {
+			s.eof = true
+			s.pending = s.trailerFrame("")
+		} else if err != nil {
+			log.Errorf("grpc-web: read grpc response failed, errors:\n%+v", err)
+			s.eof = true
+			s.pending = s.trailerFrame(grpcStatusUnavailable)
+		}
+
+		if n > 0 {
+			return n, nil
+		}
+	}
+
+	if len(s.pending) == 0 {
+		return 0, io.EOF
+	}
+
+	n := copy(p, s.pending)
+	s.pending = s.pending[n:]
+	return n, nil
+}
+
+// trailerFrame returns the trailer frame, the status is used if the grpc-status is missing,
+// for the trailers-only response, the grpc-status is in the headers
+func (s *grpcWebStream) trailerFrame(status string) []byte {
+	trailers := s.res.Trailer
+	if trailers.Get("Grpc-Status") == "" {
+		trailers = s.res.Header
+	}
+
+	var buf bytes.Buffer
+	for key, values := range trailers {
+		key = strings.ToLower(key)
+		if !strings.HasPrefix(key, "grpc-") && s.res.Trailer.Get(key) == "" {
+			continue
+		}
+
+		for _, value := range values {
+			buf.WriteString(key)
+			buf.WriteString(": ")
+			buf.WriteString(value)
+			buf.WriteString("\r\n")
+		}
+	}
+
+	if trailers.Get("Grpc-Status") == "" {
+		if status == "" {
+			status = grpcStatusUnknown
+		}
+		buf.WriteString("grpc-status: " + status + "\r\n")
+	}
+
+	frame := make([]byte, 5, 5+buf.Len())
+	frame[0] = grpcTrailerFlag
+	binary.BigEndian.PutUint32(frame[1:], uint32(buf.Len()))
+	return append(frame, buf.Bytes()...)
+}
+
+func (s *grpcWebStream) Close() error {
+	return s.res.Body.Close()
+}
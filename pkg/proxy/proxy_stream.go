@@ -0,0 +1,325 @@
+package proxy
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/fagongzi/log"
+)
+
+const (
+	defaultStreamHandshakeTimeout = time.Second * 5
+	defaultStreamDialTimeout      = time.Second * 3
+
+	tlsRecordHandshake       = 0x16
+	tlsHandshakeClientHello  = 0x01
+	tlsExtensionServerName   = 0x00
+	tlsServerNameTypeDNSName = 0x00
+)
+
+var (
+	errNotClientHello = errors.New("not a tls client hello")
+)
+
+// StreamListener is a layer-4 listener, the raw tcp connections are forwarded to the servers of
+// the cluster. If tls is true, the connections are routed by the SNI of the tls client hello without
+// terminating tls, and the cluster is used if no route matches
+type StreamListener struct {
+	Addr        string        `json:"addr"`
+	TLS         bool          `json:"tls,omitempty"`
+	ClusterID   uint64        `json:"cluster,omitempty"`
+	Routes      []StreamRoute `json:"routes,omitempty"`
+	IdleTimeout int64         `json:"idleTimeout,omitempty"`
+}
+
+// StreamRoute route the tls connections to the cluster by server name, *.example.com is supported
+type StreamRoute struct {
+	ServerName string `json:"serverName"`
+	ClusterID  uint64 `json:"cluster"`
+}
+
+func parseStreamListeners(file string) ([]*StreamListener, error) {
+	if file == "" {
+		return nil, nil
+	}
+
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	var listeners []*StreamListener
+	err = json.Unmarshal(data, &listeners)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, l := range listeners {
+		if l.Addr == "" {
+			return nil, fmt.Errorf("missing stream listener addr")
+		}
+
+		if l.ClusterID == 0 && len(l.Routes) == 0 {
+			return nil, fmt.Errorf("missing stream listener cluster: %s", l.Addr)
+		}
+
+		if !l.TLS && len(l.Routes) > 0 {
+			return nil, fmt.Errorf("stream listener routes require tls: %s", l.Addr)
+		}
+	}
+
+	return listeners, nil
+}
+
+func (l *StreamListener) selectCluster(serverName string) uint64 {
+	serverName = strings.ToLower(serverName)
+	for _, r := range l.Routes {
+		name := strings.ToLower(r.ServerName)
+		if name == serverName ||
+			(strings.HasPrefix(name, "*.") && strings.HasSuffix(serverName, name[1:])) {
+			return r.ClusterID
+		}
+	}
+
+	return l.ClusterID
+}
+
+func (p *Proxy) startStreamListeners() {
+	for _, cfg := range p.streamCfgs {
+		l, err := net.Listen("tcp", cfg.Addr)
+		if err != nil {
+			log.Fatalf("gateway proxy stream listener start failed, errors:\n%+v",
+				err)
+		}
+
+		p.streamListeners = append(p.streamListeners, l)
+		go p.serveStream(l, cfg)
+
+		log.Infof("gateway proxy stream listener started at <%s>", cfg.Addr)
+	}
+}
+
+func (p *Proxy) stopStreamListeners() {
+	for _, l := range p.streamListeners {
+		l.Close()
+	}
+}
+
+func (p *Proxy) serveStream(l net.Listener, cfg *StreamListener) {
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			if !p.isStopped() {
+				log.Errorf("stream-%s: accept failed, errors:\n%+v",
+					cfg.Addr,
+					err)
+			}
+			return
+		}
+
+		go p.handleStream(conn, cfg)
+	}
+}
+
+func (p *Proxy) handleStream(conn net.Conn, cfg *StreamListener) {
+	defer conn.Close()
+
+	var hello []byte
+	id := cfg.ClusterID
+	if cfg.TLS {
+		conn.SetReadDeadline(time.Now().Add(defaultStreamHandshakeTimeout))
+		data, serverName, err := readClientHello(conn)
+		if err != nil {
+			log.Warnf("stream-%s: read client hello from %s failed, errors:\n%+v",
+				cfg.Addr,
+				conn.RemoteAddr(),
+				err)
+			return
+		}
+		conn.SetReadDeadline(time.Time{})
+
+		hello = data
+		id = cfg.selectCluster(serverName)
+	}
+
+	svr := p.dispatcher.selectStreamServer(id)
+	if svr == nil {
+		log.Warnf("stream-%s: cluster %d has no server, close connection from %s",
+			cfg.Addr,
+			id,
+			conn.RemoteAddr())
+		return
+	}
+
+	p.dispatcher.analysiser.Request(svr.id)
+	backend, err := p.dialTimeout(svr.meta.Addr, defaultStreamDialTimeout, nil)
+	if err != nil {
+		p.dispatcher.analysiser.Failure(svr.id)
+		log.Errorf("stream-%s: connect to %s failed, errors:\n%+v",
+			cfg.Addr,
+			svr.meta.Addr,
+			err)
+		return
+	}
+	defer backend.Close()
+
+	startAt := time.Now()
+	if len(hello) > 0 {
+		_, err = backend.Write(hello)
+		if err != nil {
+			p.dispatcher.analysiser.Failure(svr.id)
+			return
+		}
+	}
+
+	timeout := time.Duration(cfg.IdleTimeout) * time.Second
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go pipeStream(backend, conn, timeout, &wg)
+	go pipeStream(conn, backend, timeout, &wg)
+	wg.Wait()
+
+	p.dispatcher.analysiser.Response(svr.id, time.Now().Sub(startAt).Nanoseconds())
+}
+
+// pipeStream copy the data from src to dst until EOF or the connection is idle for the timeout,
+// then close the write side of dst
+func pipeStream(dst, src net.Conn, timeout time.Duration, wg *sync.WaitGroup) {
+	defer wg.Done()
+
+	buf := make([]byte, 32*1024)
+	for {
+		if timeout > 0 {
+			src.SetReadDeadline(time.Now().Add(timeout))
+		}
+
+		n, err := src.Read(buf)
+		if n > 0 {
+			if _, werr := dst.Write(buf[:n]); werr != nil {
+				break
+			}
+		}
+
+		if err != nil {
+			break
+		}
+	}
+
+	if c, ok := dst.(*net.TCPConn); ok {
+		c.CloseWrite()
+	} else {
+		dst.Close()
+	}
+
+	// unblock the other direction if the connection is idle
+	if timeout > 0 {
+		src.SetReadDeadline(time.Now())
+	}
+}
+
+// readClientHello read the tls client hello record, returns the read data and the server name
+func readClientHello(r io.Reader) ([]byte, string, error) {
+	header := make([]byte, 5)
+	_, err := io.ReadFull(r, header)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if header[0] != tlsRecordHandshake {
+		return nil, "", errNotClientHello
+	}
+
+	data := make([]byte, 5+int(binary.BigEndian.Uint16(header[3:5])))
+	copy(data, header)
+	_, err = io.ReadFull(r, data[5:])
+	if err != nil {
+		return nil, "", err
+	}
+
+	serverName, err := parseServerName(data[5:])
+	if err != nil {
+		return nil, "", err
+	}
+
+	return data, serverName, nil
+}
+
+// parseServerName returns the server name extension of the client hello handshake message
+func parseServerName(msg []byte) (string, error) {
+	if len(msg) < 4 || msg[0] != tlsHandshakeClientHello {
+		return "", errNotClientHello
+	}
+
+	// version(2) + random(32)
+	pos := 4 + 2 + 32
+	if len(msg) < pos+1 {
+		return "", errNotClientHello
+	}
+
+	// session id
+	pos += 1 + int(msg[pos])
+	if len(msg) < pos+2 {
+		return "", errNotClientHello
+	}
+
+	// cipher suites
+	pos += 2 + int(binary.BigEndian.Uint16(msg[pos:]))
+	if len(msg) < pos+1 {
+		return "", errNotClientHello
+	}
+
+	// compression methods
+	pos += 1 + int(msg[pos])
+	if len(msg) < pos+2 {
+		// no extensions
+		return "", nil
+	}
+
+	end := pos + 2 + int(binary.BigEndian.Uint16(msg[pos:]))
+	if end > len(msg) {
+		return "", errNotClientHello
+	}
+	pos += 2
+
+	for pos+4 <= end {
+		extType := binary.BigEndian.Uint16(msg[pos:])
+		extLen := int(binary.BigEndian.Uint16(msg[pos+2:]))
+		pos += 4
+		if pos+extLen > end {
+			return "", errNotClientHello
+		}
+
+		if extType == tlsExtensionServerName {
+			ext := msg[pos : pos+extLen]
+			if len(ext) < 2 {
+				return "", errNotClientHello
+			}
+
+			for i := 2; i+3 <= len(ext); {
+				nameType := ext[i]
+				nameLen := int(binary.BigEndian.Uint16(ext[i+1:]))
+				i += 3
+				if i+nameLen > len(ext) {
+					return "", errNotClientHello
+				}
+
+				if nameType == tlsServerNameTypeDNSName {
+					return string(ext[i : i+nameLen]), nil
+				}
+				i += nameLen
+			}
+		}
+
+		pos += extLen
+	}
+
+	return "", nil
+}
@@ -3,18 +3,42 @@ package proxy
 import (
 	"bytes"
 	"fmt"
+	"io/ioutil"
+	"net"
 	"net/http"
 	"net/url"
+	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/fagongzi/log"
 	"github.com/fagongzi/util/hack"
 	"github.com/gorilla/websocket"
-	"github.com/koding/websocketproxy"
 	"github.com/valyala/fasthttp"
 )
 
 const (
 	websocketRspKey = "__ws_rsp"
+
+	websocketControlTimeout = time.Second
+)
+
+var (
+	// websocketRemoveHeaders the headers of the handshake that set by the websocket dialer
+	websocketRemoveHeaders = map[string]bool{
+		"Host":                     true,
+		"Origin":                   true,
+		"Upgrade":                  true,
+		"Connection":               true,
+		"Sec-Websocket-Key":        true,
+		"Sec-Websocket-Version":    true,
+		"Sec-Websocket-Extensions": true,
+		"Content-Length":           true,
+		"Keep-Alive":               true,
+		"Te":                       true,
+		"Trailers":                 true,
+		"Transfer-Encoding":        true,
+	}
 )
 
 // ServeHTTP  http reverse handler by http
@@ -31,12 +55,24 @@ func (p *Proxy) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
 	buf.Write(hack.StringToSlice(req.RequestURI))
 	requestTag := hack.SliceToString(buf.Bytes())
 
+	if p.ipLimiter != nil && !p.ipLimiter.allowRequest(trimIPPort(req.RemoteAddr), time.Now()) {
+		rw.WriteHeader(fasthttp.StatusTooManyRequests)
+		return
+	}
+
 	if req.Method != "GET" {
 		rw.WriteHeader(fasthttp.StatusMethodNotAllowed)
 		return
 	}
 
 	ctx := &fasthttp.RequestCtx{}
+	// the client ip of the ip access control and the kill switch
+	if addr, err := net.ResolveTCPAddr("tcp", req.RemoteAddr); err == nil {
+		ctx.Init(&fasthttp.Request{}, addr, nil)
+	}
+	if internal, _ := req.Context().Value(internalListenerContext{}).(bool); internal {
+		ctx.SetUserValue(internalListenerKey, true)
+	}
 	for k, vs := range req.Header {
 		for _, v := range vs {
 			ctx.Request.Header.Add(k, v)
@@ -44,7 +80,28 @@ func (p *Proxy) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
 	}
 	ctx.Request.SetRequestURI(req.RequestURI)
 
-	api, dispatches := p.dispatcher.dispatch(&ctx.Request, requestTag)
+	if ip := GetRealClientIP(ctx); !p.dispatcher.allowIP(ip) {
+		rw.WriteHeader(fasthttp.StatusForbidden)
+		log.Warnf("%s: websocket client ip %s denied by the ip access control",
+			requestTag,
+			ip)
+		return
+	}
+
+	api, dispatches := p.dispatcher.dispatch(ctx, requestTag)
+	if ks := p.dispatcher.getKillSwitch(); ks != nil && ks.killed(api, ctx) {
+		ks.write(ctx, api, p.errorPages)
+		rw.WriteHeader(ctx.Response.StatusCode())
+		for _, dn := range dispatches {
+			releaseDispathNode(dn)
+		}
+		p.dispatcher.dispatchCompleted()
+		log.Warnf("%s: websocket killed by the kill switch, reason %s",
+			requestTag,
+			ks.meta.Reason)
+		return
+	}
+
 	if len(dispatches) <= 0 &&
 		(nil == api || api.meta.DefaultValue == nil) {
 		rw.WriteHeader(fasthttp.StatusNotFound)
@@ -60,16 +117,26 @@ func (p *Proxy) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
 		log.Fatalf("normal http request must use fasthttp")
 	}
 
+	if !p.websockets.acquire(api, p.cfg.Option.LimitCountWebSocketConn) {
+		rw.WriteHeader(fasthttp.StatusServiceUnavailable)
+		p.dispatcher.dispatchCompleted()
+		log.Warnf("%s: websocket connections over limit, return with 503",
+			requestTag)
+		return
+	}
+
 	dispatches[0].ctx = ctx
 	p.doProxy(dispatches[0], func(c *proxyContext) {
 		c.SetAttr(websocketRspKey, rw)
 	})
+	p.websockets.release(api)
 	dispatches[0].release()
 	p.dispatcher.dispatchCompleted()
 }
 
 func (p *Proxy) onWebsocket(c *proxyContext, addr string) (*fasthttp.Response, error) {
 	resp := fasthttp.AcquireResponse()
+	rw := c.GetAttr(websocketRspKey).(http.ResponseWriter)
 
 	var r http.Request
 	r.Method = "GET"
@@ -80,6 +147,7 @@ func (p *Proxy) onWebsocket(c *proxyContext, addr string) (*fasthttp.Response, e
 	r.Host = string(c.forwardReq.Host())
 
 	hdr := make(http.Header)
+	out := make(http.Header)
 	c.forwardReq.Header.VisitAll(func(k, v []byte) {
 		sk := string(k)
 		sv := string(v)
@@ -89,28 +157,309 @@ func (p *Proxy) onWebsocket(c *proxyContext, addr string) (*fasthttp.Response, e
 		default:
 			hdr.Set(sk, sv)
 		}
+
+		if !websocketRemoveHeaders[sk] {
+			out.Add(sk, sv)
+		}
 	})
 	r.Header = hdr
 	r.URL, _ = url.ParseRequestURI(r.RequestURI)
 
-	wp := &websocketproxy.WebsocketProxy{
-		Upgrader: &websocket.Upgrader{
-			ReadBufferSize:  c.result.httpOption().ReadBufferSize,
-			WriteBufferSize: c.result.httpOption().WriteBufferSize,
-			CheckOrigin: func(r *http.Request) bool {
-				return true
-			},
-		},
-		Director: func(incoming *http.Request, out http.Header) {
-			out.Set("Origin", fmt.Sprintf("http://%s", addr))
-			out.Set("Host", addr)
+	api := c.result.api
+	origin := api.webSocketOptions().Origin
+	if origin == "" {
+		origin = fmt.Sprintf("http://%s", addr)
+	}
+	out.Set("Origin", origin)
+	out.Set("Host", c.result.upstreamHost())
+	out.Set("X-Forwarded-Proto", "http")
+
+	option := c.result.httpOption()
+	dialer := &websocket.Dialer{
+		NetDial: func(network, addr string) (net.Conn, error) {
+			return p.dial(addr, nil)
 		},
-		Backend: func(r *http.Request) *url.URL {
-			u, _ := url.Parse(fmt.Sprintf("ws://%s%s", addr, r.RequestURI))
-			return u
+		HandshakeTimeout: option.ReadTimeout,
+		ReadBufferSize:   option.ReadBufferSize,
+		WriteBufferSize:  option.WriteBufferSize,
+	}
+
+	backend, res, err := dialer.Dial(fmt.Sprintf("ws://%s%s", addr, r.RequestURI), out)
+	if err != nil {
+		if res != nil {
+			// the handshake is refused by the backend, e.g. 401
+			resp.SetStatusCode(res.StatusCode)
+			copyWebsocketResponse(rw, res)
+			return resp, nil
+		}
+
+		resp.SetStatusCode(fasthttp.StatusServiceUnavailable)
+		rw.WriteHeader(fasthttp.StatusServiceUnavailable)
+		return resp, nil
+	}
+	defer backend.Close()
+
+	upgrader := &websocket.Upgrader{
+		ReadBufferSize:  option.ReadBufferSize,
+		WriteBufferSize: option.WriteBufferSize,
+		CheckOrigin: func(r *http.Request) bool {
+			return true
 		},
 	}
 
-	wp.ServeHTTP(c.GetAttr(websocketRspKey).(http.ResponseWriter), &r)
+	upgradeHeader := make(http.Header)
+	for _, key := range []string{"Sec-Websocket-Protocol", "Set-Cookie"} {
+		if value := res.Header.Get(key); value != "" {
+			upgradeHeader.Set(key, value)
+		}
+	}
+
+	client, err := upgrader.Upgrade(rw, &r, upgradeHeader)
+	if err != nil {
+		log.Errorf("%s: websocket upgrade failed, errors:\n%+v",
+			c.result.requestTag,
+			err)
+		return resp, nil
+	}
+	defer client.Close()
+
+	idle := p.cfg.Option.LimitDurationWebSocketIdle
+	if value := api.webSocketOptions().IdleTimeout; value > 0 {
+		idle = time.Second * time.Duration(value)
+	}
+
+	s := newWebsocketSession(client, backend, idle, func() {
+		p.dispatcher.analysiser.Message(api.meta.ID)
+	})
+	p.websockets.add(s)
+	p.dispatcher.analysiser.Connect(api.meta.ID)
+	incrWebSocketConn(api.meta.Name)
+
+	start := time.Now()
+	s.serve()
+
+	p.websockets.remove(s)
+	p.dispatcher.analysiser.Disconnect(api.meta.ID, time.Since(start))
+	decrWebSocketConn(api.meta.Name)
 	return resp, nil
 }
+
+func copyWebsocketResponse(rw http.ResponseWriter, res *http.Response) {
+	defer res.Body.Close()
+
+	for key, values := range res.Header {
+		for _, value := range values {
+			rw.Header().Add(key, value)
+		}
+	}
+	rw.WriteHeader(res.StatusCode)
+
+	body, _ := ioutil.ReadAll(res.Body)
+	rw.Write(body)
+}
+
+// websocketSession is a proxied websocket connection, the messages and the control frames are
+// relayed between the client and the backend, onMessage is called after a message is relayed
+type websocketSession struct {
+	client, backend *websocket.Conn
+	idle            time.Duration
+	lastActive      int64
+	onMessage       func()
+	closeOnce       sync.Once
+	closeC          chan struct{}
+}
+
+func newWebsocketSession(client, backend *websocket.Conn, idle time.Duration, onMessage func()) *websocketSession {
+	return &websocketSession{
+		client:     client,
+		backend:    backend,
+		idle:       idle,
+		lastActive: time.Now().UnixNano(),
+		onMessage:  onMessage,
+		closeC:     make(chan struct{}),
+	}
+}
+
+// serve relay the messages until one side is closed
+func (s *websocketSession) serve() {
+	errC := make(chan error, 2)
+	go s.pipe(s.client, s.backend, errC)
+	go s.pipe(s.backend, s.client, errC)
+
+	if s.idle > 0 {
+		go s.watchIdle()
+	}
+
+	<-errC
+	s.closeOnce.Do(func() {
+		close(s.closeC)
+	})
+}
+
+func (s *websocketSession) pipe(dst, src *websocket.Conn, errC chan error) {
+	relay := func(messageType int) func(string) error {
+		return func(data string) error {
+			s.touch()
+			return dst.WriteControl(messageType, []byte(data), time.Now().Add(websocketControlTimeout))
+		}
+	}
+	src.SetPingHandler(relay(websocket.PingMessage))
+	src.SetPongHandler(relay(websocket.PongMessage))
+
+	for {
+		messageType, msg, err := src.ReadMessage()
+		if err != nil {
+			m := websocket.FormatCloseMessage(websocket.CloseNormalClosure, err.Error())
+			if e, ok := err.(*websocket.CloseError); ok && e.Code != websocket.CloseNoStatusReceived {
+				m = websocket.FormatCloseMessage(e.Code, e.Text)
+			}
+			dst.WriteControl(websocket.CloseMessage, m, time.Now().Add(websocketControlTimeout))
+			errC <- err
+			return
+		}
+
+		s.touch()
+		err = dst.WriteMessage(messageType, msg)
+		if err != nil {
+			errC <- err
+			return
+		}
+		s.onMessage()
+	}
+}
+
+func (s *websocketSession) touch() {
+	atomic.StoreInt64(&s.lastActive, time.Now().UnixNano())
+}
+
+// watchIdle close the session if no messages in both directions for the idle timeout
+func (s *websocketSession) watchIdle() {
+	timer := time.NewTimer(s.idle)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-s.closeC:
+			return
+		case <-timer.C:
+			elapsed := time.Since(time.Unix(0, atomic.LoadInt64(&s.lastActive)))
+			if elapsed >= s.idle {
+				s.goingAway("idle timeout")
+				s.close()
+				return
+			}
+			timer.Reset(s.idle - elapsed)
+		}
+	}
+}
+
+// goingAway send the close frames to both sides, the session ends after the peers reply
+func (s *websocketSession) goingAway(reason string) {
+	m := websocket.FormatCloseMessage(websocket.CloseGoingAway, reason)
+	deadline := time.Now().Add(websocketControlTimeout)
+	s.client.WriteControl(websocket.CloseMessage, m, deadline)
+	s.backend.WriteControl(websocket.CloseMessage, m, deadline)
+}
+
+func (s *websocketSession) close() {
+	s.client.Close()
+	s.backend.Close()
+}
+
+// websocketConns limits and tracks the websocket connections of the proxy
+type websocketConns struct {
+	sync.Mutex
+
+	total    int64
+	apis     map[uint64]int64
+	sessions map[*websocketSession]struct{}
+	draining bool
+}
+
+func newWebsocketConns() *websocketConns {
+	return &websocketConns{
+		apis:     make(map[uint64]int64),
+		sessions: make(map[*websocketSession]struct{}),
+	}
+}
+
+// acquire returns false if the connections of the proxy or the api are over the limit,
+// 0 means no limit
+func (w *websocketConns) acquire(api *apiRuntime, max int) bool {
+	w.Lock()
+	defer w.Unlock()
+
+	if w.draining || (max > 0 && w.total >= int64(max)) {
+		return false
+	}
+
+	id := api.meta.ID
+	if value := api.webSocketOptions().MaxConns; value > 0 && w.apis[id] >= value {
+		return false
+	}
+
+	w.total++
+	w.apis[id]++
+	return true
+}
+
+func (w *websocketConns) release(api *apiRuntime) {
+	w.Lock()
+	id := api.meta.ID
+	w.total--
+	w.apis[id]--
+	if w.apis[id] <= 0 {
+		delete(w.apis, id)
+	}
+	w.Unlock()
+}
+
+func (w *websocketConns) add(s *websocketSession) {
+	w.Lock()
+	w.sessions[s] = struct{}{}
+	w.Unlock()
+}
+
+func (w *websocketConns) remove(s *websocketSession) {
+	w.Lock()
+	delete(w.sessions, s)
+	w.Unlock()
+}
+
+func (w *websocketConns) count() int {
+	w.Lock()
+	defer w.Unlock()
+	return len(w.sessions)
+}
+
+// drain rejects the new connections, and send the close frames to the current connections,
+// the connections not closed by the peers in the timeout are closed forcibly
+func (w *websocketConns) drain(timeout time.Duration) {
+	w.Lock()
+	w.draining = true
+	var sessions []*websocketSession
+	for s := range w.sessions {
+		sessions = append(sessions, s)
+	}
+	w.Unlock()
+
+	if len(sessions) == 0 {
+		return
+	}
+
+	log.Infof("stop: start to drain %d websocket connections", len(sessions))
+	for _, s := range sessions {
+		s.goingAway("proxy shutdown")
+	}
+
+	deadline := time.Now().Add(timeout)
+	for w.count() > 0 && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond * 100)
+	}
+
+	w.Lock()
+	for s := range w.sessions {
+		s.close()
+	}
+	w.Unlock()
+}
@@ -0,0 +1,260 @@
+package proxy
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/fagongzi/gateway/pkg/pb/metapb"
+	"github.com/fagongzi/gateway/pkg/store"
+	"github.com/fagongzi/gateway/pkg/util"
+	"github.com/fagongzi/log"
+	"github.com/fagongzi/util/format"
+)
+
+const (
+	// rateLimitSweepInterval is the interval to remove the full buckets, the full bucket is the same
+	// as a new bucket, so the buckets of the clients that gone are not kept
+	rateLimitSweepInterval = time.Minute
+)
+
+var (
+	errRateLimitExists   = errors.New("RateLimit already exist")
+	errRateLimitNotFound = errors.New("RateLimit not found")
+)
+
+type rateLimitBucketKey struct {
+	api    uint64
+	client string
+}
+
+// rateLimitProfile is the rate and the burst of the rate limit in the time window
+type rateLimitProfile struct {
+	window *timeWindow
+	rate   float64
+	burst  int64
+}
+
+func newRateLimitProfile(rate, period, burst int64) *rateLimitProfile {
+	if period <= 0 {
+		period = 1
+	}
+
+	if burst <= 0 {
+		burst = rate
+	}
+
+	return &rateLimitProfile{
+		rate:  float64(rate) / float64(period),
+		burst: burst,
+	}
+}
+
+// rateLimitRuntime is the token buckets of a rate limit on this proxy, keyed by the api and the client.
+// The profile is rescheduled every minute, the buckets are reset when the profile is switched
+type rateLimitRuntime struct {
+	sync.Mutex
+
+	meta        *metapb.RateLimit
+	apis        map[uint64]struct{}
+	base        *rateLimitProfile
+	profiles    []*rateLimitProfile
+	active      *rateLimitProfile
+	scheduledAt int64
+	buckets     map[rateLimitBucketKey]*util.TokenBucket
+	sweptAt     time.Time
+}
+
+func newRateLimitRuntime(meta *metapb.RateLimit) *rateLimitRuntime {
+	l := &rateLimitRuntime{
+		meta:        meta,
+		base:        newRateLimitProfile(meta.Rate, meta.Period, meta.Burst),
+		scheduledAt: -1,
+		buckets:     make(map[rateLimitBucketKey]*util.TokenBucket),
+		sweptAt:     time.Now(),
+	}
+	l.active = l.base
+
+	if len(meta.APIs) > 0 {
+		l.apis = make(map[uint64]struct{}, len(meta.APIs))
+		for _, id := range meta.APIs {
+			l.apis[id] = struct{}{}
+		}
+	}
+
+	for i := range meta.Profiles {
+		value := &meta.Profiles[i]
+		w, err := parseTimeWindow(&value.Window)
+		if err != nil {
+			log.Errorf("rate limit <%d> parse profile time window failed, errors:\n%+v",
+				meta.ID,
+				err)
+			continue
+		}
+
+		profile := newRateLimitProfile(value.Rate, value.Period, value.Burst)
+		profile.window = w
+		l.profiles = append(l.profiles, profile)
+	}
+
+	return l
+}
+
+func (l *rateLimitRuntime) matches(api uint64) bool {
+	if l.apis != nil {
+		_, ok := l.apis[api]
+		return ok
+	}
+
+	return l.meta.API == 0 || l.meta.API == api
+}
+
+// take take a token from the bucket of the api and the client, returns false and the duration until
+// a token is available if the bucket is empty. The apis of the group share the bucket of the client
+func (l *rateLimitRuntime) take(api uint64, client string, now time.Time) (bool, time.Duration) {
+	if l.apis != nil {
+		api = 0
+	}
+
+	l.Lock()
+	l.schedule(now)
+	if now.Sub(l.sweptAt) >= rateLimitSweepInterval {
+		l.sweep(now)
+	}
+
+	key := rateLimitBucketKey{api: api, client: client}
+	bucket, ok := l.buckets[key]
+	if !ok {
+		bucket = util.NewTokenBucket(l.active.rate, l.active.burst, now)
+		l.buckets[key] = bucket
+	}
+	l.Unlock()
+
+	return bucket.Take(now)
+}
+
+// schedule switch to the first profile that contains the time, or the base profile, the time windows
+// are in minutes, so the profiles are evaluated once a minute
+func (l *rateLimitRuntime) schedule(now time.Time) {
+	if len(l.profiles) == 0 {
+		return
+	}
+
+	minute := now.Unix() / 60
+	if minute == l.scheduledAt {
+		return
+	}
+	l.scheduledAt = minute
+
+	active := l.base
+	for _, profile := range l.profiles {
+		if profile.window.contains(now) {
+			active = profile
+			break
+		}
+	}
+
+	if active != l.active {
+		l.active = active
+		l.buckets = make(map[rateLimitBucketKey]*util.TokenBucket)
+		log.Infof("rate limit <%d> switched to rate %.2f/s, burst %d",
+			l.meta.ID,
+			active.rate,
+			active.burst)
+	}
+}
+
+func (l *rateLimitRuntime) sweep(now time.Time) {
+	for key, bucket := range l.buckets {
+		if bucket.Full(now) {
+			delete(l.buckets, key)
+		}
+	}
+	l.sweptAt = now
+}
+
+func (r *dispatcher) loadRateLimits() {
+	log.Infof("load rate limits")
+
+	err := r.store.GetRateLimits(limit, func(value interface{}) error {
+		return r.addRateLimit(value.(*metapb.RateLimit))
+	})
+	if nil != err {
+		log.Errorf("load rate limits failed, errors:\n%+v",
+			err)
+		return
+	}
+}
+
+func (r *dispatcher) doRateLimitEvent(evt *store.Evt) {
+	value, _ := evt.Value.(*metapb.RateLimit)
+
+	if evt.Type == store.EventTypeNew {
+		r.addRateLimit(value)
+	} else if evt.Type == store.EventTypeDelete {
+		r.removeRateLimit(format.MustParseStrUInt64(evt.Key))
+	} else if evt.Type == store.EventTypeUpdate {
+		r.updateRateLimit(value)
+	}
+}
+
+func (r *dispatcher) addRateLimit(meta *metapb.RateLimit) error {
+	r.Lock()
+	defer r.Unlock()
+
+	if _, ok := r.rateLimits[meta.ID]; ok {
+		return errRateLimitExists
+	}
+
+	r.rateLimits[meta.ID] = newRateLimitRuntime(meta)
+	log.Infof("rate limit <%d> added, data <%s>",
+		meta.ID,
+		meta.String())
+
+	return nil
+}
+
+// updateRateLimit update the rate limit, the buckets are reset
+func (r *dispatcher) updateRateLimit(meta *metapb.RateLimit) error {
+	r.Lock()
+	defer r.Unlock()
+
+	if _, ok := r.rateLimits[meta.ID]; !ok {
+		return errRateLimitNotFound
+	}
+
+	r.rateLimits[meta.ID] = newRateLimitRuntime(meta)
+	log.Infof("rate limit <%d> updated, data <%s>",
+		meta.ID,
+		meta.String())
+
+	return nil
+}
+
+func (r *dispatcher) removeRateLimit(id uint64) error {
+	r.Lock()
+	defer r.Unlock()
+
+	if _, ok := r.rateLimits[id]; !ok {
+		return errRateLimitNotFound
+	}
+
+	delete(r.rateLimits, id)
+	log.Infof("rate limit <%d> removed", id)
+
+	return nil
+}
+
+// matchedRateLimits returns the rate limits of the api, the filters are called in the dispatch that
+// holds the read lock, a nested read lock is blocked by a pending writer and deadlocks the dispatch.
+// NOTE: MUST Lock on call this function!!
+func (r *dispatcher) matchedRateLimits(api uint64) []*rateLimitRuntime {
+	var values []*rateLimitRuntime
+	for _, l := range r.rateLimits {
+		if l.matches(api) {
+			values = append(values, l)
+		}
+	}
+
+	return values
+}
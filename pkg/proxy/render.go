@@ -1,7 +1,11 @@
 package proxy
 
 import (
+	"encoding/json"
+	"strings"
+
 	"github.com/buger/jsonparser"
+	"github.com/fagongzi/gateway/pkg/util"
 	"github.com/fagongzi/log"
 	"github.com/valyala/fasthttp"
 )
@@ -20,10 +24,12 @@ type render struct {
 	doRender     func(*fasthttp.RequestCtx)
 	allocBytes   [][]byte
 	requestTag   string
+	errorPages   errorPages
 }
 
-func (rd *render) init(requestTag string, api *apiRuntime, nodes []*dispathNode) {
+func (rd *render) init(requestTag string, api *apiRuntime, nodes []*dispathNode, pages errorPages) {
 	rd.requestTag = requestTag
+	rd.errorPages = pages
 	rd.nodes = nodes
 	rd.api = api
 	rd.doRender = rd.renderSingle
@@ -65,7 +71,7 @@ func (rd *render) renderSingle(ctx *fasthttp.RequestCtx) {
 			return
 		}
 
-		ctx.SetStatusCode(dn.code)
+		writeError(ctx, rd.api, rd.errorPages, dn.code, dn.err)
 		dn.release()
 		return
 	}
@@ -84,6 +90,7 @@ func (rd *render) renderSingle(ctx *fasthttp.RequestCtx) {
 func (rd *render) renderMulti(ctx *fasthttp.RequestCtx) {
 	var err error
 	var hasError bool
+	var degraded []string
 	code := fasthttp.StatusInternalServerError
 	hasTemplate := rd.api.hasRenderTemplate()
 
@@ -102,6 +109,10 @@ func (rd *render) renderMulti(ctx *fasthttp.RequestCtx) {
 			continue
 		}
 
+		if dn.hasError() {
+			degraded = append(degraded, dn.node.meta.AttrName)
+		}
+
 		dn.copyHeaderTo(ctx)
 		dn.release()
 	}
@@ -112,7 +123,7 @@ func (rd *render) renderMulti(ctx *fasthttp.RequestCtx) {
 			return
 		}
 
-		ctx.SetStatusCode(code)
+		writeError(ctx, rd.api, rd.errorPages, code, err)
 		log.Errorf("%s: return with %d, errors: %v",
 			rd.requestTag,
 			code,
@@ -120,6 +131,10 @@ func (rd *render) renderMulti(ctx *fasthttp.RequestCtx) {
 		return
 	}
 
+	if len(degraded) > 0 {
+		rd.markDegraded(ctx, degraded)
+	}
+
 	if !hasTemplate {
 		ctx.Write(rd.multiContext)
 		log.Infof("%s: return with aggregation",
@@ -130,9 +145,38 @@ func (rd *render) renderMulti(ctx *fasthttp.RequestCtx) {
 	rd.renderTemplate(ctx, rd.multiContext)
 }
 
+// markDegraded add the attrs of the nodes that using the default values to the response header,
+// and to the degraded attr of the merged result if the api set
+func (rd *render) markDegraded(ctx *fasthttp.RequestCtx, attrs []string) {
+	ctx.Response.Header.Set(MultiResultsDegradedHeader, strings.Join(attrs, ","))
+
+	if attr := rd.api.meta.DegradedAttr; attr != "" {
+		value, _ := json.Marshal(attrs)
+		data, err := jsonparser.Set(rd.multiContext, value, attr)
+		if err != nil {
+			log.Errorf("%s: set degraded attr %s failed, errors: %v",
+				rd.requestTag,
+				attr,
+				err)
+		} else {
+			rd.multiContext = data
+		}
+	}
+
+	log.Warnf("%s: return with degraded nodes %v",
+		rd.requestTag,
+		attrs)
+}
+
 func (rd *render) renderRaw(ctx *fasthttp.RequestCtx, dn *dispathNode) {
 	ctx.Response.Header.SetContentTypeBytes(dn.getResponseContentType())
-	ctx.Write(dn.getResponseBody())
+	if dn.stream != nil {
+		// the stream is closed by the fasthttp after the body is written
+		ctx.SetBodyStream(dn.stream, -1)
+		dn.stream = nil
+	} else {
+		ctx.Write(dn.getResponseBody())
+	}
 	dn.release()
 
 	log.Infof("%s: return with raw body",
@@ -159,13 +203,18 @@ func (rd *render) renderDefault(ctx *fasthttp.RequestCtx) {
 	} else {
 		ctx.SetStatusCode(fasthttp.StatusOK)
 	}
-	ctx.Write(rd.api.meta.DefaultValue.Body)
+	ctx.Write(applyTemplateBytes(rd.api.meta.DefaultValue.Body, rd.api, &ctx.Request))
 
 	log.Infof("%s: return with default value",
 		rd.requestTag)
 }
 
 func (rd *render) renderTemplate(ctx *fasthttp.RequestCtx, context []byte) {
+	if rd.api.meta.RenderTemplate.Body != "" {
+		rd.renderBody(ctx, context)
+		return
+	}
+
 	data, err := rd.extract(context)
 	if err != nil {
 		ctx.SetStatusCode(fasthttp.StatusInternalServerError)
@@ -181,6 +230,33 @@ func (rd *render) renderTemplate(ctx *fasthttp.RequestCtx, context []byte) {
 		rd.requestTag)
 }
 
+// renderBody execute the body template with the result of the nodes, e.g. rename and prune the fields
+// of the results of the nodes by {"id":{{.Result "user.id"}},"orders":{{.Result "orders.items"}}}
+func (rd *render) renderBody(ctx *fasthttp.RequestCtx, context []byte) {
+	data, err := util.ExecuteTemplate(rd.api.meta.RenderTemplate.Body, &util.TemplateData{
+		Request:   &ctx.Request,
+		Constants: rd.api.constants,
+		Body:      context,
+	})
+	if err != nil {
+		ctx.SetStatusCode(fasthttp.StatusInternalServerError)
+		log.Errorf("%s: return with 500, errors: %v",
+			rd.requestTag,
+			err)
+		return
+	}
+
+	if contentType := rd.api.meta.RenderTemplate.ContentType; contentType != "" {
+		ctx.Response.Header.SetContentType(contentType)
+	} else {
+		ctx.Response.Header.SetContentType(MultiResultsContentType)
+	}
+	ctx.WriteString(data)
+
+	log.Infof("%s: return with body template",
+		rd.requestTag)
+}
+
 func (rd *render) extract(src []byte) ([]byte, error) {
 	var err error
 	data := emptyObject
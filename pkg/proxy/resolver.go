@@ -0,0 +1,49 @@
+package proxy
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net"
+	"time"
+
+	"github.com/fagongzi/gateway/pkg/util"
+)
+
+const (
+	defaultDialTimeout = time.Second * 3
+)
+
+func parseResolver(file string) (*util.Resolver, error) {
+	if file == "" {
+		return nil, nil
+	}
+
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := &util.ResolverCfg{}
+	err = json.Unmarshal(data, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return util.NewResolver(cfg), nil
+}
+
+// dial connect to the backend server, used by the http clients
+func (p *Proxy) dial(addr string, trace *util.HTTPTrace) (net.Conn, error) {
+	return p.dialTimeout(addr, defaultDialTimeout, trace)
+}
+
+// dialTimeout connect to the backend server, the host of the addr is resolved by the
+// custom resolver if configured, otherwise by the host resolver. The dns and connect
+// phases are recorded to the trace if not nil
+func (p *Proxy) dialTimeout(addr string, timeout time.Duration, trace *util.HTTPTrace) (net.Conn, error) {
+	if p.resolver != nil {
+		return p.resolver.DialTrace(addr, timeout, trace)
+	}
+
+	return util.DialTrace(addr, timeout, trace)
+}
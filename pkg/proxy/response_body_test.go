@@ -0,0 +1,88 @@
+package proxy
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/fagongzi/gateway/pkg/pb/metapb"
+	"github.com/fagongzi/gateway/pkg/util"
+	"github.com/valyala/fasthttp"
+)
+
+func TestTruncateResponseBodyLargerThanLimit(t *testing.T) {
+	data := bytes.Repeat([]byte("a"), 1024*1024*2)
+	chunked := false
+	svr := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !chunked {
+			w.Header().Set("Content-Length", "2097152")
+		}
+		w.Write(data)
+	}))
+	defer svr.Close()
+
+	api := &apiRuntime{meta: &metapb.API{
+		ResponseBody: &metapb.ResponseBodyPolicy{
+			MaxBytes: 1024,
+			Truncate: true,
+		},
+	}}
+	option := util.DefaultHTTPOption()
+	option.MaxResponseBodySize = 1024 * 1024
+	option.MaxResponseBodySize, option.TruncateResponseBody = api.maxResponseBody(option.MaxResponseBodySize)
+
+	cli := util.NewFastHTTPClient()
+	addr := strings.TrimPrefix(svr.URL, "http://")
+	for _, chunked = range []bool{false, true} {
+		req := fasthttp.AcquireRequest()
+		req.SetRequestURI("/")
+		req.SetHost(addr)
+
+		res, err := cli.Do(req, addr, option)
+		if err != nil {
+			t.Errorf("chunked %v: expect truncated, but errors:%+v", chunked, err)
+			continue
+		}
+		if !api.limitResponseBody(res, 1024*1024) {
+			t.Errorf("chunked %v: expect truncated, but rejected", chunked)
+		}
+		if len(res.Body()) != 1024 {
+			t.Errorf("chunked %v: expect 1024 bytes, but %d", chunked, len(res.Body()))
+		}
+		if string(res.Header.Peek(defaultTruncatedHeader)) != "true" {
+			t.Errorf("chunked %v: expect truncated header", chunked)
+		}
+
+		fasthttp.ReleaseRequest(req)
+		fasthttp.ReleaseResponse(res)
+	}
+}
+
+func TestTruncateResponseBodyLimitedByProxy(t *testing.T) {
+	api := &apiRuntime{meta: &metapb.API{
+		ResponseBody: &metapb.ResponseBodyPolicy{
+			MaxBytes: 1024 * 1024 * 4,
+			Truncate: true,
+		},
+	}}
+
+	max, truncate := api.maxResponseBody(1024 * 1024)
+	if !truncate || max != 1024*1024 {
+		t.Fatalf("expect read at most the limit of the proxy, but %d %v", max, truncate)
+	}
+
+	res := fasthttp.AcquireResponse()
+	defer fasthttp.ReleaseResponse(res)
+	res.SetBody(bytes.Repeat([]byte("a"), max))
+	if !api.limitResponseBody(res, 1024*1024) {
+		t.Fatalf("expect truncated, but rejected")
+	}
+	if len(res.Body()) != 1024*1024-1 {
+		t.Errorf("expect %d bytes, but %d", 1024*1024-1, len(res.Body()))
+	}
+	if string(res.Header.Peek(defaultTruncatedHeader)) != "true" {
+		t.Errorf("expect truncated header")
+	}
+}
@@ -0,0 +1,329 @@
+package proxy
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	"github.com/fagongzi/gateway/pkg/pb/metapb"
+	"github.com/fagongzi/gateway/pkg/store"
+	"github.com/fagongzi/gateway/pkg/util"
+	"github.com/fagongzi/log"
+)
+
+type resyncMeta interface {
+	Marshal() ([]byte, error)
+}
+
+// resyncKind is the values of a kind of the meta, keyed by the key of the watch events
+type resyncKind struct {
+	src    store.EvtSrc
+	keys   []string
+	values map[string]interface{}
+}
+
+func newResyncKind(src store.EvtSrc) *resyncKind {
+	return &resyncKind{
+		src:    src,
+		values: make(map[string]interface{}),
+	}
+}
+
+func (k *resyncKind) add(key string, value interface{}) {
+	if _, ok := k.values[key]; !ok {
+		k.keys = append(k.keys, key)
+	}
+	k.values[key] = value
+}
+
+// requestResync request a full resync of the meta, the resync is done by the watch event loop, so
+// it is serialized with the watched events. The request is ignored if a resync is pending
+func (r *dispatcher) requestResync() bool {
+	select {
+	case r.resyncC <- struct{}{}:
+		return true
+	default:
+		return false
+	}
+}
+
+// resync load all meta from the store, and apply the differences to this proxy as the watched
+// events, it is used to recover the proxy that missed the watched events. The dns servers are
+// not in the store, and the kill switch has the dedicated watcher, both are not resynced
+func (r *dispatcher) resync() {
+	log.Infof("resync meta started")
+
+	// the changes after the revision are watched
+	revision, writeAt, err := r.store.GetLastWrite()
+	if err != nil {
+		log.Errorf("resync meta failed, errors:\n%+v", err)
+		return
+	}
+
+	remotes, err := r.resyncRemotes()
+	if err != nil {
+		log.Errorf("resync meta failed, errors:\n%+v", err)
+		return
+	}
+	locals := r.resyncLocals()
+
+	// remove in the reversed order of the dependencies
+	removed := 0
+	for i := len(locals) - 1; i >= 0; i-- {
+		local, remote := locals[i], remotes[i]
+		for _, key := range local.keys {
+			if _, ok := remote.values[key]; !ok {
+				r.doEvent(&store.Evt{Src: local.src, Type: store.EventTypeDelete, Key: key, Value: local.values[key]})
+				removed++
+			}
+		}
+	}
+
+	added, updated := 0, 0
+	for i, remote := range remotes {
+		local := locals[i]
+		for _, key := range remote.keys {
+			value := remote.values[key]
+			if old, ok := local.values[key]; !ok {
+				r.doEvent(&store.Evt{Src: remote.src, Type: store.EventTypeNew, Key: key, Value: value})
+				added++
+			} else if resyncChanged(old, value) {
+				r.doEvent(&store.Evt{Src: remote.src, Type: store.EventTypeUpdate, Key: key, Value: value})
+				updated++
+			}
+		}
+	}
+
+	if revision > 0 {
+		r.propagation.applied(revision, writeAt, time.Now())
+	}
+
+	log.Infof("resync meta completed at revision %d, %d added, %d updated, %d removed",
+		revision,
+		added,
+		updated,
+		removed)
+}
+
+// resyncLocals returns the meta of this proxy, in the same order of the resyncRemotes
+func (r *dispatcher) resyncLocals() []*resyncKind {
+	r.RLock()
+	defer r.RUnlock()
+
+	proxies := newResyncKind(store.EventSrcProxy)
+	for key, value := range r.proxies {
+		proxies.add(key, value)
+	}
+
+	clusters := newResyncKind(store.EventSrcCluster)
+	for id, value := range r.clusters {
+		clusters.add(resyncKey(id), value.meta)
+	}
+
+	servers := newResyncKind(store.EventSrcServer)
+	binds := newResyncKind(store.EventSrcBind)
+	for id, value := range r.servers {
+		if id&dnsServerIDMask != 0 {
+			continue
+		}
+
+		servers.add(resyncKey(id), value.meta)
+		for clusterID := range r.binds[id] {
+			binds.add(resyncBindKey(clusterID, id), &metapb.Bind{ClusterID: clusterID, ServerID: id})
+		}
+	}
+
+	tpls := newResyncKind(store.EventSrcAPITemplate)
+	for id, value := range r.templates {
+		tpls.add(resyncKey(id), value)
+	}
+
+	apis := newResyncKind(store.EventSrcAPI)
+	for id, value := range r.apis {
+		apis.add(resyncKey(id), value.origin)
+	}
+
+	routings := newResyncKind(store.EventSrcRouting)
+	for id, value := range r.routings {
+		routings.add(resyncKey(id), value.meta)
+	}
+
+	overrides := newResyncKind(store.EventSrcProxyOverride)
+	for id, value := range r.overrides {
+		overrides.add(resyncKey(id), value)
+	}
+
+	consumers := newResyncKind(store.EventSrcConsumer)
+	for id, value := range r.consumers {
+		consumers.add(resyncKey(id), value.meta)
+	}
+
+	policies := newResyncKind(store.EventSrcPolicy)
+	if r.policy != nil {
+		policies.add(policyKey, r.policy)
+	}
+
+	limits := newResyncKind(store.EventSrcRateLimit)
+	for id, value := range r.rateLimits {
+		limits.add(resyncKey(id), value.meta)
+	}
+
+	protos := newResyncKind(store.EventSrcProtoDescriptor)
+	for id, value := range r.protos {
+		protos.add(resyncKey(id), value.meta)
+	}
+
+	return []*resyncKind{proxies, clusters, servers, binds, tpls, protos, apis, routings, overrides, consumers, policies, limits}
+}
+
+// resyncRemotes returns the meta in the store, in the order of the dependencies
+func (r *dispatcher) resyncRemotes() ([]*resyncKind, error) {
+	proxies := newResyncKind(store.EventSrcProxy)
+	err := r.store.GetProxies(limit, func(value *metapb.Proxy) error {
+		proxies.add(util.GetAddrFormat(value.Addr), value)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	clusters := newResyncKind(store.EventSrcCluster)
+	err = r.store.GetClusters(limit, func(value interface{}) error {
+		clusters.add(resyncKey(value.(*metapb.Cluster).ID), value)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	servers := newResyncKind(store.EventSrcServer)
+	err = r.store.GetServers(limit, func(value interface{}) error {
+		servers.add(resyncKey(value.(*metapb.Server).ID), value)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	binds := newResyncKind(store.EventSrcBind)
+	for _, key := range clusters.keys {
+		clusterID := clusters.values[key].(*metapb.Cluster).ID
+		ids, err := r.store.GetBindServers(clusterID)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, id := range ids {
+			binds.add(resyncBindKey(clusterID, id), &metapb.Bind{ClusterID: clusterID, ServerID: id})
+		}
+	}
+
+	tpls := newResyncKind(store.EventSrcAPITemplate)
+	err = r.store.GetAPITemplates(limit, func(value interface{}) error {
+		tpls.add(resyncKey(value.(*metapb.APITemplate).ID), value)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	apis := newResyncKind(store.EventSrcAPI)
+	err = r.store.GetAPIs(limit, func(value interface{}) error {
+		if api := value.(*metapb.API); r.servesAPI(api) {
+			apis.add(resyncKey(api.ID), api)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	routings := newResyncKind(store.EventSrcRouting)
+	err = r.store.GetRoutings(limit, func(value interface{}) error {
+		routings.add(resyncKey(value.(*metapb.Routing).ID), value)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	overrides := newResyncKind(store.EventSrcProxyOverride)
+	err = r.store.GetProxyOverrides(limit, func(value interface{}) error {
+		overrides.add(resyncKey(value.(*metapb.ProxyOverride).ID), value)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	consumers := newResyncKind(store.EventSrcConsumer)
+	err = r.store.GetConsumers(limit, func(value interface{}) error {
+		consumers.add(resyncKey(value.(*metapb.Consumer).ID), value)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	policies := newResyncKind(store.EventSrcPolicy)
+	policy, err := r.store.GetPolicy()
+	if err != nil {
+		return nil, err
+	}
+	if policy != nil {
+		policies.add(policyKey, policy)
+	}
+
+	limits := newResyncKind(store.EventSrcRateLimit)
+	err = r.store.GetRateLimits(limit, func(value interface{}) error {
+		limits.add(resyncKey(value.(*metapb.RateLimit).ID), value)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	protos := newResyncKind(store.EventSrcProtoDescriptor)
+	err = r.store.GetProtoDescriptors(limit, func(value interface{}) error {
+		protos.add(resyncKey(value.(*metapb.ProtoDescriptor).ID), value)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return []*resyncKind{proxies, clusters, servers, binds, tpls, protos, apis, routings, overrides, consumers, policies, limits}, nil
+}
+
+// resyncChanged returns true if the values are different, the binds and the proxies are never
+// updated
+func resyncChanged(old, value interface{}) bool {
+	switch value.(type) {
+	case *metapb.Bind, *metapb.Proxy:
+		return false
+	}
+
+	oldMeta, ok := old.(resyncMeta)
+	if !ok {
+		return true
+	}
+
+	oldData, err := oldMeta.Marshal()
+	if err != nil {
+		return true
+	}
+	data, err := value.(resyncMeta).Marshal()
+	if err != nil {
+		return true
+	}
+
+	return !bytes.Equal(oldData, data)
+}
+
+func resyncKey(id uint64) string {
+	return fmt.Sprintf("%d", id)
+}
+
+func resyncBindKey(clusterID, serverID uint64) string {
+	return fmt.Sprintf("%d/%d", clusterID, serverID)
+}
@@ -0,0 +1,129 @@
+package proxy
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/fagongzi/gateway/pkg/pb/metapb"
+	"github.com/valyala/fasthttp"
+)
+
+const (
+	routeTestTag = "route-test"
+)
+
+// routeTest dispatch the sample request as a real request, the request is checked by the kill switch and
+// the limits of the api before forwarding, but it's never sent to the servers
+func (p *Proxy) routeTest(value *metapb.RouteTest) *metapb.RouteTestResult {
+	result := &metapb.RouteTestResult{
+		Proxy: p.cfg.Addr,
+	}
+	for _, f := range p.filters {
+		result.Filters = append(result.Filters, f.Name())
+	}
+
+	ctx := newRouteTestCtx(value)
+	api, dispatches := p.dispatcher.dispatch(ctx, routeTestTag)
+	defer func() {
+		for _, dn := range dispatches {
+			releaseDispathNode(dn)
+		}
+		p.dispatcher.dispatchCompleted()
+	}()
+
+	if api != nil {
+		result.API = api.meta.ID
+		result.APIName = api.meta.Name
+		result.UseDefault = api.meta.UseDefault
+	}
+
+	if !p.dispatcher.allowIP(GetRealClientIP(ctx)) {
+		result.Code = fasthttp.StatusForbidden
+		result.Reason = "denied by the ip access control"
+		return result
+	}
+
+	if ks := p.dispatcher.getKillSwitch(); ks != nil && ks.killed(api, ctx) {
+		ks.write(ctx, api, p.errorPages)
+		result.Code = int32(ctx.Response.StatusCode())
+		result.Reason = fmt.Sprintf("killed by the kill switch, reason %s", ks.meta.Reason)
+		return result
+	}
+
+	if len(dispatches) == 0 &&
+		(nil == api || (api.meta.DefaultValue == nil && api.graphQL == nil)) {
+		result.Code = fasthttp.StatusNotFound
+		result.Reason = "not match"
+		return result
+	}
+
+	if api.isSunset(time.Now().Unix()) {
+		result.Code = fasthttp.StatusGone
+		result.Reason = "api is sunset"
+		return result
+	}
+
+	if api.exceedRequestHeaders(&ctx.Request.Header) {
+		result.Code = fasthttp.StatusRequestHeaderFieldsTooLarge
+		result.Reason = "request headers too large"
+		return result
+	}
+
+	for _, dn := range dispatches {
+		node := metapb.RouteTestNode{
+			ClusterID: dn.node.meta.ClusterID,
+		}
+		if dn.cluster != nil {
+			node.ClusterID = dn.cluster.ID
+			node.ClusterName = dn.cluster.Name
+		}
+		if dn.dest != nil {
+			node.ServerID = dn.dest.meta.ID
+			node.ServerAddr = dn.dest.meta.Addr
+		}
+		if dn.routing != nil {
+			node.Routing = dn.routing.meta.ID
+			node.RoutingName = dn.routing.meta.Name
+		}
+		if dn.copyTo != nil {
+			node.CopyTo = dn.copyTo.meta.Addr
+		}
+
+		result.Nodes = append(result.Nodes, node)
+	}
+
+	return result
+}
+
+func newRouteTestCtx(value *metapb.RouteTest) *fasthttp.RequestCtx {
+	req := &fasthttp.Request{}
+	if value.Method != "" {
+		req.Header.SetMethod(value.Method)
+	} else {
+		req.Header.SetMethod(http.MethodGet)
+	}
+	req.SetRequestURI(value.Path)
+	if value.Host != "" {
+		req.Header.SetHost(value.Host)
+	}
+	for _, h := range value.Headers {
+		req.Header.Add(h.Name, h.Value)
+	}
+	if value.Body != "" {
+		req.SetBodyString(value.Body)
+	}
+
+	ip := net.ParseIP(value.ClientIP)
+	if ip == nil {
+		ip = net.IPv4(127, 0, 0, 1)
+	}
+
+	ctx := &fasthttp.RequestCtx{}
+	ctx.Init(req, &net.TCPAddr{IP: ip}, nil)
+	if value.Internal {
+		ctx.SetUserValue(internalListenerKey, true)
+	}
+	return ctx
+}
@@ -0,0 +1,95 @@
+package proxy
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	pbutil "github.com/fagongzi/gateway/pkg/pb"
+	"github.com/fagongzi/gateway/pkg/pb/metapb"
+	"github.com/valyala/fasthttp"
+)
+
+func parseSecurityHeaders(file string) (*metapb.SecurityHeaders, error) {
+	if file == "" {
+		return nil, nil
+	}
+
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	value := &metapb.SecurityHeaders{}
+	err = json.Unmarshal(data, value)
+	if err != nil {
+		return nil, err
+	}
+
+	err = pbutil.ValidateSecurityHeaders(value)
+	if err != nil {
+		return nil, err
+	}
+
+	return value, nil
+}
+
+// setSecurityHeaders set the security headers of the proxy policy and the api policy to the response,
+// the fields of the api policy override the proxy policy, and the removed headers of both are removed
+func (p *Proxy) setSecurityHeaders(ctx *fasthttp.RequestCtx, api *apiRuntime) {
+	var apiPolicy *metapb.SecurityHeaders
+	if api != nil {
+		apiPolicy = api.meta.SecurityHeaders
+	}
+
+	if apiPolicy != nil && apiPolicy.Disabled {
+		return
+	}
+
+	setSecurityHeaders(ctx, api, p.securityHeaders)
+	setSecurityHeaders(ctx, api, apiPolicy)
+}
+
+func setSecurityHeaders(ctx *fasthttp.RequestCtx, api *apiRuntime, policy *metapb.SecurityHeaders) {
+	if policy == nil {
+		return
+	}
+
+	header := &ctx.Response.Header
+	for _, name := range policy.RemoveHeaders {
+		header.Del(name)
+	}
+
+	if policy.Server != "" {
+		header.SetServer(policy.Server)
+	}
+
+	if policy.HSTSMaxAge > 0 {
+		value := fmt.Sprintf("max-age=%d", policy.HSTSMaxAge)
+		if policy.HSTSIncludeSubDomains {
+			value += "; includeSubDomains"
+		}
+		if policy.HSTSPreload {
+			value += "; preload"
+		}
+		header.Set("Strict-Transport-Security", value)
+	}
+
+	if policy.NoSniff {
+		header.Set("X-Content-Type-Options", "nosniff")
+	}
+
+	if policy.FrameOptions != "" {
+		header.Set("X-Frame-Options", strings.ToUpper(policy.FrameOptions))
+	}
+
+	if policy.ContentSecurityPolicy != "" {
+		header.Set("Content-Security-Policy",
+			applyTemplate(policy.ContentSecurityPolicy, api, &ctx.Request))
+	}
+
+	if policy.ReferrerPolicy != "" {
+		header.Set("Referrer-Policy", policy.ReferrerPolicy)
+	}
+}
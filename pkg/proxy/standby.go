@@ -0,0 +1,99 @@
+package proxy
+
+import (
+	"sort"
+	"time"
+
+	"github.com/fagongzi/gateway/pkg/pb/metapb"
+	"github.com/fagongzi/log"
+	"github.com/fagongzi/util/collection"
+)
+
+const (
+	// the max standby events that kept in each cluster
+	maxStandbyEvents = 32
+)
+
+func (r *dispatcher) isStandbyServer(id uint64) bool {
+	svr, ok := r.servers[id]
+	return ok && svr.meta.Standby
+}
+
+// standbyEvents returns the standby events of all clusters on this proxy
+func (r *dispatcher) standbyEvents(proxy string) []*metapb.StandbyEvent {
+	r.RLock()
+	defer r.RUnlock()
+
+	var values []*metapb.StandbyEvent
+	for _, c := range r.clusters {
+		c.standbyLock.Lock()
+		for _, e := range c.events {
+			value := e
+			value.Proxy = proxy
+			values = append(values, &value)
+		}
+		c.standbyLock.Unlock()
+	}
+
+	return values
+}
+
+func (c *clusterRuntime) updateStandbys() {
+	c.standbyLock.Lock()
+	c.balanceStandbys()
+	c.standbyLock.Unlock()
+}
+
+// balanceStandbys promote the up standby servers until the active servers reach the min active,
+// and demote the promoted servers that no longer needed, the caller must hold the standby lock
+func (c *clusterRuntime) balanceStandbys() {
+	if len(c.standbys) == 0 {
+		return
+	}
+
+	promoted := 0
+	ids := make([]uint64, 0, len(c.standbys))
+	for id, ok := range c.standbys {
+		if ok {
+			promoted++
+		}
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		return ids[i] < ids[j]
+	})
+
+	active := c.svrs.Len() - promoted
+	need := int(c.meta.MinActive) - active
+	for _, id := range ids {
+		if promoted < need && !c.standbys[id] {
+			c.standbys[id] = true
+			c.svrs.PushBack(id)
+			promoted++
+			c.addStandbyEvent(id, true, active)
+			log.Infof("bind <%d,%d> standby promoted, active servers %d less than %d",
+				c.meta.ID, id, active, c.meta.MinActive)
+		} else if promoted > need && c.standbys[id] {
+			c.standbys[id] = false
+			collection.Remove(c.svrs, id)
+			promoted--
+			c.addStandbyEvent(id, false, active)
+			log.Infof("bind <%d,%d> standby demoted, active servers %d",
+				c.meta.ID, id, active)
+		}
+	}
+}
+
+func (c *clusterRuntime) addStandbyEvent(id uint64, promoted bool, active int) {
+	if len(c.events) >= maxStandbyEvents {
+		c.events = c.events[1:]
+	}
+
+	c.events = append(c.events, metapb.StandbyEvent{
+		ClusterID: c.meta.ID,
+		ServerID:  id,
+		Promoted:  promoted,
+		Active:    int32(active),
+		At:        time.Now().Unix(),
+	})
+}
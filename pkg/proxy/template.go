@@ -0,0 +1,46 @@
+package proxy
+
+import (
+	"bytes"
+
+	"github.com/fagongzi/gateway/pkg/util"
+	"github.com/fagongzi/log"
+	"github.com/valyala/fasthttp"
+)
+
+var (
+	templateActionLeft = []byte("{{")
+)
+
+// applyTemplate execute the text as template with the request and the constants of the api if
+// the text has the template actions, the text is returned if the template failed
+func applyTemplate(text string, api *apiRuntime, req *fasthttp.Request) string {
+	if !util.IsTemplate(text) {
+		return text
+	}
+
+	value, err := util.ExecuteTemplate(text, newTemplateData(api, req))
+	if err != nil {
+		log.Errorf("execute template failed, errors:\n%+v", err)
+		return text
+	}
+
+	return value
+}
+
+// applyTemplateBytes is the applyTemplate for the bytes
+func applyTemplateBytes(value []byte, api *apiRuntime, req *fasthttp.Request) []byte {
+	if !bytes.Contains(value, templateActionLeft) {
+		return value
+	}
+
+	return []byte(applyTemplate(string(value), api, req))
+}
+
+func newTemplateData(api *apiRuntime, req *fasthttp.Request) *util.TemplateData {
+	data := &util.TemplateData{Request: req}
+	if api != nil {
+		data.Constants = api.constants
+	}
+	return data
+}
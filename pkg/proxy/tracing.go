@@ -0,0 +1,404 @@
+package proxy
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	mrand "math/rand"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/fagongzi/log"
+	"github.com/valyala/fasthttp"
+)
+
+const (
+	b3TraceIDHeader      = "X-B3-TraceId"
+	b3SpanIDHeader       = "X-B3-SpanId"
+	b3ParentSpanIDHeader = "X-B3-ParentSpanId"
+	b3SampledHeader      = "X-B3-Sampled"
+
+	// the span kinds of otlp
+	spanKindInternal = 1
+	spanKindServer   = 2
+	spanKindClient   = 3
+
+	// the status codes of otlp
+	spanStatusError = 2
+
+	defaultTracingService  = "gateway-proxy"
+	tracingScope           = "github.com/fagongzi/gateway"
+	tracingExportInterval  = time.Second * 5
+	tracingExportTimeout   = time.Second * 10
+	maxTracingBatch        = 512
+	maxTracingQueue        = 4096
+	maxTracingErrorMessage = 256
+)
+
+// tracer export the spans of the requests to the otlp/http endpoint as json, e.g. the collector of
+// opentelemetry or jaeger(4318). The spans are queued and exported in batches, the spans are dropped
+// if the queue is full, so the requests are never blocked by the exporter
+type tracer struct {
+	endpoint string
+	service  string
+	sampling int
+	cli      *http.Client
+	spans    chan *span
+	dropped  int64
+}
+
+// span is an operation of the request, the methods of the nil span do nothing, so the callers
+// don't need to check whether the request is traced
+type span struct {
+	tracer   *tracer
+	traceID  [16]byte
+	spanID   [8]byte
+	parentID [8]byte
+	name     string
+	kind     int
+	start    time.Time
+	end      time.Time
+	attrs    []spanAttr
+	err      string
+}
+
+type spanAttr struct {
+	key   string
+	value interface{}
+}
+
+func newTracer(opt *Option) *tracer {
+	if opt.TracingEndpoint == "" {
+		return nil
+	}
+
+	service := opt.TracingService
+	if service == "" {
+		service = defaultTracingService
+	}
+
+	return &tracer{
+		endpoint: opt.TracingEndpoint,
+		service:  service,
+		sampling: opt.TracingSampling,
+		cli: &http.Client{
+			Timeout: tracingExportTimeout,
+		},
+		spans: make(chan *span, maxTracingQueue),
+	}
+}
+
+// start returns the server span of the client request, the span is the child of the traceparent or
+// the b3 headers of the request. The request that not sampled by the upstream or the sampling has no
+// span
+func (t *tracer) start(ctx *fasthttp.RequestCtx) *span {
+	if t == nil {
+		return nil
+	}
+
+	s := &span{
+		tracer: t,
+		name:   fmt.Sprintf("HTTP %s", ctx.Method()),
+		kind:   spanKindServer,
+		start:  time.Now(),
+	}
+
+	sampled, ok := extractTraceParent(&ctx.Request.Header, s)
+	if !ok {
+		sampled, ok = extractB3(&ctx.Request.Header, s)
+	}
+	if !ok {
+		rand.Read(s.traceID[:])
+		sampled = t.sampling <= 0 || t.sampling >= 100 || mrand.Intn(100) < t.sampling
+	}
+	if !sampled {
+		return nil
+	}
+
+	rand.Read(s.spanID[:])
+	s.setAttr("http.method", string(ctx.Method()))
+	s.setAttr("http.target", string(ctx.RequestURI()))
+	s.setAttr("net.peer.ip", GetRealClientIP(ctx))
+	return s
+}
+
+// extractTraceParent returns the sampled flag and true if the header has a valid traceparent, e.g.
+// 00-<trace-id>-<parent-id>-01
+func extractTraceParent(header *fasthttp.RequestHeader, s *span) (bool, bool) {
+	fields := strings.Split(string(header.Peek(traceParentHeader)), "-")
+	if len(fields) != 4 || len(fields[1]) != 32 || len(fields[2]) != 16 || len(fields[3]) != 2 {
+		return false, false
+	}
+
+	flags, err := hex.DecodeString(fields[3])
+	if err != nil || !decodeTraceID(fields[1], s.traceID[:]) || !decodeTraceID(fields[2], s.parentID[:]) {
+		return false, false
+	}
+
+	return flags[0]&0x01 == 0x01, true
+}
+
+// extractB3 returns the sampled flag and true if the header has the valid b3 headers, the trace id
+// of 64 bits is the lower bits of the trace id
+func extractB3(header *fasthttp.RequestHeader, s *span) (bool, bool) {
+	traceID := string(header.Peek(b3TraceIDHeader))
+	if len(traceID) == 16 {
+		traceID = strings.Repeat("0", 16) + traceID
+	}
+
+	if len(traceID) != 32 || !decodeTraceID(traceID, s.traceID[:]) ||
+		!decodeTraceID(string(header.Peek(b3SpanIDHeader)), s.parentID[:]) {
+		return false, false
+	}
+
+	sampled := string(header.Peek(b3SampledHeader))
+	return sampled != "0" && sampled != "false", true
+}
+
+// decodeTraceID decode the hex id to the value, the id of all zeros is invalid
+func decodeTraceID(id string, value []byte) bool {
+	if len(id) != hex.EncodedLen(len(value)) {
+		return false
+	}
+
+	_, err := hex.Decode(value, []byte(id))
+	return err == nil && !bytes.Equal(value, make([]byte, len(value)))
+}
+
+// child returns a child span of the span
+func (s *span) child(name string, kind int) *span {
+	if s == nil {
+		return nil
+	}
+
+	c := &span{
+		tracer:   s.tracer,
+		traceID:  s.traceID,
+		parentID: s.spanID,
+		name:     name,
+		kind:     kind,
+		start:    time.Now(),
+	}
+	rand.Read(c.spanID[:])
+	return c
+}
+
+// backend returns the client span of the request that sent to the server
+func (s *span) backend(addr string, attempt int32) *span {
+	if s == nil {
+		return nil
+	}
+
+	c := s.child(fmt.Sprintf("backend %s", addr), spanKindClient)
+	c.setAttr("net.peer.name", addr)
+	c.setAttr("gateway.attempt", int(attempt))
+	return c
+}
+
+// filter returns the internal span of the phase of the filter
+func (s *span) filter(name, phase string) *span {
+	if s == nil {
+		return nil
+	}
+
+	return s.child(fmt.Sprintf("filter %s %s", name, phase), spanKindInternal)
+}
+
+// setAPI name the server span by the matched api, it MUST be called before the dispatch completed
+func (s *span) setAPI(api *apiRuntime) {
+	if s == nil || api == nil {
+		return
+	}
+
+	s.name = fmt.Sprintf("%s %s", s.name, api.meta.Name)
+	s.setAttr("gateway.api", api.meta.Name)
+}
+
+func (s *span) setAttr(key string, value interface{}) {
+	if s != nil {
+		s.attrs = append(s.attrs, spanAttr{key: key, value: value})
+	}
+}
+
+func (s *span) setError(err error) {
+	if s != nil && err != nil {
+		s.err = err.Error()
+		if len(s.err) > maxTracingErrorMessage {
+			s.err = s.err[:maxTracingErrorMessage]
+		}
+	}
+}
+
+// setStatusCode set the status code of the response, the 5xx responses are errors
+func (s *span) setStatusCode(code int) {
+	if s == nil {
+		return
+	}
+
+	s.setAttr("http.status_code", code)
+	if code >= fasthttp.StatusInternalServerError && s.err == "" {
+		s.err = fmt.Sprintf("status code %d", code)
+	}
+}
+
+// inject set the traceparent and the b3 headers of the span to the request that sent to the backend
+func (s *span) inject(header *fasthttp.RequestHeader) {
+	if s == nil {
+		return
+	}
+
+	traceID := hex.EncodeToString(s.traceID[:])
+	spanID := hex.EncodeToString(s.spanID[:])
+	header.Set(traceParentHeader, fmt.Sprintf("00-%s-%s-01", traceID, spanID))
+	header.Set(b3TraceIDHeader, traceID)
+	header.Set(b3SpanIDHeader, spanID)
+	header.Set(b3ParentSpanIDHeader, hex.EncodeToString(s.parentID[:]))
+	header.Set(b3SampledHeader, "1")
+}
+
+// finish end the span and queue it to export
+func (s *span) finish() {
+	if s == nil {
+		return
+	}
+
+	s.end = time.Now()
+	select {
+	case s.tracer.spans <- s:
+	default:
+		atomic.AddInt64(&s.tracer.dropped, 1)
+	}
+}
+
+func (p *Proxy) readyToExportTraces() {
+	if p.tracer == nil {
+		return
+	}
+
+	p.runner.RunCancelableTask(p.tracer.run)
+}
+
+// run export the queued spans every interval or when the batch is full, until the ctx is done
+func (t *tracer) run(ctx context.Context) {
+	ticker := time.NewTicker(tracingExportInterval)
+	defer ticker.Stop()
+
+	var batch []*span
+	for {
+		select {
+		case <-ctx.Done():
+			t.export(batch)
+			return
+		case s := <-t.spans:
+			batch = append(batch, s)
+			if len(batch) >= maxTracingBatch {
+				t.export(batch)
+				batch = nil
+			}
+		case <-ticker.C:
+			t.export(batch)
+			batch = nil
+		}
+	}
+}
+
+func (t *tracer) export(spans []*span) {
+	if len(spans) == 0 {
+		return
+	}
+
+	data, err := json.Marshal(t.encode(spans))
+	if err != nil {
+		log.Errorf("tracing: encode %d spans failed, errors:\n%+v", len(spans), err)
+		return
+	}
+
+	rsp, err := t.cli.Post(t.endpoint, "application/json", bytes.NewReader(data))
+	if err != nil {
+		log.Errorf("tracing: export %d spans to %s failed, errors:\n%+v", len(spans), t.endpoint, err)
+		return
+	}
+	defer rsp.Body.Close()
+
+	if rsp.StatusCode != http.StatusOK {
+		body, _ := ioutil.ReadAll(io.LimitReader(rsp.Body, maxTracingErrorMessage))
+		log.Errorf("tracing: export %d spans to %s failed with %d: %s", len(spans), t.endpoint,
+			rsp.StatusCode, strings.TrimSpace(string(body)))
+	}
+
+	if dropped := atomic.SwapInt64(&t.dropped, 0); dropped > 0 {
+		log.Warnf("tracing: %d spans dropped because the queue is full", dropped)
+	}
+}
+
+// encode returns the spans as the ExportTraceServiceRequest of otlp in json
+func (t *tracer) encode(spans []*span) interface{} {
+	values := make([]interface{}, 0, len(spans))
+	for _, s := range spans {
+		value := map[string]interface{}{
+			"traceId":           hex.EncodeToString(s.traceID[:]),
+			"spanId":            hex.EncodeToString(s.spanID[:]),
+			"name":              s.name,
+			"kind":              s.kind,
+			"startTimeUnixNano": strconv.FormatInt(s.start.UnixNano(), 10),
+			"endTimeUnixNano":   strconv.FormatInt(s.end.UnixNano(), 10),
+			"attributes":        encodeSpanAttrs(s.attrs),
+		}
+		if s.parentID != [8]byte{} {
+			value["parentSpanId"] = hex.EncodeToString(s.parentID[:])
+		}
+		if s.err != "" {
+			value["status"] = map[string]interface{}{
+				"code":    spanStatusError,
+				"message": s.err,
+			}
+		}
+		values = append(values, value)
+	}
+
+	return map[string]interface{}{
+		"resourceSpans": []interface{}{
+			map[string]interface{}{
+				"resource": map[string]interface{}{
+					"attributes": encodeSpanAttrs([]spanAttr{{key: "service.name", value: t.service}}),
+				},
+				"scopeSpans": []interface{}{
+					map[string]interface{}{
+						"scope": map[string]interface{}{"name": tracingScope},
+						"spans": values,
+					},
+				},
+			},
+		},
+	}
+}
+
+func encodeSpanAttrs(attrs []spanAttr) []interface{} {
+	values := make([]interface{}, 0, len(attrs))
+	for _, attr := range attrs {
+		var value map[string]interface{}
+		switch v := attr.value.(type) {
+		case int:
+			value = map[string]interface{}{"intValue": strconv.Itoa(v)}
+		case bool:
+			value = map[string]interface{}{"boolValue": v}
+		default:
+			value = map[string]interface{}{"stringValue": fmt.Sprintf("%v", v)}
+		}
+
+		values = append(values, map[string]interface{}{
+			"key":   attr.key,
+			"value": value,
+		})
+	}
+
+	return values
+}
@@ -0,0 +1,177 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/fagongzi/gateway/pkg/pb/metapb"
+	"github.com/fagongzi/gateway/pkg/pb/rpcpb"
+	"github.com/fagongzi/gateway/pkg/store"
+	"github.com/fagongzi/grpcx"
+	"github.com/fagongzi/log"
+	"github.com/labstack/echo"
+)
+
+var (
+	// apiApproval the apis are published after another operator approved, the put requests only write
+	// the draft apis, the other publish states are changed by the publish workflow
+	apiApproval = false
+
+	errPublishState = errors.New("invalid publish state")
+	errSelfApproval = errors.New("the api can not be approved by the submitter")
+)
+
+// SetAPIApproval require the approval of the api publishing, the api submitted by an operator must be
+// approved by another operator before it is published
+func SetAPIApproval(value bool) {
+	apiApproval = value
+}
+
+type publishOP struct {
+	id       uint64
+	operator string
+}
+
+func publishOPFactory(ctx echo.Context) (interface{}, error) {
+	id, err := idParamFactory(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	operator := ctx.QueryParam("operator")
+	if operator == "" {
+		return nil, fmt.Errorf("missing operator query value")
+	}
+
+	return &publishOP{id: id.(uint64), operator: operator}, nil
+}
+
+// checkPutAPI check the publish state of the put api, the approval is maintained by the workflow, so
+// the approval of the stored api is kept
+func checkPutAPI(db store.Store, value *metapb.API) error {
+	value.Approval = nil
+	if value.ID > 0 {
+		old, err := db.GetAPI(value.ID)
+		if err != nil && !errors.Is(err, store.ErrNotFound) {
+			return err
+		}
+
+		if err == nil {
+			value.Approval = old.Approval
+		}
+	}
+
+	if apiApproval && value.PublishState != metapb.Draft {
+		return fmt.Errorf("%w: %s, only the draft apis can be put with the approval",
+			errPublishState,
+			value.PublishState.String())
+	}
+
+	return checkGRPCTranscodings(db, value)
+}
+
+func checkBatchAPIs(db store.Store, batch *rpcpb.BatchReq) error {
+	for _, req := range batch.PutAPIs {
+		err := checkPutAPI(db, &req.API)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// changePublishState change the publish state of the api from the state to the target state
+func changePublishState(id uint64, from, to metapb.PublishState, change func(*metapb.API) error) error {
+	api, err := Store.GetAPI(id)
+	if err != nil {
+		return err
+	}
+
+	if api.PublishState != from {
+		return fmt.Errorf("%w: %s, expect %s",
+			errPublishState,
+			api.PublishState.String(),
+			from.String())
+	}
+
+	if change != nil {
+		err = change(api)
+		if err != nil {
+			return err
+		}
+	}
+
+	api.PublishState = to
+	_, err = Store.PutAPI(api)
+	return err
+}
+
+// submitAPIHandler submit the draft api to review
+func submitAPIHandler(value interface{}) (*grpcx.JSONResult, error) {
+	op := value.(*publishOP)
+	err := changePublishState(op.id, metapb.Draft, metapb.Review, func(api *metapb.API) error {
+		api.Approval = &metapb.Approval{
+			Submitter:   op.operator,
+			SubmittedAt: time.Now().Unix(),
+		}
+		return nil
+	})
+	if err != nil {
+		log.Errorf("api-api-submit: req %+v, errors:%+v", op, err)
+		return nil, err
+	}
+
+	return &grpcx.JSONResult{}, nil
+}
+
+// approveAPIHandler publish the api in review, with the approval the operator must not be the submitter
+func approveAPIHandler(value interface{}) (*grpcx.JSONResult, error) {
+	op := value.(*publishOP)
+	err := changePublishState(op.id, metapb.Review, metapb.Published, func(api *metapb.API) error {
+		if api.Approval == nil {
+			api.Approval = &metapb.Approval{}
+		}
+
+		if apiApproval && api.Approval.Submitter == op.operator {
+			return errSelfApproval
+		}
+
+		api.Approval.Approver = op.operator
+		api.Approval.ApprovedAt = time.Now().Unix()
+		return nil
+	})
+	if err != nil {
+		log.Errorf("api-api-approve: req %+v, errors:%+v", op, err)
+		return nil, err
+	}
+
+	return &grpcx.JSONResult{}, nil
+}
+
+// rejectAPIHandler return the api in review to draft
+func rejectAPIHandler(value interface{}) (*grpcx.JSONResult, error) {
+	op := value.(*publishOP)
+	err := changePublishState(op.id, metapb.Review, metapb.Draft, nil)
+	if err != nil {
+		log.Errorf("api-api-reject: req %+v, errors:%+v", op, err)
+		return nil, err
+	}
+
+	log.Infof("api-api-reject: api %d rejected by %s", op.id, op.operator)
+	return &grpcx.JSONResult{}, nil
+}
+
+// deprecateAPIHandler deprecate the published api, the deprecated api is still reachable
+func deprecateAPIHandler(value interface{}) (*grpcx.JSONResult, error) {
+	op := value.(*publishOP)
+	err := changePublishState(op.id, metapb.Published, metapb.Deprecated, nil)
+	if err != nil {
+		log.Errorf("api-api-deprecate: req %+v, errors:%+v", op, err)
+		return nil, err
+	}
+
+	log.Infof("api-api-deprecate: api %d deprecated by %s", op.id, op.operator)
+	return &grpcx.JSONResult{}, nil
+}
@@ -0,0 +1,265 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/fagongzi/gateway/pkg/pb/metapb"
+	"github.com/fagongzi/log"
+	"github.com/fagongzi/util/format"
+	"github.com/labstack/echo"
+)
+
+const (
+	changesetHeader         = "X-Changeset"
+	changeDescriptionHeader = "X-Change-Description"
+	operatorHeader          = "X-Operator"
+
+	changesetCommittedEvent  = "changeset_committed"
+	changesetRolledBackEvent = "changeset_rolled_back"
+	changesetWebhookRetries  = 3
+)
+
+var (
+	// requireChangeDescription the admin mutations must carry the change description or the changeset
+	requireChangeDescription = false
+	changesetWebhook         = ""
+	// changesetLock serialize the updates of the changesets
+	changesetLock sync.Mutex
+	// recordLock serialize the recorded mutations, so the write window of a record only contains the
+	// writes of it's own mutation. The unrecorded mutations share the lock
+	recordLock sync.RWMutex
+
+	errChangesetState = errors.New("invalid changeset state")
+
+	// unrecordedRoutes are the admin mutations that change no meta or called by the programs,
+	// the change description is not required
+	unrecordedRoutes = map[string]bool{
+		apiVersion + "/route-test":        true,
+		apiVersion + "/proxies/resync":    true,
+		apiVersion + "/apis/:id/cache":    true,
+		apiVersion + "/system/backup":     true,
+		apiVersion + "/servers/heartbeat": true,
+		// the not found routes of the group
+		apiVersion:        true,
+		apiVersion + "/*": true,
+	}
+)
+
+// SetRequireChangeDescription require the X-Change-Description or the X-Changeset header on the admin
+// mutations, the mutations without the headers are rejected
+func SetRequireChangeDescription(value bool) {
+	requireChangeDescription = value
+}
+
+// SetChangesetWebhook set the webhook that notified when a changeset is committed or rolled back
+func SetChangesetWebhook(value string) {
+	changesetWebhook = value
+}
+
+// changesetNotification is the body of the changeset webhook
+type changesetNotification struct {
+	Event     string            `json:"event"`
+	Changeset *metapb.Changeset `json:"changeset"`
+}
+
+// recordChange records the admin mutation to the changeset of the X-Changeset header, the mutation with the
+// X-Change-Description header only is recorded to a new committed changeset. The mutations that changes
+// no meta are not recorded
+func recordChange(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		req := ctx.Request()
+		if req.Method == http.MethodGet || req.Method == http.MethodHead || req.Method == http.MethodOptions ||
+			unrecordedRoutes[ctx.Path()] || strings.HasPrefix(ctx.Path(), apiVersion+"/changesets") {
+			return next(ctx)
+		}
+
+		record := metapb.ChangeRecord{
+			Description: req.Header.Get(changeDescriptionHeader),
+			Operator:    changeOperator(ctx),
+			Method:      req.Method,
+			Path:        req.URL.RequestURI(),
+		}
+
+		var id uint64
+		if value := req.Header.Get(changesetHeader); value != "" {
+			v, err := format.ParseStrUInt64(value)
+			if err != nil {
+				return writeBadRequest(ctx, fmt.Errorf("error %s header: %s", changesetHeader, value))
+			}
+
+			changeset, err := Store.GetChangeset(v)
+			if err != nil {
+				return writeError(ctx, err)
+			}
+			if changeset.State != metapb.ChangesetOpen {
+				return writeError(ctx, fmt.Errorf("%w: %s, the changes are only recorded to the open changeset",
+					errChangesetState,
+					changeset.State.String()))
+			}
+			id = v
+		} else if record.Description == "" {
+			if requireChangeDescription {
+				return writeBadRequest(ctx, fmt.Errorf("missing %s or %s header",
+					changeDescriptionHeader,
+					changesetHeader))
+			}
+
+			recordLock.RLock()
+			defer recordLock.RUnlock()
+			return next(ctx)
+		}
+
+		from, to, err := recordWindow(ctx, next)
+		if err != nil || ctx.Response().Status != http.StatusOK {
+			return err
+		}
+		// no meta is changed
+		if to <= from {
+			return nil
+		}
+
+		record.From = from
+		record.To = to
+		record.At = time.Now().Unix()
+		err = addChangeRecord(id, record)
+		if err != nil {
+			log.Errorf("api-changeset-record: changeset %d, req %+v, errors:%+v", id, record, err)
+		}
+
+		return nil
+	}
+}
+
+// recordWindow calls the handler of the recorded mutation, and returns the revisions of the last meta
+// write before and after the handler, to is 0 if the last write can not be read after the handler
+func recordWindow(ctx echo.Context, next echo.HandlerFunc) (int64, int64, error) {
+	recordLock.Lock()
+	defer recordLock.Unlock()
+
+	from, err := lastWriteRevision()
+	if err != nil {
+		return 0, 0, writeError(ctx, err)
+	}
+
+	err = next(ctx)
+	if err != nil || ctx.Response().Status != http.StatusOK {
+		return 0, 0, err
+	}
+
+	to, _, err := Store.GetLastWrite()
+	if err != nil {
+		log.Errorf("api-changeset-record: req %s %s, errors:%+v",
+			ctx.Request().Method,
+			ctx.Request().URL.RequestURI(),
+			err)
+		return from, 0, nil
+	}
+
+	return from, to, nil
+}
+
+// changeOperator returns the operator of the X-Operator header or the operator query value
+func changeOperator(ctx echo.Context) string {
+	if value := ctx.Request().Header.Get(operatorHeader); value != "" {
+		return value
+	}
+
+	return ctx.QueryParam("operator")
+}
+
+// lastWriteRevision returns the revision of the last meta write, the current revision of the store if
+// nothing written
+func lastWriteRevision() (int64, error) {
+	revision, _, err := Store.GetLastWrite()
+	if err != nil {
+		return 0, err
+	}
+
+	if revision == 0 {
+		system, err := Store.System()
+		if err != nil {
+			return 0, err
+		}
+		revision = system.Revision
+	}
+
+	return revision, nil
+}
+
+// addChangeRecord append the record to the changeset, 0 means a new committed changeset with the record
+func addChangeRecord(id uint64, record metapb.ChangeRecord) error {
+	changesetLock.Lock()
+	defer changesetLock.Unlock()
+
+	if id == 0 {
+		value := &metapb.Changeset{
+			Name:        fmt.Sprintf("%s %s", record.Method, record.Path),
+			Description: record.Description,
+			Operator:    record.Operator,
+			State:       metapb.ChangesetCommitted,
+			CreatedAt:   record.At,
+			CommittedAt: record.At,
+			Records:     []metapb.ChangeRecord{record},
+		}
+		_, err := Store.PutChangeset(value)
+		if err != nil {
+			return err
+		}
+
+		notifyChangeset(changesetCommittedEvent, value)
+		return nil
+	}
+
+	value, err := Store.GetChangeset(id)
+	if err != nil {
+		return err
+	}
+
+	// the changeset is committed or rolled back during the mutation, the mutation is done, so record it anyway
+	if value.State != metapb.ChangesetOpen {
+		log.Warnf("api-changeset-record: changeset %d is %s, record %s %s",
+			id,
+			value.State.String(),
+			record.Method,
+			record.Path)
+	}
+
+	value.Records = append(value.Records, record)
+	_, err = Store.PutChangeset(value)
+	return err
+}
+
+// notifyChangeset notify the changeset webhook in background, the notification is retried if the
+// webhook failed
+func notifyChangeset(event string, value *metapb.Changeset) {
+	if changesetWebhook == "" {
+		return
+	}
+
+	webhook := changesetWebhook
+	notification := &changesetNotification{
+		Event:     event,
+		Changeset: value,
+	}
+	go func() {
+		var err error
+		for i := 0; i < changesetWebhookRetries; i++ {
+			err = postWebhook(webhook, notification)
+			if err == nil {
+				return
+			}
+
+			time.Sleep(time.Second * time.Duration(i+1))
+		}
+
+		log.Warnf("api-changeset-notify: changeset %d %s, errors:%+v",
+			value.ID,
+			event,
+			err)
+	}()
+}
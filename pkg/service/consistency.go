@@ -0,0 +1,327 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/fagongzi/gateway/pkg/pb/metapb"
+	"github.com/fagongzi/log"
+	"github.com/fagongzi/util/task"
+)
+
+const (
+	maxTrafficRate = 100
+
+	// the kinds of the findings, the same as the meta changes
+	findingBind     = "bind"
+	findingAPI      = "api"
+	findingRouting  = "routing"
+	findingOverride = "override"
+)
+
+// StartConsistencyChecker check the consistency of the config in the store every interval,
+// and log the findings
+func StartConsistencyChecker(runner *task.Runner, interval time.Duration) {
+	if interval <= 0 {
+		log.Info("consistency: checker disabled")
+		return
+	}
+
+	log.Info("consistency: checker started")
+	runner.RunCancelableTask(func(ctx context.Context) {
+		t := time.NewTicker(interval)
+		defer t.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				log.Info("stop: consistency checker stopped")
+				return
+			case <-t.C:
+				report, err := checkConsistency()
+				if err != nil {
+					log.Errorf("consistency: check failed, errors:\n%+v", err)
+					continue
+				}
+
+				for _, finding := range report.Findings {
+					log.Warnf("consistency: %s %s<%d, %s> %s, %s",
+						finding.Type.String(),
+						finding.Kind,
+						finding.ID,
+						finding.Name,
+						finding.Message,
+						finding.Suggestion)
+				}
+			}
+		}
+	})
+}
+
+// consistencyChecker is the snapshot of the meta in the store
+type consistencyChecker struct {
+	clusters  map[uint64]*metapb.Cluster
+	servers   map[uint64]*metapb.Server
+	binds     map[uint64][]uint64
+	apis      map[uint64]*metapb.API
+	routings  []*metapb.Routing
+	templates map[uint64]*metapb.APITemplate
+	overrides []*metapb.ProxyOverride
+	findings  []metapb.ConfigFinding
+
+	// the ids in the store order, so the findings are stable
+	clusterIDs []uint64
+	apiIDs     []uint64
+}
+
+// checkConsistency scan the store for the dangling references, the empty clusters that used by
+// the live apis and routings, and the overlapping routings that total traffic rate exceeds 100%
+func checkConsistency() (*metapb.ConsistencyReport, error) {
+	c, err := newConsistencyChecker()
+	if err != nil {
+		return nil, err
+	}
+
+	c.checkBinds()
+	c.checkAPIs()
+	c.checkRoutings()
+	c.checkOverrides()
+
+	return &metapb.ConsistencyReport{
+		CheckedAt: time.Now().Unix(),
+		Findings:  c.findings,
+	}, nil
+}
+
+func newConsistencyChecker() (*consistencyChecker, error) {
+	c := &consistencyChecker{
+		clusters:  make(map[uint64]*metapb.Cluster),
+		servers:   make(map[uint64]*metapb.Server),
+		binds:     make(map[uint64][]uint64),
+		apis:      make(map[uint64]*metapb.API),
+		templates: make(map[uint64]*metapb.APITemplate),
+	}
+
+	err := Store.GetClusters(limit, func(data interface{}) error {
+		v := data.(*metapb.Cluster)
+		c.clusters[v.ID] = v
+		c.clusterIDs = append(c.clusterIDs, v.ID)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = Store.GetServers(limit, func(data interface{}) error {
+		v := data.(*metapb.Server)
+		c.servers[v.ID] = v
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, id := range c.clusterIDs {
+		c.binds[id], err = Store.GetBindServers(id)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = Store.GetAPIs(limit, func(data interface{}) error {
+		v := data.(*metapb.API)
+		c.apis[v.ID] = v
+		c.apiIDs = append(c.apiIDs, v.ID)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = Store.GetRoutings(limit, func(data interface{}) error {
+		c.routings = append(c.routings, data.(*metapb.Routing))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = Store.GetAPITemplates(limit, func(data interface{}) error {
+		v := data.(*metapb.APITemplate)
+		c.templates[v.ID] = v
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = Store.GetProxyOverrides(limit, func(data interface{}) error {
+		c.overrides = append(c.overrides, data.(*metapb.ProxyOverride))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+func (c *consistencyChecker) add(findingType metapb.FindingType, kind string, id uint64, name, message, suggestion string) {
+	c.findings = append(c.findings, metapb.ConfigFinding{
+		Type:       findingType,
+		Kind:       kind,
+		ID:         id,
+		Name:       name,
+		Message:    message,
+		Suggestion: suggestion,
+	})
+}
+
+// availableServers returns the number of the bind servers of the cluster that not drained
+func (c *consistencyChecker) availableServers(id uint64) int {
+	n := 0
+	for _, sid := range c.binds[id] {
+		if svr, ok := c.servers[sid]; ok && !svr.Drained {
+			n++
+		}
+	}
+
+	return n
+}
+
+func (c *consistencyChecker) checkBinds() {
+	for _, id := range c.clusterIDs {
+		for _, sid := range c.binds[id] {
+			if _, ok := c.servers[sid]; !ok {
+				c.add(metapb.DanglingReference, findingBind, 0, fmt.Sprintf("%d/%d", id, sid),
+					fmt.Sprintf("cluster %d binds the missing server %d", id, sid),
+					"remove the bind")
+			}
+		}
+	}
+}
+
+// checkCluster check the cluster that used by the field of the live object
+func (c *consistencyChecker) checkCluster(kind string, id uint64, name, field string, cluster uint64, live bool) {
+	meta, ok := c.clusters[cluster]
+	if !ok {
+		c.add(metapb.DanglingReference, kind, id, name,
+			fmt.Sprintf("%s references the missing cluster %d", field, cluster),
+			fmt.Sprintf("create the cluster or change the %s", field))
+		return
+	}
+
+	// the servers of the dns cluster are resolved by the proxies
+	if live && meta.DNS == nil && c.availableServers(cluster) == 0 {
+		c.add(metapb.EmptyCluster, kind, id, name,
+			fmt.Sprintf("%s uses the cluster %d that has no available servers", field, cluster),
+			"bind the servers to the cluster or undrain the servers")
+	}
+}
+
+func (c *consistencyChecker) checkAPIs() {
+	for _, id := range c.apiIDs {
+		api := c.apis[id]
+		live := api.Status == metapb.Up
+
+		if api.Template > 0 {
+			if _, ok := c.templates[api.Template]; !ok {
+				c.add(metapb.DanglingReference, findingAPI, api.ID, api.Name,
+					fmt.Sprintf("template references the missing template %d", api.Template),
+					"create the template or change the template")
+			}
+		}
+
+		for idx, node := range api.Nodes {
+			c.checkCluster(findingAPI, api.ID, api.Name,
+				fmt.Sprintf("nodes[%d].clusterID", idx), node.ClusterID, live)
+		}
+
+		if api.GraphQL != nil {
+			for idx, resolver := range api.GraphQL.Resolvers {
+				c.checkCluster(findingAPI, api.ID, api.Name,
+					fmt.Sprintf("graphQL.resolvers[%d].clusterID", idx), resolver.ClusterID, live)
+			}
+		}
+	}
+}
+
+func (c *consistencyChecker) checkRoutings() {
+	// the up routings that have the same api and the same conditions overlap, the routings
+	// without api overlap with all the apis
+	groups := make(map[string][]*metapb.Routing)
+	var keys []string
+	for _, routing := range c.routings {
+		if routing.API > 0 {
+			if _, ok := c.apis[routing.API]; !ok {
+				c.add(metapb.DanglingReference, findingRouting, routing.ID, routing.Name,
+					fmt.Sprintf("api references the missing api %d", routing.API),
+					"remove the routing or change the api")
+			}
+		}
+
+		live := routing.Status == metapb.Up
+		c.checkCluster(findingRouting, routing.ID, routing.Name, "clusterID", routing.ClusterID, live)
+
+		if live {
+			key := routingGroupKey(routing.API, routing.Conditions)
+			if _, ok := groups[key]; !ok {
+				keys = append(keys, key)
+			}
+			groups[key] = append(groups[key], routing)
+		}
+	}
+
+	sort.Strings(keys)
+	for _, key := range keys {
+		routings := groups[key]
+		if routings[0].API > 0 {
+			routings = append(routings, groups[routingGroupKey(0, routings[0].Conditions)]...)
+		}
+
+		total := int32(0)
+		names := make([]string, 0, len(routings))
+		for _, routing := range routings {
+			total += routing.TrafficRate
+			names = append(names, fmt.Sprintf("%d", routing.ID))
+		}
+
+		if total > maxTrafficRate {
+			c.add(metapb.RoutingOverflow, findingRouting, routings[0].ID, routings[0].Name,
+				fmt.Sprintf("the overlapping routings [%s] total traffic rate is %d%%", strings.Join(names, ","), total),
+				"reduce the traffic rate of the routings, the later routings only receive the rest traffic")
+		}
+	}
+}
+
+func (c *consistencyChecker) checkOverrides() {
+	for _, override := range c.overrides {
+		var apis []uint64
+		apis = append(apis, override.EnabledAPIs...)
+		apis = append(apis, override.DisabledAPIs...)
+		for _, limit := range override.RateLimits {
+			apis = append(apis, limit.API)
+		}
+
+		for _, api := range apis {
+			if _, ok := c.apis[api]; !ok {
+				c.add(metapb.DanglingReference, findingOverride, override.ID, override.Name,
+					fmt.Sprintf("references the missing api %d", api),
+					"remove the api from the override")
+			}
+		}
+	}
+}
+
+func routingGroupKey(api uint64, conditions []metapb.Condition) string {
+	values := make([]string, 0, len(conditions))
+	for _, cond := range conditions {
+		values = append(values, cond.String())
+	}
+	sort.Strings(values)
+
+	return fmt.Sprintf("%020d/%s", api, strings.Join(values, "&"))
+}
@@ -1,9 +1,182 @@
 package service
 
 import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
 	"errors"
+	"net/http"
+
+	pbutil "github.com/fagongzi/gateway/pkg/pb"
+	"github.com/fagongzi/gateway/pkg/store"
+	"github.com/fagongzi/grpcx"
+	"github.com/labstack/echo"
 )
 
 var (
 	errRPCCancel = errors.New("rpc cancel")
 )
+
+// the stable codes of the errors, the clients should use the codes instead of the messages
+const (
+	codeBadRequest      = "BAD_REQUEST"
+	codeInvalidArgument = "INVALID_ARGUMENT"
+	codeUnauthorized    = "UNAUTHORIZED"
+	codeForbidden       = "FORBIDDEN"
+	codeNotFound        = "NOT_FOUND"
+	codeConflict        = "CONFLICT"
+	codeInternal        = "INTERNAL"
+	codeNotImplemented  = "NOT_IMPLEMENTED"
+
+	codeIdempotencyKeyInUse  = "IDEMPOTENCY_KEY_IN_USE"
+	codeIdempotencyKeyReused = "IDEMPOTENCY_KEY_REUSED"
+)
+
+var (
+	legacyErrors = false
+)
+
+// SetLegacyErrors returns the errors with the status code only and without the body, the behavior
+// of the old versions
+func SetLegacyErrors(value bool) {
+	legacyErrors = value
+}
+
+// errorResult is the response of the failed requests, code is the status code
+type errorResult struct {
+	Code  int       `json:"code"`
+	Error *apiError `json:"error"`
+}
+
+// apiError is the structured error, code is the stable error code, fields are the details of the
+// invalid fields, requestID is the X-Request-ID of the request, generated if missing
+type apiError struct {
+	Code      string        `json:"code"`
+	Message   string        `json:"message"`
+	Fields    []*fieldError `json:"fields,omitempty"`
+	RequestID string        `json:"requestID"`
+}
+
+type fieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// newJSONBodyHTTPHandle returns a http handle with JSON body, the errors are returned as the
+// structured errors
+func newJSONBodyHTTPHandle(factory func() interface{}, handler func(interface{}) (*grpcx.JSONResult, error)) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		value := factory()
+		err := grpcx.ReadJSONFromBody(ctx, value)
+		if err != nil {
+			return writeBadRequest(ctx, err)
+		}
+
+		result, err := handler(value)
+		if err != nil {
+			return writeError(ctx, err)
+		}
+
+		return ctx.JSON(http.StatusOK, result)
+	}
+}
+
+// newGetHTTPHandle returns a http handle with the params of the url, the errors are returned as
+// the structured errors
+func newGetHTTPHandle(factory func(echo.Context) (interface{}, error), handler func(interface{}) (*grpcx.JSONResult, error)) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		value, err := factory(ctx)
+		if err != nil {
+			return writeBadRequest(ctx, err)
+		}
+
+		result, err := handler(value)
+		if err != nil {
+			return writeError(ctx, err)
+		}
+
+		return ctx.JSON(http.StatusOK, result)
+	}
+}
+
+// writeBadRequest write the error of the unparsable body or params
+func writeBadRequest(ctx echo.Context, err error) error {
+	value := &apiError{
+		Code:    codeBadRequest,
+		Message: err.Error(),
+	}
+
+	if e, ok := err.(*json.UnmarshalTypeError); ok && e.Field != "" {
+		value.Fields = append(value.Fields, &fieldError{Field: e.Field, Message: err.Error()})
+	}
+
+	return writeAPIError(ctx, http.StatusBadRequest, value)
+}
+
+// writeError write the error of the handler, the status code and the error code are decided by
+// the error
+func writeError(ctx echo.Context, err error) error {
+	value := &apiError{
+		Code:    codeInternal,
+		Message: err.Error(),
+	}
+	status := http.StatusInternalServerError
+
+	switch e := err.(type) {
+	case *pbutil.ValidationError:
+		status = http.StatusBadRequest
+		value.Code = codeInvalidArgument
+		if e.Field != "" {
+			value.Fields = append(value.Fields, &fieldError{Field: e.Field, Message: e.Message})
+		}
+	default:
+		if errors.Is(err, store.ErrNotFound) {
+			status = http.StatusNotFound
+			value.Code = codeNotFound
+		} else if err == store.ErrHasBind || err == store.ErrTemplateInUse || err == store.ErrDescriptorInUse ||
+			err == store.ErrOwnerInUse ||
+			errors.Is(err, store.ErrStaleOP) ||
+			errors.Is(err, errPublishState) || errors.Is(err, errChangesetState) {
+			status = http.StatusConflict
+			value.Code = codeConflict
+		} else if err == errSelfApproval {
+			status = http.StatusForbidden
+			value.Code = codeForbidden
+		} else if errors.Is(err, store.ErrDiffNotSupported) {
+			status = http.StatusNotImplemented
+			value.Code = codeNotImplemented
+		}
+	}
+
+	return writeAPIError(ctx, status, value)
+}
+
+func writeUnauthorized(ctx echo.Context, message string) error {
+	return writeAPIError(ctx, http.StatusUnauthorized, &apiError{
+		Code:    codeUnauthorized,
+		Message: message,
+	})
+}
+
+func writeAPIError(ctx echo.Context, status int, value *apiError) error {
+	if legacyErrors {
+		return ctx.NoContent(status)
+	}
+
+	value.RequestID = requestID(ctx)
+	ctx.Response().Header().Set(echo.HeaderXRequestID, value.RequestID)
+	return ctx.JSON(status, &errorResult{
+		Code:  status,
+		Error: value,
+	})
+}
+
+func requestID(ctx echo.Context) string {
+	if value := ctx.Request().Header.Get(echo.HeaderXRequestID); value != "" {
+		return value
+	}
+
+	var value [8]byte
+	rand.Read(value[:])
+	return hex.EncodeToString(value[:])
+}
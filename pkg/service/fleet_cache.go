@@ -0,0 +1,151 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/fagongzi/log"
+	"github.com/fagongzi/util/task"
+)
+
+const (
+	// fleetCacheIdle the cached aggregations that not read in the duration are not refreshed and removed
+	fleetCacheIdle = time.Minute
+)
+
+var (
+	fleet = &fleetCache{
+		entries: make(map[string]*fleetEntry),
+	}
+)
+
+// fleetCache cache the aggregations of the data of all proxies, e.g. the analysis and the health of the
+// servers, so the dashboards that poll every second share a fan-out to the proxies in the ttl. The entries
+// that read recently are refreshed in background before they are expired, the readers never wait for the
+// proxies unless the entry is new or idle
+type fleetCache struct {
+	sync.Mutex
+
+	ttl     time.Duration
+	entries map[string]*fleetEntry
+}
+
+type fleetEntry struct {
+	// loading is held while loading, so the concurrent readers of a new entry load once
+	loading sync.Mutex
+
+	load       func() (interface{}, error)
+	value      interface{}
+	loadedAt   time.Time
+	accessedAt time.Time
+}
+
+// StartFleetCache cache the fleet aggregations in the ttl, and refresh the entries that read recently in
+// background, 0 means disable
+func StartFleetCache(runner *task.Runner, ttl time.Duration) {
+	if ttl <= 0 {
+		log.Info("fleet-cache: cache disabled")
+		return
+	}
+
+	fleet.Lock()
+	fleet.ttl = ttl
+	fleet.Unlock()
+
+	log.Info("fleet-cache: cache started")
+	runner.RunCancelableTask(func(ctx context.Context) {
+		t := time.NewTicker(ttl / 2)
+		defer t.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				log.Info("stop: fleet cache stopped")
+				return
+			case <-t.C:
+				fleet.refresh(time.Now())
+			}
+		}
+	})
+}
+
+// get returns the cached value of the key, the value is loaded if it's not cached or expired. The errors
+// are not cached, the values must not be modified by the callers
+func (c *fleetCache) get(key string, load func() (interface{}, error)) (interface{}, error) {
+	c.Lock()
+	if c.ttl <= 0 {
+		c.Unlock()
+		return load()
+	}
+
+	e, ok := c.entries[key]
+	if !ok {
+		e = &fleetEntry{load: load}
+		c.entries[key] = e
+	}
+	e.accessedAt = time.Now()
+	value, ok := c.cached(e)
+	c.Unlock()
+
+	if ok {
+		return value, nil
+	}
+
+	e.loading.Lock()
+	defer e.loading.Unlock()
+
+	// loaded by another reader
+	c.Lock()
+	value, ok = c.cached(e)
+	c.Unlock()
+	if ok {
+		return value, nil
+	}
+
+	value, err := load()
+	if err != nil {
+		return nil, err
+	}
+
+	c.Lock()
+	e.value, e.loadedAt = value, time.Now()
+	c.Unlock()
+	return value, nil
+}
+
+func (c *fleetCache) cached(e *fleetEntry) (interface{}, bool) {
+	if e.loadedAt.IsZero() || time.Since(e.loadedAt) >= c.ttl {
+		return nil, false
+	}
+
+	return e.value, true
+}
+
+// refresh reload the entries that older than the half of the ttl, so the entries that read recently are
+// never expired, the idle entries are removed
+func (c *fleetCache) refresh(now time.Time) {
+	var entries []*fleetEntry
+	c.Lock()
+	for key, e := range c.entries {
+		if now.Sub(e.accessedAt) >= fleetCacheIdle {
+			delete(c.entries, key)
+		} else if !e.loadedAt.IsZero() && now.Sub(e.loadedAt) >= c.ttl/2 {
+			entries = append(entries, e)
+		}
+	}
+	c.Unlock()
+
+	for _, e := range entries {
+		// the readers get the current value meanwhile
+		value, err := e.load()
+		if err != nil {
+			log.Warnf("fleet-cache: refresh failed, errors:%+v", err)
+			continue
+		}
+
+		c.Lock()
+		e.value, e.loadedAt = value, time.Now()
+		c.Unlock()
+	}
+}
@@ -3,6 +3,7 @@ package service
 import (
 	"fmt"
 
+	"github.com/fagongzi/gateway/pkg/pb/metapb"
 	"github.com/fagongzi/util/format"
 	"github.com/labstack/echo"
 )
@@ -13,19 +14,37 @@ const (
 
 // InitHTTPRouter init http router
 func InitHTTPRouter(server *echo.Echo, ui, uiPrefix string) {
-	versionGroup := server.Group(apiVersion)
+	versionGroup := server.Group(apiVersion, idempotent, recordChange)
 	initClusterRouter(versionGroup)
 	initServerRouter(versionGroup)
 	initBindRouter(versionGroup)
 	initRoutingRouter(versionGroup)
 	initAPIRouter(versionGroup)
+	initAPITemplateRouter(versionGroup)
+	initProxyOverrideRouter(versionGroup)
+	initKillSwitchRouter(versionGroup)
+	initPolicyRouter(versionGroup)
+	initIPAccessControlRouter(versionGroup)
+	initConsumerRouter(versionGroup)
+	initRateLimitRouter(versionGroup)
+	initProtoDescriptorRouter(versionGroup)
 	initSystemRouter(versionGroup)
+	initHealthRouter(versionGroup)
+	initDiffRouter(versionGroup)
+	initReportRouter(versionGroup)
+	initConsistencyRouter(versionGroup)
+	initAnalysisRouter(versionGroup)
+	initProxyRouter(versionGroup)
+	initRouteTestRouter(versionGroup)
+	initChangesetRouter(versionGroup)
+	initMetricRouter(server)
 	initStatic(server, ui, uiPrefix)
 }
 
 type limitQuery struct {
 	limit   int64
 	afterID uint64
+	tags    []*metapb.PairValue
 }
 
 func idParamFactory(ctx echo.Context) (interface{}, error) {
@@ -45,6 +64,7 @@ func idParamFactory(ctx echo.Context) (interface{}, error) {
 func limitQueryFactory(ctx echo.Context) (interface{}, error) {
 	query := &limitQuery{
 		limit: limit,
+		tags:  parseTags(ctx),
 	}
 
 	value := ctx.QueryParam("limit")
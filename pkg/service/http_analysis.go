@@ -0,0 +1,234 @@
+package service
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/fagongzi/gateway/pkg/pb/metapb"
+	"github.com/fagongzi/gateway/pkg/util"
+	"github.com/fagongzi/grpcx"
+	"github.com/fagongzi/log"
+	"github.com/fagongzi/util/format"
+	"github.com/labstack/echo"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+func initAnalysisRouter(server *echo.Group) {
+	server.GET("/analysis/heatmap",
+		newGetHTTPHandle(apiQueryFactory, getHeatmapHandler))
+	server.GET("/analysis",
+		newGetHTTPHandle(emptyParamFactory, getAnalysisHandler))
+	server.GET("/analysis/:id",
+		newGetHTTPHandle(idParamFactory, getServerAnalysisHandler))
+}
+
+// initMetricRouter export the metrics of the api server, and the analysis stats of all proxies
+// labeled by the proxy address
+func initMetricRouter(server *echo.Echo) {
+	prometheus.Register(util.NewAnalysisCollector([]string{"proxy"}, collectProxiesAnalysis))
+	server.GET("/metrics", echo.WrapHandler(util.NewMetricHandler(nil)))
+}
+
+// proxyAnalysisRecently is the recently points of the servers and the apis of a proxy
+type proxyAnalysisRecently struct {
+	Proxy   string                   `json:"proxy"`
+	Targets []*util.AnalysisRecently `json:"targets"`
+}
+
+// proxyAnalysisStats is the analysis stats of a proxy
+type proxyAnalysisStats struct {
+	proxy string
+	stats []*util.AnalysisStat
+}
+
+func collectProxiesAnalysis(fn func(*util.AnalysisStat, ...string)) {
+	values, err := fleet.get("analysis/stats", getFleetAnalysisStats)
+	if err != nil {
+		log.Errorf("api-analysis-metrics: errors:%+v", err)
+		return
+	}
+
+	for _, value := range values.([]*proxyAnalysisStats) {
+		for _, stat := range value.stats {
+			fn(stat, value.proxy)
+		}
+	}
+}
+
+// getFleetAnalysisStats returns the analysis stats of all proxies, the proxies that can not be accessed
+// are skipped
+func getFleetAnalysisStats() (interface{}, error) {
+	var values []*proxyAnalysisStats
+	err := getFromProxies("/analysis/stats", analysisStatsFactory, func(proxy *metapb.Proxy, data interface{}, err error) {
+		if err != nil {
+			log.Warnf("api-analysis-metrics: proxy %s skipped, errors:%+v", proxy.Addr, err)
+			return
+		}
+
+		values = append(values, &proxyAnalysisStats{
+			proxy: proxy.Addr,
+			stats: *data.(*[]*util.AnalysisStat),
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return values, nil
+}
+
+// getAnalysisHandler returns the recently points of all intervals of the servers and the apis of
+// all proxies
+func getAnalysisHandler(value interface{}) (*grpcx.JSONResult, error) {
+	values, err := fleet.get("analysis/recently", getFleetAnalysisRecently)
+	if err != nil {
+		log.Errorf("api-analysis-get: errors:%+v", err)
+		return nil, err
+	}
+
+	return &grpcx.JSONResult{Data: values}, nil
+}
+
+// getServerAnalysisHandler returns the recently points of all intervals of the server of all proxies
+func getServerAnalysisHandler(value interface{}) (*grpcx.JSONResult, error) {
+	svr, err := Store.GetServer(value.(uint64))
+	if err != nil {
+		log.Errorf("api-analysis-server-get: req %+v, errors:%+v", value, err)
+		return nil, err
+	}
+
+	values, err := fleet.get("analysis/recently", getFleetAnalysisRecently)
+	if err != nil {
+		log.Errorf("api-analysis-server-get: req %+v, errors:%+v", value, err)
+		return nil, err
+	}
+
+	var result []*proxyAnalysisRecently
+	for _, value := range values.([]*proxyAnalysisRecently) {
+		item := &proxyAnalysisRecently{Proxy: value.Proxy}
+		for _, target := range value.Targets {
+			if target.Kind == "server" && target.ID == svr.ID {
+				item.Targets = append(item.Targets, target)
+			}
+		}
+		result = append(result, item)
+	}
+
+	return &grpcx.JSONResult{Data: result}, nil
+}
+
+// getFleetAnalysisRecently returns the recently points of all proxies, the proxies that can not be
+// accessed are skipped
+func getFleetAnalysisRecently() (interface{}, error) {
+	var values []*proxyAnalysisRecently
+	err := getFromProxies("/analysis/recently", analysisRecentlyFactory, func(proxy *metapb.Proxy, data interface{}, err error) {
+		if err != nil {
+			log.Warnf("api-analysis-get: proxy %s skipped, errors:%+v", proxy.Addr, err)
+			return
+		}
+
+		values = append(values, &proxyAnalysisRecently{
+			Proxy:   proxy.Addr,
+			Targets: *data.(*[]*util.AnalysisRecently),
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(values, func(i, j int) bool {
+		return values[i].Proxy < values[j].Proxy
+	})
+	return values, nil
+}
+
+// getHeatmapHandler returns the latency heatmap of the api, the counts of the same interval of
+// all proxies are summed
+func getHeatmapHandler(value interface{}) (*grpcx.JSONResult, error) {
+	api, err := Store.GetAPI(value.(uint64))
+	if err != nil {
+		log.Errorf("api-analysis-heatmap: req %+v, errors:%+v", value, err)
+		return nil, err
+	}
+
+	heatmap, err := fleet.get(fmt.Sprintf("analysis/heatmap/%d", api.ID), func() (interface{}, error) {
+		return getFleetHeatmap(api)
+	})
+	if err != nil {
+		log.Errorf("api-analysis-heatmap: req %+v, errors:%+v", value, err)
+		return nil, err
+	}
+
+	return &grpcx.JSONResult{Data: heatmap}, nil
+}
+
+// getFleetHeatmap returns the latency heatmap of the api that summed from all proxies
+func getFleetHeatmap(api *metapb.API) (*metapb.LatencyHeatmap, error) {
+	heatmap := &metapb.LatencyHeatmap{
+		API: api.Name,
+	}
+	rows := make(map[int64][]uint64)
+	err := getFromProxies(fmt.Sprintf("/analysis/heatmap?api=%d", api.ID), heatmapFactory, func(proxy *metapb.Proxy, data interface{}, err error) {
+		if err != nil {
+			log.Warnf("api-analysis-heatmap: proxy %s skipped, errors:%+v", proxy.Addr, err)
+			return
+		}
+
+		value := data.(*metapb.LatencyHeatmap)
+		if heatmap.Buckets == nil {
+			heatmap.Interval = value.Interval
+			heatmap.Buckets = value.Buckets
+		} else if value.Interval != heatmap.Interval || len(value.Buckets) != len(heatmap.Buckets) {
+			log.Warnf("api-analysis-heatmap: proxy %s skipped, buckets mismatch", proxy.Addr)
+			return
+		}
+
+		for _, row := range value.Rows {
+			counts, ok := rows[row.Time]
+			if !ok {
+				counts = make([]uint64, len(heatmap.Buckets)+1)
+				rows[row.Time] = counts
+			}
+
+			for i := 0; i < len(row.Counts) && i < len(counts); i++ {
+				counts[i] += row.Counts[i]
+			}
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	heatmap.Rows = make([]metapb.HeatmapRow, 0, len(rows))
+	for t, counts := range rows {
+		heatmap.Rows = append(heatmap.Rows, metapb.HeatmapRow{Time: t, Counts: counts})
+	}
+	sort.Slice(heatmap.Rows, func(i, j int) bool {
+		return heatmap.Rows[i].Time < heatmap.Rows[j].Time
+	})
+
+	return heatmap, nil
+}
+
+func apiQueryFactory(ctx echo.Context) (interface{}, error) {
+	value := ctx.QueryParam("api")
+	if value == "" {
+		return nil, fmt.Errorf("missing api query value")
+	}
+
+	return format.ParseStrUInt64(value)
+}
+
+func heatmapFactory() interface{} {
+	return &metapb.LatencyHeatmap{}
+}
+
+func analysisStatsFactory() interface{} {
+	var values []*util.AnalysisStat
+	return &values
+}
+
+func analysisRecentlyFactory() interface{} {
+	var values []*util.AnalysisRecently
+	return &values
+}
@@ -1,24 +1,55 @@
 package service
 
 import (
+	"fmt"
+	"sort"
+
+	pbutil "github.com/fagongzi/gateway/pkg/pb"
 	"github.com/fagongzi/gateway/pkg/pb/metapb"
+	"github.com/fagongzi/gateway/pkg/pb/rpcpb"
 	"github.com/fagongzi/grpcx"
 	"github.com/fagongzi/log"
 	"github.com/labstack/echo"
 )
 
+type cacheInvalidation struct {
+	Proxy   string `json:"proxy"`
+	Removed int    `json:"removed"`
+	Error   string `json:"error,omitempty"`
+}
+
 func initAPIRouter(server *echo.Group) {
 	server.GET("/apis/:id",
-		grpcx.NewGetHTTPHandle(idParamFactory, getAPIHandler))
+		newGetHTTPHandle(idParamFactory, getAPIHandler))
+	server.GET("/apis/:id/resolved",
+		newGetHTTPHandle(idParamFactory, getResolvedAPIHandler))
 	server.DELETE("/apis/:id",
-		grpcx.NewGetHTTPHandle(idParamFactory, deleteAPIHandler))
+		newGetHTTPHandle(idParamFactory, deleteAPIHandler))
+	server.DELETE("/apis/:id/cache",
+		newGetHTTPHandle(idParamFactory, deleteAPICacheHandler))
 	server.PUT("/apis",
-		grpcx.NewJSONBodyHTTPHandle(putAPIFactory, postAPIHandler))
+		newJSONBodyHTTPHandle(putAPIFactory, postAPIHandler))
 	server.GET("/apis",
-		grpcx.NewGetHTTPHandle(limitQueryFactory, listAPIHandler))
+		newGetHTTPHandle(limitQueryFactory, listAPIHandler))
+	server.PUT("/apis/status",
+		newGetHTTPHandle(tagStatusFactory, putAPIsStatusHandler))
+	server.PUT("/apis/:id/submit",
+		newGetHTTPHandle(publishOPFactory, submitAPIHandler))
+	server.PUT("/apis/:id/approve",
+		newGetHTTPHandle(publishOPFactory, approveAPIHandler))
+	server.PUT("/apis/:id/reject",
+		newGetHTTPHandle(publishOPFactory, rejectAPIHandler))
+	server.PUT("/apis/:id/deprecate",
+		newGetHTTPHandle(publishOPFactory, deprecateAPIHandler))
 }
 
 func postAPIHandler(value interface{}) (*grpcx.JSONResult, error) {
+	err := checkPutAPI(Store, value.(*metapb.API))
+	if err != nil {
+		log.Errorf("api-api-put: req %+v, errors:%+v", value, err)
+		return nil, err
+	}
+
 	id, err := Store.PutAPI(value.(*metapb.API))
 	if err != nil {
 		log.Errorf("api-api-put: req %+v, errors:%+v", value, err)
@@ -38,6 +69,47 @@ func deleteAPIHandler(value interface{}) (*grpcx.JSONResult, error) {
 	return &grpcx.JSONResult{}, nil
 }
 
+// deleteAPICacheHandler removes the cached responses of the api on all proxies, returns the removed
+// count of every proxy
+func deleteAPICacheHandler(value interface{}) (*grpcx.JSONResult, error) {
+	api, err := Store.GetAPI(value.(uint64))
+	if err != nil {
+		log.Errorf("api-api-cache-delete: req %+v, errors:%+v", value, err)
+		return nil, err
+	}
+
+	var values []*cacheInvalidation
+	err = deleteFromProxies(fmt.Sprintf("/cache?api=%d", api.ID), cacheRemovedFactory, func(proxy *metapb.Proxy, data interface{}, err error) {
+		result := &cacheInvalidation{
+			Proxy: proxy.Addr,
+		}
+		values = append(values, result)
+
+		if err != nil {
+			log.Warnf("api-api-cache-delete: proxy %s failed, errors:%+v", proxy.Addr, err)
+			result.Error = err.Error()
+			return
+		}
+
+		result.Removed = *data.(*int)
+	})
+	if err != nil {
+		log.Errorf("api-api-cache-delete: req %+v, errors:%+v", value, err)
+		return nil, err
+	}
+
+	sort.Slice(values, func(i, j int) bool {
+		return values[i].Proxy < values[j].Proxy
+	})
+
+	return &grpcx.JSONResult{Data: values}, nil
+}
+
+func cacheRemovedFactory() interface{} {
+	var value int
+	return &value
+}
+
 func getAPIHandler(value interface{}) (*grpcx.JSONResult, error) {
 	value, err := Store.GetAPI(value.(uint64))
 	if err != nil {
@@ -48,13 +120,33 @@ func getAPIHandler(value interface{}) (*grpcx.JSONResult, error) {
 	return &grpcx.JSONResult{Data: value}, nil
 }
 
+func getResolvedAPIHandler(value interface{}) (*grpcx.JSONResult, error) {
+	api, err := Store.GetAPI(value.(uint64))
+	if err != nil {
+		log.Errorf("api-api-resolved-get: req %+v, errors:%+v", value, err)
+		return nil, err
+	}
+
+	tpl, clusters, global, err := getInheritedPolicies(api)
+	if err != nil {
+		log.Errorf("api-api-resolved-get: req %+v, errors:%+v", value, err)
+		return nil, err
+	}
+
+	if tpl != nil {
+		api = pbutil.MergeAPITemplate(api, tpl)
+	}
+
+	return &grpcx.JSONResult{Data: pbutil.MergePolicies(api, clusters, global)}, nil
+}
+
 func listAPIHandler(value interface{}) (*grpcx.JSONResult, error) {
 	query := value.(*limitQuery)
 	var values []*metapb.API
 
 	err := Store.GetAPIs(limit, func(data interface{}) error {
 		v := data.(*metapb.API)
-		if int64(len(values)) < query.limit && v.ID > query.afterID {
+		if int64(len(values)) < query.limit && v.ID > query.afterID && matchTags(v.Tags, query.tags) {
 			values = append(values, v)
 		}
 		return nil
@@ -67,6 +159,41 @@ func listAPIHandler(value interface{}) (*grpcx.JSONResult, error) {
 	return &grpcx.JSONResult{Data: values}, nil
 }
 
+// putAPIsStatusHandler change the status of all the apis that match the tags, returns the changed ids
+func putAPIsStatusHandler(value interface{}) (*grpcx.JSONResult, error) {
+	query := value.(*tagQuery)
+	var values []*metapb.API
+
+	err := Store.GetAPIs(limit, func(data interface{}) error {
+		v := data.(*metapb.API)
+		if matchTags(v.Tags, query.tags) && v.Status != query.status {
+			v.Status = query.status
+			values = append(values, v)
+		}
+		return nil
+	})
+	if err != nil {
+		log.Errorf("api-api-status-put: req %+v, errors:%+v", value, err)
+		return nil, err
+	}
+
+	ids := make([]uint64, 0, len(values))
+	err = batchEach(len(values), func(from, to int) *rpcpb.BatchReq {
+		batch := &rpcpb.BatchReq{}
+		for _, v := range values[from:to] {
+			batch.PutAPIs = append(batch.PutAPIs, &rpcpb.PutAPIReq{API: *v})
+			ids = append(ids, v.ID)
+		}
+		return batch
+	})
+	if err != nil {
+		log.Errorf("api-api-status-put: req %+v, errors:%+v", value, err)
+		return nil, err
+	}
+
+	return &grpcx.JSONResult{Data: ids}, nil
+}
+
 func putAPIFactory() interface{} {
 	return &metapb.API{}
 }
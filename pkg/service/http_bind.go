@@ -9,10 +9,10 @@ import (
 
 func initBindRouter(server *echo.Group) {
 	server.DELETE("/binds",
-		grpcx.NewJSONBodyHTTPHandle(bindFactory, deleteBindHandler))
+		newJSONBodyHTTPHandle(bindFactory, deleteBindHandler))
 
 	server.PUT("/binds",
-		grpcx.NewJSONBodyHTTPHandle(bindFactory, postBindHandler))
+		newJSONBodyHTTPHandle(bindFactory, postBindHandler))
 }
 
 func postBindHandler(value interface{}) (*grpcx.JSONResult, error) {
@@ -0,0 +1,206 @@
+package service
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/fagongzi/gateway/pkg/pb/metapb"
+	"github.com/fagongzi/grpcx"
+	"github.com/fagongzi/log"
+	"github.com/labstack/echo"
+)
+
+type rollbackOP struct {
+	id    uint64
+	force bool
+}
+
+func initChangesetRouter(server *echo.Group) {
+	server.GET("/changesets/:id",
+		newGetHTTPHandle(idParamFactory, getChangesetHandler))
+	server.GET("/changesets/:id/diff",
+		newGetHTTPHandle(idParamFactory, getChangesetDiffHandler))
+	server.PUT("/changesets/:id/commit",
+		newGetHTTPHandle(idParamFactory, commitChangesetHandler))
+	server.PUT("/changesets/:id/rollback",
+		newGetHTTPHandle(rollbackOPFactory, rollbackChangesetHandler))
+	server.PUT("/changesets",
+		newJSONBodyHTTPHandle(putChangesetFactory, postChangesetHandler))
+	server.GET("/changesets",
+		newGetHTTPHandle(limitQueryFactory, listChangesetHandler))
+}
+
+// postChangesetHandler open a new changeset, or update the name and the description of an open changeset,
+// the state and the records are maintained by the admin apis
+func postChangesetHandler(value interface{}) (*grpcx.JSONResult, error) {
+	changesetLock.Lock()
+	defer changesetLock.Unlock()
+
+	meta := value.(*metapb.Changeset)
+	if meta.ID == 0 {
+		meta.State = metapb.ChangesetOpen
+		meta.CreatedAt = time.Now().Unix()
+		meta.CommittedAt = 0
+		meta.RolledBackAt = 0
+		meta.RollbackRevision = 0
+		meta.Records = nil
+	} else {
+		old, err := Store.GetChangeset(meta.ID)
+		if err != nil {
+			log.Errorf("api-changeset-put: req %+v, errors:%+v", value, err)
+			return nil, err
+		}
+
+		if old.State != metapb.ChangesetOpen {
+			return nil, fmt.Errorf("%w: %s, only the open changeset can be updated",
+				errChangesetState,
+				old.State.String())
+		}
+
+		old.Name = meta.Name
+		old.Description = meta.Description
+		old.Operator = meta.Operator
+		meta = old
+	}
+
+	id, err := Store.PutChangeset(meta)
+	if err != nil {
+		log.Errorf("api-changeset-put: req %+v, errors:%+v", value, err)
+		return nil, err
+	}
+
+	return &grpcx.JSONResult{Data: id}, nil
+}
+
+func getChangesetHandler(value interface{}) (*grpcx.JSONResult, error) {
+	value, err := Store.GetChangeset(value.(uint64))
+	if err != nil {
+		log.Errorf("api-changeset-get: req %+v, errors:%+v", value, err)
+		return nil, err
+	}
+
+	return &grpcx.JSONResult{Data: value}, nil
+}
+
+// getChangesetDiffHandler returns the meta changes of the records of the changeset, one diff per record
+func getChangesetDiffHandler(value interface{}) (*grpcx.JSONResult, error) {
+	changeset, err := Store.GetChangeset(value.(uint64))
+	if err != nil {
+		log.Errorf("api-changeset-diff-get: req %+v, errors:%+v", value, err)
+		return nil, err
+	}
+
+	diffs := make([]*metapb.MetaDiff, 0, len(changeset.Records))
+	for _, record := range changeset.Records {
+		diff, err := Store.Diff(record.From, record.To)
+		if err != nil {
+			log.Errorf("api-changeset-diff-get: req %+v, errors:%+v", value, err)
+			return nil, err
+		}
+
+		diffs = append(diffs, diff)
+	}
+
+	return &grpcx.JSONResult{Data: diffs}, nil
+}
+
+func commitChangesetHandler(value interface{}) (*grpcx.JSONResult, error) {
+	changesetLock.Lock()
+	defer changesetLock.Unlock()
+
+	changeset, err := Store.GetChangeset(value.(uint64))
+	if err != nil {
+		log.Errorf("api-changeset-commit: req %+v, errors:%+v", value, err)
+		return nil, err
+	}
+
+	if changeset.State != metapb.ChangesetOpen {
+		return nil, fmt.Errorf("%w: %s, only the open changeset can be committed",
+			errChangesetState,
+			changeset.State.String())
+	}
+
+	changeset.State = metapb.ChangesetCommitted
+	changeset.CommittedAt = time.Now().Unix()
+	_, err = Store.PutChangeset(changeset)
+	if err != nil {
+		log.Errorf("api-changeset-commit: req %+v, errors:%+v", value, err)
+		return nil, err
+	}
+
+	notifyChangeset(changesetCommittedEvent, changeset)
+	return &grpcx.JSONResult{Data: changeset}, nil
+}
+
+// rollbackChangesetHandler revert the meta changes of the changeset in one txn, the rollback fails if the
+// meta is changed after the changeset unless force
+func rollbackChangesetHandler(value interface{}) (*grpcx.JSONResult, error) {
+	changesetLock.Lock()
+	defer changesetLock.Unlock()
+
+	op := value.(*rollbackOP)
+	changeset, err := Store.GetChangeset(op.id)
+	if err != nil {
+		log.Errorf("api-changeset-rollback: req %+v, errors:%+v", op, err)
+		return nil, err
+	}
+
+	if changeset.State == metapb.ChangesetRolledBack {
+		return nil, fmt.Errorf("%w: %s, the changeset is already rolled back",
+			errChangesetState,
+			changeset.State.String())
+	}
+
+	revision, err := Store.Revert(changeset.Records, op.force)
+	if err != nil {
+		log.Errorf("api-changeset-rollback: req %+v, errors:%+v", op, err)
+		return nil, err
+	}
+
+	changeset.State = metapb.ChangesetRolledBack
+	changeset.RolledBackAt = time.Now().Unix()
+	changeset.RollbackRevision = revision
+	_, err = Store.PutChangeset(changeset)
+	if err != nil {
+		log.Errorf("api-changeset-rollback: req %+v, errors:%+v", op, err)
+		return nil, err
+	}
+
+	notifyChangeset(changesetRolledBackEvent, changeset)
+	return &grpcx.JSONResult{Data: changeset}, nil
+}
+
+func putChangesetFactory() interface{} {
+	return &metapb.Changeset{}
+}
+
+func rollbackOPFactory(ctx echo.Context) (interface{}, error) {
+	id, err := idParamFactory(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &rollbackOP{
+		id:    id.(uint64),
+		force: ctx.QueryParam("force") == "true",
+	}, nil
+}
+
+func listChangesetHandler(value interface{}) (*grpcx.JSONResult, error) {
+	query := value.(*limitQuery)
+	var values []*metapb.Changeset
+
+	err := Store.GetChangesets(limit, func(data interface{}) error {
+		v := data.(*metapb.Changeset)
+		if int64(len(values)) < query.limit && v.ID > query.afterID {
+			values = append(values, v)
+		}
+		return nil
+	})
+	if err != nil {
+		log.Errorf("api-changeset-list-get: req %+v, errors:%+v", value, err)
+		return nil, err
+	}
+
+	return &grpcx.JSONResult{Data: values}, nil
+}
@@ -1,6 +1,8 @@
 package service
 
 import (
+	"sort"
+
 	"github.com/fagongzi/gateway/pkg/pb/metapb"
 	"github.com/fagongzi/grpcx"
 	"github.com/fagongzi/log"
@@ -9,17 +11,19 @@ import (
 
 func initClusterRouter(server *echo.Group) {
 	server.GET("/clusters/:id",
-		grpcx.NewGetHTTPHandle(idParamFactory, getClusterHandler))
+		newGetHTTPHandle(idParamFactory, getClusterHandler))
 	server.GET("/clusters/:id/binds",
-		grpcx.NewGetHTTPHandle(idParamFactory, bindsClusterHandler))
+		newGetHTTPHandle(idParamFactory, bindsClusterHandler))
+	server.GET("/clusters/:id/standby/events",
+		newGetHTTPHandle(idParamFactory, standbyEventsClusterHandler))
 	server.DELETE("/clusters/:id",
-		grpcx.NewGetHTTPHandle(idParamFactory, deleteClusterHandler))
+		newGetHTTPHandle(idParamFactory, deleteClusterHandler))
 	server.DELETE("/clusters/:id/binds",
-		grpcx.NewGetHTTPHandle(idParamFactory, deleteClusterBindsHandler))
+		newGetHTTPHandle(idParamFactory, deleteClusterBindsHandler))
 	server.PUT("/clusters",
-		grpcx.NewJSONBodyHTTPHandle(putClusterFactory, postClusterHandler))
+		newJSONBodyHTTPHandle(putClusterFactory, postClusterHandler))
 	server.GET("/clusters",
-		grpcx.NewGetHTTPHandle(limitQueryFactory, listClusterHandler))
+		newGetHTTPHandle(limitQueryFactory, listClusterHandler))
 }
 
 func postClusterHandler(value interface{}) (*grpcx.JSONResult, error) {
@@ -72,13 +76,46 @@ func bindsClusterHandler(value interface{}) (*grpcx.JSONResult, error) {
 	return &grpcx.JSONResult{Data: values}, nil
 }
 
+// standbyEventsClusterHandler returns the standby events of the cluster on all proxies
+func standbyEventsClusterHandler(value interface{}) (*grpcx.JSONResult, error) {
+	id := value.(uint64)
+	if _, err := Store.GetCluster(id); err != nil {
+		log.Errorf("api-cluster-standby-events-get: req %+v, errors:%+v", value, err)
+		return nil, err
+	}
+
+	values := make([]*metapb.StandbyEvent, 0)
+	err := getFromProxies("/clusters/standby/events", standbyEventsFactory, func(proxy *metapb.Proxy, data interface{}, err error) {
+		if err != nil {
+			log.Warnf("api-cluster-standby-events-get: proxy %s skipped, errors:%+v", proxy.Addr, err)
+			return
+		}
+
+		for _, e := range *data.(*[]*metapb.StandbyEvent) {
+			if e.ClusterID == id {
+				values = append(values, e)
+			}
+		}
+	})
+	if err != nil {
+		log.Errorf("api-cluster-standby-events-get: req %+v, errors:%+v", value, err)
+		return nil, err
+	}
+
+	sort.Slice(values, func(i, j int) bool {
+		return values[i].At < values[j].At
+	})
+
+	return &grpcx.JSONResult{Data: values}, nil
+}
+
 func listClusterHandler(value interface{}) (*grpcx.JSONResult, error) {
 	query := value.(*limitQuery)
 	var values []*metapb.Cluster
 
 	err := Store.GetClusters(limit, func(data interface{}) error {
 		v := data.(*metapb.Cluster)
-		if int64(len(values)) < query.limit && v.ID > query.afterID {
+		if int64(len(values)) < query.limit && v.ID > query.afterID && matchTags(v.Tags, query.tags) {
 			values = append(values, v)
 		}
 		return nil
@@ -94,3 +131,8 @@ func listClusterHandler(value interface{}) (*grpcx.JSONResult, error) {
 func putClusterFactory() interface{} {
 	return &metapb.Cluster{}
 }
+
+func standbyEventsFactory() interface{} {
+	var values []*metapb.StandbyEvent
+	return &values
+}
@@ -0,0 +1,22 @@
+package service
+
+import (
+	"github.com/fagongzi/grpcx"
+	"github.com/fagongzi/log"
+	"github.com/labstack/echo"
+)
+
+func initConsistencyRouter(server *echo.Group) {
+	server.GET("/consistency",
+		newGetHTTPHandle(emptyParamFactory, getConsistencyHandler))
+}
+
+func getConsistencyHandler(value interface{}) (*grpcx.JSONResult, error) {
+	report, err := checkConsistency()
+	if err != nil {
+		log.Errorf("api-consistency-get: errors:%+v", err)
+		return nil, err
+	}
+
+	return &grpcx.JSONResult{Data: report}, nil
+}
@@ -0,0 +1,286 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/fagongzi/gateway/pkg/pb/metapb"
+	"github.com/fagongzi/gateway/pkg/store"
+	"github.com/fagongzi/grpcx"
+	"github.com/fagongzi/log"
+	"github.com/labstack/echo"
+)
+
+func initConsumerRouter(server *echo.Group) {
+	server.GET("/consumers/:id",
+		newGetHTTPHandle(idParamFactory, getConsumerHandler))
+	server.GET("/consumers/:id/usage",
+		newGetHTTPHandle(idParamFactory, getConsumerUsageHandler))
+	server.DELETE("/consumers/:id",
+		newGetHTTPHandle(idParamFactory, deleteConsumerHandler))
+	server.PUT("/consumers",
+		newJSONBodyHTTPHandle(putConsumerFactory, postConsumerHandler))
+	server.GET("/consumers",
+		newGetHTTPHandle(limitQueryFactory, listConsumerHandler))
+	server.PUT("/consumer-bindings",
+		newJSONBodyHTTPHandle(consumerBindingsFactory, bindConsumersHandler))
+	server.DELETE("/consumer-bindings",
+		newJSONBodyHTTPHandle(consumerBindingsFactory, unbindConsumersHandler))
+}
+
+// consumerBindings is the bulk bindings between the consumers and the apis, each consumer is bound to
+// or unbound from all apis
+type consumerBindings struct {
+	Consumers []uint64 `json:"consumers"`
+	APIs      []uint64 `json:"apis"`
+}
+
+// consumerUsage is the requests of the consumer in the day, the quota is applied on each proxy
+type consumerUsage struct {
+	Day      string           `json:"day"`
+	Quota    int64            `json:"quota"`
+	Requests int64            `json:"requests"`
+	Proxies  map[string]int64 `json:"proxies"`
+}
+
+func postConsumerHandler(value interface{}) (*grpcx.JSONResult, error) {
+	consumer := value.(*metapb.Consumer)
+
+	// the allowed apis must exist
+	err := checkAPIsExist(consumer.AllowedAPIs)
+	if err != nil {
+		log.Errorf("api-consumer-put: req %+v, errors:%+v", value, err)
+		return nil, err
+	}
+
+	// the owner is only set by the portal, the admin api can not change it
+	consumer.Owner = ""
+	if consumer.ID != 0 {
+		old, err := Store.GetConsumer(consumer.ID)
+		if err != nil && !errors.Is(err, store.ErrNotFound) {
+			log.Errorf("api-consumer-put: req %+v, errors:%+v", value, err)
+			return nil, err
+		}
+		if err == nil {
+			consumer.Owner = old.Owner
+		}
+	}
+
+	id, err := Store.PutConsumer(consumer)
+	if err != nil {
+		log.Errorf("api-consumer-put: req %+v, errors:%+v", value, err)
+		return nil, err
+	}
+
+	return &grpcx.JSONResult{Data: id}, nil
+}
+
+func deleteConsumerHandler(value interface{}) (*grpcx.JSONResult, error) {
+	err := Store.RemoveConsumer(value.(uint64))
+	if err != nil {
+		log.Errorf("api-consumer-delete: req %+v, errors:%+v", value, err)
+		return nil, err
+	}
+
+	return &grpcx.JSONResult{}, nil
+}
+
+func getConsumerHandler(value interface{}) (*grpcx.JSONResult, error) {
+	value, err := Store.GetConsumer(value.(uint64))
+	if err != nil {
+		log.Errorf("api-consumer-get: req %+v, errors:%+v", value, err)
+		return nil, err
+	}
+
+	return &grpcx.JSONResult{Data: value}, nil
+}
+
+func getConsumerUsageHandler(value interface{}) (*grpcx.JSONResult, error) {
+	consumer, err := Store.GetConsumer(value.(uint64))
+	if err != nil {
+		log.Errorf("api-consumer-usage-get: req %+v, errors:%+v", value, err)
+		return nil, err
+	}
+
+	usage, err := getConsumerUsage(consumer)
+	if err != nil {
+		log.Errorf("api-consumer-usage-get: req %+v, errors:%+v", value, err)
+		return nil, err
+	}
+
+	return &grpcx.JSONResult{Data: usage}, nil
+}
+
+// bindConsumersHandler allow the consumers to call the apis, the consumers become restricted, so they can
+// only call the bound apis
+func bindConsumersHandler(value interface{}) (*grpcx.JSONResult, error) {
+	bindings := value.(*consumerBindings)
+	err := checkAPIsExist(bindings.APIs)
+	if err != nil {
+		log.Errorf("api-consumer-bindings-put: req %+v, errors:%+v", value, err)
+		return nil, err
+	}
+
+	err = changeConsumerBindings(bindings, func(consumer *metapb.Consumer) {
+		consumer.RestrictAPIs = true
+		for _, id := range bindings.APIs {
+			if !containsID(consumer.AllowedAPIs, id) {
+				consumer.AllowedAPIs = append(consumer.AllowedAPIs, id)
+			}
+		}
+	})
+	if err != nil {
+		log.Errorf("api-consumer-bindings-put: req %+v, errors:%+v", value, err)
+		return nil, err
+	}
+
+	return &grpcx.JSONResult{}, nil
+}
+
+// unbindConsumersHandler disallow the consumers to call the apis, the consumers are still restricted even
+// if no api is bound
+func unbindConsumersHandler(value interface{}) (*grpcx.JSONResult, error) {
+	bindings := value.(*consumerBindings)
+	err := changeConsumerBindings(bindings, func(consumer *metapb.Consumer) {
+		consumer.RestrictAPIs = true
+		values := consumer.AllowedAPIs[:0]
+		for _, id := range consumer.AllowedAPIs {
+			if !containsID(bindings.APIs, id) {
+				values = append(values, id)
+			}
+		}
+		consumer.AllowedAPIs = values
+	})
+	if err != nil {
+		log.Errorf("api-consumer-bindings-delete: req %+v, errors:%+v", value, err)
+		return nil, err
+	}
+
+	return &grpcx.JSONResult{}, nil
+}
+
+// changeConsumerBindings change the allowed apis of the consumers, all consumers must exist before the
+// changes are saved
+func changeConsumerBindings(bindings *consumerBindings, change func(*metapb.Consumer)) error {
+	if len(bindings.Consumers) == 0 || len(bindings.APIs) == 0 {
+		return fmt.Errorf("missing consumers or apis")
+	}
+
+	portalLock.Lock()
+	defer portalLock.Unlock()
+
+	consumers := make([]*metapb.Consumer, 0, len(bindings.Consumers))
+	for _, id := range bindings.Consumers {
+		consumer, err := Store.GetConsumer(id)
+		if err != nil {
+			return err
+		}
+
+		consumers = append(consumers, consumer)
+	}
+
+	for _, consumer := range consumers {
+		change(consumer)
+		sort.Slice(consumer.AllowedAPIs, func(i, j int) bool {
+			return consumer.AllowedAPIs[i] < consumer.AllowedAPIs[j]
+		})
+
+		_, err := Store.PutConsumer(consumer)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func checkAPIsExist(ids []uint64) error {
+	for _, id := range ids {
+		_, err := Store.GetAPI(id)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func containsID(values []uint64, id uint64) bool {
+	for _, value := range values {
+		if value == id {
+			return true
+		}
+	}
+
+	return false
+}
+
+func putConsumerFactory() interface{} {
+	return &metapb.Consumer{}
+}
+
+func consumerBindingsFactory() interface{} {
+	return &consumerBindings{}
+}
+
+func listConsumerHandler(value interface{}) (*grpcx.JSONResult, error) {
+	query := value.(*limitQuery)
+	var values []*metapb.Consumer
+
+	err := Store.GetConsumers(limit, func(data interface{}) error {
+		v := data.(*metapb.Consumer)
+		if int64(len(values)) < query.limit && v.ID > query.afterID {
+			values = append(values, v)
+		}
+		return nil
+	})
+	if err != nil {
+		log.Errorf("api-consumer-list-get: req %+v, errors:%+v", value, err)
+		return nil, err
+	}
+
+	return &grpcx.JSONResult{Data: values}, nil
+}
+
+// getConsumerUsage returns the usage of the consumer from all proxies
+func getConsumerUsage(consumer *metapb.Consumer) (*consumerUsage, error) {
+	usage := &consumerUsage{
+		Quota:   consumer.Quota,
+		Proxies: make(map[string]int64),
+	}
+
+	err := getFromProxies("/consumers/usage", consumersUsageFactory, func(proxy *metapb.Proxy, data interface{}, err error) {
+		if err != nil {
+			log.Warnf("api-consumer-usage: proxy %s skipped, errors:%+v", proxy.Addr, err)
+			return
+		}
+
+		// the proxy returns the usages of the current and the previous day
+		values := *data.(*[]*metapb.ConsumerUsage)
+		day := ""
+		for _, u := range values {
+			if u.Day > day {
+				day = u.Day
+			}
+		}
+
+		for _, u := range values {
+			if u.Consumer == consumer.ID && u.Day == day {
+				usage.Day = day
+				usage.Requests += u.Requests
+				usage.Proxies[proxy.Addr] += u.Requests
+			}
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return usage, nil
+}
+
+func consumersUsageFactory() interface{} {
+	var values []*metapb.ConsumerUsage
+	return &values
+}
@@ -0,0 +1,57 @@
+package service
+
+import (
+	"fmt"
+
+	"github.com/fagongzi/grpcx"
+	"github.com/fagongzi/log"
+	"github.com/fagongzi/util/format"
+	"github.com/labstack/echo"
+)
+
+type diffQuery struct {
+	from int64
+	to   int64
+}
+
+func initDiffRouter(server *echo.Group) {
+	server.GET("/diff",
+		newGetHTTPHandle(diffQueryFactory, getDiffHandler))
+}
+
+func getDiffHandler(value interface{}) (*grpcx.JSONResult, error) {
+	query := value.(*diffQuery)
+	diff, err := Store.Diff(query.from, query.to)
+	if err != nil {
+		log.Errorf("api-diff-get: req %+v, errors:%+v", query, err)
+		return nil, err
+	}
+
+	return &grpcx.JSONResult{Data: diff}, nil
+}
+
+func diffQueryFactory(ctx echo.Context) (interface{}, error) {
+	query := &diffQuery{}
+
+	value := ctx.QueryParam("from")
+	if value == "" {
+		return nil, fmt.Errorf("missing from revision")
+	}
+
+	from, err := format.ParseStrInt64(value)
+	if err != nil {
+		return nil, err
+	}
+	query.from = from
+
+	value = ctx.QueryParam("to")
+	if value != "" {
+		to, err := format.ParseStrInt64(value)
+		if err != nil {
+			return nil, err
+		}
+		query.to = to
+	}
+
+	return query, nil
+}
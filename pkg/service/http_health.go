@@ -0,0 +1,122 @@
+package service
+
+import (
+	"sort"
+
+	"github.com/fagongzi/gateway/pkg/pb/metapb"
+	"github.com/fagongzi/grpcx"
+	"github.com/fagongzi/log"
+	"github.com/fagongzi/util/format"
+	"github.com/labstack/echo"
+)
+
+func initHealthRouter(server *echo.Group) {
+	server.GET("/health/servers",
+		newGetHTTPHandle(emptyParamFactory, getServersHealthHandler))
+	server.GET("/health/transitions",
+		newGetHTTPHandle(serverQueryFactory, getHealthTransitionsHandler))
+}
+
+func getServersHealthHandler(value interface{}) (*grpcx.JSONResult, error) {
+	values, err := fleet.get("health/servers", getFleetServersHealth)
+	if err != nil {
+		log.Errorf("api-health-servers: errors:%+v", err)
+		return nil, err
+	}
+
+	return &grpcx.JSONResult{Data: values}, nil
+}
+
+// getFleetServersHealth returns the health of the servers that seen by all proxies
+func getFleetServersHealth() (interface{}, error) {
+	servers := make(map[uint64]*metapb.FleetServerHealth)
+	err := getFromProxies("/health/servers", serversHealthFactory, func(proxy *metapb.Proxy, data interface{}, err error) {
+		if err != nil {
+			log.Warnf("api-health-servers: proxy %s skipped, errors:%+v", proxy.Addr, err)
+			return
+		}
+
+		for _, h := range *data.(*[]*metapb.ServerHealth) {
+			value, ok := servers[h.ServerID]
+			if !ok {
+				value = &metapb.FleetServerHealth{
+					ServerID: h.ServerID,
+					Addr:     h.Addr,
+					Status:   h.Status,
+					Score:    h.Score,
+				}
+				servers[h.ServerID] = value
+			}
+
+			// the fleet status and score are the worst that any proxy seen
+			if h.Status > value.Status {
+				value.Status = h.Status
+			}
+			if h.Score < value.Score {
+				value.Score = h.Score
+			}
+			value.Proxies = append(value.Proxies, *h)
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	values := make([]*metapb.FleetServerHealth, 0, len(servers))
+	for _, value := range servers {
+		values = append(values, value)
+	}
+	sort.Slice(values, func(i, j int) bool {
+		return values[i].ServerID < values[j].ServerID
+	})
+
+	return values, nil
+}
+
+func serversHealthFactory() interface{} {
+	var values []*metapb.ServerHealth
+	return &values
+}
+
+// getHealthTransitionsHandler returns the last heath check status transitions that published by the
+// proxies, the transitions of the proxies that no longer registered are skipped
+func getHealthTransitionsHandler(value interface{}) (*grpcx.JSONResult, error) {
+	id := value.(uint64)
+	proxies := make(map[string]struct{})
+	err := Store.GetProxies(limit, func(proxy *metapb.Proxy) error {
+		proxies[proxy.Addr] = struct{}{}
+		return nil
+	})
+	if err != nil {
+		log.Errorf("api-health-transitions: req %+v, errors:%+v", value, err)
+		return nil, err
+	}
+
+	var values []*metapb.HealthTransition
+	err = Store.GetHealthTransitions(func(transition *metapb.HealthTransition) error {
+		if _, ok := proxies[transition.Proxy]; !ok {
+			return nil
+		}
+
+		if id == 0 || transition.ServerID == id {
+			values = append(values, transition)
+		}
+		return nil
+	})
+	if err != nil {
+		log.Errorf("api-health-transitions: req %+v, errors:%+v", value, err)
+		return nil, err
+	}
+
+	return &grpcx.JSONResult{Data: values}, nil
+}
+
+// serverQueryFactory returns the server id of the server query value, 0 means all servers
+func serverQueryFactory(ctx echo.Context) (interface{}, error) {
+	value := ctx.QueryParam("server")
+	if value == "" {
+		return uint64(0), nil
+	}
+
+	return format.ParseStrUInt64(value)
+}
@@ -0,0 +1,163 @@
+package service
+
+import (
+	"sync"
+
+	"github.com/fagongzi/gateway/pkg/pb/metapb"
+	"github.com/fagongzi/grpcx"
+	"github.com/fagongzi/log"
+	"github.com/labstack/echo"
+)
+
+var (
+	// policyLock serialize the read-modify-write of the global policy
+	policyLock sync.Mutex
+)
+
+// apiIPAccessControl is the ip access control of an api to update
+type apiIPAccessControl struct {
+	id    uint64
+	value *metapb.IPAccessControl
+}
+
+func initIPAccessControlRouter(server *echo.Group) {
+	server.GET("/ip-access-control",
+		newGetHTTPHandle(emptyParamFactory, getIPAccessControlHandler))
+	server.PUT("/ip-access-control",
+		newJSONBodyHTTPHandle(putIPAccessControlFactory, putIPAccessControlHandler))
+	server.DELETE("/ip-access-control",
+		newGetHTTPHandle(emptyParamFactory, deleteIPAccessControlHandler))
+	server.GET("/apis/:id/ip-access-control",
+		newGetHTTPHandle(idParamFactory, getAPIIPAccessControlHandler))
+	server.PUT("/apis/:id/ip-access-control",
+		newGetHTTPHandle(apiIPAccessControlFactory, putAPIIPAccessControlHandler))
+	server.DELETE("/apis/:id/ip-access-control",
+		newGetHTTPHandle(idParamFactory, deleteAPIIPAccessControlHandler))
+}
+
+func getIPAccessControlHandler(value interface{}) (*grpcx.JSONResult, error) {
+	policy, err := Store.GetPolicy()
+	if err != nil {
+		log.Errorf("api-ip-access-control-get: errors:%+v", err)
+		return nil, err
+	}
+
+	if policy == nil || policy.IPAccessControl == nil {
+		return &grpcx.JSONResult{Data: &metapb.IPAccessControl{}}, nil
+	}
+
+	return &grpcx.JSONResult{Data: policy.IPAccessControl}, nil
+}
+
+func putIPAccessControlHandler(value interface{}) (*grpcx.JSONResult, error) {
+	err := updateGlobalIPAccessControl(value.(*metapb.IPAccessControl))
+	if err != nil {
+		log.Errorf("api-ip-access-control-put: req %+v, errors:%+v", value, err)
+		return nil, err
+	}
+
+	return &grpcx.JSONResult{}, nil
+}
+
+func deleteIPAccessControlHandler(value interface{}) (*grpcx.JSONResult, error) {
+	err := updateGlobalIPAccessControl(nil)
+	if err != nil {
+		log.Errorf("api-ip-access-control-delete: errors:%+v", err)
+		return nil, err
+	}
+
+	return &grpcx.JSONResult{}, nil
+}
+
+// updateGlobalIPAccessControl replace the ip access control of the global policy, the other fields of
+// the policy are kept
+func updateGlobalIPAccessControl(value *metapb.IPAccessControl) error {
+	policyLock.Lock()
+	defer policyLock.Unlock()
+
+	policy, err := Store.GetPolicy()
+	if err != nil {
+		return err
+	}
+	if policy == nil {
+		if value == nil {
+			return nil
+		}
+		policy = &metapb.Policy{}
+	}
+
+	policy.IPAccessControl = value
+	return Store.PutPolicy(policy)
+}
+
+func getAPIIPAccessControlHandler(value interface{}) (*grpcx.JSONResult, error) {
+	api, err := Store.GetAPI(value.(uint64))
+	if err != nil {
+		log.Errorf("api-api-ip-access-control-get: req %+v, errors:%+v", value, err)
+		return nil, err
+	}
+
+	if api.IPAccessControl == nil {
+		return &grpcx.JSONResult{Data: &metapb.IPAccessControl{}}, nil
+	}
+
+	return &grpcx.JSONResult{Data: api.IPAccessControl}, nil
+}
+
+func putAPIIPAccessControlHandler(value interface{}) (*grpcx.JSONResult, error) {
+	req := value.(*apiIPAccessControl)
+	err := updateAPIIPAccessControl(req.id, req.value)
+	if err != nil {
+		log.Errorf("api-api-ip-access-control-put: req %+v, errors:%+v", req.value, err)
+		return nil, err
+	}
+
+	return &grpcx.JSONResult{}, nil
+}
+
+func deleteAPIIPAccessControlHandler(value interface{}) (*grpcx.JSONResult, error) {
+	err := updateAPIIPAccessControl(value.(uint64), nil)
+	if err != nil {
+		log.Errorf("api-api-ip-access-control-delete: req %+v, errors:%+v", value, err)
+		return nil, err
+	}
+
+	return &grpcx.JSONResult{}, nil
+}
+
+// updateAPIIPAccessControl replace the ip access control of the api, the api is checked as the other
+// updates of the api
+func updateAPIIPAccessControl(id uint64, value *metapb.IPAccessControl) error {
+	api, err := Store.GetAPI(id)
+	if err != nil {
+		return err
+	}
+
+	api.IPAccessControl = value
+	err = checkPutAPI(Store, api)
+	if err != nil {
+		return err
+	}
+
+	_, err = Store.PutAPI(api)
+	return err
+}
+
+func putIPAccessControlFactory() interface{} {
+	return &metapb.IPAccessControl{}
+}
+
+func apiIPAccessControlFactory(ctx echo.Context) (interface{}, error) {
+	id, err := idParamFactory(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	value := &metapb.IPAccessControl{}
+	err = grpcx.ReadJSONFromBody(ctx, value)
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiIPAccessControl{id: id.(uint64), value: value}, nil
+}
@@ -0,0 +1,53 @@
+package service
+
+import (
+	"github.com/fagongzi/gateway/pkg/pb/metapb"
+	"github.com/fagongzi/grpcx"
+	"github.com/fagongzi/log"
+	"github.com/labstack/echo"
+)
+
+func initKillSwitchRouter(server *echo.Group) {
+	server.GET("/killswitch",
+		newGetHTTPHandle(emptyParamFactory, getKillSwitchHandler))
+	server.DELETE("/killswitch",
+		newGetHTTPHandle(emptyParamFactory, deleteKillSwitchHandler))
+	server.PUT("/killswitch",
+		newJSONBodyHTTPHandle(putKillSwitchFactory, putKillSwitchHandler))
+}
+
+func putKillSwitchHandler(value interface{}) (*grpcx.JSONResult, error) {
+	err := Store.PutKillSwitch(value.(*metapb.KillSwitch))
+	if err != nil {
+		log.Errorf("api-killswitch-put: req %+v, errors:%+v", value, err)
+		return nil, err
+	}
+
+	log.Warnf("api-killswitch-put: kill switch set, req %+v", value)
+	return &grpcx.JSONResult{}, nil
+}
+
+func deleteKillSwitchHandler(value interface{}) (*grpcx.JSONResult, error) {
+	err := Store.RemoveKillSwitch()
+	if err != nil {
+		log.Errorf("api-killswitch-delete: errors:%+v", err)
+		return nil, err
+	}
+
+	log.Warnf("api-killswitch-delete: kill switch removed")
+	return &grpcx.JSONResult{}, nil
+}
+
+func getKillSwitchHandler(value interface{}) (*grpcx.JSONResult, error) {
+	value, err := Store.GetKillSwitch()
+	if err != nil {
+		log.Errorf("api-killswitch-get: errors:%+v", err)
+		return nil, err
+	}
+
+	return &grpcx.JSONResult{Data: value}, nil
+}
+
+func putKillSwitchFactory() interface{} {
+	return &metapb.KillSwitch{}
+}
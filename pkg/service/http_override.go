@@ -0,0 +1,72 @@
+package service
+
+import (
+	"github.com/fagongzi/gateway/pkg/pb/metapb"
+	"github.com/fagongzi/grpcx"
+	"github.com/fagongzi/log"
+	"github.com/labstack/echo"
+)
+
+func initProxyOverrideRouter(server *echo.Group) {
+	server.GET("/overrides/:id",
+		newGetHTTPHandle(idParamFactory, getProxyOverrideHandler))
+	server.DELETE("/overrides/:id",
+		newGetHTTPHandle(idParamFactory, deleteProxyOverrideHandler))
+	server.PUT("/overrides",
+		newJSONBodyHTTPHandle(putProxyOverrideFactory, postProxyOverrideHandler))
+	server.GET("/overrides",
+		newGetHTTPHandle(limitQueryFactory, listProxyOverrideHandler))
+}
+
+func postProxyOverrideHandler(value interface{}) (*grpcx.JSONResult, error) {
+	id, err := Store.PutProxyOverride(value.(*metapb.ProxyOverride))
+	if err != nil {
+		log.Errorf("api-override-put: req %+v, errors:%+v", value, err)
+		return nil, err
+	}
+
+	return &grpcx.JSONResult{Data: id}, nil
+}
+
+func deleteProxyOverrideHandler(value interface{}) (*grpcx.JSONResult, error) {
+	err := Store.RemoveProxyOverride(value.(uint64))
+	if err != nil {
+		log.Errorf("api-override-delete: req %+v, errors:%+v", value, err)
+		return nil, err
+	}
+
+	return &grpcx.JSONResult{}, nil
+}
+
+func getProxyOverrideHandler(value interface{}) (*grpcx.JSONResult, error) {
+	value, err := Store.GetProxyOverride(value.(uint64))
+	if err != nil {
+		log.Errorf("api-override-get: req %+v, errors:%+v", value, err)
+		return nil, err
+	}
+
+	return &grpcx.JSONResult{Data: value}, nil
+}
+
+func putProxyOverrideFactory() interface{} {
+	return &metapb.ProxyOverride{}
+}
+
+func listProxyOverrideHandler(value interface{}) (*grpcx.JSONResult, error) {
+	query := value.(*limitQuery)
+	var values []*metapb.ProxyOverride
+
+	err := Store.GetProxyOverrides(limit, func(data interface{}) error {
+		v := data.(*metapb.ProxyOverride)
+		if int64(len(values)) < query.limit && v.ID > query.afterID {
+			values = append(values, v)
+		}
+		return nil
+	})
+	if err != nil {
+		log.Errorf("api-override-list-get: req %+v, errors:%+v", value, err)
+		return nil, err
+	}
+
+	return &grpcx.JSONResult{Data: values}, nil
+}
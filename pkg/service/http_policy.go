@@ -0,0 +1,108 @@
+package service
+
+import (
+	"errors"
+
+	pbutil "github.com/fagongzi/gateway/pkg/pb"
+	"github.com/fagongzi/gateway/pkg/pb/metapb"
+	"github.com/fagongzi/gateway/pkg/store"
+	"github.com/fagongzi/grpcx"
+	"github.com/fagongzi/log"
+	"github.com/labstack/echo"
+)
+
+func initPolicyRouter(server *echo.Group) {
+	server.GET("/policy",
+		newGetHTTPHandle(emptyParamFactory, getPolicyHandler))
+	server.DELETE("/policy",
+		newGetHTTPHandle(emptyParamFactory, deletePolicyHandler))
+	server.PUT("/policy",
+		newJSONBodyHTTPHandle(putPolicyFactory, putPolicyHandler))
+	server.GET("/apis/:id/policy",
+		newGetHTTPHandle(idParamFactory, getAPIPolicyHandler))
+}
+
+func putPolicyHandler(value interface{}) (*grpcx.JSONResult, error) {
+	err := Store.PutPolicy(value.(*metapb.Policy))
+	if err != nil {
+		log.Errorf("api-policy-put: req %+v, errors:%+v", value, err)
+		return nil, err
+	}
+
+	return &grpcx.JSONResult{}, nil
+}
+
+func deletePolicyHandler(value interface{}) (*grpcx.JSONResult, error) {
+	err := Store.RemovePolicy()
+	if err != nil {
+		log.Errorf("api-policy-delete: errors:%+v", err)
+		return nil, err
+	}
+
+	return &grpcx.JSONResult{}, nil
+}
+
+func getPolicyHandler(value interface{}) (*grpcx.JSONResult, error) {
+	value, err := Store.GetPolicy()
+	if err != nil {
+		log.Errorf("api-policy-get: errors:%+v", err)
+		return nil, err
+	}
+
+	return &grpcx.JSONResult{Data: value}, nil
+}
+
+// getAPIPolicyHandler returns the effective policies of the api, and where the policies come from
+func getAPIPolicyHandler(value interface{}) (*grpcx.JSONResult, error) {
+	api, err := Store.GetAPI(value.(uint64))
+	if err != nil {
+		log.Errorf("api-api-policy-get: req %+v, errors:%+v", value, err)
+		return nil, err
+	}
+
+	tpl, clusters, global, err := getInheritedPolicies(api)
+	if err != nil {
+		log.Errorf("api-api-policy-get: req %+v, errors:%+v", value, err)
+		return nil, err
+	}
+
+	return &grpcx.JSONResult{Data: pbutil.ResolvePolicy(api, tpl, clusters, global)}, nil
+}
+
+// getInheritedPolicies returns the template, the clusters and the global policy that the api inherits
+func getInheritedPolicies(api *metapb.API) (*metapb.APITemplate, func(uint64) *metapb.Cluster, *metapb.Policy, error) {
+	var tpl *metapb.APITemplate
+	if api.Template != 0 {
+		value, err := Store.GetAPITemplate(api.Template)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		tpl = value
+	}
+
+	global, err := Store.GetPolicy()
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	values := make(map[uint64]*metapb.Cluster)
+	for _, node := range api.Nodes {
+		if _, ok := values[node.ClusterID]; ok {
+			continue
+		}
+
+		cluster, err := Store.GetCluster(node.ClusterID)
+		if err != nil && !errors.Is(err, store.ErrNotFound) {
+			return nil, nil, nil, err
+		}
+		values[node.ClusterID] = cluster
+	}
+
+	return tpl, func(id uint64) *metapb.Cluster {
+		return values[id]
+	}, global, nil
+}
+
+func putPolicyFactory() interface{} {
+	return &metapb.Policy{}
+}
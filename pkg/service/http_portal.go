@@ -0,0 +1,373 @@
+package service
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	jwt "github.com/dgrijalva/jwt-go"
+	"github.com/fagongzi/gateway/pkg/pb/metapb"
+	"github.com/fagongzi/gateway/pkg/store"
+	"github.com/fagongzi/gateway/pkg/util"
+	"github.com/fagongzi/grpcx"
+	"github.com/fagongzi/log"
+	"github.com/fagongzi/util/format"
+	"github.com/labstack/echo"
+)
+
+const (
+	portalVersion = "/portal/v1"
+
+	portalDeveloperKey   = "developer"
+	portalMaxKeys        = 2
+	defaultPortalGrace   = int64(86400)
+	portalBearerPrefix   = "Bearer "
+	portalDeveloperClaim = "sub"
+)
+
+var (
+	portalSecret []byte
+	portalQuota  int64
+	portalKeyTTL int64
+	// portalLock serialize the read-modify-write of the consumers by the portal
+	// and the key expiry notifier
+	portalLock sync.Mutex
+)
+
+// portalParam is the developer of the portal request and the key id of the path
+type portalParam struct {
+	developer string
+	keyID     string
+	grace     int64
+	ttl       int64
+}
+
+// portalAPI is the published api in the portal
+type portalAPI struct {
+	ID          uint64 `json:"id"`
+	Name        string `json:"name"`
+	URLPattern  string `json:"urlPattern"`
+	Method      string `json:"method"`
+	Domain      string `json:"domain,omitempty"`
+	Description string `json:"description,omitempty"`
+	Doc         string `json:"doc,omitempty"`
+	KeyRequired bool   `json:"keyRequired"`
+}
+
+// portalKey is the api key of the developer, the key is only returned when it's created
+type portalKey struct {
+	ID        string `json:"id"`
+	Key       string `json:"key,omitempty"`
+	CreatedAt int64  `json:"createdAt"`
+	ExpireAt  int64  `json:"expireAt,omitempty"`
+}
+
+// InitPortalRouter init the developer portal router, the developers are authenticated by the jwt
+// signed with the secret(HS256), the sub claim is the identity of the developer. The portal is
+// separated from the admin api, a developer can only see the published apis and manage it's own keys.
+// keyTTL(secs) is the max ttl of the keys, 0 means the keys never expire by default
+func InitPortalRouter(server *echo.Echo, secret string, quota, keyTTL int64) {
+	portalSecret = []byte(secret)
+	portalQuota = quota
+	portalKeyTTL = keyTTL
+
+	group := server.Group(portalVersion, portalAuth)
+	group.GET("/apis",
+		newGetHTTPHandle(portalParamFactory, listPortalAPIHandler))
+	group.GET("/keys",
+		newGetHTTPHandle(portalParamFactory, listPortalKeyHandler))
+	group.POST("/keys",
+		newGetHTTPHandle(portalParamFactory, createPortalKeyHandler))
+	group.POST("/keys/:key/rotate",
+		newGetHTTPHandle(portalParamFactory, rotatePortalKeyHandler))
+	group.DELETE("/keys/:key",
+		newGetHTTPHandle(portalParamFactory, deletePortalKeyHandler))
+	group.GET("/usage",
+		newGetHTTPHandle(portalParamFactory, getPortalUsageHandler))
+}
+
+func portalAuth(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		value := ctx.Request().Header.Get(echo.HeaderAuthorization)
+		if !strings.HasPrefix(value, portalBearerPrefix) {
+			return writeUnauthorized(ctx, "missing bearer token")
+		}
+
+		token, err := jwt.Parse(value[len(portalBearerPrefix):], func(token *jwt.Token) (interface{}, error) {
+			if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+				return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+			}
+			return portalSecret, nil
+		})
+		if err != nil || !token.Valid {
+			return writeUnauthorized(ctx, "invalid token")
+		}
+
+		claims, ok := token.Claims.(jwt.MapClaims)
+		if !ok {
+			return writeUnauthorized(ctx, "invalid token claims")
+		}
+
+		// the tokens are signed by the shared secret, a leaked token without the exp never expires
+		if !claims.VerifyExpiresAt(time.Now().Unix(), true) {
+			return writeUnauthorized(ctx, "missing or invalid exp of the token")
+		}
+
+		developer, _ := claims[portalDeveloperClaim].(string)
+		if developer == "" {
+			return writeUnauthorized(ctx, "missing developer of the token")
+		}
+
+		ctx.Set(portalDeveloperKey, developer)
+		return next(ctx)
+	}
+}
+
+func portalParamFactory(ctx echo.Context) (interface{}, error) {
+	param := &portalParam{
+		developer: ctx.Get(portalDeveloperKey).(string),
+		keyID:     ctx.Param("key"),
+		grace:     defaultPortalGrace,
+		ttl:       portalKeyTTL,
+	}
+
+	value := ctx.QueryParam("grace")
+	if value != "" {
+		grace, err := format.ParseStrInt64(value)
+		if err != nil {
+			return nil, err
+		}
+		param.grace = grace
+	}
+
+	value = ctx.QueryParam("ttl")
+	if value != "" {
+		ttl, err := format.ParseStrInt64(value)
+		if err != nil {
+			return nil, err
+		}
+
+		// the ttl can not exceed the max ttl
+		if ttl > 0 && (portalKeyTTL == 0 || ttl < portalKeyTTL) {
+			param.ttl = ttl
+		}
+	}
+
+	return param, nil
+}
+
+func listPortalAPIHandler(value interface{}) (*grpcx.JSONResult, error) {
+	var values []*portalAPI
+	err := Store.GetAPIs(limit, func(data interface{}) error {
+		v := data.(*metapb.API)
+		if v.Portal != nil && v.Portal.Published && v.Status == metapb.Up &&
+			(v.PublishState == metapb.Published || v.PublishState == metapb.Deprecated) {
+			values = append(values, &portalAPI{
+				ID:          v.ID,
+				Name:        v.Name,
+				URLPattern:  v.URLPattern,
+				Method:      v.Method,
+				Domain:      v.Domain,
+				Description: v.Portal.Description,
+				Doc:         v.Portal.Doc,
+				KeyRequired: v.Portal.KeyRequired,
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		log.Errorf("api-portal-apis: req %+v, errors:%+v", value, err)
+		return nil, err
+	}
+
+	return &grpcx.JSONResult{Data: values}, nil
+}
+
+func listPortalKeyHandler(value interface{}) (*grpcx.JSONResult, error) {
+	consumer, err := getPortalConsumer(value.(*portalParam).developer)
+	if err != nil {
+		log.Errorf("api-portal-keys-list: req %+v, errors:%+v", value, err)
+		return nil, err
+	}
+
+	values := make([]*portalKey, 0)
+	if consumer != nil {
+		for _, key := range consumer.Keys {
+			values = append(values, &portalKey{
+				ID:        key.ID,
+				CreatedAt: key.CreatedAt,
+				ExpireAt:  key.ExpireAt,
+			})
+		}
+	}
+
+	return &grpcx.JSONResult{Data: values}, nil
+}
+
+func createPortalKeyHandler(value interface{}) (*grpcx.JSONResult, error) {
+	param := value.(*portalParam)
+
+	portalLock.Lock()
+	defer portalLock.Unlock()
+
+	consumer, err := getPortalConsumer(param.developer)
+	if err != nil {
+		log.Errorf("api-portal-keys-create: req %+v, errors:%+v", value, err)
+		return nil, err
+	}
+
+	if consumer == nil {
+		consumer = &metapb.Consumer{
+			Name:  param.developer,
+			Quota: portalQuota,
+			Owner: param.developer,
+		}
+	}
+
+	key, err := addPortalKey(consumer, param.ttl)
+	if err != nil {
+		log.Errorf("api-portal-keys-create: req %+v, errors:%+v", value, err)
+		return nil, err
+	}
+
+	return &grpcx.JSONResult{Data: key}, nil
+}
+
+func rotatePortalKeyHandler(value interface{}) (*grpcx.JSONResult, error) {
+	param := value.(*portalParam)
+
+	portalLock.Lock()
+	defer portalLock.Unlock()
+
+	consumer, idx, err := getPortalKey(param)
+	if err != nil {
+		log.Errorf("api-portal-keys-rotate: req %+v, errors:%+v", value, err)
+		return nil, err
+	}
+
+	// the old key is still valid in the grace period
+	expireAt := time.Now().Unix() + param.grace
+	if old := &consumer.Keys[idx]; old.ExpireAt == 0 || old.ExpireAt > expireAt {
+		old.ExpireAt = expireAt
+	}
+
+	key, err := addPortalKey(consumer, param.ttl)
+	if err != nil {
+		log.Errorf("api-portal-keys-rotate: req %+v, errors:%+v", value, err)
+		return nil, err
+	}
+
+	return &grpcx.JSONResult{Data: key}, nil
+}
+
+func deletePortalKeyHandler(value interface{}) (*grpcx.JSONResult, error) {
+	param := value.(*portalParam)
+
+	portalLock.Lock()
+	defer portalLock.Unlock()
+
+	consumer, idx, err := getPortalKey(param)
+	if err != nil {
+		log.Errorf("api-portal-keys-delete: req %+v, errors:%+v", value, err)
+		return nil, err
+	}
+
+	consumer.Keys = append(consumer.Keys[:idx], consumer.Keys[idx+1:]...)
+	_, err = Store.PutConsumer(consumer)
+	if err != nil {
+		log.Errorf("api-portal-keys-delete: req %+v, errors:%+v", value, err)
+		return nil, err
+	}
+
+	return &grpcx.JSONResult{}, nil
+}
+
+func getPortalUsageHandler(value interface{}) (*grpcx.JSONResult, error) {
+	consumer, err := getPortalConsumer(value.(*portalParam).developer)
+	if err != nil {
+		log.Errorf("api-portal-usage: req %+v, errors:%+v", value, err)
+		return nil, err
+	}
+
+	if consumer == nil {
+		return &grpcx.JSONResult{Data: &consumerUsage{Quota: portalQuota}}, nil
+	}
+
+	usage, err := getConsumerUsage(consumer)
+	if err != nil {
+		log.Errorf("api-portal-usage: req %+v, errors:%+v", value, err)
+		return nil, err
+	}
+
+	return &grpcx.JSONResult{Data: usage}, nil
+}
+
+// getPortalConsumer returns the consumer created by the portal for the developer, nil if the developer
+// has no consumer. The consumers created by the admin api have no owner, so the developer can never
+// manage them even if the names are same
+func getPortalConsumer(developer string) (*metapb.Consumer, error) {
+	return Store.GetConsumerByOwner(developer)
+}
+
+func getPortalKey(param *portalParam) (*metapb.Consumer, int, error) {
+	consumer, err := getPortalConsumer(param.developer)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if consumer != nil {
+		for idx, key := range consumer.Keys {
+			if key.ID == param.keyID {
+				return consumer, idx, nil
+			}
+		}
+	}
+
+	return nil, 0, fmt.Errorf("api key %s %w", param.keyID, store.ErrNotFound)
+}
+
+// addPortalKey add a new key to the consumer, the expired keys are removed. A consumer has at most
+// two valid keys, so the old key and the new key are both valid in the grace period of the rotation
+func addPortalKey(consumer *metapb.Consumer, ttl int64) (*portalKey, error) {
+	now := time.Now().Unix()
+	keys := consumer.Keys[:0]
+	for _, key := range consumer.Keys {
+		if key.ExpireAt == 0 || key.ExpireAt > now {
+			keys = append(keys, key)
+		}
+	}
+	consumer.Keys = keys
+
+	if len(consumer.Keys) >= portalMaxKeys {
+		return nil, fmt.Errorf("too many api keys, max is %d", portalMaxKeys)
+	}
+
+	expireAt := int64(0)
+	if ttl > 0 {
+		expireAt = now + ttl
+	}
+
+	id, value, err := util.NewAPIKey()
+	if err != nil {
+		return nil, err
+	}
+
+	consumer.Keys = append(consumer.Keys, metapb.APIKey{
+		ID:        id,
+		Hash:      util.APIKeyHash(value),
+		CreatedAt: now,
+		ExpireAt:  expireAt,
+	})
+	_, err = Store.PutConsumer(consumer)
+	if err != nil {
+		return nil, err
+	}
+
+	return &portalKey{
+		ID:        id,
+		Key:       value,
+		CreatedAt: now,
+		ExpireAt:  expireAt,
+	}, nil
+}
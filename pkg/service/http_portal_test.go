@@ -0,0 +1,86 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/fagongzi/gateway/pkg/pb/metapb"
+	"github.com/fagongzi/gateway/pkg/store"
+)
+
+// consumerStore is a store only keeps the consumers in memory
+type consumerStore struct {
+	store.Store
+
+	id        uint64
+	consumers map[uint64]*metapb.Consumer
+}
+
+func (s *consumerStore) PutConsumer(value *metapb.Consumer) (uint64, error) {
+	if value.ID == 0 {
+		s.id++
+		value.ID = s.id
+	}
+
+	v := *value
+	s.consumers[value.ID] = &v
+	return value.ID, nil
+}
+
+func (s *consumerStore) GetConsumerByOwner(owner string) (*metapb.Consumer, error) {
+	for _, v := range s.consumers {
+		if v.Owner == owner {
+			value := *v
+			return &value, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func TestPortalNeverAdoptConsumerWithSameName(t *testing.T) {
+	operator := &metapb.Consumer{
+		ID:   1,
+		Name: "alice",
+		Keys: []metapb.APIKey{{ID: "operator", Hash: "hash"}},
+	}
+	s := &consumerStore{id: 1, consumers: map[uint64]*metapb.Consumer{1: operator}}
+
+	old := Store
+	Store = s
+	defer func() {
+		Store = old
+	}()
+
+	param := &portalParam{developer: "alice"}
+	rsp, err := listPortalKeyHandler(param)
+	if err != nil {
+		t.Fatalf("list keys failed, errors:%+v", err)
+	}
+	if keys := rsp.Data.([]*portalKey); len(keys) != 0 {
+		t.Fatalf("expect no keys of the developer, but %+v", keys)
+	}
+
+	_, err = createPortalKeyHandler(param)
+	if err != nil {
+		t.Fatalf("create key failed, errors:%+v", err)
+	}
+
+	if len(s.consumers) != 2 {
+		t.Fatalf("expect a new consumer of the developer, but %d consumers", len(s.consumers))
+	}
+	if v := s.consumers[1]; v.Owner != "" || len(v.Keys) != 1 || v.Keys[0].ID != "operator" {
+		t.Errorf("expect the operator consumer unchanged, but %+v", v)
+	}
+	if v := s.consumers[2]; v.Owner != "alice" || v.Name != "alice" || len(v.Keys) != 1 {
+		t.Errorf("expect the consumer owned by the developer, but %+v", v)
+	}
+
+	// the developer can not manage the keys of the operator consumer
+	_, err = deletePortalKeyHandler(&portalParam{developer: "alice", keyID: "operator"})
+	if err == nil {
+		t.Errorf("expect the key of the operator consumer not found")
+	}
+	if len(s.consumers[1].Keys) != 1 {
+		t.Errorf("expect the key of the operator consumer not deleted")
+	}
+}
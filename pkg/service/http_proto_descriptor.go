@@ -0,0 +1,123 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+
+	pbutil "github.com/fagongzi/gateway/pkg/pb"
+	"github.com/fagongzi/gateway/pkg/pb/metapb"
+	"github.com/fagongzi/gateway/pkg/store"
+	"github.com/fagongzi/gateway/pkg/util"
+	"github.com/fagongzi/grpcx"
+	"github.com/fagongzi/log"
+	"github.com/labstack/echo"
+)
+
+func initProtoDescriptorRouter(server *echo.Group) {
+	server.GET("/protos/:id",
+		newGetHTTPHandle(idParamFactory, getProtoDescriptorHandler))
+	server.DELETE("/protos/:id",
+		newGetHTTPHandle(idParamFactory, deleteProtoDescriptorHandler))
+	server.PUT("/protos",
+		newJSONBodyHTTPHandle(putProtoDescriptorFactory, postProtoDescriptorHandler))
+	server.GET("/protos",
+		newGetHTTPHandle(limitQueryFactory, listProtoDescriptorHandler))
+}
+
+func postProtoDescriptorHandler(value interface{}) (*grpcx.JSONResult, error) {
+	id, err := Store.PutProtoDescriptor(value.(*metapb.ProtoDescriptor))
+	if err != nil {
+		log.Errorf("api-proto-put: req %+v, errors:%+v", value, err)
+		return nil, err
+	}
+
+	return &grpcx.JSONResult{Data: id}, nil
+}
+
+func deleteProtoDescriptorHandler(value interface{}) (*grpcx.JSONResult, error) {
+	err := Store.RemoveProtoDescriptor(value.(uint64))
+	if err != nil {
+		log.Errorf("api-proto-delete: req %+v, errors:%+v", value, err)
+		return nil, err
+	}
+
+	return &grpcx.JSONResult{}, nil
+}
+
+func getProtoDescriptorHandler(value interface{}) (*grpcx.JSONResult, error) {
+	value, err := Store.GetProtoDescriptor(value.(uint64))
+	if err != nil {
+		log.Errorf("api-proto-get: req %+v, errors:%+v", value, err)
+		return nil, err
+	}
+
+	return &grpcx.JSONResult{Data: value}, nil
+}
+
+func putProtoDescriptorFactory() interface{} {
+	return &metapb.ProtoDescriptor{}
+}
+
+func listProtoDescriptorHandler(value interface{}) (*grpcx.JSONResult, error) {
+	query := value.(*limitQuery)
+	var values []*metapb.ProtoDescriptor
+
+	err := Store.GetProtoDescriptors(limit, func(data interface{}) error {
+		v := data.(*metapb.ProtoDescriptor)
+		if int64(len(values)) < query.limit && v.ID > query.afterID {
+			values = append(values, v)
+		}
+		return nil
+	})
+	if err != nil {
+		log.Errorf("api-proto-list-get: req %+v, errors:%+v", value, err)
+		return nil, err
+	}
+
+	return &grpcx.JSONResult{Data: values}, nil
+}
+
+// checkGRPCTranscodings check the grpc methods of the api nodes exist in the proto descriptors,
+// the streaming methods can not be transcoded
+func checkGRPCTranscodings(db store.Store, value *metapb.API) error {
+	for i, node := range value.Nodes {
+		t := node.GRPCTranscoding
+		if t == nil {
+			continue
+		}
+
+		field := fmt.Sprintf("nodes[%d].grpcTranscoding", i)
+		pd, err := db.GetProtoDescriptor(t.DescriptorID)
+		if errors.Is(err, store.ErrNotFound) {
+			return &pbutil.ValidationError{
+				Field:   field + ".descriptor",
+				Message: fmt.Sprintf("proto descriptor not found: %d", t.DescriptorID),
+			}
+		}
+		if err != nil {
+			return err
+		}
+
+		descriptors, err := util.ParseProtoDescriptors(pd.Data)
+		if err != nil {
+			return err
+		}
+
+		method, ok := descriptors.Method(t.Method)
+		if !ok {
+			return &pbutil.ValidationError{
+				Field:   field + ".method",
+				Message: fmt.Sprintf("grpc method not found: %s", t.Method),
+			}
+		}
+
+		if method.ClientStreaming || method.ServerStreaming {
+			return &pbutil.ValidationError{
+				Field:   field + ".method",
+				Message: fmt.Sprintf("streaming grpc method can not be transcoded: %s", t.Method),
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,122 @@
+package service
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/fagongzi/gateway/pkg/pb/metapb"
+	"github.com/fagongzi/gateway/pkg/store"
+	"github.com/fagongzi/grpcx"
+	"github.com/fagongzi/log"
+	"github.com/labstack/echo"
+)
+
+var (
+	// StaleProxyTimeout the proxy is stale if the last meta change is not applied in the duration
+	StaleProxyTimeout = time.Second * 10
+)
+
+type proxyStatus struct {
+	*metapb.Proxy
+	Propagation *metapb.ConfigPropagation `json:"propagation,omitempty"`
+	Revision    int64                     `json:"revision"`
+	Stale       bool                      `json:"stale"`
+	Error       string                    `json:"error,omitempty"`
+}
+
+func initProxyRouter(server *echo.Group) {
+	server.GET("/proxies",
+		newGetHTTPHandle(emptyParamFactory, listProxiesHandler))
+	server.POST("/proxies/resync",
+		newGetHTTPHandle(proxyQueryFactory, postProxyResyncHandler))
+}
+
+func listProxiesHandler(value interface{}) (*grpcx.JSONResult, error) {
+	values, err := getProxiesStatus()
+	if err != nil {
+		log.Errorf("api-proxy-list: errors:%+v", err)
+		return nil, err
+	}
+
+	return &grpcx.JSONResult{Data: values}, nil
+}
+
+// postProxyResyncHandler request the proxy to load all meta from the store, the resync is done
+// asynchronously by the proxy
+func postProxyResyncHandler(value interface{}) (*grpcx.JSONResult, error) {
+	var target *metapb.Proxy
+	err := Store.GetProxies(limit, func(proxy *metapb.Proxy) error {
+		if proxy.Addr == value.(string) {
+			target = proxy
+		}
+		return nil
+	})
+	if err != nil {
+		log.Errorf("api-proxy-resync: req %+v, errors:%+v", value, err)
+		return nil, err
+	}
+	if target == nil {
+		return nil, fmt.Errorf("proxy <%s> %w", value, store.ErrNotFound)
+	}
+
+	var accepted bool
+	err = postToProxy(target, "/config/resync", &accepted)
+	if err != nil {
+		log.Errorf("api-proxy-resync: req %+v, errors:%+v", value, err)
+		return nil, err
+	}
+
+	return &grpcx.JSONResult{Data: accepted}, nil
+}
+
+// getProxiesStatus returns the proxies with the latency of the meta changes propagation, the
+// proxy that has not applied the last meta change in time is marked as stale
+func getProxiesStatus() ([]*proxyStatus, error) {
+	revision, writeAt, err := Store.GetLastWrite()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	var values []*proxyStatus
+	err = getFromProxies("/config/propagation", configPropagationFactory, func(proxy *metapb.Proxy, data interface{}, err error) {
+		status := &proxyStatus{
+			Proxy:    proxy,
+			Revision: revision,
+		}
+		values = append(values, status)
+
+		if err != nil {
+			log.Warnf("api-proxy-list: proxy %s propagation skipped, errors:%+v", proxy.Addr, err)
+			status.Error = err.Error()
+			return
+		}
+
+		status.Propagation = data.(*metapb.ConfigPropagation)
+		status.Stale = status.Propagation.Revision < revision &&
+			now.Sub(time.Unix(0, writeAt)) > StaleProxyTimeout
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(values, func(i, j int) bool {
+		return values[i].Addr < values[j].Addr
+	})
+
+	return values, nil
+}
+
+func proxyQueryFactory(ctx echo.Context) (interface{}, error) {
+	value := ctx.QueryParam("proxy")
+	if value == "" {
+		return nil, fmt.Errorf("missing proxy query value")
+	}
+
+	return value, nil
+}
+
+func configPropagationFactory() interface{} {
+	return &metapb.ConfigPropagation{}
+}
@@ -0,0 +1,87 @@
+package service
+
+import (
+	"github.com/fagongzi/gateway/pkg/pb/metapb"
+	"github.com/fagongzi/grpcx"
+	"github.com/fagongzi/log"
+	"github.com/labstack/echo"
+)
+
+func initRateLimitRouter(server *echo.Group) {
+	server.GET("/ratelimits/:id",
+		newGetHTTPHandle(idParamFactory, getRateLimitHandler))
+	server.DELETE("/ratelimits/:id",
+		newGetHTTPHandle(idParamFactory, deleteRateLimitHandler))
+	server.PUT("/ratelimits",
+		newJSONBodyHTTPHandle(putRateLimitFactory, postRateLimitHandler))
+	server.GET("/ratelimits",
+		newGetHTTPHandle(limitQueryFactory, listRateLimitHandler))
+}
+
+func postRateLimitHandler(value interface{}) (*grpcx.JSONResult, error) {
+	meta := value.(*metapb.RateLimit)
+	apis := meta.APIs
+	if meta.API > 0 {
+		apis = []uint64{meta.API}
+	}
+
+	// the limited apis must exist
+	for _, id := range apis {
+		_, err := Store.GetAPI(id)
+		if err != nil {
+			log.Errorf("api-ratelimit-put: req %+v, errors:%+v", value, err)
+			return nil, err
+		}
+	}
+
+	id, err := Store.PutRateLimit(meta)
+	if err != nil {
+		log.Errorf("api-ratelimit-put: req %+v, errors:%+v", value, err)
+		return nil, err
+	}
+
+	return &grpcx.JSONResult{Data: id}, nil
+}
+
+func deleteRateLimitHandler(value interface{}) (*grpcx.JSONResult, error) {
+	err := Store.RemoveRateLimit(value.(uint64))
+	if err != nil {
+		log.Errorf("api-ratelimit-delete: req %+v, errors:%+v", value, err)
+		return nil, err
+	}
+
+	return &grpcx.JSONResult{}, nil
+}
+
+func getRateLimitHandler(value interface{}) (*grpcx.JSONResult, error) {
+	value, err := Store.GetRateLimit(value.(uint64))
+	if err != nil {
+		log.Errorf("api-ratelimit-get: req %+v, errors:%+v", value, err)
+		return nil, err
+	}
+
+	return &grpcx.JSONResult{Data: value}, nil
+}
+
+func putRateLimitFactory() interface{} {
+	return &metapb.RateLimit{}
+}
+
+func listRateLimitHandler(value interface{}) (*grpcx.JSONResult, error) {
+	query := value.(*limitQuery)
+	var values []*metapb.RateLimit
+
+	err := Store.GetRateLimits(limit, func(data interface{}) error {
+		v := data.(*metapb.RateLimit)
+		if int64(len(values)) < query.limit && v.ID > query.afterID {
+			values = append(values, v)
+		}
+		return nil
+	})
+	if err != nil {
+		log.Errorf("api-ratelimit-list-get: req %+v, errors:%+v", value, err)
+		return nil, err
+	}
+
+	return &grpcx.JSONResult{Data: values}, nil
+}
@@ -0,0 +1,209 @@
+package service
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/fagongzi/gateway/pkg/pb/metapb"
+	"github.com/fagongzi/grpcx"
+	"github.com/fagongzi/log"
+	"github.com/fagongzi/util/format"
+	"github.com/labstack/echo"
+)
+
+const (
+	periodDaily   = "daily"
+	periodMonthly = "monthly"
+	formatJSON    = "json"
+	formatCSV     = "csv"
+)
+
+type usageReportQuery struct {
+	from, to string
+	period   string
+	format   string
+	consumer uint64
+	api      uint64
+}
+
+// usageReportRow is the requests of the consumer to the api in the period(yyyy-mm-dd or yyyy-mm)
+type usageReportRow struct {
+	Period       string `json:"period"`
+	Consumer     uint64 `json:"consumer"`
+	ConsumerName string `json:"consumerName"`
+	API          uint64 `json:"api"`
+	APIName      string `json:"apiName"`
+	Requests     int64  `json:"requests"`
+}
+
+func initReportRouter(server *echo.Group) {
+	server.GET("/reports/usage", getUsageReportHandler)
+}
+
+func getUsageReportHandler(ctx echo.Context) error {
+	query, err := usageReportQueryFactory(ctx)
+	if err != nil {
+		return writeBadRequest(ctx, err)
+	}
+
+	rows, err := getUsageReport(query)
+	if err != nil {
+		log.Errorf("api-report-usage-get: req %+v, errors:%+v", query, err)
+		return writeError(ctx, err)
+	}
+
+	if query.format == formatJSON {
+		return ctx.JSON(http.StatusOK, &grpcx.JSONResult{Data: rows})
+	}
+
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	w.Write([]string{"period", "consumer", "consumerName", "api", "apiName", "requests"})
+	for _, row := range rows {
+		w.Write([]string{
+			row.Period,
+			strconv.FormatUint(row.Consumer, 10),
+			row.ConsumerName,
+			strconv.FormatUint(row.API, 10),
+			row.APIName,
+			strconv.FormatInt(row.Requests, 10),
+		})
+	}
+	w.Flush()
+
+	ctx.Response().Header().Set(echo.HeaderContentDisposition,
+		fmt.Sprintf("attachment; filename=usage-%s-%s.csv", query.from, query.to))
+	return ctx.Blob(http.StatusOK, "text/csv", buf.Bytes())
+}
+
+// getUsageReport returns the usages that aggregated by the period, consumer and api, the usages
+// of all proxies and all epochs are summed
+func getUsageReport(query *usageReportQuery) ([]*usageReportRow, error) {
+	rows := make(map[usageReportRow]*usageReportRow)
+	err := Store.GetConsumerUsages(query.from, query.to, func(value *metapb.ConsumerUsage) error {
+		if (query.consumer > 0 && value.Consumer != query.consumer) ||
+			(query.api > 0 && value.API != query.api) {
+			return nil
+		}
+
+		period := value.Day
+		if query.period == periodMonthly {
+			period = value.Day[:len(usageMonthFormat)]
+		}
+
+		key := usageReportRow{Period: period, Consumer: value.Consumer, API: value.API}
+		row, ok := rows[key]
+		if !ok {
+			row = &usageReportRow{Period: period, Consumer: value.Consumer, API: value.API}
+			rows[key] = row
+		}
+		row.Requests += value.Requests
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	consumers := make(map[uint64]string)
+	err = Store.GetConsumers(limit, func(data interface{}) error {
+		v := data.(*metapb.Consumer)
+		consumers[v.ID] = v.Name
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	apis := make(map[uint64]string)
+	err = Store.GetAPIs(limit, func(data interface{}) error {
+		v := data.(*metapb.API)
+		apis[v.ID] = v.Name
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	values := make([]*usageReportRow, 0, len(rows))
+	for _, row := range rows {
+		row.ConsumerName = consumers[row.Consumer]
+		row.APIName = apis[row.API]
+		values = append(values, row)
+	}
+	sort.Slice(values, func(i, j int) bool {
+		if values[i].Period != values[j].Period {
+			return values[i].Period < values[j].Period
+		}
+		if values[i].Consumer != values[j].Consumer {
+			return values[i].Consumer < values[j].Consumer
+		}
+		return values[i].API < values[j].API
+	})
+
+	return values, nil
+}
+
+func usageReportQueryFactory(ctx echo.Context) (*usageReportQuery, error) {
+	now := time.Now()
+	query := &usageReportQuery{
+		from:   now.Format(usageMonthFormat) + "-01",
+		to:     now.Format(usageDayFormat),
+		period: periodDaily,
+		format: formatJSON,
+	}
+
+	if value := ctx.QueryParam("from"); value != "" {
+		if _, err := time.Parse(usageDayFormat, value); err != nil {
+			return nil, err
+		}
+		query.from = value
+	}
+
+	if value := ctx.QueryParam("to"); value != "" {
+		if _, err := time.Parse(usageDayFormat, value); err != nil {
+			return nil, err
+		}
+		query.to = value
+	}
+
+	if query.from > query.to {
+		return nil, fmt.Errorf("from %s is after to %s", query.from, query.to)
+	}
+
+	if value := ctx.QueryParam("period"); value != "" {
+		if value != periodDaily && value != periodMonthly {
+			return nil, fmt.Errorf("error period: %s", value)
+		}
+		query.period = value
+	}
+
+	if value := ctx.QueryParam("format"); value != "" {
+		if value != formatJSON && value != formatCSV {
+			return nil, fmt.Errorf("error format: %s", value)
+		}
+		query.format = value
+	}
+
+	if value := ctx.QueryParam("consumer"); value != "" {
+		id, err := format.ParseStrUInt64(value)
+		if err != nil {
+			return nil, err
+		}
+		query.consumer = id
+	}
+
+	if value := ctx.QueryParam("api"); value != "" {
+		id, err := format.ParseStrUInt64(value)
+		if err != nil {
+			return nil, err
+		}
+		query.api = id
+	}
+
+	return query, nil
+}
@@ -0,0 +1,70 @@
+package service
+
+import (
+	"fmt"
+	"sort"
+
+	pbutil "github.com/fagongzi/gateway/pkg/pb"
+	"github.com/fagongzi/gateway/pkg/pb/metapb"
+	"github.com/fagongzi/gateway/pkg/store"
+	"github.com/fagongzi/grpcx"
+	"github.com/fagongzi/log"
+	"github.com/labstack/echo"
+)
+
+func initRouteTestRouter(server *echo.Group) {
+	server.POST("/route-test",
+		newJSONBodyHTTPHandle(routeTestFactory, postRouteTestHandler))
+}
+
+func routeTestFactory() interface{} {
+	return &metapb.RouteTest{}
+}
+
+// postRouteTestHandler dispatch the sample request by a proxy, the proxy returns the matched api, the
+// selected servers and the filters without sending any traffic
+func postRouteTestHandler(value interface{}) (*grpcx.JSONResult, error) {
+	req := value.(*metapb.RouteTest)
+	err := pbutil.ValidateRouteTest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	target, err := getRouteTestProxy(req.Proxy)
+	if err != nil {
+		log.Errorf("api-route-test: req %+v, errors:%+v", value, err)
+		return nil, err
+	}
+
+	result := &metapb.RouteTestResult{}
+	err = postJSONToProxy(target, "/route-test", req, result)
+	if err != nil {
+		log.Errorf("api-route-test: req %+v, errors:%+v", value, err)
+		return nil, err
+	}
+
+	return &grpcx.JSONResult{Data: result}, nil
+}
+
+// getRouteTestProxy returns the proxy of the addr, or the first proxy that has the manager addr
+// if the addr is empty
+func getRouteTestProxy(addr string) (*metapb.Proxy, error) {
+	var proxies []*metapb.Proxy
+	err := Store.GetProxies(limit, func(proxy *metapb.Proxy) error {
+		if (addr == "" && proxy.AddrRPC != "") || proxy.Addr == addr {
+			proxies = append(proxies, proxy)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(proxies) == 0 {
+		return nil, fmt.Errorf("proxy <%s> %w", addr, store.ErrNotFound)
+	}
+
+	sort.Slice(proxies, func(i, j int) bool {
+		return proxies[i].Addr < proxies[j].Addr
+	})
+	return proxies[0], nil
+}
@@ -1,21 +1,35 @@
 package service
 
 import (
+	"fmt"
+
 	"github.com/fagongzi/gateway/pkg/pb/metapb"
+	"github.com/fagongzi/gateway/pkg/pb/rpcpb"
 	"github.com/fagongzi/grpcx"
 	"github.com/fagongzi/log"
+	"github.com/fagongzi/util/format"
 	"github.com/labstack/echo"
 )
 
 func initRoutingRouter(server *echo.Group) {
 	server.GET("/routings/:id",
-		grpcx.NewGetHTTPHandle(idParamFactory, getRoutingHandler))
+		newGetHTTPHandle(idParamFactory, getRoutingHandler))
 	server.DELETE("/routings/:id",
-		grpcx.NewGetHTTPHandle(idParamFactory, deleteRoutingHandler))
+		newGetHTTPHandle(idParamFactory, deleteRoutingHandler))
 	server.PUT("/routings",
-		grpcx.NewJSONBodyHTTPHandle(putRoutingFactory, postRoutingHandler))
+		newJSONBodyHTTPHandle(putRoutingFactory, postRoutingHandler))
 	server.GET("/routings",
-		grpcx.NewGetHTTPHandle(limitQueryFactory, listRoutingHandler))
+		newGetHTTPHandle(limitQueryFactory, listRoutingHandler))
+	server.PUT("/routings/status",
+		newGetHTTPHandle(tagStatusFactory, putRoutingsStatusHandler))
+	server.PUT("/routings/:id/traffic-rate",
+		newGetHTTPHandle(trafficRateQueryFactory, putRoutingTrafficRateHandler))
+}
+
+// trafficRateQuery change the traffic rate of the routing
+type trafficRateQuery struct {
+	id   uint64
+	rate int32
 }
 
 func postRoutingHandler(value interface{}) (*grpcx.JSONResult, error) {
@@ -48,6 +62,41 @@ func getRoutingHandler(value interface{}) (*grpcx.JSONResult, error) {
 	return &grpcx.JSONResult{Data: value}, nil
 }
 
+// putRoutingsStatusHandler change the status of all the routings that match the tags, returns the changed ids
+func putRoutingsStatusHandler(value interface{}) (*grpcx.JSONResult, error) {
+	query := value.(*tagQuery)
+	var values []*metapb.Routing
+
+	err := Store.GetRoutings(limit, func(data interface{}) error {
+		v := data.(*metapb.Routing)
+		if matchTags(v.Tags, query.tags) && v.Status != query.status {
+			v.Status = query.status
+			values = append(values, v)
+		}
+		return nil
+	})
+	if err != nil {
+		log.Errorf("api-routing-status-put: req %+v, errors:%+v", value, err)
+		return nil, err
+	}
+
+	ids := make([]uint64, 0, len(values))
+	err = batchEach(len(values), func(from, to int) *rpcpb.BatchReq {
+		batch := &rpcpb.BatchReq{}
+		for _, v := range values[from:to] {
+			batch.PutRoutings = append(batch.PutRoutings, &rpcpb.PutRoutingReq{Routing: *v})
+			ids = append(ids, v.ID)
+		}
+		return batch
+	})
+	if err != nil {
+		log.Errorf("api-routing-status-put: req %+v, errors:%+v", value, err)
+		return nil, err
+	}
+
+	return &grpcx.JSONResult{Data: ids}, nil
+}
+
 func putRoutingFactory() interface{} {
 	return &metapb.Routing{}
 }
@@ -58,7 +107,7 @@ func listRoutingHandler(value interface{}) (*grpcx.JSONResult, error) {
 
 	err := Store.GetRoutings(limit, func(data interface{}) error {
 		v := data.(*metapb.Routing)
-		if int64(len(values)) < query.limit && v.ID > query.afterID {
+		if int64(len(values)) < query.limit && v.ID > query.afterID && matchTags(v.Tags, query.tags) {
 			values = append(values, v)
 		}
 		return nil
@@ -70,3 +119,40 @@ func listRoutingHandler(value interface{}) (*grpcx.JSONResult, error) {
 
 	return &grpcx.JSONResult{Data: values}, nil
 }
+
+// putRoutingTrafficRateHandler change the traffic rate of the routing, e.g. increase the traffic of the
+// canary cluster step by step, returns the changed routing
+func putRoutingTrafficRateHandler(value interface{}) (*grpcx.JSONResult, error) {
+	query := value.(*trafficRateQuery)
+	routing, err := Store.GetRouting(query.id)
+	if err != nil {
+		log.Errorf("api-routing-traffic-rate-put: req %+v, errors:%+v", value, err)
+		return nil, err
+	}
+
+	routing.TrafficRate = query.rate
+	_, err = Store.PutRouting(routing)
+	if err != nil {
+		log.Errorf("api-routing-traffic-rate-put: req %+v, errors:%+v", value, err)
+		return nil, err
+	}
+
+	return &grpcx.JSONResult{Data: routing}, nil
+}
+
+func trafficRateQueryFactory(ctx echo.Context) (interface{}, error) {
+	id, err := idParamFactory(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rate, err := format.ParseStrInt(ctx.QueryParam("rate"))
+	if err != nil {
+		return nil, err
+	}
+	if rate <= 0 || rate > 100 {
+		return nil, fmt.Errorf("error traffic rate: %d", rate)
+	}
+
+	return &trafficRateQuery{id: id.(uint64), rate: int32(rate)}, nil
+}
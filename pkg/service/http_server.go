@@ -1,21 +1,118 @@
 package service
 
 import (
+	"fmt"
+	"time"
+
+	pbutil "github.com/fagongzi/gateway/pkg/pb"
 	"github.com/fagongzi/gateway/pkg/pb/metapb"
+	"github.com/fagongzi/gateway/pkg/pb/rpcpb"
 	"github.com/fagongzi/grpcx"
 	"github.com/fagongzi/log"
+	"github.com/fagongzi/util/format"
 	"github.com/labstack/echo"
 )
 
 func initServerRouter(server *echo.Group) {
 	server.GET("/servers/:id",
-		grpcx.NewGetHTTPHandle(idParamFactory, getServerHandler))
+		newGetHTTPHandle(idParamFactory, getServerHandler))
 	server.DELETE("/servers/:id",
-		grpcx.NewGetHTTPHandle(idParamFactory, deleteServerHandler))
+		newGetHTTPHandle(idParamFactory, deleteServerHandler))
 	server.PUT("/servers",
-		grpcx.NewJSONBodyHTTPHandle(putServerFactory, postServerHandler))
+		newJSONBodyHTTPHandle(putServerFactory, postServerHandler))
 	server.GET("/servers",
-		grpcx.NewGetHTTPHandle(limitQueryFactory, listServerHandler))
+		newGetHTTPHandle(limitQueryFactory, listServerHandler))
+	server.POST("/servers/batch",
+		newJSONBodyHTTPHandle(batchServersFactory, postBatchServersHandler))
+	server.PUT("/servers/heartbeat",
+		newJSONBodyHTTPHandle(heartbeatServersFactory, putHeartbeatServersHandler))
+	server.PUT("/servers/drain",
+		newGetHTTPHandle(tagDrainFactory, putServersDrainHandler))
+	server.PUT("/servers/:id/weight",
+		newGetHTTPHandle(weightQueryFactory, putServerWeightHandler))
+}
+
+// weightQuery change the weight of the server to the target in ramp seconds
+type weightQuery struct {
+	id     uint64
+	target int32
+	ramp   int64
+}
+
+type batchServers struct {
+	TTL     int64          `json:"ttl"`
+	Servers []*batchServer `json:"servers"`
+}
+
+type batchServer struct {
+	metapb.Server
+	Clusters []uint64 `json:"clusters"`
+}
+
+type heartbeatServers struct {
+	IDs []uint64 `json:"ids"`
+}
+
+func postBatchServersHandler(value interface{}) (*grpcx.JSONResult, error) {
+	req := value.(*batchServers)
+
+	// the server without id is reused by the address, so the deployment tooling
+	// can register the same instances again to refresh them, and the drained servers are kept drained
+	addrs := make(map[string]*metapb.Server)
+	err := Store.GetServers(limit, func(data interface{}) error {
+		v := data.(*metapb.Server)
+		addrs[v.Addr] = v
+		return nil
+	})
+	if err != nil {
+		log.Errorf("api-server-batch: req %+v, errors:%+v", value, err)
+		return nil, err
+	}
+
+	servers := make([]*metapb.Server, 0, len(req.Servers))
+	for _, svr := range req.Servers {
+		if old, ok := addrs[svr.Addr]; ok && svr.ID == 0 {
+			svr.ID = old.ID
+			svr.Drained = old.Drained
+		}
+		servers = append(servers, &svr.Server)
+	}
+
+	ids, err := Store.RegisterServers(servers, req.TTL)
+	if err != nil {
+		log.Errorf("api-server-batch: req %+v, errors:%+v", value, err)
+		return nil, err
+	}
+
+	batch := &rpcpb.BatchReq{}
+	for idx, svr := range req.Servers {
+		for _, cluster := range svr.Clusters {
+			batch.AddBinds = append(batch.AddBinds, &rpcpb.AddBindReq{
+				Cluster: cluster,
+				Server:  ids[idx],
+			})
+		}
+	}
+
+	if len(batch.AddBinds) > 0 {
+		_, err = Store.Batch(batch)
+		if err != nil {
+			log.Errorf("api-server-batch: req %+v, errors:%+v", value, err)
+			return nil, err
+		}
+	}
+
+	return &grpcx.JSONResult{Data: ids}, nil
+}
+
+func putHeartbeatServersHandler(value interface{}) (*grpcx.JSONResult, error) {
+	expired, err := Store.HeartbeatServers(value.(*heartbeatServers).IDs)
+	if err != nil {
+		log.Errorf("api-server-heartbeat: req %+v, errors:%+v", value, err)
+		return nil, err
+	}
+
+	return &grpcx.JSONResult{Data: expired}, nil
 }
 
 func postServerHandler(value interface{}) (*grpcx.JSONResult, error) {
@@ -54,7 +151,7 @@ func listServerHandler(value interface{}) (*grpcx.JSONResult, error) {
 
 	err := Store.GetServers(limit, func(data interface{}) error {
 		v := data.(*metapb.Server)
-		if int64(len(values)) < query.limit && v.ID > query.afterID {
+		if int64(len(values)) < query.limit && v.ID > query.afterID && matchTags(v.Tags, query.tags) {
 			values = append(values, v)
 		}
 		return nil
@@ -67,6 +164,98 @@ func listServerHandler(value interface{}) (*grpcx.JSONResult, error) {
 	return &grpcx.JSONResult{Data: values}, nil
 }
 
+// putServersDrainHandler drain or undrain all the servers that match the tags, returns the changed ids
+func putServersDrainHandler(value interface{}) (*grpcx.JSONResult, error) {
+	query := value.(*tagQuery)
+	var values []*metapb.Server
+
+	err := Store.GetServers(limit, func(data interface{}) error {
+		v := data.(*metapb.Server)
+		if matchTags(v.Tags, query.tags) && v.Drained != query.drained {
+			v.Drained = query.drained
+			values = append(values, v)
+		}
+		return nil
+	})
+	if err != nil {
+		log.Errorf("api-server-drain-put: req %+v, errors:%+v", value, err)
+		return nil, err
+	}
+
+	ids := make([]uint64, 0, len(values))
+	err = batchEach(len(values), func(from, to int) *rpcpb.BatchReq {
+		batch := &rpcpb.BatchReq{}
+		for _, v := range values[from:to] {
+			batch.PutServers = append(batch.PutServers, &rpcpb.PutServerReq{Server: *v})
+			ids = append(ids, v.ID)
+		}
+		return batch
+	})
+	if err != nil {
+		log.Errorf("api-server-drain-put: req %+v, errors:%+v", value, err)
+		return nil, err
+	}
+
+	return &grpcx.JSONResult{Data: ids}, nil
+}
+
+// putServerWeightHandler change the weight of the server, the weight ramps from the current weight
+// of the server, so the ramp in progress is changed smoothly
+func putServerWeightHandler(value interface{}) (*grpcx.JSONResult, error) {
+	query := value.(*weightQuery)
+	svr, err := Store.GetServer(query.id)
+	if err != nil {
+		log.Errorf("api-server-weight-put: req %+v, errors:%+v", value, err)
+		return nil, err
+	}
+
+	svr.Weight = pbutil.RampServerWeight(svr.Weight, query.target, query.ramp, time.Now().Unix())
+	_, err = Store.PutServer(svr)
+	if err != nil {
+		log.Errorf("api-server-weight-put: req %+v, errors:%+v", value, err)
+		return nil, err
+	}
+
+	return &grpcx.JSONResult{Data: svr.Weight}, nil
+}
+
+func weightQueryFactory(ctx echo.Context) (interface{}, error) {
+	id, err := idParamFactory(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query := &weightQuery{id: id.(uint64)}
+	target, err := format.ParseStrInt(ctx.QueryParam("weight"))
+	if err != nil {
+		return nil, err
+	}
+	if target < 0 || target > pbutil.MaxServerWeight {
+		return nil, fmt.Errorf("error weight: %d", target)
+	}
+	query.target = int32(target)
+
+	if value := ctx.QueryParam("ramp"); value != "" {
+		query.ramp, err = format.ParseStrInt64(value)
+		if err != nil {
+			return nil, err
+		}
+		if query.ramp < 0 {
+			return nil, fmt.Errorf("error ramp: %d", query.ramp)
+		}
+	}
+
+	return query, nil
+}
+
 func putServerFactory() interface{} {
 	return &metapb.Server{}
 }
+
+func batchServersFactory() interface{} {
+	return &batchServers{}
+}
+
+func heartbeatServersFactory() interface{} {
+	return &heartbeatServers{}
+}
@@ -12,10 +12,10 @@ type backup struct {
 
 func initSystemRouter(server *echo.Group) {
 	server.GET("/system",
-		grpcx.NewGetHTTPHandle(emptyParamFactory, getSystemHandler))
+		newGetHTTPHandle(emptyParamFactory, getSystemHandler))
 
 	server.POST("/system/backup",
-		grpcx.NewJSONBodyHTTPHandle(backupFactory, postBackupHandler))
+		newJSONBodyHTTPHandle(backupFactory, postBackupHandler))
 }
 
 func getSystemHandler(value interface{}) (*grpcx.JSONResult, error) {
@@ -0,0 +1,122 @@
+package service
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/fagongzi/gateway/pkg/pb/metapb"
+	"github.com/fagongzi/gateway/pkg/pb/rpcpb"
+	"github.com/fagongzi/util/format"
+	"github.com/labstack/echo"
+)
+
+// tagQuery is the bulk operation of the objects that match all the tags
+type tagQuery struct {
+	tags    []*metapb.PairValue
+	status  metapb.Status
+	drained bool
+}
+
+// parseTags parse the tag query params, the format is name=value, or name that matches any value
+func parseTags(ctx echo.Context) []*metapb.PairValue {
+	var tags []*metapb.PairValue
+	for _, value := range ctx.QueryParams()["tag"] {
+		if value == "" {
+			continue
+		}
+
+		tag := &metapb.PairValue{Name: value}
+		if idx := strings.Index(value, "="); idx >= 0 {
+			tag.Name, tag.Value = value[:idx], value[idx+1:]
+		}
+		tags = append(tags, tag)
+	}
+
+	return tags
+}
+
+// matchTags returns true if the tags contains all the expect tags
+func matchTags(tags, expects []*metapb.PairValue) bool {
+	for _, expect := range expects {
+		matched := false
+		for _, tag := range tags {
+			if tag.Name == expect.Name && (expect.Value == "" || tag.Value == expect.Value) {
+				matched = true
+				break
+			}
+		}
+
+		if !matched {
+			return false
+		}
+	}
+
+	return true
+}
+
+func tagQueryFactory(ctx echo.Context) (*tagQuery, error) {
+	query := &tagQuery{
+		tags: parseTags(ctx),
+	}
+
+	// avoid changing all the objects by mistake
+	if len(query.tags) == 0 {
+		return nil, fmt.Errorf("missing tag query value")
+	}
+
+	return query, nil
+}
+
+func tagStatusFactory(ctx echo.Context) (interface{}, error) {
+	query, err := tagQueryFactory(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	status, err := format.ParseStrInt(ctx.QueryParam("status"))
+	if err != nil {
+		return nil, err
+	}
+
+	query.status = metapb.Status(status)
+	if query.status != metapb.Up && query.status != metapb.Down {
+		return nil, fmt.Errorf("error status: %d", status)
+	}
+
+	return query, nil
+}
+
+func tagDrainFactory(ctx echo.Context) (interface{}, error) {
+	query, err := tagQueryFactory(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query.drained = true
+	if value := ctx.QueryParam("drained"); value != "" {
+		query.drained, err = strconv.ParseBool(value)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return query, nil
+}
+
+// batchEach put the n changed values to the store in batches, fn returns the batch of [from, to)
+func batchEach(n int, fn func(from, to int) *rpcpb.BatchReq) error {
+	for from := 0; from < n; from += int(limit) {
+		to := from + int(limit)
+		if to > n {
+			to = n
+		}
+
+		_, err := Store.Batch(fn(from, to))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,72 @@
+package service
+
+import (
+	"github.com/fagongzi/gateway/pkg/pb/metapb"
+	"github.com/fagongzi/grpcx"
+	"github.com/fagongzi/log"
+	"github.com/labstack/echo"
+)
+
+func initAPITemplateRouter(server *echo.Group) {
+	server.GET("/templates/:id",
+		newGetHTTPHandle(idParamFactory, getAPITemplateHandler))
+	server.DELETE("/templates/:id",
+		newGetHTTPHandle(idParamFactory, deleteAPITemplateHandler))
+	server.PUT("/templates",
+		newJSONBodyHTTPHandle(putAPITemplateFactory, postAPITemplateHandler))
+	server.GET("/templates",
+		newGetHTTPHandle(limitQueryFactory, listAPITemplateHandler))
+}
+
+func postAPITemplateHandler(value interface{}) (*grpcx.JSONResult, error) {
+	id, err := Store.PutAPITemplate(value.(*metapb.APITemplate))
+	if err != nil {
+		log.Errorf("api-template-put: req %+v, errors:%+v", value, err)
+		return nil, err
+	}
+
+	return &grpcx.JSONResult{Data: id}, nil
+}
+
+func deleteAPITemplateHandler(value interface{}) (*grpcx.JSONResult, error) {
+	err := Store.RemoveAPITemplate(value.(uint64))
+	if err != nil {
+		log.Errorf("api-template-delete: req %+v, errors:%+v", value, err)
+		return nil, err
+	}
+
+	return &grpcx.JSONResult{}, nil
+}
+
+func getAPITemplateHandler(value interface{}) (*grpcx.JSONResult, error) {
+	value, err := Store.GetAPITemplate(value.(uint64))
+	if err != nil {
+		log.Errorf("api-template-get: req %+v, errors:%+v", value, err)
+		return nil, err
+	}
+
+	return &grpcx.JSONResult{Data: value}, nil
+}
+
+func putAPITemplateFactory() interface{} {
+	return &metapb.APITemplate{}
+}
+
+func listAPITemplateHandler(value interface{}) (*grpcx.JSONResult, error) {
+	query := value.(*limitQuery)
+	var values []*metapb.APITemplate
+
+	err := Store.GetAPITemplates(limit, func(data interface{}) error {
+		v := data.(*metapb.APITemplate)
+		if int64(len(values)) < query.limit && v.ID > query.afterID {
+			values = append(values, v)
+		}
+		return nil
+	})
+	if err != nil {
+		log.Errorf("api-template-list-get: req %+v, errors:%+v", value, err)
+		return nil, err
+	}
+
+	return &grpcx.JSONResult{Data: values}, nil
+}
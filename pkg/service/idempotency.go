@@ -0,0 +1,190 @@
+package service
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/fagongzi/log"
+	"github.com/labstack/echo"
+)
+
+const (
+	idempotencyKeyHeader      = "Idempotency-Key"
+	idempotentReplayedHeader  = "Idempotent-Replayed"
+	maxIdempotencyKeyLength   = 255
+	defaultIdempotencyKeysTTL = time.Minute * 10
+)
+
+var (
+	idempotency = &idempotencyCache{
+		ttl:     defaultIdempotencyKeysTTL,
+		entries: make(map[string]*idempotentResult),
+	}
+)
+
+// SetIdempotencyKeysTTL set the duration that the results of the admin mutations with the
+// Idempotency-Key header are replayed, 0 means disable
+func SetIdempotencyKeysTTL(value time.Duration) {
+	idempotency.Lock()
+	idempotency.ttl = value
+	idempotency.Unlock()
+}
+
+// idempotencyCache is the results of the admin mutations by the idempotency keys, the results are kept
+// in the memory of the api server
+type idempotencyCache struct {
+	sync.Mutex
+
+	ttl       time.Duration
+	entries   map[string]*idempotentResult
+	expiredAt time.Time
+}
+
+// idempotentResult is the response of the mutation, the mutation is in progress if not done, the
+// fingerprint is the hash of the method, the uri and the body of the request
+type idempotentResult struct {
+	fingerprint [sha256.Size]byte
+	done        bool
+	status      int
+	contentType string
+	body        []byte
+	expireAt    time.Time
+}
+
+// idempotentWriter keep the written body of the response
+type idempotentWriter struct {
+	http.ResponseWriter
+	body bytes.Buffer
+}
+
+func (w *idempotentWriter) Write(p []byte) (int, error) {
+	w.body.Write(p)
+	return w.ResponseWriter.Write(p)
+}
+
+// idempotent replays the result of the admin mutation that has the same Idempotency-Key header in the
+// ttl, so the automation can retry the mutations after the network errors without duplicates. The
+// request that reuses a key with the different method, uri or body is rejected with 422, the request
+// that the key is in progress is rejected with 409. The failed mutations (5xx) are not kept
+func idempotent(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		req := ctx.Request()
+		key := req.Header.Get(idempotencyKeyHeader)
+		if key == "" || req.Method == http.MethodGet || req.Method == http.MethodHead ||
+			req.Method == http.MethodOptions {
+			return next(ctx)
+		}
+
+		idempotency.Lock()
+		ttl := idempotency.ttl
+		idempotency.Unlock()
+		if ttl <= 0 {
+			return next(ctx)
+		}
+
+		if len(key) > maxIdempotencyKeyLength {
+			return writeBadRequest(ctx, fmt.Errorf("%s header is longer than %d",
+				idempotencyKeyHeader,
+				maxIdempotencyKeyLength))
+		}
+
+		data, err := ioutil.ReadAll(req.Body)
+		if err != nil {
+			return writeBadRequest(ctx, err)
+		}
+		req.Body = ioutil.NopCloser(bytes.NewReader(data))
+
+		fingerprint := sha256.Sum256([]byte(fmt.Sprintf("%s\n%s\n%s", req.Method, req.URL.RequestURI(), data)))
+		result, ok := idempotency.acquire(key, fingerprint, time.Now())
+		if ok {
+			w := &idempotentWriter{ResponseWriter: ctx.Response().Writer}
+			ctx.Response().Writer = w
+			err = next(ctx)
+			ctx.Response().Writer = w.ResponseWriter
+			idempotency.release(key, result, err == nil && ctx.Response().Status < http.StatusInternalServerError,
+				ctx.Response().Status,
+				ctx.Response().Header().Get(echo.HeaderContentType),
+				w.body.Bytes())
+			return err
+		}
+
+		if result == nil {
+			return writeAPIError(ctx, http.StatusUnprocessableEntity, &apiError{
+				Code:    codeIdempotencyKeyReused,
+				Message: fmt.Sprintf("%s is used by a different request", idempotencyKeyHeader),
+			})
+		}
+
+		if !result.done {
+			return writeAPIError(ctx, http.StatusConflict, &apiError{
+				Code:    codeIdempotencyKeyInUse,
+				Message: fmt.Sprintf("the request of the %s is in progress", idempotencyKeyHeader),
+			})
+		}
+
+		log.Infof("api-idempotency: replay the result of key %s, %s %s", key, req.Method, req.URL.RequestURI())
+		ctx.Response().Header().Set(idempotentReplayedHeader, "true")
+		return ctx.Blob(result.status, result.contentType, result.body)
+	}
+}
+
+// acquire returns the result of the key and true if the request should be executed, otherwise returns a
+// copy of the result, the result is nil if the key is used by a different request
+func (c *idempotencyCache) acquire(key string, fingerprint [sha256.Size]byte, now time.Time) (*idempotentResult, bool) {
+	c.Lock()
+	defer c.Unlock()
+
+	c.expire(now)
+	if result, ok := c.entries[key]; ok && result.expireAt.After(now) {
+		if result.fingerprint != fingerprint {
+			return nil, false
+		}
+		value := *result
+		return &value, false
+	}
+
+	result := &idempotentResult{
+		fingerprint: fingerprint,
+		expireAt:    now.Add(c.ttl),
+	}
+	c.entries[key] = result
+	return result, true
+}
+
+// release keep the response of the executed request, or remove the key so the request can be retried
+func (c *idempotencyCache) release(key string, result *idempotentResult, keep bool, status int, contentType string, body []byte) {
+	c.Lock()
+	defer c.Unlock()
+
+	if !keep {
+		if c.entries[key] == result {
+			delete(c.entries, key)
+		}
+		return
+	}
+
+	result.done = true
+	result.status = status
+	result.contentType = contentType
+	result.body = body
+	result.expireAt = time.Now().Add(c.ttl)
+}
+
+// expire remove the expired results, at most once in a second
+func (c *idempotencyCache) expire(now time.Time) {
+	if now.Sub(c.expiredAt) < time.Second {
+		return
+	}
+
+	c.expiredAt = now
+	for key, result := range c.entries {
+		if !result.expireAt.After(now) {
+			delete(c.entries, key)
+		}
+	}
+}
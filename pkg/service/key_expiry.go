@@ -0,0 +1,143 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/fagongzi/gateway/pkg/pb/metapb"
+	"github.com/fagongzi/log"
+	"github.com/fagongzi/util/task"
+)
+
+const (
+	keyExpiryCheckInterval = time.Minute
+	keyExpiringEvent       = "api_key_expiring"
+)
+
+var (
+	webhookClient = &http.Client{
+		Timeout: time.Second * 5,
+	}
+)
+
+// keyExpiryNotification is the body of the key expiry webhook
+type keyExpiryNotification struct {
+	Event    string `json:"event"`
+	Consumer uint64 `json:"consumer"`
+	Name     string `json:"name"`
+	Key      string `json:"key"`
+	ExpireAt int64  `json:"expireAt"`
+}
+
+// StartKeyExpiryNotifier notify the consumers by the webhook before their keys expire, the notification
+// is sent once when the key is going to expire in the notice(secs). The webhook of the consumer is used
+// if set, otherwise the webhook. The notification is retried until the webhook returns 2xx
+func StartKeyExpiryNotifier(runner *task.Runner, webhook string, notice int64) {
+	if notice <= 0 {
+		log.Info("key-expiry: notifier disabled")
+		return
+	}
+
+	log.Info("key-expiry: notifier started")
+	runner.RunCancelableTask(func(ctx context.Context) {
+		t := time.NewTicker(keyExpiryCheckInterval)
+		defer t.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				log.Info("stop: key expiry notifier stopped")
+				return
+			case <-t.C:
+				err := notifyExpiringKeys(webhook, notice, time.Now().Unix())
+				if err != nil {
+					log.Errorf("key-expiry: check failed, errors:\n%+v", err)
+				}
+			}
+		}
+	})
+}
+
+func notifyExpiringKeys(webhook string, notice, now int64) error {
+	portalLock.Lock()
+	defer portalLock.Unlock()
+
+	var consumers []*metapb.Consumer
+	err := Store.GetConsumers(limit, func(data interface{}) error {
+		consumers = append(consumers, data.(*metapb.Consumer))
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, consumer := range consumers {
+		target := consumer.Webhook
+		if target == "" {
+			target = webhook
+		}
+		if target == "" {
+			continue
+		}
+
+		changed := false
+		for idx := range consumer.Keys {
+			key := &consumer.Keys[idx]
+			// notified in the current notice window, the window changes if the key is extended
+			if key.ExpireAt == 0 || key.ExpireAt <= now ||
+				key.ExpireAt-now > notice || key.NotifiedAt >= key.ExpireAt-notice {
+				continue
+			}
+
+			err := postWebhook(target, &keyExpiryNotification{
+				Event:    keyExpiringEvent,
+				Consumer: consumer.ID,
+				Name:     consumer.Name,
+				Key:      key.ID,
+				ExpireAt: key.ExpireAt,
+			})
+			if err != nil {
+				log.Warnf("key-expiry: notify key %s of consumer %s failed, errors:%+v",
+					key.ID,
+					consumer.Name,
+					err)
+				continue
+			}
+
+			key.NotifiedAt = now
+			changed = true
+		}
+
+		if changed {
+			_, err := Store.PutConsumer(consumer)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func postWebhook(url string, value interface{}) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+
+	rsp, err := webhookClient.Post(url, "application/json", bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	defer rsp.Body.Close()
+
+	if rsp.StatusCode < http.StatusOK || rsp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("webhook returns status code %d", rsp.StatusCode)
+	}
+
+	return nil
+}
@@ -0,0 +1,109 @@
+package service
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/fagongzi/gateway/pkg/pb/metapb"
+	"github.com/fagongzi/grpcx"
+)
+
+var (
+	proxyClient = &http.Client{
+		Timeout: time.Second * 3,
+	}
+)
+
+// getFromProxies call the manager api of all proxies in parallel,
+// the fn is called with the proxy and the data or the error of the proxy.
+func getFromProxies(path string, factory func() interface{}, fn func(*metapb.Proxy, interface{}, error)) error {
+	return requestProxies(http.MethodGet, path, factory, fn)
+}
+
+// deleteFromProxies call the delete manager api of all proxies in parallel, the same as getFromProxies
+func deleteFromProxies(path string, factory func() interface{}, fn func(*metapb.Proxy, interface{}, error)) error {
+	return requestProxies(http.MethodDelete, path, factory, fn)
+}
+
+func requestProxies(method, path string, factory func() interface{}, fn func(*metapb.Proxy, interface{}, error)) error {
+	var proxies []*metapb.Proxy
+	err := Store.GetProxies(limit, func(value *metapb.Proxy) error {
+		proxies = append(proxies, value)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	var lock sync.Mutex
+	var wg sync.WaitGroup
+	for _, proxy := range proxies {
+		wg.Add(1)
+		go func(proxy *metapb.Proxy) {
+			defer wg.Done()
+
+			value := factory()
+			err := requestProxy(proxy, method, path, nil, value)
+
+			lock.Lock()
+			fn(proxy, value, err)
+			lock.Unlock()
+		}(proxy)
+	}
+	wg.Wait()
+
+	return nil
+}
+
+func getFromProxy(proxy *metapb.Proxy, path string, value interface{}) error {
+	return requestProxy(proxy, http.MethodGet, path, nil, value)
+}
+
+func postToProxy(proxy *metapb.Proxy, path string, value interface{}) error {
+	return requestProxy(proxy, http.MethodPost, path, nil, value)
+}
+
+// postJSONToProxy post the body as json to the manager api of the proxy
+func postJSONToProxy(proxy *metapb.Proxy, path string, body, value interface{}) error {
+	return requestProxy(proxy, http.MethodPost, path, body, value)
+}
+
+func requestProxy(proxy *metapb.Proxy, method, path string, body, value interface{}) error {
+	if proxy.AddrRPC == "" {
+		return fmt.Errorf("proxy <%s> has no manager addr", proxy.Addr)
+	}
+
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequest(method, fmt.Sprintf("http://%s%s%s", proxy.AddrRPC, apiVersion, path), reader)
+	if err != nil {
+		return err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	rsp, err := proxyClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer rsp.Body.Close()
+
+	if rsp.StatusCode != http.StatusOK {
+		return fmt.Errorf("proxy <%s> returns status code %d", proxy.Addr, rsp.StatusCode)
+	}
+
+	return json.NewDecoder(rsp.Body).Decode(&grpcx.JSONResult{Data: value})
+}
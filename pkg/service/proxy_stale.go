@@ -0,0 +1,72 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/fagongzi/log"
+	"github.com/fagongzi/util/task"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	staleProxiesGauge = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: "gateway",
+			Subsystem: "apiserver",
+			Name:      "stale_proxies",
+			Help:      "Current number of the proxies that not applied the last meta change in time.",
+		})
+)
+
+func init() {
+	prometheus.Register(staleProxiesGauge)
+}
+
+// StartStaleProxyChecker check the proxies periodically, the stale proxies are logged and counted
+// in the metric, so the stuck watches are alerted before the routings are stale for long
+func StartStaleProxyChecker(runner *task.Runner, interval time.Duration) {
+	if interval <= 0 {
+		log.Info("stale-proxy: checker disabled")
+		return
+	}
+
+	log.Info("stale-proxy: checker started")
+	runner.RunCancelableTask(func(ctx context.Context) {
+		t := time.NewTicker(interval)
+		defer t.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				log.Info("stop: stale proxy checker stopped")
+				return
+			case <-t.C:
+				checkStaleProxies()
+			}
+		}
+	})
+}
+
+func checkStaleProxies() {
+	values, err := getProxiesStatus()
+	if err != nil {
+		log.Errorf("stale-proxy: check failed, errors:\n%+v", err)
+		return
+	}
+
+	stale := 0
+	for _, value := range values {
+		if !value.Stale {
+			continue
+		}
+
+		stale++
+		log.Warnf("stale-proxy: proxy %s applied revision %d, behind the store revision %d, resync by POST /v1/proxies/resync?proxy=%s",
+			value.Addr,
+			value.Propagation.Revision,
+			value.Revision,
+			value.Addr)
+	}
+	staleProxiesGauge.Set(float64(stale))
+}
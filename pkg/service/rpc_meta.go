@@ -156,6 +156,11 @@ func (s *metaService) PutAPI(ctx context.Context, req *rpcpb.PutAPIReq) (*rpcpb.
 	case <-ctx.Done():
 		return nil, errRPCCancel
 	default:
+		err := checkPutAPI(s.db, &req.API)
+		if err != nil {
+			return nil, err
+		}
+
 		id, err := s.db.PutAPI(&req.API)
 		if err != nil {
 			return nil, err
@@ -303,6 +308,11 @@ func (s *metaService) Batch(ctx context.Context, req *rpcpb.BatchReq) (*rpcpb.Ba
 	case <-ctx.Done():
 		return nil, errRPCCancel
 	default:
+		err := checkBatchAPIs(s.db, req)
+		if err != nil {
+			return nil, err
+		}
+
 		return s.db.Batch(req)
 	}
 }
@@ -0,0 +1,70 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/fagongzi/gateway/pkg/pb/metapb"
+	"github.com/fagongzi/log"
+	"github.com/fagongzi/util/task"
+)
+
+const (
+	usageDayFormat   = "2006-01-02"
+	usageMonthFormat = "2006-01"
+)
+
+// StartUsageReporter collect the consumer usages from all proxies every interval and save them
+// to the store for the usage reports, the usages older than the retention days are removed
+func StartUsageReporter(runner *task.Runner, interval time.Duration, retention int) {
+	if interval <= 0 {
+		log.Info("usage-report: reporter disabled")
+		return
+	}
+
+	log.Info("usage-report: reporter started")
+	runner.RunCancelableTask(func(ctx context.Context) {
+		t := time.NewTicker(interval)
+		defer t.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				log.Info("stop: usage reporter stopped")
+				return
+			case <-t.C:
+				err := collectUsages()
+				if err != nil {
+					log.Errorf("usage-report: collect failed, errors:\n%+v", err)
+				}
+
+				if retention > 0 {
+					err = Store.RemoveConsumerUsages(time.Now().AddDate(0, 0, -retention).Format(usageDayFormat))
+					if err != nil {
+						log.Errorf("usage-report: remove expired usages failed, errors:\n%+v", err)
+					}
+				}
+			}
+		}
+	})
+}
+
+func collectUsages() error {
+	var values []*metapb.ConsumerUsage
+	err := getFromProxies("/consumers/usage", consumersUsageFactory, func(proxy *metapb.Proxy, data interface{}, err error) {
+		if err != nil {
+			log.Warnf("usage-report: proxy %s skipped, errors:%+v", proxy.Addr, err)
+			return
+		}
+
+		for _, u := range *data.(*[]*metapb.ConsumerUsage) {
+			u.Proxy = proxy.Addr
+			values = append(values, u)
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	return Store.PutConsumerUsages(values)
+}
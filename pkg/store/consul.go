@@ -0,0 +1,343 @@
+package store
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// consulMaxTxnOps is the max ops in a consul txn
+	consulMaxTxnOps = 64
+	// consulBatchOps is the max ops of the batch txn, one op is reserved for the write time, and
+	// it is even, so the pair of the delete and the lock ops is not split
+	consulBatchOps = consulMaxTxnOps - 2
+	// consulMinSessionTTL is the min ttl of the consul session
+	consulMinSessionTTL = int64(10)
+)
+
+var (
+	// errConsulTxnAborted the consul txn is rolled back
+	errConsulTxnAborted = errors.New("consul txn aborted")
+)
+
+// consulKV is the key value of the consul kv store
+type consulKV struct {
+	Key         string
+	Value       []byte
+	CreateIndex uint64
+	ModifyIndex uint64
+	Session     string `json:",omitempty"`
+}
+
+// consulTxnOp is the kv op of the consul txn
+type consulTxnOp struct {
+	Verb    string
+	Key     string
+	Value   []byte `json:",omitempty"`
+	Index   uint64 `json:",omitempty"`
+	Session string `json:",omitempty"`
+}
+
+func consulSet(key, value string) consulTxnOp {
+	return consulTxnOp{Verb: "set", Key: key, Value: []byte(value)}
+}
+
+func consulLock(key, value, session string) consulTxnOp {
+	return consulTxnOp{Verb: "lock", Key: key, Value: []byte(value), Session: session}
+}
+
+func consulDelete(key string) consulTxnOp {
+	return consulTxnOp{Verb: "delete", Key: key}
+}
+
+func consulDeleteTree(key string) consulTxnOp {
+	return consulTxnOp{Verb: "delete-tree", Key: key}
+}
+
+// consulClient is a client of the consul http api, only the kv and the session api are used
+type consulClient struct {
+	addrs []string
+	cli   *http.Client
+}
+
+func newConsulClient(addrs []string) *consulClient {
+	return &consulClient{
+		addrs: addrs,
+		cli: &http.Client{
+			// the blocking queries wait at most consulWatchWait
+			Timeout: DefaultRequestTimeout + consulWatchWait,
+		},
+	}
+}
+
+// do send the request to the consul agents in order, until one of them is available, returns
+// the status code, the X-Consul-Index header and the body
+func (c *consulClient) do(method, path string, query url.Values, body interface{}) (int, uint64, []byte, error) {
+	var data []byte
+	if body != nil {
+		switch v := body.(type) {
+		case []byte:
+			data = v
+		default:
+			value, err := json.Marshal(body)
+			if err != nil {
+				return 0, 0, nil, err
+			}
+			data = value
+		}
+	}
+
+	var lastErr error
+	for _, addr := range c.addrs {
+		u := fmt.Sprintf("http://%s%s", addr, path)
+		if len(query) > 0 {
+			u = fmt.Sprintf("%s?%s", u, query.Encode())
+		}
+
+		var reader io.Reader
+		if data != nil {
+			reader = bytes.NewReader(data)
+		}
+
+		req, err := http.NewRequest(method, u, reader)
+		if err != nil {
+			return 0, 0, nil, err
+		}
+
+		rsp, err := c.cli.Do(req)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+
+		value, err := ioutil.ReadAll(rsp.Body)
+		rsp.Body.Close()
+		if err != nil {
+			lastErr = err
+			continue
+		}
+
+		index, _ := strconv.ParseUint(rsp.Header.Get("X-Consul-Index"), 10, 64)
+		return rsp.StatusCode, index, value, nil
+	}
+
+	return 0, 0, nil, lastErr
+}
+
+// list returns the key values with the prefix, the index is the index of the blocking query,
+// 0 means not blocking
+func (c *consulClient) list(prefix string, index uint64) ([]*consulKV, uint64, error) {
+	query := blockingQuery(index)
+	query.Set("recurse", "true")
+
+	code, index, data, err := c.do(http.MethodGet, c.kvPath(prefix), query, nil)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	switch code {
+	case http.StatusNotFound:
+		return nil, index, nil
+	case http.StatusOK:
+		var values []*consulKV
+		err = json.Unmarshal(data, &values)
+		for _, value := range values {
+			value.Key = consulKey(value.Key)
+		}
+		return values, index, err
+	}
+
+	return nil, 0, consulError(code, data)
+}
+
+// get returns the key value, nil means not found, the index is the index of the blocking query,
+// 0 means not blocking
+func (c *consulClient) get(key string, index uint64) (*consulKV, uint64, error) {
+	code, index, data, err := c.do(http.MethodGet, c.kvPath(key), blockingQuery(index), nil)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	switch code {
+	case http.StatusNotFound:
+		return nil, index, nil
+	case http.StatusOK:
+		var values []*consulKV
+		err = json.Unmarshal(data, &values)
+		if err != nil || len(values) == 0 {
+			return nil, index, err
+		}
+		values[0].Key = consulKey(values[0].Key)
+		return values[0], index, nil
+	}
+
+	return nil, 0, consulError(code, data)
+}
+
+// keys returns the keys with the prefix
+func (c *consulClient) keys(prefix string) ([]string, uint64, error) {
+	query := url.Values{}
+	query.Set("keys", "true")
+	code, index, data, err := c.do(http.MethodGet, c.kvPath(prefix), query, nil)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	switch code {
+	case http.StatusNotFound:
+		return nil, index, nil
+	case http.StatusOK:
+		var values []string
+		err = json.Unmarshal(data, &values)
+		for i := range values {
+			values[i] = consulKey(values[i])
+		}
+		return values, index, err
+	}
+
+	return nil, 0, consulError(code, data)
+}
+
+// cas put the value if the modify index of the key is not changed, 0 means the key is not exists
+func (c *consulClient) cas(key string, value []byte, index uint64) (bool, error) {
+	query := url.Values{}
+	query.Set("cas", strconv.FormatUint(index, 10))
+	code, _, data, err := c.do(http.MethodPut, c.kvPath(key), query, value)
+	if err != nil {
+		return false, err
+	}
+
+	if code != http.StatusOK {
+		return false, consulError(code, data)
+	}
+
+	return strings.TrimSpace(string(data)) == "true", nil
+}
+
+// txn execute the ops in a transaction
+func (c *consulClient) txn(ops []consulTxnOp) error {
+	type txnOp struct {
+		KV consulTxnOp
+	}
+
+	values := make([]txnOp, 0, len(ops))
+	for _, op := range ops {
+		op.Key = strings.TrimPrefix(op.Key, "/")
+		values = append(values, txnOp{KV: op})
+	}
+
+	code, _, data, err := c.do(http.MethodPut, "/v1/txn", nil, values)
+	if err != nil {
+		return err
+	}
+
+	switch code {
+	case http.StatusOK:
+		return nil
+	case http.StatusConflict:
+		return fmt.Errorf("%w: %s", errConsulTxnAborted, data)
+	}
+
+	return consulError(code, data)
+}
+
+// createSession create a session with the ttl, the keys locked by the session are deleted after the
+// session expired
+func (c *consulClient) createSession(name string, ttl int64) (string, error) {
+	if ttl < consulMinSessionTTL {
+		ttl = consulMinSessionTTL
+	}
+
+	body := map[string]interface{}{
+		"Name":      name,
+		"TTL":       fmt.Sprintf("%ds", ttl),
+		"Behavior":  "delete",
+		"LockDelay": "0s",
+	}
+	code, _, data, err := c.do(http.MethodPut, "/v1/session/create", nil, body)
+	if err != nil {
+		return "", err
+	}
+
+	if code != http.StatusOK {
+		return "", consulError(code, data)
+	}
+
+	value := struct{ ID string }{}
+	err = json.Unmarshal(data, &value)
+	if err != nil {
+		return "", err
+	}
+
+	return value.ID, nil
+}
+
+// renewSession renew the ttl of the session, returns false if the session is already expired
+func (c *consulClient) renewSession(id string) (bool, error) {
+	code, _, data, err := c.do(http.MethodPut, fmt.Sprintf("/v1/session/renew/%s", id), nil, nil)
+	if err != nil {
+		return false, err
+	}
+
+	switch code {
+	case http.StatusOK:
+		return true, nil
+	case http.StatusNotFound:
+		return false, nil
+	}
+
+	return false, consulError(code, data)
+}
+
+// keepSession renew the session every 1/3 ttl until the session is expired
+func (c *consulClient) keepSession(id string, ttl int64) {
+	if ttl < consulMinSessionTTL {
+		ttl = consulMinSessionTTL
+	}
+
+	ticker := time.NewTicker(time.Second * time.Duration(ttl) / 3)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		ok, err := c.renewSession(id)
+		if err != nil {
+			continue
+		}
+
+		if !ok {
+			return
+		}
+	}
+}
+
+// kvPath returns the path of the key, the keys of the store start with "/", but the keys of
+// consul not
+func (c *consulClient) kvPath(key string) string {
+	return fmt.Sprintf("/v1/kv/%s", strings.TrimPrefix(key, "/"))
+}
+
+func consulKey(key string) string {
+	return fmt.Sprintf("/%s", key)
+}
+
+func blockingQuery(index uint64) url.Values {
+	query := url.Values{}
+	if index > 0 {
+		query.Set("index", strconv.FormatUint(index, 10))
+		query.Set("wait", fmt.Sprintf("%ds", int(consulWatchWait.Seconds())))
+	}
+	return query
+}
+
+func consulError(code int, data []byte) error {
+	return fmt.Errorf("consul response %d: %s", code, strings.TrimSpace(string(data)))
+}
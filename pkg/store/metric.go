@@ -0,0 +1,89 @@
+package store
+
+import (
+	"time"
+
+	"github.com/coreos/etcd/clientv3"
+	"github.com/fagongzi/log"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	opTypeGet    = "get"
+	opTypePut    = "put"
+	opTypeDelete = "delete"
+	opTypeTxn    = "txn"
+	opTypeLease  = "lease"
+
+	opResultSucceed = "succeed"
+	opResultFail    = "fail"
+)
+
+var (
+	opCounterVec = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "gateway",
+			Subsystem: "store",
+			Name:      "op_total",
+			Help:      "Total number of store operation made.",
+		}, []string{"type", "result"})
+
+	opDurationHistogramVec = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: "gateway",
+			Subsystem: "store",
+			Name:      "op_duration_seconds",
+			Help:      "Bucketed histogram of store operation duration",
+			Buckets:   prometheus.ExponentialBuckets(0.0005, 2.0, 20),
+		}, []string{"type"})
+
+	opBytesHistogramVec = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: "gateway",
+			Subsystem: "store",
+			Name:      "op_bytes",
+			Help:      "Bucketed histogram of store operation payload size",
+			Buckets:   prometheus.ExponentialBuckets(64, 2.0, 16),
+		}, []string{"type"})
+)
+
+func init() {
+	prometheus.Register(opCounterVec)
+	prometheus.Register(opDurationHistogramVec)
+	prometheus.Register(opBytesHistogramVec)
+}
+
+// observeOP record the store operation metrics, and log the slow one
+func observeOP(opType, key string, size int, startAt time.Time, err error) {
+	cost := time.Now().Sub(startAt)
+
+	result := opResultSucceed
+	if err != nil {
+		result = opResultFail
+	}
+
+	opCounterVec.WithLabelValues(opType, result).Inc()
+	opDurationHistogramVec.WithLabelValues(opType).Observe(cost.Seconds())
+	opBytesHistogramVec.WithLabelValues(opType).Observe(float64(size))
+
+	if cost > SlowRequestTime {
+		log.Warnf("slow: store %s runs too slow, key=<%s> size=<%d> cost=<%s> errors:\n%+v",
+			opType,
+			key,
+			size,
+			cost,
+			err)
+	}
+}
+
+func getResponseSize(resp *clientv3.GetResponse) int {
+	if resp == nil {
+		return 0
+	}
+
+	size := 0
+	for _, kv := range resp.Kvs {
+		size += len(kv.Key) + len(kv.Value)
+	}
+	return size
+}
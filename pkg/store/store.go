@@ -16,6 +16,8 @@ var (
 	TICKER = time.Second * 3
 	// TTL timeout
 	TTL = int64(5)
+	// SlowRequestTime the store operation slower than this will be logged
+	SlowRequestTime = DefaultSlowRequestTime
 )
 
 var (
@@ -50,18 +52,36 @@ const (
 	EventSrcRouting = EvtSrc(4)
 	// EventSrcProxy routing event
 	EventSrcProxy = EvtSrc(5)
+	// EventSrcProxyOverride proxy override event
+	EventSrcProxyOverride = EvtSrc(6)
+	// EventSrcAPITemplate api template event
+	EventSrcAPITemplate = EvtSrc(7)
+	// EventSrcConsumer consumer event
+	EventSrcConsumer = EvtSrc(8)
+	// EventSrcKillSwitch kill switch event
+	EventSrcKillSwitch = EvtSrc(9)
+	// EventSrcPolicy global policy event
+	EventSrcPolicy = EvtSrc(10)
+	// EventSrcRateLimit rate limit event
+	EventSrcRateLimit = EvtSrc(11)
+	// EventSrcProtoDescriptor proto descriptor event
+	EventSrcProtoDescriptor = EvtSrc(12)
 )
 
-// Evt event
+// Evt is the watched event, revision is the store revision of the event, writeAt is the unix
+// nanos that the change written to the store, 0 means unknown
 type Evt struct {
-	Src   EvtSrc
-	Type  EvtType
-	Key   string
-	Value interface{}
+	Src      EvtSrc
+	Type     EvtType
+	Key      string
+	Value    interface{}
+	Revision int64
+	WriteAt  int64
 }
 
 func init() {
 	supportSchema["etcd"] = getEtcdStoreFrom
+	supportSchema["consul"] = getConsulStoreFrom
 }
 
 // GetStoreFrom returns a store implemention, if not support returns error
@@ -109,12 +129,19 @@ type Store interface {
 	RemoveServer(id uint64) error
 	GetServers(limit int64, fn func(interface{}) error) error
 	GetServer(id uint64) (*metapb.Server, error)
+	RegisterServers(servers []*metapb.Server, ttl int64) ([]uint64, error)
+	HeartbeatServers(ids []uint64) ([]uint64, error)
 
 	PutAPI(api *metapb.API) (uint64, error)
 	RemoveAPI(id uint64) error
 	GetAPIs(limit int64, fn func(interface{}) error) error
 	GetAPI(id uint64) (*metapb.API, error)
 
+	PutAPITemplate(tpl *metapb.APITemplate) (uint64, error)
+	RemoveAPITemplate(id uint64) error
+	GetAPITemplates(limit int64, fn func(interface{}) error) error
+	GetAPITemplate(id uint64) (*metapb.APITemplate, error)
+
 	PutRouting(routing *metapb.Routing) (uint64, error)
 	RemoveRouting(id uint64) error
 	GetRoutings(limit int64, fn func(interface{}) error) error
@@ -123,13 +150,57 @@ type Store interface {
 	RegistryProxy(proxy *metapb.Proxy, ttl int64) error
 	GetProxies(limit int64, fn func(*metapb.Proxy) error) error
 
+	PutProxyOverride(value *metapb.ProxyOverride) (uint64, error)
+	RemoveProxyOverride(id uint64) error
+	GetProxyOverrides(limit int64, fn func(interface{}) error) error
+	GetProxyOverride(id uint64) (*metapb.ProxyOverride, error)
+
+	PutKillSwitch(value *metapb.KillSwitch) error
+	RemoveKillSwitch() error
+	GetKillSwitch() (*metapb.KillSwitch, error)
+
+	PutPolicy(value *metapb.Policy) error
+	RemovePolicy() error
+	GetPolicy() (*metapb.Policy, error)
+
+	PutConsumer(value *metapb.Consumer) (uint64, error)
+	RemoveConsumer(id uint64) error
+	GetConsumers(limit int64, fn func(interface{}) error) error
+	GetConsumer(id uint64) (*metapb.Consumer, error)
+	GetConsumerByOwner(owner string) (*metapb.Consumer, error)
+
+	PutRateLimit(value *metapb.RateLimit) (uint64, error)
+	RemoveRateLimit(id uint64) error
+	GetRateLimits(limit int64, fn func(interface{}) error) error
+	GetRateLimit(id uint64) (*metapb.RateLimit, error)
+
+	PutProtoDescriptor(value *metapb.ProtoDescriptor) (uint64, error)
+	RemoveProtoDescriptor(id uint64) error
+	GetProtoDescriptors(limit int64, fn func(interface{}) error) error
+	GetProtoDescriptor(id uint64) (*metapb.ProtoDescriptor, error)
+
+	PutChangeset(value *metapb.Changeset) (uint64, error)
+	GetChangesets(limit int64, fn func(interface{}) error) error
+	GetChangeset(id uint64) (*metapb.Changeset, error)
+
+	PutConsumerUsages(values []*metapb.ConsumerUsage) error
+	GetConsumerUsages(from, to string, fn func(*metapb.ConsumerUsage) error) error
+	RemoveConsumerUsages(before string) error
+
+	PutHealthTransition(value *metapb.HealthTransition) error
+	GetHealthTransitions(fn func(*metapb.HealthTransition) error) error
+
 	Watch(evtCh chan *Evt, stopCh chan bool) error
+	WatchKillSwitch(evtCh chan *Evt, stopCh chan bool) error
 
 	Clean() error
 	SetID(id uint64) error
 	BackupTo(to string) error
 	Batch(batch *rpcpb.BatchReq) (*rpcpb.BatchRsp, error)
 	System() (*metapb.System, error)
+	Diff(from, to int64) (*metapb.MetaDiff, error)
+	Revert(records []metapb.ChangeRecord, force bool) (int64, error)
+	GetLastWrite() (revision int64, writeAt int64, err error)
 }
 
 func getKey(prefix string, id uint64) string {
@@ -0,0 +1,198 @@
+package store
+
+import (
+	"time"
+
+	"github.com/fagongzi/gateway/pkg/client"
+	"github.com/fagongzi/gateway/pkg/pb/metapb"
+	"github.com/fagongzi/gateway/pkg/pb/rpcpb"
+)
+
+// metaReader reads the meta from the store, the caller holds the lock of the store
+type metaReader interface {
+	getValues(prefix string, limit int64, factory func() pb, fn func(interface{}) error) error
+	doGetBindServers(id uint64) ([]uint64, error)
+	getID() (uint64, error)
+}
+
+// backupTo backup the meta to other gateway
+func backupTo(src metaReader, dirs *metaDirs, to string) error {
+	targetC, err := client.NewClient(time.Second*10, to)
+	if err != nil {
+		return err
+	}
+
+	defer targetC.Close()
+
+	// Clean
+	err = targetC.Clean()
+	if err != nil {
+		return err
+	}
+
+	limit := int64(96)
+	batch := &rpcpb.BatchReq{}
+
+	// backup server
+	err = src.getValues(dirs.serversDir, limit, func() pb { return &metapb.Server{} }, func(value interface{}) error {
+		batch.PutServers = append(batch.PutServers, &rpcpb.PutServerReq{
+			Server: *value.(*metapb.Server),
+		})
+
+		if int64(len(batch.PutServers)) == limit {
+			_, err := targetC.Batch(batch)
+			if err != nil {
+				return err
+			}
+
+			batch = &rpcpb.BatchReq{}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if int64(len(batch.PutServers)) > 0 {
+		_, err := targetC.Batch(batch)
+		if err != nil {
+			return err
+		}
+	}
+
+	// backup cluster
+	batch = &rpcpb.BatchReq{}
+	err = src.getValues(dirs.clustersDir, limit, func() pb { return &metapb.Cluster{} }, func(value interface{}) error {
+		batch.PutClusters = append(batch.PutClusters, &rpcpb.PutClusterReq{
+			Cluster: *value.(*metapb.Cluster),
+		})
+
+		if int64(len(batch.PutClusters)) == limit {
+			_, err := targetC.Batch(batch)
+			if err != nil {
+				return err
+			}
+
+			batch = &rpcpb.BatchReq{}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if int64(len(batch.PutClusters)) > 0 {
+		_, err := targetC.Batch(batch)
+		if err != nil {
+			return err
+		}
+	}
+
+	// backup binds
+	batch = &rpcpb.BatchReq{}
+	err = src.getValues(dirs.clustersDir, limit, func() pb { return &metapb.Cluster{} }, func(value interface{}) error {
+		cid := value.(*metapb.Cluster).ID
+		servers, err := src.doGetBindServers(cid)
+		if err != nil {
+			return err
+		}
+
+		for _, sid := range servers {
+			batch.AddBinds = append(batch.AddBinds, &rpcpb.AddBindReq{
+				Cluster: cid,
+				Server:  sid,
+			})
+
+			if int64(len(batch.AddBinds)) == limit {
+				_, err := targetC.Batch(batch)
+				if err != nil {
+					return err
+				}
+
+				batch = &rpcpb.BatchReq{}
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if int64(len(batch.AddBinds)) > 0 {
+		_, err := targetC.Batch(batch)
+		if err != nil {
+			return err
+		}
+
+		batch = &rpcpb.BatchReq{}
+	}
+
+	// backup apis
+	batch = &rpcpb.BatchReq{}
+	err = src.getValues(dirs.apisDir, limit, func() pb { return &metapb.API{} }, func(value interface{}) error {
+		batch.PutAPIs = append(batch.PutAPIs, &rpcpb.PutAPIReq{
+			API: *value.(*metapb.API),
+		})
+
+		if int64(len(batch.PutAPIs)) == limit {
+			_, err := targetC.Batch(batch)
+			if err != nil {
+				return err
+			}
+
+			batch = &rpcpb.BatchReq{}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if int64(len(batch.PutAPIs)) > 0 {
+		_, err := targetC.Batch(batch)
+		if err != nil {
+			return err
+		}
+	}
+
+	// backup routings
+	batch = &rpcpb.BatchReq{}
+	err = src.getValues(dirs.routingsDir, limit, func() pb { return &metapb.Routing{} }, func(value interface{}) error {
+		batch.PutRoutings = append(batch.PutRoutings, &rpcpb.PutRoutingReq{
+			Routing: *value.(*metapb.Routing),
+		})
+
+		if int64(len(batch.PutRoutings)) == limit {
+			_, err := targetC.Batch(batch)
+			if err != nil {
+				return err
+			}
+
+			batch = &rpcpb.BatchReq{}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if int64(len(batch.PutRoutings)) > 0 {
+		_, err := targetC.Batch(batch)
+		if err != nil {
+			return err
+		}
+	}
+
+	// backup id
+	currID, err := src.getID()
+	if err != nil {
+		return err
+	}
+
+	return targetC.SetID(currID)
+}
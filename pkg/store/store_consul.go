@@ -0,0 +1,1410 @@
+package store
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/coreos/etcd/mvcc/mvccpb"
+	pbutil "github.com/fagongzi/gateway/pkg/pb"
+	"github.com/fagongzi/gateway/pkg/pb/metapb"
+	"github.com/fagongzi/gateway/pkg/pb/rpcpb"
+	"github.com/fagongzi/util/format"
+)
+
+var (
+	// ErrDiffNotSupported the store keeps no history of the meta, so the revisions can not be compared or
+	// reverted
+	ErrDiffNotSupported = errors.New("diff and revert are not supported by the consul store, use the etcd store")
+)
+
+// ConsulStore consul store impl, the meta is saved in the consul kv store with the same layout of
+// the etcd store. The ttl of the proxies and the servers is implemented by the consul sessions
+type ConsulStore struct {
+	sync.RWMutex
+	metaDirs
+
+	idLock sync.Mutex
+	base   uint64
+	end    uint64
+
+	watchMethodMapping map[EvtSrc]func(EvtType, *mvccpb.KeyValue) *Evt
+
+	rawClient *consulClient
+}
+
+func getConsulStoreFrom(addr, prefix string) (Store, error) {
+	return NewConsulStore(strings.Split(addr, ","), prefix)
+}
+
+// NewConsulStore create a consul store
+func NewConsulStore(consulAddrs []string, prefix string) (Store, error) {
+	// the keys of the store start with "/"
+	prefix = fmt.Sprintf("/%s", strings.TrimPrefix(prefix, "/"))
+	store := &ConsulStore{
+		metaDirs:  newMetaDirs(prefix),
+		base:      100,
+		end:       100,
+		rawClient: newConsulClient(consulAddrs),
+	}
+	store.watchMethodMapping = store.watchMethods()
+
+	_, _, err := store.rawClient.get(store.idPath, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return store, nil
+}
+
+// Raw returns the raw client
+func (e *ConsulStore) Raw() interface{} {
+	return e.rawClient
+}
+
+// AddBind bind a server to a cluster
+func (e *ConsulStore) AddBind(bind *metapb.Bind) error {
+	e.Lock()
+	defer e.Unlock()
+
+	data, err := bind.Marshal()
+	if err != nil {
+		return err
+	}
+
+	return e.put(e.getBindKey(bind), string(data))
+}
+
+// Batch batch update, the ops of each kind are written in the txns of at most consulBatchOps
+func (e *ConsulStore) Batch(batch *rpcpb.BatchReq) (*rpcpb.BatchRsp, error) {
+	e.Lock()
+	defer e.Unlock()
+
+	rsp := &rpcpb.BatchRsp{}
+	ops := make([]consulTxnOp, 0, len(batch.PutServers))
+	for _, req := range batch.PutServers {
+		value := &req.Server
+		err := pbutil.ValidateServer(value)
+		if err != nil {
+			return nil, err
+		}
+
+		op, err := e.putPBWithOp(e.serversDir, value, func(id uint64) {
+			value.ID = id
+			rsp.PutServers = append(rsp.PutServers, &rpcpb.PutServerRsp{
+				ID: id,
+			})
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		ops = append(ops, op)
+	}
+	err := e.putBatch(ops...)
+	if err != nil {
+		return nil, err
+	}
+
+	ops = make([]consulTxnOp, 0, len(batch.PutClusters))
+	for _, req := range batch.PutClusters {
+		value := &req.Cluster
+		err := pbutil.ValidateCluster(value)
+		if err != nil {
+			return nil, err
+		}
+
+		op, err := e.putPBWithOp(e.clustersDir, value, func(id uint64) {
+			value.ID = id
+			rsp.PutClusters = append(rsp.PutClusters, &rpcpb.PutClusterRsp{
+				ID: id,
+			})
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		ops = append(ops, op)
+	}
+	err = e.putBatch(ops...)
+	if err != nil {
+		return nil, err
+	}
+
+	ops = make([]consulTxnOp, 0, len(batch.AddBinds))
+	for _, req := range batch.AddBinds {
+		value := &metapb.Bind{
+			ClusterID: req.Cluster,
+			ServerID:  req.Server,
+		}
+
+		data, err := value.Marshal()
+		if err != nil {
+			return nil, err
+		}
+
+		ops = append(ops, consulSet(e.getBindKey(value), string(data)))
+		rsp.AddBinds = append(rsp.AddBinds, &rpcpb.AddBindRsp{})
+	}
+	err = e.putBatch(ops...)
+	if err != nil {
+		return nil, err
+	}
+
+	ops = make([]consulTxnOp, 0, len(batch.PutAPIs))
+	for _, req := range batch.PutAPIs {
+		value := &req.API
+		err := pbutil.ValidateAPI(value)
+		if err != nil {
+			return nil, err
+		}
+
+		op, err := e.putPBWithOp(e.apisDir, value, func(id uint64) {
+			value.ID = id
+			rsp.PutAPIs = append(rsp.PutAPIs, &rpcpb.PutAPIRsp{
+				ID: id,
+			})
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		ops = append(ops, op)
+	}
+	err = e.putBatch(ops...)
+	if err != nil {
+		return nil, err
+	}
+
+	ops = make([]consulTxnOp, 0, len(batch.PutRoutings))
+	for _, req := range batch.PutRoutings {
+		value := &req.Routing
+		err := pbutil.ValidateRouting(value)
+		if err != nil {
+			return nil, err
+		}
+
+		op, err := e.putPBWithOp(e.routingsDir, value, func(id uint64) {
+			value.ID = id
+			rsp.PutRoutings = append(rsp.PutRoutings, &rpcpb.PutRoutingRsp{
+				ID: id,
+			})
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		ops = append(ops, op)
+	}
+	err = e.putBatch(ops...)
+	if err != nil {
+		return nil, err
+	}
+
+	return rsp, nil
+}
+
+// RemoveBind remove bind
+func (e *ConsulStore) RemoveBind(bind *metapb.Bind) error {
+	e.Lock()
+	defer e.Unlock()
+
+	return e.txn(consulDelete(e.getBindKey(bind)))
+}
+
+// RemoveClusterBind remove cluster all bind servers
+func (e *ConsulStore) RemoveClusterBind(id uint64) error {
+	e.Lock()
+	defer e.Unlock()
+
+	return e.txn(consulDeleteTree(e.getClusterBindPrefix(id)))
+}
+
+// GetBindServers return cluster binds servers
+func (e *ConsulStore) GetBindServers(id uint64) ([]uint64, error) {
+	e.RLock()
+	defer e.RUnlock()
+
+	return e.doGetBindServers(id)
+}
+
+func (e *ConsulStore) doGetBindServers(id uint64) ([]uint64, error) {
+	kvs, err := e.list(e.getClusterBindPrefix(id))
+	if err != nil {
+		return nil, err
+	}
+
+	var values []uint64
+	for _, item := range kvs {
+		v := &metapb.Bind{}
+		err := v.Unmarshal(item.Value)
+		if err != nil {
+			return nil, err
+		}
+
+		values = append(values, v.ServerID)
+	}
+
+	return values, nil
+}
+
+// PutCluster add or update the cluster
+func (e *ConsulStore) PutCluster(value *metapb.Cluster) (uint64, error) {
+	e.Lock()
+	defer e.Unlock()
+
+	err := pbutil.ValidateCluster(value)
+	if err != nil {
+		return 0, err
+	}
+
+	return e.putPB(e.clustersDir, value, func(id uint64) {
+		value.ID = id
+	})
+}
+
+// RemoveCluster remove the cluster and it's binds
+func (e *ConsulStore) RemoveCluster(id uint64) error {
+	e.Lock()
+	defer e.Unlock()
+
+	return e.txn(consulDelete(getKey(e.clustersDir, id)),
+		consulDeleteTree(e.getClusterBindPrefix(id)))
+}
+
+// GetClusters returns all clusters
+func (e *ConsulStore) GetClusters(limit int64, fn func(interface{}) error) error {
+	e.RLock()
+	defer e.RUnlock()
+
+	return e.getValues(e.clustersDir, limit, func() pb { return &metapb.Cluster{} }, fn)
+}
+
+// GetCluster returns the cluster
+func (e *ConsulStore) GetCluster(id uint64) (*metapb.Cluster, error) {
+	e.RLock()
+	defer e.RUnlock()
+
+	value := &metapb.Cluster{}
+	return value, e.getPB(e.clustersDir, id, value)
+}
+
+// PutServer add or update the server
+func (e *ConsulStore) PutServer(value *metapb.Server) (uint64, error) {
+	e.Lock()
+	defer e.Unlock()
+
+	err := pbutil.ValidateServer(value)
+	if err != nil {
+		return 0, err
+	}
+
+	return e.putPB(e.serversDir, value, func(id uint64) {
+		value.ID = id
+	})
+}
+
+// RemoveServer remove the server
+func (e *ConsulStore) RemoveServer(id uint64) error {
+	e.Lock()
+	defer e.Unlock()
+
+	return e.txn(consulDelete(getKey(e.serversDir, id)),
+		consulDeleteTree(e.getServerHealthPrefix(id)))
+}
+
+// GetServers returns all server
+func (e *ConsulStore) GetServers(limit int64, fn func(interface{}) error) error {
+	e.RLock()
+	defer e.RUnlock()
+
+	return e.getValues(e.serversDir, limit, func() pb { return &metapb.Server{} }, fn)
+}
+
+// GetServer returns the server
+func (e *ConsulStore) GetServer(id uint64) (*metapb.Server, error) {
+	e.RLock()
+	defer e.RUnlock()
+
+	value := &metapb.Server{}
+	return value, e.getPB(e.serversDir, id, value)
+}
+
+// RegisterServers add or update the servers, if ttl > 0, the servers
+// are locked by a consul session, and removed after ttl seconds(at least 10) without heartbeat
+func (e *ConsulStore) RegisterServers(servers []*metapb.Server, ttl int64) ([]uint64, error) {
+	e.Lock()
+	defer e.Unlock()
+
+	session := ""
+	if ttl > 0 {
+		start := time.Now()
+		id, err := e.rawClient.createSession(e.serversDir, ttl)
+		observeOP(opTypeLease, e.serversDir, 0, start, err)
+		if err != nil {
+			return nil, err
+		}
+
+		session = id
+	}
+
+	ids := make([]uint64, 0, len(servers))
+	ops := make([]consulTxnOp, 0, len(servers))
+	for _, value := range servers {
+		err := pbutil.ValidateServer(value)
+		if err != nil {
+			return nil, err
+		}
+
+		if value.ID == 0 {
+			value.ID, err = e.allocID()
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		data, err := value.Marshal()
+		if err != nil {
+			return nil, err
+		}
+
+		key := getKey(e.serversDir, value.ID)
+		ids = append(ids, value.ID)
+		if session == "" {
+			ops = append(ops, consulSet(key, string(data)))
+		} else {
+			// the key maybe locked by the expired session of the previous registration
+			ops = append(ops, consulDelete(key), consulLock(key, string(data), session))
+		}
+	}
+
+	err := e.putBatch(ops...)
+	if err != nil {
+		return nil, err
+	}
+
+	return ids, nil
+}
+
+// HeartbeatServers keep the servers registered with ttl alive, returns the servers
+// that are already expired
+func (e *ConsulStore) HeartbeatServers(ids []uint64) ([]uint64, error) {
+	e.RLock()
+	defer e.RUnlock()
+
+	var expired []uint64
+	sessions := make(map[string]struct{})
+	for _, id := range ids {
+		kv, err := e.get(getKey(e.serversDir, id))
+		if err != nil {
+			return nil, err
+		}
+
+		if kv == nil {
+			expired = append(expired, id)
+			continue
+		}
+
+		if kv.Session != "" {
+			sessions[kv.Session] = struct{}{}
+		}
+	}
+
+	for id := range sessions {
+		start := time.Now()
+		_, err := e.rawClient.renewSession(id)
+		observeOP(opTypeLease, e.serversDir, 0, start, err)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return expired, nil
+}
+
+// PutAPI add or update a API
+func (e *ConsulStore) PutAPI(value *metapb.API) (uint64, error) {
+	e.Lock()
+	defer e.Unlock()
+
+	err := pbutil.ValidateAPI(value)
+	if err != nil {
+		return 0, err
+	}
+
+	if value.Template > 0 {
+		err = e.getPB(e.tplsDir, value.Template, &metapb.APITemplate{})
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	return e.putPB(e.apisDir, value, func(id uint64) {
+		value.ID = id
+	})
+}
+
+// RemoveAPI remove a api from store
+func (e *ConsulStore) RemoveAPI(id uint64) error {
+	e.Lock()
+	defer e.Unlock()
+
+	return e.txn(consulDelete(getKey(e.apisDir, id)))
+}
+
+// GetAPIs returns all api
+func (e *ConsulStore) GetAPIs(limit int64, fn func(interface{}) error) error {
+	e.RLock()
+	defer e.RUnlock()
+
+	return e.getValues(e.apisDir, limit, func() pb { return &metapb.API{} }, fn)
+}
+
+// GetAPI returns the api
+func (e *ConsulStore) GetAPI(id uint64) (*metapb.API, error) {
+	e.RLock()
+	defer e.RUnlock()
+
+	value := &metapb.API{}
+	return value, e.getPB(e.apisDir, id, value)
+}
+
+// PutAPITemplate add or update a api template
+func (e *ConsulStore) PutAPITemplate(value *metapb.APITemplate) (uint64, error) {
+	e.Lock()
+	defer e.Unlock()
+
+	err := pbutil.ValidateAPITemplate(value)
+	if err != nil {
+		return 0, err
+	}
+
+	return e.putPB(e.tplsDir, value, func(id uint64) {
+		value.ID = id
+	})
+}
+
+// RemoveAPITemplate remove a api template from store, the template used by apis can not be removed
+func (e *ConsulStore) RemoveAPITemplate(id uint64) error {
+	e.Lock()
+	defer e.Unlock()
+
+	inUse := false
+	err := e.getValues(e.apisDir, scanLimit, func() pb { return &metapb.API{} }, func(value interface{}) error {
+		if value.(*metapb.API).Template == id {
+			inUse = true
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if inUse {
+		return ErrTemplateInUse
+	}
+
+	return e.txn(consulDelete(getKey(e.tplsDir, id)))
+}
+
+// GetAPITemplates returns all api templates
+func (e *ConsulStore) GetAPITemplates(limit int64, fn func(interface{}) error) error {
+	e.RLock()
+	defer e.RUnlock()
+
+	return e.getValues(e.tplsDir, limit, func() pb { return &metapb.APITemplate{} }, fn)
+}
+
+// GetAPITemplate returns the api template
+func (e *ConsulStore) GetAPITemplate(id uint64) (*metapb.APITemplate, error) {
+	e.RLock()
+	defer e.RUnlock()
+
+	value := &metapb.APITemplate{}
+	return value, e.getPB(e.tplsDir, id, value)
+}
+
+// PutRouting add or update routing
+func (e *ConsulStore) PutRouting(value *metapb.Routing) (uint64, error) {
+	e.Lock()
+	defer e.Unlock()
+
+	err := pbutil.ValidateRouting(value)
+	if err != nil {
+		return 0, err
+	}
+
+	return e.putPB(e.routingsDir, value, func(id uint64) {
+		value.ID = id
+	})
+}
+
+// RemoveRouting remove routing
+func (e *ConsulStore) RemoveRouting(id uint64) error {
+	e.Lock()
+	defer e.Unlock()
+
+	return e.txn(consulDelete(getKey(e.routingsDir, id)))
+}
+
+// GetRoutings returns routes in store
+func (e *ConsulStore) GetRoutings(limit int64, fn func(interface{}) error) error {
+	e.RLock()
+	defer e.RUnlock()
+
+	return e.getValues(e.routingsDir, limit, func() pb { return &metapb.Routing{} }, fn)
+}
+
+// GetRouting returns a routing
+func (e *ConsulStore) GetRouting(id uint64) (*metapb.Routing, error) {
+	e.RLock()
+	defer e.RUnlock()
+
+	value := &metapb.Routing{}
+	return value, e.getPB(e.routingsDir, id, value)
+}
+
+// RegistryProxy registry the proxy with a consul session, the session is renewed until the
+// proxy exits
+func (e *ConsulStore) RegistryProxy(proxy *metapb.Proxy, ttl int64) error {
+	key := getAddrKey(e.proxiesDir, proxy.Addr)
+	data, err := proxy.Marshal()
+	if err != nil {
+		return err
+	}
+
+	start := time.Now()
+	session, err := e.rawClient.createSession(key, ttl)
+	observeOP(opTypeLease, key, 0, start, err)
+	if err != nil {
+		return err
+	}
+
+	err = e.txn(consulDelete(key), consulLock(key, string(data), session))
+	if err != nil {
+		return err
+	}
+
+	go e.rawClient.keepSession(session, ttl)
+	return nil
+}
+
+// GetProxies returns proxies in store
+func (e *ConsulStore) GetProxies(limit int64, fn func(*metapb.Proxy) error) error {
+	kvs, err := e.list(e.proxiesDir)
+	if err != nil {
+		return err
+	}
+
+	for _, item := range kvs {
+		value := &metapb.Proxy{}
+		err := value.Unmarshal(item.Value)
+		if err != nil {
+			return err
+		}
+
+		fn(value)
+	}
+
+	return nil
+}
+
+// PutProxyOverride add or update proxy override
+func (e *ConsulStore) PutProxyOverride(value *metapb.ProxyOverride) (uint64, error) {
+	e.Lock()
+	defer e.Unlock()
+
+	err := pbutil.ValidateProxyOverride(value)
+	if err != nil {
+		return 0, err
+	}
+
+	return e.putPB(e.overrideDir, value, func(id uint64) {
+		value.ID = id
+	})
+}
+
+// RemoveProxyOverride remove proxy override
+func (e *ConsulStore) RemoveProxyOverride(id uint64) error {
+	e.Lock()
+	defer e.Unlock()
+
+	return e.txn(consulDelete(getKey(e.overrideDir, id)))
+}
+
+// GetProxyOverrides returns proxy overrides in store
+func (e *ConsulStore) GetProxyOverrides(limit int64, fn func(interface{}) error) error {
+	e.RLock()
+	defer e.RUnlock()
+
+	return e.getValues(e.overrideDir, limit, func() pb { return &metapb.ProxyOverride{} }, fn)
+}
+
+// GetProxyOverride returns a proxy override
+func (e *ConsulStore) GetProxyOverride(id uint64) (*metapb.ProxyOverride, error) {
+	e.RLock()
+	defer e.RUnlock()
+
+	value := &metapb.ProxyOverride{}
+	return value, e.getPB(e.overrideDir, id, value)
+}
+
+// PutKillSwitch set the kill switch
+func (e *ConsulStore) PutKillSwitch(value *metapb.KillSwitch) error {
+	e.Lock()
+	defer e.Unlock()
+
+	err := pbutil.ValidateKillSwitch(value)
+	if err != nil {
+		return err
+	}
+
+	value.UpdatedAt = time.Now().Unix()
+	data, err := value.Marshal()
+	if err != nil {
+		return err
+	}
+
+	return e.put(e.killPath, string(data))
+}
+
+// RemoveKillSwitch remove the kill switch, the traffic is recovered
+func (e *ConsulStore) RemoveKillSwitch() error {
+	e.Lock()
+	defer e.Unlock()
+
+	return e.txn(consulDelete(e.killPath))
+}
+
+// GetKillSwitch returns the kill switch, returns nil if the kill switch is not set
+func (e *ConsulStore) GetKillSwitch() (*metapb.KillSwitch, error) {
+	e.RLock()
+	defer e.RUnlock()
+
+	kv, err := e.get(e.killPath)
+	if err != nil {
+		return nil, err
+	}
+	if kv == nil || len(kv.Value) == 0 {
+		return nil, nil
+	}
+
+	value := &metapb.KillSwitch{}
+	err = value.Unmarshal(kv.Value)
+	if err != nil {
+		return nil, err
+	}
+
+	return value, nil
+}
+
+// PutPolicy set the global policy
+func (e *ConsulStore) PutPolicy(value *metapb.Policy) error {
+	e.Lock()
+	defer e.Unlock()
+
+	err := pbutil.ValidatePolicy(value)
+	if err != nil {
+		return err
+	}
+
+	data, err := value.Marshal()
+	if err != nil {
+		return err
+	}
+
+	return e.put(e.policyPath, string(data))
+}
+
+// RemovePolicy remove the global policy
+func (e *ConsulStore) RemovePolicy() error {
+	e.Lock()
+	defer e.Unlock()
+
+	return e.txn(consulDelete(e.policyPath))
+}
+
+// GetPolicy returns the global policy, returns nil if the global policy is not set
+func (e *ConsulStore) GetPolicy() (*metapb.Policy, error) {
+	e.RLock()
+	defer e.RUnlock()
+
+	kv, err := e.get(e.policyPath)
+	if err != nil {
+		return nil, err
+	}
+	if kv == nil || len(kv.Value) == 0 {
+		return nil, nil
+	}
+
+	value := &metapb.Policy{}
+	err = value.Unmarshal(kv.Value)
+	if err != nil {
+		return nil, err
+	}
+
+	return value, nil
+}
+
+// PutConsumer add or update consumer
+func (e *ConsulStore) PutConsumer(value *metapb.Consumer) (uint64, error) {
+	e.Lock()
+	defer e.Unlock()
+
+	err := pbutil.ValidateConsumer(value)
+	if err != nil {
+		return 0, err
+	}
+
+	if value.Owner == "" {
+		return e.putPB(e.consumerDir, value, func(id uint64) {
+			value.ID = id
+		})
+	}
+
+	// an owner has only one consumer, the owner index is written with the consumer
+	old, err := e.getConsumerByOwner(value.Owner)
+	if err != nil {
+		return 0, err
+	}
+	if old != nil && old.ID != value.ID {
+		return 0, ErrOwnerInUse
+	}
+
+	op, err := e.putPBWithOp(e.consumerDir, value, func(id uint64) {
+		value.ID = id
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return value.ID, e.txn(op, consulSet(e.getOwnerKey(value.Owner), fmt.Sprintf("%d", value.ID)))
+}
+
+// RemoveConsumer remove consumer
+func (e *ConsulStore) RemoveConsumer(id uint64) error {
+	e.Lock()
+	defer e.Unlock()
+
+	value := &metapb.Consumer{}
+	err := e.getPB(e.consumerDir, id, value)
+	if err != nil && !errors.Is(err, ErrNotFound) {
+		return err
+	}
+
+	ops := []consulTxnOp{consulDelete(getKey(e.consumerDir, id))}
+	if value.Owner != "" {
+		ops = append(ops, consulDelete(e.getOwnerKey(value.Owner)))
+	}
+
+	return e.txn(ops...)
+}
+
+// GetConsumers returns consumers in store
+func (e *ConsulStore) GetConsumers(limit int64, fn func(interface{}) error) error {
+	e.RLock()
+	defer e.RUnlock()
+
+	return e.getValues(e.consumerDir, limit, func() pb { return &metapb.Consumer{} }, fn)
+}
+
+// GetConsumer returns a consumer
+func (e *ConsulStore) GetConsumer(id uint64) (*metapb.Consumer, error) {
+	e.RLock()
+	defer e.RUnlock()
+
+	value := &metapb.Consumer{}
+	return value, e.getPB(e.consumerDir, id, value)
+}
+
+// GetConsumerByOwner returns the consumer of the owner, nil if the owner has no consumer
+func (e *ConsulStore) GetConsumerByOwner(owner string) (*metapb.Consumer, error) {
+	e.RLock()
+	defer e.RUnlock()
+
+	return e.getConsumerByOwner(owner)
+}
+
+func (e *ConsulStore) getConsumerByOwner(owner string) (*metapb.Consumer, error) {
+	kv, err := e.get(e.getOwnerKey(owner))
+	if err != nil || kv == nil || len(kv.Value) == 0 {
+		return nil, err
+	}
+
+	id, err := format.ParseStrUInt64(string(kv.Value))
+	if err != nil {
+		return nil, err
+	}
+
+	// the index is stale if the consumer is removed or it's owner is changed
+	value := &metapb.Consumer{}
+	err = e.getPB(e.consumerDir, id, value)
+	if errors.Is(err, ErrNotFound) || (err == nil && value.Owner != owner) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return value, nil
+}
+
+// PutRateLimit add or update rate limit
+func (e *ConsulStore) PutRateLimit(value *metapb.RateLimit) (uint64, error) {
+	e.Lock()
+	defer e.Unlock()
+
+	err := pbutil.ValidateRateLimit(value)
+	if err != nil {
+		return 0, err
+	}
+
+	return e.putPB(e.limitsDir, value, func(id uint64) {
+		value.ID = id
+	})
+}
+
+// RemoveRateLimit remove rate limit
+func (e *ConsulStore) RemoveRateLimit(id uint64) error {
+	e.Lock()
+	defer e.Unlock()
+
+	return e.txn(consulDelete(getKey(e.limitsDir, id)))
+}
+
+// GetRateLimits returns rate limits in store
+func (e *ConsulStore) GetRateLimits(limit int64, fn func(interface{}) error) error {
+	e.RLock()
+	defer e.RUnlock()
+
+	return e.getValues(e.limitsDir, limit, func() pb { return &metapb.RateLimit{} }, fn)
+}
+
+// GetRateLimit returns a rate limit
+func (e *ConsulStore) GetRateLimit(id uint64) (*metapb.RateLimit, error) {
+	e.RLock()
+	defer e.RUnlock()
+
+	value := &metapb.RateLimit{}
+	return value, e.getPB(e.limitsDir, id, value)
+}
+
+// PutProtoDescriptor add or update proto descriptor
+func (e *ConsulStore) PutProtoDescriptor(value *metapb.ProtoDescriptor) (uint64, error) {
+	e.Lock()
+	defer e.Unlock()
+
+	err := pbutil.ValidateProtoDescriptor(value)
+	if err != nil {
+		return 0, err
+	}
+
+	return e.putPB(e.protosDir, value, func(id uint64) {
+		value.ID = id
+	})
+}
+
+// RemoveProtoDescriptor remove proto descriptor, the descriptor used by apis can not be removed
+func (e *ConsulStore) RemoveProtoDescriptor(id uint64) error {
+	e.Lock()
+	defer e.Unlock()
+
+	inUse := false
+	err := e.getValues(e.apisDir, scanLimit, func() pb { return &metapb.API{} }, func(value interface{}) error {
+		for _, node := range value.(*metapb.API).Nodes {
+			if node.GRPCTranscoding != nil && node.GRPCTranscoding.DescriptorID == id {
+				inUse = true
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if inUse {
+		return ErrDescriptorInUse
+	}
+
+	return e.txn(consulDelete(getKey(e.protosDir, id)))
+}
+
+// GetProtoDescriptors returns proto descriptors in store
+func (e *ConsulStore) GetProtoDescriptors(limit int64, fn func(interface{}) error) error {
+	e.RLock()
+	defer e.RUnlock()
+
+	return e.getValues(e.protosDir, limit, func() pb { return &metapb.ProtoDescriptor{} }, fn)
+}
+
+// GetProtoDescriptor returns a proto descriptor
+func (e *ConsulStore) GetProtoDescriptor(id uint64) (*metapb.ProtoDescriptor, error) {
+	e.RLock()
+	defer e.RUnlock()
+
+	value := &metapb.ProtoDescriptor{}
+	return value, e.getPB(e.protosDir, id, value)
+}
+
+// PutChangeset add or update changeset
+func (e *ConsulStore) PutChangeset(value *metapb.Changeset) (uint64, error) {
+	e.Lock()
+	defer e.Unlock()
+
+	err := pbutil.ValidateChangeset(value)
+	if err != nil {
+		return 0, err
+	}
+
+	return e.putPB(e.changesDir, value, func(id uint64) {
+		value.ID = id
+	})
+}
+
+// GetChangesets returns changesets in store
+func (e *ConsulStore) GetChangesets(limit int64, fn func(interface{}) error) error {
+	e.RLock()
+	defer e.RUnlock()
+
+	return e.getValues(e.changesDir, limit, func() pb { return &metapb.Changeset{} }, fn)
+}
+
+// GetChangeset returns a changeset
+func (e *ConsulStore) GetChangeset(id uint64) (*metapb.Changeset, error) {
+	e.RLock()
+	defer e.RUnlock()
+
+	value := &metapb.Changeset{}
+	return value, e.getPB(e.changesDir, id, value)
+}
+
+// PutConsumerUsages save the consumer usages that collected from the proxies,
+// the usage of the same day, consumer, api, proxy and epoch is overwritten
+func (e *ConsulStore) PutConsumerUsages(values []*metapb.ConsumerUsage) error {
+	e.Lock()
+	defer e.Unlock()
+
+	ops := make([]consulTxnOp, 0, len(values))
+	for _, value := range values {
+		data, err := value.Marshal()
+		if err != nil {
+			return err
+		}
+
+		ops = append(ops, consulSet(e.getUsageKey(value), string(data)))
+	}
+
+	return e.putBatch(ops...)
+}
+
+// GetConsumerUsages returns the consumer usages between the days(yyyy-mm-dd), both inclusive
+func (e *ConsulStore) GetConsumerUsages(from, to string, fn func(*metapb.ConsumerUsage) error) error {
+	e.RLock()
+	defer e.RUnlock()
+
+	start := fmt.Sprintf("%s/%s", e.usageDir, from)
+	end := fmt.Sprintf("%s/%s/", e.usageDir, to)
+	kvs, err := e.list(e.usageDir)
+	if err != nil {
+		return err
+	}
+
+	for _, item := range kvs {
+		if item.Key < start || (item.Key > end && !strings.HasPrefix(item.Key, end)) {
+			continue
+		}
+
+		value := &metapb.ConsumerUsage{}
+		err := value.Unmarshal(item.Value)
+		if err != nil {
+			return err
+		}
+
+		err = fn(value)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// RemoveConsumerUsages remove the consumer usages before the day(yyyy-mm-dd)
+func (e *ConsulStore) RemoveConsumerUsages(before string) error {
+	e.Lock()
+	defer e.Unlock()
+
+	keys, _, err := e.rawClient.keys(fmt.Sprintf("%s/", e.usageDir))
+	if err != nil {
+		return err
+	}
+
+	end := fmt.Sprintf("%s/%s", e.usageDir, before)
+	var ops []consulTxnOp
+	for _, key := range keys {
+		if key < end {
+			ops = append(ops, consulDelete(key))
+		}
+	}
+
+	return e.putBatch(ops...)
+}
+
+// PutHealthTransition save the last heath check status transition of the server on the proxy
+func (e *ConsulStore) PutHealthTransition(value *metapb.HealthTransition) error {
+	e.Lock()
+	defer e.Unlock()
+
+	data, err := value.Marshal()
+	if err != nil {
+		return err
+	}
+
+	return e.put(e.getHealthKey(value), string(data))
+}
+
+// GetHealthTransitions returns the health transitions that published by the proxies
+func (e *ConsulStore) GetHealthTransitions(fn func(*metapb.HealthTransition) error) error {
+	e.RLock()
+	defer e.RUnlock()
+
+	kvs, err := e.list(e.healthDir)
+	if err != nil {
+		return err
+	}
+
+	for _, item := range kvs {
+		value := &metapb.HealthTransition{}
+		err := value.Unmarshal(item.Value)
+		if err != nil {
+			return err
+		}
+
+		err = fn(value)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Clean clean data in store
+func (e *ConsulStore) Clean() error {
+	e.Lock()
+	defer e.Unlock()
+
+	return e.txn(consulDeleteTree(fmt.Sprintf("%s/", e.prefix)))
+}
+
+// SetID set id
+func (e *ConsulStore) SetID(id uint64) error {
+	e.Lock()
+	defer e.Unlock()
+
+	err := e.put(e.idPath, string(format.Uint64ToBytes(id)))
+	if err != nil {
+		return err
+	}
+
+	e.end = 0
+	e.base = 0
+	return nil
+}
+
+// BackupTo backup to other gateway
+func (e *ConsulStore) BackupTo(to string) error {
+	e.Lock()
+	defer e.Unlock()
+
+	return backupTo(e, &e.metaDirs, to)
+}
+
+// System returns system info, the revision is the consul index of the routings
+func (e *ConsulStore) System() (*metapb.System, error) {
+	e.RLock()
+	defer e.RUnlock()
+
+	value := &metapb.System{}
+	count, _, err := e.count(e.apisDir)
+	if err != nil {
+		return nil, err
+	}
+	value.Count.API = count
+
+	count, _, err = e.count(e.clustersDir)
+	if err != nil {
+		return nil, err
+	}
+	value.Count.Cluster = count
+
+	count, _, err = e.count(e.serversDir)
+	if err != nil {
+		return nil, err
+	}
+	value.Count.Server = count
+
+	count, index, err := e.count(e.routingsDir)
+	if err != nil {
+		return nil, err
+	}
+	value.Count.Routing = count
+	value.Revision = int64(index)
+
+	return value, nil
+}
+
+// Diff is not supported, consul keeps no history of the meta
+func (e *ConsulStore) Diff(from, to int64) (*metapb.MetaDiff, error) {
+	return nil, ErrDiffNotSupported
+}
+
+// Revert is not supported, consul keeps no history of the meta
+func (e *ConsulStore) Revert(records []metapb.ChangeRecord, force bool) (int64, error) {
+	return 0, ErrDiffNotSupported
+}
+
+// GetLastWrite returns the consul index and the write time of the last meta changes, 0 means no
+// changes was written
+func (e *ConsulStore) GetLastWrite() (int64, int64, error) {
+	kv, err := e.get(e.writeAtPath)
+	if err != nil {
+		return 0, 0, err
+	}
+	if kv == nil {
+		return 0, 0, nil
+	}
+
+	writeAt, err := format.ParseStrInt64(string(kv.Value))
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return int64(kv.ModifyIndex), writeAt, nil
+}
+
+func (e *ConsulStore) put(key, value string) error {
+	return e.txn(consulSet(key, value))
+}
+
+// putBatch write the ops in the txns of at most consulBatchOps
+func (e *ConsulStore) putBatch(ops ...consulTxnOp) error {
+	for len(ops) > 0 {
+		n := len(ops)
+		if n > consulBatchOps {
+			n = consulBatchOps
+		}
+
+		err := e.txn(ops[:n]...)
+		if err != nil {
+			return err
+		}
+
+		ops = ops[n:]
+	}
+
+	return nil
+}
+
+// txn execute the ops in a consul txn, the write time is written in the same txn of the meta changes
+func (e *ConsulStore) txn(ops ...consulTxnOp) error {
+	if len(ops) == 0 {
+		return nil
+	}
+
+	opType, key, size := "", "", 0
+	stamp := false
+	for _, op := range ops {
+		if key == "" {
+			key = op.Key
+		}
+		size += len(op.Key) + len(op.Value)
+		stamp = stamp || e.isMetaKey(op.Key)
+
+		value := opTypePut
+		if strings.HasPrefix(op.Verb, "delete") {
+			value = opTypeDelete
+		}
+
+		if opType == "" {
+			opType = value
+		} else if opType != value {
+			opType = opTypeTxn
+		}
+	}
+
+	if stamp {
+		ops = append(ops, consulSet(e.writeAtPath, fmt.Sprintf("%d", time.Now().UnixNano())))
+	}
+
+	start := time.Now()
+	err := e.rawClient.txn(ops)
+	observeOP(opType, key, size, start, err)
+	return err
+}
+
+func (e *ConsulStore) putPB(prefix string, value pb, do func(uint64)) (uint64, error) {
+	op, err := e.putPBWithOp(prefix, value, do)
+	if err != nil {
+		return 0, err
+	}
+
+	return value.GetID(), e.txn(op)
+}
+
+func (e *ConsulStore) putPBWithOp(prefix string, value pb, do func(uint64)) (consulTxnOp, error) {
+	if value.GetID() == 0 {
+		id, err := e.allocID()
+		if err != nil {
+			return consulTxnOp{}, err
+		}
+
+		do(id)
+	}
+
+	data, err := value.Marshal()
+	if err != nil {
+		return consulTxnOp{}, err
+	}
+
+	return consulSet(getKey(prefix, value.GetID()), string(data)), nil
+}
+
+// getValues returns the values of the prefix in the order of the keys, consul has no range query,
+// so all values are read at once and the limit is ignored
+func (e *ConsulStore) getValues(prefix string, limit int64, factory func() pb, fn func(interface{}) error) error {
+	kvs, err := e.list(prefix)
+	if err != nil {
+		return err
+	}
+
+	for _, item := range kvs {
+		value := factory()
+		err := value.Unmarshal(item.Value)
+		if err != nil {
+			return err
+		}
+
+		fn(value)
+	}
+
+	return nil
+}
+
+// list returns the key values in the dir, sorted by the keys
+func (e *ConsulStore) list(dir string) ([]*consulKV, error) {
+	start := time.Now()
+	kvs, _, err := e.rawClient.list(fmt.Sprintf("%s/", dir), 0)
+	observeOP(opTypeGet, dir, getConsulKVsSize(kvs), start, err)
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(kvs, func(i, j int) bool {
+		return kvs[i].Key < kvs[j].Key
+	})
+	return kvs, nil
+}
+
+func (e *ConsulStore) count(dir string) (int64, uint64, error) {
+	start := time.Now()
+	keys, index, err := e.rawClient.keys(fmt.Sprintf("%s/", dir))
+	observeOP(opTypeGet, dir, 0, start, err)
+	return int64(len(keys)), index, err
+}
+
+func (e *ConsulStore) get(key string) (*consulKV, error) {
+	start := time.Now()
+	kv, _, err := e.rawClient.get(key, 0)
+	size := 0
+	if kv != nil {
+		size = len(kv.Key) + len(kv.Value)
+	}
+	observeOP(opTypeGet, key, size, start, err)
+	return kv, err
+}
+
+func (e *ConsulStore) getPB(prefix string, id uint64, value pb) error {
+	kv, err := e.get(getKey(prefix, id))
+	if err != nil {
+		return err
+	}
+	if kv == nil || len(kv.Value) == 0 {
+		return fmt.Errorf("<%d> %w", id, ErrNotFound)
+	}
+
+	return value.Unmarshal(kv.Value)
+}
+
+func (e *ConsulStore) allocID() (uint64, error) {
+	e.idLock.Lock()
+	defer e.idLock.Unlock()
+
+	if e.base == e.end {
+		end, err := e.generate()
+		if err != nil {
+			return 0, err
+		}
+
+		e.end = end
+		e.base = e.end - batch
+	}
+
+	e.base++
+	return e.base, nil
+}
+
+// generate allocate a batch of ids by the check-and-set of the id key
+func (e *ConsulStore) generate() (uint64, error) {
+	for {
+		kv, err := e.get(e.idPath)
+		if err != nil {
+			return 0, err
+		}
+
+		value, index := uint64(0), uint64(0)
+		if kv != nil {
+			index = kv.ModifyIndex
+			if len(kv.Value) > 0 {
+				value, err = format.BytesToUint64(kv.Value)
+				if err != nil {
+					return 0, err
+				}
+			}
+		}
+
+		max := value + batch
+		ok, err := e.rawClient.cas(e.idPath, format.Uint64ToBytes(max), index)
+		if err != nil {
+			return 0, err
+		}
+
+		if ok {
+			return max, nil
+		}
+	}
+}
+
+func (e *ConsulStore) getID() (uint64, error) {
+	kv, err := e.get(e.idPath)
+	if err != nil {
+		return 0, err
+	}
+
+	if kv == nil || len(kv.Value) == 0 {
+		return 0, nil
+	}
+
+	return format.BytesToUint64(kv.Value)
+}
+
+func getConsulKVsSize(kvs []*consulKV) int {
+	size := 0
+	for _, kv := range kvs {
+		size += len(kv.Key) + len(kv.Value)
+	}
+	return size
+}
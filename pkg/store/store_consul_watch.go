@@ -0,0 +1,224 @@
+package store
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/coreos/etcd/mvcc/mvccpb"
+	"github.com/fagongzi/log"
+	"github.com/fagongzi/util/format"
+)
+
+const (
+	// consulWatchWait is the max wait time of the blocking queries, the expired proxies and servers
+	// are not stamped with the write time, so they are found by the list after the wait time
+	consulWatchWait = time.Second * 10
+	// consulRetryInterval is the interval to retry the failed blocking queries
+	consulRetryInterval = time.Second
+)
+
+// Watch watch the meta changes from consul. Consul has no watch api of the changes, so the meta is
+// listed after the write time of the meta changes updated, and compared with the last listed. The
+// deleted meta are returned before the added and updated, the deleted are in the reversed order of
+// the dependencies, and the others are in the order of the consul index
+func (e *ConsulStore) Watch(evtCh chan *Evt, stopCh chan bool) error {
+	log.Infof("watch event at: <%s>",
+		e.prefix)
+
+	last, index, err := e.listWatched()
+	for err != nil {
+		log.Errorf("watch event failed, errors:\n%+v", err)
+		time.Sleep(consulRetryInterval)
+		last, index, err = e.listWatched()
+	}
+
+	for {
+		select {
+		case <-stopCh:
+			return nil
+		default:
+		}
+
+		_, value, err := e.rawClient.get(e.writeAtPath, index)
+		if err != nil {
+			log.Errorf("watch event failed, errors:\n%+v", err)
+			time.Sleep(consulRetryInterval)
+			continue
+		}
+		if value < index {
+			// the index of consul is reset
+			value = 0
+		}
+		index = value
+
+		current, _, err := e.listWatched()
+		if err != nil {
+			log.Errorf("watch event failed, errors:\n%+v", err)
+			time.Sleep(consulRetryInterval)
+			continue
+		}
+
+		for _, evt := range e.diffWatched(last, current) {
+			log.Debugf("watch event: <%s, %v>",
+				evt.Key,
+				evt.Type)
+			evtCh <- evt
+		}
+		last = current
+	}
+}
+
+// WatchKillSwitch watch the kill switch with a dedicated blocking query, so the kill switch events
+// are not queued behind the other meta events
+func (e *ConsulStore) WatchKillSwitch(evtCh chan *Evt, stopCh chan bool) error {
+	log.Infof("watch kill switch at: <%s>",
+		e.killPath)
+
+	kv, index, err := e.rawClient.get(e.killPath, 0)
+	for {
+		select {
+		case <-stopCh:
+			return nil
+		default:
+		}
+
+		if err != nil {
+			log.Errorf("watch kill switch failed, errors:\n%+v", err)
+			time.Sleep(consulRetryInterval)
+			kv, index, err = e.rawClient.get(e.killPath, 0)
+			continue
+		}
+
+		last := kv
+		kv, index, err = e.rawClient.get(e.killPath, index)
+		if err != nil || !consulKVChanged(last, kv) {
+			continue
+		}
+
+		evtType := EventTypeUpdate
+		value := &mvccpb.KeyValue{}
+		if kv == nil {
+			evtType = EventTypeDelete
+		} else {
+			value.Value = kv.Value
+		}
+
+		log.Debugf("watch kill switch event: <%v>",
+			evtType)
+		evtCh <- e.doWatchWithKillSwitch(evtType, value)
+	}
+}
+
+// watchedKV is the key value of the watched meta, the order is the order of the dependencies
+type watchedKV struct {
+	*consulKV
+	src   EvtSrc
+	order int
+}
+
+// listWatched returns the watched meta by the keys, and the index of the write time
+func (e *ConsulStore) listWatched() (map[string]*watchedKV, uint64, error) {
+	// the index is read first, so the changes after the list are not missed
+	kv, index, err := e.rawClient.get(e.writeAtPath, 0)
+	if err != nil {
+		return nil, 0, err
+	}
+	if kv != nil {
+		index = kv.ModifyIndex
+	}
+
+	values := make(map[string]*watchedKV)
+	dirs := []string{e.proxiesDir, e.clustersDir, e.serversDir, e.bindsDir,
+		e.tplsDir, e.apisDir, e.routingsDir, e.overrideDir, e.consumerDir, e.limitsDir, e.protosDir}
+	for order, dir := range dirs {
+		kvs, _, err := e.rawClient.list(fmt.Sprintf("%s/", dir), 0)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		for _, kv := range kvs {
+			if src, ok := e.watchSrc(kv.Key); ok {
+				values[kv.Key] = &watchedKV{consulKV: kv, src: src, order: order}
+			}
+		}
+	}
+
+	// the global policy is a single key, not a dir
+	policy, _, err := e.rawClient.get(e.policyPath, 0)
+	if err != nil {
+		return nil, 0, err
+	}
+	if policy != nil {
+		values[policy.Key] = &watchedKV{consulKV: policy, src: EventSrcPolicy, order: len(dirs)}
+	}
+
+	return values, index, nil
+}
+
+func (e *ConsulStore) diffWatched(last, current map[string]*watchedKV) []*Evt {
+	var removed, changed []*watchedKV
+	for key, value := range last {
+		if _, ok := current[key]; !ok {
+			removed = append(removed, value)
+		}
+	}
+	for key, value := range current {
+		if old, ok := last[key]; !ok || old.ModifyIndex != value.ModifyIndex {
+			changed = append(changed, value)
+		}
+	}
+
+	sort.Slice(removed, func(i, j int) bool {
+		if removed[i].order != removed[j].order {
+			return removed[i].order > removed[j].order
+		}
+		return removed[i].Key < removed[j].Key
+	})
+	sort.Slice(changed, func(i, j int) bool {
+		if changed[i].ModifyIndex != changed[j].ModifyIndex {
+			return changed[i].ModifyIndex < changed[j].ModifyIndex
+		}
+		return changed[i].order < changed[j].order
+	})
+
+	writeAt := int64(0)
+	writeAtIndex := uint64(0)
+	if kv, _, err := e.rawClient.get(e.writeAtPath, 0); err == nil && kv != nil {
+		writeAt, _ = format.ParseStrInt64(string(kv.Value))
+		writeAtIndex = kv.ModifyIndex
+	}
+
+	evts := make([]*Evt, 0, len(removed)+len(changed))
+	for _, value := range removed {
+		evts = append(evts, e.watchMethodMapping[value.src](EventTypeDelete,
+			&mvccpb.KeyValue{Key: []byte(value.Key)}))
+	}
+	for _, value := range changed {
+		evtType := EventTypeUpdate
+		if _, ok := last[value.Key]; !ok {
+			evtType = EventTypeNew
+		}
+
+		evt := e.watchMethodMapping[value.src](evtType, &mvccpb.KeyValue{
+			Key:         []byte(value.Key),
+			Value:       value.Value,
+			ModRevision: int64(value.ModifyIndex),
+		})
+		evt.Revision = int64(value.ModifyIndex)
+		if value.ModifyIndex == writeAtIndex {
+			evt.WriteAt = writeAt
+		}
+		evts = append(evts, evt)
+	}
+
+	return evts
+}
+
+func consulKVChanged(last, current *consulKV) bool {
+	if last == nil || current == nil {
+		return last != current
+	}
+
+	return last.ModifyIndex != current.ModifyIndex
+}
@@ -0,0 +1,202 @@
+package store
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/coreos/etcd/clientv3"
+	"github.com/fagongzi/gateway/pkg/pb/metapb"
+	"github.com/fagongzi/gateway/pkg/util"
+)
+
+const (
+	kindCluster  = "cluster"
+	kindServer   = "server"
+	kindBind     = "bind"
+	kindAPI      = "api"
+	kindRouting  = "routing"
+	kindTemplate = "template"
+	kindOverride = "override"
+	kindConsumer = "consumer"
+	kindLimit    = "ratelimit"
+	kindProto    = "proto"
+)
+
+type diffValue interface {
+	Unmarshal([]byte) error
+}
+
+type diffKind struct {
+	name    string
+	prefix  string
+	factory func() diffValue
+}
+
+// Diff returns the configuration changes between the two revisions, 0 means the current revision.
+// The revisions that compacted by etcd can not be compared.
+func (e *EtcdStore) Diff(from, to int64) (*metapb.MetaDiff, error) {
+	e.RLock()
+	defer e.RUnlock()
+
+	if to == 0 {
+		rsp, err := e.get(e.prefix, clientv3.WithCountOnly())
+		if err != nil {
+			return nil, err
+		}
+		to = rsp.Header.Revision
+	}
+
+	if from <= 0 || from > to {
+		return nil, fmt.Errorf("error revisions: %d, %d", from, to)
+	}
+
+	value := &metapb.MetaDiff{
+		From: from,
+		To:   to,
+	}
+	for _, kind := range e.diffKinds() {
+		changes, err := e.diffKind(kind, from, to)
+		if err != nil {
+			return nil, err
+		}
+
+		value.Changes = append(value.Changes, changes...)
+	}
+
+	return value, nil
+}
+
+func (e *EtcdStore) diffKinds() []diffKind {
+	return []diffKind{
+		{kindCluster, e.clustersDir, func() diffValue { return &metapb.Cluster{} }},
+		{kindServer, e.serversDir, func() diffValue { return &metapb.Server{} }},
+		{kindBind, e.bindsDir, func() diffValue { return &metapb.Bind{} }},
+		{kindAPI, e.apisDir, func() diffValue { return &metapb.API{} }},
+		{kindRouting, e.routingsDir, func() diffValue { return &metapb.Routing{} }},
+		{kindTemplate, e.tplsDir, func() diffValue { return &metapb.APITemplate{} }},
+		{kindOverride, e.overrideDir, func() diffValue { return &metapb.ProxyOverride{} }},
+		{kindConsumer, e.consumerDir, func() diffValue { return &metapb.Consumer{} }},
+		{kindLimit, e.limitsDir, func() diffValue { return &metapb.RateLimit{} }},
+		{kindProto, e.protosDir, func() diffValue { return &metapb.ProtoDescriptor{} }},
+	}
+}
+
+func (e *EtcdStore) diffKind(kind diffKind, from, to int64) ([]metapb.MetaChange, error) {
+	fromValues, err := e.getRawValues(kind.prefix, from)
+	if err != nil {
+		return nil, err
+	}
+
+	toValues, err := e.getRawValues(kind.prefix, to)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]string, 0, len(fromValues)+len(toValues))
+	for key := range fromValues {
+		keys = append(keys, key)
+	}
+	for key := range toValues {
+		if _, ok := fromValues[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	var changes []metapb.MetaChange
+	for _, key := range keys {
+		fromData, hasFrom := fromValues[key]
+		toData, hasTo := toValues[key]
+		if hasFrom && hasTo && bytes.Equal(fromData, toData) {
+			continue
+		}
+
+		change := metapb.MetaChange{
+			Kind: kind.name,
+			Type: metapb.MetaModified,
+		}
+
+		var fromJSON, toJSON []byte
+		if hasFrom {
+			fromJSON, err = e.decodeDiffValue(kind, fromData, &change)
+			if err != nil {
+				return nil, err
+			}
+		} else {
+			change.Type = metapb.MetaAdded
+		}
+
+		if hasTo {
+			toJSON, err = e.decodeDiffValue(kind, toData, &change)
+			if err != nil {
+				return nil, err
+			}
+		} else {
+			change.Type = metapb.MetaRemoved
+		}
+
+		diffs, err := util.DiffJSON(fromJSON, toJSON)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(diffs) == 0 {
+			continue
+		}
+
+		for _, diff := range diffs {
+			change.Fields = append(change.Fields, metapb.FieldChange{
+				Path: diff.Path,
+				From: diff.From,
+				To:   diff.To,
+			})
+		}
+		changes = append(changes, change)
+	}
+
+	return changes, nil
+}
+
+// decodeDiffValue returns the json of the meta, and set the id and the name of the change,
+// the later value overrides the name
+func (e *EtcdStore) decodeDiffValue(kind diffKind, data []byte, change *metapb.MetaChange) ([]byte, error) {
+	value := kind.factory()
+	err := value.Unmarshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	switch v := value.(type) {
+	case *metapb.Bind:
+		change.Name = fmt.Sprintf("%d/%d", v.ClusterID, v.ServerID)
+	case *metapb.Server:
+		change.ID = v.ID
+		change.Name = v.Addr
+	case pb:
+		change.ID = v.GetID()
+		if named, ok := value.(interface {
+			GetName() string
+		}); ok {
+			change.Name = named.GetName()
+		}
+	}
+
+	return json.Marshal(value)
+}
+
+// getRawValues returns the values of the prefix at the revision
+func (e *EtcdStore) getRawValues(prefix string, rev int64) (map[string][]byte, error) {
+	rsp, err := e.get(prefix+"/", clientv3.WithPrefix(), clientv3.WithRev(rev))
+	if err != nil {
+		return nil, err
+	}
+
+	values := make(map[string][]byte, len(rsp.Kvs))
+	for _, item := range rsp.Kvs {
+		values[string(item.Key)] = item.Value
+	}
+
+	return values, nil
+}
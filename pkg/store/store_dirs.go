@@ -0,0 +1,137 @@
+package store
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/fagongzi/gateway/pkg/pb/metapb"
+)
+
+// metaDirs is the layout of the meta in the store, all store implementions use the same layout
+type metaDirs struct {
+	prefix      string
+	clustersDir string
+	serversDir  string
+	bindsDir    string
+	apisDir     string
+	proxiesDir  string
+	routingsDir string
+	overrideDir string
+	consumerDir string
+	ownersDir   string
+	limitsDir   string
+	protosDir   string
+	changesDir  string
+	usageDir    string
+	healthDir   string
+	tplsDir     string
+	killPath    string
+	policyPath  string
+	writeAtPath string
+	idPath      string
+}
+
+func newMetaDirs(prefix string) metaDirs {
+	return metaDirs{
+		prefix:      prefix,
+		clustersDir: fmt.Sprintf("%s/clusters", prefix),
+		serversDir:  fmt.Sprintf("%s/servers", prefix),
+		bindsDir:    fmt.Sprintf("%s/binds", prefix),
+		apisDir:     fmt.Sprintf("%s/apis", prefix),
+		proxiesDir:  fmt.Sprintf("%s/proxies", prefix),
+		routingsDir: fmt.Sprintf("%s/routings", prefix),
+		overrideDir: fmt.Sprintf("%s/overrides", prefix),
+		consumerDir: fmt.Sprintf("%s/consumers", prefix),
+		ownersDir:   fmt.Sprintf("%s/consumer-owners", prefix),
+		limitsDir:   fmt.Sprintf("%s/ratelimits", prefix),
+		protosDir:   fmt.Sprintf("%s/protos", prefix),
+		changesDir:  fmt.Sprintf("%s/changesets", prefix),
+		usageDir:    fmt.Sprintf("%s/usages", prefix),
+		healthDir:   fmt.Sprintf("%s/health", prefix),
+		tplsDir:     fmt.Sprintf("%s/templates", prefix),
+		killPath:    fmt.Sprintf("%s/killswitch", prefix),
+		policyPath:  fmt.Sprintf("%s/policy", prefix),
+		writeAtPath: fmt.Sprintf("%s/writeat", prefix),
+		idPath:      fmt.Sprintf("%s/id", prefix),
+	}
+}
+
+func (d *metaDirs) isMetaKey(key string) bool {
+	if key == d.policyPath {
+		return true
+	}
+
+	for _, dir := range []string{d.clustersDir, d.serversDir, d.bindsDir, d.apisDir,
+		d.routingsDir, d.overrideDir, d.tplsDir, d.consumerDir, d.limitsDir, d.protosDir} {
+		if strings.HasPrefix(key, dir) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// watchSrc returns the event src of the key, false means the key is not watched
+func (d *metaDirs) watchSrc(key string) (EvtSrc, bool) {
+	if strings.HasPrefix(key, d.clustersDir) {
+		return EventSrcCluster, true
+	} else if strings.HasPrefix(key, d.serversDir) {
+		return EventSrcServer, true
+	} else if strings.HasPrefix(key, d.bindsDir) {
+		return EventSrcBind, true
+	} else if strings.HasPrefix(key, d.apisDir) {
+		return EventSrcAPI, true
+	} else if strings.HasPrefix(key, d.routingsDir) {
+		return EventSrcRouting, true
+	} else if strings.HasPrefix(key, d.proxiesDir) {
+		return EventSrcProxy, true
+	} else if strings.HasPrefix(key, d.overrideDir) {
+		return EventSrcProxyOverride, true
+	} else if strings.HasPrefix(key, d.tplsDir) {
+		return EventSrcAPITemplate, true
+	} else if strings.HasPrefix(key, d.consumerDir) {
+		return EventSrcConsumer, true
+	} else if strings.HasPrefix(key, d.limitsDir) {
+		return EventSrcRateLimit, true
+	} else if strings.HasPrefix(key, d.protosDir) {
+		return EventSrcProtoDescriptor, true
+	} else if key == d.policyPath {
+		return EventSrcPolicy, true
+	}
+
+	return 0, false
+}
+
+func (d *metaDirs) getClusterBindPrefix(id uint64) string {
+	return getKey(d.bindsDir, id)
+}
+
+func (d *metaDirs) getBindKey(bind *metapb.Bind) string {
+	return getKey(d.getClusterBindPrefix(bind.ClusterID), bind.ServerID)
+}
+
+// getUsageKey returns the key of the consumer usage, the usages are sorted by the day
+func (d *metaDirs) getUsageKey(value *metapb.ConsumerUsage) string {
+	return fmt.Sprintf("%s/%s/%020d/%020d/%s/%d",
+		d.usageDir,
+		value.Day,
+		value.Consumer,
+		value.API,
+		value.Proxy,
+		value.Epoch)
+}
+
+// getOwnerKey returns the index key of the owner of the consumer, the value is the id of the consumer
+func (d *metaDirs) getOwnerKey(owner string) string {
+	return fmt.Sprintf("%s/%s", d.ownersDir, url.PathEscape(owner))
+}
+
+func (d *metaDirs) getServerHealthPrefix(id uint64) string {
+	return getKey(d.healthDir, id)
+}
+
+// getHealthKey returns the key of the health transition, the transitions are sorted by the server
+func (d *metaDirs) getHealthKey(value *metapb.HealthTransition) string {
+	return fmt.Sprintf("%s/%s", d.getServerHealthPrefix(value.ServerID), value.Proxy)
+}
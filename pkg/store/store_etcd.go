@@ -9,7 +9,6 @@ import (
 
 	"github.com/coreos/etcd/clientv3"
 	"github.com/coreos/etcd/mvcc/mvccpb"
-	"github.com/fagongzi/gateway/pkg/client"
 	pbutil "github.com/fagongzi/gateway/pkg/pb"
 	"github.com/fagongzi/gateway/pkg/pb/metapb"
 	"github.com/fagongzi/gateway/pkg/pb/rpcpb"
@@ -23,6 +22,14 @@ var (
 	ErrHasBind = errors.New("Has bind info, can not delete")
 	// ErrStaleOP is a stale error
 	ErrStaleOP = errors.New("stale option")
+	// ErrTemplateInUse error the api template is used by some apis, can not delete
+	ErrTemplateInUse = errors.New("API template is in use, can not delete")
+	// ErrDescriptorInUse error the proto descriptor is used by some apis, can not delete
+	ErrDescriptorInUse = errors.New("Proto descriptor is in use, can not delete")
+	// ErrNotFound the meta is not found
+	ErrNotFound = errors.New("not found")
+	// ErrOwnerInUse error the owner already has a consumer
+	ErrOwnerInUse = errors.New("Owner already has a consumer")
 )
 
 const (
@@ -33,22 +40,15 @@ const (
 	// DefaultSlowRequestTime default slow request time
 	DefaultSlowRequestTime = time.Second * 1
 
-	batch = uint64(1000)
-	endID = uint64(math.MaxUint64)
+	batch     = uint64(1000)
+	endID     = uint64(math.MaxUint64)
+	scanLimit = int64(96)
 )
 
 // EtcdStore etcd store impl
 type EtcdStore struct {
 	sync.RWMutex
-
-	prefix      string
-	clustersDir string
-	serversDir  string
-	bindsDir    string
-	apisDir     string
-	proxiesDir  string
-	routingsDir string
-	idPath      string
+	metaDirs
 
 	idLock sync.Mutex
 	base   uint64
@@ -63,17 +63,9 @@ type EtcdStore struct {
 // NewEtcdStore create a etcd store
 func NewEtcdStore(etcdAddrs []string, prefix string) (Store, error) {
 	store := &EtcdStore{
-		prefix:             prefix,
-		clustersDir:        fmt.Sprintf("%s/clusters", prefix),
-		serversDir:         fmt.Sprintf("%s/servers", prefix),
-		bindsDir:           fmt.Sprintf("%s/binds", prefix),
-		apisDir:            fmt.Sprintf("%s/apis", prefix),
-		proxiesDir:         fmt.Sprintf("%s/proxies", prefix),
-		routingsDir:        fmt.Sprintf("%s/routings", prefix),
-		idPath:             fmt.Sprintf("%s/id", prefix),
-		watchMethodMapping: make(map[EvtSrc]func(EvtType, *mvccpb.KeyValue) *Evt),
-		base:               100,
-		end:                100,
+		metaDirs: newMetaDirs(prefix),
+		base:     100,
+		end:      100,
 	}
 
 	cli, err := clientv3.New(clientv3.Config{
@@ -350,7 +342,9 @@ func (e *EtcdStore) RemoveServer(id uint64) error {
 	e.Lock()
 	defer e.Unlock()
 
-	return e.delete(getKey(e.serversDir, id))
+	_, err := e.txn().Then(clientv3.OpDelete(getKey(e.serversDir, id)),
+		clientv3.OpDelete(e.getServerHealthPrefix(id), clientv3.WithPrefix())).Commit()
+	return err
 }
 
 // GetServers returns all server
@@ -370,6 +364,95 @@ func (e *EtcdStore) GetServer(id uint64) (*metapb.Server, error) {
 	return value, e.getPB(e.serversDir, id, value)
 }
 
+// RegisterServers add or update the servers in one transaction, if ttl > 0, the servers
+// will be removed after ttl seconds without heartbeat
+func (e *EtcdStore) RegisterServers(servers []*metapb.Server, ttl int64) ([]uint64, error) {
+	e.Lock()
+	defer e.Unlock()
+
+	var opts []clientv3.OpOption
+	if ttl > 0 {
+		id, err := e.grantLease(e.serversDir, ttl)
+		if err != nil {
+			return nil, err
+		}
+
+		opts = append(opts, clientv3.WithLease(id))
+	}
+
+	ids := make([]uint64, 0, len(servers))
+	ops := make([]clientv3.Op, 0, len(servers))
+	for _, value := range servers {
+		err := pbutil.ValidateServer(value)
+		if err != nil {
+			return nil, err
+		}
+
+		if value.ID == 0 {
+			value.ID, err = e.allocID()
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		data, err := value.Marshal()
+		if err != nil {
+			return nil, err
+		}
+
+		ids = append(ids, value.ID)
+		ops = append(ops, e.op(getKey(e.serversDir, value.ID), string(data), opts...))
+	}
+
+	err := e.putBatch(ops...)
+	if err != nil {
+		return nil, err
+	}
+
+	return ids, nil
+}
+
+// HeartbeatServers keep the servers registered with ttl alive, returns the servers
+// that are already expired
+func (e *EtcdStore) HeartbeatServers(ids []uint64) ([]uint64, error) {
+	e.RLock()
+	defer e.RUnlock()
+
+	var expired []uint64
+	leases := make(map[clientv3.LeaseID]struct{})
+	for _, id := range ids {
+		rsp, err := e.get(getKey(e.serversDir, id))
+		if err != nil {
+			return nil, err
+		}
+
+		if len(rsp.Kvs) == 0 {
+			expired = append(expired, id)
+			continue
+		}
+
+		if rsp.Kvs[0].Lease != 0 {
+			leases[clientv3.LeaseID(rsp.Kvs[0].Lease)] = struct{}{}
+		}
+	}
+
+	lessor := clientv3.NewLease(e.rawClient)
+	defer lessor.Close()
+
+	for id := range leases {
+		start := time.Now()
+		ctx, cancel := context.WithTimeout(e.rawClient.Ctx(), DefaultRequestTimeout)
+		_, err := lessor.KeepAliveOnce(ctx, id)
+		cancel()
+		observeOP(opTypeLease, e.serversDir, 0, start, err)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return expired, nil
+}
+
 // PutAPI add or update a API
 func (e *EtcdStore) PutAPI(value *metapb.API) (uint64, error) {
 	e.Lock()
@@ -380,6 +463,13 @@ func (e *EtcdStore) PutAPI(value *metapb.API) (uint64, error) {
 		return 0, err
 	}
 
+	if value.Template > 0 {
+		err = e.getPB(e.tplsDir, value.Template, &metapb.APITemplate{})
+		if err != nil {
+			return 0, err
+		}
+	}
+
 	return e.putPB(e.apisDir, value, func(id uint64) {
 		value.ID = id
 	})
@@ -410,6 +500,61 @@ func (e *EtcdStore) GetAPI(id uint64) (*metapb.API, error) {
 	return value, e.getPB(e.apisDir, id, value)
 }
 
+// PutAPITemplate add or update a api template
+func (e *EtcdStore) PutAPITemplate(value *metapb.APITemplate) (uint64, error) {
+	e.Lock()
+	defer e.Unlock()
+
+	err := pbutil.ValidateAPITemplate(value)
+	if err != nil {
+		return 0, err
+	}
+
+	return e.putPB(e.tplsDir, value, func(id uint64) {
+		value.ID = id
+	})
+}
+
+// RemoveAPITemplate remove a api template from store, the template used by apis can not be removed
+func (e *EtcdStore) RemoveAPITemplate(id uint64) error {
+	e.Lock()
+	defer e.Unlock()
+
+	inUse := false
+	err := e.getValues(e.apisDir, scanLimit, func() pb { return &metapb.API{} }, func(value interface{}) error {
+		if value.(*metapb.API).Template == id {
+			inUse = true
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if inUse {
+		return ErrTemplateInUse
+	}
+
+	return e.delete(getKey(e.tplsDir, id))
+}
+
+// GetAPITemplates returns all api templates
+func (e *EtcdStore) GetAPITemplates(limit int64, fn func(interface{}) error) error {
+	e.RLock()
+	defer e.RUnlock()
+
+	return e.getValues(e.tplsDir, limit, func() pb { return &metapb.APITemplate{} }, fn)
+}
+
+// GetAPITemplate returns the api template
+func (e *EtcdStore) GetAPITemplate(id uint64) (*metapb.APITemplate, error) {
+	e.RLock()
+	defer e.RUnlock()
+
+	value := &metapb.APITemplate{}
+	return value, e.getPB(e.tplsDir, id, value)
+}
+
 // PutRouting add or update routing
 func (e *EtcdStore) PutRouting(value *metapb.Routing) (uint64, error) {
 	e.Lock()
@@ -461,9 +606,11 @@ func (e *EtcdStore) RegistryProxy(proxy *metapb.Proxy, ttl int64) error {
 	lessor := clientv3.NewLease(e.rawClient)
 	defer lessor.Close()
 
+	start := time.Now()
 	ctx, cancel := context.WithTimeout(e.rawClient.Ctx(), DefaultRequestTimeout)
 	leaseResp, err := lessor.Grant(ctx, ttl)
 	cancel()
+	observeOP(opTypeLease, key, 0, start, err)
 	if err != nil {
 		return err
 	}
@@ -510,217 +657,410 @@ func (e *EtcdStore) GetProxies(limit int64, fn func(*metapb.Proxy) error) error
 	return nil
 }
 
-// Clean clean data in store
-func (e *EtcdStore) Clean() error {
+// PutProxyOverride add or update proxy override
+func (e *EtcdStore) PutProxyOverride(value *metapb.ProxyOverride) (uint64, error) {
 	e.Lock()
 	defer e.Unlock()
 
-	return e.delete(e.prefix, clientv3.WithPrefix())
+	err := pbutil.ValidateProxyOverride(value)
+	if err != nil {
+		return 0, err
+	}
+
+	return e.putPB(e.overrideDir, value, func(id uint64) {
+		value.ID = id
+	})
 }
 
-// SetID set id
-func (e *EtcdStore) SetID(id uint64) error {
+// RemoveProxyOverride remove proxy override
+func (e *EtcdStore) RemoveProxyOverride(id uint64) error {
 	e.Lock()
 	defer e.Unlock()
 
-	op := clientv3.OpPut(e.idPath, string(format.Uint64ToBytes(id)))
-	rsp, err := e.txn().Then(op).Commit()
+	return e.delete(getKey(e.overrideDir, id))
+}
+
+// GetProxyOverrides returns proxy overrides in store
+func (e *EtcdStore) GetProxyOverrides(limit int64, fn func(interface{}) error) error {
+	e.RLock()
+	defer e.RUnlock()
+
+	return e.getValues(e.overrideDir, limit, func() pb { return &metapb.ProxyOverride{} }, fn)
+}
+
+// GetProxyOverride returns a proxy override
+func (e *EtcdStore) GetProxyOverride(id uint64) (*metapb.ProxyOverride, error) {
+	e.RLock()
+	defer e.RUnlock()
+
+	value := &metapb.ProxyOverride{}
+	return value, e.getPB(e.overrideDir, id, value)
+}
+
+// PutKillSwitch set the kill switch
+func (e *EtcdStore) PutKillSwitch(value *metapb.KillSwitch) error {
+	e.Lock()
+	defer e.Unlock()
+
+	err := pbutil.ValidateKillSwitch(value)
 	if err != nil {
 		return err
 	}
 
-	if !rsp.Succeeded {
-		return ErrStaleOP
+	value.UpdatedAt = time.Now().Unix()
+	data, err := value.Marshal()
+	if err != nil {
+		return err
 	}
 
-	e.end = 0
-	e.base = 0
-	return nil
+	return e.put(e.killPath, string(data))
 }
 
-// BackupTo backup to other gateway
-func (e *EtcdStore) BackupTo(to string) error {
+// RemoveKillSwitch remove the kill switch, the traffic is recovered
+func (e *EtcdStore) RemoveKillSwitch() error {
 	e.Lock()
 	defer e.Unlock()
 
-	targetC, err := client.NewClient(time.Second*10, to)
+	return e.delete(e.killPath)
+}
+
+// GetKillSwitch returns the kill switch, returns nil if the kill switch is not set
+func (e *EtcdStore) GetKillSwitch() (*metapb.KillSwitch, error) {
+	e.RLock()
+	defer e.RUnlock()
+
+	data, err := e.getValue(e.killPath)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	if len(data) == 0 {
+		return nil, nil
+	}
+
+	value := &metapb.KillSwitch{}
+	err = value.Unmarshal(data)
+	if err != nil {
+		return nil, err
 	}
 
-	defer targetC.Close()
+	return value, nil
+}
+
+// PutPolicy set the global policy
+func (e *EtcdStore) PutPolicy(value *metapb.Policy) error {
+	e.Lock()
+	defer e.Unlock()
 
-	// Clean
-	err = targetC.Clean()
+	err := pbutil.ValidatePolicy(value)
 	if err != nil {
 		return err
 	}
 
-	limit := int64(96)
-	batch := &rpcpb.BatchReq{}
+	data, err := value.Marshal()
+	if err != nil {
+		return err
+	}
 
-	// backup server
-	err = e.getValues(e.serversDir, limit, func() pb { return &metapb.Server{} }, func(value interface{}) error {
-		batch.PutServers = append(batch.PutServers, &rpcpb.PutServerReq{
-			Server: *value.(*metapb.Server),
-		})
+	return e.put(e.policyPath, string(data))
+}
 
-		if int64(len(batch.PutServers)) == limit {
-			_, err := targetC.Batch(batch)
-			if err != nil {
-				return err
-			}
+// RemovePolicy remove the global policy
+func (e *EtcdStore) RemovePolicy() error {
+	e.Lock()
+	defer e.Unlock()
 
-			batch = &rpcpb.BatchReq{}
-		}
+	return e.delete(e.policyPath)
+}
 
-		return nil
-	})
+// GetPolicy returns the global policy, returns nil if the global policy is not set
+func (e *EtcdStore) GetPolicy() (*metapb.Policy, error) {
+	e.RLock()
+	defer e.RUnlock()
+
+	data, err := e.getValue(e.policyPath)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	if len(data) == 0 {
+		return nil, nil
 	}
 
-	if int64(len(batch.PutServers)) > 0 {
-		_, err := targetC.Batch(batch)
-		if err != nil {
-			return err
-		}
+	value := &metapb.Policy{}
+	err = value.Unmarshal(data)
+	if err != nil {
+		return nil, err
 	}
 
-	// backup cluster
-	batch = &rpcpb.BatchReq{}
-	err = e.getValues(e.clustersDir, limit, func() pb { return &metapb.Cluster{} }, func(value interface{}) error {
-		batch.PutClusters = append(batch.PutClusters, &rpcpb.PutClusterReq{
-			Cluster: *value.(*metapb.Cluster),
-		})
+	return value, nil
+}
 
-		if int64(len(batch.PutClusters)) == limit {
-			_, err := targetC.Batch(batch)
-			if err != nil {
-				return err
-			}
+// PutConsumer add or update consumer
+func (e *EtcdStore) PutConsumer(value *metapb.Consumer) (uint64, error) {
+	e.Lock()
+	defer e.Unlock()
 
-			batch = &rpcpb.BatchReq{}
-		}
+	err := pbutil.ValidateConsumer(value)
+	if err != nil {
+		return 0, err
+	}
 
-		return nil
+	if value.Owner == "" {
+		return e.putPB(e.consumerDir, value, func(id uint64) {
+			value.ID = id
+		})
+	}
+
+	// an owner has only one consumer, the owner index is written with the consumer
+	old, err := e.getConsumerByOwner(value.Owner)
+	if err != nil {
+		return 0, err
+	}
+	if old != nil && old.ID != value.ID {
+		return 0, ErrOwnerInUse
+	}
+
+	op, err := e.putPBWithOp(e.consumerDir, value, func(id uint64) {
+		value.ID = id
 	})
 	if err != nil {
+		return 0, err
+	}
+
+	return value.ID, e.putBatch(op, e.op(e.getOwnerKey(value.Owner), fmt.Sprintf("%d", value.ID)))
+}
+
+// RemoveConsumer remove consumer
+func (e *EtcdStore) RemoveConsumer(id uint64) error {
+	e.Lock()
+	defer e.Unlock()
+
+	value := &metapb.Consumer{}
+	err := e.getPB(e.consumerDir, id, value)
+	if err != nil && !errors.Is(err, ErrNotFound) {
 		return err
 	}
 
-	if int64(len(batch.PutClusters)) > 0 {
-		_, err := targetC.Batch(batch)
-		if err != nil {
-			return err
-		}
+	ops := []clientv3.Op{clientv3.OpDelete(getKey(e.consumerDir, id))}
+	if value.Owner != "" {
+		ops = append(ops, clientv3.OpDelete(e.getOwnerKey(value.Owner)))
 	}
 
-	// backup binds
-	batch = &rpcpb.BatchReq{}
-	err = e.getValues(e.clustersDir, limit, func() pb { return &metapb.Cluster{} }, func(value interface{}) error {
-		cid := value.(*metapb.Cluster).ID
-		servers, err := e.doGetBindServers(cid)
-		if err != nil {
-			return err
-		}
+	_, err = e.txn().Then(ops...).Commit()
+	return err
+}
 
-		for _, sid := range servers {
-			batch.AddBinds = append(batch.AddBinds, &rpcpb.AddBindReq{
-				Cluster: cid,
-				Server:  sid,
-			})
+// GetConsumers returns consumers in store
+func (e *EtcdStore) GetConsumers(limit int64, fn func(interface{}) error) error {
+	e.RLock()
+	defer e.RUnlock()
+
+	return e.getValues(e.consumerDir, limit, func() pb { return &metapb.Consumer{} }, fn)
+}
 
-			if int64(len(batch.AddBinds)) == limit {
-				_, err := targetC.Batch(batch)
-				if err != nil {
-					return err
-				}
+// GetConsumer returns a consumer
+func (e *EtcdStore) GetConsumer(id uint64) (*metapb.Consumer, error) {
+	e.RLock()
+	defer e.RUnlock()
 
-				batch = &rpcpb.BatchReq{}
-			}
-		}
+	value := &metapb.Consumer{}
+	return value, e.getPB(e.consumerDir, id, value)
+}
 
-		return nil
-	})
-	if err != nil {
-		return err
+// GetConsumerByOwner returns the consumer of the owner, nil if the owner has no consumer
+func (e *EtcdStore) GetConsumerByOwner(owner string) (*metapb.Consumer, error) {
+	e.RLock()
+	defer e.RUnlock()
+
+	return e.getConsumerByOwner(owner)
+}
+
+func (e *EtcdStore) getConsumerByOwner(owner string) (*metapb.Consumer, error) {
+	data, err := e.getValue(e.getOwnerKey(owner))
+	if err != nil || len(data) == 0 {
+		return nil, err
 	}
 
-	if int64(len(batch.AddBinds)) > 0 {
-		_, err := targetC.Batch(batch)
-		if err != nil {
-			return err
-		}
+	id, err := format.ParseStrUInt64(string(data))
+	if err != nil {
+		return nil, err
+	}
 
-		batch = &rpcpb.BatchReq{}
+	// the index is stale if the consumer is removed or it's owner is changed
+	value := &metapb.Consumer{}
+	err = e.getPB(e.consumerDir, id, value)
+	if errors.Is(err, ErrNotFound) || (err == nil && value.Owner != owner) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
 	}
 
-	// backup apis
-	batch = &rpcpb.BatchReq{}
-	err = e.getValues(e.apisDir, limit, func() pb { return &metapb.API{} }, func(value interface{}) error {
-		batch.PutAPIs = append(batch.PutAPIs, &rpcpb.PutAPIReq{
-			API: *value.(*metapb.API),
-		})
+	return value, nil
+}
 
-		if int64(len(batch.PutAPIs)) == limit {
-			_, err := targetC.Batch(batch)
-			if err != nil {
-				return err
-			}
+// PutRateLimit add or update rate limit
+func (e *EtcdStore) PutRateLimit(value *metapb.RateLimit) (uint64, error) {
+	e.Lock()
+	defer e.Unlock()
 
-			batch = &rpcpb.BatchReq{}
-		}
+	err := pbutil.ValidateRateLimit(value)
+	if err != nil {
+		return 0, err
+	}
 
-		return nil
+	return e.putPB(e.limitsDir, value, func(id uint64) {
+		value.ID = id
 	})
+}
+
+// RemoveRateLimit remove rate limit
+func (e *EtcdStore) RemoveRateLimit(id uint64) error {
+	e.Lock()
+	defer e.Unlock()
+
+	return e.delete(getKey(e.limitsDir, id))
+}
+
+// GetRateLimits returns rate limits in store
+func (e *EtcdStore) GetRateLimits(limit int64, fn func(interface{}) error) error {
+	e.RLock()
+	defer e.RUnlock()
+
+	return e.getValues(e.limitsDir, limit, func() pb { return &metapb.RateLimit{} }, fn)
+}
+
+// GetRateLimit returns a rate limit
+func (e *EtcdStore) GetRateLimit(id uint64) (*metapb.RateLimit, error) {
+	e.RLock()
+	defer e.RUnlock()
+
+	value := &metapb.RateLimit{}
+	return value, e.getPB(e.limitsDir, id, value)
+}
+
+// PutProtoDescriptor add or update proto descriptor
+func (e *EtcdStore) PutProtoDescriptor(value *metapb.ProtoDescriptor) (uint64, error) {
+	e.Lock()
+	defer e.Unlock()
+
+	err := pbutil.ValidateProtoDescriptor(value)
 	if err != nil {
-		return err
+		return 0, err
 	}
 
-	if int64(len(batch.PutAPIs)) > 0 {
-		_, err := targetC.Batch(batch)
-		if err != nil {
-			return err
-		}
-	}
+	return e.putPB(e.protosDir, value, func(id uint64) {
+		value.ID = id
+	})
+}
 
-	// backup routings
-	batch = &rpcpb.BatchReq{}
-	err = e.getValues(e.routingsDir, limit, func() pb { return &metapb.Routing{} }, func(value interface{}) error {
-		batch.PutRoutings = append(batch.PutRoutings, &rpcpb.PutRoutingReq{
-			Routing: *value.(*metapb.Routing),
-		})
+// RemoveProtoDescriptor remove proto descriptor, the descriptor used by apis can not be removed
+func (e *EtcdStore) RemoveProtoDescriptor(id uint64) error {
+	e.Lock()
+	defer e.Unlock()
 
-		if int64(len(batch.PutRoutings)) == limit {
-			_, err := targetC.Batch(batch)
-			if err != nil {
-				return err
+	inUse := false
+	err := e.getValues(e.apisDir, scanLimit, func() pb { return &metapb.API{} }, func(value interface{}) error {
+		for _, node := range value.(*metapb.API).Nodes {
+			if node.GRPCTranscoding != nil && node.GRPCTranscoding.DescriptorID == id {
+				inUse = true
 			}
-
-			batch = &rpcpb.BatchReq{}
 		}
-
 		return nil
 	})
 	if err != nil {
 		return err
 	}
 
-	if int64(len(batch.PutRoutings)) > 0 {
-		_, err := targetC.Batch(batch)
-		if err != nil {
-			return err
-		}
+	if inUse {
+		return ErrDescriptorInUse
 	}
 
-	// backup id
-	currID, err := e.getID()
+	return e.delete(getKey(e.protosDir, id))
+}
+
+// GetProtoDescriptors returns proto descriptors in store
+func (e *EtcdStore) GetProtoDescriptors(limit int64, fn func(interface{}) error) error {
+	e.RLock()
+	defer e.RUnlock()
+
+	return e.getValues(e.protosDir, limit, func() pb { return &metapb.ProtoDescriptor{} }, fn)
+}
+
+// GetProtoDescriptor returns a proto descriptor
+func (e *EtcdStore) GetProtoDescriptor(id uint64) (*metapb.ProtoDescriptor, error) {
+	e.RLock()
+	defer e.RUnlock()
+
+	value := &metapb.ProtoDescriptor{}
+	return value, e.getPB(e.protosDir, id, value)
+}
+
+// PutChangeset add or update changeset
+func (e *EtcdStore) PutChangeset(value *metapb.Changeset) (uint64, error) {
+	e.Lock()
+	defer e.Unlock()
+
+	err := pbutil.ValidateChangeset(value)
+	if err != nil {
+		return 0, err
+	}
+
+	return e.putPB(e.changesDir, value, func(id uint64) {
+		value.ID = id
+	})
+}
+
+// GetChangesets returns changesets in store
+func (e *EtcdStore) GetChangesets(limit int64, fn func(interface{}) error) error {
+	e.RLock()
+	defer e.RUnlock()
+
+	return e.getValues(e.changesDir, limit, func() pb { return &metapb.Changeset{} }, fn)
+}
+
+// GetChangeset returns a changeset
+func (e *EtcdStore) GetChangeset(id uint64) (*metapb.Changeset, error) {
+	e.RLock()
+	defer e.RUnlock()
+
+	value := &metapb.Changeset{}
+	return value, e.getPB(e.changesDir, id, value)
+}
+
+// Clean clean data in store
+func (e *EtcdStore) Clean() error {
+	e.Lock()
+	defer e.Unlock()
+
+	return e.delete(e.prefix, clientv3.WithPrefix())
+}
+
+// SetID set id
+func (e *EtcdStore) SetID(id uint64) error {
+	e.Lock()
+	defer e.Unlock()
+
+	op := clientv3.OpPut(e.idPath, string(format.Uint64ToBytes(id)))
+	rsp, err := e.txn().Then(op).Commit()
 	if err != nil {
 		return err
 	}
 
-	return targetC.SetID(currID)
+	if !rsp.Succeeded {
+		return ErrStaleOP
+	}
+
+	e.end = 0
+	e.base = 0
+	return nil
+}
+
+// BackupTo backup to other gateway
+func (e *EtcdStore) BackupTo(to string) error {
+	e.Lock()
+	defer e.Unlock()
+
+	return backupTo(e, &e.metaDirs, to)
 }
 
 // System returns system info
@@ -752,6 +1092,7 @@ func (e *EtcdStore) System() (*metapb.System, error) {
 		return nil, err
 	}
 	value.Count.Routing = rsp.Count
+	value.Revision = rsp.Header.Revision
 
 	return value, nil
 }
@@ -775,19 +1116,30 @@ func (e *EtcdStore) putBatch(ops ...clientv3.Op) error {
 }
 
 func (e *EtcdStore) putTTL(key, value string, ttl int64) error {
+	id, err := e.grantLease(key, ttl)
+	if err != nil {
+		return err
+	}
+
+	_, err = e.txn().Then(clientv3.OpPut(key, value, clientv3.WithLease(id))).Commit()
+	return err
+}
+
+func (e *EtcdStore) grantLease(key string, ttl int64) (clientv3.LeaseID, error) {
 	lessor := clientv3.NewLease(e.rawClient)
 	defer lessor.Close()
 
+	start := time.Now()
 	ctx, cancel := context.WithTimeout(e.rawClient.Ctx(), DefaultRequestTimeout)
 	leaseResp, err := lessor.Grant(ctx, ttl)
 	cancel()
+	observeOP(opTypeLease, key, 0, start, err)
 
 	if err != nil {
-		return err
+		return 0, err
 	}
 
-	_, err = e.txn().Then(clientv3.OpPut(key, value, clientv3.WithLease(leaseResp.ID))).Commit()
-	return err
+	return leaseResp.ID, nil
 }
 
 func (e *EtcdStore) delete(key string, opts ...clientv3.OpOption) error {
@@ -871,10 +1223,13 @@ func (e *EtcdStore) getValues(prefix string, limit int64, factory func() pb, fn
 }
 
 func (e *EtcdStore) get(key string, opts ...clientv3.OpOption) (*clientv3.GetResponse, error) {
+	start := time.Now()
 	ctx, cancel := context.WithTimeout(e.rawClient.Ctx(), DefaultRequestTimeout)
 	defer cancel()
 
-	return clientv3.NewKV(e.rawClient).Get(ctx, key, opts...)
+	resp, err := clientv3.NewKV(e.rawClient).Get(ctx, key, opts...)
+	observeOP(opTypeGet, key, getResponseSize(resp), start, err)
+	return resp, err
 }
 
 func (e *EtcdStore) getPB(prefix string, id uint64, value pb) error {
@@ -883,7 +1238,7 @@ func (e *EtcdStore) getPB(prefix string, id uint64, value pb) error {
 		return err
 	}
 	if len(data) == 0 {
-		return fmt.Errorf("<%d> not found", id)
+		return fmt.Errorf("<%d> %w", id, ErrNotFound)
 	}
 
 	err = value.Unmarshal(data)
@@ -895,10 +1250,12 @@ func (e *EtcdStore) getPB(prefix string, id uint64, value pb) error {
 }
 
 func (e *EtcdStore) getValue(key string) ([]byte, error) {
+	start := time.Now()
 	ctx, cancel := context.WithTimeout(e.rawClient.Ctx(), DefaultRequestTimeout)
 	defer cancel()
 
 	resp, err := clientv3.NewKV(e.rawClient).Get(ctx, key)
+	observeOP(opTypeGet, key, getResponseSize(resp), start, err)
 	if nil != err {
 		return nil, err
 	}
@@ -1005,11 +1362,3 @@ func (e *EtcdStore) updateID(old, value uint64) error {
 
 	return nil
 }
-
-func (e *EtcdStore) getClusterBindPrefix(id uint64) string {
-	return getKey(e.bindsDir, id)
-}
-
-func (e *EtcdStore) getBindKey(bind *metapb.Bind) string {
-	return getKey(e.getClusterBindPrefix(bind.ClusterID), bind.ServerID)
-}
@@ -0,0 +1,60 @@
+package store
+
+import (
+	"fmt"
+
+	"github.com/coreos/etcd/clientv3"
+	"github.com/fagongzi/gateway/pkg/pb/metapb"
+)
+
+// PutHealthTransition save the last heath check status transition of the server on the proxy
+func (e *EtcdStore) PutHealthTransition(value *metapb.HealthTransition) error {
+	e.Lock()
+	defer e.Unlock()
+
+	data, err := value.Marshal()
+	if err != nil {
+		return err
+	}
+
+	return e.put(e.getHealthKey(value), string(data))
+}
+
+// GetHealthTransitions returns the health transitions that published by the proxies
+func (e *EtcdStore) GetHealthTransitions(fn func(*metapb.HealthTransition) error) error {
+	e.RLock()
+	defer e.RUnlock()
+
+	start := fmt.Sprintf("%s/", e.healthDir)
+	withRange := clientv3.WithRange(clientv3.GetPrefixRangeEnd(start))
+	withLimit := clientv3.WithLimit(scanLimit)
+
+	for {
+		resp, err := e.get(start, withRange, withLimit)
+		if err != nil {
+			return err
+		}
+
+		for _, item := range resp.Kvs {
+			value := &metapb.HealthTransition{}
+			err := value.Unmarshal(item.Value)
+			if err != nil {
+				return err
+			}
+
+			err = fn(value)
+			if err != nil {
+				return err
+			}
+
+			start = string(item.Key) + "\x00"
+		}
+
+		// read complete
+		if len(resp.Kvs) < int(scanLimit) {
+			break
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,142 @@
+package store
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	"github.com/coreos/etcd/clientv3"
+	"github.com/fagongzi/gateway/pkg/pb/metapb"
+	"github.com/fagongzi/log"
+)
+
+// revertValue is the value of a meta key at a revision, ok is false if the key is missing
+type revertValue struct {
+	data []byte
+	ok   bool
+}
+
+func (v revertValue) equal(value revertValue) bool {
+	return v.ok == value.ok && bytes.Equal(v.data, value.data)
+}
+
+// Revert restores the meta that changed by the records in one txn, the meta changed by multiple records is
+// restored to the value before the earliest record. The meta changed again after the records is a conflict,
+// the revert fails with ErrStaleOP unless force. Returns the revision of the revert, 0 means no changes.
+// The revisions that compacted by etcd can not be reverted.
+func (e *EtcdStore) Revert(records []metapb.ChangeRecord, force bool) (int64, error) {
+	e.Lock()
+	defer e.Unlock()
+
+	sorted := make([]metapb.ChangeRecord, len(records))
+	copy(sorted, records)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].From < sorted[j].From
+	})
+
+	targets := make(map[string]revertValue)
+	latest := make(map[string]revertValue)
+	for _, record := range sorted {
+		if record.From <= 0 || record.From > record.To {
+			return 0, fmt.Errorf("error revisions: %d, %d", record.From, record.To)
+		}
+
+		fromValues, err := e.getRevertValues(record.From)
+		if err != nil {
+			return 0, err
+		}
+
+		toValues, err := e.getRevertValues(record.To)
+		if err != nil {
+			return 0, err
+		}
+
+		for key := range mergeKeys(fromValues, toValues) {
+			from, to := fromValues[key], toValues[key]
+			if from.equal(to) {
+				continue
+			}
+
+			if _, ok := targets[key]; !ok {
+				targets[key] = from
+			}
+			latest[key] = to
+		}
+	}
+
+	if len(targets) == 0 {
+		return 0, nil
+	}
+
+	current, err := e.getRevertValues(0)
+	if err != nil {
+		return 0, err
+	}
+
+	keys := make([]string, 0, len(targets))
+	for key := range targets {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	ops := make([]clientv3.Op, 0, len(keys))
+	for _, key := range keys {
+		if !current[key].equal(latest[key]) {
+			if !force {
+				log.Warnf("revert failed, meta <%s> is changed after the records", key)
+				return 0, ErrStaleOP
+			}
+
+			log.Warnf("revert meta <%s> that changed after the records", key)
+		}
+
+		if target := targets[key]; target.ok {
+			ops = append(ops, clientv3.OpPut(key, string(target.data)))
+		} else {
+			ops = append(ops, clientv3.OpDelete(key))
+		}
+	}
+
+	rsp, err := e.txn().Then(ops...).Commit()
+	if err != nil {
+		return 0, err
+	}
+
+	return rsp.Header.Revision, nil
+}
+
+// getRevertValues returns the meta values that can be reverted at the revision, 0 means the current revision
+func (e *EtcdStore) getRevertValues(rev int64) (map[string]revertValue, error) {
+	values := make(map[string]revertValue)
+	for _, kind := range e.diffKinds() {
+		kvs, err := e.getRawValues(kind.prefix, rev)
+		if err != nil {
+			return nil, err
+		}
+
+		for key, data := range kvs {
+			values[key] = revertValue{data: data, ok: true}
+		}
+	}
+
+	rsp, err := e.get(e.policyPath, clientv3.WithRev(rev))
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range rsp.Kvs {
+		values[string(item.Key)] = revertValue{data: item.Value, ok: true}
+	}
+
+	return values, nil
+}
+
+func mergeKeys(values ...map[string]revertValue) map[string]struct{} {
+	keys := make(map[string]struct{})
+	for _, value := range values {
+		for key := range value {
+			keys[key] = struct{}{}
+		}
+	}
+
+	return keys
+}
@@ -0,0 +1,86 @@
+package store
+
+import (
+	"fmt"
+
+	"github.com/coreos/etcd/clientv3"
+	"github.com/fagongzi/gateway/pkg/pb/metapb"
+)
+
+const (
+	// usageBatchSize is the max ops in a txn
+	usageBatchSize = 100
+)
+
+// PutConsumerUsages save the consumer usages that collected from the proxies,
+// the usage of the same day, consumer, api, proxy and epoch is overwritten
+func (e *EtcdStore) PutConsumerUsages(values []*metapb.ConsumerUsage) error {
+	e.Lock()
+	defer e.Unlock()
+
+	var ops []clientv3.Op
+	for _, value := range values {
+		data, err := value.Marshal()
+		if err != nil {
+			return err
+		}
+
+		ops = append(ops, e.op(e.getUsageKey(value), string(data)))
+		if len(ops) == usageBatchSize {
+			err = e.putBatch(ops...)
+			if err != nil {
+				return err
+			}
+			ops = ops[:0]
+		}
+	}
+
+	return e.putBatch(ops...)
+}
+
+// GetConsumerUsages returns the consumer usages between the days(yyyy-mm-dd), both inclusive
+func (e *EtcdStore) GetConsumerUsages(from, to string, fn func(*metapb.ConsumerUsage) error) error {
+	e.RLock()
+	defer e.RUnlock()
+
+	start := fmt.Sprintf("%s/%s", e.usageDir, from)
+	withRange := clientv3.WithRange(clientv3.GetPrefixRangeEnd(fmt.Sprintf("%s/%s/", e.usageDir, to)))
+	withLimit := clientv3.WithLimit(scanLimit)
+
+	for {
+		resp, err := e.get(start, withRange, withLimit)
+		if err != nil {
+			return err
+		}
+
+		for _, item := range resp.Kvs {
+			value := &metapb.ConsumerUsage{}
+			err := value.Unmarshal(item.Value)
+			if err != nil {
+				return err
+			}
+
+			err = fn(value)
+			if err != nil {
+				return err
+			}
+
+			start = string(item.Key) + "\x00"
+		}
+
+		// read complete
+		if len(resp.Kvs) < int(scanLimit) {
+			break
+		}
+	}
+
+	return nil
+}
+
+// RemoveConsumerUsages remove the consumer usages before the day(yyyy-mm-dd)
+func (e *EtcdStore) RemoveConsumerUsages(before string) error {
+	e.Lock()
+	defer e.Unlock()
+
+	return e.delete(fmt.Sprintf("%s/", e.usageDir), clientv3.WithRange(fmt.Sprintf("%s/%s", e.usageDir, before)))
+}
@@ -36,8 +36,15 @@ func (e *EtcdStore) doWatch() {
 				return
 			}
 
+			// the write time is written in the same txn of the meta changes
+			writeAts := make(map[int64]int64)
+			for _, ev := range wresp.Events {
+				if string(ev.Kv.Key) == e.writeAtPath {
+					writeAts[ev.Kv.ModRevision], _ = format.ParseStrInt64(string(ev.Kv.Value))
+				}
+			}
+
 			for _, ev := range wresp.Events {
-				var evtSrc EvtSrc
 				var evtType EvtType
 
 				switch ev.Type {
@@ -52,26 +59,18 @@ func (e *EtcdStore) doWatch() {
 				}
 
 				key := string(ev.Kv.Key)
-				if strings.HasPrefix(key, e.clustersDir) {
-					evtSrc = EventSrcCluster
-				} else if strings.HasPrefix(key, e.serversDir) {
-					evtSrc = EventSrcServer
-				} else if strings.HasPrefix(key, e.bindsDir) {
-					evtSrc = EventSrcBind
-				} else if strings.HasPrefix(key, e.apisDir) {
-					evtSrc = EventSrcAPI
-				} else if strings.HasPrefix(key, e.routingsDir) {
-					evtSrc = EventSrcRouting
-				} else if strings.HasPrefix(key, e.proxiesDir) {
-					evtSrc = EventSrcProxy
-				} else {
+				evtSrc, ok := e.watchSrc(key)
+				if !ok {
 					continue
 				}
 
 				log.Debugf("watch event: <%s, %v>",
 					key,
 					evtType)
-				e.evtCh <- e.watchMethodMapping[evtSrc](evtType, ev.Kv)
+				evt := e.watchMethodMapping[evtSrc](evtType, ev.Kv)
+				evt.Revision = ev.Kv.ModRevision
+				evt.WriteAt = writeAts[ev.Kv.ModRevision]
+				e.evtCh <- evt
 			}
 		}
 
@@ -84,7 +83,60 @@ func (e *EtcdStore) doWatch() {
 	}
 }
 
-func (e *EtcdStore) doWatchWithCluster(evtType EvtType, kv *mvccpb.KeyValue) *Evt {
+// WatchKillSwitch watch the kill switch with a dedicated watcher, so the kill switch events are not
+// queued behind the other meta events
+func (e *EtcdStore) WatchKillSwitch(evtCh chan *Evt, stopCh chan bool) error {
+	log.Infof("watch kill switch at: <%s>",
+		e.killPath)
+
+	watcher := clientv3.NewWatcher(e.rawClient)
+	defer watcher.Close()
+
+	ctx := e.rawClient.Ctx()
+	for {
+		rch := watcher.Watch(ctx, e.killPath)
+		for wresp := range rch {
+			if wresp.Canceled {
+				return nil
+			}
+
+			for _, ev := range wresp.Events {
+				evtType := EventTypeUpdate
+				if ev.Type == mvccpb.DELETE {
+					evtType = EventTypeDelete
+				}
+
+				log.Debugf("watch kill switch event: <%v>",
+					evtType)
+				evtCh <- e.doWatchWithKillSwitch(evtType, ev.Kv)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			// server closed, return
+			return nil
+		case <-stopCh:
+			return nil
+		default:
+		}
+	}
+}
+
+func (d *metaDirs) doWatchWithKillSwitch(evtType EvtType, kv *mvccpb.KeyValue) *Evt {
+	value := &metapb.KillSwitch{}
+	if len(kv.Value) > 0 {
+		protoc.MustUnmarshal(value, []byte(kv.Value))
+	}
+
+	return &Evt{
+		Src:   EventSrcKillSwitch,
+		Type:  evtType,
+		Value: value,
+	}
+}
+
+func (d *metaDirs) doWatchWithCluster(evtType EvtType, kv *mvccpb.KeyValue) *Evt {
 	value := &metapb.Cluster{}
 	if len(kv.Value) > 0 {
 		protoc.MustUnmarshal(value, []byte(kv.Value))
@@ -93,12 +145,12 @@ func (e *EtcdStore) doWatchWithCluster(evtType EvtType, kv *mvccpb.KeyValue) *Ev
 	return &Evt{
 		Src:   EventSrcCluster,
 		Type:  evtType,
-		Key:   strings.Replace(string(kv.Key), fmt.Sprintf("%s/", e.clustersDir), "", 1),
+		Key:   strings.Replace(string(kv.Key), fmt.Sprintf("%s/", d.clustersDir), "", 1),
 		Value: value,
 	}
 }
 
-func (e *EtcdStore) doWatchWithServer(evtType EvtType, kv *mvccpb.KeyValue) *Evt {
+func (d *metaDirs) doWatchWithServer(evtType EvtType, kv *mvccpb.KeyValue) *Evt {
 	value := &metapb.Server{}
 	if len(kv.Value) > 0 {
 		protoc.MustUnmarshal(value, []byte(kv.Value))
@@ -107,14 +159,14 @@ func (e *EtcdStore) doWatchWithServer(evtType EvtType, kv *mvccpb.KeyValue) *Evt
 	return &Evt{
 		Src:   EventSrcServer,
 		Type:  evtType,
-		Key:   strings.Replace(string(kv.Key), fmt.Sprintf("%s/", e.serversDir), "", 1),
+		Key:   strings.Replace(string(kv.Key), fmt.Sprintf("%s/", d.serversDir), "", 1),
 		Value: value,
 	}
 }
 
-func (e *EtcdStore) doWatchWithBind(evtType EvtType, kv *mvccpb.KeyValue) *Evt {
+func (d *metaDirs) doWatchWithBind(evtType EvtType, kv *mvccpb.KeyValue) *Evt {
 	// bind key is: bindsDir/clusterID/serverID
-	key := strings.Replace(string(kv.Key), fmt.Sprintf("%s/", e.bindsDir), "", 1)
+	key := strings.Replace(string(kv.Key), fmt.Sprintf("%s/", d.bindsDir), "", 1)
 	infos := strings.SplitN(key, "/", 2)
 
 	return &Evt{
@@ -128,7 +180,7 @@ func (e *EtcdStore) doWatchWithBind(evtType EvtType, kv *mvccpb.KeyValue) *Evt {
 	}
 }
 
-func (e *EtcdStore) doWatchWithAPI(evtType EvtType, kv *mvccpb.KeyValue) *Evt {
+func (d *metaDirs) doWatchWithAPI(evtType EvtType, kv *mvccpb.KeyValue) *Evt {
 	value := &metapb.API{}
 	if len(kv.Value) > 0 {
 		protoc.MustUnmarshal(value, []byte(kv.Value))
@@ -137,12 +189,12 @@ func (e *EtcdStore) doWatchWithAPI(evtType EvtType, kv *mvccpb.KeyValue) *Evt {
 	return &Evt{
 		Src:   EventSrcAPI,
 		Type:  evtType,
-		Key:   strings.Replace(string(kv.Key), fmt.Sprintf("%s/", e.apisDir), "", 1),
+		Key:   strings.Replace(string(kv.Key), fmt.Sprintf("%s/", d.apisDir), "", 1),
 		Value: value,
 	}
 }
 
-func (e *EtcdStore) doWatchWithRouting(evtType EvtType, kv *mvccpb.KeyValue) *Evt {
+func (d *metaDirs) doWatchWithRouting(evtType EvtType, kv *mvccpb.KeyValue) *Evt {
 	value := &metapb.Routing{}
 	if len(kv.Value) > 0 {
 		protoc.MustUnmarshal(value, []byte(kv.Value))
@@ -151,12 +203,12 @@ func (e *EtcdStore) doWatchWithRouting(evtType EvtType, kv *mvccpb.KeyValue) *Ev
 	return &Evt{
 		Src:   EventSrcRouting,
 		Type:  evtType,
-		Key:   strings.Replace(string(kv.Key), fmt.Sprintf("%s/", e.routingsDir), "", 1),
+		Key:   strings.Replace(string(kv.Key), fmt.Sprintf("%s/", d.routingsDir), "", 1),
 		Value: value,
 	}
 }
 
-func (e *EtcdStore) doWatchWithProxy(evtType EvtType, kv *mvccpb.KeyValue) *Evt {
+func (d *metaDirs) doWatchWithProxy(evtType EvtType, kv *mvccpb.KeyValue) *Evt {
 	value := &metapb.Proxy{}
 	if len(kv.Value) > 0 {
 		protoc.MustUnmarshal(value, []byte(kv.Value))
@@ -165,16 +217,111 @@ func (e *EtcdStore) doWatchWithProxy(evtType EvtType, kv *mvccpb.KeyValue) *Evt
 	return &Evt{
 		Src:   EventSrcProxy,
 		Type:  evtType,
-		Key:   strings.Replace(string(kv.Key), fmt.Sprintf("%s/", e.proxiesDir), "", 1),
+		Key:   strings.Replace(string(kv.Key), fmt.Sprintf("%s/", d.proxiesDir), "", 1),
+		Value: value,
+	}
+}
+
+func (d *metaDirs) doWatchWithProxyOverride(evtType EvtType, kv *mvccpb.KeyValue) *Evt {
+	value := &metapb.ProxyOverride{}
+	if len(kv.Value) > 0 {
+		protoc.MustUnmarshal(value, []byte(kv.Value))
+	}
+
+	return &Evt{
+		Src:   EventSrcProxyOverride,
+		Type:  evtType,
+		Key:   strings.Replace(string(kv.Key), fmt.Sprintf("%s/", d.overrideDir), "", 1),
+		Value: value,
+	}
+}
+
+func (d *metaDirs) doWatchWithAPITemplate(evtType EvtType, kv *mvccpb.KeyValue) *Evt {
+	value := &metapb.APITemplate{}
+	if len(kv.Value) > 0 {
+		protoc.MustUnmarshal(value, []byte(kv.Value))
+	}
+
+	return &Evt{
+		Src:   EventSrcAPITemplate,
+		Type:  evtType,
+		Key:   strings.Replace(string(kv.Key), fmt.Sprintf("%s/", d.tplsDir), "", 1),
+		Value: value,
+	}
+}
+
+func (d *metaDirs) doWatchWithConsumer(evtType EvtType, kv *mvccpb.KeyValue) *Evt {
+	value := &metapb.Consumer{}
+	if len(kv.Value) > 0 {
+		protoc.MustUnmarshal(value, []byte(kv.Value))
+	}
+
+	return &Evt{
+		Src:   EventSrcConsumer,
+		Type:  evtType,
+		Key:   strings.Replace(string(kv.Key), fmt.Sprintf("%s/", d.consumerDir), "", 1),
+		Value: value,
+	}
+}
+
+func (d *metaDirs) doWatchWithRateLimit(evtType EvtType, kv *mvccpb.KeyValue) *Evt {
+	value := &metapb.RateLimit{}
+	if len(kv.Value) > 0 {
+		protoc.MustUnmarshal(value, []byte(kv.Value))
+	}
+
+	return &Evt{
+		Src:   EventSrcRateLimit,
+		Type:  evtType,
+		Key:   strings.Replace(string(kv.Key), fmt.Sprintf("%s/", d.limitsDir), "", 1),
+		Value: value,
+	}
+}
+
+func (d *metaDirs) doWatchWithProtoDescriptor(evtType EvtType, kv *mvccpb.KeyValue) *Evt {
+	value := &metapb.ProtoDescriptor{}
+	if len(kv.Value) > 0 {
+		protoc.MustUnmarshal(value, []byte(kv.Value))
+	}
+
+	return &Evt{
+		Src:   EventSrcProtoDescriptor,
+		Type:  evtType,
+		Key:   strings.Replace(string(kv.Key), fmt.Sprintf("%s/", d.protosDir), "", 1),
+		Value: value,
+	}
+}
+
+func (d *metaDirs) doWatchWithPolicy(evtType EvtType, kv *mvccpb.KeyValue) *Evt {
+	value := &metapb.Policy{}
+	if len(kv.Value) > 0 {
+		protoc.MustUnmarshal(value, []byte(kv.Value))
+	}
+
+	return &Evt{
+		Src:   EventSrcPolicy,
+		Type:  evtType,
 		Value: value,
 	}
 }
 
 func (e *EtcdStore) init() {
-	e.watchMethodMapping[EventSrcBind] = e.doWatchWithBind
-	e.watchMethodMapping[EventSrcServer] = e.doWatchWithServer
-	e.watchMethodMapping[EventSrcCluster] = e.doWatchWithCluster
-	e.watchMethodMapping[EventSrcAPI] = e.doWatchWithAPI
-	e.watchMethodMapping[EventSrcRouting] = e.doWatchWithRouting
-	e.watchMethodMapping[EventSrcProxy] = e.doWatchWithProxy
+	e.watchMethodMapping = e.watchMethods()
+}
+
+func (d *metaDirs) watchMethods() map[EvtSrc]func(EvtType, *mvccpb.KeyValue) *Evt {
+	return map[EvtSrc]func(EvtType, *mvccpb.KeyValue) *Evt{
+		EventSrcBind:            d.doWatchWithBind,
+		EventSrcServer:          d.doWatchWithServer,
+		EventSrcCluster:         d.doWatchWithCluster,
+		EventSrcAPI:             d.doWatchWithAPI,
+		EventSrcRouting:         d.doWatchWithRouting,
+		EventSrcProxy:           d.doWatchWithProxy,
+		EventSrcProxyOverride:   d.doWatchWithProxyOverride,
+		EventSrcAPITemplate:     d.doWatchWithAPITemplate,
+		EventSrcConsumer:        d.doWatchWithConsumer,
+		EventSrcPolicy:          d.doWatchWithPolicy,
+		EventSrcRateLimit:       d.doWatchWithRateLimit,
+		EventSrcProtoDescriptor: d.doWatchWithProtoDescriptor,
+	}
 }
@@ -0,0 +1,43 @@
+package store
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/coreos/etcd/clientv3"
+	"github.com/fagongzi/util/format"
+)
+
+// stampWriteAt appends the write time of the meta changes to the ops, the write time is written
+// in the same txn, so the watchers can get the write time by the revision of the changes
+func (e *EtcdStore) stampWriteAt(ops []clientv3.Op) []clientv3.Op {
+	for _, op := range ops {
+		if (op.IsPut() || op.IsDelete()) && e.isMetaKey(string(op.KeyBytes())) {
+			values := make([]clientv3.Op, 0, len(ops)+1)
+			values = append(values, ops...)
+			return append(values, clientv3.OpPut(e.writeAtPath,
+				strconv.FormatInt(time.Now().UnixNano(), 10)))
+		}
+	}
+
+	return ops
+}
+
+// GetLastWrite returns the revision and the write time of the last meta changes, 0 means no
+// changes was written since the write time supported
+func (e *EtcdStore) GetLastWrite() (int64, int64, error) {
+	rsp, err := e.get(e.writeAtPath)
+	if err != nil {
+		return 0, 0, err
+	}
+	if len(rsp.Kvs) == 0 {
+		return 0, 0, nil
+	}
+
+	writeAt, err := format.ParseStrInt64(string(rsp.Kvs[0].Value))
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return rsp.Kvs[0].ModRevision, writeAt, nil
+}
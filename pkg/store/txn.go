@@ -4,21 +4,25 @@ import (
 	"time"
 
 	"github.com/coreos/etcd/clientv3"
-	"github.com/fagongzi/log"
 	"golang.org/x/net/context"
 )
 
-// slowLogTxn wraps etcd transaction and log slow one.
+// slowLogTxn wraps etcd transaction, records the metrics and logs slow one.
+// The stamp appends the ops to the ops of Then, e.g. the write time of the meta changes.
 type slowLogTxn struct {
 	clientv3.Txn
 	cancel context.CancelFunc
+	hasCmp bool
+	ops    []clientv3.Op
+	stamp  func([]clientv3.Op) []clientv3.Op
 }
 
-func newSlowLogTxn(client *clientv3.Client) clientv3.Txn {
+func newSlowLogTxn(client *clientv3.Client, stamp func([]clientv3.Op) []clientv3.Op) clientv3.Txn {
 	ctx, cancel := context.WithTimeout(client.Ctx(), DefaultRequestTimeout)
 	return &slowLogTxn{
 		Txn:    client.Txn(ctx),
 		cancel: cancel,
+		stamp:  stamp,
 	}
 }
 
@@ -26,13 +30,23 @@ func (t *slowLogTxn) If(cs ...clientv3.Cmp) clientv3.Txn {
 	return &slowLogTxn{
 		Txn:    t.Txn.If(cs...),
 		cancel: t.cancel,
+		hasCmp: t.hasCmp || len(cs) > 0,
+		ops:    t.ops,
+		stamp:  t.stamp,
 	}
 }
 
 func (t *slowLogTxn) Then(ops ...clientv3.Op) clientv3.Txn {
+	if t.stamp != nil {
+		ops = t.stamp(ops)
+	}
+
 	return &slowLogTxn{
 		Txn:    t.Txn.Then(ops...),
 		cancel: t.cancel,
+		hasCmp: t.hasCmp,
+		ops:    append(t.ops, ops...),
+		stamp:  t.stamp,
 	}
 }
 
@@ -42,17 +56,43 @@ func (t *slowLogTxn) Commit() (*clientv3.TxnResponse, error) {
 	resp, err := t.Txn.Commit()
 	t.cancel()
 
-	cost := time.Now().Sub(start)
-	if cost > DefaultSlowRequestTime {
-		log.Warn("slow: txn runs too slow, resp=<%v> cost=<%s> errors:\n %+v",
-			resp,
-			cost,
-			err)
+	opType, key, size := t.describe()
+	observeOP(opType, key, size, start, err)
+	return resp, err
+}
+
+// describe returns the op type, the first key and the payload size of this txn
+func (t *slowLogTxn) describe() (string, string, int) {
+	opType := ""
+	key := ""
+	size := 0
+	for _, op := range t.ops {
+		if key == "" {
+			key = string(op.KeyBytes())
+		}
+		size += len(op.KeyBytes()) + len(op.ValueBytes())
+
+		value := opTypeTxn
+		if op.IsPut() {
+			value = opTypePut
+		} else if op.IsDelete() {
+			value = opTypeDelete
+		}
+
+		if opType == "" {
+			opType = value
+		} else if opType != value {
+			opType = opTypeTxn
+		}
 	}
 
-	return resp, err
+	if t.hasCmp || opType == "" {
+		opType = opTypeTxn
+	}
+
+	return opType, key, size
 }
 
 func (e *EtcdStore) txn() clientv3.Txn {
-	return newSlowLogTxn(e.rawClient)
+	return newSlowLogTxn(e.rawClient, e.stampWriteAt)
 }
@@ -1,7 +1,10 @@
 package util
 
 import (
+	"context"
+	"sort"
 	"sync"
+	stdatomic "sync/atomic"
 	"time"
 
 	"github.com/fagongzi/goetty"
@@ -12,13 +15,27 @@ import (
 type point struct {
 	requests          atomic.Int64
 	rejects           atomic.Int64
+	retries           atomic.Int64
 	failure           atomic.Int64
 	successed         atomic.Int64
 	continuousFailure atomic.Int64
-
-	costs atomic.Int64
-	max   atomic.Int64
-	min   atomic.Int64
+	violations        atomic.Int64
+	connections       atomic.Int64
+	// sessions, messages and durations are the totals of the long-lived connections, the durations
+	// are the lifetimes of the closed connections
+	sessions  atomic.Int64
+	messages  atomic.Int64
+	durations atomic.Int64
+	timeouts  [phaseCount]atomic.Int64
+	failures  [failureKindCount]atomic.Int64
+	latencies [latencyBucketCount + 1]atomic.Int64
+	costs     atomic.Int64
+
+	// updated by the compare and swap
+	max    int64
+	min    int64
+	ewma   int64
+	phases [phaseCount]int64
 }
 
 func (p *point) dump(target *point) {
@@ -26,21 +43,99 @@ func (p *point) dump(target *point) {
 	target.rejects.Set(p.rejects.Get())
 	target.failure.Set(p.failure.Get())
 	target.successed.Set(p.successed.Get())
-	target.max.Set(p.max.Get())
-	target.min.Set(p.min.Get())
+	target.violations.Set(p.violations.Get())
+	stdatomic.StoreInt64(&target.max, stdatomic.SwapInt64(&p.max, 0))
+	stdatomic.StoreInt64(&target.min, stdatomic.SwapInt64(&p.min, 0))
 	target.costs.Set(p.costs.Get())
+	for i := range p.latencies {
+		target.latencies[i].Set(p.latencies[i].Get())
+	}
+}
+
+// ewmaWeight the weight of the latest latency in the latency EWMA is 1/ewmaWeight
+const ewmaWeight = 5
+
+// latencyBucketCount the latency histogram has the exponential buckets from 0.5ms to 262s, and a
+// bucket for the larger latency
+const latencyBucketCount = 20
+
+var latencyBuckets = func() []int64 {
+	values := make([]int64, latencyBucketCount)
+	for i := range values {
+		values[i] = int64(time.Microsecond*500) << uint(i)
+	}
+	return values
+}()
+
+// FailureKind is the class of the failed requests
+type FailureKind int
+
+const (
+	// FailureUpstreamTimeout the backend or the deadline of the request timed out
+	FailureUpstreamTimeout = FailureKind(iota)
+	// FailureUpstreamRefused the backend can not be connected, or the connection is broken
+	FailureUpstreamRefused
+	// FailureUpstream5xx the backend returns a 5xx response
+	FailureUpstream5xx
+	// FailureGatewayReject the gateway rejects the request to protect the backends, e.g. the circuit
+	// breaker, the rate limits and no available server
+	FailureGatewayReject
+	// FailureClientAbort the client canceled the request
+	FailureClientAbort
+	// FailureFilterDenied the request is denied by the filters, e.g. the authentication and the validation
+	FailureFilterDenied
+
+	failureKindCount = int(FailureFilterDenied) + 1
+)
+
+var failureKindNames = [failureKindCount]string{"upstream-timeout", "upstream-refused", "upstream-5xx",
+	"gateway-reject", "client-abort", "filter-denied"}
 
-	p.min.Set(0)
-	p.max.Set(0)
+func (k FailureKind) String() string {
+	return failureKindNames[k]
 }
 
-// Analysis analysis struct
+// FailureKinds returns all classes of the failed requests
+func FailureKinds() []FailureKind {
+	return []FailureKind{FailureUpstreamTimeout, FailureUpstreamRefused, FailureUpstream5xx,
+		FailureGatewayReject, FailureClientAbort, FailureFilterDenied}
+}
+
+type eventType int
+
+const (
+	eventRequest = eventType(iota)
+	eventResponse
+	eventFailure
+	eventReject
+	eventViolation
+	eventTrace
+	eventTimeout
+	eventMessage
+	eventRetry
+	eventClassify
+)
+
+// event is an update of the analysis that recorded by the recorder
+type event struct {
+	eventType eventType
+	key       uint64
+	cost      int64
+	phase     Phase
+	failure   FailureKind
+	durations [phaseCount]time.Duration
+}
+
+// Analysis analysis struct, the points are updated by the atomic operations without the lock, the
+// lock is only used by the registration of the points and the recently points
 type Analysis struct {
 	sync.RWMutex
 
 	tw             *goetty.TimeoutWheel
-	points         map[uint64]*point
+	points         sync.Map // key -> *point
 	recentlyPoints map[uint64]map[time.Duration]*Recently
+	events         chan event
+	dropped        atomic.Int64
 }
 
 // Recently recently point data
@@ -56,9 +151,11 @@ type Recently struct {
 	successed int64
 	failure   int64
 	rejects   int64
+	violation int64
 	max       int64
 	min       int64
 	avg       int64
+	latencies [latencyBucketCount + 1]int64
 }
 
 func newRecently(key uint64, period time.Duration) *Recently {
@@ -77,13 +174,42 @@ func newPoint() *point {
 // NewAnalysis returns a Analysis
 func NewAnalysis(tw *goetty.TimeoutWheel) *Analysis {
 	return &Analysis{
-		points:         make(map[uint64]*point),
 		recentlyPoints: make(map[uint64]map[time.Duration]*Recently),
 		tw:             tw,
 	}
 }
 
-// RemoveTarget remove analysis point on a key
+// EnableRecorder make the updates asynchronous, the updates are sent to a channel with the size
+// and applied by the RunRecorder, the updates are dropped if the channel is full, so that the
+// callers are never blocked by the analysis. It must be called before any updates
+func (a *Analysis) EnableRecorder(size int) {
+	if size > 0 {
+		a.events = make(chan event, size)
+	}
+}
+
+// RunRecorder apply the updates sent to the channel until the ctx is done
+func (a *Analysis) RunRecorder(ctx context.Context) {
+	if a.events == nil {
+		return
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case e := <-a.events:
+			a.apply(e)
+		}
+	}
+}
+
+// Dropped returns the count of the updates that dropped because the channel is full
+func (a *Analysis) Dropped() int64 {
+	return a.dropped.Get()
+}
+
+// RemoveTarget remove analysis point on a key, and stop the tickers of the recently points
 func (a *Analysis) RemoveTarget(key uint64) {
 	a.Lock()
 	defer a.Unlock()
@@ -94,7 +220,7 @@ func (a *Analysis) RemoveTarget(key uint64) {
 		}
 	}
 
-	delete(a.points, key)
+	a.points.Delete(key)
 	delete(a.recentlyPoints, key)
 }
 
@@ -107,9 +233,7 @@ func (a *Analysis) AddTarget(key uint64, interval time.Duration) {
 		return
 	}
 
-	if _, ok := a.points[key]; !ok {
-		a.points[key] = &point{}
-	}
+	a.points.LoadOrStore(key, newPoint())
 
 	if _, ok := a.recentlyPoints[key]; !ok {
 		a.recentlyPoints[key] = make(map[time.Duration]*Recently)
@@ -148,6 +272,21 @@ func (a *Analysis) GetRecentlyRequestCount(server uint64, interval time.Duration
 	return value
 }
 
+// GetRecentlyViolationCount return the contract violation count in spec duration
+func (a *Analysis) GetRecentlyViolationCount(key uint64, interval time.Duration) int {
+	a.RLock()
+
+	point := a.getPoint(key, interval)
+	if point == nil {
+		a.RUnlock()
+		return 0
+	}
+
+	value := int(point.violation)
+	a.RUnlock()
+	return value
+}
+
 // GetRecentlyMax return max latency in spec secs
 func (a *Analysis) GetRecentlyMax(server uint64, interval time.Duration) int {
 	a.RLock()
@@ -192,6 +331,37 @@ func (a *Analysis) GetRecentlyAvg(server uint64, interval time.Duration) int {
 	return value
 }
 
+// GetRecentlyPercentile return the percentile(0-100) latency in spec duration, the latency is
+// estimated by the histogram buckets
+func (a *Analysis) GetRecentlyPercentile(server uint64, interval time.Duration, percentile float64) int {
+	a.RLock()
+
+	point := a.getPoint(server, interval)
+	if point == nil {
+		a.RUnlock()
+		return 0
+	}
+
+	value := point.percentile(percentile)
+	a.RUnlock()
+	return value
+}
+
+// GetRecentlyP50 return the median latency in spec duration
+func (a *Analysis) GetRecentlyP50(server uint64, interval time.Duration) int {
+	return a.GetRecentlyPercentile(server, interval, 50)
+}
+
+// GetRecentlyP95 return the p95 latency in spec duration
+func (a *Analysis) GetRecentlyP95(server uint64, interval time.Duration) int {
+	return a.GetRecentlyPercentile(server, interval, 95)
+}
+
+// GetRecentlyP99 return the p99 latency in spec duration
+func (a *Analysis) GetRecentlyP99(server uint64, interval time.Duration) int {
+	return a.GetRecentlyPercentile(server, interval, 99)
+}
+
 // GetQPS return qps in spec duration
 func (a *Analysis) GetQPS(server uint64, interval time.Duration) int {
 	a.RLock()
@@ -292,62 +462,352 @@ func (a *Analysis) GetRecentlyRequestFailureCount(server uint64, interval time.D
 	return value
 }
 
+// RecentlyStat is the recently point of an interval, the interval is in seconds, the latencies are
+// in milliseconds
+type RecentlyStat struct {
+	Interval  int64 `json:"interval"`
+	QPS       int   `json:"qps"`
+	Requests  int64 `json:"requests"`
+	Successed int64 `json:"successed"`
+	Failure   int64 `json:"failure"`
+	Rejects   int64 `json:"rejects"`
+	Max       int64 `json:"max"`
+	Min       int64 `json:"min"`
+	Avg       int64 `json:"avg"`
+}
+
+// AnalysisRecently is the recently points of an analysis target
+type AnalysisRecently struct {
+	Kind     string          `json:"kind"`
+	ID       uint64          `json:"id"`
+	Name     string          `json:"name"`
+	Recently []*RecentlyStat `json:"recently"`
+}
+
+// GetRecentlyStats returns the recently points of all intervals of the key, sorted by the interval
+func (a *Analysis) GetRecentlyStats(key uint64) []*RecentlyStat {
+	a.RLock()
+	defer a.RUnlock()
+
+	var values []*RecentlyStat
+	for interval, r := range a.recentlyPoints[key] {
+		values = append(values, &RecentlyStat{
+			Interval:  int64(interval / time.Second),
+			QPS:       r.qps,
+			Requests:  r.requests,
+			Successed: r.successed,
+			Failure:   r.failure,
+			Rejects:   r.rejects,
+			Max:       r.max,
+			Min:       r.min,
+			Avg:       r.avg,
+		})
+	}
+
+	sort.Slice(values, func(i, j int) bool {
+		return values[i].Interval < values[j].Interval
+	})
+	return values
+}
+
+// AnalysisStat is the totals of the analysis point since the point added, latencies are the
+// counts of the latency histogram buckets, the last one is the count of the larger latency
+type AnalysisStat struct {
+	Kind      string  `json:"kind"`
+	Name      string  `json:"name"`
+	Requests  int64   `json:"requests"`
+	Successed int64   `json:"successed"`
+	Failure   int64   `json:"failure"`
+	Rejects   int64   `json:"rejects"`
+	Retries   int64   `json:"retries"`
+	QPS       int     `json:"qps"`
+	Costs     int64   `json:"costs"`
+	Latencies []int64 `json:"latencies"`
+	// Connections is the current long-lived connections(e.g. websocket), Sessions is the total
+	// connections, Durations is the total lifetime(ns) of the closed connections
+	Connections int64 `json:"connections"`
+	Sessions    int64 `json:"sessions"`
+	Messages    int64 `json:"messages"`
+	Durations   int64 `json:"durations"`
+	// Failures is the counts of the classes of the failed requests, the classes without failures are omitted
+	Failures map[string]int64 `json:"failures,omitempty"`
+}
+
+// LatencyBuckets returns the upper bounds(secs) of the latency histogram buckets
+func LatencyBuckets() []float64 {
+	values := make([]float64, latencyBucketCount)
+	for i, value := range latencyBuckets {
+		values[i] = time.Duration(value).Seconds()
+	}
+	return values
+}
+
+// GetStat return the totals of the key, the qps is the qps in spec duration
+func (a *Analysis) GetStat(key uint64, interval time.Duration) (*AnalysisStat, bool) {
+	p, ok := a.getPointValue(key)
+	if !ok {
+		return nil, false
+	}
+
+	value := &AnalysisStat{
+		Requests:    p.requests.Get(),
+		Successed:   p.successed.Get(),
+		Failure:     p.failure.Get(),
+		Rejects:     p.rejects.Get(),
+		Retries:     p.retries.Get(),
+		Costs:       p.costs.Get(),
+		Latencies:   make([]int64, len(p.latencies)),
+		Connections: p.connections.Get(),
+		Sessions:    p.sessions.Get(),
+		Messages:    p.messages.Get(),
+		Durations:   p.durations.Get(),
+	}
+	for i := range p.latencies {
+		value.Latencies[i] = p.latencies[i].Get()
+	}
+	for _, kind := range FailureKinds() {
+		if n := p.failures[kind].Get(); n > 0 {
+			if value.Failures == nil {
+				value.Failures = make(map[string]int64)
+			}
+			value.Failures[kind.String()] = n
+		}
+	}
+	a.RLock()
+	if point := a.getPoint(key, interval); point != nil {
+		value.QPS = point.qps
+	}
+	a.RUnlock()
+
+	return value, true
+}
+
+// GetFailureCount return the count of the class of the failed requests
+func (a *Analysis) GetFailureCount(key uint64, kind FailureKind) int {
+	p, ok := a.getPointValue(key)
+	if !ok {
+		return 0
+	}
+
+	return int(p.failures[kind].Get())
+}
+
 // GetContinuousFailureCount return Continuous failure request count in spec secs
 func (a *Analysis) GetContinuousFailureCount(server uint64) int {
-	a.RLock()
+	p, ok := a.getPointValue(server)
+	if !ok {
+		return 0
+	}
 
-	p, ok := a.points[server]
+	return int(p.continuousFailure.Get())
+}
+
+// GetConnections return the current long-lived connections
+func (a *Analysis) GetConnections(key uint64) int {
+	p, ok := a.getPointValue(key)
 	if !ok {
-		a.RUnlock()
 		return 0
 	}
 
-	value := int(p.continuousFailure.Get())
-	a.RUnlock()
-	return value
+	return int(p.connections.Get())
+}
+
+// GetLatencyEWMA return the exponentially weighted moving average of the latency
+func (a *Analysis) GetLatencyEWMA(key uint64) time.Duration {
+	p, ok := a.getPointValue(key)
+	if !ok {
+		return 0
+	}
+
+	return time.Duration(stdatomic.LoadInt64(&p.ewma))
+}
+
+// GetPhaseLatencyEWMA return the exponentially weighted moving average of the latency of the upstream phase
+func (a *Analysis) GetPhaseLatencyEWMA(key uint64, phase Phase) time.Duration {
+	p, ok := a.getPointValue(key)
+	if !ok {
+		return 0
+	}
+
+	return time.Duration(stdatomic.LoadInt64(&p.phases[phase]))
+}
+
+// GetTimeoutCount return the total upstream timeouts in the phase
+func (a *Analysis) GetTimeoutCount(key uint64, phase Phase) int {
+	p, ok := a.getPointValue(key)
+	if !ok {
+		return 0
+	}
+
+	return int(p.timeouts[phase].Get())
 }
 
 // Reject incr reject count
 func (a *Analysis) Reject(key uint64) {
-	a.Lock()
-	p := a.points[key]
-	p.rejects.Incr()
-	a.Unlock()
+	a.record(event{eventType: eventReject, key: key})
+}
+
+// Retry incr the retry count, the retries are not counted as the requests
+func (a *Analysis) Retry(key uint64) {
+	a.record(event{eventType: eventRetry, key: key})
 }
 
 // Failure incr failure count
 func (a *Analysis) Failure(key uint64) {
-	a.Lock()
-	p := a.points[key]
-	p.failure.Incr()
-	p.continuousFailure.Incr()
-	a.Unlock()
+	a.record(event{eventType: eventFailure, key: key})
+}
+
+// Classify incr the count of the class of the failed request, the classes are counted separately from
+// the failures and the rejects
+func (a *Analysis) Classify(key uint64, kind FailureKind) {
+	a.record(event{eventType: eventClassify, key: key, failure: kind})
+}
+
+// Violation incr contract violation count
+func (a *Analysis) Violation(key uint64) {
+	a.record(event{eventType: eventViolation, key: key})
+}
+
+// Trace update the latency EWMA of the phases of the completed upstream request, the phases
+// that not happened are skipped, e.g. the dns and connect phases of the reused connections
+func (a *Analysis) Trace(key uint64, trace *HTTPTrace) {
+	a.record(event{eventType: eventTrace, key: key, durations: trace.Durations})
+}
+
+// Timeout incr the upstream timeout count of the phase
+func (a *Analysis) Timeout(key uint64, phase Phase) {
+	a.record(event{eventType: eventTimeout, key: key, phase: phase})
+}
+
+// Connect incr the current connections, the connections are always updated synchronously,
+// since a dropped update never recovers
+func (a *Analysis) Connect(key uint64) {
+	if p, ok := a.getPointValue(key); ok {
+		p.connections.Incr()
+		p.sessions.Incr()
+	}
+}
+
+// Disconnect decr the current connections, the duration is the lifetime of the connection
+func (a *Analysis) Disconnect(key uint64, duration time.Duration) {
+	if p, ok := a.getPointValue(key); ok {
+		p.connections.Add(-1)
+		p.durations.Add(int64(duration))
+	}
+}
+
+// Message incr the message count of the long-lived connections
+func (a *Analysis) Message(key uint64) {
+	a.record(event{eventType: eventMessage, key: key})
 }
 
 // Request incr request count
 func (a *Analysis) Request(key uint64) {
-	a.Lock()
-	p := a.points[key]
-	p.requests.Incr()
-	a.Unlock()
+	a.record(event{eventType: eventRequest, key: key})
 }
 
 // Response incr successed count
 func (a *Analysis) Response(key uint64, cost int64) {
-	a.Lock()
-	p := a.points[key]
-	p.successed.Incr()
-	p.costs.Add(cost)
-	p.continuousFailure.Set(0)
+	a.record(event{eventType: eventResponse, key: key, cost: cost})
+}
 
-	if p.max.Get() < cost {
-		p.max.Set(cost)
+func (a *Analysis) record(e event) {
+	if a.events == nil {
+		a.apply(e)
+		return
 	}
 
-	if p.min.Get() == 0 || p.min.Get() > cost {
-		p.min.Set(cost)
+	select {
+	case a.events <- e:
+	default:
+		a.dropped.Incr()
 	}
-	a.Unlock()
+}
+
+func (a *Analysis) apply(e event) {
+	p, ok := a.getPointValue(e.key)
+	if !ok {
+		return
+	}
+
+	switch e.eventType {
+	case eventRequest:
+		p.requests.Incr()
+	case eventResponse:
+		p.successed.Incr()
+		p.costs.Add(e.cost)
+		p.continuousFailure.Set(0)
+
+		updateMax(&p.max, e.cost)
+		updateMin(&p.min, e.cost)
+
+		updateEWMA(&p.ewma, e.cost)
+		p.latencies[sort.Search(latencyBucketCount, func(i int) bool {
+			return latencyBuckets[i] >= e.cost
+		})].Incr()
+	case eventFailure:
+		p.failure.Incr()
+		p.continuousFailure.Incr()
+	case eventReject:
+		p.rejects.Incr()
+	case eventViolation:
+		p.violations.Incr()
+	case eventTrace:
+		for _, phase := range Phases() {
+			if cost := int64(e.durations[phase]); cost > 0 {
+				updateEWMA(&p.phases[phase], cost)
+			}
+		}
+	case eventTimeout:
+		p.timeouts[e.phase].Incr()
+	case eventMessage:
+		p.messages.Incr()
+	case eventRetry:
+		p.retries.Incr()
+	case eventClassify:
+		p.failures[e.failure].Incr()
+	}
+}
+
+func updateEWMA(value *int64, cost int64) {
+	for {
+		ewma := stdatomic.LoadInt64(value)
+		next := cost
+		if ewma != 0 {
+			next = ewma + (cost-ewma)/ewmaWeight
+		}
+
+		if stdatomic.CompareAndSwapInt64(value, ewma, next) {
+			return
+		}
+	}
+}
+
+func updateMax(value *int64, cost int64) {
+	for {
+		max := stdatomic.LoadInt64(value)
+		if max >= cost || stdatomic.CompareAndSwapInt64(value, max, cost) {
+			return
+		}
+	}
+}
+
+func updateMin(value *int64, cost int64) {
+	for {
+		min := stdatomic.LoadInt64(value)
+		if (min != 0 && min <= cost) || stdatomic.CompareAndSwapInt64(value, min, cost) {
+			return
+		}
+	}
+}
+
+func (a *Analysis) getPointValue(key uint64) (*point, bool) {
+	value, ok := a.points.Load(key)
+	if !ok {
+		return nil, false
+	}
+
+	return value.(*point), true
 }
 
 func (a *Analysis) getPoint(key uint64, interval time.Duration) *Recently {
@@ -368,7 +828,14 @@ func (a *Analysis) recentlyTimeout(arg interface{}) {
 	recently := arg.(*Recently)
 
 	a.RLock()
-	if p, ok := a.points[recently.key]; ok {
+	// the target is removed while the timeout is firing, and maybe added again with a new
+	// recently, so the removed recently must not be rescheduled
+	if a.getPoint(recently.key, recently.period) != recently {
+		a.RUnlock()
+		return
+	}
+
+	if p, ok := a.getPointValue(recently.key); ok {
 		recently.record(p)
 		t, _ := a.tw.Schedule(recently.period, a.recentlyTimeout, recently)
 		recently.timeout = t
@@ -412,14 +879,19 @@ func (r *Recently) calc() {
 		r.rejects = 0
 	}
 
-	r.max = r.current.max.Get()
+	r.violation = r.current.violations.Get() - r.prev.violations.Get()
+	if r.violation < 0 {
+		r.violation = 0
+	}
+
+	r.max = stdatomic.LoadInt64(&r.current.max)
 	if r.max < 0 {
 		r.max = 0
 	} else {
 		r.max = int64(r.max / 1000 / 1000)
 	}
 
-	r.min = r.current.min.Get()
+	r.min = stdatomic.LoadInt64(&r.current.min)
 	if r.min < 0 {
 		r.min = 0
 	} else {
@@ -433,6 +905,13 @@ func (r *Recently) calc() {
 		r.avg = int64(costs / 1000 / 1000 / r.requests)
 	}
 
+	for i := range r.latencies {
+		r.latencies[i] = r.current.latencies[i].Get() - r.prev.latencies[i].Get()
+		if r.latencies[i] < 0 {
+			r.latencies[i] = 0
+		}
+	}
+
 	if r.successed > r.requests {
 		r.qps = int(r.requests / int64(r.period/time.Second))
 	} else {
@@ -440,3 +919,44 @@ func (r *Recently) calc() {
 	}
 
 }
+
+// percentile returns the latency(ms) of the percentile, the latency is interpolated linearly in
+// the bucket that the rank falls in, and the max latency is used as the upper bound of the last
+// bucket
+func (r *Recently) percentile(percentile float64) int {
+	total := int64(0)
+	for _, count := range r.latencies {
+		total += count
+	}
+	if total == 0 {
+		return 0
+	}
+
+	rank := float64(total) * percentile / 100
+	count := int64(0)
+	for i, n := range r.latencies {
+		if n == 0 || float64(count+n) < rank {
+			count += n
+			continue
+		}
+
+		lower := int64(0)
+		if i > 0 {
+			lower = latencyBuckets[i-1]
+		}
+		var upper int64
+		if i < latencyBucketCount {
+			upper = latencyBuckets[i]
+		} else {
+			upper = r.max * int64(time.Millisecond)
+			if upper < lower {
+				upper = lower
+			}
+		}
+
+		value := float64(lower) + float64(upper-lower)*(rank-float64(count))/float64(n)
+		return int(int64(value) / int64(time.Millisecond))
+	}
+
+	return int(r.max)
+}
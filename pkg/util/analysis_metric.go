@@ -0,0 +1,104 @@
+package util
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// AnalysisCollector export the analysis stats as the prometheus metrics, the metrics are labeled
+// by the kind and the name of the stats, e.g. the server address and the api name, after the
+// labels of the collector
+type AnalysisCollector struct {
+	requests  *prometheus.Desc
+	successed *prometheus.Desc
+	failure   *prometheus.Desc
+	rejects   *prometheus.Desc
+	retries   *prometheus.Desc
+	qps       *prometheus.Desc
+	latency   *prometheus.Desc
+	// the descs of the long-lived connections
+	connections *prometheus.Desc
+	sessions    *prometheus.Desc
+	messages    *prometheus.Desc
+	durations   *prometheus.Desc
+	failures    *prometheus.Desc
+	buckets     []float64
+	stats       func(func(stat *AnalysisStat, labelValues ...string))
+}
+
+// NewAnalysisCollector returns a analysis collector, the stats are collected by the stats func
+// with the values of the labels
+func NewAnalysisCollector(labels []string, stats func(func(stat *AnalysisStat, labelValues ...string))) *AnalysisCollector {
+	labels = append(append([]string{}, labels...), "kind", "name")
+	desc := func(name, help string) *prometheus.Desc {
+		return prometheus.NewDesc(prometheus.BuildFQName("gateway", "analysis", name), help, labels, nil)
+	}
+
+	// the failures are labeled by the class of the failed requests after the kind and the name
+	failures := prometheus.NewDesc(prometheus.BuildFQName("gateway", "analysis", "failure_class_total"),
+		"Total number of the analysis failed requests by the class.", append(labels, "class"), nil)
+
+	return &AnalysisCollector{
+		requests:    desc("requests_total", "Total number of the analysis requests."),
+		successed:   desc("successed_total", "Total number of the analysis succeed requests."),
+		failure:     desc("failure_total", "Total number of the analysis failure requests."),
+		rejects:     desc("rejects_total", "Total number of the analysis rejected requests."),
+		retries:     desc("retries_total", "Total number of the analysis retries."),
+		qps:         desc("qps", "Recently qps of the analysis."),
+		latency:     desc("latency_seconds", "Bucketed histogram of the analysis latency of the succeed requests."),
+		connections: desc("connections", "Current number of the analysis long-lived connections."),
+		sessions:    desc("connections_total", "Total number of the analysis long-lived connections."),
+		messages:    desc("messages_total", "Total number of the messages of the analysis long-lived connections."),
+		durations:   desc("connection_duration_seconds_total", "Total duration of the closed analysis long-lived connections."),
+		failures:    failures,
+		buckets:     LatencyBuckets(),
+		stats:       stats,
+	}
+}
+
+// Describe implements prometheus.Collector
+func (c *AnalysisCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.requests
+	ch <- c.successed
+	ch <- c.failure
+	ch <- c.rejects
+	ch <- c.retries
+	ch <- c.qps
+	ch <- c.latency
+	ch <- c.connections
+	ch <- c.sessions
+	ch <- c.messages
+	ch <- c.durations
+	ch <- c.failures
+}
+
+// Collect implements prometheus.Collector
+func (c *AnalysisCollector) Collect(ch chan<- prometheus.Metric) {
+	c.stats(func(stat *AnalysisStat, labelValues ...string) {
+		values := append(append([]string{}, labelValues...), stat.Kind, stat.Name)
+		ch <- prometheus.MustNewConstMetric(c.requests, prometheus.CounterValue, float64(stat.Requests), values...)
+		ch <- prometheus.MustNewConstMetric(c.successed, prometheus.CounterValue, float64(stat.Successed), values...)
+		ch <- prometheus.MustNewConstMetric(c.failure, prometheus.CounterValue, float64(stat.Failure), values...)
+		ch <- prometheus.MustNewConstMetric(c.rejects, prometheus.CounterValue, float64(stat.Rejects), values...)
+		ch <- prometheus.MustNewConstMetric(c.retries, prometheus.CounterValue, float64(stat.Retries), values...)
+		ch <- prometheus.MustNewConstMetric(c.qps, prometheus.GaugeValue, float64(stat.QPS), values...)
+		ch <- prometheus.MustNewConstMetric(c.connections, prometheus.GaugeValue, float64(stat.Connections), values...)
+		ch <- prometheus.MustNewConstMetric(c.sessions, prometheus.CounterValue, float64(stat.Sessions), values...)
+		ch <- prometheus.MustNewConstMetric(c.messages, prometheus.CounterValue, float64(stat.Messages), values...)
+		ch <- prometheus.MustNewConstMetric(c.durations, prometheus.CounterValue, float64(stat.Durations)/1e9, values...)
+		for class, n := range stat.Failures {
+			ch <- prometheus.MustNewConstMetric(c.failures, prometheus.CounterValue, float64(n), append(values, class)...)
+		}
+
+		count := uint64(0)
+		buckets := make(map[float64]uint64, len(c.buckets))
+		for i, n := range stat.Latencies {
+			count += uint64(n)
+			if i < len(c.buckets) {
+				buckets[c.buckets[i]] = count
+			}
+		}
+
+		ch <- prometheus.MustNewConstHistogram(c.latency, count,
+			float64(stat.Costs)/1e9, buckets, values...)
+	})
+}
@@ -1,10 +1,13 @@
 package util
 
 import (
+	"sync"
+	stdatomic "sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/fagongzi/goetty"
+	"github.com/prometheus/client_golang/prometheus"
 )
 
 func TestAddTarget(t *testing.T) {
@@ -12,7 +15,7 @@ func TestAddTarget(t *testing.T) {
 	tw := goetty.NewTimeoutWheel(goetty.WithTickInterval(time.Millisecond * 10))
 	ans := NewAnalysis(tw)
 	ans.AddTarget(key, time.Millisecond*10)
-	if 1 != len(ans.points) {
+	if 1 != pointCount(ans) {
 		t.Errorf("add target failed")
 		return
 	}
@@ -35,7 +38,7 @@ func TestRemoveTarget(t *testing.T) {
 	ans.AddTarget(key, time.Millisecond*10)
 	ans.RemoveTarget(key)
 
-	if 0 != len(ans.points) {
+	if 0 != pointCount(ans) {
 		t.Errorf("remove target failed")
 		return
 	}
@@ -45,3 +48,251 @@ func TestRemoveTarget(t *testing.T) {
 		return
 	}
 }
+
+func TestRemovedTargetNotRescheduled(t *testing.T) {
+	key := uint64(1)
+	tw := goetty.NewTimeoutWheel(goetty.WithTickInterval(time.Millisecond * 10))
+	ans := NewAnalysis(tw)
+	ans.AddTarget(key, time.Hour)
+	removed := ans.recentlyPoints[key][time.Hour]
+	ans.RemoveTarget(key)
+	ans.AddTarget(key, time.Hour)
+
+	timeout := removed.timeout
+	ans.recentlyTimeout(removed)
+	if timeout != removed.timeout {
+		t.Errorf("removed target rescheduled")
+		return
+	}
+
+	added := ans.recentlyPoints[key][time.Hour]
+	timeout = added.timeout
+	ans.recentlyTimeout(added)
+	if timeout == added.timeout {
+		t.Errorf("added target not rescheduled")
+		return
+	}
+}
+
+func TestGetRecentlyStats(t *testing.T) {
+	key := uint64(1)
+	tw := goetty.NewTimeoutWheel(goetty.WithTickInterval(time.Millisecond * 10))
+	ans := NewAnalysis(tw)
+	ans.AddTarget(key, time.Minute)
+	ans.AddTarget(key, time.Second)
+	if 0 != len(ans.GetRecentlyStats(2)) {
+		t.Errorf("recently stats of missing key failed")
+		return
+	}
+
+	ans.recentlyPoints[key][time.Second].requests = 10
+	ans.recentlyPoints[key][time.Second].max = 20
+	values := ans.GetRecentlyStats(key)
+	if 2 != len(values) {
+		t.Errorf("recently stats failed, %d", len(values))
+		return
+	}
+
+	if 1 != values[0].Interval || 60 != values[1].Interval {
+		t.Errorf("recently stats not sorted, %d %d", values[0].Interval, values[1].Interval)
+		return
+	}
+
+	if 10 != values[0].Requests || 20 != values[0].Max {
+		t.Errorf("recently stats failed, %+v", values[0])
+		return
+	}
+}
+
+func TestRecentlyPercentile(t *testing.T) {
+	r := newRecently(1, time.Second)
+	if 0 != r.percentile(99) {
+		t.Errorf("percentile of empty histogram failed")
+		return
+	}
+
+	r.latencies[10] = 90
+	r.latencies[12] = 10
+	if 398 != r.percentile(50) {
+		t.Errorf("p50 failed, %d", r.percentile(50))
+		return
+	}
+
+	if 1536 != r.percentile(95) {
+		t.Errorf("p95 failed, %d", r.percentile(95))
+		return
+	}
+
+	if 1945 != r.percentile(99) {
+		t.Errorf("p99 failed, %d", r.percentile(99))
+		return
+	}
+
+	r.latencies[latencyBucketCount] = 10
+	r.max = latencyBuckets[latencyBucketCount-1]/int64(time.Millisecond) + 1000
+	if r.max != int64(r.percentile(100)) {
+		t.Errorf("percentile of the last bucket failed, %d", r.percentile(100))
+		return
+	}
+}
+
+func TestResponseLatencyBucket(t *testing.T) {
+	key := uint64(1)
+	tw := goetty.NewTimeoutWheel(goetty.WithTickInterval(time.Millisecond * 10))
+	ans := NewAnalysis(tw)
+	ans.AddTarget(key, time.Second)
+	ans.Response(key, int64(time.Millisecond*300))
+	ans.Response(key, int64(time.Hour))
+
+	p, _ := ans.getPointValue(key)
+	if 1 != p.latencies[10].Get() {
+		t.Errorf("latency bucket failed")
+		return
+	}
+
+	if 1 != p.latencies[latencyBucketCount].Get() {
+		t.Errorf("latency overflow bucket failed")
+		return
+	}
+}
+
+func TestAnalysisCollector(t *testing.T) {
+	key := uint64(1)
+	tw := goetty.NewTimeoutWheel(goetty.WithTickInterval(time.Millisecond * 10))
+	ans := NewAnalysis(tw)
+	ans.AddTarget(key, time.Second)
+	ans.Request(key)
+	ans.Response(key, int64(time.Millisecond*300))
+
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(NewAnalysisCollector([]string{"proxy"}, func(fn func(*AnalysisStat, ...string)) {
+		stat, _ := ans.GetStat(key, time.Second)
+		stat.Kind = "server"
+		stat.Name = "127.0.0.1:8080"
+		fn(stat, "127.0.0.1:80")
+	}))
+
+	mfs, err := registry.Gather()
+	if err != nil {
+		t.Errorf("gather failed, errors:%+v", err)
+		return
+	}
+
+	for _, mf := range mfs {
+		if mf.GetName() != "gateway_analysis_latency_seconds" {
+			continue
+		}
+
+		h := mf.GetMetric()[0].GetHistogram()
+		if 1 != h.GetSampleCount() || 0.3 != h.GetSampleSum() {
+			t.Errorf("latency histogram failed, %+v", h)
+		}
+		if 3 != len(mf.GetMetric()[0].GetLabel()) {
+			t.Errorf("latency labels failed, %+v", mf.GetMetric()[0].GetLabel())
+		}
+		return
+	}
+
+	t.Errorf("latency histogram not found")
+}
+
+func TestConnectionStat(t *testing.T) {
+	key := uint64(1)
+	tw := goetty.NewTimeoutWheel(goetty.WithTickInterval(time.Millisecond * 10))
+	ans := NewAnalysis(tw)
+	ans.AddTarget(key, time.Second)
+	ans.Connect(key)
+	ans.Connect(key)
+	ans.Message(key)
+	ans.Message(key)
+	ans.Message(key)
+	ans.Disconnect(key, time.Second)
+
+	stat, _ := ans.GetStat(key, time.Second)
+	if 1 != stat.Connections || 2 != stat.Sessions {
+		t.Errorf("connections failed, %d %d", stat.Connections, stat.Sessions)
+		return
+	}
+
+	if 3 != stat.Messages || int64(time.Second) != stat.Durations {
+		t.Errorf("messages and durations failed, %d %d", stat.Messages, stat.Durations)
+		return
+	}
+}
+
+func TestRetryStat(t *testing.T) {
+	key := uint64(1)
+	tw := goetty.NewTimeoutWheel(goetty.WithTickInterval(time.Millisecond * 10))
+	ans := NewAnalysis(tw)
+	ans.AddTarget(key, time.Second)
+	ans.Request(key)
+	ans.Retry(key)
+	ans.Retry(key)
+	ans.Response(key, int64(time.Millisecond))
+
+	stat, _ := ans.GetStat(key, time.Second)
+	if 1 != stat.Requests || 2 != stat.Retries {
+		t.Errorf("retries failed, %d %d", stat.Requests, stat.Retries)
+	}
+}
+
+func TestFailureClassify(t *testing.T) {
+	key := uint64(1)
+	tw := goetty.NewTimeoutWheel(goetty.WithTickInterval(time.Millisecond * 10))
+	ans := NewAnalysis(tw)
+	ans.AddTarget(key, time.Second)
+	ans.Request(key)
+	ans.Failure(key)
+	ans.Classify(key, FailureUpstreamTimeout)
+	ans.Classify(key, FailureUpstreamTimeout)
+	ans.Classify(key, FailureFilterDenied)
+
+	if 2 != ans.GetFailureCount(key, FailureUpstreamTimeout) || 0 != ans.GetFailureCount(key, FailureClientAbort) {
+		t.Errorf("failure classify failed")
+		return
+	}
+
+	stat, _ := ans.GetStat(key, time.Second)
+	if 1 != stat.Failure || 2 != len(stat.Failures) ||
+		2 != stat.Failures["upstream-timeout"] || 1 != stat.Failures["filter-denied"] {
+		t.Errorf("failure classes failed, %+v", stat.Failures)
+	}
+}
+
+func TestConcurrentResponse(t *testing.T) {
+	key := uint64(1)
+	tw := goetty.NewTimeoutWheel(goetty.WithTickInterval(time.Millisecond * 10))
+	ans := NewAnalysis(tw)
+	ans.AddTarget(key, time.Hour)
+
+	var wg sync.WaitGroup
+	for i := 1; i <= 100; i++ {
+		wg.Add(1)
+		go func(cost int64) {
+			defer wg.Done()
+			ans.Request(key)
+			ans.Response(key, cost)
+		}(int64(i))
+	}
+	wg.Wait()
+
+	p, _ := ans.getPointValue(key)
+	if 100 != p.requests.Get() || 100 != p.successed.Get() {
+		t.Errorf("concurrent counts failed, %d %d", p.requests.Get(), p.successed.Get())
+		return
+	}
+
+	if 100 != stdatomic.LoadInt64(&p.max) || 1 != stdatomic.LoadInt64(&p.min) {
+		t.Errorf("concurrent max and min failed")
+		return
+	}
+}
+
+func pointCount(ans *Analysis) int {
+	count := 0
+	ans.points.Range(func(key, value interface{}) bool {
+		count++
+		return true
+	})
+	return count
+}
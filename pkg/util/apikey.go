@@ -0,0 +1,30 @@
+package util
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+)
+
+const (
+	apiKeyIDSize     = 4
+	apiKeySecretSize = 16
+)
+
+// NewAPIKey returns a random api key and it's id, the id is the prefix of the key
+func NewAPIKey() (string, string, error) {
+	data := make([]byte, apiKeyIDSize+apiKeySecretSize)
+	_, err := rand.Read(data)
+	if err != nil {
+		return "", "", err
+	}
+
+	key := hex.EncodeToString(data)
+	return key[:apiKeyIDSize*2], key, nil
+}
+
+// APIKeyHash returns the sha256 hash of the api key, only the hash is stored
+func APIKeyHash(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,112 @@
+package util
+
+import (
+	"context"
+	"net"
+	"time"
+)
+
+var (
+	// DialFallbackDelay is the delay to start connecting to the next address of the host while the
+	// previous attempts are in progress(RFC 8305), 0 means the next address is tried only after
+	// the previous attempts failed
+	DialFallbackDelay = time.Millisecond * 250
+)
+
+type dialResult struct {
+	conn net.Conn
+	err  error
+}
+
+// dialFallback connect to the addresses with the staggered fallback, the next address is tried
+// after the fallback delay or the failure of the previous attempts, the first established
+// connection is returned, and the timeout is shared by all attempts
+func dialFallback(addrs []string, port string, timeout time.Duration) (net.Conn, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	dialer := &net.Dialer{}
+	results := make(chan dialResult, len(addrs))
+	next, pending := 0, 0
+	var fallback <-chan time.Time
+	start := func() {
+		addr := net.JoinHostPort(addrs[next], port)
+		next++
+		pending++
+		go func() {
+			conn, err := dialer.DialContext(ctx, "tcp", addr)
+			results <- dialResult{conn: conn, err: err}
+		}()
+
+		fallback = nil
+		if next < len(addrs) && DialFallbackDelay > 0 {
+			fallback = time.After(DialFallbackDelay)
+		}
+	}
+
+	start()
+	var err error
+	for pending > 0 {
+		select {
+		case <-fallback:
+			start()
+		case result := <-results:
+			pending--
+			if result.err == nil {
+				go closeDialResults(results, pending)
+				return result.conn, nil
+			}
+
+			err = result.err
+			if next < len(addrs) {
+				start()
+			}
+		}
+	}
+
+	return nil, err
+}
+
+// closeDialResults close the connections that established by the remaining attempts
+func closeDialResults(results chan dialResult, pending int) {
+	for i := 0; i < pending; i++ {
+		if result := <-results; result.err == nil {
+			result.conn.Close()
+		}
+	}
+}
+
+// interleaveAddrs sort the addresses by alternating the ip families, starts with the family of
+// the first address, so that an unreachable family doesn't delay the other
+func interleaveAddrs(addrs []string) []string {
+	if len(addrs) < 2 {
+		return addrs
+	}
+
+	var primaries, secondaries []string
+	v4 := isIPv4(addrs[0])
+	for _, addr := range addrs {
+		if isIPv4(addr) == v4 {
+			primaries = append(primaries, addr)
+		} else {
+			secondaries = append(secondaries, addr)
+		}
+	}
+
+	values := make([]string, 0, len(addrs))
+	for i := 0; i < len(primaries) || i < len(secondaries); i++ {
+		if i < len(primaries) {
+			values = append(values, primaries[i])
+		}
+		if i < len(secondaries) {
+			values = append(values, secondaries[i])
+		}
+	}
+
+	return values
+}
+
+func isIPv4(addr string) bool {
+	ip := net.ParseIP(addr)
+	return ip != nil && ip.To4() != nil
+}
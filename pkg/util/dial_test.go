@@ -0,0 +1,44 @@
+package util
+
+import (
+	"net"
+	"testing"
+	"time"
+)
+
+func TestInterleaveAddrs(t *testing.T) {
+	addrs := interleaveAddrs([]string{"::1", "::2", "::3", "10.0.0.1", "10.0.0.2"})
+	expects := []string{"::1", "10.0.0.1", "::2", "10.0.0.2", "::3"}
+	if len(addrs) != len(expects) {
+		t.Errorf("expect %+v, but %+v", expects, addrs)
+		return
+	}
+
+	for i, addr := range addrs {
+		if addr != expects[i] {
+			t.Errorf("expect %+v, but %+v", expects, addrs)
+			return
+		}
+	}
+}
+
+func TestDialFallback(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Errorf("listen failed, errors:%+v", err)
+		return
+	}
+	defer l.Close()
+
+	_, port, _ := net.SplitHostPort(l.Addr().String())
+	conn, err := dialFallback([]string{"127.0.0.2", "127.0.0.1"}, port, time.Second)
+	if err != nil {
+		t.Errorf("dial failed, errors:%+v", err)
+		return
+	}
+	conn.Close()
+
+	if conn.RemoteAddr().String() != l.Addr().String() {
+		t.Errorf("expect %s, but %s", l.Addr(), conn.RemoteAddr())
+	}
+}
@@ -0,0 +1,129 @@
+package util
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+)
+
+// JSONDiff is the difference of a field of two json documents, path is like nodes[0].clusterID,
+// the objects and the arrays are json encoded, empty means the field is missing
+type JSONDiff struct {
+	Path string
+	From string
+	To   string
+}
+
+// DiffJSON returns the differences of the two json documents, the objects are compared by the fields
+// recursively, and the arrays are compared by the index
+func DiffJSON(from, to []byte) ([]JSONDiff, error) {
+	var fromValue, toValue interface{}
+	err := unmarshalJSON(from, &fromValue)
+	if err != nil {
+		return nil, err
+	}
+
+	err = unmarshalJSON(to, &toValue)
+	if err != nil {
+		return nil, err
+	}
+
+	var diffs []JSONDiff
+	diffJSONValue("", fromValue, toValue, true, true, &diffs)
+	return diffs, nil
+}
+
+func unmarshalJSON(data []byte, value *interface{}) error {
+	if len(data) == 0 {
+		return nil
+	}
+
+	d := json.NewDecoder(bytes.NewReader(data))
+	d.UseNumber()
+	return d.Decode(value)
+}
+
+func diffJSONValue(path string, from, to interface{}, hasFrom, hasTo bool, diffs *[]JSONDiff) {
+	fromObject, ok1 := from.(map[string]interface{})
+	toObject, ok2 := to.(map[string]interface{})
+	if ok1 && ok2 {
+		keys := make(map[string]struct{}, len(fromObject)+len(toObject))
+		for key := range fromObject {
+			keys[key] = struct{}{}
+		}
+		for key := range toObject {
+			keys[key] = struct{}{}
+		}
+
+		sorted := make([]string, 0, len(keys))
+		for key := range keys {
+			sorted = append(sorted, key)
+		}
+		sort.Strings(sorted)
+
+		for _, key := range sorted {
+			fromField, ok1 := fromObject[key]
+			toField, ok2 := toObject[key]
+			diffJSONValue(joinJSONPath(path, key), fromField, toField, ok1, ok2, diffs)
+		}
+		return
+	}
+
+	fromArray, ok1 := from.([]interface{})
+	toArray, ok2 := to.([]interface{})
+	if ok1 && ok2 {
+		size := len(fromArray)
+		if len(toArray) > size {
+			size = len(toArray)
+		}
+
+		for i := 0; i < size; i++ {
+			var fromItem, toItem interface{}
+			if i < len(fromArray) {
+				fromItem = fromArray[i]
+			}
+			if i < len(toArray) {
+				toItem = toArray[i]
+			}
+			diffJSONValue(fmt.Sprintf("%s[%d]", path, i), fromItem, toItem, i < len(fromArray), i < len(toArray), diffs)
+		}
+		return
+	}
+
+	fromText := formatJSONValue(from, hasFrom)
+	toText := formatJSONValue(to, hasTo)
+	if hasFrom != hasTo || fromText != toText {
+		*diffs = append(*diffs, JSONDiff{
+			Path: path,
+			From: fromText,
+			To:   toText,
+		})
+	}
+}
+
+func joinJSONPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+
+	return path + "." + key
+}
+
+func formatJSONValue(value interface{}, exists bool) string {
+	if !exists {
+		return ""
+	}
+
+	switch v := value.(type) {
+	case string:
+		return v
+	case json.Number:
+		return v.String()
+	case nil:
+		return "null"
+	}
+
+	data, _ := json.Marshal(value)
+	return string(data)
+}
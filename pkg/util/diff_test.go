@@ -0,0 +1,52 @@
+package util
+
+import (
+	"testing"
+)
+
+func TestDiffJSON(t *testing.T) {
+	from := []byte(`{"name":"api","status":0,"nodes":[{"clusterID":1}],"perms":["a"]}`)
+	to := []byte(`{"name":"api","status":1,"nodes":[{"clusterID":2},{"clusterID":3}],"maxQPS":10}`)
+
+	diffs, err := DiffJSON(from, to)
+	if err != nil {
+		t.Errorf("diff failed, errors:%+v", err)
+		return
+	}
+
+	expects := []JSONDiff{
+		{Path: "maxQPS", From: "", To: "10"},
+		{Path: "nodes[0].clusterID", From: "1", To: "2"},
+		{Path: "nodes[1]", From: "", To: `{"clusterID":3}`},
+		{Path: "perms", From: `["a"]`, To: ""},
+		{Path: "status", From: "0", To: "1"},
+	}
+	if len(diffs) != len(expects) {
+		t.Errorf("expect %+v, but %+v", expects, diffs)
+		return
+	}
+
+	for i, diff := range diffs {
+		if diff != expects[i] {
+			t.Errorf("expect %+v, but %+v", expects[i], diff)
+		}
+	}
+}
+
+func TestDiffJSONWithEmpty(t *testing.T) {
+	diffs, err := DiffJSON(nil, []byte(`{"name":"api"}`))
+	if err != nil {
+		t.Errorf("diff failed, errors:%+v", err)
+		return
+	}
+
+	if len(diffs) != 1 || diffs[0].Path != "" || diffs[0].To != `{"name":"api"}` {
+		t.Errorf("error diffs %+v", diffs)
+		return
+	}
+
+	diffs, err = DiffJSON([]byte(`{"name":"api"}`), []byte(`{"name":"api"}`))
+	if err != nil || len(diffs) != 0 {
+		t.Errorf("expect no diffs, but %+v, %+v", diffs, err)
+	}
+}
@@ -2,8 +2,10 @@ package util
 
 import (
 	"bufio"
+	"bytes"
 	"io"
 	"net"
+	"net/http/httputil"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -11,6 +13,10 @@ import (
 	"github.com/valyala/fasthttp"
 )
 
+const (
+	defaultDialTimeout = time.Second * 3
+)
+
 var startTimeUnix = time.Now().Unix()
 var clientConnPool sync.Pool
 
@@ -20,6 +26,8 @@ type HTTPOption struct {
 	MaxConns int
 	// MaxConnDuration Keep-alive connections are closed after this duration.
 	MaxConnDuration time.Duration
+	// MaxConnRequests Keep-alive connections are closed after this number of requests, 0 means no limit.
+	MaxConnRequests int
 	// MaxIdleConnDuration Idle keep-alive connections are closed after this duration.
 	MaxIdleConnDuration time.Duration
 	// ReadBufferSize Per-connection buffer size for responses' reading.
@@ -32,6 +40,9 @@ type HTTPOption struct {
 	WriteTimeout time.Duration
 	// MaxResponseBodySize Maximum response body size.
 	MaxResponseBodySize int
+	// TruncateResponseBody Read at most MaxResponseBodySize bytes of the response body rather than fail with
+	// ErrBodyTooLarge, the connection is closed if the body is not read to the end.
+	TruncateResponseBody bool
 }
 
 // DefaultHTTPOption returns a HTTP Option
@@ -53,6 +64,7 @@ type FastHTTPClient struct {
 	sync.RWMutex
 
 	defaultOption *HTTPOption
+	dial          DialFunc
 	hostClients   map[string]*hostClients
 	readerPool    sync.Pool
 	writerPool    sync.Pool
@@ -71,16 +83,22 @@ func NewFastHTTPClientOption(defaultOption *HTTPOption) *FastHTTPClient {
 	}
 }
 
+// SetDial set the dial func to connect to the servers, default is DialTrace with 3s timeout
+func (c *FastHTTPClient) SetDial(dial DialFunc) {
+	c.dial = dial
+}
+
 type hostClients struct {
 	sync.Mutex
 
 	option      *HTTPOption
+	dial        DialFunc
 	lastUseTime uint32
 	connsCount  int
 	conns       []*clientConn
 }
 
-func (c *hostClients) acquireConn(addr string) (*clientConn, error) {
+func (c *hostClients) acquireConn(addr string, trace *HTTPTrace) (*clientConn, error) {
 	var cc *clientConn
 	createConn := false
 	startCleaner := false
@@ -114,7 +132,7 @@ func (c *hostClients) acquireConn(addr string) (*clientConn, error) {
 		return nil, fasthttp.ErrNoFreeConns
 	}
 
-	conn, err := dialAddr(addr)
+	conn, err := dialAddr(c.dial, addr, trace)
 	if err != nil {
 		c.decConnsCount()
 		return nil, err
@@ -190,6 +208,7 @@ type clientConn struct {
 
 	createdTime time.Time
 	lastUseTime time.Time
+	requests    int
 
 	lastReadDeadlineTime  time.Time
 	lastWriteDeadlineTime time.Time
@@ -197,9 +216,19 @@ type clientConn struct {
 
 // Do do a http request
 func (c *FastHTTPClient) Do(req *fasthttp.Request, addr string, option *HTTPOption) (*fasthttp.Response, error) {
-	resp, retry, err := c.do(req, addr, option)
-	if err != nil && retry && isIdempotent(req) {
-		resp, _, err = c.do(req, addr, option)
+	return c.DoWithTrace(req, addr, option, nil)
+}
+
+// DoWithTrace do a http request, and record the phases of the request to the trace.
+// If the request is retried, the trace is the last attempt
+func (c *FastHTTPClient) DoWithTrace(req *fasthttp.Request, addr string, option *HTTPOption, trace *HTTPTrace) (*fasthttp.Response, error) {
+	trace.Reset()
+	// the body stream is consumed by the first attempt
+	stream := req.IsBodyStream()
+	resp, retry, err := c.do(req, addr, option, trace)
+	if err != nil && retry && isIdempotent(req) && !stream {
+		trace.Reset()
+		resp, _, err = c.do(req, addr, option, trace)
 	}
 	if err == io.EOF {
 		err = fasthttp.ErrConnectionClosed
@@ -207,13 +236,13 @@ func (c *FastHTTPClient) Do(req *fasthttp.Request, addr string, option *HTTPOpti
 	return resp, err
 }
 
-func (c *FastHTTPClient) do(req *fasthttp.Request, addr string, option *HTTPOption) (*fasthttp.Response, bool, error) {
+func (c *FastHTTPClient) do(req *fasthttp.Request, addr string, option *HTTPOption, trace *HTTPTrace) (*fasthttp.Response, bool, error) {
 	resp := fasthttp.AcquireResponse()
-	ok, err := c.doNonNilReqResp(req, resp, addr, option)
+	ok, err := c.doNonNilReqResp(req, resp, addr, option, trace)
 	return resp, ok, err
 }
 
-func (c *FastHTTPClient) doNonNilReqResp(req *fasthttp.Request, resp *fasthttp.Response, addr string, option *HTTPOption) (bool, error) {
+func (c *FastHTTPClient) doNonNilReqResp(req *fasthttp.Request, resp *fasthttp.Response, addr string, option *HTTPOption, trace *HTTPTrace) (bool, error) {
 	if req == nil {
 		panic("BUG: req cannot be nil")
 	}
@@ -230,7 +259,7 @@ func (c *FastHTTPClient) doNonNilReqResp(req *fasthttp.Request, resp *fasthttp.R
 	var ok bool
 	c.Lock()
 	if hc, ok = c.hostClients[addr]; !ok {
-		hc = &hostClients{option: opt}
+		hc = &hostClients{option: opt, dial: c.dial}
 		c.hostClients[addr] = hc
 	}
 	c.Unlock()
@@ -241,8 +270,9 @@ func (c *FastHTTPClient) doNonNilReqResp(req *fasthttp.Request, resp *fasthttp.R
 	// so the GC may reclaim these resources (e.g. response body).
 	resp.Reset()
 
-	cc, err := hc.acquireConn(addr)
+	cc, err := hc.acquireConn(addr, trace)
 	if err != nil {
+		trace.Fail(IsTimeout(err))
 		return false, err
 	}
 	conn := cc.c
@@ -262,12 +292,15 @@ func (c *FastHTTPClient) doNonNilReqResp(req *fasthttp.Request, resp *fasthttp.R
 		}
 	}
 
+	cc.requests++
 	resetConnection := false
-	if opt.MaxConnDuration > 0 && time.Since(cc.createdTime) > opt.MaxConnDuration && !req.ConnectionClose() {
+	if ((opt.MaxConnDuration > 0 && time.Since(cc.createdTime) > opt.MaxConnDuration) ||
+		(opt.MaxConnRequests > 0 && cc.requests >= opt.MaxConnRequests)) && !req.ConnectionClose() {
 		req.SetConnectionClose()
 		resetConnection = true
 	}
 
+	trace.Enter(PhaseWrite)
 	bw := c.acquireWriter(conn, opt)
 	err = req.Write(bw)
 
@@ -279,6 +312,7 @@ func (c *FastHTTPClient) doNonNilReqResp(req *fasthttp.Request, resp *fasthttp.R
 		err = bw.Flush()
 	}
 	if err != nil {
+		trace.Fail(timedOut(err, deadline(cc.lastWriteDeadlineTime, opt.WriteTimeout)))
 		c.releaseWriter(bw)
 		hc.closeConn(cc)
 		return true, err
@@ -304,8 +338,23 @@ func (c *FastHTTPClient) doNonNilReqResp(req *fasthttp.Request, resp *fasthttp.R
 		resp.SkipBody = true
 	}
 
+	trace.Enter(PhaseFirstByte)
 	br := c.acquireReader(conn, opt)
-	if err = resp.ReadLimitBody(br, opt.MaxResponseBodySize); err != nil {
+	if trace != nil {
+		// wait for the first byte before reading, so the time to first byte is separated from the body
+		if _, err = br.Peek(1); err == nil {
+			trace.Enter(PhaseBody)
+		}
+	}
+	if err == nil && opt.TruncateResponseBody && opt.MaxResponseBodySize > 0 {
+		var drained bool
+		drained, err = readTruncatedResponse(resp, br, opt.MaxResponseBodySize)
+		resetConnection = resetConnection || !drained
+	} else if err == nil {
+		err = resp.ReadLimitBody(br, opt.MaxResponseBodySize)
+	}
+	if err != nil {
+		trace.Fail(timedOut(err, deadline(cc.lastReadDeadlineTime, opt.ReadTimeout)))
 		c.releaseReader(br)
 		hc.closeConn(cc)
 		if err == io.EOF {
@@ -314,6 +363,7 @@ func (c *FastHTTPClient) doNonNilReqResp(req *fasthttp.Request, resp *fasthttp.R
 		return false, err
 	}
 	c.releaseReader(br)
+	trace.Enter(PhaseNone)
 
 	if resetConnection || req.ConnectionClose() || resp.ConnectionClose() {
 		hc.closeConn(cc)
@@ -324,8 +374,84 @@ func (c *FastHTTPClient) doNonNilReqResp(req *fasthttp.Request, resp *fasthttp.R
 	return false, err
 }
 
-func dialAddr(addr string) (net.Conn, error) {
-	conn, err := fasthttp.Dial(addr)
+// readTruncatedResponse read the response, the body is truncated at the max bytes, so the large body is
+// never buffered. Returns false if the body is not read to the end
+func readTruncatedResponse(resp *fasthttp.Response, br *bufio.Reader, maxBodySize int) (bool, error) {
+	err := resp.Header.Read(br)
+	if err != nil {
+		return false, err
+	}
+	if resp.Header.StatusCode() == fasthttp.StatusContinue {
+		if err = resp.Header.Read(br); err != nil {
+			return false, err
+		}
+	}
+
+	// all 1xx, 204 and 304 responses must not include a body
+	code := resp.Header.StatusCode()
+	if resp.SkipBody || (code >= fasthttp.StatusContinue && code < fasthttp.StatusOK) ||
+		code == fasthttp.StatusNoContent || code == fasthttp.StatusNotModified {
+		return true, nil
+	}
+
+	var body io.Reader
+	size := resp.Header.ContentLength()
+	switch {
+	case size >= 0:
+		body = io.LimitReader(br, int64(size))
+	case size == -1:
+		body = httputil.NewChunkedReader(br)
+	default:
+		// the body is read until the connection is closed
+		body = br
+	}
+
+	n, err := io.Copy(resp.BodyWriter(), io.LimitReader(body, int64(maxBodySize)))
+	if err != nil {
+		resp.Reset()
+		return false, err
+	}
+	resp.Header.SetContentLength(int(n))
+
+	switch {
+	case size >= 0 && n < int64(size) && n < int64(maxBodySize):
+		resp.Reset()
+		return false, io.ErrUnexpectedEOF
+	case size >= 0:
+		return n == int64(size), nil
+	case size == -1:
+		return readChunkedTrailer(body, br, n < int64(maxBodySize))
+	}
+
+	return false, nil
+}
+
+// readChunkedTrailer returns true if the chunked body is read to the end, and the trailer is skipped
+func readChunkedTrailer(body io.Reader, br *bufio.Reader, eof bool) (bool, error) {
+	if !eof {
+		var b [1]byte
+		if n, err := body.Read(b[:]); n > 0 || err != io.EOF {
+			return false, nil
+		}
+	}
+
+	for {
+		line, err := br.ReadSlice('\n')
+		if err != nil {
+			return false, err
+		}
+		if len(bytes.TrimSpace(line)) == 0 {
+			return true, nil
+		}
+	}
+}
+
+func dialAddr(dial DialFunc, addr string, trace *HTTPTrace) (net.Conn, error) {
+	if dial == nil {
+		dial = defaultDial
+	}
+
+	conn, err := dial(addr, trace)
 	if err != nil {
 		return nil, err
 	}
@@ -336,6 +462,19 @@ func dialAddr(addr string) (net.Conn, error) {
 	return conn, nil
 }
 
+func defaultDial(addr string, trace *HTTPTrace) (net.Conn, error) {
+	return DialTrace(addr, defaultDialTimeout, trace)
+}
+
+// deadline returns the deadline of the conn that set at the last time, zero if no timeout
+func deadline(last time.Time, timeout time.Duration) time.Time {
+	if timeout <= 0 {
+		return time.Time{}
+	}
+
+	return last.Add(timeout)
+}
+
 func (c *FastHTTPClient) acquireWriter(conn net.Conn, opt *HTTPOption) *bufio.Writer {
 	v := c.writerPool.Get()
 	if v == nil {
@@ -376,6 +515,7 @@ func acquireClientConn(conn net.Conn) *clientConn {
 	cc := v.(*clientConn)
 	cc.c = conn
 	cc.createdTime = time.Now()
+	cc.requests = 0
 	return cc
 }
 
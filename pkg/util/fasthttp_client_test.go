@@ -0,0 +1,66 @@
+package util
+
+import (
+	"bufio"
+	"strings"
+	"testing"
+
+	"github.com/valyala/fasthttp"
+)
+
+func TestReadTruncatedResponse(t *testing.T) {
+	next := "HTTP/1.1 204 No Content\r\n\r\n"
+	cases := []struct {
+		data    string
+		max     int
+		body    string
+		drained bool
+	}{
+		{"HTTP/1.1 200 OK\r\nContent-Length: 5\r\n\r\nhello" + next, 10, "hello", true},
+		{"HTTP/1.1 200 OK\r\nContent-Length: 11\r\n\r\nhello world" + next, 5, "hello", false},
+		{"HTTP/1.1 200 OK\r\nContent-Length: 5\r\n\r\nhello" + next, 5, "hello", true},
+		{"HTTP/1.1 200 OK\r\nTransfer-Encoding: chunked\r\n\r\n5\r\nhello\r\n6\r\n world\r\n0\r\n\r\n" + next, 20, "hello world", true},
+		{"HTTP/1.1 200 OK\r\nTransfer-Encoding: chunked\r\n\r\n5\r\nhello\r\n6\r\n world\r\n0\r\nX-Trailer: 1\r\n\r\n" + next, 11, "hello world", true},
+		{"HTTP/1.1 200 OK\r\nTransfer-Encoding: chunked\r\n\r\n5\r\nhello\r\n6\r\n world\r\n0\r\n\r\n" + next, 7, "hello w", false},
+		{"HTTP/1.1 200 OK\r\nConnection: close\r\n\r\nhello world", 5, "hello", false},
+		{"HTTP/1.1 304 Not Modified\r\nContent-Length: 5\r\n\r\n" + next, 5, "", true},
+	}
+
+	for i, c := range cases {
+		br := bufio.NewReader(strings.NewReader(c.data))
+		resp := fasthttp.AcquireResponse()
+		drained, err := readTruncatedResponse(resp, br, c.max)
+		if err != nil {
+			t.Errorf("case %d: read failed, errors:%+v", i, err)
+			continue
+		}
+		if string(resp.Body()) != c.body {
+			t.Errorf("case %d: expect body %q, but %q", i, c.body, resp.Body())
+		}
+		if drained != c.drained {
+			t.Errorf("case %d: expect drained %v, but %v", i, c.drained, drained)
+		}
+		if resp.Header.ContentLength() != len(c.body) && resp.StatusCode() == fasthttp.StatusOK {
+			t.Errorf("case %d: expect content length %d, but %d", i, len(c.body), resp.Header.ContentLength())
+		}
+
+		// the next response on the connection is readable after the drained body
+		if drained {
+			resp.Reset()
+			if err := resp.Read(br); err != nil || resp.StatusCode() != fasthttp.StatusNoContent {
+				t.Errorf("case %d: read next response failed, errors:%+v", i, err)
+			}
+		}
+		fasthttp.ReleaseResponse(resp)
+	}
+}
+
+func TestReadTruncatedResponseWithUnexpectedEOF(t *testing.T) {
+	br := bufio.NewReader(strings.NewReader("HTTP/1.1 200 OK\r\nContent-Length: 11\r\n\r\nhello"))
+	resp := fasthttp.AcquireResponse()
+	defer fasthttp.ReleaseResponse(resp)
+
+	if _, err := readTruncatedResponse(resp, br, 10); err == nil {
+		t.Errorf("expect unexpected eof")
+	}
+}
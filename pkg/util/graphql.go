@@ -0,0 +1,505 @@
+package util
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+const (
+	gqlEOF = iota
+	gqlPunct
+	gqlName
+	gqlNumber
+	gqlString
+)
+
+// GraphQLField is a field of the graphql selection set
+type GraphQLField struct {
+	Alias     string
+	Name      string
+	Arguments map[string]interface{}
+	Fields    []*GraphQLField
+}
+
+// ResponseName returns the key of the field in the response
+func (f *GraphQLField) ResponseName() string {
+	if f.Alias != "" {
+		return f.Alias
+	}
+
+	return f.Name
+}
+
+func (f *GraphQLField) depth() int {
+	max := 0
+	for _, sub := range f.Fields {
+		if d := sub.depth(); d > max {
+			max = d
+		}
+	}
+
+	return max + 1
+}
+
+func (f *GraphQLField) complexity() int {
+	value := 0
+	for _, sub := range f.Fields {
+		value += sub.complexity()
+	}
+
+	return value + 1
+}
+
+// GraphQLOperation is a graphql operation, only query and mutation are supported
+type GraphQLOperation struct {
+	Type   string
+	Name   string
+	Fields []*GraphQLField
+}
+
+// Depth returns the max depth of the fields, the root fields are at depth 1
+func (op *GraphQLOperation) Depth() int {
+	max := 0
+	for _, f := range op.Fields {
+		if d := f.depth(); d > max {
+			max = d
+		}
+	}
+
+	return max
+}
+
+// Complexity returns the complexity of the operation, every field costs 1 except the root
+// fields, the cost of root fields is returned by the cost func
+func (op *GraphQLOperation) Complexity(cost func(*GraphQLField) int) int {
+	value := 0
+	for _, f := range op.Fields {
+		value += f.complexity() - 1 + cost(f)
+	}
+
+	return value
+}
+
+// ParseGraphQL parse the graphql document and returns the operation with the name,
+// the variables are resolved in the arguments. Fragments, directives and subscriptions
+// are not supported
+func ParseGraphQL(document string, operationName string, variables map[string]interface{}) (*GraphQLOperation, error) {
+	p := &gqlParser{
+		src:       document,
+		variables: variables,
+	}
+
+	var ops []*GraphQLOperation
+	err := p.next()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.kind != gqlEOF {
+		op, err := p.parseOperation()
+		if err != nil {
+			return nil, err
+		}
+
+		ops = append(ops, op)
+	}
+
+	if len(ops) == 0 {
+		return nil, fmt.Errorf("missing graphql operation")
+	}
+
+	if operationName == "" {
+		if len(ops) > 1 {
+			return nil, fmt.Errorf("missing graphql operation name")
+		}
+
+		return ops[0], nil
+	}
+
+	for _, op := range ops {
+		if op.Name == operationName {
+			return op, nil
+		}
+	}
+
+	return nil, fmt.Errorf("graphql operation %s not found", operationName)
+}
+
+type gqlParser struct {
+	src       string
+	pos       int
+	kind      int
+	token     string
+	variables map[string]interface{}
+	defaults  map[string]interface{}
+}
+
+func (p *gqlParser) parseOperation() (*GraphQLOperation, error) {
+	op := &GraphQLOperation{Type: "query"}
+	p.defaults = make(map[string]interface{})
+
+	if p.kind == gqlName {
+		switch p.token {
+		case "query", "mutation":
+			op.Type = p.token
+		default:
+			return nil, fmt.Errorf("graphql %s is not supported", p.token)
+		}
+
+		err := p.next()
+		if err != nil {
+			return nil, err
+		}
+
+		if p.kind == gqlName {
+			op.Name = p.token
+			if err := p.next(); err != nil {
+				return nil, err
+			}
+		}
+
+		if p.is("(") {
+			if err := p.parseVariableDefinitions(); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	fields, err := p.parseSelectionSet()
+	if err != nil {
+		return nil, err
+	}
+
+	op.Fields = fields
+	return op, nil
+}
+
+func (p *gqlParser) parseVariableDefinitions() error {
+	err := p.expect("(")
+	if err != nil {
+		return err
+	}
+
+	for !p.is(")") {
+		if err := p.expect("$"); err != nil {
+			return err
+		}
+
+		name, err := p.parseName()
+		if err != nil {
+			return err
+		}
+
+		if err := p.expect(":"); err != nil {
+			return err
+		}
+
+		if err := p.skipType(); err != nil {
+			return err
+		}
+
+		if p.is("=") {
+			if err := p.next(); err != nil {
+				return err
+			}
+
+			value, err := p.parseValue()
+			if err != nil {
+				return err
+			}
+			p.defaults[name] = value
+		}
+	}
+
+	return p.next()
+}
+
+func (p *gqlParser) skipType() error {
+	if p.is("[") {
+		if err := p.next(); err != nil {
+			return err
+		}
+
+		if err := p.skipType(); err != nil {
+			return err
+		}
+
+		if err := p.expect("]"); err != nil {
+			return err
+		}
+	} else if _, err := p.parseName(); err != nil {
+		return err
+	}
+
+	if p.is("!") {
+		return p.next()
+	}
+
+	return nil
+}
+
+func (p *gqlParser) parseSelectionSet() ([]*GraphQLField, error) {
+	err := p.expect("{")
+	if err != nil {
+		return nil, err
+	}
+
+	var fields []*GraphQLField
+	for !p.is("}") {
+		if p.is("...") {
+			return nil, fmt.Errorf("graphql fragment is not supported")
+		}
+
+		field, err := p.parseField()
+		if err != nil {
+			return nil, err
+		}
+		fields = append(fields, field)
+	}
+
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("graphql empty selection set")
+	}
+
+	return fields, p.next()
+}
+
+func (p *gqlParser) parseField() (*GraphQLField, error) {
+	name, err := p.parseName()
+	if err != nil {
+		return nil, err
+	}
+
+	field := &GraphQLField{Name: name}
+	if p.is(":") {
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+
+		field.Alias = name
+		field.Name, err = p.parseName()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if p.is("(") {
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+
+		field.Arguments = make(map[string]interface{})
+		for !p.is(")") {
+			arg, err := p.parseName()
+			if err != nil {
+				return nil, err
+			}
+
+			if err := p.expect(":"); err != nil {
+				return nil, err
+			}
+
+			field.Arguments[arg], err = p.parseValue()
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+	}
+
+	if p.is("@") {
+		return nil, fmt.Errorf("graphql directive is not supported")
+	}
+
+	if p.is("{") {
+		field.Fields, err = p.parseSelectionSet()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return field, nil
+}
+
+func (p *gqlParser) parseValue() (interface{}, error) {
+	kind, token := p.kind, p.token
+	switch {
+	case kind == gqlPunct && token == "$":
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+
+		name, err := p.parseName()
+		if err != nil {
+			return nil, err
+		}
+
+		if value, ok := p.variables[name]; ok {
+			return value, nil
+		}
+		return p.defaults[name], nil
+	case kind == gqlPunct && token == "[":
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+
+		values := make([]interface{}, 0)
+		for !p.is("]") {
+			value, err := p.parseValue()
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, value)
+		}
+		return values, p.next()
+	case kind == gqlPunct && token == "{":
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+
+		values := make(map[string]interface{})
+		for !p.is("}") {
+			name, err := p.parseName()
+			if err != nil {
+				return nil, err
+			}
+
+			if err := p.expect(":"); err != nil {
+				return nil, err
+			}
+
+			values[name], err = p.parseValue()
+			if err != nil {
+				return nil, err
+			}
+		}
+		return values, p.next()
+	case kind == gqlNumber:
+		return json.Number(token), p.next()
+	case kind == gqlString:
+		var value string
+		err := json.Unmarshal([]byte(token), &value)
+		if err != nil {
+			return nil, fmt.Errorf("graphql error string %s", token)
+		}
+		return value, p.next()
+	case kind == gqlName:
+		var value interface{} = token
+		switch token {
+		case "true":
+			value = true
+		case "false":
+			value = false
+		case "null":
+			value = nil
+		}
+		return value, p.next()
+	}
+
+	return nil, p.unexpected()
+}
+
+func (p *gqlParser) parseName() (string, error) {
+	if p.kind != gqlName {
+		return "", p.unexpected()
+	}
+
+	name := p.token
+	return name, p.next()
+}
+
+func (p *gqlParser) is(punct string) bool {
+	return p.kind == gqlPunct && p.token == punct
+}
+
+func (p *gqlParser) expect(punct string) error {
+	if !p.is(punct) {
+		return p.unexpected()
+	}
+
+	return p.next()
+}
+
+func (p *gqlParser) unexpected() error {
+	if p.kind == gqlEOF {
+		return fmt.Errorf("graphql unexpected end of document")
+	}
+
+	return fmt.Errorf("graphql unexpected %s at %d", p.token, p.pos-len(p.token))
+}
+
+func (p *gqlParser) next() error {
+	for p.pos < len(p.src) {
+		c := p.src[p.pos]
+		if c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == ',' {
+			p.pos++
+		} else if c == '#' {
+			for p.pos < len(p.src) && p.src[p.pos] != '\n' {
+				p.pos++
+			}
+		} else {
+			break
+		}
+	}
+
+	if p.pos >= len(p.src) {
+		p.kind, p.token = gqlEOF, ""
+		return nil
+	}
+
+	start := p.pos
+	c := p.src[p.pos]
+	switch {
+	case strings.HasPrefix(p.src[p.pos:], "..."):
+		p.pos += 3
+		p.kind = gqlPunct
+	case strings.IndexByte("!$():=@[]{}|", c) >= 0:
+		p.pos++
+		p.kind = gqlPunct
+	case c == '_' || isLetter(c):
+		for p.pos < len(p.src) && (p.src[p.pos] == '_' || isLetter(p.src[p.pos]) || isDigit(p.src[p.pos])) {
+			p.pos++
+		}
+		p.kind = gqlName
+	case c == '-' || isDigit(c):
+		p.pos++
+		for p.pos < len(p.src) && (isDigit(p.src[p.pos]) || strings.IndexByte(".eE+-", p.src[p.pos]) >= 0) {
+			p.pos++
+		}
+		p.kind = gqlNumber
+	case c == '"':
+		if strings.HasPrefix(p.src[p.pos:], `"""`) {
+			return fmt.Errorf("graphql block string is not supported")
+		}
+
+		p.pos++
+		for p.pos < len(p.src) && p.src[p.pos] != '"' {
+			if p.src[p.pos] == '\\' {
+				p.pos++
+			}
+			p.pos++
+		}
+		if p.pos >= len(p.src) {
+			return fmt.Errorf("graphql unterminated string at %d", start)
+		}
+		p.pos++
+		p.kind = gqlString
+	default:
+		return fmt.Errorf("graphql unexpected character %q at %d", c, start)
+	}
+
+	p.token = p.src[start:p.pos]
+	return nil
+}
+
+func isLetter(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
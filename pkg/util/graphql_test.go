@@ -0,0 +1,80 @@
+package util
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestParseGraphQL(t *testing.T) {
+	op, err := ParseGraphQL(`
+	# fetch the user
+	query getUser($id: ID!, $size: Int = 10) {
+		me: user(id: $id) {
+			name
+			friends(first: $size, tags: ["a", "b"]) { name }
+		}
+	}`, "", map[string]interface{}{"id": "u1"})
+	if err != nil {
+		t.Errorf("parse graphql failed, errors:%+v", err)
+		return
+	}
+
+	if op.Type != "query" || op.Name != "getUser" || len(op.Fields) != 1 {
+		t.Errorf("error operation %+v", op)
+		return
+	}
+
+	field := op.Fields[0]
+	if field.ResponseName() != "me" || field.Name != "user" || field.Arguments["id"] != "u1" {
+		t.Errorf("error field %+v", field)
+		return
+	}
+
+	friends := field.Fields[1]
+	if friends.Arguments["first"] != json.Number("10") || len(friends.Arguments["tags"].([]interface{})) != 2 {
+		t.Errorf("error arguments %+v", friends.Arguments)
+		return
+	}
+
+	if op.Depth() != 3 {
+		t.Errorf("expect depth 3, but %d", op.Depth())
+		return
+	}
+
+	if c := op.Complexity(func(*GraphQLField) int { return 5 }); c != 8 {
+		t.Errorf("expect complexity 8, but %d", c)
+		return
+	}
+}
+
+func TestParseGraphQLWithOperationName(t *testing.T) {
+	doc := `query a { x } mutation b { y(v: 1) }`
+	if _, err := ParseGraphQL(doc, "", nil); err == nil {
+		t.Errorf("expect missing operation name error")
+		return
+	}
+
+	op, err := ParseGraphQL(doc, "b", nil)
+	if err != nil {
+		t.Errorf("parse graphql failed, errors:%+v", err)
+		return
+	}
+
+	if op.Type != "mutation" || op.Fields[0].Name != "y" {
+		t.Errorf("error operation %+v", op)
+		return
+	}
+}
+
+func TestParseGraphQLWithUnsupported(t *testing.T) {
+	for _, doc := range []string{
+		`{ user { ...userFields } }`,
+		`subscription { events }`,
+		`{ user @include(if: true) }`,
+		`{ user(`,
+	} {
+		if _, err := ParseGraphQL(doc, "", nil); err == nil {
+			t.Errorf("expect error for %s", doc)
+		}
+	}
+}
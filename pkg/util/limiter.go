@@ -0,0 +1,126 @@
+package util
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+)
+
+// RateLimiter is a token bucket that refills max tokens per second and holds at most max tokens,
+// unlike the rate.Limiter the remaining tokens are exposed for the rate limit response headers
+type RateLimiter struct {
+	sync.Mutex
+
+	max    float64
+	tokens float64
+	last   time.Time
+}
+
+// NewRateLimiter returns a rate limiter with the max qps
+func NewRateLimiter(max int64) *RateLimiter {
+	return &RateLimiter{
+		max:    float64(max),
+		tokens: float64(max),
+		last:   time.Now(),
+	}
+}
+
+// Wait take a token, blocks until the token is available or the ctx is done
+func (l *RateLimiter) Wait(ctx context.Context) error {
+	l.Lock()
+	l.advance(time.Now())
+	l.tokens--
+	tokens := l.tokens
+	l.Unlock()
+
+	if tokens >= 0 {
+		return nil
+	}
+
+	t := time.NewTimer(time.Duration(-tokens / l.max * float64(time.Second)))
+	defer t.Stop()
+
+	select {
+	case <-t.C:
+		return nil
+	case <-ctx.Done():
+		// give back the token that not used
+		l.Lock()
+		l.tokens++
+		l.Unlock()
+		return ctx.Err()
+	}
+}
+
+// State returns the max qps, the remaining tokens and the seconds until the bucket is full
+func (l *RateLimiter) State(now time.Time) (limit, remaining, reset int64) {
+	l.Lock()
+	l.advance(now)
+	tokens := l.tokens
+	l.Unlock()
+
+	if tokens > 0 {
+		remaining = int64(tokens)
+	}
+
+	return int64(l.max), remaining, int64(math.Ceil((l.max - tokens) / l.max))
+}
+
+func (l *RateLimiter) advance(now time.Time) {
+	if now.After(l.last) {
+		l.tokens = math.Min(l.max, l.tokens+now.Sub(l.last).Seconds()*l.max)
+		l.last = now
+	}
+}
+
+// TokenBucket is a token bucket that refills rate tokens per second and holds at most burst tokens,
+// unlike the RateLimiter it never blocks, the rejected requests get the time until a token is available
+type TokenBucket struct {
+	sync.Mutex
+
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+// NewTokenBucket returns a full token bucket
+func NewTokenBucket(rate float64, burst int64, now time.Time) *TokenBucket {
+	return &TokenBucket{
+		rate:   rate,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   now,
+	}
+}
+
+// Take take a token, returns false and the duration until a token is available if the bucket is empty
+func (b *TokenBucket) Take(now time.Time) (bool, time.Duration) {
+	b.Lock()
+	defer b.Unlock()
+
+	b.advance(now)
+	if b.tokens >= 1 {
+		b.tokens--
+		return true, 0
+	}
+
+	return false, time.Duration((1 - b.tokens) / b.rate * float64(time.Second))
+}
+
+// Full returns true if the bucket is full, a full bucket is the same as a new bucket
+func (b *TokenBucket) Full(now time.Time) bool {
+	b.Lock()
+	defer b.Unlock()
+
+	b.advance(now)
+	return b.tokens >= b.burst
+}
+
+func (b *TokenBucket) advance(now time.Time) {
+	if now.After(b.last) {
+		b.tokens = math.Min(b.burst, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+		b.last = now
+	}
+}
@@ -0,0 +1,43 @@
+package util
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTokenBucket(t *testing.T) {
+	now := time.Now()
+	b := NewTokenBucket(2, 3, now)
+
+	for i := 0; i < 3; i++ {
+		if ok, _ := b.Take(now); !ok {
+			t.Errorf("expect take %d succeed", i)
+			return
+		}
+	}
+
+	ok, wait := b.Take(now)
+	if ok {
+		t.Errorf("expect take failed with the empty bucket")
+		return
+	}
+	if wait != time.Millisecond*500 {
+		t.Errorf("expect wait %s, but %s", time.Millisecond*500, wait)
+		return
+	}
+
+	if ok, _ := b.Take(now.Add(wait)); !ok {
+		t.Errorf("expect take succeed after wait")
+		return
+	}
+
+	if b.Full(now.Add(time.Second)) {
+		t.Errorf("expect bucket not full")
+		return
+	}
+
+	if !b.Full(now.Add(time.Second * 2)) {
+		t.Errorf("expect bucket full")
+		return
+	}
+}
@@ -123,6 +123,22 @@ func (c *Cache) removeElement(e *list.Element) {
 	}
 }
 
+// RemoveIf removes the keys that matched, and returns the number of the removed keys.
+func (c *Cache) RemoveIf(fn func(key Key) bool) int {
+	c.Lock()
+	defer c.Unlock()
+
+	removed := 0
+	for key, ele := range c.cache {
+		if fn(key) {
+			c.removeElement(ele)
+			removed++
+		}
+	}
+
+	return removed
+}
+
 // Len returns the number of items in the cache.
 func (c *Cache) Len() int {
 	c.RLock()
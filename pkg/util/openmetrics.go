@@ -0,0 +1,249 @@
+package util
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+)
+
+const (
+	// OpenMetricsContentType the content type of the OpenMetrics text format
+	OpenMetricsContentType = "application/openmetrics-text; version=1.0.0; charset=utf-8"
+
+	openMetricsAccept = "application/openmetrics-text"
+	// maxExemplarRunes the max length of the labels of a exemplar in OpenMetrics
+	maxExemplarRunes = 128
+	exemplarTraceID  = "trace_id"
+)
+
+// Exemplar is the last observation of a histogram bucket with the trace id
+type Exemplar struct {
+	TraceID   string
+	Value     float64
+	Timestamp time.Time
+}
+
+// Exemplars keep the last exemplar of the buckets of the histograms, keyed by the name and the labels of
+// the histograms, the exemplars are written with the buckets in the OpenMetrics exposition
+type Exemplars struct {
+	sync.RWMutex
+
+	values map[string][]*Exemplar
+}
+
+// NewExemplars returns exemplars
+func NewExemplars() *Exemplars {
+	return &Exemplars{
+		values: make(map[string][]*Exemplar),
+	}
+}
+
+// Observe record the observation of the histogram as the exemplar of the bucket, the buckets must be the
+// same as the histogram
+func (e *Exemplars) Observe(name string, labels prometheus.Labels, buckets []float64, value float64, traceID string, now time.Time) {
+	if traceID == "" {
+		return
+	}
+
+	// the label set of a exemplar is limited to 128 runes
+	if limit := maxExemplarRunes - len(exemplarTraceID); len(traceID) > limit {
+		traceID = traceID[:limit]
+	}
+
+	key := exemplarKey(name, labels)
+	idx := sort.SearchFloat64s(buckets, value)
+
+	e.Lock()
+	values, ok := e.values[key]
+	if !ok {
+		// the last one is the +Inf bucket
+		values = make([]*Exemplar, len(buckets)+1)
+		e.values[key] = values
+	}
+	if idx < len(values) {
+		values[idx] = &Exemplar{TraceID: traceID, Value: value, Timestamp: now}
+	}
+	e.Unlock()
+}
+
+func (e *Exemplars) get(name string, labels []*dto.LabelPair, idx int) *Exemplar {
+	if e == nil {
+		return nil
+	}
+
+	values := make(prometheus.Labels, len(labels))
+	for _, label := range labels {
+		values[label.GetName()] = label.GetValue()
+	}
+
+	e.RLock()
+	defer e.RUnlock()
+
+	buckets := e.values[exemplarKey(name, values)]
+	if idx < len(buckets) {
+		return buckets[idx]
+	}
+	return nil
+}
+
+func exemplarKey(name string, labels prometheus.Labels) string {
+	names := make([]string, 0, len(labels))
+	for label := range labels {
+		names = append(names, label)
+	}
+	sort.Strings(names)
+
+	key := name
+	for _, label := range names {
+		key += "\xff" + label + "\xff" + labels[label]
+	}
+	return key
+}
+
+// NewMetricHandler returns the http handler of the metrics of the default gatherer, the scrapers that accept
+// the OpenMetrics get the OpenMetrics text format with the exemplars, others get the prometheus formats
+func NewMetricHandler(exemplars *Exemplars) http.Handler {
+	handler := prometheus.Handler()
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.Contains(r.Header.Get("Accept"), openMetricsAccept) {
+			handler.ServeHTTP(w, r)
+			return
+		}
+
+		mfs, err := prometheus.DefaultGatherer.Gather()
+		if err != nil {
+			http.Error(w, "An error has occurred during metrics collection:\n\n"+err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", OpenMetricsContentType)
+		err = WriteOpenMetrics(w, mfs, exemplars)
+		if err != nil {
+			http.Error(w, "An error has occurred during metrics encoding:\n\n"+err.Error(), http.StatusInternalServerError)
+		}
+	})
+}
+
+// WriteOpenMetrics write the metric families in the OpenMetrics text format, the buckets of the histograms
+// have the exemplars if exist. The counters are named without the _total suffix, and the samples have it
+func WriteOpenMetrics(w io.Writer, mfs []*dto.MetricFamily, exemplars *Exemplars) error {
+	buf := bufio.NewWriter(w)
+	for _, mf := range mfs {
+		name := mf.GetName()
+		family := name
+		typ := "unknown"
+		switch mf.GetType() {
+		case dto.MetricType_COUNTER:
+			typ = "counter"
+			family = strings.TrimSuffix(name, "_total")
+		case dto.MetricType_GAUGE:
+			typ = "gauge"
+		case dto.MetricType_SUMMARY:
+			typ = "summary"
+		case dto.MetricType_HISTOGRAM:
+			typ = "histogram"
+		}
+
+		fmt.Fprintf(buf, "# TYPE %s %s\n", family, typ)
+		if mf.Help != nil {
+			fmt.Fprintf(buf, "# HELP %s %s\n", family, escapeOpenMetrics(mf.GetHelp()))
+		}
+
+		for _, m := range mf.GetMetric() {
+			labels := m.GetLabel()
+			switch mf.GetType() {
+			case dto.MetricType_COUNTER:
+				writeOpenMetricsSample(buf, family+"_total", labels, "", "", m.GetCounter().GetValue(), nil)
+			case dto.MetricType_GAUGE:
+				writeOpenMetricsSample(buf, name, labels, "", "", m.GetGauge().GetValue(), nil)
+			case dto.MetricType_SUMMARY:
+				for _, q := range m.GetSummary().GetQuantile() {
+					writeOpenMetricsSample(buf, name, labels, "quantile", formatOpenMetricsFloat(q.GetQuantile()), q.GetValue(), nil)
+				}
+				writeOpenMetricsSample(buf, name+"_sum", labels, "", "", m.GetSummary().GetSampleSum(), nil)
+				writeOpenMetricsSample(buf, name+"_count", labels, "", "", float64(m.GetSummary().GetSampleCount()), nil)
+			case dto.MetricType_HISTOGRAM:
+				h := m.GetHistogram()
+				inf := false
+				for i, b := range h.GetBucket() {
+					inf = math.IsInf(b.GetUpperBound(), 1)
+					writeOpenMetricsSample(buf, name+"_bucket", labels, "le", formatOpenMetricsFloat(b.GetUpperBound()), float64(b.GetCumulativeCount()),
+						exemplars.get(name, labels, i))
+				}
+				if !inf {
+					writeOpenMetricsSample(buf, name+"_bucket", labels, "le", "+Inf", float64(h.GetSampleCount()),
+						exemplars.get(name, labels, len(h.GetBucket())))
+				}
+				writeOpenMetricsSample(buf, name+"_sum", labels, "", "", h.GetSampleSum(), nil)
+				writeOpenMetricsSample(buf, name+"_count", labels, "", "", float64(h.GetSampleCount()), nil)
+			default:
+				writeOpenMetricsSample(buf, name, labels, "", "", m.GetUntyped().GetValue(), nil)
+			}
+		}
+	}
+
+	buf.WriteString("# EOF\n")
+	return buf.Flush()
+}
+
+// writeOpenMetricsSample write the sample, the extra label is the le of the buckets or the quantile of
+// the summaries, the exemplar is only for the buckets
+func writeOpenMetricsSample(buf *bufio.Writer, name string, labels []*dto.LabelPair, extraName, extraValue string, value float64, exemplar *Exemplar) {
+	buf.WriteString(name)
+	if len(labels) > 0 || extraName != "" {
+		buf.WriteByte('{')
+		for i, label := range labels {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			fmt.Fprintf(buf, "%s=\"%s\"", label.GetName(), escapeOpenMetrics(label.GetValue()))
+		}
+		if extraName != "" {
+			if len(labels) > 0 {
+				buf.WriteByte(',')
+			}
+			fmt.Fprintf(buf, "%s=\"%s\"", extraName, extraValue)
+		}
+		buf.WriteByte('}')
+	}
+	buf.WriteByte(' ')
+	buf.WriteString(formatOpenMetricsFloat(value))
+
+	if exemplar != nil {
+		fmt.Fprintf(buf, " # {%s=\"%s\"} %s %s",
+			exemplarTraceID,
+			escapeOpenMetrics(exemplar.TraceID),
+			formatOpenMetricsFloat(exemplar.Value),
+			strconv.FormatFloat(float64(exemplar.Timestamp.UnixNano())/1e9, 'f', 3, 64))
+	}
+	buf.WriteByte('\n')
+}
+
+func formatOpenMetricsFloat(value float64) string {
+	switch {
+	case math.IsInf(value, 1):
+		return "+Inf"
+	case math.IsInf(value, -1):
+		return "-Inf"
+	case math.IsNaN(value):
+		return "NaN"
+	}
+
+	return strconv.FormatFloat(value, 'g', -1, 64)
+}
+
+var openMetricsEscaper = strings.NewReplacer("\\", `\\`, "\n", `\n`, "\"", `\"`)
+
+func escapeOpenMetrics(value string) string {
+	return openMetricsEscaper.Replace(value)
+}
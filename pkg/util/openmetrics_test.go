@@ -0,0 +1,72 @@
+package util
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+)
+
+func TestWriteOpenMetrics(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	counter := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "test_requests_total",
+		Help: "Total number of \"requests\".",
+	}, []string{"name"})
+	buckets := []float64{0.1, 1}
+	histogram := prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "test_duration_seconds",
+		Help:    "Duration.",
+		Buckets: buckets,
+	}, []string{"name"})
+	registry.MustRegister(counter, histogram)
+
+	now := time.Unix(1600000000, 0)
+	exemplars := NewExemplars()
+	counter.WithLabelValues("a").Inc()
+	histogram.WithLabelValues("a").Observe(0.5)
+	exemplars.Observe("test_duration_seconds", prometheus.Labels{"name": "a"}, buckets, 0.5, "abc", now)
+	histogram.WithLabelValues("a").Observe(2)
+	exemplars.Observe("test_duration_seconds", prometheus.Labels{"name": "a"}, buckets, 2, "def", now)
+	exemplars.Observe("test_duration_seconds", prometheus.Labels{"name": "b"}, buckets, 2, "ghi", now)
+
+	mfs, err := registry.Gather()
+	if err != nil {
+		t.Fatalf("gather failed, errors:%+v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := WriteOpenMetrics(&buf, mfs, exemplars); err != nil {
+		t.Fatalf("write failed, errors:%+v", err)
+	}
+
+	expect := strings.Join([]string{
+		`# TYPE test_duration_seconds histogram`,
+		`# HELP test_duration_seconds Duration.`,
+		`test_duration_seconds_bucket{name="a",le="0.1"} 0`,
+		`test_duration_seconds_bucket{name="a",le="1"} 1 # {trace_id="abc"} 0.5 1600000000.000`,
+		`test_duration_seconds_bucket{name="a",le="+Inf"} 2 # {trace_id="def"} 2 1600000000.000`,
+		`test_duration_seconds_sum{name="a"} 2.5`,
+		`test_duration_seconds_count{name="a"} 2`,
+		`# TYPE test_requests counter`,
+		`# HELP test_requests Total number of \"requests\".`,
+		`test_requests_total{name="a"} 1`,
+		`# EOF`,
+		``,
+	}, "\n")
+	if buf.String() != expect {
+		t.Errorf("openmetrics failed, got:\n%s", buf.String())
+	}
+}
+
+func TestExemplarsWithoutTraceID(t *testing.T) {
+	exemplars := NewExemplars()
+	exemplars.Observe("test", nil, []float64{1}, 0.5, "", time.Now())
+
+	if value := exemplars.get("test", []*dto.LabelPair{}, 0); value != nil {
+		t.Errorf("exemplar without trace id failed, %+v", value)
+	}
+}
@@ -0,0 +1,717 @@
+package util
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
+)
+
+const (
+	wireVarint  = 0
+	wireFixed64 = 1
+	wireBytes   = 2
+	wireFixed32 = 5
+)
+
+// ProtoMethod is a grpc method of the proto descriptors, the types are the full names of the messages
+type ProtoMethod struct {
+	Path            string
+	InputType       string
+	OutputType      string
+	ClientStreaming bool
+	ServerStreaming bool
+}
+
+// ProtoDescriptors is the messages, the enums and the methods of a FileDescriptorSet, used to transcode
+// the json to the protobuf messages and back by the proto3 json mapping
+type ProtoDescriptors struct {
+	messages map[string]*descriptor.DescriptorProto
+	enums    map[string]*descriptor.EnumDescriptorProto
+	methods  map[string]*ProtoMethod
+}
+
+// ParseProtoDescriptors parse the serialized FileDescriptorSet, the set must include the imports, e.g.
+// protoc --include_imports --descriptor_set_out
+func ParseProtoDescriptors(data []byte) (*ProtoDescriptors, error) {
+	set := &descriptor.FileDescriptorSet{}
+	if err := proto.Unmarshal(data, set); err != nil {
+		return nil, fmt.Errorf("error descriptor set: %s", err)
+	}
+
+	d := &ProtoDescriptors{
+		messages: make(map[string]*descriptor.DescriptorProto),
+		enums:    make(map[string]*descriptor.EnumDescriptorProto),
+		methods:  make(map[string]*ProtoMethod),
+	}
+	for _, file := range set.File {
+		prefix := ""
+		if file.GetPackage() != "" {
+			prefix = "." + file.GetPackage()
+		}
+
+		for _, msg := range file.MessageType {
+			d.addMessage(prefix, msg)
+		}
+		for _, enum := range file.EnumType {
+			d.enums[prefix+"."+enum.GetName()] = enum
+		}
+		for _, svc := range file.Service {
+			for _, m := range svc.Method {
+				path := fmt.Sprintf("%s.%s/%s", prefix, svc.GetName(), m.GetName())[1:]
+				d.methods[path] = &ProtoMethod{
+					Path:            "/" + path,
+					InputType:       m.GetInputType(),
+					OutputType:      m.GetOutputType(),
+					ClientStreaming: m.GetClientStreaming(),
+					ServerStreaming: m.GetServerStreaming(),
+				}
+			}
+		}
+	}
+
+	for _, m := range d.methods {
+		if _, ok := d.messages[m.InputType]; !ok {
+			return nil, fmt.Errorf("missing message %s of method %s", m.InputType, m.Path)
+		}
+		if _, ok := d.messages[m.OutputType]; !ok {
+			return nil, fmt.Errorf("missing message %s of method %s", m.OutputType, m.Path)
+		}
+	}
+
+	return d, nil
+}
+
+func (d *ProtoDescriptors) addMessage(prefix string, msg *descriptor.DescriptorProto) {
+	name := prefix + "." + msg.GetName()
+	d.messages[name] = msg
+	for _, nested := range msg.NestedType {
+		d.addMessage(name, nested)
+	}
+	for _, enum := range msg.EnumType {
+		d.enums[name+"."+enum.GetName()] = enum
+	}
+}
+
+// Method returns the method by the name, e.g. helloworld.Greeter/SayHello
+func (d *ProtoDescriptors) Method(name string) (*ProtoMethod, bool) {
+	m, ok := d.methods[strings.TrimPrefix(name, "/")]
+	return m, ok
+}
+
+// Methods returns the names of the methods
+func (d *ProtoDescriptors) Methods() []string {
+	values := make([]string, 0, len(d.methods))
+	for name := range d.methods {
+		values = append(values, name)
+	}
+	return values
+}
+
+// EncodeJSON encode the json value to the protobuf message, the unknown fields are ignored. The scalar
+// fields accept the strings, e.g. the query values
+func (d *ProtoDescriptors) EncodeJSON(message string, value map[string]interface{}) ([]byte, error) {
+	msg, ok := d.messages[message]
+	if !ok {
+		return nil, fmt.Errorf("missing message %s", message)
+	}
+
+	return d.encodeMessage(msg, value, "")
+}
+
+// DecodeJSON decode the protobuf message to the json value, the fields that not on the wire are omitted
+func (d *ProtoDescriptors) DecodeJSON(message string, data []byte) (map[string]interface{}, error) {
+	msg, ok := d.messages[message]
+	if !ok {
+		return nil, fmt.Errorf("missing message %s", message)
+	}
+
+	return d.decodeMessage(msg, data, "")
+}
+
+func (d *ProtoDescriptors) encodeMessage(msg *descriptor.DescriptorProto, value map[string]interface{}, path string) ([]byte, error) {
+	var buf []byte
+	for _, field := range msg.Field {
+		v, ok := value[jsonName(field)]
+		if !ok {
+			v, ok = value[field.GetName()]
+		}
+		if !ok || v == nil {
+			continue
+		}
+
+		fieldPath := path + "." + jsonName(field)
+		if field.GetLabel() != descriptor.FieldDescriptorProto_LABEL_REPEATED {
+			data, err := d.encodeField(buf, field, v, fieldPath)
+			if err != nil {
+				return nil, err
+			}
+			buf = data
+			continue
+		}
+
+		if entry := d.mapEntry(field); entry != nil {
+			values, ok := v.(map[string]interface{})
+			if !ok {
+				return nil, fmt.Errorf("%s: expect object", fieldPath)
+			}
+
+			for key, item := range values {
+				data, err := d.encodeMessage(entry, map[string]interface{}{
+					jsonName(entry.Field[0]): key,
+					jsonName(entry.Field[1]): item,
+				}, fieldPath)
+				if err != nil {
+					return nil, err
+				}
+
+				buf = appendTag(buf, field.GetNumber(), wireBytes)
+				buf = appendBytes(buf, data)
+			}
+			continue
+		}
+
+		values, ok := v.([]interface{})
+		if !ok {
+			// a single query value of the repeated field
+			values = []interface{}{v}
+		}
+		for idx, item := range values {
+			data, err := d.encodeField(buf, field, item, fmt.Sprintf("%s[%d]", fieldPath, idx))
+			if err != nil {
+				return nil, err
+			}
+			buf = data
+		}
+	}
+
+	return buf, nil
+}
+
+func (d *ProtoDescriptors) encodeField(buf []byte, field *descriptor.FieldDescriptorProto, v interface{}, path string) ([]byte, error) {
+	num := field.GetNumber()
+	switch field.GetType() {
+	case descriptor.FieldDescriptorProto_TYPE_MESSAGE:
+		msg, ok := d.messages[field.GetTypeName()]
+		if !ok {
+			return nil, fmt.Errorf("%s: missing message %s", path, field.GetTypeName())
+		}
+
+		value, ok := v.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("%s: expect object", path)
+		}
+
+		data, err := d.encodeMessage(msg, value, path)
+		if err != nil {
+			return nil, err
+		}
+		return appendBytes(appendTag(buf, num, wireBytes), data), nil
+	case descriptor.FieldDescriptorProto_TYPE_STRING:
+		value, ok := v.(string)
+		if !ok {
+			return nil, fmt.Errorf("%s: expect string", path)
+		}
+		return appendBytes(appendTag(buf, num, wireBytes), []byte(value)), nil
+	case descriptor.FieldDescriptorProto_TYPE_BYTES:
+		value, ok := v.(string)
+		if !ok {
+			return nil, fmt.Errorf("%s: expect base64 string", path)
+		}
+
+		data, err := base64.StdEncoding.DecodeString(value)
+		if err != nil {
+			data, err = base64.URLEncoding.DecodeString(value)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%s: expect base64 string", path)
+		}
+		return appendBytes(appendTag(buf, num, wireBytes), data), nil
+	case descriptor.FieldDescriptorProto_TYPE_BOOL:
+		value, err := jsonBool(v)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s", path, err)
+		}
+
+		n := uint64(0)
+		if value {
+			n = 1
+		}
+		return appendVarint(appendTag(buf, num, wireVarint), n), nil
+	case descriptor.FieldDescriptorProto_TYPE_ENUM:
+		n, err := d.enumNumber(field.GetTypeName(), v)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s", path, err)
+		}
+		return appendVarint(appendTag(buf, num, wireVarint), uint64(int64(n))), nil
+	case descriptor.FieldDescriptorProto_TYPE_FLOAT:
+		value, err := jsonFloat(v, 32)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s", path, err)
+		}
+		return appendFixed32(appendTag(buf, num, wireFixed32), math.Float32bits(float32(value))), nil
+	case descriptor.FieldDescriptorProto_TYPE_DOUBLE:
+		value, err := jsonFloat(v, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s", path, err)
+		}
+		return appendFixed64(appendTag(buf, num, wireFixed64), math.Float64bits(value)), nil
+	case descriptor.FieldDescriptorProto_TYPE_INT32, descriptor.FieldDescriptorProto_TYPE_INT64:
+		value, err := jsonInt(v, field.GetType() == descriptor.FieldDescriptorProto_TYPE_INT32)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s", path, err)
+		}
+		return appendVarint(appendTag(buf, num, wireVarint), uint64(value)), nil
+	case descriptor.FieldDescriptorProto_TYPE_UINT32, descriptor.FieldDescriptorProto_TYPE_UINT64:
+		value, err := jsonUint(v, field.GetType() == descriptor.FieldDescriptorProto_TYPE_UINT32)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s", path, err)
+		}
+		return appendVarint(appendTag(buf, num, wireVarint), value), nil
+	case descriptor.FieldDescriptorProto_TYPE_SINT32, descriptor.FieldDescriptorProto_TYPE_SINT64:
+		value, err := jsonInt(v, field.GetType() == descriptor.FieldDescriptorProto_TYPE_SINT32)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s", path, err)
+		}
+		return appendVarint(appendTag(buf, num, wireVarint), uint64(value<<1)^uint64(value>>63)), nil
+	case descriptor.FieldDescriptorProto_TYPE_FIXED32:
+		value, err := jsonUint(v, true)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s", path, err)
+		}
+		return appendFixed32(appendTag(buf, num, wireFixed32), uint32(value)), nil
+	case descriptor.FieldDescriptorProto_TYPE_SFIXED32:
+		value, err := jsonInt(v, true)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s", path, err)
+		}
+		return appendFixed32(appendTag(buf, num, wireFixed32), uint32(int32(value))), nil
+	case descriptor.FieldDescriptorProto_TYPE_FIXED64:
+		value, err := jsonUint(v, false)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s", path, err)
+		}
+		return appendFixed64(appendTag(buf, num, wireFixed64), value), nil
+	case descriptor.FieldDescriptorProto_TYPE_SFIXED64:
+		value, err := jsonInt(v, false)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s", path, err)
+		}
+		return appendFixed64(appendTag(buf, num, wireFixed64), uint64(value)), nil
+	}
+
+	return nil, fmt.Errorf("%s: not support type %s", path, field.GetType().String())
+}
+
+func (d *ProtoDescriptors) decodeMessage(msg *descriptor.DescriptorProto, data []byte, path string) (map[string]interface{}, error) {
+	fields := make(map[int32]*descriptor.FieldDescriptorProto, len(msg.Field))
+	for _, field := range msg.Field {
+		fields[field.GetNumber()] = field
+	}
+
+	value := make(map[string]interface{})
+	for len(data) > 0 {
+		tag, n := binary.Uvarint(data)
+		if n <= 0 {
+			return nil, fmt.Errorf("%s: error tag", path)
+		}
+		data = data[n:]
+
+		num, wire := int32(tag>>3), int(tag&7)
+		raw, rest, err := readWire(data, wire)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s", path, err)
+		}
+		data = rest
+
+		field, ok := fields[num]
+		if !ok {
+			continue
+		}
+
+		name := jsonName(field)
+		fieldPath := path + "." + name
+		if entry := d.mapEntry(field); entry != nil {
+			item, err := d.decodeMessage(entry, raw, fieldPath)
+			if err != nil {
+				return nil, err
+			}
+
+			values, _ := value[name].(map[string]interface{})
+			if values == nil {
+				values = make(map[string]interface{})
+				value[name] = values
+			}
+
+			key := item[jsonName(entry.Field[0])]
+			if key == nil {
+				key = ""
+			}
+			values[fmt.Sprintf("%v", key)] = item[jsonName(entry.Field[1])]
+			continue
+		}
+
+		if field.GetLabel() != descriptor.FieldDescriptorProto_LABEL_REPEATED {
+			v, err := d.decodeField(field, wire, raw, fieldPath)
+			if err != nil {
+				return nil, err
+			}
+			value[name] = v
+			continue
+		}
+
+		values, _ := value[name].([]interface{})
+		if wire == wireBytes && isPackable(field.GetType()) {
+			for len(raw) > 0 {
+				item, rest, err := readWire(raw, scalarWire(field.GetType()))
+				if err != nil {
+					return nil, fmt.Errorf("%s: %s", fieldPath, err)
+				}
+				raw = rest
+
+				v, err := d.decodeField(field, scalarWire(field.GetType()), item, fieldPath)
+				if err != nil {
+					return nil, err
+				}
+				values = append(values, v)
+			}
+		} else {
+			v, err := d.decodeField(field, wire, raw, fieldPath)
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, v)
+		}
+		value[name] = values
+	}
+
+	return value, nil
+}
+
+func (d *ProtoDescriptors) decodeField(field *descriptor.FieldDescriptorProto, wire int, raw []byte, path string) (interface{}, error) {
+	if wire != scalarWire(field.GetType()) {
+		return nil, fmt.Errorf("%s: error wire type %d", path, wire)
+	}
+
+	switch field.GetType() {
+	case descriptor.FieldDescriptorProto_TYPE_MESSAGE:
+		msg, ok := d.messages[field.GetTypeName()]
+		if !ok {
+			return nil, fmt.Errorf("%s: missing message %s", path, field.GetTypeName())
+		}
+		return d.decodeMessage(msg, raw, path)
+	case descriptor.FieldDescriptorProto_TYPE_STRING:
+		return string(raw), nil
+	case descriptor.FieldDescriptorProto_TYPE_BYTES:
+		return base64.StdEncoding.EncodeToString(raw), nil
+	case descriptor.FieldDescriptorProto_TYPE_FLOAT:
+		return jsonNumber(float64(math.Float32frombits(binary.LittleEndian.Uint32(raw)))), nil
+	case descriptor.FieldDescriptorProto_TYPE_DOUBLE:
+		return jsonNumber(math.Float64frombits(binary.LittleEndian.Uint64(raw))), nil
+	case descriptor.FieldDescriptorProto_TYPE_FIXED32:
+		return binary.LittleEndian.Uint32(raw), nil
+	case descriptor.FieldDescriptorProto_TYPE_SFIXED32:
+		return int32(binary.LittleEndian.Uint32(raw)), nil
+	case descriptor.FieldDescriptorProto_TYPE_FIXED64:
+		return strconv.FormatUint(binary.LittleEndian.Uint64(raw), 10), nil
+	case descriptor.FieldDescriptorProto_TYPE_SFIXED64:
+		return strconv.FormatInt(int64(binary.LittleEndian.Uint64(raw)), 10), nil
+	}
+
+	n, size := binary.Uvarint(raw)
+	if size <= 0 {
+		return nil, fmt.Errorf("%s: error varint", path)
+	}
+
+	switch field.GetType() {
+	case descriptor.FieldDescriptorProto_TYPE_BOOL:
+		return n != 0, nil
+	case descriptor.FieldDescriptorProto_TYPE_ENUM:
+		return d.enumName(field.GetTypeName(), int32(n)), nil
+	case descriptor.FieldDescriptorProto_TYPE_INT32:
+		return int32(n), nil
+	case descriptor.FieldDescriptorProto_TYPE_UINT32:
+		return uint32(n), nil
+	case descriptor.FieldDescriptorProto_TYPE_SINT32:
+		return int32(uint32(n>>1) ^ -uint32(n&1)), nil
+	case descriptor.FieldDescriptorProto_TYPE_INT64:
+		return strconv.FormatInt(int64(n), 10), nil
+	case descriptor.FieldDescriptorProto_TYPE_UINT64:
+		return strconv.FormatUint(n, 10), nil
+	case descriptor.FieldDescriptorProto_TYPE_SINT64:
+		return strconv.FormatInt(int64(n>>1)^-int64(n&1), 10), nil
+	}
+
+	return nil, fmt.Errorf("%s: not support type %s", path, field.GetType().String())
+}
+
+// mapEntry returns the entry message of the map field, nil if the field is not a map
+func (d *ProtoDescriptors) mapEntry(field *descriptor.FieldDescriptorProto) *descriptor.DescriptorProto {
+	if field.GetType() != descriptor.FieldDescriptorProto_TYPE_MESSAGE ||
+		field.GetLabel() != descriptor.FieldDescriptorProto_LABEL_REPEATED {
+		return nil
+	}
+
+	msg, ok := d.messages[field.GetTypeName()]
+	if !ok || !msg.GetOptions().GetMapEntry() || len(msg.Field) != 2 {
+		return nil
+	}
+
+	return msg
+}
+
+func (d *ProtoDescriptors) enumNumber(name string, v interface{}) (int32, error) {
+	if value, ok := v.(string); ok {
+		if enum, ok := d.enums[name]; ok {
+			for _, item := range enum.Value {
+				if item.GetName() == value {
+					return item.GetNumber(), nil
+				}
+			}
+		}
+	}
+
+	n, err := jsonInt(v, true)
+	if err != nil {
+		return 0, fmt.Errorf("error enum value %v of %s", v, name)
+	}
+	return int32(n), nil
+}
+
+func (d *ProtoDescriptors) enumName(name string, n int32) interface{} {
+	if enum, ok := d.enums[name]; ok {
+		for _, item := range enum.Value {
+			if item.GetNumber() == n {
+				return item.GetName()
+			}
+		}
+	}
+
+	return n
+}
+
+// jsonName returns the json name of the field, the lower camel case of the name if protoc not set it
+func jsonName(field *descriptor.FieldDescriptorProto) string {
+	if field.JsonName != nil {
+		return field.GetJsonName()
+	}
+
+	var buf bytes.Buffer
+	upper := false
+	for _, c := range field.GetName() {
+		if c == '_' {
+			upper = true
+			continue
+		}
+
+		if upper && c >= 'a' && c <= 'z' {
+			c -= 'a' - 'A'
+		}
+		upper = false
+		buf.WriteRune(c)
+	}
+	return buf.String()
+}
+
+func isPackable(t descriptor.FieldDescriptorProto_Type) bool {
+	switch t {
+	case descriptor.FieldDescriptorProto_TYPE_STRING,
+		descriptor.FieldDescriptorProto_TYPE_BYTES,
+		descriptor.FieldDescriptorProto_TYPE_MESSAGE,
+		descriptor.FieldDescriptorProto_TYPE_GROUP:
+		return false
+	}
+
+	return true
+}
+
+func scalarWire(t descriptor.FieldDescriptorProto_Type) int {
+	switch t {
+	case descriptor.FieldDescriptorProto_TYPE_STRING,
+		descriptor.FieldDescriptorProto_TYPE_BYTES,
+		descriptor.FieldDescriptorProto_TYPE_MESSAGE:
+		return wireBytes
+	case descriptor.FieldDescriptorProto_TYPE_FLOAT,
+		descriptor.FieldDescriptorProto_TYPE_FIXED32,
+		descriptor.FieldDescriptorProto_TYPE_SFIXED32:
+		return wireFixed32
+	case descriptor.FieldDescriptorProto_TYPE_DOUBLE,
+		descriptor.FieldDescriptorProto_TYPE_FIXED64,
+		descriptor.FieldDescriptorProto_TYPE_SFIXED64:
+		return wireFixed64
+	}
+
+	return wireVarint
+}
+
+// readWire returns the value of the wire type and the rest data, the length prefix of the bytes is removed
+func readWire(data []byte, wire int) ([]byte, []byte, error) {
+	switch wire {
+	case wireVarint:
+		_, n := binary.Uvarint(data)
+		if n <= 0 {
+			return nil, nil, fmt.Errorf("error varint")
+		}
+		return data[:n], data[n:], nil
+	case wireFixed64:
+		if len(data) < 8 {
+			return nil, nil, fmt.Errorf("unexpected EOF")
+		}
+		return data[:8], data[8:], nil
+	case wireFixed32:
+		if len(data) < 4 {
+			return nil, nil, fmt.Errorf("unexpected EOF")
+		}
+		return data[:4], data[4:], nil
+	case wireBytes:
+		size, n := binary.Uvarint(data)
+		if n <= 0 || uint64(len(data)-n) < size {
+			return nil, nil, fmt.Errorf("unexpected EOF")
+		}
+		return data[n : n+int(size)], data[n+int(size):], nil
+	}
+
+	return nil, nil, fmt.Errorf("not support wire type %d", wire)
+}
+
+func appendTag(buf []byte, num int32, wire int) []byte {
+	return appendVarint(buf, uint64(num)<<3|uint64(wire))
+}
+
+func appendVarint(buf []byte, value uint64) []byte {
+	var data [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(data[:], value)
+	return append(buf, data[:n]...)
+}
+
+func appendFixed32(buf []byte, value uint32) []byte {
+	var data [4]byte
+	binary.LittleEndian.PutUint32(data[:], value)
+	return append(buf, data[:]...)
+}
+
+func appendFixed64(buf []byte, value uint64) []byte {
+	var data [8]byte
+	binary.LittleEndian.PutUint64(data[:], value)
+	return append(buf, data[:]...)
+}
+
+func appendBytes(buf []byte, data []byte) []byte {
+	return append(appendVarint(buf, uint64(len(data))), data...)
+}
+
+func jsonBool(v interface{}) (bool, error) {
+	switch value := v.(type) {
+	case bool:
+		return value, nil
+	case string:
+		b, err := strconv.ParseBool(value)
+		if err == nil {
+			return b, nil
+		}
+	}
+
+	return false, fmt.Errorf("expect bool, %v", v)
+}
+
+func jsonFloat(v interface{}, bits int) (float64, error) {
+	switch value := v.(type) {
+	case float64:
+		return value, nil
+	case json.Number:
+		return strconv.ParseFloat(string(value), bits)
+	case string:
+		switch value {
+		case "NaN":
+			return math.NaN(), nil
+		case "Infinity":
+			return math.Inf(1), nil
+		case "-Infinity":
+			return math.Inf(-1), nil
+		}
+
+		f, err := strconv.ParseFloat(value, bits)
+		if err == nil {
+			return f, nil
+		}
+	}
+
+	return 0, fmt.Errorf("expect number, %v", v)
+}
+
+func jsonInt(v interface{}, is32 bool) (int64, error) {
+	bits := 64
+	if is32 {
+		bits = 32
+	}
+
+	var text string
+	switch value := v.(type) {
+	case float64:
+		if value != math.Trunc(value) {
+			return 0, fmt.Errorf("expect integer, %v", v)
+		}
+		text = strconv.FormatFloat(value, 'f', -1, 64)
+	case json.Number:
+		text = string(value)
+	case string:
+		text = value
+	default:
+		return 0, fmt.Errorf("expect integer, %v", v)
+	}
+
+	n, err := strconv.ParseInt(text, 10, bits)
+	if err != nil {
+		return 0, fmt.Errorf("expect integer, %v", v)
+	}
+	return n, nil
+}
+
+func jsonUint(v interface{}, is32 bool) (uint64, error) {
+	bits := 64
+	if is32 {
+		bits = 32
+	}
+
+	var text string
+	switch value := v.(type) {
+	case float64:
+		if value != math.Trunc(value) {
+			return 0, fmt.Errorf("expect unsigned integer, %v", v)
+		}
+		text = strconv.FormatFloat(value, 'f', -1, 64)
+	case json.Number:
+		text = string(value)
+	case string:
+		text = value
+	default:
+		return 0, fmt.Errorf("expect unsigned integer, %v", v)
+	}
+
+	n, err := strconv.ParseUint(text, 10, bits)
+	if err != nil {
+		return 0, fmt.Errorf("expect unsigned integer, %v", v)
+	}
+	return n, nil
+}
+
+// jsonNumber returns the float, or the string of the NaN and the infinities that json not supported
+func jsonNumber(value float64) interface{} {
+	switch {
+	case math.IsNaN(value):
+		return "NaN"
+	case math.IsInf(value, 1):
+		return "Infinity"
+	case math.IsInf(value, -1):
+		return "-Infinity"
+	}
+
+	return value
+}
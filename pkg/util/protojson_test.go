@@ -0,0 +1,172 @@
+package util
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
+)
+
+func newTestField(name string, num int32, t descriptor.FieldDescriptorProto_Type, repeated bool, typeName string) *descriptor.FieldDescriptorProto {
+	label := descriptor.FieldDescriptorProto_LABEL_OPTIONAL
+	if repeated {
+		label = descriptor.FieldDescriptorProto_LABEL_REPEATED
+	}
+
+	field := &descriptor.FieldDescriptorProto{
+		Name:   proto.String(name),
+		Number: proto.Int32(num),
+		Label:  &label,
+		Type:   &t,
+	}
+	if typeName != "" {
+		field.TypeName = proto.String(typeName)
+	}
+	return field
+}
+
+func newTestDescriptors(t *testing.T) *ProtoDescriptors {
+	set := &descriptor.FileDescriptorSet{
+		File: []*descriptor.FileDescriptorProto{
+			{
+				Name:    proto.String("user.proto"),
+				Package: proto.String("test"),
+				EnumType: []*descriptor.EnumDescriptorProto{
+					{
+						Name: proto.String("Role"),
+						Value: []*descriptor.EnumValueDescriptorProto{
+							{Name: proto.String("GUEST"), Number: proto.Int32(0)},
+							{Name: proto.String("ADMIN"), Number: proto.Int32(1)},
+						},
+					},
+				},
+				MessageType: []*descriptor.DescriptorProto{
+					{
+						Name: proto.String("User"),
+						Field: []*descriptor.FieldDescriptorProto{
+							newTestField("id", 1, descriptor.FieldDescriptorProto_TYPE_INT64, false, ""),
+							newTestField("user_name", 2, descriptor.FieldDescriptorProto_TYPE_STRING, false, ""),
+							newTestField("role", 3, descriptor.FieldDescriptorProto_TYPE_ENUM, false, ".test.Role"),
+							newTestField("scores", 4, descriptor.FieldDescriptorProto_TYPE_SINT32, true, ""),
+							newTestField("labels", 5, descriptor.FieldDescriptorProto_TYPE_MESSAGE, true, ".test.User.LabelsEntry"),
+							newTestField("friend", 6, descriptor.FieldDescriptorProto_TYPE_MESSAGE, false, ".test.User"),
+							newTestField("avatar", 7, descriptor.FieldDescriptorProto_TYPE_BYTES, false, ""),
+							newTestField("active", 8, descriptor.FieldDescriptorProto_TYPE_BOOL, false, ""),
+							newTestField("rate", 9, descriptor.FieldDescriptorProto_TYPE_DOUBLE, false, ""),
+						},
+						NestedType: []*descriptor.DescriptorProto{
+							{
+								Name: proto.String("LabelsEntry"),
+								Field: []*descriptor.FieldDescriptorProto{
+									newTestField("key", 1, descriptor.FieldDescriptorProto_TYPE_STRING, false, ""),
+									newTestField("value", 2, descriptor.FieldDescriptorProto_TYPE_INT32, false, ""),
+								},
+								Options: &descriptor.MessageOptions{MapEntry: proto.Bool(true)},
+							},
+						},
+					},
+				},
+				Service: []*descriptor.ServiceDescriptorProto{
+					{
+						Name: proto.String("Users"),
+						Method: []*descriptor.MethodDescriptorProto{
+							{
+								Name:       proto.String("Get"),
+								InputType:  proto.String(".test.User"),
+								OutputType: proto.String(".test.User"),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	data, err := proto.Marshal(set)
+	if err != nil {
+		t.Fatalf("marshal descriptor set failed, errors:%+v", err)
+	}
+
+	d, err := ParseProtoDescriptors(data)
+	if err != nil {
+		t.Fatalf("parse descriptor set failed, errors:%+v", err)
+	}
+	return d
+}
+
+func TestProtoDescriptorsMethod(t *testing.T) {
+	d := newTestDescriptors(t)
+
+	m, ok := d.Method("/test.Users/Get")
+	if !ok || m.Path != "/test.Users/Get" || m.InputType != ".test.User" {
+		t.Errorf("method failed, %+v", m)
+		return
+	}
+
+	if _, ok := d.Method("test.Users/List"); ok {
+		t.Errorf("missing method failed")
+	}
+}
+
+func TestProtoJSONTranscode(t *testing.T) {
+	d := newTestDescriptors(t)
+
+	var value map[string]interface{}
+	input := `{"id":"9007199254740993","userName":"alice","role":"ADMIN","scores":[-1,2],
+		"labels":{"a":1},"friend":{"user_name":"bob","active":"true"},"avatar":"AQI=","rate":0.5,"unknown":1}`
+	if err := json.Unmarshal([]byte(input), &value); err != nil {
+		t.Fatalf("unmarshal failed, errors:%+v", err)
+	}
+
+	data, err := d.EncodeJSON(".test.User", value)
+	if err != nil {
+		t.Errorf("encode failed, errors:%+v", err)
+		return
+	}
+
+	decoded, err := d.DecodeJSON(".test.User", data)
+	if err != nil {
+		t.Errorf("decode failed, errors:%+v", err)
+		return
+	}
+
+	expect := map[string]interface{}{
+		"id":       "9007199254740993",
+		"userName": "alice",
+		"role":     "ADMIN",
+		"scores":   []interface{}{int32(-1), int32(2)},
+		"labels":   map[string]interface{}{"a": int32(1)},
+		"friend":   map[string]interface{}{"userName": "bob", "active": true},
+		"avatar":   "AQI=",
+		"rate":     0.5,
+	}
+	if !reflect.DeepEqual(expect, decoded) {
+		t.Errorf("transcode failed, %+v", decoded)
+	}
+}
+
+func TestProtoJSONQueryValues(t *testing.T) {
+	d := newTestDescriptors(t)
+
+	data, err := d.EncodeJSON(".test.User", map[string]interface{}{
+		"id":     "12",
+		"role":   "1",
+		"scores": "3",
+	})
+	if err != nil {
+		t.Errorf("encode failed, errors:%+v", err)
+		return
+	}
+
+	decoded, _ := d.DecodeJSON(".test.User", data)
+	if decoded["id"] != "12" || decoded["role"] != "ADMIN" ||
+		!reflect.DeepEqual(decoded["scores"], []interface{}{int32(3)}) {
+		t.Errorf("query values failed, %+v", decoded)
+	}
+
+	if _, err := d.EncodeJSON(".test.User", map[string]interface{}{"id": "x"}); err == nil {
+		t.Errorf("error integer not rejected")
+	}
+}
@@ -0,0 +1,141 @@
+package util
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	defaultResolverTimeout = time.Second * 3
+)
+
+var (
+	errNoAddress = errors.New("no address for host")
+)
+
+// ResolverCfg is the dns resolver cfg, servers are the dns servers(ip:port), default is the
+// servers of the host. The host without dots is resolved with the search domains first.
+// ttl and negativeTTL(secs) are the cache ttl of the succeed and failed lookups, 0 means no cache
+type ResolverCfg struct {
+	Servers     []string `json:"servers,omitempty"`
+	Search      []string `json:"search,omitempty"`
+	TTL         int64    `json:"ttl,omitempty"`
+	NegativeTTL int64    `json:"negativeTTL,omitempty"`
+	Timeout     int64    `json:"timeout,omitempty"`
+}
+
+type dnsEntry struct {
+	addrs    []string
+	err      error
+	expireAt time.Time
+}
+
+// Resolver is a dns resolver with the specific dns servers, search domains and cache
+type Resolver struct {
+	sync.RWMutex
+
+	cfg      *ResolverCfg
+	resolver *net.Resolver
+	timeout  time.Duration
+	cache    map[string]*dnsEntry
+	next     uint64
+}
+
+// NewResolver returns a dns resolver
+func NewResolver(cfg *ResolverCfg) *Resolver {
+	r := &Resolver{
+		cfg:      cfg,
+		resolver: &net.Resolver{PreferGo: true},
+		timeout:  defaultResolverTimeout,
+		cache:    make(map[string]*dnsEntry),
+	}
+
+	if cfg.Timeout > 0 {
+		r.timeout = time.Second * time.Duration(cfg.Timeout)
+	}
+
+	if len(cfg.Servers) > 0 {
+		r.resolver.Dial = func(ctx context.Context, network, address string) (net.Conn, error) {
+			server := cfg.Servers[atomic.AddUint64(&r.next, 1)%uint64(len(cfg.Servers))]
+			d := &net.Dialer{Timeout: r.timeout}
+			return d.DialContext(ctx, network, server)
+		}
+	}
+
+	return r
+}
+
+// LookupHost returns the addresses of the host, the ip is returned directly
+func (r *Resolver) LookupHost(host string) ([]string, error) {
+	if net.ParseIP(host) != nil {
+		return []string{host}, nil
+	}
+
+	now := time.Now()
+	r.RLock()
+	entry, ok := r.cache[host]
+	r.RUnlock()
+	if ok && now.Before(entry.expireAt) {
+		return entry.addrs, entry.err
+	}
+
+	addrs, err := r.lookup(host)
+	ttl := r.cfg.TTL
+	if err != nil {
+		ttl = r.cfg.NegativeTTL
+	}
+
+	r.Lock()
+	if ttl > 0 {
+		r.cache[host] = &dnsEntry{
+			addrs:    addrs,
+			err:      err,
+			expireAt: now.Add(time.Second * time.Duration(ttl)),
+		}
+	} else {
+		delete(r.cache, host)
+	}
+	r.Unlock()
+
+	return addrs, err
+}
+
+func (r *Resolver) lookup(host string) ([]string, error) {
+	var names []string
+	if !strings.Contains(strings.TrimSuffix(host, "."), ".") {
+		for _, domain := range r.cfg.Search {
+			names = append(names, host+"."+strings.Trim(domain, "."))
+		}
+	}
+	names = append(names, host)
+
+	ctx, cancel := context.WithTimeout(context.Background(), r.timeout)
+	defer cancel()
+
+	err := errNoAddress
+	for _, name := range names {
+		var addrs []string
+		addrs, err = r.resolver.LookupHost(ctx, name)
+		if err == nil && len(addrs) > 0 {
+			return addrs, nil
+		}
+	}
+
+	return nil, err
+}
+
+// DialTimeout connect to the addr(host:port), the host is resolved by the resolver,
+// and the addresses are tried in order
+func (r *Resolver) DialTimeout(addr string, timeout time.Duration) (net.Conn, error) {
+	return r.DialTrace(addr, timeout, nil)
+}
+
+// DialTrace is the same as DialTimeout, and record the dns and connect phases to the trace
+func (r *Resolver) DialTrace(addr string, timeout time.Duration, trace *HTTPTrace) (net.Conn, error) {
+	return dialTrace(r.LookupHost, addr, timeout, trace)
+}
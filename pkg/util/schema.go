@@ -0,0 +1,254 @@
+package util
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"unicode/utf8"
+)
+
+// SchemaError is a json schema validation error
+type SchemaError struct {
+	Path    string `json:"path"`
+	Message string `json:"message"`
+}
+
+// Schema is a compiled json schema, it supports the keywords: type, properties, required,
+// additionalProperties(bool), items, enum, minimum, maximum, minLength, maxLength,
+// pattern, minItems and maxItems
+type Schema struct {
+	Type                 interface{}        `json:"type,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	AdditionalProperties *bool              `json:"additionalProperties,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	Enum                 []interface{}      `json:"enum,omitempty"`
+	Minimum              *float64           `json:"minimum,omitempty"`
+	Maximum              *float64           `json:"maximum,omitempty"`
+	MinLength            *int               `json:"minLength,omitempty"`
+	MaxLength            *int               `json:"maxLength,omitempty"`
+	Pattern              string             `json:"pattern,omitempty"`
+	MinItems             *int               `json:"minItems,omitempty"`
+	MaxItems             *int               `json:"maxItems,omitempty"`
+
+	types   []string
+	pattern *regexp.Regexp
+}
+
+// ParseSchema returns a compiled json schema
+func ParseSchema(data []byte) (*Schema, error) {
+	s := &Schema{}
+	err := json.Unmarshal(data, s)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.compile()
+	if err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+func (s *Schema) compile() error {
+	switch value := s.Type.(type) {
+	case nil:
+	case string:
+		s.types = []string{value}
+	case []interface{}:
+		for _, t := range value {
+			name, ok := t.(string)
+			if !ok {
+				return fmt.Errorf("error schema type: %v", t)
+			}
+			s.types = append(s.types, name)
+		}
+	default:
+		return fmt.Errorf("error schema type: %v", value)
+	}
+
+	if s.Pattern != "" {
+		pattern, err := regexp.Compile(s.Pattern)
+		if err != nil {
+			return err
+		}
+		s.pattern = pattern
+	}
+
+	for _, p := range s.Properties {
+		err := p.compile()
+		if err != nil {
+			return err
+		}
+	}
+
+	if s.Items != nil {
+		return s.Items.compile()
+	}
+
+	return nil
+}
+
+// ValidateJSON validate the json data, returns the validation errors
+func (s *Schema) ValidateJSON(data []byte) []SchemaError {
+	var value interface{}
+	err := json.Unmarshal(data, &value)
+	if err != nil {
+		return []SchemaError{{Path: "$", Message: fmt.Sprintf("invalid json: %s", err)}}
+	}
+
+	return s.Validate(value)
+}
+
+// ValidateStrings validate the string values, e.g. query string or headers,
+// the values are converted according to the types of the properties
+func (s *Schema) ValidateStrings(values map[string]string) []SchemaError {
+	obj := make(map[string]interface{}, len(values))
+	for key, value := range values {
+		obj[key] = value
+		if p, ok := s.Properties[key]; ok {
+			obj[key] = p.convert(value)
+		}
+	}
+
+	return s.Validate(obj)
+}
+
+// Validate validate the value that unmarshal from json, returns the validation errors
+func (s *Schema) Validate(value interface{}) []SchemaError {
+	var errs []SchemaError
+	s.validate("$", value, &errs)
+	return errs
+}
+
+func (s *Schema) convert(value string) interface{} {
+	for _, t := range s.types {
+		switch t {
+		case "integer", "number":
+			if v, err := strconv.ParseFloat(value, 64); err == nil {
+				return v
+			}
+		case "boolean":
+			if v, err := strconv.ParseBool(value); err == nil {
+				return v
+			}
+		}
+	}
+
+	return value
+}
+
+func (s *Schema) validate(path string, value interface{}, errs *[]SchemaError) {
+	if len(s.types) > 0 && !s.matchesType(value) {
+		*errs = append(*errs, SchemaError{Path: path, Message: fmt.Sprintf("expect type %v", s.types)})
+		return
+	}
+
+	if len(s.Enum) > 0 && !s.inEnum(value) {
+		*errs = append(*errs, SchemaError{Path: path, Message: fmt.Sprintf("expect one of %v", s.Enum)})
+	}
+
+	switch v := value.(type) {
+	case float64:
+		if s.Minimum != nil && v < *s.Minimum {
+			*errs = append(*errs, SchemaError{Path: path, Message: fmt.Sprintf("less than minimum %v", *s.Minimum)})
+		}
+		if s.Maximum != nil && v > *s.Maximum {
+			*errs = append(*errs, SchemaError{Path: path, Message: fmt.Sprintf("greater than maximum %v", *s.Maximum)})
+		}
+	case string:
+		n := utf8.RuneCountInString(v)
+		if s.MinLength != nil && n < *s.MinLength {
+			*errs = append(*errs, SchemaError{Path: path, Message: fmt.Sprintf("shorter than minLength %d", *s.MinLength)})
+		}
+		if s.MaxLength != nil && n > *s.MaxLength {
+			*errs = append(*errs, SchemaError{Path: path, Message: fmt.Sprintf("longer than maxLength %d", *s.MaxLength)})
+		}
+		if s.pattern != nil && !s.pattern.MatchString(v) {
+			*errs = append(*errs, SchemaError{Path: path, Message: fmt.Sprintf("not match pattern %s", s.Pattern)})
+		}
+	case []interface{}:
+		if s.MinItems != nil && len(v) < *s.MinItems {
+			*errs = append(*errs, SchemaError{Path: path, Message: fmt.Sprintf("fewer than minItems %d", *s.MinItems)})
+		}
+		if s.MaxItems != nil && len(v) > *s.MaxItems {
+			*errs = append(*errs, SchemaError{Path: path, Message: fmt.Sprintf("more than maxItems %d", *s.MaxItems)})
+		}
+		if s.Items != nil {
+			for idx, item := range v {
+				s.Items.validate(fmt.Sprintf("%s[%d]", path, idx), item, errs)
+			}
+		}
+	case map[string]interface{}:
+		for _, name := range s.Required {
+			if _, ok := v[name]; !ok {
+				*errs = append(*errs, SchemaError{Path: path + "." + name, Message: "required"})
+			}
+		}
+		names := make([]string, 0, len(v))
+		for name := range v {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			if p, ok := s.Properties[name]; ok {
+				p.validate(path+"."+name, v[name], errs)
+			} else if s.AdditionalProperties != nil && !*s.AdditionalProperties {
+				*errs = append(*errs, SchemaError{Path: path + "." + name, Message: "additional property not allowed"})
+			}
+		}
+	}
+}
+
+func (s *Schema) matchesType(value interface{}) bool {
+	for _, t := range s.types {
+		switch t {
+		case "null":
+			if value == nil {
+				return true
+			}
+		case "boolean":
+			if _, ok := value.(bool); ok {
+				return true
+			}
+		case "string":
+			if _, ok := value.(string); ok {
+				return true
+			}
+		case "number":
+			if _, ok := value.(float64); ok {
+				return true
+			}
+		case "integer":
+			if v, ok := value.(float64); ok && v == math.Trunc(v) {
+				return true
+			}
+		case "array":
+			if _, ok := value.([]interface{}); ok {
+				return true
+			}
+		case "object":
+			if _, ok := value.(map[string]interface{}); ok {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func (s *Schema) inEnum(value interface{}) bool {
+	for _, expect := range s.Enum {
+		if reflect.DeepEqual(expect, value) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,52 @@
+package util
+
+import (
+	"testing"
+)
+
+func TestSchemaValidateJSON(t *testing.T) {
+	schema, err := ParseSchema([]byte(`{"type":"object","required":["id","name"],"properties":{"id":{"type":"integer","minimum":1},"name":{"type":"string","maxLength":3}}}`))
+	if err != nil {
+		t.Errorf("parse schema failed, errors:%+v", err)
+		return
+	}
+
+	if errs := schema.ValidateJSON([]byte(`{"id":1,"name":"abc"}`)); len(errs) != 0 {
+		t.Errorf("validate failed, errors:%+v", errs)
+		return
+	}
+
+	errs := schema.ValidateJSON([]byte(`{"id":0.5,"name":"abcd"}`))
+	if len(errs) != 2 {
+		t.Errorf("expect 2 errors, but %+v", errs)
+		return
+	}
+
+	if errs[0].Path != "$.id" || errs[1].Path != "$.name" {
+		t.Errorf("error paths %+v", errs)
+		return
+	}
+}
+
+func TestSchemaValidateStrings(t *testing.T) {
+	schema, err := ParseSchema([]byte(`{"type":"object","required":["page"],"properties":{"page":{"type":"integer"}}}`))
+	if err != nil {
+		t.Errorf("parse schema failed, errors:%+v", err)
+		return
+	}
+
+	if errs := schema.ValidateStrings(map[string]string{"page": "1"}); len(errs) != 0 {
+		t.Errorf("validate failed, errors:%+v", errs)
+		return
+	}
+
+	if errs := schema.ValidateStrings(map[string]string{"page": "a"}); len(errs) != 1 {
+		t.Errorf("expect 1 error, but %+v", errs)
+		return
+	}
+
+	if errs := schema.ValidateStrings(nil); len(errs) != 1 {
+		t.Errorf("expect 1 error, but %+v", errs)
+		return
+	}
+}
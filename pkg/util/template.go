@@ -0,0 +1,264 @@
+package util
+
+import (
+	"bytes"
+	"crypto/md5"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"text/template"
+	"text/template/parse"
+	"time"
+
+	"github.com/buger/jsonparser"
+	"github.com/fagongzi/util/hack"
+	"github.com/valyala/fasthttp"
+)
+
+var (
+	templates       sync.Map
+	expandTemplates sync.Map
+	templateFuncs   = template.FuncMap{
+		"uuid":         uuid,
+		"now":          now,
+		"md5":          md5Hex,
+		"sha1":         sha1Hex,
+		"sha256":       sha256Hex,
+		"base64":       base64Encode,
+		"base64Decode": base64Decode,
+		"jsonPath":     jsonPath,
+		"json":         jsonEncode,
+		"env":          os.Getenv,
+		"escapeDollar": escapeDollar,
+	}
+)
+
+// TemplateData is the data of the templates, e.g. {{.Header "X-User-Id"}}, the request is nil if
+// the template is not executed with a request. The body is the json result of the backends that
+// rendered by the template
+type TemplateData struct {
+	Request   *fasthttp.Request
+	Constants map[string]string
+	Body      []byte
+}
+
+// Header returns the header value of the request
+func (d *TemplateData) Header(name string) string {
+	if d.Request == nil {
+		return ""
+	}
+	return string(d.Request.Header.Peek(name))
+}
+
+// Query returns the query string value of the request
+func (d *TemplateData) Query(name string) string {
+	if d.Request == nil {
+		return ""
+	}
+	return string(d.Request.URI().QueryArgs().Peek(name))
+}
+
+// Cookie returns the cookie value of the request
+func (d *TemplateData) Cookie(name string) string {
+	if d.Request == nil {
+		return ""
+	}
+	return string(d.Request.Header.Cookie(name))
+}
+
+// JSON returns the value of the path in the json body of the request
+func (d *TemplateData) JSON(path string) string {
+	if d.Request == nil {
+		return ""
+	}
+	return jsonPath(hack.SliceToString(d.Request.Body()), path)
+}
+
+// Result returns the json value of the path in the body, e.g. {{.Result "user.name"}} returns "alice"
+// with the quotes, empty path returns the body, the missing value is null
+func (d *TemplateData) Result(path string) string {
+	if path == "" {
+		if len(d.Body) == 0 {
+			return "null"
+		}
+		return string(d.Body)
+	}
+
+	value, vt, _, err := jsonparser.Get(d.Body, strings.Split(path, ".")...)
+	if err != nil {
+		return "null"
+	}
+
+	if vt == jsonparser.String {
+		return "\"" + string(value) + "\""
+	}
+	return string(value)
+}
+
+// Const returns the custom constant of the api
+func (d *TemplateData) Const(name string) string {
+	return d.Constants[name]
+}
+
+// IsTemplate returns true if the text has the template actions
+func IsTemplate(text string) bool {
+	return strings.Contains(text, "{{")
+}
+
+// ParseTemplate parse the text as template with the function library
+func ParseTemplate(text string) (*template.Template, error) {
+	return template.New("").Funcs(templateFuncs).Option("missingkey=zero").Parse(text)
+}
+
+// ExecuteTemplate execute the text as template with the data, the templates are parsed once and
+// cached by the text
+func ExecuteTemplate(text string, data *TemplateData) (string, error) {
+	return execute(&templates, text, data, nil)
+}
+
+// ExpandTemplate execute the text as template with the data, the $ in the outputs of the actions
+// are escaped, so the result can be used as the replacement of the regexp and the request values
+// can't reference the groups of the regexp
+func ExpandTemplate(text string, data *TemplateData) (string, error) {
+	return execute(&expandTemplates, text, data, escapeActions)
+}
+
+func execute(cache *sync.Map, text string, data *TemplateData, adjust func(parse.Node)) (string, error) {
+	var t *template.Template
+	if value, ok := cache.Load(text); ok {
+		t = value.(*template.Template)
+	} else {
+		parsed, err := ParseTemplate(text)
+		if err != nil {
+			return "", err
+		}
+
+		if adjust != nil {
+			adjust(parsed.Tree.Root)
+		}
+
+		value, _ = cache.LoadOrStore(text, parsed)
+		t = value.(*template.Template)
+	}
+
+	var buf bytes.Buffer
+	err := t.Execute(&buf, data)
+	if err != nil {
+		return "", err
+	}
+
+	return buf.String(), nil
+}
+
+// escapeActions pipe the outputs of the actions to the escapeDollar
+func escapeActions(node parse.Node) {
+	switch n := node.(type) {
+	case *parse.ListNode:
+		if n == nil {
+			return
+		}
+		for _, child := range n.Nodes {
+			escapeActions(child)
+		}
+	case *parse.ActionNode:
+		if len(n.Pipe.Decl) == 0 {
+			n.Pipe.Cmds = append(n.Pipe.Cmds, &parse.CommandNode{
+				NodeType: parse.NodeCommand,
+				Args:     []parse.Node{parse.NewIdentifier("escapeDollar")},
+			})
+		}
+	case *parse.IfNode:
+		escapeActions(n.List)
+		escapeActions(n.ElseList)
+	case *parse.RangeNode:
+		escapeActions(n.List)
+		escapeActions(n.ElseList)
+	case *parse.WithNode:
+		escapeActions(n.List)
+		escapeActions(n.ElseList)
+	}
+}
+
+func escapeDollar(value interface{}) string {
+	return strings.Replace(fmt.Sprint(value), "$", "$$", -1)
+}
+
+// uuid returns a random uuid(version 4)
+func uuid() string {
+	var value [16]byte
+	rand.Read(value[:])
+	value[6] = (value[6] & 0x0f) | 0x40
+	value[8] = (value[8] & 0x3f) | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", value[0:4], value[4:6], value[6:8], value[8:10], value[10:])
+}
+
+// now returns the current time using the layout, the layout can be unix, unixms or the go time
+// layout, default is RFC3339
+func now(layout ...string) string {
+	t := time.Now()
+	if len(layout) == 0 {
+		return t.Format(time.RFC3339)
+	}
+
+	switch layout[0] {
+	case "unix":
+		return fmt.Sprintf("%d", t.Unix())
+	case "unixms":
+		return fmt.Sprintf("%d", t.UnixNano()/int64(time.Millisecond))
+	default:
+		return t.Format(layout[0])
+	}
+}
+
+func md5Hex(value string) string {
+	sum := md5.Sum([]byte(value))
+	return hex.EncodeToString(sum[:])
+}
+
+func sha1Hex(value string) string {
+	sum := sha1.Sum([]byte(value))
+	return hex.EncodeToString(sum[:])
+}
+
+func sha256Hex(value string) string {
+	sum := sha256.Sum256([]byte(value))
+	return hex.EncodeToString(sum[:])
+}
+
+func base64Encode(value string) string {
+	return base64.StdEncoding.EncodeToString([]byte(value))
+}
+
+func base64Decode(value string) (string, error) {
+	data, err := base64.StdEncoding.DecodeString(value)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// jsonEncode returns the json of the value, e.g. {{.Query "id" | json}} returns the quoted string
+func jsonEncode(value interface{}) (string, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// jsonPath returns the value of the path in the json, the path is separated by the dot,
+// e.g. user.tags.[0]
+func jsonPath(src, path string) string {
+	value, _, _, err := jsonparser.Get(hack.StringToSlice(src), strings.Split(path, ".")...)
+	if err != nil {
+		return ""
+	}
+	return string(value)
+}
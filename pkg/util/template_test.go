@@ -0,0 +1,22 @@
+package util
+
+import (
+	"testing"
+)
+
+func TestTemplateResult(t *testing.T) {
+	data := &TemplateData{
+		Body: []byte(`{"user":{"id":1,"name":"alice","tags":["a","b"]},"orders":{"items":[]}}`),
+	}
+
+	value, err := ExecuteTemplate(`{"id":{{.Result "user.id"}},"name":{{.Result "user.name"}},"tags":{{.Result "user.tags"}},"missing":{{.Result "user.age"}},"lang":{{"zh\"" | json}}}`, data)
+	if err != nil {
+		t.Errorf("execute template failed, errors:%+v", err)
+		return
+	}
+
+	expect := `{"id":1,"name":"alice","tags":["a","b"],"missing":null,"lang":"zh\""}`
+	if value != expect {
+		t.Errorf("expect %s, but %s", expect, value)
+	}
+}
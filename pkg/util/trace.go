@@ -0,0 +1,148 @@
+package util
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net"
+	"time"
+)
+
+// Phase is the phase of the upstream request
+type Phase int
+
+const (
+	// PhaseNone the request is not started or completed
+	PhaseNone = Phase(iota)
+	// PhaseDNS resolve the host of the server
+	PhaseDNS
+	// PhaseConnect connect to the server
+	PhaseConnect
+	// PhaseWrite write the request to the server
+	PhaseWrite
+	// PhaseFirstByte wait for the first byte of the response
+	PhaseFirstByte
+	// PhaseBody read the response headers and body
+	PhaseBody
+
+	phaseCount = int(PhaseBody) + 1
+)
+
+var phaseNames = [phaseCount]string{"none", "dns", "connect", "write", "first_byte", "body"}
+
+func (p Phase) String() string {
+	return phaseNames[p]
+}
+
+// Phases returns the phases of the upstream request in order
+func Phases() []Phase {
+	return []Phase{PhaseDNS, PhaseConnect, PhaseWrite, PhaseFirstByte, PhaseBody}
+}
+
+// HTTPTrace is the durations of the phases of the upstream request. If the request failed, Phase is
+// the phase that failed in, and Timeout is true if the phase timed out. A nil trace records nothing
+type HTTPTrace struct {
+	Phase     Phase
+	Timeout   bool
+	Durations [phaseCount]time.Duration
+
+	start time.Time
+}
+
+// Reset reset the trace for a new request
+func (t *HTTPTrace) Reset() {
+	if t != nil {
+		*t = HTTPTrace{}
+	}
+}
+
+// Enter end the current phase and enter the phase
+func (t *HTTPTrace) Enter(phase Phase) {
+	if t == nil {
+		return
+	}
+
+	now := time.Now()
+	if t.Phase != PhaseNone {
+		t.Durations[t.Phase] += now.Sub(t.start)
+	}
+	t.Phase = phase
+	t.start = now
+}
+
+// Fail end the current phase with the error, the phase is kept
+func (t *HTTPTrace) Fail(timeout bool) {
+	if t == nil {
+		return
+	}
+
+	phase := t.Phase
+	t.Enter(PhaseNone)
+	t.Phase = phase
+	t.Timeout = timeout
+}
+
+func (t *HTTPTrace) String() string {
+	var buf bytes.Buffer
+	for _, phase := range Phases() {
+		if buf.Len() > 0 {
+			buf.WriteByte(' ')
+		}
+		fmt.Fprintf(&buf, "%s=%s", phase, t.Durations[phase])
+	}
+
+	return buf.String()
+}
+
+// DialFunc connect to the addr, and enter the dns and connect phases of the trace
+type DialFunc func(addr string, trace *HTTPTrace) (net.Conn, error)
+
+// DialTrace connect to the addr(host:port), the host is resolved by the host resolver
+func DialTrace(addr string, timeout time.Duration, trace *HTTPTrace) (net.Conn, error) {
+	return dialTrace(func(host string) ([]string, error) {
+		if net.ParseIP(host) != nil {
+			return []string{host}, nil
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		defer cancel()
+		return net.DefaultResolver.LookupHost(ctx, host)
+	}, addr, timeout, trace)
+}
+
+// dialTrace resolve the host of the addr by the lookup in the dns phase, and connect to the
+// addresses with the staggered fallback in the connect phase
+func dialTrace(lookup func(host string) ([]string, error), addr string, timeout time.Duration, trace *HTTPTrace) (net.Conn, error) {
+	host, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	trace.Enter(PhaseDNS)
+	addrs, err := lookup(host)
+	if err != nil {
+		return nil, err
+	}
+	if len(addrs) == 0 {
+		return nil, fmt.Errorf("no addresses of the host %s", host)
+	}
+
+	trace.Enter(PhaseConnect)
+	if len(addrs) == 1 {
+		return net.DialTimeout("tcp", net.JoinHostPort(addrs[0], port), timeout)
+	}
+
+	return dialFallback(interleaveAddrs(addrs), port, timeout)
+}
+
+// IsTimeout returns true if the err is a timeout error
+func IsTimeout(err error) bool {
+	value, ok := err.(net.Error)
+	return ok && value.Timeout()
+}
+
+// timedOut returns true if the err is a timeout error or the deadline of the conn is exceeded,
+// the errors returned by fasthttp when reading the response are not always net errors
+func timedOut(err error, deadline time.Time) bool {
+	return IsTimeout(err) || (!deadline.IsZero() && !time.Now().Before(deadline))
+}